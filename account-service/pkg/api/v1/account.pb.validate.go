@@ -36,6 +36,1237 @@ var (
 // define the regex for a UUID once up-front
 var _account_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
 
+// Validate checks the field values on GetMFAStatusRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetMFAStatusRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	return nil
+}
+
+// GetMFAStatusRequestValidationError is the validation error returned by
+// GetMFAStatusRequest.Validate if the designated constraints aren't met.
+type GetMFAStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMFAStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMFAStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMFAStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMFAStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMFAStatusRequestValidationError) ErrorName() string {
+	return "GetMFAStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMFAStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMFAStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMFAStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMFAStatusRequestValidationError{}
+
+// Validate checks the field values on GetMFAStatusResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetMFAStatusResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Enabled
+
+	// no validation rules for Required
+
+	// no validation rules for RecoveryCodesLeft
+
+	if v, ok := interface{}(m.GetEnabledOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return GetMFAStatusResponseValidationError{
+				field:  "EnabledOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetMFAStatusResponseValidationError is the validation error returned by
+// GetMFAStatusResponse.Validate if the designated constraints aren't met.
+type GetMFAStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMFAStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMFAStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMFAStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMFAStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMFAStatusResponseValidationError) ErrorName() string {
+	return "GetMFAStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMFAStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMFAStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMFAStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMFAStatusResponseValidationError{}
+
+// Validate checks the field values on EnrollMFARequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *EnrollMFARequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// EnrollMFARequestValidationError is the validation error returned by
+// EnrollMFARequest.Validate if the designated constraints aren't met.
+type EnrollMFARequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollMFARequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollMFARequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollMFARequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollMFARequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollMFARequestValidationError) ErrorName() string { return "EnrollMFARequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EnrollMFARequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollMFARequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollMFARequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollMFARequestValidationError{}
+
+// Validate checks the field values on EnrollMFAResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *EnrollMFAResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Secret
+
+	// no validation rules for KeyUri
+
+	return nil
+}
+
+// EnrollMFAResponseValidationError is the validation error returned by
+// EnrollMFAResponse.Validate if the designated constraints aren't met.
+type EnrollMFAResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollMFAResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollMFAResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollMFAResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollMFAResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollMFAResponseValidationError) ErrorName() string {
+	return "EnrollMFAResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrollMFAResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollMFAResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollMFAResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollMFAResponseValidationError{}
+
+// Validate checks the field values on ActivateMFARequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ActivateMFARequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_ActivateMFARequest_Code_Pattern.MatchString(m.GetCode()) {
+		return ActivateMFARequestValidationError{
+			field:  "Code",
+			reason: "value does not match regex pattern \"^[0-9]{6}$\"",
+		}
+	}
+
+	return nil
+}
+
+// ActivateMFARequestValidationError is the validation error returned by
+// ActivateMFARequest.Validate if the designated constraints aren't met.
+type ActivateMFARequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActivateMFARequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActivateMFARequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActivateMFARequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActivateMFARequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActivateMFARequestValidationError) ErrorName() string {
+	return "ActivateMFARequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ActivateMFARequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActivateMFARequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActivateMFARequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActivateMFARequestValidationError{}
+
+var _ActivateMFARequest_Code_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
+// Validate checks the field values on ActivateMFAResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ActivateMFAResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// ActivateMFAResponseValidationError is the validation error returned by
+// ActivateMFAResponse.Validate if the designated constraints aren't met.
+type ActivateMFAResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActivateMFAResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActivateMFAResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActivateMFAResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActivateMFAResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActivateMFAResponseValidationError) ErrorName() string {
+	return "ActivateMFAResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ActivateMFAResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActivateMFAResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActivateMFAResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActivateMFAResponseValidationError{}
+
+// Validate checks the field values on RegenerateMFARecoveryCodesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *RegenerateMFARecoveryCodesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_RegenerateMFARecoveryCodesRequest_Code_Pattern.MatchString(m.GetCode()) {
+		return RegenerateMFARecoveryCodesRequestValidationError{
+			field:  "Code",
+			reason: "value does not match regex pattern \"^[0-9]{6}$\"",
+		}
+	}
+
+	return nil
+}
+
+// RegenerateMFARecoveryCodesRequestValidationError is the validation error
+// returned by RegenerateMFARecoveryCodesRequest.Validate if the designated
+// constraints aren't met.
+type RegenerateMFARecoveryCodesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegenerateMFARecoveryCodesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegenerateMFARecoveryCodesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegenerateMFARecoveryCodesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegenerateMFARecoveryCodesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegenerateMFARecoveryCodesRequestValidationError) ErrorName() string {
+	return "RegenerateMFARecoveryCodesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegenerateMFARecoveryCodesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegenerateMFARecoveryCodesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegenerateMFARecoveryCodesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegenerateMFARecoveryCodesRequestValidationError{}
+
+var _RegenerateMFARecoveryCodesRequest_Code_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
+// Validate checks the field values on DisableMFARequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *DisableMFARequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for Code
+
+	return nil
+}
+
+// DisableMFARequestValidationError is the validation error returned by
+// DisableMFARequest.Validate if the designated constraints aren't met.
+type DisableMFARequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableMFARequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableMFARequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableMFARequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableMFARequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableMFARequestValidationError) ErrorName() string {
+	return "DisableMFARequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableMFARequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableMFARequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableMFARequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableMFARequestValidationError{}
+
+// Validate checks the field values on DisableMFAResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DisableMFAResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Success
+
+	return nil
+}
+
+// DisableMFAResponseValidationError is the validation error returned by
+// DisableMFAResponse.Validate if the designated constraints aren't met.
+type DisableMFAResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableMFAResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableMFAResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableMFAResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableMFAResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableMFAResponseValidationError) ErrorName() string {
+	return "DisableMFAResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableMFAResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableMFAResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableMFAResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableMFAResponseValidationError{}
+
+// Validate checks the field values on CreateAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateAPIKeyRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_CreateAPIKeyRequest_Name_Pattern.MatchString(m.GetName()) {
+		return CreateAPIKeyRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9_-]{1,64}$\"",
+		}
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	if len(m.GetScopes()) < 1 {
+		return CreateAPIKeyRequestValidationError{
+			field:  "Scopes",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetScopes() {
+		_, _ = idx, item
+
+		if !_CreateAPIKeyRequest_Scopes_Pattern.MatchString(item) {
+			return CreateAPIKeyRequestValidationError{
+				field:  fmt.Sprintf("Scopes[%v]", idx),
+				reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+			}
+		}
+
+	}
+
+	if m.GetExpiresAt() == nil {
+		return CreateAPIKeyRequestValidationError{
+			field:  "ExpiresAt",
+			reason: "value is required",
+		}
+	}
+
+	return nil
+}
+
+// CreateAPIKeyRequestValidationError is the validation error returned by
+// CreateAPIKeyRequest.Validate if the designated constraints aren't met.
+type CreateAPIKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAPIKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAPIKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAPIKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAPIKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAPIKeyRequestValidationError) ErrorName() string {
+	return "CreateAPIKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAPIKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAPIKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAPIKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAPIKeyRequestValidationError{}
+
+var _CreateAPIKeyRequest_Name_Pattern = regexp.MustCompile("^[a-zA-Z0-9_-]{1,64}$")
+
+var _CreateAPIKeyRequest_Scopes_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on CreateAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateAPIKeyResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetApiKey()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAPIKeyResponseValidationError{
+				field:  "ApiKey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Key
+
+	return nil
+}
+
+// CreateAPIKeyResponseValidationError is the validation error returned by
+// CreateAPIKeyResponse.Validate if the designated constraints aren't met.
+type CreateAPIKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAPIKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAPIKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAPIKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAPIKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAPIKeyResponseValidationError) ErrorName() string {
+	return "CreateAPIKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAPIKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAPIKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAPIKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAPIKeyResponseValidationError{}
+
+// Validate checks the field values on ListAPIKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListAPIKeysRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	return nil
+}
+
+// ListAPIKeysRequestValidationError is the validation error returned by
+// ListAPIKeysRequest.Validate if the designated constraints aren't met.
+type ListAPIKeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAPIKeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAPIKeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAPIKeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAPIKeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAPIKeysRequestValidationError) ErrorName() string {
+	return "ListAPIKeysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAPIKeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAPIKeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAPIKeysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAPIKeysRequestValidationError{}
+
+// Validate checks the field values on ListAPIKeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListAPIKeysResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetApiKeys() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListAPIKeysResponseValidationError{
+					field:  fmt.Sprintf("ApiKeys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListAPIKeysResponseValidationError is the validation error returned by
+// ListAPIKeysResponse.Validate if the designated constraints aren't met.
+type ListAPIKeysResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAPIKeysResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAPIKeysResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAPIKeysResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAPIKeysResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAPIKeysResponseValidationError) ErrorName() string {
+	return "ListAPIKeysResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAPIKeysResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAPIKeysResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAPIKeysResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAPIKeysResponseValidationError{}
+
+// Validate checks the field values on RevokeAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RevokeAPIKeyRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return RevokeAPIKeyRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// RevokeAPIKeyRequestValidationError is the validation error returned by
+// RevokeAPIKeyRequest.Validate if the designated constraints aren't met.
+type RevokeAPIKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAPIKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAPIKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAPIKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAPIKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAPIKeyRequestValidationError) ErrorName() string {
+	return "RevokeAPIKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAPIKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAPIKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAPIKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAPIKeyRequestValidationError{}
+
+// Validate checks the field values on RevokeAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RevokeAPIKeyResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Success
+
+	return nil
+}
+
+// RevokeAPIKeyResponseValidationError is the validation error returned by
+// RevokeAPIKeyResponse.Validate if the designated constraints aren't met.
+type RevokeAPIKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAPIKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAPIKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAPIKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAPIKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAPIKeyResponseValidationError) ErrorName() string {
+	return "RevokeAPIKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAPIKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAPIKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAPIKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAPIKeyResponseValidationError{}
+
+// Validate checks the field values on APIKey with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *APIKey) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	if v, ok := interface{}(m.GetCreatedOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetExpiresAt()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// APIKeyValidationError is the validation error returned by APIKey.Validate if
+// the designated constraints aren't met.
+type APIKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e APIKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e APIKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e APIKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e APIKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e APIKeyValidationError) ErrorName() string { return "APIKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e APIKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAPIKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = APIKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = APIKeyValidationError{}
+
 // Validate checks the field values on GetAdminUserScopeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -44,6 +1275,13 @@ func (m *GetAdminUserScopeRequest) Validate() error {
 		return nil
 	}
 
+	if len(m.GetScopes()) != 1 {
+		return GetAdminUserScopeRequestValidationError{
+			field:  "Scopes",
+			reason: "value must contain exactly 1 item(s)",
+		}
+	}
+
 	for idx, item := range m.GetScopes() {
 		_, _ = idx, item
 
@@ -270,6 +1508,234 @@ var _ interface {
 	ErrorName() string
 } = AdminDetailValidationError{}
 
+// Validate checks the field values on ListScopeUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListScopeUsersRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_ListScopeUsersRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return ListScopeUsersRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	return nil
+}
+
+// ListScopeUsersRequestValidationError is the validation error returned by
+// ListScopeUsersRequest.Validate if the designated constraints aren't met.
+type ListScopeUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScopeUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScopeUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScopeUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScopeUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScopeUsersRequestValidationError) ErrorName() string {
+	return "ListScopeUsersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScopeUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScopeUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScopeUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScopeUsersRequestValidationError{}
+
+var _ListScopeUsersRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on ListScopeUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListScopeUsersResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListScopeUsersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListScopeUsersResponseValidationError is the validation error returned by
+// ListScopeUsersResponse.Validate if the designated constraints aren't met.
+type ListScopeUsersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScopeUsersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScopeUsersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScopeUsersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScopeUsersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScopeUsersResponseValidationError) ErrorName() string {
+	return "ListScopeUsersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScopeUsersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScopeUsersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScopeUsersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScopeUsersResponseValidationError{}
+
+// Validate checks the field values on ScopeUser with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *ScopeUser) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	return nil
+}
+
+// ScopeUserValidationError is the validation error returned by
+// ScopeUser.Validate if the designated constraints aren't met.
+type ScopeUserValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScopeUserValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScopeUserValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScopeUserValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScopeUserValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScopeUserValidationError) ErrorName() string { return "ScopeUserValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScopeUserValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScopeUser.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScopeUserValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScopeUserValidationError{}
+
 // Validate checks the field values on GetScopeRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, an
 // error is returned.
@@ -58,6 +58,24 @@ type AccountServiceClient interface {
 	ListComplienceGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListComplienceGroupsResponse, error)
 	//GetAdminUserScope returns admin details for a particular scope
 	GetAdminUserScope(ctx context.Context, in *GetAdminUserScopeRequest, opts ...grpc.CallOption) (*GetAdminUserScopeResponse, error)
+	//ListScopeUsers lists the active users having access to the scope with their current role
+	ListScopeUsers(ctx context.Context, in *ListScopeUsersRequest, opts ...grpc.CallOption) (*ListScopeUsersResponse, error)
+	//CreateAPIKey creates a personal api key for the user or, for super admins, for another user
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	//ListAPIKeys lists the api keys of the user, secrets are never returned
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	//RevokeAPIKey revokes an api key, it cannot be used anymore
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
+	//GetMFAStatus tells whether the user has enrolled an authenticator and whether the user must
+	GetMFAStatus(ctx context.Context, in *GetMFAStatusRequest, opts ...grpc.CallOption) (*GetMFAStatusResponse, error)
+	//EnrollMFA generates a new totp secret for the user, it is used once activated
+	EnrollMFA(ctx context.Context, in *EnrollMFARequest, opts ...grpc.CallOption) (*EnrollMFAResponse, error)
+	//ActivateMFA activates the enrolled secret with a first code and returns the recovery codes
+	ActivateMFA(ctx context.Context, in *ActivateMFARequest, opts ...grpc.CallOption) (*ActivateMFAResponse, error)
+	//RegenerateMFARecoveryCodes replaces the recovery codes of the user
+	RegenerateMFARecoveryCodes(ctx context.Context, in *RegenerateMFARecoveryCodesRequest, opts ...grpc.CallOption) (*ActivateMFAResponse, error)
+	//DisableMFA removes the authenticator of the user, super admins can reset the one of other users
+	DisableMFA(ctx context.Context, in *DisableMFARequest, opts ...grpc.CallOption) (*DisableMFAResponse, error)
 }
 
 type accountServiceClient struct {
@@ -293,6 +311,87 @@ func (c *accountServiceClient) GetAdminUserScope(ctx context.Context, in *GetAdm
 	return out, nil
 }
 
+func (c *accountServiceClient) ListScopeUsers(ctx context.Context, in *ListScopeUsersRequest, opts ...grpc.CallOption) (*ListScopeUsersResponse, error) {
+	out := new(ListScopeUsersResponse)
+	err := c.cc.Invoke(ctx, "/optisam.account.v1.AccountService/ListScopeUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/optisam.account.v1.AccountService/CreateAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, "/optisam.account.v1.AccountService/ListAPIKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/optisam.account.v1.AccountService/RevokeAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetMFAStatus(ctx context.Context, in *GetMFAStatusRequest, opts ...grpc.CallOption) (*GetMFAStatusResponse, error) {
+	out := new(GetMFAStatusResponse)
+	err := c.cc.Invoke(ctx, "/optisam.account.v1.AccountService/GetMFAStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) EnrollMFA(ctx context.Context, in *EnrollMFARequest, opts ...grpc.CallOption) (*EnrollMFAResponse, error) {
+	out := new(EnrollMFAResponse)
+	err := c.cc.Invoke(ctx, "/optisam.account.v1.AccountService/EnrollMFA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ActivateMFA(ctx context.Context, in *ActivateMFARequest, opts ...grpc.CallOption) (*ActivateMFAResponse, error) {
+	out := new(ActivateMFAResponse)
+	err := c.cc.Invoke(ctx, "/optisam.account.v1.AccountService/ActivateMFA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) RegenerateMFARecoveryCodes(ctx context.Context, in *RegenerateMFARecoveryCodesRequest, opts ...grpc.CallOption) (*ActivateMFAResponse, error) {
+	out := new(ActivateMFAResponse)
+	err := c.cc.Invoke(ctx, "/optisam.account.v1.AccountService/RegenerateMFARecoveryCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) DisableMFA(ctx context.Context, in *DisableMFARequest, opts ...grpc.CallOption) (*DisableMFAResponse, error) {
+	out := new(DisableMFAResponse)
+	err := c.cc.Invoke(ctx, "/optisam.account.v1.AccountService/DisableMFA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountServiceServer is the server API for AccountService service.
 // All implementations should embed UnimplementedAccountServiceServer
 // for forward compatibility
@@ -338,6 +437,24 @@ type AccountServiceServer interface {
 	ListComplienceGroups(context.Context, *ListGroupsRequest) (*ListComplienceGroupsResponse, error)
 	//GetAdminUserScope returns admin details for a particular scope
 	GetAdminUserScope(context.Context, *GetAdminUserScopeRequest) (*GetAdminUserScopeResponse, error)
+	//ListScopeUsers lists the active users having access to the scope with their current role
+	ListScopeUsers(context.Context, *ListScopeUsersRequest) (*ListScopeUsersResponse, error)
+	//CreateAPIKey creates a personal api key for the user or, for super admins, for another user
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	//ListAPIKeys lists the api keys of the user, secrets are never returned
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	//RevokeAPIKey revokes an api key, it cannot be used anymore
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+	//GetMFAStatus tells whether the user has enrolled an authenticator and whether the user must
+	GetMFAStatus(context.Context, *GetMFAStatusRequest) (*GetMFAStatusResponse, error)
+	//EnrollMFA generates a new totp secret for the user, it is used once activated
+	EnrollMFA(context.Context, *EnrollMFARequest) (*EnrollMFAResponse, error)
+	//ActivateMFA activates the enrolled secret with a first code and returns the recovery codes
+	ActivateMFA(context.Context, *ActivateMFARequest) (*ActivateMFAResponse, error)
+	//RegenerateMFARecoveryCodes replaces the recovery codes of the user
+	RegenerateMFARecoveryCodes(context.Context, *RegenerateMFARecoveryCodesRequest) (*ActivateMFAResponse, error)
+	//DisableMFA removes the authenticator of the user, super admins can reset the one of other users
+	DisableMFA(context.Context, *DisableMFARequest) (*DisableMFAResponse, error)
 }
 
 // UnimplementedAccountServiceServer should be embedded to have forward compatible implementations.
@@ -419,6 +536,33 @@ func (UnimplementedAccountServiceServer) ListComplienceGroups(context.Context, *
 func (UnimplementedAccountServiceServer) GetAdminUserScope(context.Context, *GetAdminUserScopeRequest) (*GetAdminUserScopeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAdminUserScope not implemented")
 }
+func (UnimplementedAccountServiceServer) ListScopeUsers(context.Context, *ListScopeUsersRequest) (*ListScopeUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScopeUsers not implemented")
+}
+func (UnimplementedAccountServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedAccountServiceServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedAccountServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedAccountServiceServer) GetMFAStatus(context.Context, *GetMFAStatusRequest) (*GetMFAStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMFAStatus not implemented")
+}
+func (UnimplementedAccountServiceServer) EnrollMFA(context.Context, *EnrollMFARequest) (*EnrollMFAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollMFA not implemented")
+}
+func (UnimplementedAccountServiceServer) ActivateMFA(context.Context, *ActivateMFARequest) (*ActivateMFAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateMFA not implemented")
+}
+func (UnimplementedAccountServiceServer) RegenerateMFARecoveryCodes(context.Context, *RegenerateMFARecoveryCodesRequest) (*ActivateMFAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateMFARecoveryCodes not implemented")
+}
+func (UnimplementedAccountServiceServer) DisableMFA(context.Context, *DisableMFARequest) (*DisableMFAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableMFA not implemented")
+}
 
 // UnsafeAccountServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to AccountServiceServer will
@@ -881,6 +1025,168 @@ func _AccountService_GetAdminUserScope_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_ListScopeUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScopeUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListScopeUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.account.v1.AccountService/ListScopeUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListScopeUsers(ctx, req.(*ListScopeUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.account.v1.AccountService/CreateAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.account.v1.AccountService/ListAPIKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.account.v1.AccountService/RevokeAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetMFAStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMFAStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetMFAStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.account.v1.AccountService/GetMFAStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetMFAStatus(ctx, req.(*GetMFAStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_EnrollMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).EnrollMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.account.v1.AccountService/EnrollMFA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).EnrollMFA(ctx, req.(*EnrollMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ActivateMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ActivateMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.account.v1.AccountService/ActivateMFA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ActivateMFA(ctx, req.(*ActivateMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_RegenerateMFARecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateMFARecoveryCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).RegenerateMFARecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.account.v1.AccountService/RegenerateMFARecoveryCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).RegenerateMFARecoveryCodes(ctx, req.(*RegenerateMFARecoveryCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_DisableMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).DisableMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.account.v1.AccountService/DisableMFA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).DisableMFA(ctx, req.(*DisableMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AccountService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "optisam.account.v1.AccountService",
 	HandlerType: (*AccountServiceServer)(nil),
@@ -985,6 +1291,42 @@ var _AccountService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAdminUserScope",
 			Handler:    _AccountService_GetAdminUserScope_Handler,
 		},
+		{
+			MethodName: "ListScopeUsers",
+			Handler:    _AccountService_ListScopeUsers_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _AccountService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _AccountService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _AccountService_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "GetMFAStatus",
+			Handler:    _AccountService_GetMFAStatus_Handler,
+		},
+		{
+			MethodName: "EnrollMFA",
+			Handler:    _AccountService_EnrollMFA_Handler,
+		},
+		{
+			MethodName: "ActivateMFA",
+			Handler:    _AccountService_ActivateMFA_Handler,
+		},
+		{
+			MethodName: "RegenerateMFARecoveryCodes",
+			Handler:    _AccountService_RegenerateMFARecoveryCodes_Handler,
+		},
+		{
+			MethodName: "DisableMFA",
+			Handler:    _AccountService_DisableMFA_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "account.proto",
@@ -535,6 +535,186 @@ func (mr *MockAccountServiceClientMockRecorder) GetAdminUserScope(ctx, in interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdminUserScope", reflect.TypeOf((*MockAccountServiceClient)(nil).GetAdminUserScope), varargs...)
 }
 
+// ListScopeUsers mocks base method
+func (m *MockAccountServiceClient) ListScopeUsers(ctx context.Context, in *v1.ListScopeUsersRequest, opts ...grpc.CallOption) (*v1.ListScopeUsersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListScopeUsers", varargs...)
+	ret0, _ := ret[0].(*v1.ListScopeUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScopeUsers indicates an expected call of ListScopeUsers
+func (mr *MockAccountServiceClientMockRecorder) ListScopeUsers(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScopeUsers", reflect.TypeOf((*MockAccountServiceClient)(nil).ListScopeUsers), varargs...)
+}
+
+// CreateAPIKey mocks base method
+func (m *MockAccountServiceClient) CreateAPIKey(ctx context.Context, in *v1.CreateAPIKeyRequest, opts ...grpc.CallOption) (*v1.CreateAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAPIKey", varargs...)
+	ret0, _ := ret[0].(*v1.CreateAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey
+func (mr *MockAccountServiceClientMockRecorder) CreateAPIKey(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockAccountServiceClient)(nil).CreateAPIKey), varargs...)
+}
+
+// ListAPIKeys mocks base method
+func (m *MockAccountServiceClient) ListAPIKeys(ctx context.Context, in *v1.ListAPIKeysRequest, opts ...grpc.CallOption) (*v1.ListAPIKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAPIKeys", varargs...)
+	ret0, _ := ret[0].(*v1.ListAPIKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys
+func (mr *MockAccountServiceClientMockRecorder) ListAPIKeys(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockAccountServiceClient)(nil).ListAPIKeys), varargs...)
+}
+
+// RevokeAPIKey mocks base method
+func (m *MockAccountServiceClient) RevokeAPIKey(ctx context.Context, in *v1.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*v1.RevokeAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeAPIKey", varargs...)
+	ret0, _ := ret[0].(*v1.RevokeAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey
+func (mr *MockAccountServiceClientMockRecorder) RevokeAPIKey(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockAccountServiceClient)(nil).RevokeAPIKey), varargs...)
+}
+
+// GetMFAStatus mocks base method
+func (m *MockAccountServiceClient) GetMFAStatus(ctx context.Context, in *v1.GetMFAStatusRequest, opts ...grpc.CallOption) (*v1.GetMFAStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetMFAStatus", varargs...)
+	ret0, _ := ret[0].(*v1.GetMFAStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMFAStatus indicates an expected call of GetMFAStatus
+func (mr *MockAccountServiceClientMockRecorder) GetMFAStatus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMFAStatus", reflect.TypeOf((*MockAccountServiceClient)(nil).GetMFAStatus), varargs...)
+}
+
+// EnrollMFA mocks base method
+func (m *MockAccountServiceClient) EnrollMFA(ctx context.Context, in *v1.EnrollMFARequest, opts ...grpc.CallOption) (*v1.EnrollMFAResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnrollMFA", varargs...)
+	ret0, _ := ret[0].(*v1.EnrollMFAResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollMFA indicates an expected call of EnrollMFA
+func (mr *MockAccountServiceClientMockRecorder) EnrollMFA(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollMFA", reflect.TypeOf((*MockAccountServiceClient)(nil).EnrollMFA), varargs...)
+}
+
+// ActivateMFA mocks base method
+func (m *MockAccountServiceClient) ActivateMFA(ctx context.Context, in *v1.ActivateMFARequest, opts ...grpc.CallOption) (*v1.ActivateMFAResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ActivateMFA", varargs...)
+	ret0, _ := ret[0].(*v1.ActivateMFAResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivateMFA indicates an expected call of ActivateMFA
+func (mr *MockAccountServiceClientMockRecorder) ActivateMFA(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateMFA", reflect.TypeOf((*MockAccountServiceClient)(nil).ActivateMFA), varargs...)
+}
+
+// RegenerateMFARecoveryCodes mocks base method
+func (m *MockAccountServiceClient) RegenerateMFARecoveryCodes(ctx context.Context, in *v1.RegenerateMFARecoveryCodesRequest, opts ...grpc.CallOption) (*v1.ActivateMFAResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegenerateMFARecoveryCodes", varargs...)
+	ret0, _ := ret[0].(*v1.ActivateMFAResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateMFARecoveryCodes indicates an expected call of RegenerateMFARecoveryCodes
+func (mr *MockAccountServiceClientMockRecorder) RegenerateMFARecoveryCodes(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateMFARecoveryCodes", reflect.TypeOf((*MockAccountServiceClient)(nil).RegenerateMFARecoveryCodes), varargs...)
+}
+
+// DisableMFA mocks base method
+func (m *MockAccountServiceClient) DisableMFA(ctx context.Context, in *v1.DisableMFARequest, opts ...grpc.CallOption) (*v1.DisableMFAResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DisableMFA", varargs...)
+	ret0, _ := ret[0].(*v1.DisableMFAResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableMFA indicates an expected call of DisableMFA
+func (mr *MockAccountServiceClientMockRecorder) DisableMFA(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableMFA", reflect.TypeOf((*MockAccountServiceClient)(nil).DisableMFA), varargs...)
+}
+
 // MockAccountServiceServer is a mock of AccountServiceServer interface
 type MockAccountServiceServer struct {
 	ctrl     *gomock.Controller
@@ -933,6 +1113,141 @@ func (mr *MockAccountServiceServerMockRecorder) GetAdminUserScope(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdminUserScope", reflect.TypeOf((*MockAccountServiceServer)(nil).GetAdminUserScope), arg0, arg1)
 }
 
+// ListScopeUsers mocks base method
+func (m *MockAccountServiceServer) ListScopeUsers(arg0 context.Context, arg1 *v1.ListScopeUsersRequest) (*v1.ListScopeUsersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScopeUsers", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ListScopeUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScopeUsers indicates an expected call of ListScopeUsers
+func (mr *MockAccountServiceServerMockRecorder) ListScopeUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScopeUsers", reflect.TypeOf((*MockAccountServiceServer)(nil).ListScopeUsers), arg0, arg1)
+}
+
+// CreateAPIKey mocks base method
+func (m *MockAccountServiceServer) CreateAPIKey(arg0 context.Context, arg1 *v1.CreateAPIKeyRequest) (*v1.CreateAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(*v1.CreateAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey
+func (mr *MockAccountServiceServerMockRecorder) CreateAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockAccountServiceServer)(nil).CreateAPIKey), arg0, arg1)
+}
+
+// ListAPIKeys mocks base method
+func (m *MockAccountServiceServer) ListAPIKeys(arg0 context.Context, arg1 *v1.ListAPIKeysRequest) (*v1.ListAPIKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ListAPIKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys
+func (mr *MockAccountServiceServerMockRecorder) ListAPIKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockAccountServiceServer)(nil).ListAPIKeys), arg0, arg1)
+}
+
+// RevokeAPIKey mocks base method
+func (m *MockAccountServiceServer) RevokeAPIKey(arg0 context.Context, arg1 *v1.RevokeAPIKeyRequest) (*v1.RevokeAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(*v1.RevokeAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey
+func (mr *MockAccountServiceServerMockRecorder) RevokeAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockAccountServiceServer)(nil).RevokeAPIKey), arg0, arg1)
+}
+
+// GetMFAStatus mocks base method
+func (m *MockAccountServiceServer) GetMFAStatus(arg0 context.Context, arg1 *v1.GetMFAStatusRequest) (*v1.GetMFAStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMFAStatus", arg0, arg1)
+	ret0, _ := ret[0].(*v1.GetMFAStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMFAStatus indicates an expected call of GetMFAStatus
+func (mr *MockAccountServiceServerMockRecorder) GetMFAStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMFAStatus", reflect.TypeOf((*MockAccountServiceServer)(nil).GetMFAStatus), arg0, arg1)
+}
+
+// EnrollMFA mocks base method
+func (m *MockAccountServiceServer) EnrollMFA(arg0 context.Context, arg1 *v1.EnrollMFARequest) (*v1.EnrollMFAResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollMFA", arg0, arg1)
+	ret0, _ := ret[0].(*v1.EnrollMFAResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollMFA indicates an expected call of EnrollMFA
+func (mr *MockAccountServiceServerMockRecorder) EnrollMFA(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollMFA", reflect.TypeOf((*MockAccountServiceServer)(nil).EnrollMFA), arg0, arg1)
+}
+
+// ActivateMFA mocks base method
+func (m *MockAccountServiceServer) ActivateMFA(arg0 context.Context, arg1 *v1.ActivateMFARequest) (*v1.ActivateMFAResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateMFA", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ActivateMFAResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivateMFA indicates an expected call of ActivateMFA
+func (mr *MockAccountServiceServerMockRecorder) ActivateMFA(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateMFA", reflect.TypeOf((*MockAccountServiceServer)(nil).ActivateMFA), arg0, arg1)
+}
+
+// RegenerateMFARecoveryCodes mocks base method
+func (m *MockAccountServiceServer) RegenerateMFARecoveryCodes(arg0 context.Context, arg1 *v1.RegenerateMFARecoveryCodesRequest) (*v1.ActivateMFAResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateMFARecoveryCodes", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ActivateMFAResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateMFARecoveryCodes indicates an expected call of RegenerateMFARecoveryCodes
+func (mr *MockAccountServiceServerMockRecorder) RegenerateMFARecoveryCodes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateMFARecoveryCodes", reflect.TypeOf((*MockAccountServiceServer)(nil).RegenerateMFARecoveryCodes), arg0, arg1)
+}
+
+// DisableMFA mocks base method
+func (m *MockAccountServiceServer) DisableMFA(arg0 context.Context, arg1 *v1.DisableMFARequest) (*v1.DisableMFAResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableMFA", arg0, arg1)
+	ret0, _ := ret[0].(*v1.DisableMFAResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableMFA indicates an expected call of DisableMFA
+func (mr *MockAccountServiceServerMockRecorder) DisableMFA(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableMFA", reflect.TypeOf((*MockAccountServiceServer)(nil).DisableMFA), arg0, arg1)
+}
+
 // MockUnsafeAccountServiceServer is a mock of UnsafeAccountServiceServer interface
 type MockUnsafeAccountServiceServer struct {
 	ctrl     *gomock.Controller
@@ -279,8 +279,14 @@ func RunServer() error {
 			logger.Log.Sugar().Debug("Recovered in RunServer", r)
 		}
 	}()
+	auditPublisher, err := iam.NewAuditPublisher(cfg.IAM)
+	if err != nil {
+		logger.Log.Fatal("Failed to create audit publisher", zap.Error(err))
+	}
+	revoked := iam.NewRevocationList(cfg.IAM)
+	apiKeys := iam.NewAPIKeyStore(cfg.IAM)
 	go func() {
-		_ = rest.RunServer(ctx, cfg.GRPCPort, cfg.HTTPPort, verifyKey)
+		_ = rest.RunServer(ctx, cfg.GRPCPort, cfg.HTTPPort, verifyKey, revoked, apiKeys)
 	}()
-	return grpc.RunServer(ctx, v1API, cfg.GRPCPort, verifyKey, cfg.IAM.APIKey, v1.AdminRightsRequired)
+	return grpc.RunServer(ctx, v1API, cfg.GRPCPort, verifyKey, cfg.IAM.APIKey, v1.AdminRightsRequired, revoked, apiKeys, v1.MFAEnrollmentMethods, auditPublisher)
 }
@@ -4,6 +4,7 @@ import (
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/iam"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/jaeger"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/mfa"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/pki"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/postgres"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/redis"
@@ -62,6 +63,9 @@ type Config struct {
 	IAM iam.Config
 
 	Emailtemplate Emailtemplate
+
+	// MFA tells which roles must login with a second factor, they cannot disable it
+	MFA mfa.Policy
 }
 
 type Emailtemplate struct {
@@ -106,6 +110,10 @@ func (c Config) Validate() error {
 	if err := c.PKI.Validate(); err != nil {
 		return err
 	}
+
+	if err := c.MFA.Validate(); err != nil {
+		return err
+	}
 	// if err := c.Redis.Validate(); err != nil {
 	// 	return err
 	// }
@@ -190,4 +198,7 @@ func Configure(v *viper.Viper, p *pflag.FlagSet) {
 
 	// PKI configuration
 	v.SetDefault("pki.publickeypath", ".")
+
+	// MFA configuration
+	v.SetDefault("mfa.requiredroles", mfa.DefaultRequiredRoles)
 }
@@ -10,22 +10,25 @@ import (
 
 	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/account-service/pkg/api/v1"
 
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/audit"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
 	mw "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/revocation"
 
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
 )
 
 // RunServer runs gRPC service to publish Auth service
-func RunServer(ctx context.Context, v1API v1.AccountServiceServer, port string, verifyKey *rsa.PublicKey, apiKey string, adminRights mw.AdminRightsRequiredFunc) error {
+func RunServer(ctx context.Context, v1API v1.AccountServiceServer, port string, verifyKey *rsa.PublicKey, apiKey string, adminRights mw.AdminRightsRequiredFunc, revoked revocation.List, keys apikey.Store, mfaEnrollmentMethods []string, auditPublisher audit.Publisher) error {
 	listen, err := net.Listen("tcp", ":"+port)
 	if err != nil {
 		return err
 	}
 
 	// gRPC server statup options
-	opts := mw.ChainedWithAdminFilter(logger.Log, verifyKey, apiKey, adminRights)
+	opts := mw.ChainedWithAdminFilter(logger.Log, verifyKey, apiKey, adminRights, mw.WithRevocationList(revoked), mw.WithAPIKeyStore(keys), mw.WithMFAEnrollmentMethods(mfaEnrollmentMethods...), mw.WithAuditPublisher(auditPublisher))
 	opts = append(opts, grpc.StatsHandler(&ocgrpc.ServerHandler{}))
 	// add middleware
 	// opts = grpc_middleware.AddLogging(logger.Log, opts)
@@ -12,6 +12,8 @@ import (
 
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
 	rest_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/rest"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/revocation"
 
 	"google.golang.org/protobuf/encoding/protojson"
 
@@ -23,7 +25,7 @@ import (
 )
 
 // RunServer runs HTTP/REST gateway
-func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.PublicKey) error {
+func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.PublicKey, revoked revocation.List, keys apikey.Store) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -46,10 +48,7 @@ func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.Pu
 	srv := &http.Server{
 		Addr: ":" + httpPort,
 		Handler: &ochttp.Handler{Handler: rest_middleware.AddCORS([]string{"*"},
-			// rest_middleware.ValidateAuth(verifyKey,
-			// rest_middleware.AddLogger(logger.Log,
-			mux),
-		// )},
+			rest_middleware.ValidateAuthWithMFAEnrollment(verifyKey, revoked, keys, mux)),
 		},
 	}
 
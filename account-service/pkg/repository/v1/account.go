@@ -112,6 +112,8 @@ type Account interface {
 	SetScope(ctx context.Context, scope []*Scope) error
 	GetScopes(ctx context.Context, s []string) ([]*Scope, error)
 	DropScope(ctx context.Context, s string) error
+	// RevokeUserTokens revokes all the tokens issued to the user and api keys created until now
+	RevokeUserTokens(ctx context.Context, userID string) error
 
 	//GetComplienceGroups returns complienced groups with scopes
 	GetComplienceGroups(ctx context.Context) ([]GetComplienceGroups, error)
@@ -120,6 +122,43 @@ type Account interface {
 	GenerateRandomPassword() ([]byte, error)
 	CreateToken() string
 	AdminUserForScope(ctx context.Context, scopes []string) ([]*AdminUserForScope, error)
+
+	// ScopeUsers lists the active users having access to the scope with their role
+	ScopeUsers(ctx context.Context, scope string) ([]*AccountInfo, error)
+
+	// CreateAPIKey saves the api key in database and in the api key store shared with services
+	CreateAPIKey(ctx context.Context, key *APIKey) error
+
+	// APIKeys lists the api keys of the user
+	APIKeys(ctx context.Context, userID string) ([]*APIKey, error)
+
+	// APIKeyByID returns the api key with given id, ErrNoData is returned if there is no such key
+	APIKeyByID(ctx context.Context, id string) (*APIKey, error)
+
+	// DeleteAPIKey deletes the api key from database and from the api key store
+	DeleteAPIKey(ctx context.Context, id string) error
+
+	// DropUserAPIKeys removes all the api keys of the user from the api key store
+	DropUserAPIKeys(ctx context.Context, userID string) error
+
+	// UserMFA returns the authenticator of the user, ErrNoData is returned if user has none
+	UserMFA(ctx context.Context, userID string) (*UserMFA, error)
+
+	// SaveMFASecret saves a new secret for the user, it is not used until it is enabled
+	SaveMFASecret(ctx context.Context, userID, secret string) error
+
+	// EnableMFA enables the secret of the user with its first used time step and recovery codes
+	EnableMFA(ctx context.Context, userID string, lastUsedStep int64, recoveryCodes []string) error
+
+	// UpdateMFARecoveryCodes replaces the recovery codes of the user
+	UpdateMFARecoveryCodes(ctx context.Context, userID string, recoveryCodes []string) error
+
+	// UseMFAStep records the time step of an accepted code, false is returned if a code
+	// of this step or of a later one was already used
+	UseMFAStep(ctx context.Context, userID string, step int64) (bool, error)
+
+	// DeleteMFA removes the authenticator of the user
+	DeleteMFA(ctx context.Context, userID string) error
 }
 
 func NullString(str string) sql.NullString {
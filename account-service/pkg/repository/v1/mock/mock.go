@@ -37,6 +37,36 @@ func (m *MockAccount) EXPECT() *MockAccountMockRecorder {
 	return m.recorder
 }
 
+// APIKeyByID mocks base method
+func (m *MockAccount) APIKeyByID(arg0 context.Context, arg1 string) (*v1.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIKeyByID", arg0, arg1)
+	ret0, _ := ret[0].(*v1.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// APIKeyByID indicates an expected call of APIKeyByID
+func (mr *MockAccountMockRecorder) APIKeyByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIKeyByID", reflect.TypeOf((*MockAccount)(nil).APIKeyByID), arg0, arg1)
+}
+
+// APIKeys mocks base method
+func (m *MockAccount) APIKeys(arg0 context.Context, arg1 string) ([]*v1.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIKeys", arg0, arg1)
+	ret0, _ := ret[0].([]*v1.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// APIKeys indicates an expected call of APIKeys
+func (mr *MockAccountMockRecorder) APIKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIKeys", reflect.TypeOf((*MockAccount)(nil).APIKeys), arg0, arg1)
+}
+
 // AccountInfo mocks base method
 func (m *MockAccount) AccountInfo(arg0 context.Context, arg1 string) (*v1.AccountInfo, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +169,20 @@ func (mr *MockAccountMockRecorder) ChildGroupsDirect(arg0, arg1, arg2 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChildGroupsDirect", reflect.TypeOf((*MockAccount)(nil).ChildGroupsDirect), arg0, arg1, arg2)
 }
 
+// CreateAPIKey mocks base method
+func (m *MockAccount) CreateAPIKey(arg0 context.Context, arg1 *v1.APIKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey
+func (mr *MockAccountMockRecorder) CreateAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockAccount)(nil).CreateAPIKey), arg0, arg1)
+}
+
 // CreateAccount mocks base method
 func (m *MockAccount) CreateAccount(arg0 context.Context, arg1 *v1.AccountInfo) error {
 	m.ctrl.T.Helper()
@@ -196,6 +240,20 @@ func (mr *MockAccountMockRecorder) CreateToken() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MockAccount)(nil).CreateToken))
 }
 
+// DeleteAPIKey mocks base method
+func (m *MockAccount) DeleteAPIKey(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAPIKey indicates an expected call of DeleteAPIKey
+func (mr *MockAccountMockRecorder) DeleteAPIKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAPIKey", reflect.TypeOf((*MockAccount)(nil).DeleteAPIKey), arg0, arg1)
+}
+
 // DeleteGroup mocks base method
 func (m *MockAccount) DeleteGroup(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
@@ -224,6 +282,20 @@ func (mr *MockAccountMockRecorder) DeleteGroupUsers(arg0, arg1, arg2 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupUsers", reflect.TypeOf((*MockAccount)(nil).DeleteGroupUsers), arg0, arg1, arg2)
 }
 
+// DeleteMFA mocks base method
+func (m *MockAccount) DeleteMFA(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMFA", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMFA indicates an expected call of DeleteMFA
+func (mr *MockAccountMockRecorder) DeleteMFA(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMFA", reflect.TypeOf((*MockAccount)(nil).DeleteMFA), arg0, arg1)
+}
+
 // DeleteUser mocks base method
 func (m *MockAccount) DeleteUser(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -266,6 +338,34 @@ func (mr *MockAccountMockRecorder) DropScopeTX(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropScopeTX", reflect.TypeOf((*MockAccount)(nil).DropScopeTX), arg0, arg1)
 }
 
+// DropUserAPIKeys mocks base method
+func (m *MockAccount) DropUserAPIKeys(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DropUserAPIKeys", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DropUserAPIKeys indicates an expected call of DropUserAPIKeys
+func (mr *MockAccountMockRecorder) DropUserAPIKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropUserAPIKeys", reflect.TypeOf((*MockAccount)(nil).DropUserAPIKeys), arg0, arg1)
+}
+
+// EnableMFA mocks base method
+func (m *MockAccount) EnableMFA(arg0 context.Context, arg1 string, arg2 int64, arg3 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableMFA", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableMFA indicates an expected call of EnableMFA
+func (mr *MockAccountMockRecorder) EnableMFA(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableMFA", reflect.TypeOf((*MockAccount)(nil).EnableMFA), arg0, arg1, arg2, arg3)
+}
+
 // GenerateMailBody mocks base method
 func (m *MockAccount) GenerateMailBody(arg0 helper.EmailParams, arg1 context.Context, arg2 config.Config) (string, error) {
 	m.ctrl.T.Helper()
@@ -430,6 +530,34 @@ func (mr *MockAccountMockRecorder) ListScopes(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScopes", reflect.TypeOf((*MockAccount)(nil).ListScopes), arg0, arg1)
 }
 
+// RevokeUserTokens mocks base method
+func (m *MockAccount) RevokeUserTokens(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserTokens", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserTokens indicates an expected call of RevokeUserTokens
+func (mr *MockAccountMockRecorder) RevokeUserTokens(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserTokens", reflect.TypeOf((*MockAccount)(nil).RevokeUserTokens), arg0, arg1)
+}
+
+// SaveMFASecret mocks base method
+func (m *MockAccount) SaveMFASecret(arg0 context.Context, arg1 string, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveMFASecret", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveMFASecret indicates an expected call of SaveMFASecret
+func (mr *MockAccountMockRecorder) SaveMFASecret(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMFASecret", reflect.TypeOf((*MockAccount)(nil).SaveMFASecret), arg0, arg1, arg2)
+}
+
 // ScopeByCode mocks base method
 func (m *MockAccount) ScopeByCode(arg0 context.Context, arg1 string) (*v1.Scope, error) {
 	m.ctrl.T.Helper()
@@ -460,6 +588,21 @@ func (mr *MockAccountMockRecorder) ScopeExpensesByScopeCode(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScopeExpensesByScopeCode", reflect.TypeOf((*MockAccount)(nil).ScopeExpensesByScopeCode), arg0, arg1)
 }
 
+// ScopeUsers mocks base method
+func (m *MockAccount) ScopeUsers(arg0 context.Context, arg1 string) ([]*v1.AccountInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScopeUsers", arg0, arg1)
+	ret0, _ := ret[0].([]*v1.AccountInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScopeUsers indicates an expected call of ScopeUsers
+func (mr *MockAccountMockRecorder) ScopeUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScopeUsers", reflect.TypeOf((*MockAccount)(nil).ScopeUsers), arg0, arg1)
+}
+
 // SetScope mocks base method
 func (m *MockAccount) SetScope(arg0 context.Context, arg1 []*v1.Scope) error {
 	m.ctrl.T.Helper()
@@ -516,6 +659,20 @@ func (mr *MockAccountMockRecorder) UpdateGroup(arg0, arg1, arg2 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroup", reflect.TypeOf((*MockAccount)(nil).UpdateGroup), arg0, arg1, arg2)
 }
 
+// UpdateMFARecoveryCodes mocks base method
+func (m *MockAccount) UpdateMFARecoveryCodes(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMFARecoveryCodes", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMFARecoveryCodes indicates an expected call of UpdateMFARecoveryCodes
+func (mr *MockAccountMockRecorder) UpdateMFARecoveryCodes(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMFARecoveryCodes", reflect.TypeOf((*MockAccount)(nil).UpdateMFARecoveryCodes), arg0, arg1, arg2)
+}
+
 // UpdateUserAccount mocks base method
 func (m *MockAccount) UpdateUserAccount(arg0 context.Context, arg1 string, arg2 *v1.UpdateUserAccount) error {
 	m.ctrl.T.Helper()
@@ -544,6 +701,21 @@ func (mr *MockAccountMockRecorder) UpsertScopeExpenses(arg0, arg1, arg2, arg3, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertScopeExpenses", reflect.TypeOf((*MockAccount)(nil).UpsertScopeExpenses), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// UseMFAStep mocks base method
+func (m *MockAccount) UseMFAStep(arg0 context.Context, arg1 string, arg2 int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseMFAStep", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseMFAStep indicates an expected call of UseMFAStep
+func (mr *MockAccountMockRecorder) UseMFAStep(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseMFAStep", reflect.TypeOf((*MockAccount)(nil).UseMFAStep), arg0, arg1, arg2)
+}
+
 // UserBelongsToAdminGroup mocks base method
 func (m *MockAccount) UserBelongsToAdminGroup(arg0 context.Context, arg1, arg2 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -574,6 +746,21 @@ func (mr *MockAccountMockRecorder) UserExistsByID(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserExistsByID", reflect.TypeOf((*MockAccount)(nil).UserExistsByID), arg0, arg1)
 }
 
+// UserMFA mocks base method
+func (m *MockAccount) UserMFA(arg0 context.Context, arg1 string) (*v1.UserMFA, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserMFA", arg0, arg1)
+	ret0, _ := ret[0].(*v1.UserMFA)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserMFA indicates an expected call of UserMFA
+func (mr *MockAccountMockRecorder) UserMFA(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserMFA", reflect.TypeOf((*MockAccount)(nil).UserMFA), arg0, arg1)
+}
+
 // UserOwnedGroups mocks base method
 func (m *MockAccount) UserOwnedGroups(arg0 context.Context, arg1 string, arg2 *v1.GroupQueryParams) (int, []*v1.Group, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,15 @@
+package v1
+
+import "time"
+
+// APIKey represents a personal api key, the secret part of the key is only stored hashed
+type APIKey struct {
+	ID         string
+	Name       string
+	UserID     string
+	Role       Role
+	Scopes     []string
+	SecretHash string
+	CreatedOn  time.Time
+	ExpiresAt  time.Time
+}
@@ -0,0 +1,14 @@
+package v1
+
+import "time"
+
+// UserMFA represents the totp authenticator of a user, only hashes of recovery codes are stored
+type UserMFA struct {
+	UserID        string
+	Secret        string
+	Enabled       bool
+	RecoveryCodes []string
+	LastUsedStep  int64
+	CreatedOn     time.Time
+	EnabledOn     time.Time
+}
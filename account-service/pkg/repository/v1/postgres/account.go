@@ -170,6 +170,19 @@ const (
 	WHERE u.role = 'Admin'
   	AND g.scopes && $1
 	`
+	selectScopeUsers = `
+	SELECT u.username, u.role
+	FROM users u
+	WHERE u.account_status = 'Active'
+	AND ($1 = ANY(u.federated_scopes) OR EXISTS (
+		SELECT 1
+		FROM group_ownership go
+		JOIN groups g ON go.group_id = g.id
+		WHERE go.user_id = u.username
+		AND $1 = ANY(g.scopes)
+	))
+	ORDER BY u.username
+	`
 )
 
 // AccountRepository for Dgraph
@@ -228,7 +241,7 @@ func (r *AccountRepository) UpdateUserAccount(ctx context.Context, userID string
 	if n != 1 {
 		return fmt.Errorf("repo/postgres - UpdateUserAccount - expected one row to be affected,actual affected rows: %v", n)
 	}
-	return nil
+	return r.syncAPIKeyOwners(ctx, userID)
 }
 
 // AccountInfo implements v1.Account's AccountInfo function.
@@ -549,7 +562,7 @@ func (r *AccountRepository) DropScopeTX(ctx context.Context, reqScope string) er
 	if err != nil {
 		return err
 	}
-	return nil
+	return r.syncAPIKeyOwners(ctx)
 }
 
 // UsersAll implements Account AdminUserForScope function
@@ -569,3 +582,27 @@ func (r *AccountRepository) AdminUserForScope(ctx context.Context, scopes []stri
 	}
 	return users, nil
 }
+
+// ScopeUsers implements Account ScopeUsers function, the users have access to the scope through
+// the groups they own or the groups of their identity provider
+func (r *AccountRepository) ScopeUsers(ctx context.Context, scope string) ([]*v1.AccountInfo, error) {
+	rows, err := r.db.QueryContext(ctx, selectScopeUsers, scope)
+	if err != nil {
+		logger.Log.Error("repo/postgres - ScopeUsers - failed to execute query", zap.String("reason", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+	var users []*v1.AccountInfo
+	for rows.Next() {
+		user := &v1.AccountInfo{}
+		var userRole role
+		if err := rows.Scan(&user.UserID, &userRole); err != nil {
+			return nil, err
+		}
+		if user.Role, err = postgresRoleToDBRole(userRole); err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, rows.Err()
+}
@@ -0,0 +1,234 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/account-service/pkg/repository/v1"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+const (
+	insertAPIKeyQuery = `
+	INSERT INTO api_keys (id,name,username,role,scopes,secret_hash,created_on,expires_at)
+	VALUES($1,$2,$3,$4,$5,$6,$7,$8)`
+
+	selectAPIKeys = `
+	SELECT
+	id,
+	name,
+	username,
+	role,
+	scopes,
+	secret_hash,
+	created_on,
+	expires_at
+	FROM api_keys
+	`
+
+	selectUserAPIKeys = selectAPIKeys + "WHERE username = $1 ORDER BY created_on"
+
+	selectAPIKeyByID = selectAPIKeys + "WHERE id = $1"
+
+	selectUserAPIKeyIDs = "SELECT id FROM api_keys WHERE username = $1"
+
+	deleteAPIKeyQuery = "DELETE FROM api_keys WHERE id = $1"
+
+	selectAPIKeyOwners = "SELECT DISTINCT username FROM api_keys"
+
+	selectUsersAPIKeyOwners = selectAPIKeyOwners + " WHERE username = ANY($1)"
+)
+
+// CreateAPIKey implements Account CreateAPIKey function.
+func (r *AccountRepository) CreateAPIKey(ctx context.Context, key *v1.APIKey) error {
+	rKey, err := dbRoleToPostGresRole(key.Role)
+	if err != nil {
+		logger.Log.Error("repo/postgres - CreateAPIKey - dbRoleToPostGresRole", zap.String("reason", err.Error()))
+		return err
+	}
+	if _, err := r.db.ExecContext(ctx, insertAPIKeyQuery, key.ID, key.Name, key.UserID, rKey, pq.Array(key.Scopes), key.SecretHash, key.CreatedOn, key.ExpiresAt); err != nil {
+		logger.Log.Error("repo/postgres - CreateAPIKey - failed to execute query", zap.String("reason", err.Error()))
+		return err
+	}
+	err = r.syncAPIKeyOwners(ctx, key.UserID)
+	if err == nil {
+		err = apikey.NewStore(r.r).Save(ctx, &apikey.Key{
+			ID:         key.ID,
+			Name:       key.Name,
+			UserID:     key.UserID,
+			Role:       claims.Role(rKey),
+			Scopes:     key.Scopes,
+			SecretHash: key.SecretHash,
+			CreatedOn:  key.CreatedOn,
+			ExpiresAt:  key.ExpiresAt,
+		})
+	}
+	if err != nil {
+		logger.Log.Error("repo/postgres - CreateAPIKey - failed to save key in store", zap.String("reason", err.Error()))
+		// a key which is not in the store cannot be used, it must not be listed either
+		if _, errDel := r.db.ExecContext(ctx, deleteAPIKeyQuery, key.ID); errDel != nil {
+			logger.Log.Error("repo/postgres - CreateAPIKey - failed to delete key", zap.String("reason", errDel.Error()))
+		}
+		return err
+	}
+	return nil
+}
+
+// APIKeys implements Account APIKeys function.
+func (r *AccountRepository) APIKeys(ctx context.Context, userID string) ([]*v1.APIKey, error) {
+	rows, err := r.db.QueryContext(ctx, selectUserAPIKeys, userID)
+	if err != nil {
+		logger.Log.Error("repo/postgres - APIKeys - failed to execute query", zap.String("reason", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+	var keys []*v1.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			logger.Log.Error("repo/postgres - APIKeys - failed to scan row", zap.String("reason", err.Error()))
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+// APIKeyByID implements Account APIKeyByID function.
+func (r *AccountRepository) APIKeyByID(ctx context.Context, id string) (*v1.APIKey, error) {
+	key, err := scanAPIKey(r.db.QueryRowContext(ctx, selectAPIKeyByID, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, v1.ErrNoData
+		}
+		return nil, err
+	}
+	return key, nil
+}
+
+// DeleteAPIKey implements Account DeleteAPIKey function.
+func (r *AccountRepository) DeleteAPIKey(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, deleteAPIKeyQuery, id)
+	if err != nil {
+		logger.Log.Error("repo/postgres - DeleteAPIKey - failed to execute query", zap.String("reason", err.Error()))
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		logger.Log.Error("repo/postgres - DeleteAPIKey - failed to get number of rows affected", zap.String("reason", err.Error()))
+		return err
+	}
+	if n != 1 {
+		return fmt.Errorf("repo/postgres - DeleteAPIKey - expected one row to be affected,actual affected rows: %v", n)
+	}
+	return apikey.NewStore(r.r).Delete(ctx, id)
+}
+
+// DropUserAPIKeys implements Account DropUserAPIKeys function.
+func (r *AccountRepository) DropUserAPIKeys(ctx context.Context, userID string) error {
+	rows, err := r.db.QueryContext(ctx, selectUserAPIKeyIDs, userID)
+	if err != nil {
+		logger.Log.Error("repo/postgres - DropUserAPIKeys - failed to execute query", zap.String("reason", err.Error()))
+		return err
+	}
+	defer rows.Close()
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	store := apikey.NewStore(r.r)
+	if err := store.Delete(ctx, ids...); err != nil {
+		return err
+	}
+	return store.DeleteOwner(ctx, userID)
+}
+
+// syncAPIKeyOwners saves in the api key store the role and scopes the users owning api keys
+// currently have, so that keys never give more than their owner. All the owners are saved
+// when no user is given.
+func (r *AccountRepository) syncAPIKeyOwners(ctx context.Context, userIDs ...string) error {
+	var rows *sql.Rows
+	var err error
+	if len(userIDs) == 0 {
+		rows, err = r.db.QueryContext(ctx, selectAPIKeyOwners)
+	} else {
+		rows, err = r.db.QueryContext(ctx, selectUsersAPIKeyOwners, pq.Array(userIDs))
+	}
+	if err != nil {
+		logger.Log.Error("repo/postgres - syncAPIKeyOwners - failed to execute query", zap.String("reason", err.Error()))
+		return err
+	}
+	defer rows.Close()
+	var owners []string
+	for rows.Next() {
+		var owner string
+		if err := rows.Scan(&owner); err != nil {
+			return err
+		}
+		owners = append(owners, owner)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	store := apikey.NewStore(r.r)
+	for _, owner := range owners {
+		acc, err := r.AccountInfo(ctx, owner)
+		if err == v1.ErrNoData {
+			if err := store.DeleteOwner(ctx, owner); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			logger.Log.Error("repo/postgres - syncAPIKeyOwners - AccountInfo", zap.String("reason", err.Error()))
+			return err
+		}
+		groups, err := r.UserOwnedGroupsDirect(ctx, owner, nil)
+		if err != nil {
+			logger.Log.Error("repo/postgres - syncAPIKeyOwners - UserOwnedGroupsDirect", zap.String("reason", err.Error()))
+			return err
+		}
+		var scopes []string
+		for _, grp := range groups {
+			scopes = append(scopes, grp.Scopes...)
+		}
+		ownerRole, _ := claims.ReturnRole(acc.Role.RoleToRoleString())
+		if err := store.SaveOwner(ctx, owner, ownerRole, scopes); err != nil {
+			logger.Log.Error("repo/postgres - syncAPIKeyOwners - failed to save owner in store", zap.String("reason", err.Error()))
+			return err
+		}
+	}
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAPIKey(row scanner) (*v1.APIKey, error) {
+	key := &v1.APIKey{}
+	var rKey role
+	if err := row.Scan(&key.ID, &key.Name, &key.UserID, &rKey, pq.Array(&key.Scopes), &key.SecretHash, &key.CreatedOn, &key.ExpiresAt); err != nil {
+		return nil, err
+	}
+	roleDB, err := postgresRoleToDBRole(rKey)
+	if err != nil {
+		return nil, err
+	}
+	key.Role = roleDB
+	return key, nil
+}
@@ -20,6 +20,8 @@ var rc *redis.Client
 const (
 	serverPath = "../../../../cmd/server/"
 	dropQuery  = `
+	DROP TABLE IF EXISTS api_keys;
+	DROP TABLE IF EXISTS users_mfa;
 	DROP TABLE IF EXISTS group_ownership;
 	DROP TABLE IF EXISTS groups;
 	DROP TABLE IF EXISTS scopes;
@@ -46,7 +48,7 @@ func TestMain(m *testing.M) {
 		cleanup()
 		docker.Stop(dockers)
 	}()
-	files := []string{"scripts/1_user_login.sql", "schema/2_add_users_audit_table.sql"}
+	files := []string{"scripts/1_user_login.sql", "schema/2_add_users_audit_table.sql", "schema/4_add_api_keys.sql", "schema/5_add_users_mfa.sql"}
 	db, dockers, err = base.Testdata(serverPath, files)
 	if err != nil {
 		panic(err)
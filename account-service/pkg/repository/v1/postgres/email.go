@@ -8,6 +8,8 @@ import (
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/email"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/helper"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/redis"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/revocation"
 )
 
 func (r *AccountRepository) GenerateMailBody(acc helper.EmailParams, ctx context.Context, cfg config.Config) (string, error) {
@@ -17,3 +19,11 @@ func (r *AccountRepository) GenerateMailBody(acc helper.EmailParams, ctx context
 func (r *AccountRepository) SetToken(ep helper.EmailParams, ctx context.Context, ttl int) error {
 	return redis.SetToken(ep, ctx, r.r, ttl)
 }
+
+// RevokeUserTokens implements Account RevokeUserTokens function.
+func (r *AccountRepository) RevokeUserTokens(ctx context.Context, userID string) error {
+	if err := revocation.NewList(r.r).RevokeUser(ctx, userID); err != nil {
+		return err
+	}
+	return apikey.NewStore(r.r).RevokeOwnerKeys(ctx, userID)
+}
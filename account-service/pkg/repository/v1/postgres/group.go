@@ -200,7 +200,7 @@ func (r *AccountRepository) DeleteGroup(ctx context.Context, groupID int64) (ret
 	if n != 1 {
 		return fmt.Errorf("repo/postgres - DeleteGroup - expected one row to be affected,actual affected rows: %v", n)
 	}
-	return nil
+	return r.syncAPIKeyOwners(ctx)
 }
 
 // UpdateGroup implements Account UpdateGroup function
@@ -225,7 +225,10 @@ func (r *AccountRepository) UpdateGroup(ctx context.Context, groupID int64, upda
 		if error := txn.Commit(); error != nil {
 			logger.Log.Error(" UpdateGroup - failed to commit txn", zap.String("reason", error.Error()))
 			retErr = fmt.Errorf(" UpdateGroup - cannot commit txn")
+			return
 		}
+		// the scopes of the group may have changed
+		retErr = r.syncAPIKeyOwners(ctx)
 	}()
 	newFullyQualifiedName := strings.TrimSuffix(grpInfo.FullyQualifiedName, grpInfo.Name) + update.Name
 	result, err := r.db.ExecContext(ctx, updateGroupName, groupID, update.Name, newFullyQualifiedName, pq.Array(update.Scopes), update.GroupCompliance)
@@ -285,7 +288,7 @@ func (r *AccountRepository) AddGroupUsers(ctx context.Context, groupID int64, us
 	if n != int64(len(userIDs)) {
 		return fmt.Errorf("repo/postgres - AddGroupUsers - expected rows to be affected: %v , actual affected rows: %v", len(userIDs), n)
 	}
-	return nil
+	return r.syncAPIKeyOwners(ctx, userIDs...)
 }
 
 // DeleteGroupUsers implements Account DeleteGroupUsers function
@@ -306,7 +309,7 @@ func (r *AccountRepository) DeleteGroupUsers(ctx context.Context, groupID int64,
 	if n != int64(len(userIDs)) {
 		return fmt.Errorf("repo/postgres - DeleteGroupUsers - expected rows to be affected: %v , actual affected rows: %v", len(userIDs), n)
 	}
-	return nil
+	return r.syncAPIKeyOwners(ctx, userIDs...)
 }
 
 // IsGroupRoot implements Account IsGroupRoot function
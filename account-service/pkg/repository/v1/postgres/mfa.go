@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/account-service/pkg/repository/v1"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+const (
+	selectUserMFAQuery = `
+	SELECT
+	username,
+	secret,
+	enabled,
+	recovery_codes,
+	last_used_step,
+	created_on,
+	enabled_on
+	FROM users_mfa
+	WHERE username = $1`
+
+	// a new secret replaces the pending one, an enabled secret must be deleted first
+	saveMFASecretQuery = `
+	INSERT INTO users_mfa (username,secret,created_on)
+	VALUES($1,$2,$3)
+	ON CONFLICT (username) DO UPDATE SET secret = EXCLUDED.secret, created_on = EXCLUDED.created_on
+	WHERE users_mfa.enabled = FALSE`
+
+	enableMFAQuery = `
+	UPDATE users_mfa SET enabled = TRUE, enabled_on = $2, last_used_step = $3, recovery_codes = $4
+	WHERE username = $1 AND enabled = FALSE`
+
+	updateMFARecoveryCodesQuery = "UPDATE users_mfa SET recovery_codes = $2 WHERE username = $1 AND enabled = TRUE"
+
+	useMFAStepQuery = "UPDATE users_mfa SET last_used_step = $2 WHERE username = $1 AND enabled = TRUE AND last_used_step < $2"
+
+	deleteMFAQuery = "DELETE FROM users_mfa WHERE username = $1"
+)
+
+// UserMFA implements Account UserMFA function.
+func (r *AccountRepository) UserMFA(ctx context.Context, userID string) (*v1.UserMFA, error) {
+	m := &v1.UserMFA{}
+	var enabledOn sql.NullTime
+	err := r.db.QueryRowContext(ctx, selectUserMFAQuery, userID).Scan(&m.UserID, &m.Secret, &m.Enabled, pq.Array(&m.RecoveryCodes), &m.LastUsedStep, &m.CreatedOn, &enabledOn)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, v1.ErrNoData
+		}
+		logger.Log.Error("repo/postgres - UserMFA - failed to execute query", zap.String("reason", err.Error()))
+		return nil, err
+	}
+	m.EnabledOn = enabledOn.Time
+	return m, nil
+}
+
+// SaveMFASecret implements Account SaveMFASecret function.
+func (r *AccountRepository) SaveMFASecret(ctx context.Context, userID, secret string) error {
+	result, err := r.db.ExecContext(ctx, saveMFASecretQuery, userID, secret, time.Now().UTC())
+	if err != nil {
+		logger.Log.Error("repo/postgres - SaveMFASecret - failed to execute query", zap.String("reason", err.Error()))
+		return err
+	}
+	return expectOneRow(result, "SaveMFASecret")
+}
+
+// EnableMFA implements Account EnableMFA function.
+func (r *AccountRepository) EnableMFA(ctx context.Context, userID string, lastUsedStep int64, recoveryCodes []string) error {
+	result, err := r.db.ExecContext(ctx, enableMFAQuery, userID, time.Now().UTC(), lastUsedStep, pq.Array(recoveryCodes))
+	if err != nil {
+		logger.Log.Error("repo/postgres - EnableMFA - failed to execute query", zap.String("reason", err.Error()))
+		return err
+	}
+	return expectOneRow(result, "EnableMFA")
+}
+
+// UpdateMFARecoveryCodes implements Account UpdateMFARecoveryCodes function.
+func (r *AccountRepository) UpdateMFARecoveryCodes(ctx context.Context, userID string, recoveryCodes []string) error {
+	result, err := r.db.ExecContext(ctx, updateMFARecoveryCodesQuery, userID, pq.Array(recoveryCodes))
+	if err != nil {
+		logger.Log.Error("repo/postgres - UpdateMFARecoveryCodes - failed to execute query", zap.String("reason", err.Error()))
+		return err
+	}
+	return expectOneRow(result, "UpdateMFARecoveryCodes")
+}
+
+// UseMFAStep implements Account UseMFAStep function.
+func (r *AccountRepository) UseMFAStep(ctx context.Context, userID string, step int64) (bool, error) {
+	result, err := r.db.ExecContext(ctx, useMFAStepQuery, userID, step)
+	if err != nil {
+		logger.Log.Error("repo/postgres - UseMFAStep - failed to execute query", zap.String("reason", err.Error()))
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		logger.Log.Error("repo/postgres - UseMFAStep - failed to get number of rows affected", zap.String("reason", err.Error()))
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// DeleteMFA implements Account DeleteMFA function.
+func (r *AccountRepository) DeleteMFA(ctx context.Context, userID string) error {
+	if _, err := r.db.ExecContext(ctx, deleteMFAQuery, userID); err != nil {
+		logger.Log.Error("repo/postgres - DeleteMFA - failed to execute query", zap.String("reason", err.Error()))
+		return err
+	}
+	return nil
+}
+
+func expectOneRow(result sql.Result, fn string) error {
+	n, err := result.RowsAffected()
+	if err != nil {
+		logger.Log.Error("repo/postgres - "+fn+" - failed to get number of rows affected", zap.String("reason", err.Error()))
+		return err
+	}
+	if n != 1 {
+		return fmt.Errorf("repo/postgres - %s - expected one row to be affected,actual affected rows: %v", fn, n)
+	}
+	return nil
+}
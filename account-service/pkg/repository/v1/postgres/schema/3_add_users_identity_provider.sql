@@ -0,0 +1,12 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- identity_provider is set for accounts provisioned by a federated login,
+-- local accounts keep it NULL.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS identity_provider VARCHAR;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS federated_scopes TEXT[] NOT NULL DEFAULT '{}';
+
+-- +migrate Down
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE users DROP COLUMN IF EXISTS federated_scopes;
+ALTER TABLE users DROP COLUMN IF EXISTS identity_provider;
@@ -0,0 +1,21 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- api_keys are the personal api keys of users and service accounts,
+-- only the hash of the secret part of a key is stored.
+CREATE TABLE IF NOT EXISTS api_keys (
+  id VARCHAR NOT NULL,
+  name VARCHAR NOT NULL,
+  username VARCHAR NOT NULL REFERENCES users (username) ON DELETE CASCADE,
+  role VARCHAR NOT NULL REFERENCES roles (user_role),
+  scopes TEXT[] NOT NULL DEFAULT '{}',
+  secret_hash VARCHAR NOT NULL,
+  created_on TIMESTAMP NOT NULL DEFAULT NOW(),
+  expires_at TIMESTAMP NOT NULL,
+  PRIMARY KEY(id),
+  UNIQUE(username, name)
+);
+
+-- +migrate Down
+-- SQL in section 'Up' is executed when this migration is applied
+DROP TABLE IF EXISTS api_keys;
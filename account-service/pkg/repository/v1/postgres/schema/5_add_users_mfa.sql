@@ -0,0 +1,20 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+-- users_mfa holds the totp authenticator of users, a secret is only used to login
+-- once it is enabled. Only the hashes of recovery codes are stored.
+CREATE TABLE IF NOT EXISTS users_mfa (
+  username VARCHAR NOT NULL REFERENCES users (username) ON DELETE CASCADE,
+  secret VARCHAR NOT NULL,
+  enabled BOOLEAN NOT NULL DEFAULT FALSE,
+  recovery_codes TEXT[] NOT NULL DEFAULT '{}',
+  -- last_used_step is the time step of the last accepted code, a code cannot be used twice
+  last_used_step BIGINT NOT NULL DEFAULT 0,
+  created_on TIMESTAMP NOT NULL DEFAULT NOW(),
+  enabled_on TIMESTAMP,
+  PRIMARY KEY(username)
+);
+
+-- +migrate Down
+-- SQL in section 'Up' is executed when this migration is applied
+DROP TABLE IF EXISTS users_mfa;
@@ -335,6 +335,16 @@ func (s *accountServiceServer) DeleteAccount(ctx context.Context, req *v1.Delete
 		logger.Log.Error("service/v1 - DeleteAccount - InsertUserAudit", zap.Error(err))
 		return &v1.DeleteAccountResponse{Success: false}, status.Error(codes.Internal, "DBError")
 	}
+	// Tokens already issued to the user must not be usable anymore
+	if err := s.accountRepo.RevokeUserTokens(ctx, req.UserId); err != nil {
+		logger.Log.Error("service/v1 - DeleteAccount - RevokeUserTokens", zap.Error(err))
+		return &v1.DeleteAccountResponse{Success: false}, status.Error(codes.Internal, "failed to revoke user tokens")
+	}
+	// api keys are deleted with the user, they must not stay usable in the api key store
+	if err := s.accountRepo.DropUserAPIKeys(ctx, req.UserId); err != nil {
+		logger.Log.Error("service/v1 - DeleteAccount - DropUserAPIKeys", zap.Error(err))
+		return &v1.DeleteAccountResponse{Success: false}, status.Error(codes.Internal, "failed to revoke user api keys")
+	}
 	if err := s.accountRepo.DeleteUser(ctx, req.UserId); err != nil {
 		logger.Log.Error("service/v1 - DeleteAccount - DeleteUser", zap.Error(err))
 		return &v1.DeleteAccountResponse{Success: false}, status.Error(codes.Internal, "DBError")
@@ -815,6 +825,11 @@ func (s *accountServiceServer) ChangePassword(ctx context.Context, req *v1.Chang
 		logger.Log.Error("service -CheckPassword - GenerateFromPassword", zap.Error(err))
 		return nil, status.Error(codes.Internal, "unknown error")
 	}
+	// Tokens issued with the old password must not be usable anymore
+	if err := s.accountRepo.RevokeUserTokens(ctx, userClaims.UserID); err != nil {
+		logger.Log.Error("service/v1 - ChangePassword - RevokeUserTokens", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to revoke user tokens")
+	}
 	if err := s.accountRepo.ChangePassword(ctx, userClaims.UserID, string(newPass)); err != nil {
 		logger.Log.Error("service/v1 - ChangePassword - ChangePassword", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to change password")
@@ -850,6 +865,31 @@ func (s *accountServiceServer) GetAdminUserScope(ctx context.Context, req *v1.Ge
 	return &response, nil
 }
 
+// ListScopeUsers lists the active users having access to the scope with their current role,
+// services check with it that the users they act for still have access to the scope.
+func (s *accountServiceServer) ListScopeUsers(ctx context.Context, req *v1.ListScopeUsersRequest) (*v1.ListScopeUsersResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "cannot find claims in context")
+	}
+	if userClaims.Role != claims.RoleAdmin && userClaims.Role != claims.RoleSuperAdmin {
+		return nil, status.Error(codes.PermissionDenied, "only admin users can list the users of a scope")
+	}
+	if !helper.Contains(userClaims.Socpes, req.GetScope()) {
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	users, err := s.accountRepo.ScopeUsers(ctx, req.GetScope())
+	if err != nil {
+		logger.Log.Error("service/v1 - ListScopeUsers - ScopeUsers", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get the users of the scope")
+	}
+	resp := &v1.ListScopeUsersResponse{Users: make([]*v1.ScopeUser, len(users))}
+	for i, u := range users {
+		resp.Users[i] = &v1.ScopeUser{UserId: u.UserID, Role: v1.ROLE(u.Role)}
+	}
+	return resp, nil
+}
+
 func groupExists(groupID int64, groups []*repo.Group) bool {
 	for _, group := range groups {
 		if group.ID == groupID {
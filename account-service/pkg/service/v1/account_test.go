@@ -40,6 +40,8 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func Test_DeleteResourceByScope(t *testing.T) {
@@ -797,6 +799,8 @@ func Test_accountServiceServer_DeleteAccount(t *testing.T) {
 					Operation:       db.AuditStatusDELETED,
 					UpdatedBy:       "admin@test.com",
 				}).Times(1).Return(nil)
+				mockRepo.EXPECT().RevokeUserTokens(gomock.Any(), "admin1@test.com").Times(1).Return(nil)
+				mockRepo.EXPECT().DropUserAPIKeys(gomock.Any(), "admin1@test.com").Times(1).Return(nil)
 				mockRepo.EXPECT().DeleteUser(grpc_middleware.AddClaims(context.Background(), &claims.Claims{
 					UserID: "admin@test.com",
 					Role:   "SuperAdmin",
@@ -836,6 +840,8 @@ func Test_accountServiceServer_DeleteAccount(t *testing.T) {
 					Operation:       db.AuditStatusDELETED,
 					UpdatedBy:       "admin@test.com",
 				}).Times(1).Return(nil)
+				mockRepo.EXPECT().RevokeUserTokens(gomock.Any(), "admin1@test.com").Times(1).Return(nil)
+				mockRepo.EXPECT().DropUserAPIKeys(gomock.Any(), "admin1@test.com").Times(1).Return(nil)
 				mockRepo.EXPECT().DeleteUser(ctx, "admin1@test.com").Times(1).Return(nil)
 			},
 			want: &v1.DeleteAccountResponse{
@@ -1025,6 +1031,8 @@ func Test_accountServiceServer_DeleteAccount(t *testing.T) {
 					Operation:       db.AuditStatusDELETED,
 					UpdatedBy:       "admin@test.com",
 				}).Times(1).Return(nil)
+				mockRepo.EXPECT().RevokeUserTokens(gomock.Any(), "admin1@test.com").Times(1).Return(nil)
+				mockRepo.EXPECT().DropUserAPIKeys(gomock.Any(), "admin1@test.com").Times(1).Return(nil)
 				mockRepo.EXPECT().DeleteUser(grpc_middleware.AddClaims(context.Background(), &claims.Claims{
 					UserID: "admin@test.com",
 					Role:   "SuperAdmin",
@@ -3148,6 +3156,7 @@ func Test_accountServiceServer_ChangePassword(t *testing.T) {
 					FirstLogin: true,
 					Password:   abcHash,
 				}, nil).Times(1)
+				mockRepo.EXPECT().RevokeUserTokens(ctx, "admin@superuser.com").Return(nil).Times(1)
 				mockRepo.EXPECT().ChangePassword(ctx, "admin@superuser.com", gomock.Any()).Return(nil).Times(1)
 				mockRepo.EXPECT().ChangeUserFirstLogin(ctx, "admin@superuser.com").Times(1).Return(nil)
 			},
@@ -3171,6 +3180,7 @@ func Test_accountServiceServer_ChangePassword(t *testing.T) {
 					FirstLogin: false,
 					Password:   abcHash,
 				}, nil).Times(1)
+				mockRepo.EXPECT().RevokeUserTokens(ctx, "admin@superuser.com").Return(nil).Times(1)
 				mockRepo.EXPECT().ChangePassword(ctx, "admin@superuser.com", gomock.Any()).Return(nil).Times(1)
 			},
 			want: &v1.ChangePasswordResponse{
@@ -3346,6 +3356,7 @@ func Test_accountServiceServer_ChangePassword(t *testing.T) {
 				mockRepo.EXPECT().AccountInfo(ctx, "admin@superuser.com").Return(&repv1.AccountInfo{
 					Password: abcHash,
 				}, nil).Times(1)
+				mockRepo.EXPECT().RevokeUserTokens(ctx, "admin@superuser.com").Return(nil).Times(1)
 				mockRepo.EXPECT().ChangePassword(ctx, "admin@superuser.com", gomock.Any()).Return(errors.New("failed to change password")).Times(1)
 			},
 			wantErr: true,
@@ -3366,6 +3377,7 @@ func Test_accountServiceServer_ChangePassword(t *testing.T) {
 					FirstLogin: true,
 					Password:   abcHash,
 				}, nil).Times(1)
+				mockRepo.EXPECT().RevokeUserTokens(ctx, "admin@superuser.com").Return(nil).Times(1)
 				mockRepo.EXPECT().ChangePassword(ctx, "admin@superuser.com", gomock.Any()).Return(nil).Times(1)
 				mockRepo.EXPECT().ChangeUserFirstLogin(ctx, "admin@superuser.com").Times(1).Return(errors.New("Internal"))
 			},
@@ -3457,6 +3469,78 @@ func Test_accountServiceServer_GetAdminUserScope(t *testing.T) {
 	}
 }
 
+func Test_accountServiceServer_ListScopeUsers(t *testing.T) {
+	admin := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   claims.RoleAdmin,
+		Socpes: []string{"Scope1", "Scope2"},
+	})
+	user := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "user@test.com",
+		Role:   claims.RoleUser,
+		Socpes: []string{"Scope1"},
+	})
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		scope   string
+		setup   func(rep *mock.MockAccount)
+		want    *v1.ListScopeUsersResponse
+		wantErr codes.Code
+	}{
+		{name: "SUCCESS",
+			ctx:   admin,
+			scope: "Scope1",
+			setup: func(rep *mock.MockAccount) {
+				rep.EXPECT().ScopeUsers(admin, "Scope1").Return([]*repv1.AccountInfo{
+					{UserID: "admin@test.com", Role: repv1.RoleAdmin},
+					{UserID: "user@test.com", Role: repv1.RoleUser},
+				}, nil).Times(1)
+			},
+			want: &v1.ListScopeUsersResponse{Users: []*v1.ScopeUser{
+				{UserId: "admin@test.com", Role: v1.ROLE_ADMIN},
+				{UserId: "user@test.com", Role: v1.ROLE_USER},
+			}},
+		},
+		{name: "FAILURE - scope of another admin",
+			ctx:     admin,
+			scope:   "Scope3",
+			setup:   func(rep *mock.MockAccount) {},
+			wantErr: codes.PermissionDenied,
+		},
+		{name: "FAILURE - user",
+			ctx:     user,
+			scope:   "Scope1",
+			setup:   func(rep *mock.MockAccount) {},
+			wantErr: codes.PermissionDenied,
+		},
+		{name: "FAILURE - db error",
+			ctx:   admin,
+			scope: "Scope1",
+			setup: func(rep *mock.MockAccount) {
+				rep.EXPECT().ScopeUsers(admin, "Scope1").Return(nil, errors.New("test error")).Times(1)
+			},
+			wantErr: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			rep := mock.NewMockAccount(mockCtrl)
+			tt.setup(rep)
+			s := &accountServiceServer{accountRepo: rep}
+			got, err := s.ListScopeUsers(tt.ctx, &v1.ListScopeUsersRequest{Scope: tt.scope})
+			if status.Code(err) != tt.wantErr {
+				t.Fatalf("accountServiceServer.ListScopeUsers() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("accountServiceServer.ListScopeUsers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func compareUsers(t *testing.T, name string, exp *v1.ListUsersResponse, act *v1.ListUsersResponse) {
 	if exp == nil && act == nil {
 		return
@@ -0,0 +1,179 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/account-service/pkg/api/v1"
+	repo "gitlab.tech.orange/optisam/optisam-it/optisam-services/account-service/pkg/repository/v1"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+
+	pTypes "github.com/golang/protobuf/ptypes"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxAPIKeyValidity is the longest time an api key can be used
+const maxAPIKeyValidity = 365 * 24 * time.Hour
+
+// CreateAPIKey implements AccountService CreateAPIKey function
+func (s *accountServiceServer) CreateAPIKey(ctx context.Context, req *v1.CreateAPIKeyRequest) (*v1.CreateAPIKeyResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "cannot find claims in context")
+	}
+	// a leaked key must not be able to renew itself
+	if apikey.IsKeyClaims(userClaims) {
+		return nil, status.Error(codes.PermissionDenied, "api keys cannot create api keys")
+	}
+	ownerID := userClaims.UserID
+	if req.UserId != "" && req.UserId != userClaims.UserID {
+		if userClaims.Role != claims.RoleSuperAdmin {
+			return nil, status.Error(codes.PermissionDenied, "only superadmin user can create api keys for other users")
+		}
+		ownerID = req.UserId
+	}
+	owner, err := s.accountRepo.AccountInfo(ctx, ownerID)
+	if err != nil {
+		if err == repo.ErrNoData {
+			return nil, status.Error(codes.NotFound, "user does not exist")
+		}
+		logger.Log.Error("service/v1 - CreateAPIKey - AccountInfo", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get Account info")
+	}
+	ownerRole, _ := claims.ReturnRole(owner.Role.RoleToRoleString())
+	keyRole := owner.Role
+	if req.Role != v1.ROLE_UNDEFINED {
+		role, ok := claims.ReturnRole(repo.Role(req.Role).RoleToRoleString())
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "undefined role")
+		}
+		if role.Rank() > ownerRole.Rank() {
+			return nil, status.Error(codes.PermissionDenied, "api key role cannot be higher than the role of the user")
+		}
+		keyRole = repo.Role(req.Role)
+	}
+	groups, err := s.accountRepo.UserOwnedGroupsDirect(ctx, ownerID, nil)
+	if err != nil {
+		logger.Log.Error("service/v1 - CreateAPIKey - UserOwnedGroupsDirect", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get user groups")
+	}
+	var ownerScopes []string
+	for _, grp := range groups {
+		ownerScopes = append(ownerScopes, grp.Scopes...)
+	}
+	if len(req.Scopes) == 0 || !ifSubset(req.Scopes, ownerScopes) {
+		return nil, status.Error(codes.PermissionDenied, "api key scopes must be owned by the user")
+	}
+	expiresAt, err := pTypes.Timestamp(req.ExpiresAt)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid expiry")
+	}
+	now := time.Now().UTC()
+	if !expiresAt.After(now) || expiresAt.Sub(now) > maxAPIKeyValidity {
+		return nil, status.Error(codes.InvalidArgument, "api key must expire in the future and within a year")
+	}
+	keys, err := s.accountRepo.APIKeys(ctx, ownerID)
+	if err != nil {
+		logger.Log.Error("service/v1 - CreateAPIKey - APIKeys", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get api keys")
+	}
+	for _, k := range keys {
+		if k.Name == req.Name {
+			return nil, status.Error(codes.AlreadyExists, "api key name already exists")
+		}
+	}
+	id, key, secretHash, err := apikey.Generate()
+	if err != nil {
+		logger.Log.Error("service/v1 - CreateAPIKey - Generate", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to generate api key")
+	}
+	k := &repo.APIKey{
+		ID:         id,
+		Name:       req.Name,
+		UserID:     ownerID,
+		Role:       keyRole,
+		Scopes:     req.Scopes,
+		SecretHash: secretHash,
+		CreatedOn:  now,
+		ExpiresAt:  expiresAt,
+	}
+	if err := s.accountRepo.CreateAPIKey(ctx, k); err != nil {
+		logger.Log.Error("service/v1 - CreateAPIKey - CreateAPIKey", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to create api key")
+	}
+	return &v1.CreateAPIKeyResponse{
+		ApiKey: convertRepoAPIKeyToSrvAPIKey(k),
+		Key:    key,
+	}, nil
+}
+
+// ListAPIKeys implements AccountService ListAPIKeys function
+func (s *accountServiceServer) ListAPIKeys(ctx context.Context, req *v1.ListAPIKeysRequest) (*v1.ListAPIKeysResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "cannot find claims in context")
+	}
+	ownerID := userClaims.UserID
+	if req.UserId != "" && req.UserId != userClaims.UserID {
+		if userClaims.Role != claims.RoleSuperAdmin {
+			return nil, status.Error(codes.PermissionDenied, "only superadmin user can list api keys of other users")
+		}
+		ownerID = req.UserId
+	}
+	keys, err := s.accountRepo.APIKeys(ctx, ownerID)
+	if err != nil {
+		logger.Log.Error("service/v1 - ListAPIKeys - APIKeys", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get api keys")
+	}
+	apiKeys := make([]*v1.APIKey, len(keys))
+	for i := range keys {
+		apiKeys[i] = convertRepoAPIKeyToSrvAPIKey(keys[i])
+	}
+	return &v1.ListAPIKeysResponse{ApiKeys: apiKeys}, nil
+}
+
+// RevokeAPIKey implements AccountService RevokeAPIKey function
+func (s *accountServiceServer) RevokeAPIKey(ctx context.Context, req *v1.RevokeAPIKeyRequest) (*v1.RevokeAPIKeyResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "cannot find claims in context")
+	}
+	key, err := s.accountRepo.APIKeyByID(ctx, req.Id)
+	if err != nil {
+		if err == repo.ErrNoData {
+			return nil, status.Error(codes.NotFound, "api key does not exist")
+		}
+		logger.Log.Error("service/v1 - RevokeAPIKey - APIKeyByID", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get api key")
+	}
+	if key.UserID != userClaims.UserID && userClaims.Role != claims.RoleSuperAdmin {
+		// keys of other users are not disclosed
+		return nil, status.Error(codes.NotFound, "api key does not exist")
+	}
+	if err := s.accountRepo.DeleteAPIKey(ctx, req.Id); err != nil {
+		logger.Log.Error("service/v1 - RevokeAPIKey - DeleteAPIKey", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to revoke api key")
+	}
+	return &v1.RevokeAPIKeyResponse{Success: true}, nil
+}
+
+func convertRepoAPIKeyToSrvAPIKey(key *repo.APIKey) *v1.APIKey {
+	createdOn, _ := pTypes.TimestampProto(key.CreatedOn)
+	expiresAt, _ := pTypes.TimestampProto(key.ExpiresAt)
+	return &v1.APIKey{
+		Id:        key.ID,
+		Name:      key.Name,
+		UserId:    key.UserID,
+		Role:      v1.ROLE(key.Role),
+		Scopes:    key.Scopes,
+		CreatedOn: createdOn,
+		ExpiresAt: expiresAt,
+	}
+}
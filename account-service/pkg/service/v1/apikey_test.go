@@ -0,0 +1,440 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/account-service/pkg/api/v1"
+	repv1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/account-service/pkg/repository/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/account-service/pkg/repository/v1/mock"
+
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+
+	"github.com/golang/mock/gomock"
+	pTypes "github.com/golang/protobuf/ptypes"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_accountServiceServer_CreateAPIKey(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@test.com",
+		Role:   "Admin",
+		Socpes: []string{"AAA", "BBB"},
+	})
+	superAdminCtx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "superadmin@test.com",
+		Role:   "SuperAdmin",
+	})
+	expiresAt, _ := pTypes.TimestampProto(time.Now().Add(30 * 24 * time.Hour))
+	var mockCtrl *gomock.Controller
+	var rep repv1.Account
+	var created *repv1.APIKey
+	ownerInfo := func(mockRepo *mock.MockAccount, userID string, role repv1.Role) {
+		mockRepo.EXPECT().AccountInfo(gomock.Any(), userID).Times(1).Return(&repv1.AccountInfo{
+			UserID: userID,
+			Role:   role,
+		}, nil)
+	}
+	ownerGroups := func(mockRepo *mock.MockAccount, userID string) {
+		mockRepo.EXPECT().UserOwnedGroupsDirect(gomock.Any(), userID, nil).Times(1).Return([]*repv1.Group{
+			{ID: 1, Scopes: []string{"AAA"}},
+			{ID: 2, Scopes: []string{"BBB"}},
+		}, nil)
+	}
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		req      *v1.CreateAPIKeyRequest
+		setup    func()
+		wantKey  *v1.APIKey
+		wantCode codes.Code
+	}{
+		{name: "SUCCESS - own key with lower role",
+			ctx: ctx,
+			req: &v1.CreateAPIKeyRequest{
+				Name:      "ci",
+				Role:      v1.ROLE_USER,
+				Scopes:    []string{"AAA", "BBB"},
+				ExpiresAt: expiresAt,
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				ownerInfo(mockRepo, "admin@test.com", repv1.RoleAdmin)
+				ownerGroups(mockRepo, "admin@test.com")
+				mockRepo.EXPECT().APIKeys(gomock.Any(), "admin@test.com").Times(1).Return([]*repv1.APIKey{{Name: "other"}}, nil)
+				mockRepo.EXPECT().CreateAPIKey(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(_ context.Context, k *repv1.APIKey) error {
+					created = k
+					return nil
+				})
+			},
+			wantKey: &v1.APIKey{
+				Name:   "ci",
+				UserId: "admin@test.com",
+				Role:   v1.ROLE_USER,
+				Scopes: []string{"AAA", "BBB"},
+			},
+		},
+		{name: "SUCCESS - superadmin creates key for service account with its role",
+			ctx: superAdminCtx,
+			req: &v1.CreateAPIKeyRequest{
+				Name:      "dps",
+				UserId:    "dps@test.com",
+				Scopes:    []string{"AAA"},
+				ExpiresAt: expiresAt,
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				ownerInfo(mockRepo, "dps@test.com", repv1.RoleSuperAdmin)
+				ownerGroups(mockRepo, "dps@test.com")
+				mockRepo.EXPECT().APIKeys(gomock.Any(), "dps@test.com").Times(1).Return(nil, nil)
+				mockRepo.EXPECT().CreateAPIKey(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(_ context.Context, k *repv1.APIKey) error {
+					created = k
+					return nil
+				})
+			},
+			wantKey: &v1.APIKey{
+				Name:   "dps",
+				UserId: "dps@test.com",
+				Role:   v1.ROLE_SUPER_ADMIN,
+				Scopes: []string{"AAA"},
+			},
+		},
+		{name: "FAILURE - claims of an api key",
+			ctx: grpc_middleware.AddClaims(context.Background(), (&apikey.Key{
+				UserID:    "admin@test.com",
+				Role:      claims.RoleAdmin,
+				ExpiresAt: time.Now().Add(time.Hour),
+			}).Claims()),
+			req: &v1.CreateAPIKeyRequest{
+				Name:      "ci",
+				Scopes:    []string{"AAA"},
+				ExpiresAt: expiresAt,
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = mock.NewMockAccount(mockCtrl)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{name: "FAILURE - admin creates key for other user",
+			ctx: ctx,
+			req: &v1.CreateAPIKeyRequest{
+				Name:      "ci",
+				UserId:    "user@test.com",
+				Scopes:    []string{"AAA"},
+				ExpiresAt: expiresAt,
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = mock.NewMockAccount(mockCtrl)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{name: "FAILURE - user does not exist",
+			ctx: superAdminCtx,
+			req: &v1.CreateAPIKeyRequest{
+				Name:      "ci",
+				UserId:    "user@test.com",
+				Scopes:    []string{"AAA"},
+				ExpiresAt: expiresAt,
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				mockRepo.EXPECT().AccountInfo(gomock.Any(), "user@test.com").Times(1).Return(nil, repv1.ErrNoData)
+			},
+			wantCode: codes.NotFound,
+		},
+		{name: "FAILURE - role higher than the role of the user",
+			ctx: ctx,
+			req: &v1.CreateAPIKeyRequest{
+				Name:      "ci",
+				Role:      v1.ROLE_SUPER_ADMIN,
+				Scopes:    []string{"AAA"},
+				ExpiresAt: expiresAt,
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				ownerInfo(mockRepo, "admin@test.com", repv1.RoleAdmin)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{name: "FAILURE - scope not owned by user",
+			ctx: ctx,
+			req: &v1.CreateAPIKeyRequest{
+				Name:      "ci",
+				Scopes:    []string{"AAA", "CCC"},
+				ExpiresAt: expiresAt,
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				ownerInfo(mockRepo, "admin@test.com", repv1.RoleAdmin)
+				ownerGroups(mockRepo, "admin@test.com")
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{name: "FAILURE - expiry after a year",
+			ctx: ctx,
+			req: &v1.CreateAPIKeyRequest{
+				Name:      "ci",
+				Scopes:    []string{"AAA"},
+				ExpiresAt: &tspb.Timestamp{Seconds: time.Now().Add(400 * 24 * time.Hour).Unix()},
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				ownerInfo(mockRepo, "admin@test.com", repv1.RoleAdmin)
+				ownerGroups(mockRepo, "admin@test.com")
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{name: "FAILURE - expired",
+			ctx: ctx,
+			req: &v1.CreateAPIKeyRequest{
+				Name:      "ci",
+				Scopes:    []string{"AAA"},
+				ExpiresAt: &tspb.Timestamp{Seconds: time.Now().Add(-time.Minute).Unix()},
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				ownerInfo(mockRepo, "admin@test.com", repv1.RoleAdmin)
+				ownerGroups(mockRepo, "admin@test.com")
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{name: "FAILURE - name already exists",
+			ctx: ctx,
+			req: &v1.CreateAPIKeyRequest{
+				Name:      "ci",
+				Scopes:    []string{"AAA"},
+				ExpiresAt: expiresAt,
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				ownerInfo(mockRepo, "admin@test.com", repv1.RoleAdmin)
+				ownerGroups(mockRepo, "admin@test.com")
+				mockRepo.EXPECT().APIKeys(gomock.Any(), "admin@test.com").Times(1).Return([]*repv1.APIKey{{Name: "ci"}}, nil)
+			},
+			wantCode: codes.AlreadyExists,
+		},
+		{name: "FAILURE - CreateAPIKey error",
+			ctx: ctx,
+			req: &v1.CreateAPIKeyRequest{
+				Name:      "ci",
+				Scopes:    []string{"AAA"},
+				ExpiresAt: expiresAt,
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				ownerInfo(mockRepo, "admin@test.com", repv1.RoleAdmin)
+				ownerGroups(mockRepo, "admin@test.com")
+				mockRepo.EXPECT().APIKeys(gomock.Any(), "admin@test.com").Times(1).Return(nil, nil)
+				mockRepo.EXPECT().CreateAPIKey(gomock.Any(), gomock.Any()).Times(1).Return(errors.New("DBError"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			created = nil
+			s := &accountServiceServer{
+				accountRepo: rep,
+			}
+			got, err := s.CreateAPIKey(tt.ctx, tt.req)
+			if tt.wantKey == nil {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			if !assert.Empty(t, err) || !assert.NotNil(t, created) {
+				return
+			}
+			assert.True(t, strings.HasPrefix(got.Key, apikey.Prefix+created.ID+"."))
+			id, secret, err := apikey.Parse(got.Key)
+			if assert.Empty(t, err) {
+				assert.Equal(t, created.ID, id)
+				assert.Equal(t, created.SecretHash, apikey.Hash(secret))
+			}
+			tt.wantKey.Id = created.ID
+			tt.wantKey.CreatedOn, _ = pTypes.TimestampProto(created.CreatedOn)
+			tt.wantKey.ExpiresAt = tt.req.ExpiresAt
+			assert.Equal(t, tt.wantKey, got.ApiKey)
+		})
+	}
+}
+
+func Test_accountServiceServer_ListAPIKeys(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@test.com",
+		Role:   "Admin",
+	})
+	createdOn := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiresAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	var mockCtrl *gomock.Controller
+	var rep repv1.Account
+	tests := []struct {
+		name    string
+		req     *v1.ListAPIKeysRequest
+		setup   func()
+		want    *v1.ListAPIKeysResponse
+		wantErr bool
+	}{
+		{name: "SUCCESS",
+			req: &v1.ListAPIKeysRequest{},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				mockRepo.EXPECT().APIKeys(ctx, "admin@test.com").Times(1).Return([]*repv1.APIKey{
+					{
+						ID:         "1",
+						Name:       "ci",
+						UserID:     "admin@test.com",
+						Role:       repv1.RoleUser,
+						Scopes:     []string{"AAA"},
+						SecretHash: "hash",
+						CreatedOn:  createdOn,
+						ExpiresAt:  expiresAt,
+					},
+				}, nil)
+			},
+			want: &v1.ListAPIKeysResponse{
+				ApiKeys: []*v1.APIKey{
+					{
+						Id:        "1",
+						Name:      "ci",
+						UserId:    "admin@test.com",
+						Role:      v1.ROLE_USER,
+						Scopes:    []string{"AAA"},
+						CreatedOn: &tspb.Timestamp{Seconds: createdOn.Unix()},
+						ExpiresAt: &tspb.Timestamp{Seconds: expiresAt.Unix()},
+					},
+				},
+			},
+		},
+		{name: "FAILURE - keys of other user",
+			req: &v1.ListAPIKeysRequest{UserId: "user@test.com"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = mock.NewMockAccount(mockCtrl)
+			},
+			wantErr: true,
+		},
+		{name: "FAILURE - APIKeys error",
+			req: &v1.ListAPIKeysRequest{},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				mockRepo.EXPECT().APIKeys(ctx, "admin@test.com").Times(1).Return(nil, errors.New("DBError"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			s := &accountServiceServer{
+				accountRepo: rep,
+			}
+			got, err := s.ListAPIKeys(ctx, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("accountServiceServer.ListAPIKeys() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_accountServiceServer_RevokeAPIKey(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@test.com",
+		Role:   "Admin",
+	})
+	var mockCtrl *gomock.Controller
+	var rep repv1.Account
+	tests := []struct {
+		name     string
+		setup    func()
+		want     *v1.RevokeAPIKeyResponse
+		wantCode codes.Code
+	}{
+		{name: "SUCCESS",
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				mockRepo.EXPECT().APIKeyByID(ctx, "1").Times(1).Return(&repv1.APIKey{ID: "1", UserID: "admin@test.com"}, nil)
+				mockRepo.EXPECT().DeleteAPIKey(ctx, "1").Times(1).Return(nil)
+			},
+			want: &v1.RevokeAPIKeyResponse{Success: true},
+		},
+		{name: "FAILURE - key does not exist",
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				mockRepo.EXPECT().APIKeyByID(ctx, "1").Times(1).Return(nil, repv1.ErrNoData)
+			},
+			wantCode: codes.NotFound,
+		},
+		{name: "FAILURE - key of other user",
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				mockRepo.EXPECT().APIKeyByID(ctx, "1").Times(1).Return(&repv1.APIKey{ID: "1", UserID: "user@test.com"}, nil)
+			},
+			wantCode: codes.NotFound,
+		},
+		{name: "FAILURE - DeleteAPIKey error",
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				mockRepo.EXPECT().APIKeyByID(ctx, "1").Times(1).Return(&repv1.APIKey{ID: "1", UserID: "admin@test.com"}, nil)
+				mockRepo.EXPECT().DeleteAPIKey(ctx, "1").Times(1).Return(errors.New("DBError"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			s := &accountServiceServer{
+				accountRepo: rep,
+			}
+			got, err := s.RevokeAPIKey(ctx, &v1.RevokeAPIKeyRequest{Id: "1"})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
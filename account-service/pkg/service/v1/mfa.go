@@ -0,0 +1,225 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/account-service/pkg/api/v1"
+	repo "gitlab.tech.orange/optisam/optisam-it/optisam-services/account-service/pkg/repository/v1"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/mfa"
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+
+	pTypes "github.com/golang/protobuf/ptypes"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mfaIssuer is the name authenticator apps show for optisam codes
+const mfaIssuer = "optisam"
+
+// MFAEnrollmentMethods are the apis accepting the enrollment tokens auth-service gives
+// to users who must enroll an authenticator before they can login.
+var MFAEnrollmentMethods = []string{
+	"/optisam.account.v1.AccountService/GetMFAStatus",
+	"/optisam.account.v1.AccountService/EnrollMFA",
+	"/optisam.account.v1.AccountService/ActivateMFA",
+}
+
+// GetMFAStatus implements AccountService GetMFAStatus function
+func (s *accountServiceServer) GetMFAStatus(ctx context.Context, req *v1.GetMFAStatusRequest) (*v1.GetMFAStatusResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "cannot find claims in context")
+	}
+	userID, role := userClaims.UserID, userClaims.Role
+	if req.UserId != "" && req.UserId != userClaims.UserID {
+		if userClaims.Role != claims.RoleSuperAdmin || mfa.IsEnrollmentClaims(userClaims) {
+			return nil, status.Error(codes.PermissionDenied, "only superadmin user can get mfa status of other users")
+		}
+		info, err := s.accountRepo.AccountInfo(ctx, req.UserId)
+		if err != nil {
+			if err == repo.ErrNoData {
+				return nil, status.Error(codes.NotFound, "user does not exist")
+			}
+			logger.Log.Error("service/v1 - GetMFAStatus - AccountInfo", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to get Account info")
+		}
+		userID = req.UserId
+		role, _ = claims.ReturnRole(info.Role.RoleToRoleString())
+	}
+	resp := &v1.GetMFAStatusResponse{
+		Required: s.config.MFA.Required(role),
+	}
+	m, err := s.accountRepo.UserMFA(ctx, userID)
+	if err != nil {
+		if err == repo.ErrNoData {
+			return resp, nil
+		}
+		logger.Log.Error("service/v1 - GetMFAStatus - UserMFA", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get mfa")
+	}
+	if m.Enabled {
+		resp.Enabled = true
+		resp.RecoveryCodesLeft = int32(len(m.RecoveryCodes))
+		resp.EnabledOn, _ = pTypes.TimestampProto(m.EnabledOn)
+	}
+	return resp, nil
+}
+
+// EnrollMFA implements AccountService EnrollMFA function
+func (s *accountServiceServer) EnrollMFA(ctx context.Context, req *v1.EnrollMFARequest) (*v1.EnrollMFAResponse, error) {
+	userClaims, err := mfaClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m, err := s.accountRepo.UserMFA(ctx, userClaims.UserID)
+	if err != nil && err != repo.ErrNoData {
+		logger.Log.Error("service/v1 - EnrollMFA - UserMFA", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get mfa")
+	}
+	if m != nil && m.Enabled {
+		return nil, status.Error(codes.AlreadyExists, "mfa is already enabled")
+	}
+	secret, err := mfa.GenerateSecret()
+	if err != nil {
+		logger.Log.Error("service/v1 - EnrollMFA - GenerateSecret", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to generate secret")
+	}
+	if err := s.accountRepo.SaveMFASecret(ctx, userClaims.UserID, secret); err != nil {
+		logger.Log.Error("service/v1 - EnrollMFA - SaveMFASecret", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to save secret")
+	}
+	return &v1.EnrollMFAResponse{
+		Secret: secret,
+		KeyUri: mfa.KeyURI(mfaIssuer, userClaims.UserID, secret),
+	}, nil
+}
+
+// ActivateMFA implements AccountService ActivateMFA function
+func (s *accountServiceServer) ActivateMFA(ctx context.Context, req *v1.ActivateMFARequest) (*v1.ActivateMFAResponse, error) {
+	userClaims, err := mfaClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m, err := s.accountRepo.UserMFA(ctx, userClaims.UserID)
+	if err != nil {
+		if err == repo.ErrNoData {
+			return nil, status.Error(codes.FailedPrecondition, "mfa is not enrolled")
+		}
+		logger.Log.Error("service/v1 - ActivateMFA - UserMFA", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get mfa")
+	}
+	if m.Enabled {
+		return nil, status.Error(codes.AlreadyExists, "mfa is already enabled")
+	}
+	step, ok := mfa.Validate(m.Secret, req.Code, time.Now(), m.LastUsedStep)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid code")
+	}
+	recoveryCodes, hashes, err := mfa.GenerateRecoveryCodes()
+	if err != nil {
+		logger.Log.Error("service/v1 - ActivateMFA - GenerateRecoveryCodes", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to generate recovery codes")
+	}
+	if err := s.accountRepo.EnableMFA(ctx, userClaims.UserID, step, hashes); err != nil {
+		logger.Log.Error("service/v1 - ActivateMFA - EnableMFA", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to enable mfa")
+	}
+	return &v1.ActivateMFAResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+// RegenerateMFARecoveryCodes implements AccountService RegenerateMFARecoveryCodes function
+func (s *accountServiceServer) RegenerateMFARecoveryCodes(ctx context.Context, req *v1.RegenerateMFARecoveryCodesRequest) (*v1.ActivateMFAResponse, error) {
+	userClaims, err := mfaClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m, err := s.enabledMFA(ctx, userClaims.UserID, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	recoveryCodes, hashes, err := mfa.GenerateRecoveryCodes()
+	if err != nil {
+		logger.Log.Error("service/v1 - RegenerateMFARecoveryCodes - GenerateRecoveryCodes", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to generate recovery codes")
+	}
+	if err := s.accountRepo.UpdateMFARecoveryCodes(ctx, m.UserID, hashes); err != nil {
+		logger.Log.Error("service/v1 - RegenerateMFARecoveryCodes - UpdateMFARecoveryCodes", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to update recovery codes")
+	}
+	return &v1.ActivateMFAResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+// DisableMFA implements AccountService DisableMFA function
+func (s *accountServiceServer) DisableMFA(ctx context.Context, req *v1.DisableMFARequest) (*v1.DisableMFAResponse, error) {
+	userClaims, err := mfaClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserId != "" && req.UserId != userClaims.UserID {
+		// a lost authenticator is reset by a super admin, the user enrolls a new one on next login
+		if userClaims.Role != claims.RoleSuperAdmin {
+			return nil, status.Error(codes.PermissionDenied, "only superadmin user can reset mfa of other users")
+		}
+		if err := s.accountRepo.DeleteMFA(ctx, req.UserId); err != nil {
+			logger.Log.Error("service/v1 - DisableMFA - DeleteMFA", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to disable mfa")
+		}
+		return &v1.DisableMFAResponse{Success: true}, nil
+	}
+	if s.config.MFA.Required(userClaims.Role) {
+		return nil, status.Error(codes.FailedPrecondition, "mfa is mandatory for the role of the user")
+	}
+	if _, err := s.enabledMFA(ctx, userClaims.UserID, req.Code); err != nil {
+		return nil, err
+	}
+	if err := s.accountRepo.DeleteMFA(ctx, userClaims.UserID); err != nil {
+		logger.Log.Error("service/v1 - DisableMFA - DeleteMFA", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to disable mfa")
+	}
+	return &v1.DisableMFAResponse{Success: true}, nil
+}
+
+// mfaClaims returns the claims of the user managing its authenticator, api keys cannot manage it.
+func mfaClaims(ctx context.Context) (*claims.Claims, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "cannot find claims in context")
+	}
+	if apikey.IsKeyClaims(userClaims) {
+		return nil, status.Error(codes.PermissionDenied, "api keys cannot manage mfa")
+	}
+	return userClaims, nil
+}
+
+// enabledMFA returns the enabled authenticator of the user if code is valid.
+func (s *accountServiceServer) enabledMFA(ctx context.Context, userID, code string) (*repo.UserMFA, error) {
+	m, err := s.accountRepo.UserMFA(ctx, userID)
+	if err != nil && err != repo.ErrNoData {
+		logger.Log.Error("service/v1 - enabledMFA - UserMFA", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get mfa")
+	}
+	if m == nil || !m.Enabled {
+		return nil, status.Error(codes.FailedPrecondition, "mfa is not enabled")
+	}
+	step, ok := mfa.Validate(m.Secret, code, time.Now(), m.LastUsedStep)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid code")
+	}
+	// a code is used only once, like at login
+	used, err := s.accountRepo.UseMFAStep(ctx, userID, step)
+	if err != nil {
+		logger.Log.Error("service/v1 - enabledMFA - UseMFAStep", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to use code")
+	}
+	if !used {
+		return nil, status.Error(codes.InvalidArgument, "invalid code")
+	}
+	return m, nil
+}
@@ -0,0 +1,302 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/account-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/account-service/pkg/config"
+	repv1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/account-service/pkg/repository/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/account-service/pkg/repository/v1/mock"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/mfa"
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testMFASecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func testMFACode(t *testing.T) string {
+	code, err := mfa.Code(testMFASecret, mfa.Step(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+func Test_accountServiceServer_EnrollMFA(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), mfa.EnrollmentClaims(&claims.Claims{
+		UserID: "admin@test.com",
+		Role:   claims.RoleAdmin,
+	}))
+	var mockCtrl *gomock.Controller
+	var rep repv1.Account
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		setup    func()
+		wantCode codes.Code
+	}{
+		{name: "SUCCESS - enrollment token",
+			ctx: ctx,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				mockRepo.EXPECT().UserMFA(gomock.Any(), "admin@test.com").Times(1).Return(nil, repv1.ErrNoData)
+				mockRepo.EXPECT().SaveMFASecret(gomock.Any(), "admin@test.com", gomock.Any()).Times(1).Return(nil)
+			},
+		},
+		{name: "SUCCESS - pending secret is replaced",
+			ctx: ctx,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				mockRepo.EXPECT().UserMFA(gomock.Any(), "admin@test.com").Times(1).Return(&repv1.UserMFA{UserID: "admin@test.com", Secret: testMFASecret}, nil)
+				mockRepo.EXPECT().SaveMFASecret(gomock.Any(), "admin@test.com", gomock.Any()).Times(1).Return(nil)
+			},
+		},
+		{name: "FAILURE - already enabled",
+			ctx: ctx,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				mockRepo.EXPECT().UserMFA(gomock.Any(), "admin@test.com").Times(1).Return(&repv1.UserMFA{UserID: "admin@test.com", Enabled: true}, nil)
+			},
+			wantCode: codes.AlreadyExists,
+		},
+		{name: "FAILURE - claims of an api key",
+			ctx: grpc_middleware.AddClaims(context.Background(), (&apikey.Key{
+				UserID:    "admin@test.com",
+				Role:      claims.RoleAdmin,
+				ExpiresAt: time.Now().Add(time.Hour),
+			}).Claims()),
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = mock.NewMockAccount(mockCtrl)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			s := &accountServiceServer{
+				accountRepo: rep,
+			}
+			got, err := s.EnrollMFA(tt.ctx, &v1.EnrollMFARequest{})
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			if assert.Empty(t, err) {
+				assert.NotEmpty(t, got.Secret)
+				assert.Equal(t, mfa.KeyURI(mfaIssuer, "admin@test.com", got.Secret), got.KeyUri)
+			}
+		})
+	}
+}
+
+func Test_accountServiceServer_ActivateMFA(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@test.com",
+		Role:   claims.RoleAdmin,
+	})
+	var mockCtrl *gomock.Controller
+	var rep repv1.Account
+	var savedCodes []string
+	pending := func() *repv1.UserMFA {
+		return &repv1.UserMFA{UserID: "admin@test.com", Secret: testMFASecret}
+	}
+	tests := []struct {
+		name     string
+		code     string
+		setup    func()
+		wantCode codes.Code
+	}{
+		{name: "SUCCESS",
+			code: testMFACode(t),
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				mockRepo.EXPECT().UserMFA(gomock.Any(), "admin@test.com").Times(1).Return(pending(), nil)
+				mockRepo.EXPECT().EnableMFA(gomock.Any(), "admin@test.com", gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(_ context.Context, _ string, step int64, codes []string) error {
+					assert.True(t, step >= mfa.Step(time.Now())-mfa.Skew)
+					savedCodes = codes
+					return nil
+				})
+			},
+		},
+		{name: "FAILURE - wrong code",
+			code: "000000",
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				mockRepo.EXPECT().UserMFA(gomock.Any(), "admin@test.com").Times(1).Return(pending(), nil)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{name: "FAILURE - not enrolled",
+			code: testMFACode(t),
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				mockRepo.EXPECT().UserMFA(gomock.Any(), "admin@test.com").Times(1).Return(nil, repv1.ErrNoData)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{name: "FAILURE - already enabled",
+			code: testMFACode(t),
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				m := pending()
+				m.Enabled = true
+				mockRepo.EXPECT().UserMFA(gomock.Any(), "admin@test.com").Times(1).Return(m, nil)
+			},
+			wantCode: codes.AlreadyExists,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			savedCodes = nil
+			s := &accountServiceServer{
+				accountRepo: rep,
+			}
+			got, err := s.ActivateMFA(ctx, &v1.ActivateMFARequest{Code: tt.code})
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			if assert.Empty(t, err) && assert.Len(t, got.RecoveryCodes, mfa.RecoveryCodes) {
+				// only hashes of recovery codes are stored
+				for i, code := range got.RecoveryCodes {
+					assert.Equal(t, mfa.HashRecoveryCode(code), savedCodes[i])
+				}
+			}
+		})
+	}
+}
+
+func Test_accountServiceServer_DisableMFA(t *testing.T) {
+	userCtx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "user@test.com",
+		Role:   claims.RoleUser,
+	})
+	adminCtx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@test.com",
+		Role:   claims.RoleAdmin,
+	})
+	superAdminCtx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "superadmin@test.com",
+		Role:   claims.RoleSuperAdmin,
+	})
+	var mockCtrl *gomock.Controller
+	var rep repv1.Account
+	enabled := &repv1.UserMFA{UserID: "user@test.com", Secret: testMFASecret, Enabled: true}
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		req      *v1.DisableMFARequest
+		setup    func()
+		wantCode codes.Code
+	}{
+		{name: "SUCCESS - optional mfa",
+			ctx: userCtx,
+			req: &v1.DisableMFARequest{Code: testMFACode(t)},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				mockRepo.EXPECT().UserMFA(gomock.Any(), "user@test.com").Times(1).Return(enabled, nil)
+				mockRepo.EXPECT().UseMFAStep(gomock.Any(), "user@test.com", gomock.Any()).Times(1).Return(true, nil)
+				mockRepo.EXPECT().DeleteMFA(gomock.Any(), "user@test.com").Times(1).Return(nil)
+			},
+		},
+		{name: "FAILURE - code already used",
+			ctx: userCtx,
+			req: &v1.DisableMFARequest{Code: testMFACode(t)},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				mockRepo.EXPECT().UserMFA(gomock.Any(), "user@test.com").Times(1).Return(enabled, nil)
+				mockRepo.EXPECT().UseMFAStep(gomock.Any(), "user@test.com", gomock.Any()).Times(1).Return(false, nil)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{name: "SUCCESS - superadmin resets lost authenticator",
+			ctx: superAdminCtx,
+			req: &v1.DisableMFARequest{UserId: "admin@test.com"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				mockRepo.EXPECT().DeleteMFA(gomock.Any(), "admin@test.com").Times(1).Return(nil)
+			},
+		},
+		{name: "FAILURE - wrong code",
+			ctx: userCtx,
+			req: &v1.DisableMFARequest{Code: "000000"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAccount(mockCtrl)
+				rep = mockRepo
+				mockRepo.EXPECT().UserMFA(gomock.Any(), "user@test.com").Times(1).Return(enabled, nil)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{name: "FAILURE - mandatory for role",
+			ctx: adminCtx,
+			req: &v1.DisableMFARequest{Code: testMFACode(t)},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = mock.NewMockAccount(mockCtrl)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{name: "FAILURE - admin resets another user",
+			ctx: adminCtx,
+			req: &v1.DisableMFARequest{UserId: "user@test.com"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = mock.NewMockAccount(mockCtrl)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			s := &accountServiceServer{
+				accountRepo: rep,
+				config:      config.Config{MFA: mfa.Policy{RequiredRoles: mfa.DefaultRequiredRoles}},
+			}
+			got, err := s.DisableMFA(tt.ctx, tt.req)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			if assert.Empty(t, err) {
+				assert.True(t, got.Success)
+			}
+		})
+	}
+}
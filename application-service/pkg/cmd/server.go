@@ -29,6 +29,7 @@ import (
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/postgres"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/prometheus"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/admin"
 
 	"github.com/InVisionApp/go-health"
 	"github.com/InVisionApp/go-health/checkers"
@@ -258,8 +259,14 @@ func RunServer() error {
 			logger.Log.Sugar().Debug("Recovered in RunServer", r)
 		}
 	}()
+	auditPublisher, err := iam.NewAuditPublisher(cfg.IAM)
+	if err != nil {
+		logger.Log.Fatal("Failed to create audit publisher", zap.Error(err))
+	}
+	revoked := iam.NewRevocationList(cfg.IAM)
+	apiKeys := iam.NewAPIKeyStore(cfg.IAM)
 	go func() {
-		_ = rest.RunServer(ctx, cfg.GRPCPort, cfg.HTTPPort, verifyKey, authZPolicies)
+		_ = rest.RunServer(ctx, cfg.GRPCPort, cfg.HTTPPort, verifyKey, revoked, apiKeys, authZPolicies)
 	}()
-	return grpc.RunServer(ctx, v1API, cfg.GRPCPort, verifyKey, authZPolicies, cfg.IAM.APIKey)
+	return grpc.RunServer(ctx, v1API, admin.NewServer(q), cfg.GRPCPort, verifyKey, authZPolicies, cfg.IAM.APIKey, revoked, apiKeys, auditPublisher)
 }
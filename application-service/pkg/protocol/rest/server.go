@@ -13,11 +13,14 @@ import (
 
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
 	rest_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/rest"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/opa"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/revocation"
+	wqv1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/api/v1"
 
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
-	"github.com/open-policy-agent/opa/rego"
 	"go.opencensus.io/plugin/ocgrpc"
 	"go.opencensus.io/plugin/ochttp"
 	"go.uber.org/zap"
@@ -25,7 +28,7 @@ import (
 )
 
 // RunServer runs HTTP/REST gateway
-func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.PublicKey, p *rego.PreparedEvalQuery) error {
+func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.PublicKey, revoked revocation.List, keys apikey.Store, p *opa.Policy) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -42,16 +45,8 @@ func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.Pu
 		Addr: ":" + httpPort,
 		// Handler: &ochttp.Handler{
 		Handler: &ochttp.Handler{Handler: rest_middleware.AddCORS([]string{"*"},
-			// rest_middleware.AddLogger(logger.Log,
-			// 	rest_middleware.ValidateAuth(verifyKey,
-			// 		rest_middleware.ValidateAuthZ(p, mux_http),
-			// rest_middleware.ValidateAuth(verifyKey,
-			// 	rest_middleware.AddLogger(logger.Log, mux_http),
-			muxHTTP),
-		// )))
-
+			rest_middleware.ValidateAuthWithAPIKeys(verifyKey, revoked, keys, muxHTTP)),
 		},
-		// },
 	}
 
 	// graceful shutdown
@@ -93,5 +88,8 @@ func newGateway(ctx context.Context, grpcPort string) (http.Handler, error) {
 	if err = v1.RegisterApplicationServiceHandler(ctx, muxGateway, conn); err != nil {
 		return nil, err
 	}
+	if err = wqv1.RegisterWorkerQueueAdminHandler(ctx, muxGateway, conn); err != nil {
+		return nil, err
+	}
 	return muxGateway, err
 }
@@ -17,6 +17,7 @@ const (
 	JobStatusFAILED    JobStatus = "FAILED"
 	JobStatusRETRY     JobStatus = "RETRY"
 	JobStatusRUNNING   JobStatus = "RUNNING"
+	JobStatusDEAD      JobStatus = "DEAD"
 )
 
 func (e *JobStatus) Scan(src interface{}) error {
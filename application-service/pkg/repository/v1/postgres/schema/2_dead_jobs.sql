@@ -0,0 +1,7 @@
+-- +migrate Up notransaction
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TYPE job_status ADD VALUE IF NOT EXISTS 'DEAD';
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+UPDATE jobs SET status = 'FAILED' WHERE status = 'DEAD';
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/audit-service/pkg/cmd"
+)
+
+//go:generate protoc --proto_path=../../api/proto/v1 --proto_path=../../thirdparty/ --go_out=paths=source_relative:../../pkg/api/v1 --go-grpc_out=require_unimplemented_servers=false,paths=source_relative:../../pkg/api/v1 audit.proto
+//go:generate protoc --proto_path=../../api/proto/v1 --proto_path=../../thirdparty/ --grpc-gateway_out=paths=source_relative:../../pkg/api/v1 audit.proto
+//go:generate protoc --proto_path=../../api/proto/v1 --proto_path=../../thirdparty/ --openapiv2_out=logtostderr=true,json_names_for_fields=false:../../api/swagger/v1  audit.proto
+//go:generate protoc --proto_path=../../api/proto/v1 --proto_path=../../thirdparty/ --validate_out=lang=go,paths=source_relative:../../pkg/api/v1 audit.proto
+//go:generate mockgen -destination=../../pkg/api/v1/mock/mock.go -package=mock gitlab.tech.orange/optisam/optisam-it/optisam-services/audit-service/pkg/api/v1 AuditServiceClient
+
+func main() {
+	if err := cmd.RunServer(); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
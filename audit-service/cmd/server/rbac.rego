@@ -0,0 +1,18 @@
+package rbac
+
+default allow = false
+
+# Allow admins to do anything.
+allow {
+	roles["Admin"][input.role]
+}
+
+# Normal Users
+allow {
+  user_apis[input.api]
+  input.role = "User"
+}
+
+roles := {"Admin":{"SuperAdmin","Admin"},"Normal":{"User"}}
+user_apis := {
+}
\ No newline at end of file
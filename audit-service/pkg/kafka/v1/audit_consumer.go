@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	repo "gitlab.tech.orange/optisam/optisam-it/optisam-services/audit-service/pkg/repository/v1"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/audit"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"go.uber.org/zap"
+)
+
+const (
+	// consumerGroup is the kafka consumer group of the audit service
+	consumerGroup = "optisam-audit"
+	// maxRetryInterval is the longest wait between two attempts to store an event
+	maxRetryInterval = time.Minute
+)
+
+// ConsumerConfig is the kafka configuration of the audit event consumer
+// nolint: gochecknoglobals
+var ConsumerConfig = map[string]string{"group.id": consumerGroup}
+
+// AuditConsumer stores the audit events published by the services until a termination signal.
+func AuditConsumer(c *kafka.Consumer, auditRepo repo.Audit) error {
+	if err := c.SubscribeTopics([]string{audit.Topic}, nil); err != nil {
+		logger.Log.Sugar().Error("failed to subscribe consumer: %v", err)
+		return fmt.Errorf("failed to subscribe consumer: %v", err)
+	}
+	sigchan := make(chan os.Signal, 1)
+	signal.Notify(sigchan, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		defer c.Close()
+		for {
+			select {
+			case sig := <-sigchan:
+				logger.Log.Sugar().Debug("Caught signal %v: terminating\n", sig)
+				return
+			default:
+				message, err := c.ReadMessage(1000 * time.Millisecond)
+				if err != nil {
+					continue
+				}
+				processAuditEvent(message, auditRepo)
+			}
+		}
+	}()
+	return nil
+}
+
+// processAuditEvent stores the event of the message, storage is retried until it succeeds
+// as the audit trail must not miss events.
+func processAuditEvent(message *kafka.Message, auditRepo repo.Audit) {
+	e := &audit.Event{}
+	if err := json.Unmarshal(message.Value, e); err != nil {
+		logger.Log.Error("kafka/v1 - processAuditEvent - invalid audit event", zap.ByteString("event", message.Value), zap.Error(err))
+		return
+	}
+	wait := time.Second
+	for {
+		err := auditRepo.InsertEvent(context.Background(), e)
+		if err == nil {
+			return
+		}
+		logger.Log.Error("kafka/v1 - processAuditEvent - InsertEvent", zap.String("id", e.ID), zap.Error(err))
+		time.Sleep(wait)
+		if wait *= 2; wait > maxRetryInterval {
+			wait = maxRetryInterval
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"context"
+	"crypto/rsa"
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/audit-service/pkg/api/v1"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	mw "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/opa"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/revocation"
+
+	"go.opencensus.io/plugin/ocgrpc"
+	"google.golang.org/grpc"
+)
+
+// RunServer runs gRPC service to publish Auth service
+func RunServer(ctx context.Context, v1API v1.AuditServiceServer, port string, verifyKey *rsa.PublicKey, p *opa.Policy, apiKey string, revoked revocation.List, keys apikey.Store) error {
+	listen, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		return err
+	}
+
+	// gRPC server statup options
+	opts := mw.Chained(logger.Log, verifyKey, p, apiKey, mw.WithRevocationList(revoked), mw.WithAPIKeyStore(keys))
+	opts = append(opts, grpc.StatsHandler(&ocgrpc.ServerHandler{}))
+	// add middleware
+	// opts = grpc_middleware.AddLogging(logger.Log, opts)
+	// register service
+	server := grpc.NewServer(opts...)
+	v1.RegisterAuditServiceServer(server, v1API)
+	// graceful shutdown
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt)
+	go func() {
+		for range c {
+			// sig is a ^C, handle it
+			log.Println("shutting down gRPC server...")
+
+			server.GracefulStop()
+
+			<-ctx.Done()
+		}
+	}()
+
+	// start gRPC server
+	log.Println("starting gRPC server...")
+	return server.Serve(listen)
+}
@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/audit"
+)
+
+//go:generate mockgen -destination=mock/mock.go -package=mock gitlab.tech.orange/optisam/optisam-it/optisam-services/audit-service/pkg/repository/v1 Audit
+
+// Audit is the store of audit events
+type Audit interface {
+	// InsertEvent stores the event, events already stored are ignored
+	InsertEvent(ctx context.Context, e *audit.Event) error
+	// ListEvents returns the events matching the filter latest first with the total number of matching events
+	ListEvents(ctx context.Context, f *EventFilter) ([]*audit.Event, int32, error)
+}
+
+// EventFilter selects audit events, empty fields do not filter
+type EventFilter struct {
+	// Scopes selects the events on any of the scopes
+	Scopes []string
+	// AllScopes selects events on every scope and without scope, Scopes is ignored
+	AllScopes bool
+	UserID    string
+	Entity    string
+	Method    string
+	From      time.Time
+	To        time.Time
+	Offset    int32
+	Limit     int32
+}
@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/audit-service/pkg/repository/v1"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/audit"
+
+	"github.com/lib/pq"
+)
+
+// AuditRepository is the postgres store of audit events
+type AuditRepository struct {
+	db *sql.DB
+}
+
+// NewAuditRepository creates new Repository
+func NewAuditRepository(db *sql.DB) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+const insertEventQuery = `
+INSERT INTO audit_events (id, event_time, service, method, entity, user_id, role, api_key_id, scopes, request, changes, outcome, code, error, trace_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+ON CONFLICT (id) DO NOTHING`
+
+// InsertEvent implements Audit InsertEvent function
+func (r *AuditRepository) InsertEvent(ctx context.Context, e *audit.Event) error {
+	changes, err := json.Marshal(e.Changes)
+	if err != nil {
+		return err
+	}
+	var request interface{}
+	if len(e.Request) != 0 {
+		request = []byte(e.Request)
+	}
+	scopes := e.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+	_, err = r.db.ExecContext(ctx, insertEventQuery, e.ID, e.Time, e.Service, e.Method, e.Entity, e.UserID, e.Role, e.APIKeyID,
+		pq.Array(scopes), request, changes, e.Outcome, e.Code, e.Error, e.TraceID)
+	return err
+}
+
+// ListEvents implements Audit ListEvents function
+func (r *AuditRepository) ListEvents(ctx context.Context, f *v1.EventFilter) ([]*audit.Event, int32, error) {
+	where, args := eventFilter(f)
+	// nolint: gosec
+	query := `
+SELECT count(*) OVER() AS totalrecords, id, event_time, service, method, entity, user_id, role, api_key_id, scopes,
+coalesce(request, 'null'), coalesce(changes, 'null'), outcome, code, error, trace_id
+FROM audit_events` + where + fmt.Sprintf(`
+ORDER BY event_time DESC, id
+OFFSET $%d LIMIT $%d`, len(args)+1, len(args)+2)
+	args = append(args, f.Offset, f.Limit)
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	var total int32
+	var events []*audit.Event
+	for rows.Next() {
+		e := &audit.Event{}
+		var request, changes []byte
+		if err := rows.Scan(&total, &e.ID, &e.Time, &e.Service, &e.Method, &e.Entity, &e.UserID, &e.Role, &e.APIKeyID,
+			pq.Array(&e.Scopes), &request, &changes, &e.Outcome, &e.Code, &e.Error, &e.TraceID); err != nil {
+			return nil, 0, err
+		}
+		if string(request) != "null" {
+			e.Request = request
+		}
+		if err := json.Unmarshal(changes, &e.Changes); err != nil {
+			return nil, 0, err
+		}
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
+
+// eventFilter returns the where clause of the filter with its arguments
+func eventFilter(f *v1.EventFilter) (string, []interface{}) {
+	var conds []string
+	var args []interface{}
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if !f.AllScopes {
+		add("scopes && $%d", pq.Array(f.Scopes))
+	}
+	if f.UserID != "" {
+		add("user_id = $%d", f.UserID)
+	}
+	if f.Entity != "" {
+		add("entity = $%d", f.Entity)
+	}
+	if f.Method != "" {
+		add("method = $%d", f.Method)
+	}
+	if !f.From.IsZero() {
+		add("event_time >= $%d", f.From)
+	}
+	if !f.To.IsZero() {
+		add("event_time < $%d", f.To)
+	}
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return "\nWHERE " + strings.Join(conds, " AND "), args
+}
@@ -0,0 +1,32 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS audit_events (
+  id VARCHAR PRIMARY KEY,
+  event_time TIMESTAMP NOT NULL,
+  service VARCHAR NOT NULL,
+  method VARCHAR NOT NULL,
+  entity VARCHAR NOT NULL,
+  user_id VARCHAR NOT NULL,
+  role VARCHAR NOT NULL,
+  api_key_id VARCHAR NOT NULL DEFAULT '',
+  scopes TEXT[] NOT NULL DEFAULT '{}',
+  request JSONB,
+  changes JSONB,
+  outcome VARCHAR NOT NULL,
+  code VARCHAR NOT NULL,
+  error TEXT NOT NULL DEFAULT '',
+  trace_id VARCHAR NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS audit_events_event_time_idx ON audit_events (event_time DESC);
+CREATE INDEX IF NOT EXISTS audit_events_user_id_idx ON audit_events (user_id, event_time DESC);
+CREATE INDEX IF NOT EXISTS audit_events_entity_idx ON audit_events (entity, event_time DESC);
+CREATE INDEX IF NOT EXISTS audit_events_scopes_idx ON audit_events USING GIN (scopes);
+
+Grant ALL ON ALL TABLES
+IN SCHEMA "public"
+TO optisam_app_user;
+
+-- +migrate Down
+REVOKE ALL ON ALL TABLES
+IN SCHEMA "public" from optisam_app_user;
+DROP TABLE IF EXISTS audit_events;
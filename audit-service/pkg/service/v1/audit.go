@@ -0,0 +1,115 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/audit-service/pkg/api/v1"
+	repo "gitlab.tech.orange/optisam/optisam-it/optisam-services/audit-service/pkg/repository/v1"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/audit"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/helper"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AuditServiceServer is implementation of v1.AuditServiceServer proto interface
+type AuditServiceServer struct {
+	AuditRepo repo.Audit
+}
+
+// NewAuditServiceServer creates Audit service
+func NewAuditServiceServer(auditRepo repo.Audit) *AuditServiceServer {
+	return &AuditServiceServer{
+		AuditRepo: auditRepo,
+	}
+}
+
+// ListAuditEvents implements AuditService ListAuditEvents function
+func (s *AuditServiceServer) ListAuditEvents(ctx context.Context, req *v1.ListAuditEventsRequest) (*v1.ListAuditEventsResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFoundError")
+	}
+	f := &repo.EventFilter{
+		UserID: req.UserId,
+		Entity: req.Entity,
+		Method: req.Method,
+		// API expect pagenum from 1 but the offset in DB starts
+		Offset: req.PageSize * (req.PageNum - 1),
+		Limit:  req.PageSize,
+	}
+	switch {
+	case len(req.Scopes) != 0:
+		if !helper.Contains(userClaims.Socpes, req.Scopes...) {
+			return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+		}
+		f.Scopes = req.Scopes
+	case userClaims.Role == claims.RoleSuperAdmin:
+		f.AllScopes = true
+	default:
+		f.Scopes = userClaims.Socpes
+	}
+	if req.From != nil {
+		from, err := ptypes.Timestamp(req.From)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "InvalidFromError")
+		}
+		f.From = from
+	}
+	if req.To != nil {
+		to, err := ptypes.Timestamp(req.To)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "InvalidToError")
+		}
+		f.To = to
+	}
+	events, total, err := s.AuditRepo.ListEvents(ctx, f)
+	if err != nil {
+		logger.Log.Error("service/v1 - ListAuditEvents - ListEvents", zap.Error(err))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	resp := &v1.ListAuditEventsResponse{
+		TotalRecords: total,
+		Events:       make([]*v1.AuditEvent, len(events)),
+	}
+	for i, e := range events {
+		resp.Events[i] = auditEvent(e)
+	}
+	return resp, nil
+}
+
+func auditEvent(e *audit.Event) *v1.AuditEvent {
+	event := &v1.AuditEvent{
+		Id:       e.ID,
+		Service:  e.Service,
+		Method:   e.Method,
+		Entity:   e.Entity,
+		UserId:   e.UserID,
+		Role:     e.Role,
+		ApiKeyId: e.APIKeyID,
+		Scopes:   e.Scopes,
+		Request:  string(e.Request),
+		Outcome:  string(e.Outcome),
+		Code:     e.Code,
+		Error:    e.Error,
+		TraceId:  e.TraceID,
+	}
+	event.Time, _ = ptypes.TimestampProto(e.Time)
+	for _, c := range e.Changes {
+		oldValue, _ := json.Marshal(c.Old)
+		newValue, _ := json.Marshal(c.New)
+		event.Changes = append(event.Changes, &v1.AuditChange{
+			Field:    c.Field,
+			OldValue: string(oldValue),
+			NewValue: string(newValue),
+		})
+	}
+	return event
+}
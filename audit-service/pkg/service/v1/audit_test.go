@@ -0,0 +1,161 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/audit-service/pkg/api/v1"
+	repo "gitlab.tech.orange/optisam/optisam-it/optisam-services/audit-service/pkg/repository/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/audit-service/pkg/repository/v1/mock"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/audit"
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_AuditServiceServer_ListAuditEvents(t *testing.T) {
+	adminCtx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@test.com",
+		Role:   claims.RoleAdmin,
+		Socpes: []string{"s1", "s2"},
+	})
+	superAdminCtx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "superadmin@test.com",
+		Role:   claims.RoleSuperAdmin,
+		Socpes: []string{"s1"},
+	})
+	eventTime := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	event := &audit.Event{
+		ID:      "e1",
+		Time:    eventTime,
+		Service: "optisam.products.v1.ProductService",
+		Method:  "UpdateAcqRight",
+		Entity:  "AcqRight",
+		UserID:  "admin@test.com",
+		Role:    "Admin",
+		Scopes:  []string{"s1"},
+		Request: []byte(`{"avg_unit_price":12.5,"scope":"s1","sku":"sku1"}`),
+		Changes: []audit.Change{{Field: "avg_unit_price", Old: float64(10), New: 12.5}},
+		Outcome: audit.OutcomeSuccess,
+		Code:    "OK",
+	}
+	eventTimeProto, _ := ptypes.TimestampProto(eventTime)
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	fromProto, _ := ptypes.TimestampProto(from)
+	var mockCtrl *gomock.Controller
+	var rep repo.Audit
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		req      *v1.ListAuditEventsRequest
+		setup    func()
+		want     *v1.ListAuditEventsResponse
+		wantCode codes.Code
+	}{
+		{name: "SUCCESS - filtered by scope",
+			ctx: adminCtx,
+			req: &v1.ListAuditEventsRequest{PageNum: 2, PageSize: 10, Scopes: []string{"s1"}, Entity: "AcqRight", From: fromProto},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAudit(mockCtrl)
+				rep = mockRepo
+				mockRepo.EXPECT().ListEvents(gomock.Any(), &repo.EventFilter{
+					Scopes: []string{"s1"},
+					Entity: "AcqRight",
+					From:   from,
+					Offset: 10,
+					Limit:  10,
+				}).Times(1).Return([]*audit.Event{event}, int32(11), nil)
+			},
+			want: &v1.ListAuditEventsResponse{
+				TotalRecords: 11,
+				Events: []*v1.AuditEvent{
+					{
+						Id:      "e1",
+						Time:    eventTimeProto,
+						Service: "optisam.products.v1.ProductService",
+						Method:  "UpdateAcqRight",
+						Entity:  "AcqRight",
+						UserId:  "admin@test.com",
+						Role:    "Admin",
+						Scopes:  []string{"s1"},
+						Request: `{"avg_unit_price":12.5,"scope":"s1","sku":"sku1"}`,
+						Changes: []*v1.AuditChange{{Field: "avg_unit_price", OldValue: "10", NewValue: "12.5"}},
+						Outcome: "success",
+						Code:    "OK",
+					},
+				},
+			},
+		},
+		{name: "SUCCESS - all the scopes of an admin",
+			ctx: adminCtx,
+			req: &v1.ListAuditEventsRequest{PageNum: 1, PageSize: 10, UserId: "user@test.com"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAudit(mockCtrl)
+				rep = mockRepo
+				mockRepo.EXPECT().ListEvents(gomock.Any(), &repo.EventFilter{
+					Scopes: []string{"s1", "s2"},
+					UserID: "user@test.com",
+					Limit:  10,
+				}).Times(1).Return(nil, int32(0), nil)
+			},
+			want: &v1.ListAuditEventsResponse{Events: []*v1.AuditEvent{}},
+		},
+		{name: "SUCCESS - super admin lists events without scope",
+			ctx: superAdminCtx,
+			req: &v1.ListAuditEventsRequest{PageNum: 1, PageSize: 10, Entity: "Account"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := mock.NewMockAudit(mockCtrl)
+				rep = mockRepo
+				mockRepo.EXPECT().ListEvents(gomock.Any(), &repo.EventFilter{
+					AllScopes: true,
+					Entity:    "Account",
+					Limit:     10,
+				}).Times(1).Return(nil, int32(0), nil)
+			},
+			want: &v1.ListAuditEventsResponse{Events: []*v1.AuditEvent{}},
+		},
+		{name: "FAILURE - scope of another user",
+			ctx: adminCtx,
+			req: &v1.ListAuditEventsRequest{PageNum: 1, PageSize: 10, Scopes: []string{"s3"}},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = mock.NewMockAudit(mockCtrl)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{name: "FAILURE - no claims",
+			ctx: context.Background(),
+			req: &v1.ListAuditEventsRequest{PageNum: 1, PageSize: 10},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = mock.NewMockAudit(mockCtrl)
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			s := NewAuditServiceServer(rep)
+			got, err := s.ListAuditEvents(tt.ctx, tt.req)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			if assert.Empty(t, err) {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -11,6 +11,14 @@ type AuthService interface {
 	// Login will return LoginResponse. Error if it is not able to fetch user,
 	// user does not exist or if user is blocked after three unsuccessful atemps.
 	Login(ctx context.Context, req *LoginRequest) (*LoginResponse, error)
+	// FederatedLogin provisions the account of a user authenticated by an identity provider
+	// and returns its LoginResponse. Error if none of user's groups gives access to optisam.
+	FederatedLogin(ctx context.Context, req *FederatedLoginRequest) (*LoginResponse, error)
+	// MFAChallenge returns the challenge of a user who logged in with its password, nil is
+	// returned if the user does not need a second factor.
+	MFAChallenge(ctx context.Context, userID, clientID, scope string) (*MFAChallengeResponse, error)
+	// VerifyMFA checks the second factor of the challenge and returns the LoginResponse of its user.
+	VerifyMFA(ctx context.Context, req *VerifyMFARequest) (*LoginResponse, error)
 	TokenValidation(ctx context.Context, req *TokenRequest) error
 	ChangePassword(ctx context.Context, req *ChangePasswordRequest) error
 	ForgotPassword(ctx context.Context, email string) error
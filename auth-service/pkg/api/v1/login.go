@@ -11,4 +11,36 @@ type LoginResponse struct {
 	UserID string
 	Entity string
 	Locale string
+	// ClientID and Scope are the ones the login was requested for
+	ClientID string
+	Scope    string
+}
+
+// FederatedLoginRequest is the identity of a user authenticated by an identity provider.
+type FederatedLoginRequest struct {
+	IdentityProvider string
+	Username         string
+	FirstName        string
+	LastName         string
+	Locale           string
+	Groups           []string
+}
+
+// MFAChallengeResponse is given instead of tokens to users who must verify their second factor.
+type MFAChallengeResponse struct {
+	// Token identifies the login waiting for the second factor
+	Token string
+	// ExpiresIn is the number of seconds user has to verify its second factor
+	ExpiresIn int
+	// EnrollmentRequired tells that user must enroll an authenticator before login
+	EnrollmentRequired bool
+	// EnrollmentToken is only accepted by account-service authenticator enrollment apis
+	EnrollmentToken string
+}
+
+// VerifyMFARequest is the second factor given for a login challenge.
+type VerifyMFARequest struct {
+	Token string
+	// Code is either a totp code or a recovery code
+	Code string
 }
@@ -49,6 +49,21 @@ func (mr *MockAuthServiceMockRecorder) ChangePassword(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthService)(nil).ChangePassword), arg0, arg1)
 }
 
+// FederatedLogin mocks base method.
+func (m *MockAuthService) FederatedLogin(arg0 context.Context, arg1 *v1.FederatedLoginRequest) (*v1.LoginResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FederatedLogin", arg0, arg1)
+	ret0, _ := ret[0].(*v1.LoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FederatedLogin indicates an expected call of FederatedLogin.
+func (mr *MockAuthServiceMockRecorder) FederatedLogin(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FederatedLogin", reflect.TypeOf((*MockAuthService)(nil).FederatedLogin), arg0, arg1)
+}
+
 // ForgotPassword mocks base method.
 func (m *MockAuthService) ForgotPassword(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -78,6 +93,21 @@ func (mr *MockAuthServiceMockRecorder) Login(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthService)(nil).Login), arg0, arg1)
 }
 
+// MFAChallenge mocks base method.
+func (m *MockAuthService) MFAChallenge(arg0 context.Context, arg1, arg2, arg3 string) (*v1.MFAChallengeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MFAChallenge", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*v1.MFAChallengeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MFAChallenge indicates an expected call of MFAChallenge.
+func (mr *MockAuthServiceMockRecorder) MFAChallenge(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFAChallenge", reflect.TypeOf((*MockAuthService)(nil).MFAChallenge), arg0, arg1, arg2, arg3)
+}
+
 // TokenValidation mocks base method.
 func (m *MockAuthService) TokenValidation(arg0 context.Context, arg1 *v1.TokenRequest) error {
 	m.ctrl.T.Helper()
@@ -91,3 +121,18 @@ func (mr *MockAuthServiceMockRecorder) TokenValidation(arg0, arg1 interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenValidation", reflect.TypeOf((*MockAuthService)(nil).TokenValidation), arg0, arg1)
 }
+
+// VerifyMFA mocks base method.
+func (m *MockAuthService) VerifyMFA(arg0 context.Context, arg1 *v1.VerifyMFARequest) (*v1.LoginResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyMFA", arg0, arg1)
+	ret0, _ := ret[0].(*v1.LoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyMFA indicates an expected call of VerifyMFA.
+func (mr *MockAuthServiceMockRecorder) VerifyMFA(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyMFA", reflect.TypeOf((*MockAuthService)(nil).VerifyMFA), arg0, arg1)
+}
@@ -36,4 +36,6 @@ type AccountInfo struct {
 	CreatedOn       time.Time
 	Group           []int64
 	GroupName       []string
+	// IdentityProvider is empty for local accounts
+	IdentityProvider string
 }
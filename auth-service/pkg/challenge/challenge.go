@@ -0,0 +1,144 @@
+// Package challenge keeps the password logins waiting for the second factor of the user.
+package challenge
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// keyPrefix is the redis key prefix under which pending challenges are kept.
+	keyPrefix = "MFAChallenge_"
+	// TTL is the time user has to give its second factor after a successful password login.
+	TTL = 5 * time.Minute
+	// MaxAttempts is the number of wrong codes after which the challenge is dropped
+	// and user must login with its password again.
+	MaxAttempts = 5
+	// MaxUserFailures is the number of wrong codes of all the challenges of a user after which
+	// the user is locked out for LockoutTTL, new challenges cannot be used to try more codes.
+	MaxUserFailures = 10
+	// LockoutTTL is the time wrong codes are counted for a user.
+	LockoutTTL = 15 * time.Minute
+
+	// failuresPrefix is the redis key prefix under which the wrong codes of users are counted.
+	failuresPrefix = "MFAFailures_"
+
+	fieldUserID   = "user_id"
+	fieldClientID = "client_id"
+	fieldScope    = "scope"
+	fieldAttempts = "attempts"
+)
+
+// ErrUnknownChallenge is returned when the challenge is unknown, expired or already used.
+var ErrUnknownChallenge = errors.New("unknown mfa challenge")
+
+// Challenge is a password login waiting for the second factor.
+type Challenge struct {
+	UserID string
+	// ClientID and Scope are the ones of the token request of the password login
+	ClientID string
+	Scope    string
+}
+
+// Store keeps pending challenges between the password login and the second factor verification.
+type Store interface {
+	// Save stores the challenge under its token until TTL.
+	Save(ctx context.Context, token string, c *Challenge) error
+	// Get returns the challenge, ErrUnknownChallenge is returned if there is no such challenge.
+	Get(ctx context.Context, token string) (*Challenge, error)
+	// Fail records a wrong code of the user, the challenge is deleted after MaxAttempts wrong codes.
+	Fail(ctx context.Context, token, userID string) error
+	// Locked reports whether the user gave MaxUserFailures wrong codes within LockoutTTL.
+	Locked(ctx context.Context, userID string) (bool, error)
+	// Delete deletes the challenge, a challenge can only be passed once.
+	Delete(ctx context.Context, token string) error
+}
+
+type redisStore struct {
+	r *redis.Client
+}
+
+// NewStore returns a redis backed Store.
+func NewStore(r *redis.Client) Store {
+	return &redisStore{r: r}
+}
+
+// Save implements Store Save function.
+func (s *redisStore) Save(ctx context.Context, token string, c *Challenge) error {
+	_, err := s.r.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, keyPrefix+token, fieldUserID, c.UserID, fieldClientID, c.ClientID, fieldScope, c.Scope, fieldAttempts, 0)
+		pipe.Expire(ctx, keyPrefix+token, TTL)
+		return nil
+	})
+	return err
+}
+
+// Get implements Store Get function.
+func (s *redisStore) Get(ctx context.Context, token string) (*Challenge, error) {
+	fields, err := s.r.HGetAll(ctx, keyPrefix+token).Result()
+	if err != nil {
+		return nil, err
+	}
+	userID, ok := fields[fieldUserID]
+	if !ok {
+		return nil, ErrUnknownChallenge
+	}
+	return &Challenge{UserID: userID, ClientID: fields[fieldClientID], Scope: fields[fieldScope]}, nil
+}
+
+// Fail implements Store Fail function.
+func (s *redisStore) Fail(ctx context.Context, token, userID string) error {
+	var attempts, failures *redis.IntCmd
+	var ttl *redis.DurationCmd
+	_, err := s.r.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		attempts = pipe.HIncrBy(ctx, keyPrefix+token, fieldAttempts, 1)
+		ttl = pipe.TTL(ctx, keyPrefix+token)
+		failures = pipe.Incr(ctx, failuresPrefix+userID)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if failures.Val() == 1 {
+		// wrong codes are counted from the first one
+		if err := s.r.Expire(ctx, failuresPrefix+userID, LockoutTTL).Err(); err != nil {
+			return err
+		}
+	}
+	// a challenge which expired meanwhile is recreated without expiry by the increment
+	if attempts.Val() >= MaxAttempts || ttl.Val() < 0 {
+		return s.Delete(ctx, token)
+	}
+	return nil
+}
+
+// Locked implements Store Locked function.
+func (s *redisStore) Locked(ctx context.Context, userID string) (bool, error) {
+	failures, err := s.r.Get(ctx, failuresPrefix+userID).Int()
+	if err == redis.Nil {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return failures >= MaxUserFailures, nil
+}
+
+// Delete implements Store Delete function.
+func (s *redisStore) Delete(ctx context.Context, token string) error {
+	return s.r.Del(ctx, keyPrefix+token).Err()
+}
+
+// New returns a new challenge for the user with its token.
+func New(userID, clientID, scope string) (string, *Challenge, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), &Challenge{UserID: userID, ClientID: clientID, Scope: scope}, nil
+}
@@ -9,14 +9,17 @@ import (
 	"os"
 	"time"
 
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/challenge"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/generators/access"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/server"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/stores/client"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/stores/token"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oidc"
 	repv1_postgres "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/repository/v1/postgres"
 
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/redis"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/generator"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/revocation"
 
 	redisClient "github.com/go-redis/redis/v8"
 
@@ -31,6 +34,7 @@ import (
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/buildinfo"
 	gconn "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/grpc"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/healthcheck"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/iam"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/jaeger"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
 	postgres "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/postgres"
@@ -292,11 +296,26 @@ func RunServer() error {
 		logger.Log.Fatal("cannot create token generator", zap.String("reason", err.Error()))
 	}
 
+	// verify key is needed to introspect and revoke access tokens
+	verifyKey, err := iam.GetVerifyKey(cfg.IAM)
+	if err != nil {
+		logger.Log.Fatal("cannot get verify key", zap.String("reason", err.Error()))
+	}
+
 	optisamDB := repv1_postgres.NewRepository(db, redisC)
 
-	service := v1.NewAuthServiceServer(optisamDB, cfg, grpcClientMap, p)
+	service := v1.NewAuthServiceServer(optisamDB, cfg, grpcClientMap, p, challenge.NewStore(redisC), generator)
 
-	oauth2Server := server.NewServer(token.NewStore(), client.NewStore(), access.NewGenerator(generator, service))
+	oauth2Server := server.NewServer(token.NewStore(redisC), client.NewStore(cfg.OAuth2.Clients), access.NewGenerator(generator, service))
+
+	// federated login
+	var oidcProvider *oidc.Provider
+	var oidcStates oidc.StateStore
+	if cfg.OIDC.Enabled {
+		oidcProvider = oidc.NewProvider(cfg.OIDC, &http.Client{Timeout: 10 * time.Second})
+		oidcStates = oidc.NewStateStore(redisC)
+		logger.Log.Info("federated login enabled", zap.String("issuer", cfg.OIDC.IssuerURL))
+	}
 
 	// server
 	logger.Log.Sugar().Infow("%s - grpc port,%s - http port", cfg.GRPCPort, cfg.HTTPPort)
@@ -305,5 +324,5 @@ func RunServer() error {
 			logger.Log.Sugar().Debug("Recovered in RunServer", r)
 		}
 	}()
-	return rest.RunServer(ctx, service, oauth2Server, cfg.HTTPPort, cfg)
+	return rest.RunServer(ctx, service, oauth2Server, cfg.HTTPPort, cfg, verifyKey, revocation.NewList(redisC), oidcProvider, oidcStates)
 }
@@ -12,9 +12,11 @@ import (
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/jaeger"
 	kafkaConnector "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/kafka"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/mfa"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/postgres"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/prometheus"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/redis"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
 
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -56,7 +58,62 @@ type Config struct {
 	Application     config.Application
 	IAM             iam.Config
 	Kafka           kafkaConnector.KafkaConfig
+	OAuth2          OAuth2Config
+	OIDC            OIDCConfig
+	// MFA tells which roles must give a second factor at password login, federated
+	// users give it to their identity provider
+	MFA mfa.Policy
 }
+
+// OAuth2Config holds the oauth2 server related configuration.
+type OAuth2Config struct {
+	// Clients are the registered service clients allowed to use client_credentials grant
+	Clients []OAuth2Client
+}
+
+// OAuth2Client is a registered service client.
+type OAuth2Client struct {
+	ID     string
+	Secret string
+	// Role given to tokens issued to this client, it is the maximum role client can act with
+	Role string
+	// Scopes client is allowed to request
+	Scopes []string
+}
+
+// OIDCConfig holds the configuration of the corporate identity provider users
+// can login with using OpenID Connect authorization code flow.
+type OIDCConfig struct {
+	Enabled bool
+	// Name of the identity provider, it is stored on the accounts it provisions
+	Name string
+	// IssuerURL is used for discovery and must match the iss claim of id tokens
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	// RedirectURL is the auth-service callback registered with the identity provider
+	RedirectURL string
+	// UIRedirectURL is where optisam UI receives the tokens after a successful login
+	UIRedirectURL string
+	// Scopes requested in addition to openid
+	Scopes []string
+	// UsernameClaim is the id token claim used as optisam username, email by default
+	UsernameClaim string
+	// GroupsClaim is the id token claim holding user's groups, groups by default
+	GroupsClaim string
+	// DefaultLocale of provisioned accounts
+	DefaultLocale string
+	// GroupMappings give optisam role and scopes to the members of identity provider groups
+	GroupMappings []OIDCGroupMapping
+}
+
+// OIDCGroupMapping maps an identity provider group to an optisam role and scopes.
+type OIDCGroupMapping struct {
+	Group  string
+	Role   string
+	Scopes []string
+}
+
 type HttpConfg struct {
 	Address map[string]string
 }
@@ -102,6 +159,64 @@ func (c Config) Validate() error {
 	if err := c.Kafka.Validate(); err != nil {
 		return err
 	}
+	if err := c.OAuth2.Validate(); err != nil {
+		return err
+	}
+	if err := c.OIDC.Validate(); err != nil {
+		return err
+	}
+	if err := c.MFA.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates the oauth2 configuration.
+func (c OAuth2Config) Validate() error {
+	ids := make(map[string]struct{}, len(c.Clients))
+	for _, cli := range c.Clients {
+		if cli.ID == "" || cli.Secret == "" {
+			return errors.New("oauth2 client id and secret are required")
+		}
+		if _, ok := ids[cli.ID]; ok {
+			return fmt.Errorf("oauth2 client %s is registered more than once", cli.ID)
+		}
+		ids[cli.ID] = struct{}{}
+		if _, ok := claims.ReturnRole(cli.Role); !ok {
+			return fmt.Errorf("oauth2 client %s has invalid role %s", cli.ID, cli.Role)
+		}
+		if len(cli.Scopes) == 0 {
+			return fmt.Errorf("oauth2 client %s has no scopes", cli.ID)
+		}
+	}
+	return nil
+}
+
+// Validate validates the oidc configuration.
+func (c OIDCConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Name == "" || c.IssuerURL == "" {
+		return errors.New("oidc name and issuer url are required")
+	}
+	if c.ClientID == "" || c.ClientSecret == "" {
+		return errors.New("oidc client id and secret are required")
+	}
+	if c.RedirectURL == "" || c.UIRedirectURL == "" {
+		return errors.New("oidc redirect url and ui redirect url are required")
+	}
+	if len(c.GroupMappings) == 0 {
+		return errors.New("oidc group mappings are required")
+	}
+	for _, m := range c.GroupMappings {
+		if m.Group == "" {
+			return errors.New("oidc group mapping group is required")
+		}
+		if _, ok := claims.ReturnRole(m.Role); !ok {
+			return fmt.Errorf("oidc group %s has invalid role %s", m.Group, m.Role)
+		}
+	}
 	return nil
 }
 
@@ -186,6 +301,13 @@ func Configure(v *viper.Viper, p *pflag.FlagSet) {
 	_ = v.BindEnv("kafka.sslcertificatelocation", "KAFKA_SSLCERTIFICATELOCATION")
 	_ = v.BindEnv("kafka.sslcalocation", "KAFKA_SSLCALOCATION")
 
+	v.SetDefault("oidc.usernameclaim", "email")
+	v.SetDefault("oidc.groupsclaim", "groups")
+	v.SetDefault("oidc.defaultlocale", "en")
+	_ = v.BindEnv("oidc.clientsecret", "OIDC_CLIENT_SECRET")
+
+	v.SetDefault("mfa.requiredroles", mfa.DefaultRequiredRoles)
+
 	_ = v.BindEnv("application.usernamesuperadmin", "APP_SUPER_ADMIN_USERNAME")
 	_ = v.BindEnv("application.passwordsuperadmin", "APP_SUPER_ADMIN_PASSWORD")
 }
@@ -16,6 +16,21 @@ const (
 
 	// ErrCodeLoginBlockedAccount user is trying to login into an already blocked account
 	ErrCodeLoginBlockedAccount = 3
+
+	// ErrCodeMFARequired user must give its second factor before tokens are issued
+	ErrCodeMFARequired = 4
+
+	// ErrCodeInvalidMFACode the code of the second factor is wrong or already used
+	ErrCodeInvalidMFACode = 5
+
+	// ErrCodeMFAChallengeExpired the login waiting for the second factor is unknown or expired
+	ErrCodeMFAChallengeExpired = 6
+
+	// ErrCodeMFANotEnrolled user has not enrolled its authenticator yet
+	ErrCodeMFANotEnrolled = 7
+
+	// ErrCodeMFALocked user gave too many wrong codes of the second factor
+	ErrCodeMFALocked = 8
 )
 
 var (
@@ -48,6 +63,56 @@ var (
 			StatusCode:  http.StatusUnauthorized,
 		},
 	}
+
+	// ErrMFARequired is sent with the mfa challenge instead of tokens
+	ErrMFARequired = &Error{
+		Response: &oauth2Errors.Response{
+			Error:       errors.New("mfa_required"),
+			ErrorCode:   ErrCodeMFARequired,
+			Description: "A second authentication factor is required",
+			StatusCode:  http.StatusUnauthorized,
+		},
+	}
+
+	// ErrInvalidMFACode when the code of the second factor is wrong or already used
+	ErrInvalidMFACode = &Error{
+		Response: &oauth2Errors.Response{
+			Error:       errors.New("invalid_mfa_code"),
+			ErrorCode:   ErrCodeInvalidMFACode,
+			Description: "Invalid authentication code",
+			StatusCode:  http.StatusUnauthorized,
+		},
+	}
+
+	// ErrMFAChallengeExpired when the login waiting for the second factor is unknown or expired
+	ErrMFAChallengeExpired = &Error{
+		Response: &oauth2Errors.Response{
+			Error:       errors.New("mfa_challenge_expired"),
+			ErrorCode:   ErrCodeMFAChallengeExpired,
+			Description: "Login has expired, please login again",
+			StatusCode:  http.StatusUnauthorized,
+		},
+	}
+
+	// ErrMFANotEnrolled when user verifies a second factor it has not enrolled yet
+	ErrMFANotEnrolled = &Error{
+		Response: &oauth2Errors.Response{
+			Error:       errors.New("mfa_not_enrolled"),
+			ErrorCode:   ErrCodeMFANotEnrolled,
+			Description: "Please enroll your authenticator first",
+			StatusCode:  http.StatusPreconditionFailed,
+		},
+	}
+
+	// ErrMFALocked when user gave too many wrong codes of the second factor recently
+	ErrMFALocked = &Error{
+		Response: &oauth2Errors.Response{
+			Error:       errors.New("mfa_locked"),
+			ErrorCode:   ErrCodeMFALocked,
+			Description: "Too many invalid authentication codes, please try again later",
+			StatusCode:  http.StatusTooManyRequests,
+		},
+	}
 )
 
 // Error for optisam oauth2 custiom errors
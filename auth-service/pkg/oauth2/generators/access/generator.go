@@ -2,8 +2,12 @@ package access
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"errors"
 
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/stores/client"
+
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
@@ -34,10 +38,9 @@ func NewGenerator(gen token.Generator, claimsFetcher ClaimsFetcher) oauth2.Acces
 }
 
 func (g *generator) Token(data *oauth2.GenerateBasic, isGenRefresh bool) (string, string, error) {
-	claims, err := g.claimsFetcher.UserClaims(context.Background(), data.UserID)
+	claims, err := g.claims(data)
 	if err != nil {
-		logger.Log.Error("oauth2/generators/access - Token", zap.Error(err))
-		return "", "", errors.New("cannot fetch claims for user")
+		return "", "", err
 	}
 
 	access, err := g.gen.GenerateAccessToken(claims)
@@ -49,10 +52,37 @@ func (g *generator) Token(data *oauth2.GenerateBasic, isGenRefresh bool) (string
 		return access, "", nil
 	}
 
-	refresh, err := g.gen.GenerateRefreshToken(claims)
+	// Refresh token is opaque, it is only meaningful to the token store.
+	refresh, err := newRefreshToken()
 	if err != nil {
 		return "", "", err
 	}
 
 	return access, refresh, nil
 }
+
+func (g *generator) claims(data *oauth2.GenerateBasic) (*claims.Claims, error) {
+	// client_credentials grant, token is issued to the client itself
+	if data.UserID == "" {
+		cli, ok := data.Client.(*client.Client)
+		if !ok {
+			logger.Log.Error("oauth2/generators/access - Token - client is not a registered service client")
+			return nil, errors.New("cannot fetch claims for client")
+		}
+		return cli.Claims(data.TokenInfo.GetScope()), nil
+	}
+	claims, err := g.claimsFetcher.UserClaims(context.Background(), data.UserID)
+	if err != nil {
+		logger.Log.Error("oauth2/generators/access - Token", zap.Error(err))
+		return nil, errors.New("cannot fetch claims for user")
+	}
+	return claims, nil
+}
+
+func newRefreshToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -1,8 +1,47 @@
 package handler
 
-import "net/http"
+import (
+	"net/http"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/stores/client"
+
+	"gopkg.in/oauth2.v3"
+)
 
 // ClientInfoHandler returns client info like id and secret.
+// Service clients send their credentials either with basic auth or as form values,
+// optisam UI does not send any and is identified by an empty client id.
 func ClientInfoHandler(r *http.Request) (clientID, clientSecret string, err error) {
-	return "", "", nil
+	if id, secret, ok := r.BasicAuth(); ok {
+		return id, secret, nil
+	}
+	return r.FormValue("client_id"), r.FormValue("client_secret"), nil
+}
+
+// ClientAuthorizedHandler restricts client_credentials grant to registered service clients
+// and the user grants to optisam UI.
+func ClientAuthorizedHandler(clientID string, grant oauth2.GrantType) (bool, error) {
+	if clientID == "" {
+		return grant == oauth2.PasswordCredentials || grant == oauth2.Refreshing, nil
+	}
+	return grant == oauth2.ClientCredentials, nil
+}
+
+// ClientScopeHandler returns a handler checking that requested scopes are registered for the client.
+func ClientScopeHandler(store oauth2.ClientStore) func(clientID, scope string) (bool, error) {
+	return func(clientID, scope string) (bool, error) {
+		// user scopes are resolved from user groups at token generation
+		if clientID == "" {
+			return true, nil
+		}
+		info, err := store.GetByID(clientID)
+		if err != nil {
+			return false, err
+		}
+		cli, ok := info.(*client.Client)
+		if !ok {
+			return false, nil
+		}
+		return cli.AllowsScope(scope), nil
+	}
 }
@@ -18,11 +18,22 @@ func NewServer(tokenStore oauth2.TokenStore, clientStore oauth2.ClientStore, acc
 
 	// Set the config for password token
 	manager.SetPasswordTokenCfg(&manage.Config{AccessTokenExp: time.Hour * 2,
-		// TODO: Verify this duration when we start supporting refresh grant.
 		RefreshTokenExp:   time.Hour * 24 * 7,
 		IsGenerateRefresh: true,
 	})
 
+	// Refresh token is rotated on every use, its lifetime is not extended so
+	// that a session ends at most one week after the user's login.
+	manager.SetRefreshTokenCfg(&manage.RefreshingConfig{
+		IsGenerateRefresh:  true,
+		IsRemoveAccess:     true,
+		IsRemoveRefreshing: true,
+	})
+
+	// Service clients don't get any refresh token, they can request a new one
+	// with their credentials.
+	manager.SetClientTokenCfg(&manage.Config{AccessTokenExp: time.Hour * 2})
+
 	// Inject custom token store
 	manager.MapTokenStorage(tokenStore)
 
@@ -34,9 +45,7 @@ func NewServer(tokenStore oauth2.TokenStore, clientStore oauth2.ClientStore, acc
 
 	srv := server.NewServer(server.NewConfig(), manager)
 
-	// AllowedGrantType are only PasswordCredentials as we are supporting
-	// only this grant currently
-	srv.SetAllowedGrantType(oauth2.PasswordCredentials)
+	srv.SetAllowedGrantType(oauth2.PasswordCredentials, oauth2.Refreshing, oauth2.ClientCredentials)
 
 	srv.SetInternalErrorHandler(func(err error) *errors.Response {
 		switch er := err.(type) {
@@ -48,9 +57,11 @@ func NewServer(tokenStore oauth2.TokenStore, clientStore oauth2.ClientStore, acc
 	})
 
 	// Set custom client info handler. We want to inject this because framework
-	// will try to get the client id and secret from basic auth by default. We are not
-	// supporting client id and secret currently so we need our custom handler to bypass
-	// default client info handler of framework.
+	// will try to get the client id and secret from basic auth by default and fail
+	// if they are missing. Optisam UI does not send any client credentials, only
+	// registered service clients do.
 	srv.SetClientInfoHandler(server.ClientInfoHandler(oauth2Handlers.ClientInfoHandler))
+	srv.SetClientAuthorizedHandler(server.ClientAuthorizedHandler(oauth2Handlers.ClientAuthorizedHandler))
+	srv.SetClientScopeHandler(server.ClientScopeHandler(oauth2Handlers.ClientScopeHandler(clientStore)))
 	return srv
 }
@@ -1,20 +1,93 @@
 package client
 
 import (
+	"strings"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/config"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+
 	"gopkg.in/oauth2.v3"
+	"gopkg.in/oauth2.v3/errors"
 	"gopkg.in/oauth2.v3/models"
 )
 
+// Client is a registered service client which can get tokens without any user
+// using the client_credentials grant.
+type Client struct {
+	models.Client
+	Role   claims.Role
+	Scopes []string
+}
+
+// AllowsScope checks if all the space separated scopes are allowed for the client.
+func (c *Client) AllowsScope(scope string) bool {
+	for _, s := range strings.Fields(scope) {
+		if !contains(c.Scopes, s) {
+			return false
+		}
+	}
+	return true
+}
+
+// Claims returns the claims carried by the token issued to the client for
+// the requested scopes, all the client scopes are given if scope is empty.
+func (c *Client) Claims(scope string) *claims.Claims {
+	scopes := strings.Fields(scope)
+	if len(scopes) == 0 {
+		scopes = c.Scopes
+	}
+	return &claims.Claims{
+		UserID: c.ID,
+		Role:   c.Role,
+		Socpes: scopes,
+	}
+}
+
 //go:generate mockgen -destination=mock/mock.go -package=mock gopkg.in/oauth2.v3 ClientStore
 type store struct {
+	clients map[string]*Client
 }
 
 // NewStore returns oauth2.ClientStore
-func NewStore() oauth2.ClientStore {
-	return &store{}
+func NewStore(clients []config.OAuth2Client) oauth2.ClientStore {
+	s := &store{
+		clients: make(map[string]*Client, len(clients)),
+	}
+	for _, cli := range clients {
+		// roles are already validated with configuration
+		role, _ := claims.ReturnRole(cli.Role)
+		s.clients[cli.ID] = &Client{
+			Client: models.Client{
+				ID:     cli.ID,
+				Secret: cli.Secret,
+				UserID: cli.ID,
+			},
+			Role:   role,
+			Scopes: cli.Scopes,
+		}
+	}
+	return s
 }
 
 // GetByID implements oauth2.ClientStore GetByID function
 func (s *store) GetByID(id string) (oauth2.ClientInfo, error) {
-	return &models.Client{}, nil
+	// Empty id is the optisam UI which does not authenticate as a client.
+	if id == "" {
+		return &models.Client{}, nil
+	}
+	cli, ok := s.clients[id]
+	if !ok {
+		return nil, errors.ErrInvalidClient
+	}
+	return cli, nil
+}
+
+func contains(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
 }
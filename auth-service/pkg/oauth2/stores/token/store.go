@@ -1,24 +1,61 @@
 package token
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"time"
 
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/revocation"
+
+	"github.com/go-redis/redis/v8"
 	"gopkg.in/oauth2.v3"
+	"gopkg.in/oauth2.v3/models"
+)
+
+const (
+	// refreshKeyPrefix is the redis key prefix under which refresh token
+	// information is kept.
+	refreshKeyPrefix = "OAuth2Refresh_"
 )
 
 //go:generate mockgen -destination=mock/mock.go -package=mock gopkg.in/oauth2.v3 TokenStore
-type store struct{}
+type store struct {
+	r       *redis.Client
+	revoked revocation.List
+}
 
 // NewStore returns a custom implementation of oauth2.TokenStore
-func NewStore() oauth2.TokenStore {
-	return &store{}
+// Access tokens are self contained JWTs so only refresh tokens are kept in redis.
+func NewStore(r *redis.Client) oauth2.TokenStore {
+	return &store{
+		r:       r,
+		revoked: revocation.NewList(r),
+	}
 }
 
 // Create implements gopkg.in/oauth2 create function.
 func (s *store) Create(info oauth2.TokenInfo) error {
-	// We returning nil as the framework that we are using expects us to
-	// store token in database or some other storage type.
-	return nil
+	// Access tokens are not stored, we only need to keep refresh tokens
+	// so that they can be exchanged for a new access token later.
+	refresh := info.GetRefresh()
+	if refresh == "" {
+		return nil
+	}
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	ttl := time.Duration(0)
+	if exp := info.GetRefreshExpiresIn(); exp > 0 {
+		ttl = time.Until(info.GetRefreshCreateAt().Add(exp))
+		if ttl <= 0 {
+			return errors.New("refresh token is already expired")
+		}
+	}
+	return s.r.Set(context.Background(), refreshKey(refresh), data, ttl).Err()
 }
 
 // RemoveByCode implements gopkg.in/oauth2 RemoveByCode function.
@@ -30,16 +67,13 @@ func (s *store) RemoveByCode(code string) error {
 
 // RemoveByAccess implements gopkg.in/oauth2 RemoveByAccess function
 func (s *store) RemoveByAccess(access string) error {
-	// We returning nil as the framework that we are using expects us to
-	// remove token from database or some other storage type.
+	// We returning nil as access tokens are not stored.
 	return nil
 }
 
 // RemoveByRefresh implements gopkg.in/oauth2 RemoveByRefresh function
 func (s *store) RemoveByRefresh(refresh string) error {
-	// We returning nil as the framework that we are using expects us to
-	// remove token from database or some other storage type.
-	return nil
+	return s.r.Del(context.Background(), refreshKey(refresh)).Err()
 }
 
 // GetByCode implements gopkg.in/oauth2 GetByCode function
@@ -54,5 +88,31 @@ func (s *store) GetByAccess(access string) (oauth2.TokenInfo, error) {
 
 // GetByRefresh implements gopkg.in/oauth2 GetByRefresh function
 func (s *store) GetByRefresh(refresh string) (oauth2.TokenInfo, error) {
-	return nil, errors.New("not supported")
+	data, err := s.r.Get(context.Background(), refreshKey(refresh)).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			// framework treats nil token info as an invalid refresh token
+			return nil, nil
+		}
+		return nil, err
+	}
+	info := models.NewToken()
+	if err := json.Unmarshal(data, info); err != nil {
+		return nil, err
+	}
+	// refresh tokens of a session started before user's tokens were revoked are not valid anymore
+	revoked, err := s.revoked.IsRevoked(context.Background(), "", info.GetUserID(), info.GetRefreshCreateAt().UnixMilli())
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, s.RemoveByRefresh(refresh)
+	}
+	return info, nil
+}
+
+// refreshKey hashes the refresh token so that raw tokens are never used as keys.
+func refreshKey(refresh string) string {
+	sum := sha256.Sum256([]byte(refresh))
+	return refreshKeyPrefix + hex.EncodeToString(sum[:])
 }
@@ -0,0 +1,281 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/config"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+var (
+	// ErrInvalidIDToken is returned when the id token is not issued for optisam by the identity provider.
+	ErrInvalidIDToken = errors.New("invalid id token")
+	// ErrMissingUsername is returned when the id token does not carry the username claim.
+	ErrMissingUsername = errors.New("id token has no username")
+)
+
+// Identity is the user authenticated by the identity provider.
+type Identity struct {
+	Subject   string
+	Username  string
+	FirstName string
+	LastName  string
+	Locale    string
+	Groups    []string
+}
+
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type jwks struct {
+	Keys []struct {
+		Kid string `json:"kid"`
+		Kty string `json:"kty"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+	} `json:"keys"`
+}
+
+type tokenResponse struct {
+	IDToken string `json:"id_token"`
+}
+
+// Provider is an OpenID Connect identity provider using authorization code flow.
+// Provider metadata is discovered on first use and signing keys are fetched again
+// when an unknown key id is seen, so that key rotations are handled.
+type Provider struct {
+	cfg    config.OIDCConfig
+	client *http.Client
+
+	mu   sync.Mutex
+	meta *discovery
+	keys map[string]*rsa.PublicKey
+}
+
+// NewProvider returns the identity provider described by the configuration.
+func NewProvider(cfg config.OIDCConfig, client *http.Client) *Provider {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Provider{
+		cfg:    cfg,
+		client: client,
+		keys:   make(map[string]*rsa.PublicKey),
+	}
+}
+
+// AuthCodeURL returns the identity provider url the user is redirected to for login.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	challenge := sha256.Sum256([]byte(codeVerifier))
+	q := url.Values{}
+	q.Set("response_type", "code")
+	q.Set("client_id", p.cfg.ClientID)
+	q.Set("redirect_uri", p.cfg.RedirectURL)
+	q.Set("scope", strings.Join(append([]string{"openid"}, p.cfg.Scopes...), " "))
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:]))
+	q.Set("code_challenge_method", "S256")
+	sep := "?"
+	if strings.Contains(meta.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return meta.AuthorizationEndpoint + sep + q.Encode(), nil
+}
+
+// Exchange redeems the authorization code and returns the identity carried by the id token.
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*Identity, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.cfg.RedirectURL)
+	form.Set("code_verifier", codeVerifier)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	tr := &tokenResponse{}
+	if err := p.do(req, tr); err != nil {
+		return nil, fmt.Errorf("oidc - Exchange - %v", err)
+	}
+	if tr.IDToken == "" {
+		return nil, ErrInvalidIDToken
+	}
+	return p.verify(ctx, tr.IDToken, nonce)
+}
+
+// verify checks the signature, issuer, audience, expiry and nonce of the id token.
+func (p *Provider) verify(ctx context.Context, raw, nonce string) (*Identity, error) {
+	clms := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(raw, clms, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+		kid, _ := t.Header["kid"].(string)
+		return p.key(ctx, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+	if !clms.VerifyIssuer(p.cfg.IssuerURL, true) || !hasAudience(clms["aud"], p.cfg.ClientID) {
+		return nil, fmt.Errorf("%w: issuer or audience mismatch", ErrInvalidIDToken)
+	}
+	if n, _ := clms["nonce"].(string); n == "" || n != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+	id := &Identity{
+		Subject:   stringClaim(clms, "sub"),
+		Username:  stringClaim(clms, p.cfg.UsernameClaim),
+		FirstName: stringClaim(clms, "given_name"),
+		LastName:  stringClaim(clms, "family_name"),
+		Locale:    stringClaim(clms, "locale"),
+		Groups:    stringsClaim(clms, p.cfg.GroupsClaim),
+	}
+	if id.Username == "" {
+		return nil, ErrMissingUsername
+	}
+	return id, nil
+}
+
+func (p *Provider) discover(ctx context.Context) (*discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.meta != nil {
+		return p.meta, nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(p.cfg.IssuerURL, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	meta := &discovery{}
+	if err := p.do(req, meta); err != nil {
+		return nil, fmt.Errorf("oidc - discover - %v", err)
+	}
+	if meta.Issuer != p.cfg.IssuerURL {
+		return nil, fmt.Errorf("oidc - discover - issuer %s does not match configured issuer %s", meta.Issuer, p.cfg.IssuerURL)
+	}
+	p.meta = meta
+	return meta, nil
+}
+
+func (p *Provider) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if k, ok := p.keys[kid]; ok {
+		return k, nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, meta.JWKSURI, nil)
+	if err != nil {
+		return nil, err
+	}
+	set := &jwks{}
+	if err := p.do(req, set); err != nil {
+		return nil, fmt.Errorf("oidc - keys - %v", err)
+	}
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			continue
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	p.keys = keys
+	k, ok := keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return k, nil
+}
+
+func (p *Provider) do(req *http.Request, v interface{}) error {
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %d: %s", req.URL.Path, resp.StatusCode, body)
+	}
+	return json.Unmarshal(body, v)
+}
+
+func hasAudience(aud interface{}, clientID string) bool {
+	switch a := aud.(type) {
+	case string:
+		return a == clientID
+	case []interface{}:
+		for _, v := range a {
+			if s, ok := v.(string); ok && s == clientID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func stringClaim(clms jwt.MapClaims, name string) string {
+	s, _ := clms[name].(string)
+	return s
+}
+
+func stringsClaim(clms jwt.MapClaims, name string) []string {
+	switch v := clms[name].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var values []string
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/config"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oidc/oidctest"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+)
+
+const redirectURL = "http://localhost:9097/api/v1/oidc/callback"
+
+func newTestProvider(idp *oidctest.IdP, clientID string) *Provider {
+	return NewProvider(config.OIDCConfig{
+		Name:          "test",
+		IssuerURL:     idp.Issuer(),
+		ClientID:      clientID,
+		ClientSecret:  idp.ClientSecret,
+		RedirectURL:   redirectURL,
+		Scopes:        []string{"email", "groups"},
+		UsernameClaim: "email",
+		GroupsClaim:   "groups",
+	}, nil)
+}
+
+// authorize follows the login flow until the identity provider redirects back with a code.
+func authorize(t *testing.T, p *Provider, login *Login, state string) string {
+	authURL, err := p.AuthCodeURL(context.Background(), state, login.Nonce, login.CodeVerifier)
+	if !assert.Empty(t, err) {
+		return ""
+	}
+	client := &http.Client{CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	resp, err := client.Get(authURL)
+	if !assert.Empty(t, err) {
+		return ""
+	}
+	defer resp.Body.Close()
+	loc, err := url.Parse(resp.Header.Get("Location"))
+	if !assert.Empty(t, err) {
+		return ""
+	}
+	assert.Equal(t, state, loc.Query().Get("state"))
+	return loc.Query().Get("code")
+}
+
+func TestProvider_Exchange(t *testing.T) {
+	idp, err := oidctest.NewIdP("optisam", "secret", nil)
+	if !assert.Empty(t, err) {
+		return
+	}
+	defer idp.Close()
+	tests := []struct {
+		name     string
+		clientID string
+		claims   map[string]interface{}
+		nonce    string
+		verifier string
+		want     *Identity
+		wantErr  error
+	}{
+		{name: "SUCCESS",
+			clientID: "optisam",
+			claims: map[string]interface{}{
+				"sub":         "1234",
+				"email":       "user@test.com",
+				"given_name":  "first",
+				"family_name": "last",
+				"groups":      []string{"admins", "users"},
+			},
+			want: &Identity{
+				Subject:   "1234",
+				Username:  "user@test.com",
+				FirstName: "first",
+				LastName:  "last",
+				Groups:    []string{"admins", "users"},
+			},
+		},
+		{name: "SUCCESS - single group",
+			clientID: "optisam",
+			claims: map[string]interface{}{
+				"email":  "user@test.com",
+				"groups": "admins",
+			},
+			want: &Identity{
+				Username: "user@test.com",
+				Groups:   []string{"admins"},
+			},
+		},
+		{name: "FAILURE - no username",
+			clientID: "optisam",
+			claims:   map[string]interface{}{"sub": "1234"},
+			wantErr:  ErrMissingUsername,
+		},
+		{name: "FAILURE - nonce mismatch",
+			clientID: "optisam",
+			claims:   map[string]interface{}{"email": "user@test.com"},
+			nonce:    "other",
+			wantErr:  ErrInvalidIDToken,
+		},
+		{name: "FAILURE - code verifier mismatch",
+			clientID: "optisam",
+			claims:   map[string]interface{}{"email": "user@test.com"},
+			verifier: "other",
+			wantErr:  errors.New("invalid_grant"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp.Claims = tt.claims
+			p := newTestProvider(idp, tt.clientID)
+			state, login, err := NewLogin()
+			if !assert.Empty(t, err) {
+				return
+			}
+			code := authorize(t, p, login, state)
+			nonce, verifier := login.Nonce, login.CodeVerifier
+			if tt.nonce != "" {
+				nonce = tt.nonce
+			}
+			if tt.verifier != "" {
+				verifier = tt.verifier
+			}
+			got, err := p.Exchange(context.Background(), code, verifier, nonce)
+			if tt.wantErr != nil {
+				if assert.Error(t, err) && !errors.Is(err, tt.wantErr) {
+					assert.Contains(t, err.Error(), tt.wantErr.Error())
+				}
+				return
+			}
+			if assert.Empty(t, err) {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestProvider_verify(t *testing.T) {
+	idp, err := oidctest.NewIdP("optisam", "secret", nil)
+	if !assert.Empty(t, err) {
+		return
+	}
+	defer idp.Close()
+	p := newTestProvider(idp, "optisam")
+	valid := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":   idp.Issuer(),
+			"aud":   []string{"other", "optisam"},
+			"exp":   time.Now().Add(time.Minute).Unix(),
+			"nonce": "nonce",
+			"email": "user@test.com",
+		}
+	}
+	tests := []struct {
+		name    string
+		modify  func(jwt.MapClaims)
+		wantErr bool
+	}{
+		{name: "SUCCESS - audience list",
+			modify: func(jwt.MapClaims) {},
+		},
+		{name: "FAILURE - expired",
+			modify: func(c jwt.MapClaims) {
+				c["exp"] = time.Now().Add(-time.Minute).Unix()
+			},
+			wantErr: true,
+		},
+		{name: "FAILURE - other audience",
+			modify: func(c jwt.MapClaims) {
+				c["aud"] = "other"
+			},
+			wantErr: true,
+		},
+		{name: "FAILURE - other issuer",
+			modify: func(c jwt.MapClaims) {
+				c["iss"] = "http://evil.com"
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clms := valid()
+			tt.modify(clms)
+			raw, err := idp.SignIDToken(clms)
+			if !assert.Empty(t, err) {
+				return
+			}
+			_, err = p.verify(context.Background(), raw, "nonce")
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidIDToken))
+				return
+			}
+			assert.Empty(t, err)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// stateKeyPrefix is the redis key prefix under which pending logins are kept.
+	stateKeyPrefix = "OIDCState_"
+	// StateTTL is the time user has to login with the identity provider.
+	StateTTL = 10 * time.Minute
+)
+
+// ErrUnknownState is returned when the login state is unknown, expired or already used.
+var ErrUnknownState = errors.New("unknown login state")
+
+// Login is a pending login waiting for the identity provider callback.
+type Login struct {
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+}
+
+// StateStore keeps pending logins between the redirection to the identity provider and its callback.
+type StateStore interface {
+	// Save stores a pending login under the state sent to the identity provider.
+	Save(ctx context.Context, state string, login *Login) error
+	// Take returns and removes the pending login, a state can only be used once.
+	Take(ctx context.Context, state string) (*Login, error)
+}
+
+type redisStateStore struct {
+	r *redis.Client
+}
+
+// NewStateStore returns a redis backed StateStore.
+func NewStateStore(r *redis.Client) StateStore {
+	return &redisStateStore{r: r}
+}
+
+// Save implements StateStore Save function.
+func (s *redisStateStore) Save(ctx context.Context, state string, login *Login) error {
+	data, err := json.Marshal(login)
+	if err != nil {
+		return err
+	}
+	return s.r.Set(ctx, stateKeyPrefix+state, data, StateTTL).Err()
+}
+
+// Take implements StateStore Take function.
+func (s *redisStateStore) Take(ctx context.Context, state string) (*Login, error) {
+	var get *redis.StringCmd
+	_, err := s.r.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		get = pipe.Get(ctx, stateKeyPrefix+state)
+		pipe.Del(ctx, stateKeyPrefix+state)
+		return nil
+	})
+	if err == redis.Nil {
+		return nil, ErrUnknownState
+	}
+	if err != nil {
+		return nil, err
+	}
+	data, err := get.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	login := &Login{}
+	if err := json.Unmarshal(data, login); err != nil {
+		return nil, err
+	}
+	return login, nil
+}
+
+// NewLogin returns a new pending login with its state.
+func NewLogin() (string, *Login, error) {
+	state, err := randomString()
+	if err != nil {
+		return "", nil, err
+	}
+	nonce, err := randomString()
+	if err != nil {
+		return "", nil, err
+	}
+	verifier, err := randomString()
+	if err != nil {
+		return "", nil, err
+	}
+	return state, &Login{Nonce: nonce, CodeVerifier: verifier}, nil
+}
+
+func randomString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -2,13 +2,16 @@ package rest
 
 import (
 	"context"
+	"crypto/rsa"
 	"encoding/json"
 	"net/http"
 
 	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/api/v1"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/config"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oidc"
 
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/revocation"
 
 	"go.uber.org/zap"
 	"gopkg.in/oauth2.v3/server"
@@ -18,9 +21,14 @@ type handler struct {
 	service      v1.AuthService
 	oauth2Server *server.Server
 	cfg          config.Config
+	verifyKey    *rsa.PublicKey
+	revoked      revocation.List
+	// oidcProvider and oidcStates are nil when federated login is disabled
+	oidcProvider *oidc.Provider
+	oidcStates   oidc.StateStore
 }
 
-func newHandler(service v1.AuthService, srv *server.Server, cfg config.Config) *handler {
+func newHandler(service v1.AuthService, srv *server.Server, cfg config.Config, verifyKey *rsa.PublicKey, revoked revocation.List, provider *oidc.Provider, states oidc.StateStore) *handler {
 	// In PasswordCredentials framework relies on us for validating user's credential so
 	// we inject our custom handler for verifying the identity of user.
 	srv.SetPasswordAuthorizationHandler(func(username, password string) (string, error) {
@@ -39,6 +47,10 @@ func newHandler(service v1.AuthService, srv *server.Server, cfg config.Config) *
 		service:      service,
 		oauth2Server: srv,
 		cfg:          cfg,
+		verifyKey:    verifyKey,
+		revoked:      revoked,
+		oidcProvider: provider,
+		oidcStates:   states,
 	}
 }
 
@@ -0,0 +1,87 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/errors"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+	"gopkg.in/oauth2.v3"
+)
+
+// handleTokenRequest is oauth2 server HandleTokenRequest, except that users who must give
+// a second factor get an mfa challenge instead of the tokens of the password grant.
+func (h *handler) handleTokenRequest(w http.ResponseWriter, r *http.Request) error {
+	gt, tgr, err := h.oauth2Server.ValidationTokenRequest(r)
+	if err != nil {
+		return h.tokenError(w, err)
+	}
+	if gt == oauth2.PasswordCredentials {
+		c, err := h.service.MFAChallenge(r.Context(), tgr.UserID, tgr.ClientID, tgr.Scope)
+		if err != nil {
+			logger.Log.Error("auth/handler - token - MFAChallenge", zap.Error(err))
+			return h.tokenError(w, err)
+		}
+		if c != nil {
+			data, statusCode, header := h.oauth2Server.GetErrorData(errors.ErrMFARequired)
+			data["mfa_token"] = c.Token
+			data["expires_in"] = c.ExpiresIn
+			if c.EnrollmentRequired {
+				data["mfa_enrollment_required"] = true
+				data["mfa_enrollment_token"] = c.EnrollmentToken
+			}
+			return writeToken(w, data, header, statusCode)
+		}
+	}
+	ti, err := h.oauth2Server.GetAccessToken(gt, tgr)
+	if err != nil {
+		return h.tokenError(w, err)
+	}
+	return writeToken(w, h.oauth2Server.GetTokenData(ti), nil, http.StatusOK)
+}
+
+// mfaVerify issues the tokens of a password login once its second factor is verified.
+func (h *handler) mfaVerify(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	resp, err := h.service.VerifyMFA(r.Context(), &v1.VerifyMFARequest{
+		Token: r.FormValue("mfa_token"),
+		Code:  r.FormValue("code"),
+	})
+	if err != nil {
+		_ = h.tokenError(w, err)
+		return
+	}
+	ti, err := h.oauth2Server.Manager.GenerateAccessToken(oauth2.PasswordCredentials, &oauth2.TokenGenerateRequest{
+		ClientID: resp.ClientID,
+		UserID:   resp.UserID,
+		Scope:    resp.Scope,
+		Request:  r,
+	})
+	if err != nil {
+		logger.Log.Error("auth/handler - mfaVerify - GenerateAccessToken", zap.Error(err))
+		_ = h.tokenError(w, err)
+		return
+	}
+	_ = writeToken(w, h.oauth2Server.GetTokenData(ti), nil, http.StatusOK)
+}
+
+func (h *handler) tokenError(w http.ResponseWriter, err error) error {
+	data, statusCode, header := h.oauth2Server.GetErrorData(err)
+	return writeToken(w, data, header, statusCode)
+}
+
+// writeToken writes token endpoint responses the way oauth2 server does.
+func writeToken(w http.ResponseWriter, data map[string]interface{}, header http.Header, statusCode int) error {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	for key := range header {
+		w.Header().Set(key, header.Get(key))
+	}
+	w.WriteHeader(statusCode)
+	return json.NewEncoder(w).Encode(data)
+}
@@ -0,0 +1,100 @@
+package rest
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/api/v1"
+	mock_authService "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/api/v1/mock"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/config"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/errors"
+	mock_acctok "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/generators/access/mock"
+	optisam_oauth2Server "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/server"
+	mock_clientstore "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/stores/client/mock"
+	mock_tokenstore "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/stores/token/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/oauth2.v3/models"
+	"gopkg.in/oauth2.v3/server"
+)
+
+func Test_handler_mfaVerify(t *testing.T) {
+	var service v1.AuthService
+	var srv *server.Server
+	var mockCtrl *gomock.Controller
+	tests := []struct {
+		name   string
+		setup  func()
+		status int
+		want   string
+	}{
+		{name: "SUCCESS",
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockService := mock_authService.NewMockAuthService(mockCtrl)
+				mockService.EXPECT().VerifyMFA(gomock.Any(), &v1.VerifyMFARequest{
+					Token: "challenge",
+					Code:  "123456",
+				}).Return(&v1.LoginResponse{UserID: "admin", ClientID: "optisam", Scope: "OFR"}, nil).Times(1)
+				service = mockService
+
+				mockClientStore := mock_clientstore.NewMockClientStore(mockCtrl)
+				// tokens are issued to the client of the password login
+				mockClientStore.EXPECT().GetByID("optisam").Return(&models.Client{ID: "optisam"}, nil).Times(1)
+				mockAccTokGen := mock_acctok.NewMockAccessGenerate(mockCtrl)
+				mockAccTokGen.EXPECT().Token(gomock.Any(), true).Return("access", "refresh", nil).Times(1)
+				mockTokenStore := mock_tokenstore.NewMockTokenStore(mockCtrl)
+				mockTokenStore.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+				srv = optisam_oauth2Server.NewServer(mockTokenStore, mockClientStore, mockAccTokGen)
+			},
+			status: http.StatusOK,
+			want:   `{"access_token":"access","expires_in":7200,"refresh_token":"refresh","scope":"OFR","token_type":"Bearer"}`,
+		},
+		{name: "FAILURE - invalid code",
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockService := mock_authService.NewMockAuthService(mockCtrl)
+				mockService.EXPECT().VerifyMFA(gomock.Any(), gomock.Any()).Return(nil, errors.ErrInvalidMFACode).Times(1)
+				service = mockService
+				srv = optisam_oauth2Server.NewServer(mock_tokenstore.NewMockTokenStore(mockCtrl), mock_clientstore.NewMockClientStore(mockCtrl), mock_acctok.NewMockAccessGenerate(mockCtrl))
+			},
+			status: http.StatusUnauthorized,
+			want:   `{"error":"invalid_mfa_code","error_code":5,"error_description":"Invalid authentication code"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			handler := newHandler(service, srv, config.Config{}, nil, nil, nil, nil)
+			router := httprouter.New()
+			router.POST("/api/v1/mfa/verify", handler.mfaVerify)
+			tServer := httptest.NewServer(router)
+			defer tServer.Close()
+			req, err := formRequest(tServer.URL+"/api/v1/mfa/verify", url.Values{
+				"mfa_token": {"challenge"},
+				"code":      {"123456"},
+			})
+			if !assert.Empty(t, err) {
+				return
+			}
+			resp, err := tServer.Client().Do(req)
+			if !assert.Empty(t, err) {
+				return
+			}
+			defer resp.Body.Close()
+			assert.Equal(t, tt.status, resp.StatusCode)
+			data, err := ioutil.ReadAll(resp.Body)
+			if !assert.Empty(t, err) {
+				return
+			}
+			assert.Equal(t, tt.want, string(bytes.TrimSpace(data)))
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package rest
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oidc"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/oauth2.v3"
+)
+
+const (
+	oidcErrAccessDenied = "access_denied"
+	oidcErrInvalid      = "invalid_request"
+	oidcErrServer       = "server_error"
+
+	// oidcStateCookie ties the login state to the browser which started the login, it holds a hash of the state
+	oidcStateCookie  = "optisam_oidc_state"
+	oidcCallbackPath = "/api/v1/oidc/callback"
+)
+
+// oidcLogin redirects the user to the identity provider login page.
+func (h *handler) oidcLogin(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	state, login, err := oidc.NewLogin()
+	if err != nil {
+		logger.Log.Error("auth/handler - oidcLogin - NewLogin", zap.Error(err))
+		h.oidcRedirectError(w, r, oidcErrServer, "cannot start login")
+		return
+	}
+	if err := h.oidcStates.Save(r.Context(), state, login); err != nil {
+		logger.Log.Error("auth/handler - oidcLogin - Save", zap.Error(err))
+		h.oidcRedirectError(w, r, oidcErrServer, "cannot start login")
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    stateHash(state),
+		Path:     oidcCallbackPath,
+		MaxAge:   int(oidc.StateTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	authURL, err := h.oidcProvider.AuthCodeURL(r.Context(), state, login.Nonce, login.CodeVerifier)
+	if err != nil {
+		logger.Log.Error("auth/handler - oidcLogin - AuthCodeURL", zap.Error(err))
+		h.oidcRedirectError(w, r, oidcErrServer, "identity provider is unavailable")
+		return
+	}
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+// oidcCallback receives the authorization code from the identity provider, provisions
+// the user account and redirects optisam UI with the optisam tokens.
+func (h *handler) oidcCallback(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	q := r.URL.Query()
+	if idpErr := q.Get("error"); idpErr != "" {
+		logger.Log.Info("auth/handler - oidcCallback - identity provider error", zap.String("error", idpErr), zap.String("description", q.Get("error_description")))
+		h.oidcRedirectError(w, r, oidcErrAccessDenied, "login refused by identity provider")
+		return
+	}
+	// the callback must come back to the browser which started the login, else anyone could
+	// log the user in with the account of the sender of the callback link.
+	cookie, err := r.Cookie(oidcStateCookie)
+	if err != nil || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(stateHash(q.Get("state")))) != 1 {
+		logger.Log.Info("auth/handler - oidcCallback - state does not match the browser login")
+		h.oidcRedirectError(w, r, oidcErrInvalid, "login expired, please try again")
+		return
+	}
+	http.SetCookie(w, &http.Cookie{Name: oidcStateCookie, Path: oidcCallbackPath, MaxAge: -1, HttpOnly: true, Secure: true, SameSite: http.SameSiteLaxMode})
+	login, err := h.oidcStates.Take(r.Context(), q.Get("state"))
+	if err != nil {
+		logger.Log.Info("auth/handler - oidcCallback - Take", zap.Error(err))
+		h.oidcRedirectError(w, r, oidcErrInvalid, "login expired, please try again")
+		return
+	}
+	id, err := h.oidcProvider.Exchange(r.Context(), q.Get("code"), login.CodeVerifier, login.Nonce)
+	if err != nil {
+		logger.Log.Error("auth/handler - oidcCallback - Exchange", zap.Error(err))
+		h.oidcRedirectError(w, r, oidcErrAccessDenied, "cannot verify identity")
+		return
+	}
+	resp, err := h.service.FederatedLogin(r.Context(), &v1.FederatedLoginRequest{
+		IdentityProvider: h.cfg.OIDC.Name,
+		Username:         id.Username,
+		FirstName:        id.FirstName,
+		LastName:         id.LastName,
+		Locale:           id.Locale,
+		Groups:           id.Groups,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.PermissionDenied, codes.FailedPrecondition:
+			h.oidcRedirectError(w, r, oidcErrAccessDenied, status.Convert(err).Message())
+		default:
+			logger.Log.Error("auth/handler - oidcCallback - FederatedLogin", zap.Error(err))
+			h.oidcRedirectError(w, r, oidcErrServer, "cannot login user")
+		}
+		return
+	}
+	ti, err := h.oauth2Server.Manager.GenerateAccessToken(oauth2.PasswordCredentials, &oauth2.TokenGenerateRequest{
+		UserID:  resp.UserID,
+		Request: r,
+	})
+	if err != nil {
+		logger.Log.Error("auth/handler - oidcCallback - GenerateAccessToken", zap.Error(err))
+		h.oidcRedirectError(w, r, oidcErrServer, "cannot issue tokens")
+		return
+	}
+	values := url.Values{}
+	for k, v := range h.oauth2Server.GetTokenData(ti) {
+		values.Set(k, fmt.Sprint(v))
+	}
+	// tokens are sent in the fragment so that they never reach any server log
+	http.Redirect(w, r, h.cfg.OIDC.UIRedirectURL+"#"+values.Encode(), http.StatusFound)
+}
+
+// stateHash gives the hash of the login state kept in the browser
+func stateHash(state string) string {
+	sum := sha256.Sum256([]byte(state))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func (h *handler) oidcRedirectError(w http.ResponseWriter, r *http.Request, code, description string) {
+	values := url.Values{}
+	values.Set("error", code)
+	values.Set("error_description", description)
+	http.Redirect(w, r, h.cfg.OIDC.UIRedirectURL+"#"+values.Encode(), http.StatusFound)
+}
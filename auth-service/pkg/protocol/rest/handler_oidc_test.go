@@ -0,0 +1,235 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/api/v1"
+	mock_authService "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/api/v1/mock"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/config"
+	mock_acctok "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/generators/access/mock"
+	optisam_oauth2Server "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/server"
+	clientstore "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/stores/client"
+	mock_tokenstore "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/stores/token/mock"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oidc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oidc/oidctest"
+
+	"github.com/golang/mock/gomock"
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/oauth2.v3/server"
+)
+
+const uiRedirectURL = "http://ui.test/login"
+
+type fakeStateStore struct {
+	logins map[string]*oidc.Login
+}
+
+func (f *fakeStateStore) Save(ctx context.Context, state string, login *oidc.Login) error {
+	f.logins[state] = login
+	return nil
+}
+
+func (f *fakeStateStore) Take(ctx context.Context, state string) (*oidc.Login, error) {
+	login, ok := f.logins[state]
+	if !ok {
+		return nil, oidc.ErrUnknownState
+	}
+	delete(f.logins, state)
+	return login, nil
+}
+
+// browser returns a client keeping its cookies which does not follow the redirections.
+func browser(t *testing.T, tServer *httptest.Server) *http.Client {
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &http.Client{
+		Transport: tServer.Client().Transport,
+		Jar:       jar,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// location returns the redirection sent in response to a GET request.
+func location(t *testing.T, client *http.Client, reqURL string) *url.URL {
+	resp, err := client.Get(reqURL)
+	if !assert.Empty(t, err) {
+		return nil
+	}
+	defer resp.Body.Close()
+	if !assert.Equal(t, http.StatusFound, resp.StatusCode) {
+		return nil
+	}
+	loc, err := url.Parse(resp.Header.Get("Location"))
+	if !assert.Empty(t, err) {
+		return nil
+	}
+	return loc
+}
+
+func Test_handler_oidc(t *testing.T) {
+	idp, err := oidctest.NewIdP("optisam", "secret", map[string]interface{}{
+		"email":       "user@test.com",
+		"given_name":  "first",
+		"family_name": "last",
+		"groups":      []string{"optisam-admins"},
+	})
+	if !assert.Empty(t, err) {
+		return
+	}
+	defer idp.Close()
+
+	var mockCtrl *gomock.Controller
+	var service v1.AuthService
+	var srv *server.Server
+	tests := []struct {
+		name string
+		// callback is called directly instead of login when set
+		callback string
+		// otherBrowser opens the callback of the login in another browser than the one which started it
+		otherBrowser bool
+		setup        func()
+		want         url.Values
+	}{
+		{name: "SUCCESS",
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockService := mock_authService.NewMockAuthService(mockCtrl)
+				mockService.EXPECT().FederatedLogin(gomock.Any(), &v1.FederatedLoginRequest{
+					IdentityProvider: "corporate",
+					Username:         "user@test.com",
+					FirstName:        "first",
+					LastName:         "last",
+					Groups:           []string{"optisam-admins"},
+				}).Return(&v1.LoginResponse{UserID: "user@test.com"}, nil).Times(1)
+				service = mockService
+
+				mockTokenStore := mock_tokenstore.NewMockTokenStore(mockCtrl)
+				mockTokenStore.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+				mockAccTokGen := mock_acctok.NewMockAccessGenerate(mockCtrl)
+				mockAccTokGen.EXPECT().Token(gomock.Any(), true).Return("access", "refresh", nil).Times(1)
+				srv = optisam_oauth2Server.NewServer(mockTokenStore, clientstore.NewStore(nil), mockAccTokGen)
+			},
+			want: url.Values{
+				"access_token":  {"access"},
+				"refresh_token": {"refresh"},
+				"token_type":    {"Bearer"},
+				"expires_in":    {"7200"},
+			},
+		},
+		{name: "FAILURE - no optisam role",
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockService := mock_authService.NewMockAuthService(mockCtrl)
+				mockService.EXPECT().FederatedLogin(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.PermissionDenied, "user groups do not give access to optisam")).Times(1)
+				service = mockService
+				srv = optisam_oauth2Server.NewServer(nil, clientstore.NewStore(nil), nil)
+			},
+			want: url.Values{
+				"error":             {"access_denied"},
+				"error_description": {"user groups do not give access to optisam"},
+			},
+		},
+		{name: "FAILURE - callback opened in another browser",
+			otherBrowser: true,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				service = mock_authService.NewMockAuthService(mockCtrl)
+				srv = optisam_oauth2Server.NewServer(nil, clientstore.NewStore(nil), nil)
+			},
+			want: url.Values{
+				"error":             {"invalid_request"},
+				"error_description": {"login expired, please try again"},
+			},
+		},
+		{name: "FAILURE - unknown state",
+			callback: "?code=code&state=unknown",
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				service = mock_authService.NewMockAuthService(mockCtrl)
+				srv = optisam_oauth2Server.NewServer(nil, clientstore.NewStore(nil), nil)
+			},
+			want: url.Values{
+				"error":             {"invalid_request"},
+				"error_description": {"login expired, please try again"},
+			},
+		},
+		{name: "FAILURE - identity provider error",
+			callback: "?error=access_denied&state=unknown",
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				service = mock_authService.NewMockAuthService(mockCtrl)
+				srv = optisam_oauth2Server.NewServer(nil, clientstore.NewStore(nil), nil)
+			},
+			want: url.Values{
+				"error":             {"access_denied"},
+				"error_description": {"login refused by identity provider"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			router := httprouter.New()
+			tServer := httptest.NewTLSServer(router)
+			defer tServer.Close()
+			cfg := config.Config{OIDC: config.OIDCConfig{
+				Enabled:       true,
+				Name:          "corporate",
+				IssuerURL:     idp.Issuer(),
+				ClientID:      idp.ClientID,
+				ClientSecret:  idp.ClientSecret,
+				RedirectURL:   tServer.URL + "/api/v1/oidc/callback",
+				UIRedirectURL: uiRedirectURL,
+				UsernameClaim: "email",
+				GroupsClaim:   "groups",
+			}}
+			handler := newHandler(service, srv, cfg, nil, nil, oidc.NewProvider(cfg.OIDC, nil), &fakeStateStore{logins: map[string]*oidc.Login{}})
+			router.GET("/api/v1/oidc/login", handler.oidcLogin)
+			router.GET("/api/v1/oidc/callback", handler.oidcCallback)
+
+			client := browser(t, tServer)
+			var loc *url.URL
+			if tt.callback != "" {
+				loc = location(t, client, cfg.OIDC.RedirectURL+tt.callback)
+			} else {
+				// optisam login -> identity provider -> optisam callback -> optisam UI
+				loc = location(t, client, tServer.URL+"/api/v1/oidc/login")
+				if loc == nil {
+					return
+				}
+				assert.Equal(t, idp.Issuer()+"/authorize", loc.Scheme+"://"+loc.Host+loc.Path)
+				if loc = location(t, client, loc.String()); loc == nil {
+					return
+				}
+				if tt.otherBrowser {
+					client = browser(t, tServer)
+				}
+				if loc = location(t, client, loc.String()); loc == nil {
+					return
+				}
+			}
+			if loc == nil {
+				return
+			}
+			assert.Equal(t, uiRedirectURL, loc.Scheme+"://"+loc.Host+loc.Path)
+			got, err := url.ParseQuery(loc.Fragment)
+			if assert.Empty(t, err) {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
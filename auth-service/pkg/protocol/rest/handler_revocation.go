@@ -0,0 +1,176 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	oauth2Handlers "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/handler"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/revocation"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/julienschmidt/httprouter"
+	"go.uber.org/zap"
+	"gopkg.in/oauth2.v3/errors"
+)
+
+const (
+	tokenTypeAccess  = "access_token"
+	tokenTypeRefresh = "refresh_token"
+)
+
+// introspection is the RFC 7662 introspection response.
+type introspection struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Username  string `json:"username,omitempty"`
+	Role      string `json:"role,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+	Sub       string `json:"sub,omitempty"`
+	Iss       string `json:"iss,omitempty"`
+	Jti       string `json:"jti,omitempty"`
+}
+
+// revoke implements RFC 7009 token revocation.
+// As required by the RFC, an invalid or unknown token is not an error.
+func (h *handler) revoke(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	if _, ok := h.authenticateClient(w, r); !ok {
+		return
+	}
+	token := r.FormValue("token")
+	if token == "" {
+		h.oauth2Error(w, errors.ErrInvalidRequest)
+		return
+	}
+	hint := r.FormValue("token_type_hint")
+	if hint != tokenTypeAccess {
+		if ti, err := h.oauth2Server.Manager.LoadRefreshToken(token); err == nil {
+			if err := h.oauth2Server.Manager.RemoveRefreshToken(ti.GetRefresh()); err != nil {
+				logger.Log.Error("auth/handler - revoke - RemoveRefreshToken", zap.Error(err))
+				h.oauth2Error(w, errors.ErrServerError)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+	if clms, ok := h.parseAccessToken(token); ok {
+		if clms.Id == "" {
+			// tokens issued before token ids were introduced can only expire
+			logger.Log.Info("auth/handler - revoke - token has no id", zap.String("user", clms.UserID))
+		} else if err := h.revoked.RevokeToken(r.Context(), clms.Id, time.Unix(clms.ExpiresAt, 0)); err != nil {
+			logger.Log.Error("auth/handler - revoke - RevokeToken", zap.Error(err))
+			h.oauth2Error(w, errors.ErrServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// introspect implements RFC 7662 token introspection, only registered clients can introspect tokens.
+func (h *handler) introspect(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	clientID, ok := h.authenticateClient(w, r)
+	if !ok {
+		return
+	}
+	if clientID == "" {
+		h.oauth2Error(w, errors.ErrInvalidClient)
+		return
+	}
+	token := r.FormValue("token")
+	if token == "" {
+		h.oauth2Error(w, errors.ErrInvalidRequest)
+		return
+	}
+	resp := introspection{}
+	if r.FormValue("token_type_hint") != tokenTypeRefresh {
+		if clms, ok := h.parseAccessToken(token); ok {
+			revoked, err := revocation.IsClaimsRevoked(r.Context(), h.revoked, clms)
+			if err != nil {
+				logger.Log.Error("auth/handler - introspect - IsRevoked", zap.Error(err))
+				h.oauth2Error(w, errors.ErrServerError)
+				return
+			}
+			if !revoked {
+				resp = introspection{
+					Active:    true,
+					Scope:     strings.Join(clms.Socpes, " "),
+					Username:  clms.UserID,
+					Role:      string(clms.Role),
+					TokenType: tokenTypeAccess,
+					Exp:       clms.ExpiresAt,
+					Iat:       clms.IssuedAt,
+					Sub:       clms.UserID,
+					Iss:       clms.Issuer,
+					Jti:       clms.Id,
+				}
+			}
+			sendJSON(w, resp)
+			return
+		}
+	}
+	if ti, err := h.oauth2Server.Manager.LoadRefreshToken(token); err == nil {
+		resp = introspection{
+			Active:    true,
+			Scope:     ti.GetScope(),
+			ClientID:  ti.GetClientID(),
+			Username:  ti.GetUserID(),
+			TokenType: tokenTypeRefresh,
+			Exp:       ti.GetRefreshCreateAt().Add(ti.GetRefreshExpiresIn()).Unix(),
+			Iat:       ti.GetRefreshCreateAt().Unix(),
+			Sub:       ti.GetUserID(),
+		}
+	}
+	sendJSON(w, resp)
+}
+
+// authenticateClient validates client credentials if client sent any, it returns
+// the id of the client, empty for optisam UI.
+func (h *handler) authenticateClient(w http.ResponseWriter, r *http.Request) (string, bool) {
+	clientID, clientSecret, _ := oauth2Handlers.ClientInfoHandler(r)
+	if clientID == "" {
+		return "", true
+	}
+	cli, err := h.oauth2Server.Manager.GetClient(clientID)
+	if err != nil || cli.GetSecret() != clientSecret {
+		h.oauth2Error(w, errors.ErrInvalidClient)
+		return "", false
+	}
+	return clientID, true
+}
+
+// parseAccessToken returns the claims of a valid access token.
+func (h *handler) parseAccessToken(token string) (*claims.Claims, bool) {
+	clms := &claims.Claims{}
+	t, err := jwt.ParseWithClaims(token, clms, func(token *jwt.Token) (interface{}, error) {
+		return h.verifyKey, nil
+	})
+	if err != nil || !t.Valid {
+		return nil, false
+	}
+	return clms, true
+}
+
+func (h *handler) oauth2Error(w http.ResponseWriter, err error) {
+	data, status, header := h.oauth2Server.GetErrorData(err)
+	for key := range header {
+		w.Header().Set(key, header.Get(key))
+	}
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(data)
+}
+
+func sendJSON(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(data)
+}
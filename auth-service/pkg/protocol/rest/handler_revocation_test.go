@@ -0,0 +1,211 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/config"
+	optisam_oauth2Server "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/server"
+	clientstore "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/stores/client"
+	mock_tokenstore "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/stores/token/mock"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/iam"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/generator"
+
+	"github.com/golang/mock/gomock"
+	"github.com/julienschmidt/httprouter"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/oauth2.v3/models"
+	"gopkg.in/oauth2.v3/server"
+)
+
+type fakeRevocationList struct {
+	tokens map[string]time.Time
+}
+
+func (f *fakeRevocationList) RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	f.tokens[tokenID] = expiresAt
+	return nil
+}
+
+func (f *fakeRevocationList) RevokeUser(ctx context.Context, userID string) error {
+	return nil
+}
+
+func (f *fakeRevocationList) IsRevoked(ctx context.Context, tokenID, userID string, issuedAt int64) (bool, error) {
+	_, ok := f.tokens[tokenID]
+	return ok, nil
+}
+
+func Test_handler_revocation(t *testing.T) {
+	gen, err := generator.NewTokenGenerator("../../../cmd/server/key.pem")
+	if !assert.Empty(t, err) {
+		return
+	}
+	verifyKey, err := iam.GetVerifyKey(iam.Config{PublicKeyPath: "../../../cmd/server/cert.pem"})
+	if !assert.Empty(t, err) {
+		return
+	}
+	access, err := gen.GenerateAccessToken(&claims.Claims{
+		UserID: "user@test.com",
+		Role:   claims.RoleUser,
+		Socpes: []string{"OFR", "OSP"},
+	})
+	if !assert.Empty(t, err) {
+		return
+	}
+	clms, _ := (&handler{verifyKey: verifyKey}).parseAccessToken(access)
+	clientStore := clientstore.NewStore([]config.OAuth2Client{
+		{ID: "dps", Secret: "secret", Role: "Admin", Scopes: []string{"OFR"}},
+	})
+	created := time.Now()
+	var mockCtrl *gomock.Controller
+	var srv *server.Server
+	var revoked *fakeRevocationList
+	tests := []struct {
+		name   string
+		path   string
+		data   url.Values
+		client bool
+		setup  func()
+		status int
+		want   string
+		check  func(t *testing.T)
+	}{
+		{name: "introspect - active access token",
+			path:   "/api/v1/introspect",
+			data:   url.Values{"token": {access}},
+			client: true,
+			setup:  func() {},
+			status: http.StatusOK,
+			want: `{"active":true,"scope":"OFR OSP","username":"user@test.com","role":"User","token_type":"access_token","exp":` +
+				itoa(clms.ExpiresAt) + `,"iat":` + itoa(clms.IssuedAt) + `,"sub":"user@test.com","iss":"Orange","jti":"` + clms.Id + `"}`,
+		},
+		{name: "introspect - revoked access token",
+			path:   "/api/v1/introspect",
+			data:   url.Values{"token": {access}},
+			client: true,
+			setup: func() {
+				revoked.tokens[clms.Id] = time.Now()
+			},
+			status: http.StatusOK,
+			want:   `{"active":false}`,
+		},
+		{name: "introspect - refresh token",
+			path:   "/api/v1/introspect",
+			data:   url.Values{"token": {"refresh"}, "token_type_hint": {"refresh_token"}},
+			client: true,
+			setup: func() {
+				mockTokenStore := mock_tokenstore.NewMockTokenStore(mockCtrl)
+				mockTokenStore.EXPECT().GetByRefresh("refresh").Return(&models.Token{
+					UserID:           "user@test.com",
+					Refresh:          "refresh",
+					RefreshCreateAt:  created,
+					RefreshExpiresIn: time.Hour,
+				}, nil).Times(1)
+				srv = optisam_oauth2Server.NewServer(mockTokenStore, clientStore, nil)
+			},
+			status: http.StatusOK,
+			want: `{"active":true,"username":"user@test.com","token_type":"refresh_token","exp":` +
+				itoa(created.Add(time.Hour).Unix()) + `,"iat":` + itoa(created.Unix()) + `,"sub":"user@test.com"}`,
+		},
+		{name: "introspect - unknown token",
+			path:   "/api/v1/introspect",
+			data:   url.Values{"token": {"unknown"}},
+			client: true,
+			setup: func() {
+				mockTokenStore := mock_tokenstore.NewMockTokenStore(mockCtrl)
+				mockTokenStore.EXPECT().GetByRefresh("unknown").Return(nil, nil).Times(1)
+				srv = optisam_oauth2Server.NewServer(mockTokenStore, clientStore, nil)
+			},
+			status: http.StatusOK,
+			want:   `{"active":false}`,
+		},
+		{name: "introspect - no client",
+			path:   "/api/v1/introspect",
+			data:   url.Values{"token": {access}},
+			setup:  func() {},
+			status: http.StatusUnauthorized,
+			want:   `{"error":"invalid_client","error_description":"Client authentication failed"}`,
+		},
+		{name: "revoke - access token",
+			path:   "/api/v1/revoke",
+			data:   url.Values{"token": {access}, "token_type_hint": {"access_token"}},
+			setup:  func() {},
+			status: http.StatusOK,
+			check: func(t *testing.T) {
+				assert.Equal(t, time.Unix(clms.ExpiresAt, 0), revoked.tokens[clms.Id])
+			},
+		},
+		{name: "revoke - refresh token",
+			path: "/api/v1/revoke",
+			data: url.Values{"token": {"refresh"}},
+			setup: func() {
+				mockTokenStore := mock_tokenstore.NewMockTokenStore(mockCtrl)
+				mockTokenStore.EXPECT().GetByRefresh("refresh").Return(&models.Token{
+					UserID:          "user@test.com",
+					Refresh:         "refresh",
+					RefreshCreateAt: time.Now(),
+				}, nil).Times(1)
+				mockTokenStore.EXPECT().RemoveByRefresh("refresh").Return(nil).Times(1)
+				srv = optisam_oauth2Server.NewServer(mockTokenStore, clientStore, nil)
+			},
+			status: http.StatusOK,
+		},
+		{name: "revoke - no token",
+			path:   "/api/v1/revoke",
+			data:   url.Values{},
+			setup:  func() {},
+			status: http.StatusBadRequest,
+			want:   `{"error":"invalid_request","error_description":"The request is missing a required parameter, includes an invalid parameter value, includes a parameter more than once, or is otherwise malformed"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl = gomock.NewController(t)
+			srv = optisam_oauth2Server.NewServer(nil, clientStore, nil)
+			revoked = &fakeRevocationList{tokens: map[string]time.Time{}}
+			tt.setup()
+			handler := newHandler(nil, srv, config.Config{}, verifyKey, revoked, nil, nil)
+			router := httprouter.New()
+			router.POST("/api/v1/introspect", handler.introspect)
+			router.POST("/api/v1/revoke", handler.revoke)
+			tServer := httptest.NewServer(router)
+			defer tServer.Close()
+			req, err := formRequest(tServer.URL+tt.path, tt.data)
+			if !assert.Empty(t, err) {
+				return
+			}
+			if tt.client {
+				req.SetBasicAuth("dps", "secret")
+			}
+			resp, err := tServer.Client().Do(req)
+			if !assert.Empty(t, err) {
+				return
+			}
+			defer resp.Body.Close()
+			data, err := ioutil.ReadAll(resp.Body)
+			if !assert.Empty(t, err) {
+				return
+			}
+			assert.Equal(t, tt.status, resp.StatusCode)
+			assert.Equal(t, tt.want, string(bytes.TrimSpace(data)))
+			if tt.check != nil {
+				tt.check(t)
+			}
+			mockCtrl.Finish()
+		})
+	}
+}
+
+func itoa(i int64) string {
+	return strconv.FormatInt(i, 10)
+}
@@ -14,12 +14,14 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/api/v1"
 	mock_authService "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/api/v1/mock"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/config"
 	mock_acctok "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/generators/access/mock"
 	optisam_oauth2Server "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/server"
+	clientstore "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/stores/client"
 	mock_clientstore "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/stores/client/mock"
 	mock_tokenstore "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/stores/token/mock"
 
@@ -85,6 +87,7 @@ func Test_handler_token(t *testing.T) {
 				}).Return(&v1.LoginResponse{
 					UserID: "user",
 				}, nil).Times(1)
+				mockService.EXPECT().MFAChallenge(gomock.Any(), "user", "", "").Return(nil, nil).Times(1)
 
 				service = mockService
 				mockClientStore := mock_clientstore.NewMockClientStore(mockCtrl)
@@ -120,6 +123,92 @@ func Test_handler_token(t *testing.T) {
 				return nil
 			},
 		},
+		{name: "mfa challenge",
+			args: args{
+				grantType: "password",
+				username:  "admin",
+				password:  "secret",
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockService := mock_authService.NewMockAuthService(mockCtrl)
+				mockService.EXPECT().Login(gomock.Any(), &v1.LoginRequest{
+					Username: "admin",
+					Password: "secret",
+				}).Return(&v1.LoginResponse{
+					UserID: "admin",
+				}, nil).Times(1)
+				mockService.EXPECT().MFAChallenge(gomock.Any(), "admin", "", "").Return(&v1.MFAChallengeResponse{
+					Token:     "challenge",
+					ExpiresIn: 300,
+				}, nil).Times(1)
+
+				service = mockService
+				// no token is issued before the second factor is verified
+				srv = optisam_oauth2Server.NewServer(mock_tokenstore.NewMockTokenStore(mockCtrl), mock_clientstore.NewMockClientStore(mockCtrl), mock_acctok.NewMockAccessGenerate(mockCtrl))
+			},
+			assert: func(resp *http.Response) error {
+				if http.StatusUnauthorized != resp.StatusCode {
+					return fmt.Errorf("expected status code: %v, got: %v %v", http.StatusUnauthorized, resp.Status, resp.StatusCode)
+				}
+				defer resp.Body.Close()
+
+				data, err := ioutil.ReadAll(resp.Body)
+				if err != nil {
+					return err
+				}
+
+				expData := `{"error":"mfa_required","error_code":4,"error_description":"A second authentication factor is required","expires_in":300,"mfa_token":"challenge"}`
+
+				if string(bytes.TrimSpace(data)) != expData {
+					return fmt.Errorf("expected: %s, got: %s", expData, data)
+				}
+
+				return nil
+			},
+		},
+		{name: "mfa enrollment required",
+			args: args{
+				grantType: "password",
+				username:  "admin",
+				password:  "secret",
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockService := mock_authService.NewMockAuthService(mockCtrl)
+				mockService.EXPECT().Login(gomock.Any(), gomock.Any()).Return(&v1.LoginResponse{
+					UserID: "admin",
+				}, nil).Times(1)
+				mockService.EXPECT().MFAChallenge(gomock.Any(), "admin", "", "").Return(&v1.MFAChallengeResponse{
+					Token:              "challenge",
+					ExpiresIn:          300,
+					EnrollmentRequired: true,
+					EnrollmentToken:    "enrollment",
+				}, nil).Times(1)
+
+				service = mockService
+				srv = optisam_oauth2Server.NewServer(mock_tokenstore.NewMockTokenStore(mockCtrl), mock_clientstore.NewMockClientStore(mockCtrl), mock_acctok.NewMockAccessGenerate(mockCtrl))
+			},
+			assert: func(resp *http.Response) error {
+				if http.StatusUnauthorized != resp.StatusCode {
+					return fmt.Errorf("expected status code: %v, got: %v %v", http.StatusUnauthorized, resp.Status, resp.StatusCode)
+				}
+				defer resp.Body.Close()
+
+				data, err := ioutil.ReadAll(resp.Body)
+				if err != nil {
+					return err
+				}
+
+				expData := `{"error":"mfa_required","error_code":4,"error_description":"A second authentication factor is required","expires_in":300,"mfa_enrollment_required":true,"mfa_enrollment_token":"enrollment","mfa_token":"challenge"}`
+
+				if string(bytes.TrimSpace(data)) != expData {
+					return fmt.Errorf("expected: %s, got: %s", expData, data)
+				}
+
+				return nil
+			},
+		},
 		{name: "failure",
 			args: args{
 				grantType: "password",
@@ -149,7 +238,7 @@ func Test_handler_token(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
-			handler := newHandler(service, srv, cfg)
+			handler := newHandler(service, srv, cfg, nil, nil, nil, nil)
 			router := httprouter.New()
 			router.POST("/api/v1/token", handler.token)
 			tServer := httptest.NewServer(router)
@@ -169,3 +258,177 @@ func Test_handler_token(t *testing.T) {
 		})
 	}
 }
+
+func formRequest(reqURL string, data url.Values) (*http.Request, error) {
+	req, err := http.NewRequest("POST", reqURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	return req, nil
+}
+
+func Test_handler_token_grants(t *testing.T) {
+	var srv *server.Server
+	var mockCtrl *gomock.Controller
+	var cfg config.Config
+	tests := []struct {
+		name   string
+		data   url.Values
+		setup  func()
+		status int
+		want   string
+	}{
+		{name: "refresh token",
+			data: url.Values{
+				"grant_type":    {"refresh_token"},
+				"refresh_token": {"refresh"},
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockClientStore := mock_clientstore.NewMockClientStore(mockCtrl)
+				mockClientStore.EXPECT().GetByID("").Return(&models.Client{}, nil).Times(1)
+
+				mockTokenStore := mock_tokenstore.NewMockTokenStore(mockCtrl)
+				mockTokenStore.EXPECT().GetByRefresh("refresh").Return(&models.Token{
+					UserID:           "user",
+					Access:           "access",
+					AccessCreateAt:   time.Now(),
+					AccessExpiresIn:  2 * time.Hour,
+					Refresh:          "refresh",
+					RefreshCreateAt:  time.Now(),
+					RefreshExpiresIn: time.Hour,
+				}, nil).Times(1)
+				mockTokenStore.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+				mockTokenStore.EXPECT().RemoveByAccess("access").Return(nil).Times(1)
+				mockTokenStore.EXPECT().RemoveByRefresh("refresh").Return(nil).Times(1)
+
+				mockAccTokGen := mock_acctok.NewMockAccessGenerate(mockCtrl)
+				mockAccTokGen.EXPECT().Token(gomock.Any(), true).
+					Return("access2", "refresh2", nil).Times(1)
+
+				srv = optisam_oauth2Server.NewServer(mockTokenStore, mockClientStore, mockAccTokGen)
+			},
+			status: http.StatusOK,
+			want:   `{"access_token":"access2","expires_in":7200,"refresh_token":"refresh2","token_type":"Bearer"}`,
+		},
+		{name: "refresh token - expired",
+			data: url.Values{
+				"grant_type":    {"refresh_token"},
+				"refresh_token": {"refresh"},
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockClientStore := mock_clientstore.NewMockClientStore(mockCtrl)
+				mockClientStore.EXPECT().GetByID("").Return(&models.Client{}, nil).Times(1)
+
+				mockTokenStore := mock_tokenstore.NewMockTokenStore(mockCtrl)
+				mockTokenStore.EXPECT().GetByRefresh("refresh").Return(&models.Token{
+					UserID:           "user",
+					Refresh:          "refresh",
+					RefreshCreateAt:  time.Now().Add(-2 * time.Hour),
+					RefreshExpiresIn: time.Hour,
+				}, nil).Times(1)
+
+				srv = optisam_oauth2Server.NewServer(mockTokenStore, mockClientStore, nil)
+			},
+			status: http.StatusUnauthorized,
+			want:   `{"error":"invalid_grant","error_description":"The provided authorization grant (e.g., authorization code, resource owner credentials) or refresh token is invalid, expired, revoked, does not match the redirection URI used in the authorization request, or was issued to another client"}`,
+		},
+		{name: "client credentials",
+			data: url.Values{
+				"grant_type":    {"client_credentials"},
+				"client_id":     {"dps"},
+				"client_secret": {"secret"},
+				"scope":         {"OFR"},
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				clientStore := clientstore.NewStore([]config.OAuth2Client{
+					{ID: "dps", Secret: "secret", Role: "Admin", Scopes: []string{"OFR", "OSP"}},
+				})
+
+				mockTokenStore := mock_tokenstore.NewMockTokenStore(mockCtrl)
+				mockTokenStore.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+
+				mockAccTokGen := mock_acctok.NewMockAccessGenerate(mockCtrl)
+				mockAccTokGen.EXPECT().Token(gomock.Any(), false).
+					Return("access", "", nil).Times(1)
+
+				srv = optisam_oauth2Server.NewServer(mockTokenStore, clientStore, mockAccTokGen)
+			},
+			status: http.StatusOK,
+			want:   `{"access_token":"access","expires_in":7200,"scope":"OFR","token_type":"Bearer"}`,
+		},
+		{name: "client credentials - scope not allowed",
+			data: url.Values{
+				"grant_type":    {"client_credentials"},
+				"client_id":     {"dps"},
+				"client_secret": {"secret"},
+				"scope":         {"OFR TST"},
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				clientStore := clientstore.NewStore([]config.OAuth2Client{
+					{ID: "dps", Secret: "secret", Role: "Admin", Scopes: []string{"OFR", "OSP"}},
+				})
+				srv = optisam_oauth2Server.NewServer(nil, clientStore, nil)
+			},
+			status: http.StatusBadRequest,
+			want:   `{"error":"invalid_scope","error_description":"The requested scope is invalid, unknown, or malformed"}`,
+		},
+		{name: "client credentials - wrong secret",
+			data: url.Values{
+				"grant_type":    {"client_credentials"},
+				"client_id":     {"dps"},
+				"client_secret": {"wrong"},
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				clientStore := clientstore.NewStore([]config.OAuth2Client{
+					{ID: "dps", Secret: "secret", Role: "Admin", Scopes: []string{"OFR"}},
+				})
+				srv = optisam_oauth2Server.NewServer(nil, clientStore, nil)
+			},
+			status: http.StatusUnauthorized,
+			want:   `{"error":"invalid_client","error_description":"Client authentication failed"}`,
+		},
+		{name: "client credentials - no client",
+			data: url.Values{
+				"grant_type": {"client_credentials"},
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				srv = optisam_oauth2Server.NewServer(nil, clientstore.NewStore(nil), nil)
+			},
+			status: http.StatusUnauthorized,
+			want:   `{"error":"unauthorized_client","error_description":"The client is not authorized to request an authorization code using this method"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			handler := newHandler(nil, srv, cfg, nil, nil, nil, nil)
+			router := httprouter.New()
+			router.POST("/api/v1/token", handler.token)
+			tServer := httptest.NewServer(router)
+			defer tServer.Close()
+			req, err := formRequest(tServer.URL+"/api/v1/token", tt.data)
+			if !assert.Empty(t, err) {
+				return
+			}
+			resp, err := tServer.Client().Do(req)
+			if !assert.Empty(t, err) {
+				return
+			}
+			defer resp.Body.Close()
+			data, err := ioutil.ReadAll(resp.Body)
+			if !assert.Empty(t, err) {
+				return
+			}
+			assert.Equal(t, tt.status, resp.StatusCode)
+			assert.Equal(t, tt.want, string(bytes.TrimSpace(data)))
+			mockCtrl.Finish()
+		})
+	}
+}
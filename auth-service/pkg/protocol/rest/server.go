@@ -2,13 +2,16 @@ package rest
 
 import (
 	"context"
+	"crypto/rsa"
 	"net/http"
 
 	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/api/v1"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/config"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oidc"
 
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
 	rest_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/rest"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/revocation"
 
 	"os"
 	"os/signal"
@@ -22,15 +25,22 @@ import (
 )
 
 // RunServer runs HTTP/REST gateway
-func RunServer(ctx context.Context, service v1.AuthService, serv *server.Server, httpPort string, cfg config.Config) error {
+func RunServer(ctx context.Context, service v1.AuthService, serv *server.Server, httpPort string, cfg config.Config, verifyKey *rsa.PublicKey, revoked revocation.List, provider *oidc.Provider, states oidc.StateStore) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	router := httprouter.New()
 
-	handler := newHandler(service, serv, cfg)
+	handler := newHandler(service, serv, cfg, verifyKey, revoked, provider, states)
 
 	router.POST("/api/v1/token", handler.token)
+	router.POST("/api/v1/mfa/verify", handler.mfaVerify)
+	router.POST("/api/v1/revoke", handler.revoke)
+	router.POST("/api/v1/introspect", handler.introspect)
+	if cfg.OIDC.Enabled {
+		router.GET("/api/v1/oidc/login", handler.oidcLogin)
+		router.GET(oidcCallbackPath, handler.oidcCallback)
+	}
 	router.GET("/api/v1/activate_account", handler.activateAccount)
 	router.GET("/api/v1/reset_password", handler.resetPassword)
 	router.POST("/api/v1/set_password", handler.setPassword)
@@ -0,0 +1,6 @@
+package v1
+
+import "errors"
+
+// ErrLocalAccount is returned when a federated login matches an account which is not federated.
+var ErrLocalAccount = errors.New("account is not federated")
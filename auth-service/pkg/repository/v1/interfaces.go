@@ -30,6 +30,11 @@ type Repository interface {
 	// correct credentials this time.
 	ResetLoginCount(ctx context.Context, userID string) error
 
+	// ProvisionFederatedUser creates the account of a federated user on its first login
+	// and updates its role and scopes on the next ones. ErrLocalAccount is returned
+	// if a local account already exists with the same username.
+	ProvisionFederatedUser(ctx context.Context, user *FederatedUser) error
+
 	// UserOwnedGroupsDirect return the groups directly owned by user
 	UserOwnedGroupsDirect(ctx context.Context, userID string) ([]*Group, error)
 	GetToken(ctx context.Context, acc helper.EmailParams) error
@@ -39,6 +44,15 @@ type Repository interface {
 	AccountInfo(ctx context.Context, userID string) (*v1.AccountInfo, error)
 	ChangeUserFirstLogin(ctx context.Context, userID string) error
 	ChangePassword(ctx context.Context, userID, password string) error
+	// UserMFA returns the authenticator of the user, sql.ErrNoRows is returned if user has none
+	UserMFA(ctx context.Context, userID string) (*UserMFA, error)
+	// UseMFAStep records the time step of an accepted code, false is returned if a code
+	// of the same or a later step was already used
+	UseMFAStep(ctx context.Context, userID string, step int64) (bool, error)
+	// UseMFARecoveryCode removes the recovery code of the user, false is returned if user has no such code
+	UseMFARecoveryCode(ctx context.Context, userID, codeHash string) (bool, error)
+	// RevokeUserTokens revokes all the tokens issued to the user and api keys created until now
+	RevokeUserTokens(ctx context.Context, userID string) error
 	CreateAuthContext(cfg config.Config) (context.Context, error)
 	// // CheckPassword check for users password in database
 	// CheckPassword(ctx context.Context, userID, password string) (bool, error)
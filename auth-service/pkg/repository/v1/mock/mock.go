@@ -153,6 +153,20 @@ func (mr *MockRepositoryMockRecorder) IncreaseFailedLoginCount(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncreaseFailedLoginCount", reflect.TypeOf((*MockRepository)(nil).IncreaseFailedLoginCount), arg0, arg1)
 }
 
+// ProvisionFederatedUser mocks base method.
+func (m *MockRepository) ProvisionFederatedUser(arg0 context.Context, arg1 *v10.FederatedUser) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvisionFederatedUser", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProvisionFederatedUser indicates an expected call of ProvisionFederatedUser.
+func (mr *MockRepositoryMockRecorder) ProvisionFederatedUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisionFederatedUser", reflect.TypeOf((*MockRepository)(nil).ProvisionFederatedUser), arg0, arg1)
+}
+
 // ResetLoginCount mocks base method.
 func (m *MockRepository) ResetLoginCount(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -167,6 +181,20 @@ func (mr *MockRepositoryMockRecorder) ResetLoginCount(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetLoginCount", reflect.TypeOf((*MockRepository)(nil).ResetLoginCount), arg0, arg1)
 }
 
+// RevokeUserTokens mocks base method.
+func (m *MockRepository) RevokeUserTokens(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserTokens", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserTokens indicates an expected call of RevokeUserTokens.
+func (mr *MockRepositoryMockRecorder) RevokeUserTokens(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserTokens", reflect.TypeOf((*MockRepository)(nil).RevokeUserTokens), arg0, arg1)
+}
+
 // SetToken mocks base method.
 func (m *MockRepository) SetToken(arg0 context.Context, arg1 helper.EmailParams, arg2 int) error {
 	m.ctrl.T.Helper()
@@ -181,6 +209,36 @@ func (mr *MockRepositoryMockRecorder) SetToken(arg0, arg1, arg2 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetToken", reflect.TypeOf((*MockRepository)(nil).SetToken), arg0, arg1, arg2)
 }
 
+// UseMFARecoveryCode mocks base method.
+func (m *MockRepository) UseMFARecoveryCode(arg0 context.Context, arg1 string, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseMFARecoveryCode", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseMFARecoveryCode indicates an expected call of UseMFARecoveryCode.
+func (mr *MockRepositoryMockRecorder) UseMFARecoveryCode(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseMFARecoveryCode", reflect.TypeOf((*MockRepository)(nil).UseMFARecoveryCode), arg0, arg1, arg2)
+}
+
+// UseMFAStep mocks base method.
+func (m *MockRepository) UseMFAStep(arg0 context.Context, arg1 string, arg2 int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseMFAStep", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseMFAStep indicates an expected call of UseMFAStep.
+func (mr *MockRepositoryMockRecorder) UseMFAStep(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseMFAStep", reflect.TypeOf((*MockRepository)(nil).UseMFAStep), arg0, arg1, arg2)
+}
+
 // UserInfo mocks base method.
 func (m *MockRepository) UserInfo(arg0 context.Context, arg1 string) (*v10.UserInfo, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +254,21 @@ func (mr *MockRepositoryMockRecorder) UserInfo(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserInfo", reflect.TypeOf((*MockRepository)(nil).UserInfo), arg0, arg1)
 }
 
+// UserMFA mocks base method.
+func (m *MockRepository) UserMFA(arg0 context.Context, arg1 string) (*v10.UserMFA, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserMFA", arg0, arg1)
+	ret0, _ := ret[0].(*v10.UserMFA)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserMFA indicates an expected call of UserMFA.
+func (mr *MockRepositoryMockRecorder) UserMFA(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserMFA", reflect.TypeOf((*MockRepository)(nil).UserMFA), arg0, arg1)
+}
+
 // UserOwnedGroupsDirect mocks base method.
 func (m *MockRepository) UserOwnedGroupsDirect(arg0 context.Context, arg1 string) ([]*v10.Group, error) {
 	m.ctrl.T.Helper()
@@ -2,6 +2,8 @@ package v1
 
 // Group repsrents an Optisam group
 type Group struct {
-	ID     int64
+	ID int64
+	// Name is the fully qualified name of the group
+	Name   string
 	Scopes []string
 }
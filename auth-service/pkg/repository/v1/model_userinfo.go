@@ -19,4 +19,27 @@ type UserInfo struct {
 	Locale       string
 	Password     string
 	FailedLogins uint8
+	// IdentityProvider is empty for local accounts
+	IdentityProvider string
+	// FederatedScopes are the scopes given by identity provider groups
+	FederatedScopes []string
+}
+
+// FederatedUser is an account provisioned from an identity provider login.
+type FederatedUser struct {
+	UserID           string
+	FirstName        string
+	LastName         string
+	Locale           string
+	Role             Role
+	IdentityProvider string
+	Scopes           []string
+}
+
+// UserMFA is the totp authenticator of a user enrolled with account-service
+type UserMFA struct {
+	Secret  string
+	Enabled bool
+	// LastUsedStep is the time step of the last accepted code
+	LastUsedStep int64
 }
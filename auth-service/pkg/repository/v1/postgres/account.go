@@ -15,6 +15,8 @@ import (
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/redis"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/revocation"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -32,7 +34,8 @@ const (
 	profile_pic,
 	cont_failed_login,
 	created_on,
-	first_login
+	first_login,
+	COALESCE(identity_provider,'')
 	FROM users
 	WHERE username = $1`
 
@@ -55,7 +58,7 @@ func (d *Default) DelToken(ctx context.Context, acc helper.EmailParams) error {
 func (r *Default) AccountInfo(ctx context.Context, userID string) (*v1.AccountInfo, error) {
 	ai := &v1.AccountInfo{}
 	err := r.db.QueryRowContext(ctx, selectAccountInfo, userID).
-		Scan(&ai.UserID, &ai.Password, &ai.FirstName, &ai.LastName, &ai.Locale, &ai.ProfilePic, &ai.ContFailedLogin, &ai.CreatedOn, &ai.FirstLogin)
+		Scan(&ai.UserID, &ai.Password, &ai.FirstName, &ai.LastName, &ai.Locale, &ai.ProfilePic, &ai.ContFailedLogin, &ai.CreatedOn, &ai.FirstLogin, &ai.IdentityProvider)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			logger.Log.Sugar().Errorw("unable to get account info ", err.Error())
@@ -110,6 +113,14 @@ func (r *Default) ChangePassword(ctx context.Context, userID, password string) e
 	return nil
 }
 
+// RevokeUserTokens implements Repository RevokeUserTokens function.
+func (r *Default) RevokeUserTokens(ctx context.Context, userID string) error {
+	if err := revocation.NewList(r.r).RevokeUser(ctx, userID); err != nil {
+		return err
+	}
+	return apikey.NewStore(r.r).RevokeOwnerKeys(ctx, userID)
+}
+
 func (r *Default) GenerateMailBody(ctx context.Context, acc helper.EmailParams, cfg config.Config) (string, error) {
 	return email.GenerateActivationMail(acc, ctx, cfg.Emailtemplate.Activationpath, cfg.Emailtemplate.Passwordresetpath, cfg.Emailtemplate.Redirecbaseurl)
 }
@@ -11,6 +11,7 @@ import (
 const (
 	selectDirectGroupsForUser = `SELECT 
 	id,
+	fully_qualified_name,
 	scopes
 	FROM groups
 	INNER JOIN group_ownership ON groups.id  = group_ownership.group_id
@@ -27,7 +28,7 @@ func (d *Default) UserOwnedGroupsDirect(ctx context.Context, userID string) ([]*
 	var groups []*v1.Group
 	for rows.Next() {
 		group := &v1.Group{}
-		if err := rows.Scan(&group.ID, pq.Array(&group.Scopes)); err != nil {
+		if err := rows.Scan(&group.ID, &group.Name, pq.Array(&group.Scopes)); err != nil {
 			return nil, err
 		}
 		groups = append(groups, group)
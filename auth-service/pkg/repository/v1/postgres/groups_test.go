@@ -136,21 +136,24 @@ func TestDefault_UserOwnedGroupsDirect(t *testing.T) {
 
 				return []*v1.Group{
 						{
-							ID: grps[2].id,
+							ID:   grps[2].id,
+							Name: "SUPERROOT.A.B",
 							Scopes: []string{
 								"Orange",
 								"France",
 							},
 						},
 						{
-							ID: grps[3].id,
+							ID:   grps[3].id,
+							Name: "SUPERROOT.A.C",
 							Scopes: []string{
 								"Asia",
 								"Pacific",
 							},
 						},
 						{
-							ID: grps[4].id,
+							ID:   grps[4].id,
+							Name: "SUPERROOT.A.B.D",
 							Scopes: []string{
 								"Apple",
 							},
@@ -219,6 +222,9 @@ func compareGroup(t *testing.T, name string, exp *v1.Group, act *v1.Group) {
 		assert.Equalf(t, exp.ID, act.ID, "%s.ID should be same", name)
 	}
 
+	if exp.Name != "" {
+		assert.Equalf(t, exp.Name, act.Name, "%s.Name should be same", name)
+	}
 	assert.ElementsMatchf(t, exp.Scopes, act.Scopes, "%s.Scopes should be same", name)
 
 }
@@ -5,21 +5,38 @@ import (
 	"fmt"
 
 	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/repository/v1"
+
+	"github.com/lib/pq"
 )
 
 const (
-	selectUserInfo        = "SELECT username,password,cont_failed_login,role,locale FROM users WHERE username = $1"
+	selectUserInfo        = "SELECT username,password,cont_failed_login,role,locale,COALESCE(identity_provider,''),federated_scopes FROM users WHERE username = $1"
 	incFailedLoginCount   = "UPDATE users SET cont_failed_login = cont_failed_login + 1  WHERE username = $1"
 	resetFailedLoginCount = "UPDATE users SET cont_failed_login = 0, last_login = NOW()   WHERE username = $1"
+	// federated accounts have no password, conflict update is skipped for local accounts
+	upsertFederatedUser = `
+	INSERT INTO users(username,first_name,last_name,role,password,locale,first_login,account_status,identity_provider,federated_scopes,last_login)
+	VALUES ($1,$2,$3,$4,'',$5,FALSE,'Active',$6,$7,NOW())
+	ON CONFLICT (username) DO UPDATE SET
+	first_name = EXCLUDED.first_name,
+	last_name = EXCLUDED.last_name,
+	role = EXCLUDED.role,
+	federated_scopes = EXCLUDED.federated_scopes,
+	last_login = NOW()
+	WHERE users.identity_provider = EXCLUDED.identity_provider`
 )
 
 // UserInfo implements Database UserInfo function.
 func (d *Default) UserInfo(ctx context.Context, userID string) (*v1.UserInfo, error) {
 	ui := &v1.UserInfo{}
+	var scopes []string
 	if err := d.db.QueryRowContext(ctx, selectUserInfo, userID).
-		Scan(&ui.UserID, &ui.Password, &ui.FailedLogins, &ui.Role, &ui.Locale); err != nil {
+		Scan(&ui.UserID, &ui.Password, &ui.FailedLogins, &ui.Role, &ui.Locale, &ui.IdentityProvider, pq.Array(&scopes)); err != nil {
 		return nil, err
 	}
+	if len(scopes) > 0 {
+		ui.FederatedScopes = scopes
+	}
 	return ui, nil
 }
 
@@ -58,3 +75,20 @@ func (d *Default) ResetLoginCount(ctx context.Context, userID string) error {
 
 	return nil
 }
+
+// ProvisionFederatedUser implements Database ProvisionFederatedUser function.
+func (d *Default) ProvisionFederatedUser(ctx context.Context, user *v1.FederatedUser) error {
+	result, err := d.db.ExecContext(ctx, upsertFederatedUser, user.UserID, user.FirstName, user.LastName,
+		user.Role, user.Locale, user.IdentityProvider, pq.Array(user.Scopes))
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n != 1 {
+		return v1.ErrLocalAccount
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/repository/v1"
+)
+
+const (
+	selectUserMFA = "SELECT secret,enabled,last_used_step FROM users_mfa WHERE username = $1"
+	// codes are only accepted once, concurrent logins with the same code cannot both succeed
+	useMFAStep         = "UPDATE users_mfa SET last_used_step = $2 WHERE username = $1 AND enabled = TRUE AND last_used_step < $2"
+	useMFARecoveryCode = `
+	UPDATE users_mfa SET recovery_codes = array_remove(recovery_codes, $2)
+	WHERE username = $1 AND enabled = TRUE AND $2 = ANY(recovery_codes)`
+)
+
+// UserMFA implements Database UserMFA function.
+func (d *Default) UserMFA(ctx context.Context, userID string) (*v1.UserMFA, error) {
+	m := &v1.UserMFA{}
+	if err := d.db.QueryRowContext(ctx, selectUserMFA, userID).Scan(&m.Secret, &m.Enabled, &m.LastUsedStep); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UseMFAStep implements Database UseMFAStep function.
+func (d *Default) UseMFAStep(ctx context.Context, userID string, step int64) (bool, error) {
+	return d.updateOne(ctx, useMFAStep, userID, step)
+}
+
+// UseMFARecoveryCode implements Database UseMFARecoveryCode function.
+func (d *Default) UseMFARecoveryCode(ctx context.Context, userID, codeHash string) (bool, error) {
+	return d.updateOne(ctx, useMFARecoveryCode, userID, codeHash)
+}
+
+func (d *Default) updateOne(ctx context.Context, query string, args ...interface{}) (bool, error) {
+	result, err := d.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
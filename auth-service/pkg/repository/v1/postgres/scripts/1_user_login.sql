@@ -41,6 +41,12 @@ DELETE FROM users ;
 ALTER TABLE users
 ADD COLUMN IF NOT EXISTS profile_pic BYTEA;
 
+ALTER TABLE users
+ADD COLUMN IF NOT EXISTS identity_provider VARCHAR;
+
+ALTER TABLE users
+ADD COLUMN IF NOT EXISTS federated_scopes TEXT[] NOT NULL DEFAULT '{}';
+
 INSERT INTO users(username,first_name,last_name,password,locale,role)
 VALUES 
 ('admin@test.com','super','admin','$2a$11$su8WpIWDzAoOhrvsm2U83OXW8JDs36BJNGVhJgnUIOyZW6DolRJSK','en','SuperAdmin');
@@ -70,6 +70,11 @@ func (s *AuthServiceServer) ChangePassword(ctx context.Context, req *v1.ChangePa
 		logger.Log.Sugar().Errorw("service -CheckPassword - GenerateFromPassword", zap.Error(err))
 		return status.Error(codes.Internal, "unknown error")
 	}
+	// Tokens issued with the old password must not be usable anymore
+	if err := s.rep.RevokeUserTokens(ctx, req.Username); err != nil {
+		logger.Log.Sugar().Errorw("service/v1 - ChangePassword - RevokeUserTokens", zap.Error(err))
+		return status.Error(codes.Internal, "failed to revoke user tokens")
+	}
 	if err := s.rep.ChangePassword(ctx, req.Username, string(newPass)); err != nil {
 		logger.Log.Sugar().Errorw("service/v1 - ChangePassword - ChangePassword", zap.Error(err))
 		return status.Error(codes.Internal, "failed to change password")
@@ -98,6 +103,9 @@ func (s *AuthServiceServer) ForgotPassword(ctx context.Context, email string) er
 		logger.Log.Sugar().Errorw("service - AccountInfo", "user not found")
 		return status.Error(codes.InvalidArgument, "user not found")
 	}
+	if userInfo.IdentityProvider != "" {
+		return status.Error(codes.FailedPrecondition, "password of federated accounts is managed by their identity provider")
+	}
 	emailParams := helper.EmailParams{
 		FirstName: userInfo.FirstName,
 		Email:     userInfo.UserID,
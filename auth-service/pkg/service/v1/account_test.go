@@ -63,7 +63,7 @@ func Test_TokenValidation(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 			var cfg config.Config
-			tt.s = NewAuthServiceServer(rep, cfg, nil, nil)
+			tt.s = NewAuthServiceServer(rep, cfg, nil, nil, nil, nil)
 			err := tt.s.TokenValidation(ctx, tt.ip)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("AuthServiceServer.UserClaims() error = %v, wantErr %v", err, tt.wantErr)
@@ -95,6 +95,7 @@ func TestChangePassword(t *testing.T) {
 					Password:   "oldPassword",
 					FirstLogin: true,
 				}, nil).Times(1)
+				mockRepo.EXPECT().RevokeUserTokens(ctx, "test@example.com").Return(nil).Times(1)
 				mockRepo.EXPECT().ChangePassword(ctx, "test@example.com", gomock.Any()).Return(nil).Times(1)
 				mockRepo.EXPECT().ChangeUserFirstLogin(ctx, "test@example.com").Return(nil).Times(1)
 				mockRepo.EXPECT().DelToken(ctx, gomock.Any()).Return(nil).Times(1)
@@ -217,6 +218,18 @@ func TestForgotPassword(t *testing.T) {
 		assert.Equal(t, "user not found", status.Convert(err).Message())
 	})
 
+	t.Run("Federated user", func(t *testing.T) {
+		mockRepo.EXPECT().AccountInfo(ctx, email).Return(&v1.AccountInfo{
+			FirstName:        firstName,
+			UserID:           userID,
+			IdentityProvider: "corporate",
+		}, nil)
+
+		err := authService.ForgotPassword(ctx, email)
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
 	t.Run("SetToken error", func(t *testing.T) {
 		mockRepo.EXPECT().AccountInfo(ctx, email).Return(&v1.AccountInfo{
 			FirstName: firstName,
@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/api/v1"
+	repoV1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/repository/v1"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// roleRank orders roles so that users in several groups get the highest one.
+var roleRank = map[string]int{
+	string(repoV1.RoleUser):       1,
+	string(repoV1.RoleAdmin):      2,
+	string(repoV1.RoleSuperAdmin): 3,
+}
+
+// FederatedLogin implements AuthService FederatedLogin function
+func (s *AuthServiceServer) FederatedLogin(ctx context.Context, req *v1.FederatedLoginRequest) (*v1.LoginResponse, error) {
+	role, scopes := s.mapGroups(req.Groups)
+	if role == "" {
+		logger.Log.Sugar().Infow("service/v1 - FederatedLogin - no optisam role for user groups", "user", req.Username, "groups", req.Groups)
+		return nil, status.Error(codes.PermissionDenied, "user groups do not give access to optisam")
+	}
+	locale := req.Locale
+	if locale == "" {
+		locale = s.cfg.OIDC.DefaultLocale
+	}
+	err := s.rep.ProvisionFederatedUser(ctx, &repoV1.FederatedUser{
+		UserID:           req.Username,
+		FirstName:        req.FirstName,
+		LastName:         req.LastName,
+		Locale:           locale,
+		Role:             repoV1.Role(role),
+		IdentityProvider: req.IdentityProvider,
+		Scopes:           scopes,
+	})
+	if err != nil {
+		if errors.Is(err, repoV1.ErrLocalAccount) {
+			return nil, status.Error(codes.FailedPrecondition, "a local account already exists for this user")
+		}
+		logger.Log.Error("service/v1 - FederatedLogin - ProvisionFederatedUser", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to provision user account")
+	}
+	return &v1.LoginResponse{
+		UserID: req.Username,
+		Locale: locale,
+	}, nil
+}
+
+// mapGroups returns the highest role and all the scopes given by the identity provider groups.
+func (s *AuthServiceServer) mapGroups(groups []string) (string, []string) {
+	role := ""
+	var scopes []string
+	for _, m := range s.cfg.OIDC.GroupMappings {
+		if !elementExists(groups, m.Group) {
+			continue
+		}
+		if roleRank[m.Role] > roleRank[role] {
+			role = m.Role
+		}
+		for _, sc := range m.Scopes {
+			if !elementExists(scopes, sc) {
+				scopes = append(scopes, sc)
+			}
+		}
+	}
+	return role, scopes
+}
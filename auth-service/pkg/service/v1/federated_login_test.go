@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/config"
+	repv1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/repository/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/repository/v1/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_authServiceServer_FederatedLogin(t *testing.T) {
+	cfg := config.Config{OIDC: config.OIDCConfig{
+		DefaultLocale: "en",
+		GroupMappings: []config.OIDCGroupMapping{
+			{Group: "optisam-users", Role: "User", Scopes: []string{"OFR", "OSP"}},
+			{Group: "optisam-admins", Role: "Admin", Scopes: []string{"OFR"}},
+			{Group: "optisam-auditors", Role: "User", Scopes: []string{"AUD"}},
+		},
+	}}
+	var mockCtrl *gomock.Controller
+	var rep repv1.Repository
+	tests := []struct {
+		name     string
+		req      *v1.FederatedLoginRequest
+		setup    func()
+		want     *v1.LoginResponse
+		wantCode codes.Code
+	}{
+		{name: "SUCCESS - highest role and all scopes",
+			req: &v1.FederatedLoginRequest{
+				IdentityProvider: "corporate",
+				Username:         "user@test.com",
+				FirstName:        "first",
+				Groups:           []string{"optisam-users", "optisam-admins", "other"},
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockDB := mock.NewMockRepository(mockCtrl)
+				rep = mockDB
+				mockDB.EXPECT().ProvisionFederatedUser(gomock.Any(), &repv1.FederatedUser{
+					UserID:           "user@test.com",
+					FirstName:        "first",
+					Locale:           "en",
+					Role:             repv1.RoleAdmin,
+					IdentityProvider: "corporate",
+					Scopes:           []string{"OFR", "OSP"},
+				}).Return(nil).Times(1)
+			},
+			want: &v1.LoginResponse{
+				UserID: "user@test.com",
+				Locale: "en",
+			},
+		},
+		{name: "FAILURE - no mapped group",
+			req: &v1.FederatedLoginRequest{
+				IdentityProvider: "corporate",
+				Username:         "user@test.com",
+				Groups:           []string{"other"},
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = mock.NewMockRepository(mockCtrl)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{name: "FAILURE - local account exists",
+			req: &v1.FederatedLoginRequest{
+				IdentityProvider: "corporate",
+				Username:         "user@test.com",
+				Locale:           "fr",
+				Groups:           []string{"optisam-auditors"},
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockDB := mock.NewMockRepository(mockCtrl)
+				rep = mockDB
+				mockDB.EXPECT().ProvisionFederatedUser(gomock.Any(), gomock.Any()).Return(repv1.ErrLocalAccount).Times(1)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{name: "FAILURE - db error",
+			req: &v1.FederatedLoginRequest{
+				IdentityProvider: "corporate",
+				Username:         "user@test.com",
+				Groups:           []string{"optisam-users"},
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockDB := mock.NewMockRepository(mockCtrl)
+				rep = mockDB
+				mockDB.EXPECT().ProvisionFederatedUser(gomock.Any(), gomock.Any()).Return(errors.New("db error")).Times(1)
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			s := NewAuthServiceServer(rep, cfg, nil, nil, nil, nil)
+			got, err := s.FederatedLogin(context.Background(), tt.req)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			if assert.Empty(t, err) {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/challenge"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/errors"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/mfa"
+
+	"go.uber.org/zap"
+)
+
+// MFAChallenge implements AuthService MFAChallenge function
+func (s *AuthServiceServer) MFAChallenge(ctx context.Context, userID, clientID, scope string) (*v1.MFAChallengeResponse, error) {
+	info, err := s.rep.UserInfo(ctx, userID)
+	if err != nil {
+		logger.Log.Error("service/v1 - MFAChallenge - UserInfo", zap.Error(err))
+		return nil, err
+	}
+	role, err := translateRole(info.Role)
+	if err != nil {
+		logger.Log.Error("service/v1 - MFAChallenge - translateRole", zap.Error(err))
+		return nil, err
+	}
+	m, err := s.rep.UserMFA(ctx, userID)
+	if err != nil && err != sql.ErrNoRows {
+		logger.Log.Error("service/v1 - MFAChallenge - UserMFA", zap.Error(err))
+		return nil, err
+	}
+	enrolled := m != nil && m.Enabled
+	if !enrolled && !s.cfg.MFA.Required(role) {
+		return nil, nil
+	}
+	token, c, err := challenge.New(userID, clientID, scope)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.challenges.Save(ctx, token, c); err != nil {
+		logger.Log.Error("service/v1 - MFAChallenge - Save", zap.Error(err))
+		return nil, err
+	}
+	resp := &v1.MFAChallengeResponse{
+		Token:     token,
+		ExpiresIn: int(challenge.TTL.Seconds()),
+	}
+	if !enrolled {
+		// user cannot login until it has enrolled an authenticator with account-service
+		clms, err := s.UserClaims(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		resp.EnrollmentRequired = true
+		if resp.EnrollmentToken, err = s.tokens.GenerateAccessToken(mfa.EnrollmentClaims(clms)); err != nil {
+			logger.Log.Error("service/v1 - MFAChallenge - GenerateAccessToken", zap.Error(err))
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// VerifyMFA implements AuthService VerifyMFA function
+func (s *AuthServiceServer) VerifyMFA(ctx context.Context, req *v1.VerifyMFARequest) (*v1.LoginResponse, error) {
+	c, err := s.challenges.Get(ctx, req.Token)
+	if err != nil {
+		if err == challenge.ErrUnknownChallenge {
+			return nil, errors.ErrMFAChallengeExpired
+		}
+		logger.Log.Error("service/v1 - VerifyMFA - Get", zap.Error(err))
+		return nil, err
+	}
+	// challenges are cheap to get, wrong codes are also limited across the challenges of the user
+	locked, err := s.challenges.Locked(ctx, c.UserID)
+	if err != nil {
+		logger.Log.Error("service/v1 - VerifyMFA - Locked", zap.Error(err))
+		return nil, err
+	}
+	if locked {
+		return nil, errors.ErrMFALocked
+	}
+	m, err := s.rep.UserMFA(ctx, c.UserID)
+	if err != nil && err != sql.ErrNoRows {
+		logger.Log.Error("service/v1 - VerifyMFA - UserMFA", zap.Error(err))
+		return nil, err
+	}
+	if m == nil || !m.Enabled {
+		return nil, errors.ErrMFANotEnrolled
+	}
+	valid := false
+	if mfa.IsRecoveryCode(req.Code) {
+		valid, err = s.rep.UseMFARecoveryCode(ctx, c.UserID, mfa.HashRecoveryCode(req.Code))
+	} else if step, ok := mfa.Validate(m.Secret, req.Code, time.Now(), m.LastUsedStep); ok {
+		valid, err = s.rep.UseMFAStep(ctx, c.UserID, step)
+	}
+	if err != nil {
+		logger.Log.Error("service/v1 - VerifyMFA - failed to use code", zap.Error(err))
+		return nil, err
+	}
+	if !valid {
+		if err := s.challenges.Fail(ctx, req.Token, c.UserID); err != nil {
+			logger.Log.Error("service/v1 - VerifyMFA - Fail", zap.Error(err))
+		}
+		return nil, errors.ErrInvalidMFACode
+	}
+	if err := s.challenges.Delete(ctx, req.Token); err != nil {
+		logger.Log.Error("service/v1 - VerifyMFA - Delete", zap.Error(err))
+		return nil, err
+	}
+	return &v1.LoginResponse{UserID: c.UserID, ClientID: c.ClientID, Scope: c.Scope}, nil
+}
@@ -0,0 +1,298 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/challenge"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/config"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/errors"
+	repv1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/repository/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/repository/v1/mock"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/mfa"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+	mock_token "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+const testMFASecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+type fakeChallengeStore struct {
+	challenges map[string]*challenge.Challenge
+	attempts   map[string]int
+	failures   map[string]int
+}
+
+func newFakeChallengeStore() *fakeChallengeStore {
+	return &fakeChallengeStore{
+		challenges: map[string]*challenge.Challenge{},
+		attempts:   map[string]int{},
+		failures:   map[string]int{},
+	}
+}
+
+func (f *fakeChallengeStore) Save(ctx context.Context, token string, c *challenge.Challenge) error {
+	f.challenges[token] = c
+	return nil
+}
+
+func (f *fakeChallengeStore) Get(ctx context.Context, token string) (*challenge.Challenge, error) {
+	c, ok := f.challenges[token]
+	if !ok {
+		return nil, challenge.ErrUnknownChallenge
+	}
+	return c, nil
+}
+
+func (f *fakeChallengeStore) Fail(ctx context.Context, token, userID string) error {
+	f.failures[userID]++
+	f.attempts[token]++
+	if f.attempts[token] >= challenge.MaxAttempts {
+		delete(f.challenges, token)
+	}
+	return nil
+}
+
+func (f *fakeChallengeStore) Locked(ctx context.Context, userID string) (bool, error) {
+	return f.failures[userID] >= challenge.MaxUserFailures, nil
+}
+
+func (f *fakeChallengeStore) Delete(ctx context.Context, token string) error {
+	delete(f.challenges, token)
+	return nil
+}
+
+func Test_authServiceServer_MFAChallenge(t *testing.T) {
+	cfg := config.Config{MFA: mfa.Policy{RequiredRoles: mfa.DefaultRequiredRoles}}
+	var mockCtrl *gomock.Controller
+	var rep repv1.Repository
+	var gen *mock_token.MockGenerator
+	tests := []struct {
+		name           string
+		userID         string
+		setup          func()
+		wantChallenge  bool
+		wantEnrollment bool
+	}{
+		{name: "SUCCESS - user without mfa",
+			userID: "user@test.com",
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockDB := mock.NewMockRepository(mockCtrl)
+				rep = mockDB
+				mockDB.EXPECT().UserInfo(gomock.Any(), "user@test.com").Return(&repv1.UserInfo{UserID: "user@test.com", Role: repv1.RoleUser}, nil).Times(1)
+				mockDB.EXPECT().UserMFA(gomock.Any(), "user@test.com").Return(nil, sql.ErrNoRows).Times(1)
+			},
+		},
+		{name: "SUCCESS - user who enabled mfa",
+			userID: "user@test.com",
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockDB := mock.NewMockRepository(mockCtrl)
+				rep = mockDB
+				mockDB.EXPECT().UserInfo(gomock.Any(), "user@test.com").Return(&repv1.UserInfo{UserID: "user@test.com", Role: repv1.RoleUser}, nil).Times(1)
+				mockDB.EXPECT().UserMFA(gomock.Any(), "user@test.com").Return(&repv1.UserMFA{Secret: testMFASecret, Enabled: true}, nil).Times(1)
+			},
+			wantChallenge: true,
+		},
+		{name: "SUCCESS - admin must enroll",
+			userID: "admin@test.com",
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockDB := mock.NewMockRepository(mockCtrl)
+				rep = mockDB
+				mockDB.EXPECT().UserInfo(gomock.Any(), "admin@test.com").Return(&repv1.UserInfo{UserID: "admin@test.com", Role: repv1.RoleAdmin}, nil).Times(2)
+				// an enrollment which is not activated yet does not count
+				mockDB.EXPECT().UserMFA(gomock.Any(), "admin@test.com").Return(&repv1.UserMFA{Secret: testMFASecret}, nil).Times(1)
+				mockDB.EXPECT().UserOwnedGroupsDirect(gomock.Any(), "admin@test.com").Return(nil, nil).Times(1)
+				gen = mock_token.NewMockGenerator(mockCtrl)
+				gen.EXPECT().GenerateAccessToken(gomock.Any()).DoAndReturn(func(clms *claims.Claims) (string, error) {
+					assert.True(t, mfa.IsEnrollmentClaims(clms))
+					assert.Equal(t, "admin@test.com", clms.UserID)
+					return "enrollment", nil
+				}).Times(1)
+			},
+			wantChallenge:  true,
+			wantEnrollment: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen = nil
+			tt.setup()
+			if gen == nil {
+				gen = mock_token.NewMockGenerator(mockCtrl)
+			}
+			defer mockCtrl.Finish()
+			store := newFakeChallengeStore()
+			s := NewAuthServiceServer(rep, cfg, nil, nil, store, gen)
+			got, err := s.MFAChallenge(context.Background(), tt.userID, "optisam", "OFR")
+			if !assert.Empty(t, err) {
+				return
+			}
+			if !tt.wantChallenge {
+				assert.Nil(t, got)
+				return
+			}
+			if assert.NotNil(t, got) {
+				assert.Equal(t, &challenge.Challenge{UserID: tt.userID, ClientID: "optisam", Scope: "OFR"}, store.challenges[got.Token])
+				assert.Equal(t, tt.wantEnrollment, got.EnrollmentRequired)
+				if tt.wantEnrollment {
+					assert.Equal(t, "enrollment", got.EnrollmentToken)
+				}
+			}
+		})
+	}
+}
+
+func Test_authServiceServer_VerifyMFA(t *testing.T) {
+	code, err := mfa.Code(testMFASecret, mfa.Step(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	enabled := &repv1.UserMFA{Secret: testMFASecret, Enabled: true}
+	var mockCtrl *gomock.Controller
+	var rep repv1.Repository
+	tests := []struct {
+		name          string
+		token         string
+		code          string
+		setup         func()
+		wantErr       error
+		wantChallenge bool
+	}{
+		{name: "SUCCESS - totp code",
+			token: "challenge",
+			code:  code,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockDB := mock.NewMockRepository(mockCtrl)
+				rep = mockDB
+				mockDB.EXPECT().UserMFA(gomock.Any(), "admin@test.com").Return(enabled, nil).Times(1)
+				mockDB.EXPECT().UseMFAStep(gomock.Any(), "admin@test.com", gomock.Any()).Return(true, nil).Times(1)
+			},
+		},
+		{name: "SUCCESS - recovery code",
+			token: "challenge",
+			code:  "abcde-12345",
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockDB := mock.NewMockRepository(mockCtrl)
+				rep = mockDB
+				mockDB.EXPECT().UserMFA(gomock.Any(), "admin@test.com").Return(enabled, nil).Times(1)
+				mockDB.EXPECT().UseMFARecoveryCode(gomock.Any(), "admin@test.com", mfa.HashRecoveryCode("abcde-12345")).Return(true, nil).Times(1)
+			},
+		},
+		{name: "FAILURE - code already used",
+			token: "challenge",
+			code:  code,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockDB := mock.NewMockRepository(mockCtrl)
+				rep = mockDB
+				mockDB.EXPECT().UserMFA(gomock.Any(), "admin@test.com").Return(enabled, nil).Times(1)
+				mockDB.EXPECT().UseMFAStep(gomock.Any(), "admin@test.com", gomock.Any()).Return(false, nil).Times(1)
+			},
+			wantErr:       errors.ErrInvalidMFACode,
+			wantChallenge: true,
+		},
+		{name: "FAILURE - wrong code",
+			token: "challenge",
+			code:  "000000",
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockDB := mock.NewMockRepository(mockCtrl)
+				rep = mockDB
+				mockDB.EXPECT().UserMFA(gomock.Any(), "admin@test.com").Return(enabled, nil).Times(1)
+			},
+			wantErr:       errors.ErrInvalidMFACode,
+			wantChallenge: true,
+		},
+		{name: "FAILURE - not enrolled yet",
+			token: "challenge",
+			code:  code,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockDB := mock.NewMockRepository(mockCtrl)
+				rep = mockDB
+				mockDB.EXPECT().UserMFA(gomock.Any(), "admin@test.com").Return(nil, sql.ErrNoRows).Times(1)
+			},
+			wantErr:       errors.ErrMFANotEnrolled,
+			wantChallenge: true,
+		},
+		{name: "FAILURE - unknown challenge",
+			token: "other",
+			code:  code,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = mock.NewMockRepository(mockCtrl)
+			},
+			wantErr:       errors.ErrMFAChallengeExpired,
+			wantChallenge: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			store := newFakeChallengeStore()
+			store.challenges["challenge"] = &challenge.Challenge{UserID: "admin@test.com", ClientID: "optisam", Scope: "OFR"}
+			s := NewAuthServiceServer(rep, config.Config{}, nil, nil, store, nil)
+			got, err := s.VerifyMFA(context.Background(), &v1.VerifyMFARequest{Token: tt.token, Code: tt.code})
+			assert.Equal(t, tt.wantChallenge, store.challenges["challenge"] != nil, "a challenge is only passed once")
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+			if assert.Empty(t, err) {
+				assert.Equal(t, &v1.LoginResponse{UserID: "admin@test.com", ClientID: "optisam", Scope: "OFR"}, got)
+			}
+		})
+	}
+}
+
+func Test_authServiceServer_VerifyMFA_attempts(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockDB := mock.NewMockRepository(mockCtrl)
+	mockDB.EXPECT().UserMFA(gomock.Any(), "admin@test.com").Return(&repv1.UserMFA{Secret: testMFASecret, Enabled: true}, nil).Times(challenge.MaxAttempts)
+	store := newFakeChallengeStore()
+	store.challenges["challenge"] = &challenge.Challenge{UserID: "admin@test.com"}
+	s := NewAuthServiceServer(mockDB, config.Config{}, nil, nil, store, nil)
+	for i := 0; i < challenge.MaxAttempts; i++ {
+		_, err := s.VerifyMFA(context.Background(), &v1.VerifyMFARequest{Token: "challenge", Code: "000000"})
+		assert.Equal(t, errors.ErrInvalidMFACode, err)
+	}
+	_, err := s.VerifyMFA(context.Background(), &v1.VerifyMFARequest{Token: "challenge", Code: "000000"})
+	assert.Equal(t, errors.ErrMFAChallengeExpired, err, "user must login again after too many wrong codes")
+}
+
+func Test_authServiceServer_VerifyMFA_lockout(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockDB := mock.NewMockRepository(mockCtrl)
+	mockDB.EXPECT().UserMFA(gomock.Any(), "admin@test.com").Return(&repv1.UserMFA{Secret: testMFASecret, Enabled: true}, nil).Times(challenge.MaxUserFailures)
+	store := newFakeChallengeStore()
+	s := NewAuthServiceServer(mockDB, config.Config{}, nil, nil, store, nil)
+	// new challenges do not give more tries
+	for i := 0; i < challenge.MaxUserFailures; i++ {
+		token := fmt.Sprintf("challenge-%d", i)
+		store.challenges[token] = &challenge.Challenge{UserID: "admin@test.com"}
+		_, err := s.VerifyMFA(context.Background(), &v1.VerifyMFARequest{Token: token, Code: "000000"})
+		assert.Equal(t, errors.ErrInvalidMFACode, err)
+	}
+	code, err := mfa.Code(testMFASecret, mfa.Step(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.challenges["challenge"] = &challenge.Challenge{UserID: "admin@test.com"}
+	_, err = s.VerifyMFA(context.Background(), &v1.VerifyMFARequest{Token: "challenge", Code: code})
+	assert.Equal(t, errors.ErrMFALocked, err, "a locked user cannot verify even a valid code")
+}
@@ -6,11 +6,13 @@ import (
 	"fmt"
 
 	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/challenge"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/config"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/oauth2/errors"
 	repoV1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/auth-service/pkg/repository/v1"
 
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
 
 	"github.com/confluentinc/confluent-kafka-go/kafka"
@@ -25,15 +27,19 @@ type AuthServiceServer struct {
 	cfg           config.Config
 	notification  v1.NotificationServiceClient
 	kafkaProducer *kafka.Producer
+	challenges    challenge.Store
+	tokens        token.Generator
 }
 
 // NewAuthServiceServer creates Auth service
-func NewAuthServiceServer(rep repoV1.Repository, cfg config.Config, grpcConnections map[string]*grpc.ClientConn, kafkaProducer *kafka.Producer) *AuthServiceServer {
+func NewAuthServiceServer(rep repoV1.Repository, cfg config.Config, grpcConnections map[string]*grpc.ClientConn, kafkaProducer *kafka.Producer, challenges challenge.Store, tokens token.Generator) *AuthServiceServer {
 	return &AuthServiceServer{
 		rep:           rep,
 		cfg:           cfg,
 		notification:  v1.NewNotificationServiceClient(grpcConnections["notification"]),
 		kafkaProducer: kafkaProducer,
+		challenges:    challenges,
+		tokens:        tokens,
 	}
 }
 
@@ -48,6 +54,11 @@ func (s *AuthServiceServer) Login(ctx context.Context, req *v1.LoginRequest) (*v
 		return nil, err
 	}
 
+	// federated users login with their identity provider, lockout only applies to local accounts
+	if ui.IdentityProvider != "" {
+		return nil, errors.ErrInvalidCredentials
+	}
+
 	// check if user is blocked
 	// if ui.FailedLogins >= 3 {
 	// 	return nil, errors.ErrLoginBlockedAccount
@@ -96,8 +107,14 @@ func (s *AuthServiceServer) UserClaims(ctx context.Context, userID string) (*cla
 		logger.Log.Error("service/v1 - UserClaims cannot fetch user info", zap.Error(err))
 		return nil, fmt.Errorf("cannot get claims for user: %v", userID)
 	}
-	var scopes []string
+	var scopes, groups []string
+	for _, s := range info.FederatedScopes {
+		if !elementExists(scopes, s) {
+			scopes = append(scopes, s)
+		}
+	}
 	for _, grp := range grps {
+		groups = append(groups, grp.Name)
 		for _, s := range grp.Scopes {
 			if !elementExists(scopes, s) {
 				scopes = append(scopes, s)
@@ -109,6 +126,7 @@ func (s *AuthServiceServer) UserClaims(ctx context.Context, userID string) (*cla
 		Role:   role,
 		Locale: info.Locale,
 		Socpes: scopes,
+		Groups: groups,
 	}, nil
 }
 
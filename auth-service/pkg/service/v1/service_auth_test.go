@@ -294,6 +294,26 @@ func Test_authServiceServer_Login(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{name: "failure federated user",
+			s: &AuthServiceServer{},
+			args: args{
+				req: &v1.LoginRequest{
+					Username: "user1@test.com",
+					Password: "",
+				},
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockDB := mock.NewMockRepository(mockCtrl)
+				rep = mockDB
+				mockDB.EXPECT().UserInfo(nil, "user1@test.com").
+					Return(&repv1.UserInfo{
+						UserID:           "user1@test.com",
+						IdentityProvider: "corporate",
+					}, nil).Times(1)
+			},
+			wantErr: true,
+		},
 		{name: "failure successful login but failure in resetting login count",
 			s: &AuthServiceServer{},
 			args: args{
@@ -321,7 +341,7 @@ func Test_authServiceServer_Login(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
-			tt.s = NewAuthServiceServer(rep, cfg, nil, nil)
+			tt.s = NewAuthServiceServer(rep, cfg, nil, nil, nil, nil)
 			got, err := tt.s.Login(tt.args.ctx, tt.args.req)
 			if tt.wantErr {
 				if !assert.Errorf(t, err, "AuthServiceServer.Login() - expexted error got nil") {
@@ -372,6 +392,7 @@ func Test_authServiceServer_UserClaims(t *testing.T) {
 				mockDB.EXPECT().UserOwnedGroupsDirect(nil, "user1@test.com").Return([]*repv1.Group{
 					{
 						ID:     2,
+						Name:   "ROOT.A",
 						Scopes: []string{"A", "B"},
 					},
 				}, nil).Times(1)
@@ -380,6 +401,37 @@ func Test_authServiceServer_UserClaims(t *testing.T) {
 				UserID: "user1@test.com",
 				Role:   "User",
 				Socpes: []string{"A", "B"},
+				Groups: []string{"ROOT.A"},
+			},
+		},
+		{name: "SUCCESS - federated scopes",
+			s: &AuthServiceServer{},
+			args: args{
+				userID: "user1@test.com",
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockDB := mock.NewMockRepository(mockCtrl)
+				rep = mockDB
+				mockDB.EXPECT().UserInfo(nil, "user1@test.com").Return(&repv1.UserInfo{
+					UserID:           "user1@test.com",
+					Role:             "User",
+					IdentityProvider: "corporate",
+					FederatedScopes:  []string{"B", "C"},
+				}, nil).Times(1)
+				mockDB.EXPECT().UserOwnedGroupsDirect(nil, "user1@test.com").Return([]*repv1.Group{
+					{
+						ID:     2,
+						Name:   "ROOT.A",
+						Scopes: []string{"A", "B"},
+					},
+				}, nil).Times(1)
+			},
+			want: &claims.Claims{
+				UserID: "user1@test.com",
+				Role:   "User",
+				Socpes: []string{"B", "C", "A"},
+				Groups: []string{"ROOT.A"},
 			},
 		},
 		{name: "SUCCESS - Admin role",
@@ -398,6 +450,7 @@ func Test_authServiceServer_UserClaims(t *testing.T) {
 				mockDB.EXPECT().UserOwnedGroupsDirect(nil, "user1@test.com").Return([]*repv1.Group{
 					{
 						ID:     2,
+						Name:   "ROOT.A",
 						Scopes: []string{"A", "B"},
 					},
 				}, nil).Times(1)
@@ -406,6 +459,7 @@ func Test_authServiceServer_UserClaims(t *testing.T) {
 				UserID: "user1@test.com",
 				Role:   "Admin",
 				Socpes: []string{"A", "B"},
+				Groups: []string{"ROOT.A"},
 			},
 		},
 		{name: "SUCCESS - SuperAdmin role",
@@ -424,6 +478,7 @@ func Test_authServiceServer_UserClaims(t *testing.T) {
 				mockDB.EXPECT().UserOwnedGroupsDirect(nil, "user1@test.com").Return([]*repv1.Group{
 					{
 						ID:     2,
+						Name:   "ROOT.A",
 						Scopes: []string{"A", "B"},
 					},
 				}, nil).Times(1)
@@ -432,6 +487,7 @@ func Test_authServiceServer_UserClaims(t *testing.T) {
 				UserID: "user1@test.com",
 				Role:   "SuperAdmin",
 				Socpes: []string{"A", "B"},
+				Groups: []string{"ROOT.A"},
 			},
 		},
 		{name: "FAILURE - user not found",
@@ -496,10 +552,12 @@ func Test_authServiceServer_UserClaims(t *testing.T) {
 				mockDB.EXPECT().UserOwnedGroupsDirect(nil, "user1@test.com").Return([]*repv1.Group{
 					{
 						ID:     2,
+						Name:   "ROOT.A",
 						Scopes: []string{"A", "B"},
 					},
 					{
-						ID:     2,
+						ID:     3,
+						Name:   "ROOT.B",
 						Scopes: []string{"B", "C"},
 					},
 				}, nil).Times(1)
@@ -508,6 +566,7 @@ func Test_authServiceServer_UserClaims(t *testing.T) {
 				UserID: "user1@test.com",
 				Role:   "User",
 				Socpes: []string{"A", "B", "C"},
+				Groups: []string{"ROOT.A", "ROOT.B"},
 			},
 		},
 	}
@@ -515,7 +574,7 @@ func Test_authServiceServer_UserClaims(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 			var cfg config.Config
-			tt.s = NewAuthServiceServer(rep, cfg, nil, nil)
+			tt.s = NewAuthServiceServer(rep, cfg, nil, nil, nil, nil)
 			got, err := tt.s.UserClaims(tt.args.ctx, tt.args.userID)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("AuthServiceServer.UserClaims() error = %v, wantErr %v", err, tt.wantErr)
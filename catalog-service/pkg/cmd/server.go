@@ -292,6 +292,7 @@ func RunServer() error {
 	if err != nil {
 		logger.Log.Fatal("Failed to Load RBAC policies", zap.Error(err))
 	}
+	apiKeys := iam.NewAPIKeyStore(cfg.IAM)
 
 	// run HTTP gateway
 	fmt.Printf("%s - grpc port,%s - http port", cfg.GRPCPort, cfg.HTTPPort)
@@ -300,8 +301,13 @@ func RunServer() error {
 			logger.Log.Sugar().Debug("Recovered in RunServer", r)
 		}
 	}()
+	auditPublisher, err := iam.NewAuditPublisher(cfg.IAM)
+	if err != nil {
+		logger.Log.Fatal("Failed to create audit publisher", zap.Error(err))
+	}
+	revoked := iam.NewRevocationList(cfg.IAM)
 	go func() {
-		_ = rest.RunServer(ctx, cfg.GRPCPort, cfg.HTTPPort, verifyKey, db, grpcClientMap, fmt.Sprintf("http://%s/api/v1/token", cfg.HTTPServers.Address["auth"]), cfg.IAM.APIKey, cfg.Application, redisC)
+		_ = rest.RunServer(ctx, cfg.GRPCPort, cfg.HTTPPort, verifyKey, db, grpcClientMap, cfg.Application, redisC, revoked, apiKeys)
 	}()
-	return grpc.RunServer(ctx, v1API, cfg.GRPCPort, verifyKey, authZPolicies, cfg.IAM.APIKey)
+	return grpc.RunServer(ctx, v1API, cfg.GRPCPort, verifyKey, authZPolicies, cfg.IAM.APIKey, revoked, apiKeys, auditPublisher)
 }
@@ -1,7 +1,6 @@
 package config
 
 import (
-	"optisam-backend/common/optisam/config"
 	"optisam-backend/common/optisam/iam"
 	"optisam-backend/common/optisam/jaeger"
 	"optisam-backend/common/optisam/logger"
@@ -62,13 +61,19 @@ type Config struct {
 	// For interservice http calls(non grpc server)["ip:port"]
 	HTTPServers httpConfg
 	//Application cred.
-	Application config.Application
+	Application ApplicationConfig
 }
 
 type httpConfg struct {
 	Address map[string]string
 }
 
+// ApplicationConfig holds the service account the service calls the other services with
+type ApplicationConfig struct {
+	// APIKey is the personal api key of the service account
+	APIKey string
+}
+
 // InstrumentationConfig represents the instrumentation related configuration.
 type InstrumentationConfig struct {
 
@@ -94,6 +99,10 @@ func (c Config) Validate() error {
 		return errors.New("environment is required")
 	}
 
+	if c.Application.APIKey == "" {
+		return errors.New("application.apikey is required")
+	}
+
 	if err := c.Instrumentation.Validate(); err != nil {
 		return err
 	}
@@ -181,12 +190,7 @@ func Configure(v *viper.Viper, p *pflag.FlagSet) {
 	_ = v.BindEnv("database.migration.version", "MIG_VERSION")
 	_ = v.BindEnv("database.migration.direction", "MIG_DIR")
 
-	_ = v.BindEnv("application.usernameadmin", "APP_ADMIN_USERNAME")
-	_ = v.BindEnv("application.passwordadmin", "APP_ADMIN_PASSWORD")
-	_ = v.BindEnv("application.usernamesuperadmin", "APP_SUPER_ADMIN_USERNAME")
-	_ = v.BindEnv("application.passwordsuperadmin", "APP_SUPER_ADMIN_PASSWORD")
-	_ = v.BindEnv("application.usernameuser", "APP_USER_USERNAME")
-	_ = v.BindEnv("application.passworduser", "APP_USER_PASSWORD")
+	_ = v.BindEnv("application.apikey", "APP_API_KEY")
 	// PKI configuration
 	v.SetDefault("pki.publickeypath", ".")
 
@@ -6,25 +6,28 @@ import (
 	"log"
 	"net"
 	v1 "optisam-backend/catalog-service/pkg/api/v1"
+	"optisam-backend/common/optisam/audit"
 	"optisam-backend/common/optisam/logger"
 	mw "optisam-backend/common/optisam/middleware/grpc"
+	"optisam-backend/common/optisam/opa"
+	"optisam-backend/common/optisam/token/apikey"
+	"optisam-backend/common/optisam/token/revocation"
 	"os"
 	"os/signal"
 
-	"github.com/open-policy-agent/opa/rego"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
 )
 
 // RunServer runs gRPC service to publish Auth service
-func RunServer(ctx context.Context, v1API v1.ProductCatalogServer, port string, verifyKey *rsa.PublicKey, p *rego.PreparedEvalQuery, apiKey string) error {
+func RunServer(ctx context.Context, v1API v1.ProductCatalogServer, port string, verifyKey *rsa.PublicKey, p *opa.Policy, apiKey string, revoked revocation.List, keys apikey.Store, auditPublisher audit.Publisher) error {
 	listen, err := net.Listen("tcp", ":"+port)
 	if err != nil {
 		return err
 	}
 
 	// gRPC server statup options
-	opts := mw.Chained(logger.Log, verifyKey, p, apiKey)
+	opts := mw.Chained(logger.Log, verifyKey, p, apiKey, mw.WithRevocationList(revoked), mw.WithAPIKeyStore(keys), mw.WithAuditPublisher(auditPublisher))
 	opts = append(opts, grpc.StatsHandler(&ocgrpc.ServerHandler{}))
 	// rpc message size to 8mb
 	// opts = append(opts, grpc.MaxSendMsgSize(8388608))
@@ -5,14 +5,9 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
-	"fmt"
-	"io/ioutil"
-	"log"
 	"net/http"
-	"net/url"
 	accv1 "optisam-backend/account-service/pkg/api/v1"
 	v1 "optisam-backend/catalog-service/pkg/api/v1"
-	"optisam-backend/common/optisam/config"
 	"optisam-backend/common/optisam/logger"
 	"optisam-backend/common/optisam/middleware/grpc"
 	"optisam-backend/common/optisam/strcomp"
@@ -22,7 +17,6 @@ import (
 
 	"github.com/lib/pq"
 	"go.uber.org/zap"
-	"google.golang.org/grpc/metadata"
 )
 
 func (handler *handler) GetEditor(w http.ResponseWriter, r *http.Request) {
@@ -178,16 +172,11 @@ func (handler *handler) GetEditor(w http.ResponseWriter, r *http.Request) {
 			}
 			editor.Scopes = scopeCode
 		} else {
-			cronCtx, err := CreateSharedContext(handler.AuthAPI, handler.Application)
+			cronAPIKeyCtx, err := handler.sharedContext()
 			if err != nil {
 				logger.Log.Error("couldnt fetch token, will try next time when API will execute", zap.Any("error", err))
 			}
-			if cronCtx != nil {
-				cronAPIKeyCtx, err := grpc.AddClaimsInContext(*cronCtx, handler.VerifyKey, handler.APIKey)
-				if err != nil {
-					logger.Log.Error("Cron AddClaims Failed", zap.Error(err))
-				}
-
+			if cronAPIKeyCtx != nil {
 				listScopesNames, err := handler.account.GetScopeLists(cronAPIKeyCtx, &accv1.GetScopeListRequest{Scopes: scopeCode})
 				if err != nil {
 					logger.Log.Error("couldnt fetch scope name, will try next time when API will execute", zap.Any("error", err))
@@ -547,37 +536,11 @@ func validatePagination(r *http.Request) error {
 
 }
 
-// CreateSharedContext will return admin auth token
-func CreateSharedContext(api string, appCred config.Application) (*context.Context, error) {
-	logger.Log.Info("CreateSharedContext", zap.Any("CreateSharedContext called", time.Now()))
-	ctx := context.Background()
-	respMap := make(map[string]interface{})
-	data := url.Values{
-		"username":   {appCred.UserNameSuperAdmin},
-		"password":   {appCred.PasswordSuperAdmin},
-		"grant_type": {"password"},
-	}
-	logger.Log.Info("CreateSharedContext", zap.Any("before auth api", time.Now()))
-	resp, err := http.PostForm(api, data) // nolint: gosec
-	logger.Log.Info("CreateSharedContext", zap.Any("after auth api", time.Now()))
-	if err != nil {
-		log.Println("Failed to get user claims  ", err)
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	bodyBytes, _ := ioutil.ReadAll(resp.Body)
-	// log.Println(" Token Data received", string(bodyBytes))
-	err = json.Unmarshal(bodyBytes, &respMap)
-	if err != nil {
-		log.Println("failed to unmarshal byte data", err)
-		return nil, err
+// sharedContext returns the context used to call other services on behalf of catalog, it is
+// authenticated with the personal api key of the service account.
+func (handler *handler) sharedContext() (context.Context, error) {
+	if handler.Application.APIKey == "" || handler.APIKeys == nil {
+		return nil, errors.New("api key of the service account is not configured")
 	}
-	authStr := fmt.Sprintf("Bearer %s", respMap["access_token"].(string))
-	md := metadata.Pairs("Authorization", authStr)
-
-	ctx = metadata.NewIncomingContext(ctx, md)
-	logger.Log.Info("CreateSharedContext", zap.Any("CreateSharedContext executed", time.Now()))
-
-	return &ctx, nil
+	return grpc.AddAPIKeyClaimsInContext(context.Background(), handler.APIKeys, handler.Application.APIKey)
 }
@@ -8,7 +8,6 @@ import (
 	"net/http"
 	accv1 "optisam-backend/account-service/pkg/api/v1"
 	"optisam-backend/common/optisam/logger"
-	"optisam-backend/common/optisam/middleware/grpc"
 	"sort"
 	"strings"
 	"sync"
@@ -137,19 +136,12 @@ func (handler *handler) GetEditorFilters(w http.ResponseWriter, r *http.Request)
 
 func createScopeMap(handler *handler) map[string]string {
 	scopesmap := make(map[string]string)
-	cronCtx, err := CreateSharedContext(handler.AuthAPI, handler.Application)
+	cronAPIKeyCtx, err := handler.sharedContext()
 	if err != nil {
 		logger.Log.Error("couldnt fetch token, will try next time when API will execute", zap.Any("error", err))
 		return nil
 	}
-	if cronCtx != nil {
-		logger.Log.Info("createScopeMap", zap.Any("before AddClaimsInContext", time.Now()))
-		cronAPIKeyCtx, err := grpc.AddClaimsInContext(*cronCtx, handler.VerifyKey, handler.APIKey)
-		logger.Log.Info("createScopeMap", zap.Any("after AddClaimsInContext", time.Now()))
-		if err != nil {
-			logger.Log.Error("Cron AddClaims Failed", zap.Error(err))
-			return nil
-		}
+	if cronAPIKeyCtx != nil {
 		logger.Log.Info("createScopeMap", zap.Any("before list scopes call", time.Now()))
 		listScopesNames, err := handler.account.ListScopes(cronAPIKeyCtx, &accv1.ListScopesRequest{})
 		logger.Log.Info("createScopeMap", zap.Any("after list scopes call", time.Now()))
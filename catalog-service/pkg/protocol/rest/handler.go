@@ -1,24 +1,22 @@
 package rest
 
 import (
-	"crypto/rsa"
 	"database/sql"
 	"encoding/json"
 	"net/http"
 	accv1 "optisam-backend/account-service/pkg/api/v1"
+	"optisam-backend/catalog-service/pkg/config"
 	repo "optisam-backend/catalog-service/pkg/repository/v1/postgres"
 	"optisam-backend/catalog-service/pkg/repository/v1/postgres/db"
-	"optisam-backend/common/optisam/config"
+	"optisam-backend/common/optisam/token/apikey"
 	"time"
 )
 
 type handler struct {
 	Db          *sql.DB
 	account     accv1.AccountServiceClient
-	AuthAPI     string
-	VerifyKey   *rsa.PublicKey
-	APIKey      string
-	Application config.Application
+	Application config.ApplicationConfig
+	APIKeys     apikey.Store
 	pCRepo      *repo.ProductCatalogRepository
 }
 type errorresponse struct {
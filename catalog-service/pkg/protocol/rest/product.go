@@ -8,7 +8,6 @@ import (
 	accv1 "optisam-backend/account-service/pkg/api/v1"
 	"optisam-backend/catalog-service/pkg/repository/v1/postgres/db"
 	"optisam-backend/common/optisam/logger"
-	"optisam-backend/common/optisam/middleware/grpc"
 	"strconv"
 	"strings"
 	"time"
@@ -140,16 +139,11 @@ func (handler *handler) GetProduct(w http.ResponseWriter, r *http.Request) {
 			}
 			responseObject.Scopes = scopeCode
 		} else {
-			cronCtx, err := CreateSharedContext(handler.AuthAPI, handler.Application)
+			cronAPIKeyCtx, err := handler.sharedContext()
 			if err != nil {
 				logger.Log.Error("couldnt fetch token, will try next time when API will execute", zap.Any("error", err))
 			}
-			if cronCtx != nil {
-				cronAPIKeyCtx, err := grpc.AddClaimsInContext(*cronCtx, handler.VerifyKey, handler.APIKey)
-				if err != nil {
-					logger.Log.Error("Cron AddClaims Failed", zap.Error(err))
-				}
-
+			if cronAPIKeyCtx != nil {
 				listScopesNames, err := handler.account.GetScopeLists(cronAPIKeyCtx, &accv1.GetScopeListRequest{Scopes: scopeCode})
 				if err != nil {
 					logger.Log.Error("couldnt fetch scope name, will try next time when API will execute", zap.Any("error", err))
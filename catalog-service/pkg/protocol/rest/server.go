@@ -7,10 +7,12 @@ import (
 	"net/http"
 	"net/http/pprof"
 	v1 "optisam-backend/catalog-service/pkg/api/v1"
+	"optisam-backend/catalog-service/pkg/config"
 	repo "optisam-backend/catalog-service/pkg/repository/v1/postgres"
-	"optisam-backend/common/optisam/config"
 	"optisam-backend/common/optisam/logger"
 	rest_middleware "optisam-backend/common/optisam/middleware/rest"
+	"optisam-backend/common/optisam/token/apikey"
+	"optisam-backend/common/optisam/token/revocation"
 	"os"
 	"os/signal"
 	"time"
@@ -28,7 +30,7 @@ import (
 )
 
 // RunServer runs HTTP/REST gateway
-func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.PublicKey, database *sql.DB, grpcServers map[string]*grpc.ClientConn, authapi string, apiKey string, appCred config.Application, redisc *redisClient.Client) error {
+func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.PublicKey, database *sql.DB, grpcServers map[string]*grpc.ClientConn, appCred config.ApplicationConfig, redisc *redisClient.Client, revoked revocation.List, keys apikey.Store) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -42,10 +44,8 @@ func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.Pu
 	dbObj := repo.GetProductCatalogRepository()
 	handler := handler{Db: database,
 		account:     accService.NewAccountServiceClient(grpcServers["account"]),
-		AuthAPI:     authapi,
-		VerifyKey:   verifyKey,
-		APIKey:      apiKey,
 		Application: appCred,
+		APIKeys:     keys,
 		pCRepo:      dbObj,
 	}
 
@@ -59,7 +59,8 @@ func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.Pu
 	muxHTTP.HandleFunc("/catalog/index", http.HandlerFunc(handler.GetTesting))
 	muxHTTP.HandleFunc("/catalog/productfilters", http.HandlerFunc(handler.GetProductFilters))
 
-	muxHTTP.Handle("/", gw)
+	// catalog handlers above are public, the grpc apis require an authenticated user
+	muxHTTP.Handle("/", rest_middleware.ValidateAuthWithAPIKeys(verifyKey, revoked, keys, gw))
 
 	srv := &http.Server{
 		Addr: ":" + httpPort,
@@ -0,0 +1,111 @@
+// Package audit records who changed what through the apis of optisam services.
+package audit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Topic is the kafka topic receiving the audit events of all the services.
+const Topic = "audit_events"
+
+// Outcome is the result of an audited call.
+type Outcome string
+
+const (
+	// OutcomeSuccess is the outcome of calls which succeeded
+	OutcomeSuccess Outcome = "success"
+	// OutcomeFailure is the outcome of calls which returned an error
+	OutcomeFailure Outcome = "failure"
+)
+
+// Event is the record of a mutating call.
+type Event struct {
+	ID   string    `json:"id"`
+	Time time.Time `json:"time"`
+	// Service is the full name of the grpc service, e.g. optisam.products.v1.ProductService
+	Service string `json:"service"`
+	// Method is the name of the called rpc, e.g. UpdateAcqRight
+	Method string `json:"method"`
+	// Entity is the kind of entity changed by the call, e.g. AcqRight
+	Entity string `json:"entity"`
+	// Actor
+	UserID string `json:"user_id"`
+	Role   string `json:"role"`
+	// APIKeyID is the id of the personal api key used for the call, if any
+	APIKeyID string `json:"api_key_id,omitempty"`
+	// Scopes are the scopes the call was made on
+	Scopes []string `json:"scopes"`
+	// Request is the json payload of the call, secrets are redacted
+	Request json.RawMessage `json:"request"`
+	// Previous is the state of the entity before the call when the service recorded it,
+	// secrets are redacted
+	Previous json.RawMessage `json:"previous,omitempty"`
+	// Changes are the fields changed by the call when the service recorded the previous state of the entity
+	Changes []Change `json:"changes,omitempty"`
+	Outcome Outcome  `json:"outcome"`
+	// Code is the grpc status code of the call
+	Code    string `json:"code"`
+	Error   string `json:"error,omitempty"`
+	TraceID string `json:"trace_id,omitempty"`
+}
+
+// Change is the old and new value of a field changed by a call.
+type Change struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old"`
+	New   interface{} `json:"new"`
+}
+
+// Publisher publishes audit events.
+type Publisher interface {
+	// Publish sends the event, it must not block the audited call
+	Publish(ctx context.Context, e *Event) error
+}
+
+// mutatingVerbs are the verbs starting the names of the rpcs which change data.
+// nolint: gochecknoglobals
+var mutatingVerbs = []string{
+	"Create", "Update", "Upsert", "Delete", "Drop", "Add", "Remove", "Insert",
+	"Set", "Change", "Reset", "Save", "Import", "Upload", "Inject", "Copy", "Apply",
+	"Approve", "Reject", "Cancel", "Enroll", "Activate", "Disable", "Regenerate", "Revoke", "Replay", "Discard",
+}
+
+// Mutating reports whether the rpc with the full method name changes data, rpcs are recognized
+// by the verb starting their name, e.g. UpdateAcqRight. It also returns the service, the method
+// and the entity of the rpc.
+func Mutating(fullMethod string) (service, method, entity string, ok bool) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	i := strings.LastIndex(fullMethod, "/")
+	if i < 0 {
+		return "", "", "", false
+	}
+	service, method = fullMethod[:i], fullMethod[i+1:]
+	for _, verb := range mutatingVerbs {
+		if !strings.HasPrefix(method, verb) {
+			continue
+		}
+		entity = method[len(verb):]
+		// the verb must be a whole word, Settings is not the verb Set
+		if entity != "" && !unicode.IsUpper(rune(entity[0])) {
+			continue
+		}
+		return service, method, entity, true
+	}
+	return "", "", "", false
+}
+
+// NewID returns a random id for an event.
+func NewID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 16)
+	}
+	return hex.EncodeToString(b)
+}
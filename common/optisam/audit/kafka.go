@@ -0,0 +1,52 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+
+	optisamKafka "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/kafka"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"go.uber.org/zap"
+)
+
+type kafkaPublisher struct {
+	p     *kafka.Producer
+	topic string
+}
+
+// NewPublisher returns a Publisher sending events to the audit topic of the kafka,
+// nil is returned when no kafka is configured.
+func NewPublisher(c *optisamKafka.KafkaConfig) (Publisher, error) {
+	if c == nil {
+		return nil, nil
+	}
+	p, err := optisamKafka.BuildProducer(*c, map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	// events are produced asynchronously, failed deliveries are only logged
+	go func() {
+		for e := range p.Events() {
+			if m, ok := e.(*kafka.Message); ok && m.TopicPartition.Error != nil {
+				logger.Log.Error("audit - failed to deliver audit event", zap.ByteString("event", m.Value), zap.Error(m.TopicPartition.Error))
+			}
+		}
+	}()
+	return &kafkaPublisher{p: p, topic: Topic}, nil
+}
+
+// Publish implements Publisher Publish function.
+func (k *kafkaPublisher) Publish(ctx context.Context, e *Event) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return k.p.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &k.topic, Partition: kafka.PartitionAny},
+		// events of an entity kind keep their order
+		Key:   []byte(e.Service + "/" + e.Entity),
+		Value: b,
+	}, nil)
+}
@@ -0,0 +1,144 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// Redacted replaces the values of secret fields in payloads.
+	Redacted = "REDACTED"
+	// MaxValueLen is the length after which string values are truncated in payloads,
+	// it keeps uploaded files out of the audit trail.
+	MaxValueLen = 1024
+)
+
+// secretFields are the parts of field names whose values are never recorded.
+// nolint: gochecknoglobals
+var secretFields = []string{"password", "secret", "token", "api_key", "dsn", "headers"}
+
+// secretCodes are the fields holding second factor codes.
+// nolint: gochecknoglobals
+var secretCodes = map[string]bool{"code": true, "recovery_codes": true}
+
+// Payload returns the json of the request with secrets redacted and long values truncated.
+func Payload(req interface{}) (json.RawMessage, error) {
+	var b []byte
+	var err error
+	if m, ok := req.(proto.Message); ok {
+		b, err = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(m)
+	} else {
+		b, err = json.Marshal(req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(sanitize("", v))
+}
+
+func sanitize(field string, v interface{}) interface{} {
+	if isSecret(field) && v != nil && v != "" {
+		return Redacted
+	}
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, fv := range val {
+			val[k] = sanitize(k, fv)
+		}
+	case []interface{}:
+		for i, iv := range val {
+			val[i] = sanitize(field, iv)
+		}
+	case string:
+		if len(val) > MaxValueLen {
+			return fmt.Sprintf("%s... (%d bytes)", val[:MaxValueLen], len(val))
+		}
+	}
+	return v
+}
+
+func isSecret(field string) bool {
+	field = strings.ToLower(field)
+	if secretCodes[field] {
+		return true
+	}
+	for _, s := range secretFields {
+		if strings.Contains(field, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// Diff returns the fields of the request whose value differs from the previous state.
+// Only the fields present in both payloads are compared.
+func Diff(previous, request json.RawMessage) ([]Change, error) {
+	var old, cur map[string]interface{}
+	if err := json.Unmarshal(previous, &old); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(request, &cur); err != nil {
+		return nil, err
+	}
+	changes := []Change{}
+	for field, nv := range cur {
+		ov, ok := old[field]
+		if !ok || reflect.DeepEqual(ov, nv) {
+			continue
+		}
+		changes = append(changes, Change{Field: field, Old: ov, New: nv})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes, nil
+}
+
+type recorderKey struct{}
+
+type recorder struct {
+	previous interface{}
+}
+
+// NewContext returns a context in which services can record the previous state of the entities they change.
+func NewContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, recorderKey{}, &recorder{})
+}
+
+// RecordPrevious records the state of the entity before the call changes or deletes it, the
+// changes of the call are given by the fields of previous whose value differs in the request.
+// previous should have the type of the request for the changes to be computed.
+func RecordPrevious(ctx context.Context, previous interface{}) {
+	r, ok := ctx.Value(recorderKey{}).(*recorder)
+	if !ok {
+		return
+	}
+	r.previous = previous
+}
+
+// Recording reports whether the call is audited, services only need to fetch the previous
+// state of the entities they change when it is.
+func Recording(ctx context.Context) bool {
+	_, ok := ctx.Value(recorderKey{}).(*recorder)
+	return ok
+}
+
+// Previous returns the previous state recorded in the context, nil if there is none.
+func Previous(ctx context.Context) interface{} {
+	r, ok := ctx.Value(recorderKey{}).(*recorder)
+	if !ok {
+		return nil
+	}
+	return r.previous
+}
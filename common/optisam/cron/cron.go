@@ -2,6 +2,7 @@ package cron
 
 import (
 	"log"
+	"sync"
 
 	"github.com/robfig/cron/v3"
 )
@@ -34,3 +35,52 @@ func AddCronMaintenaceJob(fp func()) {
 	log.Println("starting cron maintenance job per ", cfg.MaintenanceTime)
 	cronOb.AddFunc(cfg.MaintenanceTime, fp)
 }
+
+// Scheduler runs named jobs each on its own schedule, a job can be replaced or removed while it runs
+type Scheduler struct {
+	mu      sync.Mutex
+	cron    *cron.Cron
+	entries map[string]cron.EntryID
+}
+
+// NewScheduler starts an empty scheduler
+func NewScheduler() *Scheduler {
+	s := &Scheduler{
+		cron:    cron.New(cron.WithLogger(cron.DefaultLogger)),
+		entries: make(map[string]cron.EntryID),
+	}
+	s.cron.Start()
+	return s
+}
+
+// Schedule runs fp per spec under the name, replacing the previous job of the name
+func (s *Scheduler) Schedule(name, spec string, fp func()) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id, err := s.cron.AddFunc(spec, fp)
+	if err != nil {
+		return err
+	}
+	if old, ok := s.entries[name]; ok {
+		s.cron.Remove(old)
+	}
+	s.entries[name] = id
+	log.Println("scheduling cron job", name, "per", spec)
+	return nil
+}
+
+// Remove stops the job of the name
+func (s *Scheduler) Remove(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if id, ok := s.entries[name]; ok {
+		s.cron.Remove(id)
+		delete(s.entries, name)
+	}
+}
+
+// ValidateSpec checks that the spec can be scheduled
+func ValidateSpec(spec string) error {
+	_, err := cron.ParseStandard(spec)
+	return err
+}
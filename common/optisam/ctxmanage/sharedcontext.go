@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
@@ -17,7 +18,9 @@ import (
 const (
 	failresmap = "failed to get respmap data"
 	PASSWORD   = "password"
-	BASEURLSTR = "http://%s/api/v1/token"
+	// CLIENTCREDENTIALS is the grant of the registered service clients
+	CLIENTCREDENTIALS = "client_credentials"
+	BASEURLSTR        = "http://%s/api/v1/token"
 )
 
 func CreateSharedContext(uname, pass, add string) (*context.Context, error) {
@@ -59,3 +62,31 @@ func CreateSharedContext(uname, pass, add string) (*context.Context, error) {
 	logger.Log.Sugar().Infow("CreateSharedContext", "CreateSharedContext executed", time.Now())
 	return &ctx, nil
 }
+
+// CreateServiceContext returns a context calling the other services as the registered service client,
+// the token is requested for the scope and is sent as the authorization of the calls.
+func CreateServiceContext(ctx context.Context, clientID, secret, scope, add string) (context.Context, error) {
+	data := url.Values{
+		"grant_type": {CLIENTCREDENTIALS},
+		"scope":      {scope},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf(BASEURLSTR, add), strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(clientID, secret)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		logger.Log.Sugar().Errorw("Failed to get service client token", "client", clientID, "err", err.Error())
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil || resp.StatusCode != http.StatusOK || token.AccessToken == "" {
+		return nil, fmt.Errorf("cannot get the token of client %s for scope %s: %s", clientID, scope, resp.Status)
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token.AccessToken), nil
+}
@@ -0,0 +1,19 @@
+package iam
+
+import (
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/redis"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
+)
+
+// NewAPIKeyStore returns the api key store shared by all the services,
+// nil is returned when no api key store is configured.
+func NewAPIKeyStore(config Config) apikey.Store {
+	c := config.APIKeyStore
+	if c == nil {
+		return nil
+	}
+	if c.SentinelHost != "" {
+		return apikey.NewStore(redis.NewConnectionSentinel(*c))
+	}
+	return apikey.NewStore(redis.NewConnection(*c))
+}
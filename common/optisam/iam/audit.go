@@ -0,0 +1,11 @@
+package iam
+
+import (
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/audit"
+)
+
+// NewAuditPublisher returns the publisher of the audit events of the service,
+// nil is returned when no audit log is configured.
+func NewAuditPublisher(config Config) (audit.Publisher, error) {
+	return audit.NewPublisher(config.AuditLog)
+}
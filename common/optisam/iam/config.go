@@ -1,6 +1,9 @@
 package iam
 
 import (
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/kafka"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/redis"
+
 	"github.com/pkg/errors"
 )
 
@@ -9,6 +12,12 @@ type Config struct {
 	PublicKeyPath string
 	RegoPath      string
 	APIKey        string
+	// RevocationStore is the redis holding revoked tokens, revocation is not checked if it is not set
+	RevocationStore *redis.Config
+	// APIKeyStore is the redis holding personal api keys, api keys are not accepted if it is not set
+	APIKeyStore *redis.Config
+	// AuditLog is the kafka receiving the audit events of mutating calls, calls are not audited if it is not set
+	AuditLog *kafka.KafkaConfig
 }
 
 // Validate checks that the configuration is valid.
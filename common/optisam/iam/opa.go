@@ -2,62 +2,28 @@ package iam
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/opa"
 
-	"github.com/open-policy-agent/opa/ast"
-	"github.com/open-policy-agent/opa/rego"
-	"github.com/open-policy-agent/opa/util"
 	"go.uber.org/zap"
 )
 
-type AuthzInput struct {
-	MethodFullName string `json:"api"`
-	Role           string `json:"role"`
-}
+// AuthzInput is the input given to authorization policies.
+type AuthzInput = opa.AuthzInput
 
-func NewOPA(ctx context.Context, regoFile string) (*rego.PreparedEvalQuery, error) {
-	regoPaths := []string{regoFile}
-	r, err := rego.New(rego.Query("data.rbac.allow"), rego.Load(regoPaths, nil)).PrepareForEval(ctx)
+// NewOPA loads the rego policy, it is reloaded when its files change until ctx is done.
+func NewOPA(ctx context.Context, regoFile string) (*opa.Policy, error) {
+	p, err := opa.NewPolicy(ctx, opa.DefaultQuery, regoFile)
 	if err != nil {
 		logger.Log.Error("Failed to Load OPA Policies", zap.Error(err))
 		return nil, err
 	}
-	return &r, nil
+	go p.Watch(ctx, opa.DefaultReloadInterval)
+	return p, nil
 }
 
-func EvalAuthZ(ctx context.Context, p *rego.PreparedEvalQuery, authzInput AuthzInput) (bool, error) {
-	var input map[string]interface{}
-	bs, err := json.Marshal(authzInput)
-	if err != nil {
-		return false, err
-	}
-	err = util.UnmarshalJSON(bs, &input)
-	if err != nil {
-		return false, err
-	}
-
-	inputValue, err := ast.InterfaceToValue(input)
-	if err != nil {
-		return false, err
-	}
-
-	rs, err := p.Eval(ctx, rego.EvalParsedInput(inputValue))
-	if err != nil {
-		return false, err
-	}
-	authorized := false
-	switch decision := rs[0].Expressions[0].Value.(type) {
-	case bool:
-		if decision {
-			authorized = true
-		}
-
-	default:
-		err = fmt.Errorf("illegal value for policy evaluation result: %T", decision)
-		return false, err
-	}
-	return authorized, nil
+// EvalAuthZ evaluates the policy decision for the input.
+func EvalAuthZ(ctx context.Context, p *opa.Policy, authzInput AuthzInput) (bool, error) {
+	return p.Eval(ctx, authzInput)
 }
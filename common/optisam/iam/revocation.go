@@ -0,0 +1,19 @@
+package iam
+
+import (
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/redis"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/revocation"
+)
+
+// NewRevocationList returns the token revocation list shared by all the services,
+// nil is returned when no revocation store is configured.
+func NewRevocationList(config Config) revocation.List {
+	c := config.RevocationStore
+	if c == nil {
+		return nil
+	}
+	if c.SentinelHost != "" {
+		return revocation.NewList(redis.NewConnectionSentinel(*c))
+	}
+	return revocation.NewList(redis.NewConnection(*c))
+}
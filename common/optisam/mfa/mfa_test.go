@@ -0,0 +1,101 @@
+package mfa
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rfcSecret is the base32 encoding of the RFC 6238 SHA1 test secret "12345678901234567890"
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCode(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+	}
+	for _, tt := range tests {
+		got, err := Code(rfcSecret, Step(time.Unix(tt.unix, 0)))
+		if assert.Empty(t, err) {
+			assert.Equal(t, tt.want, got, "time %d", tt.unix)
+		}
+	}
+	_, err := Code("not base32!", 1)
+	assert.Equal(t, ErrInvalidSecret, err)
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	step := Step(now)
+	previous, _ := Code(rfcSecret, step-1)
+	tests := []struct {
+		name     string
+		code     string
+		lastStep int64
+		wantStep int64
+		wantOK   bool
+	}{
+		{name: "SUCCESS", code: "005924", wantStep: step, wantOK: true},
+		{name: "SUCCESS - previous period", code: previous, wantStep: step - 1, wantOK: true},
+		{name: "FAILURE - wrong code", code: "123456"},
+		{name: "FAILURE - wrong length", code: "05924"},
+		{name: "FAILURE - code already used", code: "005924", lastStep: step},
+		{name: "FAILURE - older than last used code", code: previous, lastStep: step - 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStep, gotOK := Validate(rfcSecret, tt.code, now, tt.lastStep)
+			assert.Equal(t, tt.wantOK, gotOK)
+			assert.Equal(t, tt.wantStep, gotStep)
+		})
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := GenerateSecret()
+	if !assert.Empty(t, err) {
+		return
+	}
+	code, err := Code(secret, Step(time.Now()))
+	if assert.Empty(t, err) {
+		_, ok := Validate(secret, code, time.Now(), 0)
+		assert.True(t, ok)
+	}
+	assert.True(t, strings.HasPrefix(KeyURI("optisam", "admin@test.com", secret), "otpauth://totp/optisam:admin@test.com?"))
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, hashes, err := GenerateRecoveryCodes()
+	if !assert.Empty(t, err) {
+		return
+	}
+	assert.Len(t, codes, RecoveryCodes)
+	for i := range codes {
+		assert.True(t, IsRecoveryCode(codes[i]))
+		assert.Equal(t, hashes[i], HashRecoveryCode(strings.ToUpper(strings.Replace(codes[i], "-", "", 1))))
+	}
+	assert.False(t, IsRecoveryCode("123456"))
+}
+
+func TestPolicy(t *testing.T) {
+	p := Policy{RequiredRoles: DefaultRequiredRoles}
+	assert.Empty(t, p.Validate())
+	assert.True(t, p.Required(claims.RoleAdmin))
+	assert.True(t, p.Required(claims.RoleSuperAdmin))
+	assert.False(t, p.Required(claims.RoleUser))
+	assert.Error(t, Policy{RequiredRoles: []string{"Root"}}.Validate())
+
+	clms := EnrollmentClaims(&claims.Claims{UserID: "admin@test.com", Role: claims.RoleAdmin})
+	assert.True(t, IsEnrollmentClaims(clms))
+	assert.False(t, IsEnrollmentClaims(&claims.Claims{UserID: "admin@test.com"}))
+}
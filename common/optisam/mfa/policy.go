@@ -0,0 +1,57 @@
+package mfa
+
+import (
+	"fmt"
+	"time"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+)
+
+const (
+	// EnrollmentAudience is the audience of the tokens given to users who must enroll
+	// their authenticator before login, such tokens are only accepted by enrollment apis.
+	EnrollmentAudience = "mfa_enrollment"
+	// EnrollmentTokenTTL is the time user has to enroll its authenticator.
+	EnrollmentTokenTTL = 10 * time.Minute
+)
+
+// Policy tells which users must login with a second factor.
+type Policy struct {
+	// RequiredRoles are the roles whose users cannot login without a second factor
+	RequiredRoles []string
+}
+
+// DefaultRequiredRoles are the roles which require a second factor if not configured otherwise.
+var DefaultRequiredRoles = []string{string(claims.RoleSuperAdmin), string(claims.RoleAdmin)}
+
+// Required reports whether users with the role must login with a second factor.
+func (p Policy) Required(role claims.Role) bool {
+	for _, r := range p.RequiredRoles {
+		if r == string(role) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the policy only contains known roles.
+func (p Policy) Validate() error {
+	for _, r := range p.RequiredRoles {
+		if _, ok := claims.ReturnRole(r); !ok {
+			return fmt.Errorf("mfa policy has invalid role %s", r)
+		}
+	}
+	return nil
+}
+
+// EnrollmentClaims restricts the claims to authenticator enrollment.
+func EnrollmentClaims(clms *claims.Claims) *claims.Claims {
+	clms.Audience = EnrollmentAudience
+	clms.ExpiresAt = time.Now().Add(EnrollmentTokenTTL).Unix()
+	return clms
+}
+
+// IsEnrollmentClaims reports whether the claims are restricted to authenticator enrollment.
+func IsEnrollmentClaims(clms *claims.Claims) bool {
+	return clms.Audience == EnrollmentAudience
+}
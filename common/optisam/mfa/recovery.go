@@ -0,0 +1,41 @@
+package mfa
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// RecoveryCodes is the number of recovery codes given to a user, each code can be used once
+// instead of a totp code when the user has lost the authenticator.
+const RecoveryCodes = 10
+
+// GenerateRecoveryCodes generates new recovery codes, the codes must be given to the
+// user and only the hashes must be stored.
+func GenerateRecoveryCodes() (codes, hashes []string, err error) {
+	codes = make([]string, RecoveryCodes)
+	hashes = make([]string, RecoveryCodes)
+	for i := range codes {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(hex.EncodeToString(b))
+		codes[i] = code[:5] + "-" + code[5:]
+		hashes[i] = HashRecoveryCode(codes[i])
+	}
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode returns the hash of a recovery code, separators and case are ignored.
+func HashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsRecoveryCode reports whether the code looks like a recovery code rather than a totp code.
+func IsRecoveryCode(code string) bool {
+	return len(code) != Digits
+}
@@ -0,0 +1,108 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/audit"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
+
+	"go.opencensus.io/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// auditedMD is the metadata sent by services calling other services while handling an audited call,
+// the called service does not audit the call again.
+const auditedMD = "x-audited"
+
+// auditServerInterceptor publishes an audit event for every mutating call made by a user, directly,
+// with a personal api key or through a service job, calls are not audited when there is no publisher.
+func auditServerInterceptor(pub audit.Publisher) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if pub == nil || auditedByCaller(ctx) {
+			return handler(ctx, req)
+		}
+		service, method, entity, ok := audit.Mutating(info.FullMethod)
+		if !ok {
+			return handler(ctx, req)
+		}
+		ctx = audit.NewContext(ctx)
+		resp, err := handler(ctx, req)
+		e := &audit.Event{
+			ID:      audit.NewID(),
+			Time:    time.Now().UTC(),
+			Service: service,
+			Method:  method,
+			Entity:  entity,
+			Scopes:  requestScopes(req),
+			Outcome: audit.OutcomeSuccess,
+			Code:    status.Code(err).String(),
+			TraceID: trace.FromContext(ctx).SpanContext().TraceID.String(),
+		}
+		if err != nil {
+			e.Outcome = audit.OutcomeFailure
+			e.Error = status.Convert(err).Message()
+		}
+		if userClaims, ok := RetrieveClaims(ctx); ok {
+			e.UserID = userClaims.UserID
+			e.Role = string(userClaims.Role)
+			if apikey.IsKeyClaims(userClaims) {
+				e.APIKeyID = userClaims.Id
+			}
+		}
+		if e.APIKeyID == "" {
+			// services calling on behalf of the owner of a personal api key forward its id
+			md, _ := metadata.FromIncomingContext(ctx)
+			if id := md.Get(apiKeyIDMD); len(id) > 0 {
+				e.APIKeyID = id[0]
+			}
+		}
+		if e.Request, ok = auditPayload(info.FullMethod, req); ok {
+			if prev := audit.Previous(ctx); prev != nil {
+				if p, ok := auditPayload(info.FullMethod, prev); ok {
+					e.Previous = p
+					e.Changes, _ = audit.Diff(p, e.Request)
+				}
+			}
+		}
+		if perr := pub.Publish(ctx, e); perr != nil {
+			logger.Log.Error("grpc/auditServerInterceptor - failed to publish audit event", zap.String("method", info.FullMethod), zap.Error(perr))
+		}
+		return resp, err
+	}
+}
+
+func auditPayload(fullMethod string, v interface{}) ([]byte, bool) {
+	b, err := audit.Payload(v)
+	if err != nil {
+		logger.Log.Error("grpc/auditServerInterceptor - failed to marshal payload", zap.String("method", fullMethod), zap.Error(err))
+		return nil, false
+	}
+	return b, true
+}
+
+// auditedByCaller reports whether the call is made by another service while handling an audited call,
+// such calls are audited by the service the user called.
+func auditedByCaller(ctx context.Context) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+	_, authorization := md["authorization"]
+	_, xAPIKey := md["x-api-key"]
+	return xAPIKey && !authorization && len(md.Get(auditedMD)) > 0
+}
+
+// requestScopes returns the scopes of the request, if it has any.
+func requestScopes(req interface{}) []string {
+	switch r := req.(type) {
+	case interface{ GetScopes() []string }:
+		return r.GetScopes()
+	case interface{ GetScope() string }:
+		if r.GetScope() != "" {
+			return []string{r.GetScope()}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/audit"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type fakePublisher struct {
+	events []*audit.Event
+}
+
+func (p *fakePublisher) Publish(ctx context.Context, e *audit.Event) error {
+	p.events = append(p.events, e)
+	return nil
+}
+
+type fakeRequest struct {
+	Sku      string `json:"sku"`
+	Scope    string `json:"scope"`
+	Password string `json:"password,omitempty"`
+}
+
+func (r *fakeRequest) GetScope() string {
+	return r.Scope
+}
+
+func Test_auditServerInterceptor(t *testing.T) {
+	userCtx := AddClaims(context.Background(), &claims.Claims{UserID: "admin@test.com", Role: claims.RoleAdmin, Socpes: []string{"OFR"}})
+	keyCtx := AddClaims(context.Background(), &claims.Claims{UserID: "user@test.com", Role: claims.RoleUser, Socpes: []string{"OFR"},
+		StandardClaims: claimsWithKey("key1")})
+	update := &grpc.UnaryServerInfo{FullMethod: "/optisam.products.v1.ProductService/UpdateAcqRight"}
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		info      *grpc.UnaryServerInfo
+		req       *fakeRequest
+		handler   grpc.UnaryHandler
+		noPub     bool
+		wantEvent *audit.Event
+	}{
+		{name: "no publisher",
+			ctx:   userCtx,
+			info:  update,
+			req:   &fakeRequest{Sku: "s1", Scope: "OFR"},
+			noPub: true,
+		},
+		{name: "read calls are not audited",
+			ctx:  userCtx,
+			info: &grpc.UnaryServerInfo{FullMethod: "/optisam.products.v1.ProductService/ListAcqRights"},
+			req:  &fakeRequest{Sku: "s1", Scope: "OFR"},
+		},
+		{name: "update with previous state",
+			ctx:  userCtx,
+			info: update,
+			req:  &fakeRequest{Sku: "s2", Scope: "OFR", Password: "pwd"},
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				audit.RecordPrevious(ctx, &fakeRequest{Sku: "s1", Scope: "OFR"})
+				return "ok", nil
+			},
+			wantEvent: &audit.Event{
+				Service:  "optisam.products.v1.ProductService",
+				Method:   "UpdateAcqRight",
+				Entity:   "AcqRight",
+				UserID:   "admin@test.com",
+				Role:     "Admin",
+				Scopes:   []string{"OFR"},
+				Request:  json.RawMessage(`{"password":"REDACTED","scope":"OFR","sku":"s2"}`),
+				Previous: json.RawMessage(`{"scope":"OFR","sku":"s1"}`),
+				Changes:  []audit.Change{{Field: "sku", Old: "s1", New: "s2"}},
+				Outcome:  audit.OutcomeSuccess,
+				Code:     "OK",
+			},
+		},
+		{name: "failed call",
+			ctx:  userCtx,
+			info: update,
+			req:  &fakeRequest{Sku: "s1", Scope: "OFR"},
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, status.Error(codes.InvalidArgument, "SKU does not exist")
+			},
+			wantEvent: &audit.Event{
+				Service: "optisam.products.v1.ProductService",
+				Method:  "UpdateAcqRight",
+				Entity:  "AcqRight",
+				UserID:  "admin@test.com",
+				Role:    "Admin",
+				Scopes:  []string{"OFR"},
+				Request: json.RawMessage(`{"scope":"OFR","sku":"s1"}`),
+				Outcome: audit.OutcomeFailure,
+				Code:    "InvalidArgument",
+				Error:   "SKU does not exist",
+			},
+		},
+		{name: "personal api key",
+			ctx:  keyCtx,
+			info: update,
+			req:  &fakeRequest{Sku: "s1", Scope: "OFR"},
+			wantEvent: &audit.Event{
+				Service:  "optisam.products.v1.ProductService",
+				Method:   "UpdateAcqRight",
+				Entity:   "AcqRight",
+				UserID:   "user@test.com",
+				Role:     "User",
+				APIKeyID: "key1",
+				Scopes:   []string{"OFR"},
+				Request:  json.RawMessage(`{"scope":"OFR","sku":"s1"}`),
+				Outcome:  audit.OutcomeSuccess,
+				Code:     "OK",
+			},
+		},
+		{name: "service call made for an api key owner",
+			ctx: metadata.NewIncomingContext(
+				AddClaims(context.Background(), &claims.Claims{UserID: "user@test.com", Role: claims.RoleUser, Socpes: []string{"OFR"}}),
+				metadata.Pairs("x-api-key", "12345", "user-id", "user@test.com", apiKeyIDMD, "key1")),
+			info: update,
+			req:  &fakeRequest{Sku: "s1", Scope: "OFR"},
+			wantEvent: &audit.Event{
+				Service:  "optisam.products.v1.ProductService",
+				Method:   "UpdateAcqRight",
+				Entity:   "AcqRight",
+				UserID:   "user@test.com",
+				Role:     "User",
+				APIKeyID: "key1",
+				Scopes:   []string{"OFR"},
+				Request:  json.RawMessage(`{"scope":"OFR","sku":"s1"}`),
+				Outcome:  audit.OutcomeSuccess,
+				Code:     "OK",
+			},
+		},
+		{name: "service call made for an audited call",
+			ctx: metadata.NewIncomingContext(
+				AddClaims(context.Background(), &claims.Claims{UserID: "admin@test.com", Role: claims.RoleAdmin, Socpes: []string{"OFR"}}),
+				metadata.Pairs("x-api-key", "12345", "user-id", "admin@test.com", auditedMD, "true")),
+			info: update,
+			req:  &fakeRequest{Sku: "s1", Scope: "OFR"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pub := &fakePublisher{}
+			var interceptor grpc.UnaryServerInterceptor
+			if tt.noPub {
+				interceptor = auditServerInterceptor(nil)
+			} else {
+				interceptor = auditServerInterceptor(pub)
+			}
+			handler := tt.handler
+			if handler == nil {
+				handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+					return "ok", nil
+				}
+			}
+			called := false
+			_, _ = interceptor(tt.ctx, tt.req, tt.info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return handler(ctx, req)
+			})
+			assert.True(t, called, "handler is not called")
+			if tt.wantEvent == nil {
+				assert.Empty(t, pub.events)
+				return
+			}
+			if !assert.Len(t, pub.events, 1) {
+				return
+			}
+			got := pub.events[0]
+			assert.NotEmpty(t, got.ID)
+			assert.False(t, got.Time.IsZero())
+			got.ID, tt.wantEvent.ID = "", ""
+			got.Time = tt.wantEvent.Time
+			got.TraceID = ""
+			assert.Equal(t, tt.wantEvent, got)
+		})
+	}
+}
+
+func TestAddAuthNClientInterceptor_audited(t *testing.T) {
+	ctx := AddClaims(context.Background(), &claims.Claims{UserID: "user@test.com", Role: claims.RoleUser, Socpes: []string{"OFR"},
+		StandardClaims: claimsWithKey("key1")})
+	var got metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		got, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+	interceptor := AddAuthNClientInterceptor("12345")
+
+	assert.NoError(t, interceptor(ctx, "/optisam.products.v1.ProductService/UpdateAcqRight", nil, nil, nil, invoker))
+	assert.Empty(t, got.Get(auditedMD))
+	assert.Equal(t, []string{"key1"}, got.Get(apiKeyIDMD))
+
+	assert.NoError(t, interceptor(audit.NewContext(ctx), "/optisam.products.v1.ProductService/UpdateAcqRight", nil, nil, nil, invoker))
+	assert.Equal(t, []string{"true"}, got.Get(auditedMD))
+}
+
+func claimsWithKey(id string) jwt.StandardClaims {
+	return jwt.StandardClaims{Id: id, Audience: apikey.Audience}
+}
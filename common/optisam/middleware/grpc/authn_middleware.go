@@ -5,10 +5,14 @@ import (
 	"crypto/rsa"
 
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/mfa"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/revocation"
 
 	"go.uber.org/zap"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 
@@ -36,9 +40,23 @@ func RetrieveClaims(ctx context.Context) (*claims.Claims, bool) {
 	return clms, ok
 }
 
-func authHandler(verifyKey *rsa.PublicKey, apiKey string) func(ctx context.Context) (context.Context, error) {
+func authHandler(verifyKey *rsa.PublicKey, apiKey string, o options) func(ctx context.Context) (context.Context, error) {
 	return func(ctx context.Context) (context.Context, error) {
 		md, _ := metadata.FromIncomingContext(ctx)
+		if o.apiKeys != nil {
+			if key, err := grpc_auth.AuthFromMD(ctx, apikey.Scheme); err == nil {
+				customClaims, err := apiKeyClaims(ctx, key, o)
+				if err != nil {
+					return nil, err
+				}
+				ctxzap.AddFields(
+					ctx,
+					zap.String("user-id", customClaims.UserID),
+					zap.String("user-role", string(customClaims.Role)),
+					zap.String("api-key-id", customClaims.Id))
+				return AddClaims(ctx, customClaims), nil
+			}
+		}
 		if _, ok := md["authorization"]; ok {
 			tokenStr, err := grpc_auth.AuthFromMD(ctx, "bearer")
 			if err != nil {
@@ -63,6 +81,17 @@ func authHandler(verifyKey *rsa.PublicKey, apiKey string) func(ctx context.Conte
 				return nil, status.Error(codes.Unauthenticated, "InvalidClaimsError")
 			}
 
+			if err := checkRevocation(ctx, o, customClaims); err != nil {
+				return nil, err
+			}
+
+			// users who must enroll a second factor can only call enrollment apis
+			if mfa.IsEnrollmentClaims(customClaims) {
+				if method, _ := grpc.Method(ctx); !o.mfaEnrollmentMethods[method] {
+					return nil, status.Error(codes.Unauthenticated, "MFAEnrollmentRequiredError")
+				}
+			}
+
 			ctxzap.AddFields(
 				ctx,
 				zap.String("user-id", customClaims.UserID),
@@ -96,6 +125,39 @@ func authHandler(verifyKey *rsa.PublicKey, apiKey string) func(ctx context.Conte
 
 }
 
+// apiKeyClaims verifies the personal api key and returns the claims it gives.
+func apiKeyClaims(ctx context.Context, key string, o options) (*claims.Claims, error) {
+	customClaims, err := apikey.Verify(ctx, o.apiKeys, key)
+	switch err {
+	case nil:
+	case apikey.ErrInvalidKey:
+		return nil, status.Error(codes.Unauthenticated, "InvalidAPIKeyError")
+	case apikey.ErrExpiredKey:
+		return nil, status.Error(codes.Unauthenticated, "ExpiredAPIKeyError")
+	default:
+		logger.Log.Error("grpc/authHandler - failed to verify api key", zap.String("reason", err.Error()))
+		return nil, status.Error(codes.Unavailable, "APIKeyCheckError")
+	}
+	// keys are revoked in the key store, the revocation list only holds tokens
+	return customClaims, nil
+}
+
+// checkRevocation returns an error if the token carrying the claims is revoked.
+func checkRevocation(ctx context.Context, o options, customClaims *claims.Claims) error {
+	if o.revocationList == nil {
+		return nil
+	}
+	revoked, err := revocation.IsClaimsRevoked(ctx, o.revocationList, customClaims)
+	if err != nil {
+		logger.Log.Error("grpc/authHandler - failed to check token revocation", zap.String("reason", err.Error()))
+		return status.Error(codes.Unavailable, "RevocationCheckError")
+	}
+	if revoked {
+		return status.Error(codes.Unauthenticated, "RevokedTokenError")
+	}
+	return nil
+}
+
 // func parseToken(tokenStr string) {
 // 	customClaims := claims.Claims{}
 // 	token, err := jwt.ParseWithClaims(tokenStr, &customClaims, func(token *jwt.Token) (interface{}, error) {
@@ -3,21 +3,32 @@ package grpc
 import (
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/opa"
 
-	"github.com/open-policy-agent/opa/rego"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-func authorizationServerInterceptor(p *rego.PreparedEvalQuery) grpc.UnaryServerInterceptor {
+func authorizationServerInterceptor(p *opa.Policy) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		userClaims, ok := RetrieveClaims(ctx)
 		if !ok {
 			return nil, status.Error(codes.Unauthenticated, "invalid claims")
 		}
+		fields, err := opa.RequestFields(req)
+		if err != nil {
+			return nil, status.Errorf(codes.PermissionDenied, "Access to %s denied: %v", info.FullMethod, err)
+		}
 		// Authorize
-		authorized, err := opa.EvalAuthZ(ctx, p, opa.AuthzInput{Role: string(userClaims.Role), MethodFullName: info.FullMethod})
+		authorized, err := p.Eval(ctx, opa.AuthzInput{
+			Role:           string(userClaims.Role),
+			MethodFullName: info.FullMethod,
+			UserID:         userClaims.UserID,
+			Scopes:         opa.RequestedScopes(fields),
+			UserScopes:     userClaims.Socpes,
+			Groups:         userClaims.Groups,
+			Request:        fields,
+		})
 		if err != nil || !authorized {
 			return nil, status.Errorf(codes.PermissionDenied, "Access to %s denied: %v", info.FullMethod, err)
 		}
@@ -15,7 +15,8 @@ import (
 )
 
 // ChainedWithAdminFilter add admin rights filter along with other filters
-func ChainedWithAdminFilter(logger *zap.Logger, verifyKey *rsa.PublicKey, apiKey string, a AdminRightsRequiredFunc) []grpc.ServerOption {
+func ChainedWithAdminFilter(logger *zap.Logger, verifyKey *rsa.PublicKey, apiKey string, a AdminRightsRequiredFunc, opts ...Option) []grpc.ServerOption {
+	mwOpts := newOptions(opts)
 
 	// Shared options for the logger, with a custom gRPC code to log level function.
 	o := []grpc_zap.Option{
@@ -27,7 +28,8 @@ func ChainedWithAdminFilter(logger *zap.Logger, verifyKey *rsa.PublicKey, apiKey
 		grpc_ctxtags.UnaryServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
 		grpc_zap.UnaryServerInterceptor(logger, o...),
 		LoggingUnaryServerInterceptor(),
-		grpc_auth.UnaryServerInterceptor(authHandler(verifyKey, apiKey)),
+		grpc_auth.UnaryServerInterceptor(authHandler(verifyKey, apiKey, mwOpts)),
+		auditServerInterceptor(mwOpts.auditPublisher),
 		//authorizationServerInterceptor(p),
 		grpc_validator.UnaryServerInterceptor(),
 		grpc_recovery.UnaryServerInterceptor(),
@@ -35,7 +37,7 @@ func ChainedWithAdminFilter(logger *zap.Logger, verifyKey *rsa.PublicKey, apiKey
 
 	streamInterceptors := []grpc.StreamServerInterceptor{
 		grpc_zap.StreamServerInterceptor(logger, o...),
-		grpc_auth.StreamServerInterceptor(authHandler(verifyKey, apiKey)),
+		grpc_auth.StreamServerInterceptor(authHandler(verifyKey, apiKey, mwOpts)),
 		grpc_ctxtags.StreamServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
 		grpc_validator.StreamServerInterceptor(),
 		grpc_recovery.StreamServerInterceptor(),
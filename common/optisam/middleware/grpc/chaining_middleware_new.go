@@ -3,19 +3,21 @@ package grpc
 import (
 	"crypto/rsa"
 
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/opa"
+
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	grpc_validator "github.com/grpc-ecosystem/go-grpc-middleware/validator"
-	"github.com/open-policy-agent/opa/rego"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
 
 // Chained for linking all grpc interceptor
-func Chained(logger *zap.Logger, verifyKey *rsa.PublicKey, p *rego.PreparedEvalQuery, apiKey string) []grpc.ServerOption {
+func Chained(logger *zap.Logger, verifyKey *rsa.PublicKey, p *opa.Policy, apiKey string, opts ...Option) []grpc.ServerOption {
+	mwOpts := newOptions(opts)
 
 	// alwaysLoggingDeciderServer := func(ctx context.Context, fullMethodName string, servingObject interface{}) bool { return true }
 	// Shared options for the logger, with a custom gRPC code to log level function.
@@ -31,7 +33,8 @@ func Chained(logger *zap.Logger, verifyKey *rsa.PublicKey, p *rego.PreparedEvalQ
 		grpc_zap.UnaryServerInterceptor(logger, o...),
 		// UserLoggingUnaryServerInterceptor(),
 		LoggingUnaryServerInterceptor(),
-		grpc_auth.UnaryServerInterceptor(authHandler(verifyKey, apiKey)),
+		grpc_auth.UnaryServerInterceptor(authHandler(verifyKey, apiKey, mwOpts)),
+		auditServerInterceptor(mwOpts.auditPublisher),
 		authorizationServerInterceptor(p),
 		grpc_validator.UnaryServerInterceptor(),
 		grpc_recovery.UnaryServerInterceptor(),
@@ -39,7 +42,7 @@ func Chained(logger *zap.Logger, verifyKey *rsa.PublicKey, p *rego.PreparedEvalQ
 
 	streamInterceptors := []grpc.StreamServerInterceptor{
 		grpc_zap.StreamServerInterceptor(logger, o...),
-		grpc_auth.StreamServerInterceptor(authHandler(verifyKey, apiKey)),
+		grpc_auth.StreamServerInterceptor(authHandler(verifyKey, apiKey, mwOpts)),
 		grpc_ctxtags.StreamServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
 		grpc_validator.StreamServerInterceptor(),
 		grpc_recovery.StreamServerInterceptor(),
@@ -4,7 +4,9 @@ import (
 	"context"
 	"crypto/rsa"
 
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/audit"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
 
 	rest_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/rest"
@@ -18,12 +20,21 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// apiKeyIDMD is the metadata giving the id of the personal api key of the user a service calls for.
+const apiKeyIDMD = "api-key-id"
+
 func AddAuthNClientInterceptor(key string) grpc.UnaryClientInterceptor {
 	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
 		ctx = metadata.AppendToOutgoingContext(ctx, "x-api-key", key)
+		if audit.Recording(ctx) {
+			ctx = metadata.AppendToOutgoingContext(ctx, auditedMD, "true")
+		}
 		if userClaims, ok := rest_middleware.RetrieveClaims(ctx); ok {
 			ctx = metadata.AppendToOutgoingContext(ctx, "user-id", userClaims.UserID)
 			ctx = metadata.AppendToOutgoingContext(ctx, "user-role", string(userClaims.Role))
+			if apikey.IsKeyClaims(userClaims) {
+				ctx = metadata.AppendToOutgoingContext(ctx, apiKeyIDMD, userClaims.Id)
+			}
 			for _, scope := range userClaims.Socpes {
 				ctx = metadata.AppendToOutgoingContext(ctx, "user-scopes", scope)
 			}
@@ -32,6 +43,9 @@ func AddAuthNClientInterceptor(key string) grpc.UnaryClientInterceptor {
 		if userClaims, ok := RetrieveClaims(ctx); ok {
 			ctx = metadata.AppendToOutgoingContext(ctx, "user-id", userClaims.UserID)
 			ctx = metadata.AppendToOutgoingContext(ctx, "user-role", string(userClaims.Role))
+			if apikey.IsKeyClaims(userClaims) {
+				ctx = metadata.AppendToOutgoingContext(ctx, apiKeyIDMD, userClaims.Id)
+			}
 			for _, scope := range userClaims.Socpes {
 				ctx = metadata.AppendToOutgoingContext(ctx, "user-scopes", scope)
 			}
@@ -90,3 +104,14 @@ func AddClaimsInContext(ctx context.Context, verifyKey *rsa.PublicKey, apiKey st
 	}
 	return nil, status.Error(codes.Unauthenticated, "NoAuthorizationFound")
 }
+
+// AddAPIKeyClaimsInContext adds the claims of the personal api key in the context, jobs use it
+// to call self service rpc and other services on behalf of the owner of the key.
+func AddAPIKeyClaimsInContext(ctx context.Context, keys apikey.Store, key string) (context.Context, error) {
+	customClaims, err := apikey.Verify(ctx, keys, key)
+	if err != nil {
+		logger.Log.Error("grpc/AddAPIKeyClaimsInContext - failed to verify api key", zap.String("reason", err.Error()))
+		return nil, status.Error(codes.Unauthenticated, "InvalidAPIKeyError")
+	}
+	return AddClaims(ctx, customClaims), nil
+}
@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/audit"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/revocation"
+)
+
+// Option customizes the interceptors chained for a service.
+type Option func(*options)
+
+type options struct {
+	revocationList revocation.List
+	apiKeys        apikey.Store
+	// mfaEnrollmentMethods are the full method names accepting mfa enrollment tokens
+	mfaEnrollmentMethods map[string]bool
+	auditPublisher       audit.Publisher
+}
+
+// WithRevocationList makes authentication reject tokens present in the revocation list.
+func WithRevocationList(l revocation.List) Option {
+	return func(o *options) {
+		o.revocationList = l
+	}
+}
+
+// WithAPIKeyStore makes authentication accept the personal api keys present in the store.
+func WithAPIKeyStore(s apikey.Store) Option {
+	return func(o *options) {
+		o.apiKeys = s
+	}
+}
+
+// WithMFAEnrollmentMethods makes authentication accept the tokens of users who must enroll
+// a second factor, only for the given full method names.
+func WithMFAEnrollmentMethods(methods ...string) Option {
+	return func(o *options) {
+		o.mfaEnrollmentMethods = make(map[string]bool, len(methods))
+		for _, m := range methods {
+			o.mfaEnrollmentMethods[m] = true
+		}
+	}
+}
+
+// WithAuditPublisher makes the service publish an audit event for every mutating call of its users.
+func WithAuditPublisher(p audit.Publisher) Option {
+	return func(o *options) {
+		o.auditPublisher = p
+	}
+}
+
+func newOptions(opts []Option) options {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
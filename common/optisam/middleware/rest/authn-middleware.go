@@ -7,9 +7,14 @@ import (
 	"net/http"
 	"strings"
 
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/mfa"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/revocation"
 
 	jwt "github.com/dgrijalva/jwt-go"
+	"go.uber.org/zap"
 )
 
 type key uint8
@@ -20,8 +25,11 @@ const (
 
 // AddClaims add claims to context
 func AddClaims(ctx context.Context, clms *claims.Claims) context.Context {
-	ctx.Value(LoggerKey{}).(*LoggerUserDetails).UserID = clms.UserID
-	ctx.Value(LoggerKey{}).(*LoggerUserDetails).Role = string(clms.Role)
+	// user details are only present when requests are logged by AddLogger
+	if details, ok := ctx.Value(LoggerKey{}).(*LoggerUserDetails); ok {
+		details.UserID = clms.UserID
+		details.Role = string(clms.Role)
+	}
 	return context.WithValue(ctx, keyClaims, clms)
 }
 
@@ -34,9 +42,49 @@ func RetrieveClaims(ctx context.Context) (*claims.Claims, bool) {
 // ValidateAuth is a middleware to check for JWT authorization
 // TODO
 func ValidateAuth(verifyKey *rsa.PublicKey, h http.Handler) http.Handler {
+	return ValidateAuthWithRevocation(verifyKey, nil, h)
+}
+
+// ValidateAuthWithRevocation is a middleware to check for JWT authorization which
+// also rejects the tokens present in revocation list.
+func ValidateAuthWithRevocation(verifyKey *rsa.PublicKey, l revocation.List, h http.Handler) http.Handler {
+	return ValidateAuthWithAPIKeys(verifyKey, l, nil, h)
+}
+
+// ValidateAuthWithAPIKeys is ValidateAuthWithRevocation which also accepts the personal
+// api keys present in keys, given as "Authorization: ApiKey <key>".
+func ValidateAuthWithAPIKeys(verifyKey *rsa.PublicKey, l revocation.List, keys apikey.Store, h http.Handler) http.Handler {
+	return validateAuth(verifyKey, l, keys, false, h)
+}
+
+// ValidateAuthWithMFAEnrollment is ValidateAuthWithAPIKeys which also lets the mfa enrollment
+// tokens through, for gateways whose grpc server restricts them to the enrollment apis.
+func ValidateAuthWithMFAEnrollment(verifyKey *rsa.PublicKey, l revocation.List, keys apikey.Store, h http.Handler) http.Handler {
+	return validateAuth(verifyKey, l, keys, true, h)
+}
+
+func validateAuth(verifyKey *rsa.PublicKey, l revocation.List, keys apikey.Store, allowEnrollment bool, h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		authorizationHeader := r.Header.Get("Authorization")
+		if keys != nil && len(authorizationHeader) > len(apikey.Scheme) &&
+			strings.EqualFold(authorizationHeader[:len(apikey.Scheme)+1], apikey.Scheme+" ") {
+			customClaims, err := apikey.Verify(r.Context(), keys, strings.TrimSpace(authorizationHeader[len(apikey.Scheme)+1:]))
+			switch err {
+			case nil:
+			case apikey.ErrInvalidKey, apikey.ErrExpiredKey:
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			default:
+				logger.Log.Error("rest/ValidateAuth - failed to verify api key", zap.String("reason", err.Error()))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			// keys are revoked in the key store, the revocation list only holds tokens
+			r = r.WithContext(AddClaims(r.Context(), customClaims))
+			h.ServeHTTP(w, r)
+			return
+		}
 		if authorizationHeader != "" {
 			bearerToken := strings.TrimPrefix(authorizationHeader, "Bearer")
 			bearerToken = strings.TrimSpace(bearerToken)
@@ -57,11 +105,31 @@ func ValidateAuth(verifyKey *rsa.PublicKey, h http.Handler) http.Handler {
 
 				return
 			}
+
+			// mfa enrollment tokens are only accepted by account-service enrollment apis
+			if !allowEnrollment && mfa.IsEnrollmentClaims(customClaims) {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+
+			if l != nil {
+				revoked, err := revocation.IsClaimsRevoked(r.Context(), l, customClaims)
+				if err != nil {
+					logger.Log.Error("rest/ValidateAuth - failed to check token revocation", zap.String("reason", err.Error()))
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				if revoked {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+			}
 			ctx := r.Context()
 			// Everything went well, proceed with the request and set the caller to the user retrieved from the parsed token
 			r = r.WithContext(AddClaims(ctx, customClaims))
 			h.ServeHTTP(w, r) // proceed in the middleware chain!
 		} else {
+			w.WriteHeader(http.StatusUnauthorized)
 			json.NewEncoder(w).Encode("Invalid Authorization Token")
 		}
 	})
@@ -5,12 +5,10 @@ import (
 
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/opa"
-
-	"github.com/open-policy-agent/opa/rego"
 )
 
-// ValidateAuthZ for RBAC authorization
-func ValidateAuthZ(p *rego.PreparedEvalQuery, h http.Handler) http.Handler {
+// ValidateAuthZ for RBAC and attribute based authorization
+func ValidateAuthZ(p *opa.Policy, h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		userClaims, ok := RetrieveClaims(r.Context())
@@ -21,7 +19,17 @@ func ValidateAuthZ(p *rego.PreparedEvalQuery, h http.Handler) http.Handler {
 		}
 
 		// Authorize
-		authorized, err := opa.EvalAuthZ(r.Context(), p, opa.AuthzInput{Role: string(userClaims.Role), MethodFullName: r.RequestURI})
+		fields := opa.QueryFields(r.URL.Query())
+		authorized, err := p.Eval(r.Context(), opa.AuthzInput{
+			Role:           string(userClaims.Role),
+			MethodFullName: r.RequestURI,
+			UserID:         userClaims.UserID,
+			Scopes:         opa.RequestedScopes(fields),
+			UserScopes:     userClaims.Socpes,
+			Groups:         userClaims.Groups,
+			Method:         r.Method,
+			Request:        fields,
+		})
 		if err != nil || !authorized {
 			w.WriteHeader(http.StatusForbidden)
 			return
@@ -0,0 +1,70 @@
+package opa
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// RequestFields returns the fields of a request message keyed by their proto names.
+func RequestFields(req interface{}) (map[string]interface{}, error) {
+	var bs []byte
+	var err error
+	if msg, ok := req.(proto.Message); ok {
+		bs, err = protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	} else {
+		bs, err = json.Marshal(req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(bs, &fields); err != nil {
+		// requests which are not objects have no fields
+		return map[string]interface{}{}, nil
+	}
+	return fields, nil
+}
+
+// QueryFields returns the query parameters of a rest call, parameters given
+// once are strings and the other ones are lists.
+func QueryFields(q url.Values) map[string]interface{} {
+	fields := make(map[string]interface{}, len(q))
+	for k, v := range q {
+		if len(v) == 1 {
+			fields[k] = v[0]
+			continue
+		}
+		values := make([]interface{}, len(v))
+		for i := range v {
+			values[i] = v[i]
+		}
+		fields[k] = values
+	}
+	return fields
+}
+
+// RequestedScopes returns the scopes a request is made for, they are found in
+// scope and scopes fields.
+func RequestedScopes(fields map[string]interface{}) []string {
+	var scopes []string
+	add := func(v interface{}) {
+		switch s := v.(type) {
+		case string:
+			if s != "" {
+				scopes = append(scopes, s)
+			}
+		case []interface{}:
+			for _, e := range s {
+				if str, ok := e.(string); ok && str != "" {
+					scopes = append(scopes, str)
+				}
+			}
+		}
+	}
+	add(fields["scope"])
+	add(fields["scopes"])
+	return scopes
+}
@@ -13,11 +13,25 @@ import (
 	"go.uber.org/zap"
 )
 
+// AuthzInput is the input given to the policies, available as input in rego.
 type AuthzInput struct {
 	MethodFullName string `json:"api"`
 	Role           string `json:"role"`
+	// UserID is the user or service client calling the api
+	UserID string `json:"user"`
+	// Scopes are the scopes requested by the call
+	Scopes []string `json:"scopes"`
+	// UserScopes are the scopes user has access to
+	UserScopes []string `json:"user_scopes"`
+	// Groups are the groups user belongs to
+	Groups []string `json:"groups"`
+	// Method is the http method of rest calls
+	Method string `json:"method,omitempty"`
+	// Request holds the fields of the request, query parameters for rest calls
+	Request map[string]interface{} `json:"request"`
 }
 
+// NewOPA prepares the policy of the rego file, use NewPolicy for policies which can be reloaded.
 func NewOPA(ctx context.Context, regoFile string) (*rego.PreparedEvalQuery, error) {
 	regoPaths := []string{regoFile}
 	r, err := rego.New(rego.Query("data.rbac.allow"), rego.Load(regoPaths, nil)).PrepareForEval(ctx)
@@ -28,6 +42,7 @@ func NewOPA(ctx context.Context, regoFile string) (*rego.PreparedEvalQuery, erro
 	return &r, nil
 }
 
+// EvalAuthZ evaluates the decision of the prepared policy for the input.
 func EvalAuthZ(ctx context.Context, p *rego.PreparedEvalQuery, authzInput AuthzInput) (bool, error) {
 	var input map[string]interface{}
 	bs, err := json.Marshal(authzInput)
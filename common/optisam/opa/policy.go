@@ -0,0 +1,141 @@
+package opa
+
+import (
+	"context"
+	"crypto/sha256"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"github.com/open-policy-agent/opa/rego"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultQuery is the decision evaluated by optisam policies.
+	DefaultQuery = "data.rbac.allow"
+	// DefaultReloadInterval is how often policy files are checked for changes.
+	DefaultReloadInterval = 30 * time.Second
+)
+
+// Policy is a rego policy which can be reloaded while it is being evaluated.
+// A policy is loaded from rego files and data json files, or directories of them.
+type Policy struct {
+	query string
+	paths []string
+
+	mu       sync.RWMutex
+	prepared *rego.PreparedEvalQuery
+	checksum []byte
+}
+
+// NewPolicy loads and prepares the policy files for evaluation of the query.
+func NewPolicy(ctx context.Context, query string, paths ...string) (*Policy, error) {
+	p := &Policy{
+		query: query,
+		paths: paths,
+	}
+	if _, err := p.Reload(ctx); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Eval evaluates the policy decision for the input.
+func (p *Policy) Eval(ctx context.Context, input AuthzInput) (bool, error) {
+	p.mu.RLock()
+	prepared := p.prepared
+	p.mu.RUnlock()
+	return EvalAuthZ(ctx, prepared, input)
+}
+
+// Reload prepares the policy again if its files have changed, it reports whether
+// a new policy is used. The current policy is kept if the new one is invalid.
+func (p *Policy) Reload(ctx context.Context) (bool, error) {
+	sum, err := checksum(p.paths)
+	if err != nil {
+		return false, err
+	}
+	p.mu.RLock()
+	unchanged := p.prepared != nil && string(sum) == string(p.checksum)
+	p.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+	r, err := rego.New(rego.Query(p.query), rego.Load(p.paths, nil)).PrepareForEval(ctx)
+	if err != nil {
+		return false, err
+	}
+	p.mu.Lock()
+	p.prepared = &r
+	p.checksum = sum
+	p.mu.Unlock()
+	return true, nil
+}
+
+// Watch reloads the policy whenever its files change until the context is done.
+func (p *Policy) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := p.Reload(ctx)
+			if err != nil {
+				logger.Log.Error("opa - Watch - cannot reload policies, keeping the previous ones", zap.Strings("paths", p.paths), zap.Error(err))
+				continue
+			}
+			if reloaded {
+				logger.Log.Info("opa - Watch - policies reloaded", zap.Strings("paths", p.paths))
+			}
+		}
+	}
+}
+
+// checksum hashes the content of all the files under paths so that a change is detected
+// even when files are replaced through symlinks, like mounted config maps are.
+func checksum(paths []string) ([]byte, error) {
+	var files []string
+	for _, path := range paths {
+		err := filepath.Walk(path, func(f string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.Mode()&os.ModeSymlink != 0 {
+				// symlinks to directories are not walked
+				if info, err = os.Stat(f); err != nil {
+					return err
+				}
+			}
+			if !info.IsDir() {
+				files = append(files, f)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(files)
+	h := sha256.New()
+	for _, f := range files {
+		file, err := os.Open(f)
+		if err != nil {
+			return nil, err
+		}
+		_, _ = io.WriteString(h, f)
+		_, err = io.Copy(h, file)
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return h.Sum(nil), nil
+}
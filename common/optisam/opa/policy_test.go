@@ -0,0 +1,215 @@
+package opa
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const abacPolicy = `package rbac
+
+default allow = false
+
+allow {
+	input.role = "Admin"
+}
+
+# users can only list products of their scopes
+allow {
+	input.role = "User"
+	input.api = "/optisam.products.v1.ProductService/ListProducts"
+	count(input.scopes) > 0
+	every_scope_allowed
+}
+
+every_scope_allowed {
+	not scope_denied
+}
+
+scope_denied {
+	scope := input.scopes[_]
+	not user_scope[scope]
+}
+
+user_scope[s] {
+	s := input.user_scopes[_]
+}
+
+# users of the finance group can delete their own reports
+allow {
+	input.role = "User"
+	input.api = "/optisam.report.v1.ReportService/DeleteReport"
+	input.groups[_] = "ROOT.FINANCE"
+	input.request.created_by = input.user
+}
+`
+
+const rbacPolicy = `package rbac
+
+default allow = false
+
+allow {
+	input.role = "Admin"
+}
+`
+
+func writePolicy(t *testing.T, path, policy string) {
+	if err := os.WriteFile(path, []byte(policy), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPolicy_Eval(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rbac.rego")
+	writePolicy(t, path, abacPolicy)
+	p, err := NewPolicy(context.Background(), DefaultQuery, path)
+	if !assert.Empty(t, err) {
+		return
+	}
+	tests := []struct {
+		name  string
+		input AuthzInput
+		want  bool
+	}{
+		{name: "SUCCESS - admin",
+			input: AuthzInput{Role: "Admin", MethodFullName: "/optisam.products.v1.ProductService/DeleteProduct"},
+			want:  true,
+		},
+		{name: "SUCCESS - user scopes",
+			input: AuthzInput{
+				Role:           "User",
+				MethodFullName: "/optisam.products.v1.ProductService/ListProducts",
+				Scopes:         []string{"A"},
+				UserScopes:     []string{"A", "B"},
+			},
+			want: true,
+		},
+		{name: "FAILURE - scope not owned by user",
+			input: AuthzInput{
+				Role:           "User",
+				MethodFullName: "/optisam.products.v1.ProductService/ListProducts",
+				Scopes:         []string{"A", "C"},
+				UserScopes:     []string{"A", "B"},
+			},
+		},
+		{name: "SUCCESS - group and request fields",
+			input: AuthzInput{
+				Role:           "User",
+				MethodFullName: "/optisam.report.v1.ReportService/DeleteReport",
+				UserID:         "user@test.com",
+				Groups:         []string{"ROOT", "ROOT.FINANCE"},
+				Request:        map[string]interface{}{"created_by": "user@test.com"},
+			},
+			want: true,
+		},
+		{name: "FAILURE - report of another user",
+			input: AuthzInput{
+				Role:           "User",
+				MethodFullName: "/optisam.report.v1.ReportService/DeleteReport",
+				UserID:         "user@test.com",
+				Groups:         []string{"ROOT.FINANCE"},
+				Request:        map[string]interface{}{"created_by": "other@test.com"},
+			},
+		},
+		{name: "FAILURE - not in group",
+			input: AuthzInput{
+				Role:           "User",
+				MethodFullName: "/optisam.report.v1.ReportService/DeleteReport",
+				UserID:         "user@test.com",
+				Groups:         []string{"ROOT.IT"},
+				Request:        map[string]interface{}{"created_by": "user@test.com"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := p.Eval(context.Background(), tt.input)
+			if assert.Empty(t, err) {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPolicy_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rbac.rego")
+	writePolicy(t, path, rbacPolicy)
+	p, err := NewPolicy(context.Background(), DefaultQuery, path)
+	if !assert.Empty(t, err) {
+		return
+	}
+	input := AuthzInput{
+		Role:           "User",
+		MethodFullName: "/optisam.products.v1.ProductService/ListProducts",
+		Scopes:         []string{"A"},
+		UserScopes:     []string{"A"},
+	}
+	allowed := func() bool {
+		got, err := p.Eval(context.Background(), input)
+		assert.Empty(t, err)
+		return got
+	}
+	assert.False(t, allowed())
+
+	reloaded, err := p.Reload(context.Background())
+	assert.Empty(t, err)
+	assert.False(t, reloaded, "policy is not changed")
+
+	writePolicy(t, path, abacPolicy)
+	reloaded, err = p.Reload(context.Background())
+	assert.Empty(t, err)
+	assert.True(t, reloaded)
+	assert.True(t, allowed())
+
+	writePolicy(t, path, "package rbac\n\nallow {")
+	reloaded, err = p.Reload(context.Background())
+	assert.Error(t, err)
+	assert.False(t, reloaded)
+	assert.True(t, allowed(), "previous policy is kept")
+}
+
+func TestRequestedScopes(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields map[string]interface{}
+		want   []string
+	}{
+		{name: "scope",
+			fields: map[string]interface{}{"scope": "A"},
+			want:   []string{"A"},
+		},
+		{name: "scopes",
+			fields: map[string]interface{}{"scopes": []interface{}{"A", "B"}},
+			want:   []string{"A", "B"},
+		},
+		{name: "query",
+			fields: QueryFields(url.Values{"scope": {"A"}, "scopes": {"B", "C"}}),
+			want:   []string{"A", "B", "C"},
+		},
+		{name: "none",
+			fields: map[string]interface{}{"name": "A"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RequestedScopes(tt.fields))
+		})
+	}
+}
+
+func TestRequestFields(t *testing.T) {
+	got, err := RequestFields(struct {
+		Scope string `json:"scope"`
+	}{Scope: "A"})
+	if assert.Empty(t, err) {
+		assert.Equal(t, map[string]interface{}{"scope": "A"}, got)
+	}
+	got, err = RequestFields(nil)
+	if assert.Empty(t, err) {
+		assert.Empty(t, got)
+	}
+}
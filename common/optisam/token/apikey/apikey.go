@@ -0,0 +1,176 @@
+// Package apikey implements the personal api keys which users and services use
+// instead of a password login, each key has its own role, scopes and expiry.
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+const (
+	// Scheme is the authorization scheme of api keys, e.g. "Authorization: ApiKey osk_..."
+	Scheme = "ApiKey"
+	// Audience is the audience of the claims of an api key, it tells them apart from token claims.
+	Audience = "apikey"
+	// Prefix starts every api key so that leaked keys are easy to find.
+	Prefix = "osk_"
+)
+
+var (
+	// ErrInvalidKey is returned when an api key is malformed, unknown or does not match.
+	ErrInvalidKey = errors.New("invalid api key")
+	// ErrExpiredKey is returned when an api key has expired.
+	ErrExpiredKey = errors.New("api key has expired")
+)
+
+// Key is an api key as known by services, the secret part of the key is only stored hashed.
+type Key struct {
+	ID         string      `json:"id"`
+	Name       string      `json:"name"`
+	UserID     string      `json:"user_id"`
+	Role       claims.Role `json:"role"`
+	Scopes     []string    `json:"scopes"`
+	SecretHash string      `json:"secret_hash"`
+	CreatedOn  time.Time   `json:"created_on"`
+	ExpiresAt  time.Time   `json:"expires_at"`
+}
+
+// Claims returns the claims of requests authenticated by the key.
+func (k *Key) Claims() *claims.Claims {
+	return &claims.Claims{
+		UserID:     k.UserID,
+		Role:       k.Role,
+		Socpes:     k.Scopes,
+		IssuedAtMs: k.CreatedOn.UnixMilli(),
+		StandardClaims: jwt.StandardClaims{
+			Id:        k.ID,
+			Audience:  Audience,
+			IssuedAt:  k.CreatedOn.Unix(),
+			ExpiresAt: k.ExpiresAt.Unix(),
+			Subject:   k.Name,
+		},
+	}
+}
+
+// OwnerClaims returns the claims of the key limited to what its owner currently has,
+// the role is lowered to the role of the owner and scopes no longer owned are dropped.
+func (k *Key) OwnerClaims(o *Owner) *claims.Claims {
+	clms := k.Claims()
+	if o.Role.Rank() < clms.Role.Rank() {
+		clms.Role = o.Role
+	}
+	owned := make(map[string]bool, len(o.Scopes))
+	for _, scope := range o.Scopes {
+		owned[scope] = true
+	}
+	scopes := make([]string, 0, len(k.Scopes))
+	for _, scope := range k.Scopes {
+		if owned[scope] {
+			scopes = append(scopes, scope)
+		}
+	}
+	clms.Socpes = scopes
+	return clms
+}
+
+// Owner is what the owner of api keys currently has, keys never give more than their owner.
+type Owner struct {
+	Role   claims.Role
+	Scopes []string
+	// KeysRevokedAt revokes all the keys of the owner created until then, its millisecond included
+	KeysRevokedAt time.Time
+}
+
+// IsKeyClaims reports whether the claims were given by an api key.
+func IsKeyClaims(clms *claims.Claims) bool {
+	return clms.Audience == Audience
+}
+
+// Store holds the api keys known by services.
+type Store interface {
+	// Save saves the key until it expires
+	Save(ctx context.Context, k *Key) error
+	// Get returns the key with given id, ErrInvalidKey is returned if there is no such key
+	Get(ctx context.Context, id string) (*Key, error)
+	// Delete deletes the keys with given ids
+	Delete(ctx context.Context, ids ...string) error
+	// SaveOwner saves the current role and scopes of the owner of keys
+	SaveOwner(ctx context.Context, userID string, role claims.Role, scopes []string) error
+	// RevokeOwnerKeys revokes all the keys of the owner created until now
+	RevokeOwnerKeys(ctx context.Context, userID string) error
+	// Owner returns the owner of keys, ErrInvalidKey is returned if the owner is unknown
+	Owner(ctx context.Context, userID string) (*Owner, error)
+	// DeleteOwner deletes the owner, which invalidates all its keys
+	DeleteOwner(ctx context.Context, userID string) error
+}
+
+// Generate generates a new key, the returned key must be given to its owner
+// and secretHash must be stored instead of the secret.
+func Generate() (id, key, secretHash string, err error) {
+	idBytes := make([]byte, 8)
+	if _, err := rand.Read(idBytes); err != nil {
+		return "", "", "", err
+	}
+	secretBytes := make([]byte, 32)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return "", "", "", err
+	}
+	id = hex.EncodeToString(idBytes)
+	secret := hex.EncodeToString(secretBytes)
+	return id, Prefix + id + "." + secret, Hash(secret), nil
+}
+
+// Parse returns the id and the secret of the key.
+func Parse(key string) (id, secret string, err error) {
+	if !strings.HasPrefix(key, Prefix) {
+		return "", "", ErrInvalidKey
+	}
+	parts := strings.SplitN(strings.TrimPrefix(key, Prefix), ".", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", ErrInvalidKey
+	}
+	return parts[0], parts[1], nil
+}
+
+// Hash returns the hash of the secret part of a key.
+func Hash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// Verify checks the key against the store and returns the claims it gives
+// with the privileges its owner currently has.
+func Verify(ctx context.Context, s Store, key string) (*claims.Claims, error) {
+	id, secret, err := Parse(key)
+	if err != nil {
+		return nil, err
+	}
+	k, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(Hash(secret)), []byte(k.SecretHash)) != 1 {
+		return nil, ErrInvalidKey
+	}
+	if !time.Now().Before(k.ExpiresAt) {
+		return nil, ErrExpiredKey
+	}
+	o, err := s.Owner(ctx, k.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if k.CreatedOn.UnixMilli() <= o.KeysRevokedAt.UnixMilli() {
+		return nil, ErrInvalidKey
+	}
+	return k.OwnerClaims(o), nil
+}
@@ -0,0 +1,147 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeStore struct {
+	keys   map[string]*Key
+	owners map[string]*Owner
+}
+
+func (f fakeStore) Save(ctx context.Context, k *Key) error {
+	f.keys[k.ID] = k
+	return nil
+}
+
+func (f fakeStore) Get(ctx context.Context, id string) (*Key, error) {
+	k, ok := f.keys[id]
+	if !ok {
+		return nil, ErrInvalidKey
+	}
+	return k, nil
+}
+
+func (f fakeStore) Delete(ctx context.Context, ids ...string) error {
+	for _, id := range ids {
+		delete(f.keys, id)
+	}
+	return nil
+}
+
+func (f fakeStore) SaveOwner(ctx context.Context, userID string, role claims.Role, scopes []string) error {
+	o, ok := f.owners[userID]
+	if !ok {
+		o = &Owner{}
+		f.owners[userID] = o
+	}
+	o.Role, o.Scopes = role, scopes
+	return nil
+}
+
+func (f fakeStore) RevokeOwnerKeys(ctx context.Context, userID string) error {
+	f.owners[userID].KeysRevokedAt = time.Now()
+	return nil
+}
+
+func (f fakeStore) Owner(ctx context.Context, userID string) (*Owner, error) {
+	o, ok := f.owners[userID]
+	if !ok {
+		return nil, ErrInvalidKey
+	}
+	return o, nil
+}
+
+func (f fakeStore) DeleteOwner(ctx context.Context, userID string) error {
+	delete(f.owners, userID)
+	return nil
+}
+
+func TestVerify(t *testing.T) {
+	ctx := context.Background()
+	s := fakeStore{keys: map[string]*Key{}, owners: map[string]*Owner{}}
+	newKey := func(userID string, role claims.Role, scopes []string, expiresAt time.Time) string {
+		id, key, secretHash, err := Generate()
+		if !assert.Empty(t, err) {
+			t.FailNow()
+		}
+		_ = s.Save(ctx, &Key{
+			ID:         id,
+			Name:       "ci",
+			UserID:     userID,
+			Role:       role,
+			Scopes:     scopes,
+			SecretHash: secretHash,
+			CreatedOn:  time.Now().Add(-time.Minute),
+			ExpiresAt:  expiresAt,
+		})
+		return key
+	}
+	_ = s.SaveOwner(ctx, "user@test.com", claims.RoleAdmin, []string{"AAA", "BBB"})
+	valid := newKey("user@test.com", claims.RoleUser, []string{"AAA"}, time.Now().Add(time.Hour))
+	expired := newKey("user@test.com", claims.RoleUser, []string{"AAA"}, time.Now().Add(-time.Hour))
+	id, _, _ := Parse(valid)
+	revoked := newKey("user@test.com", claims.RoleUser, []string{"AAA"}, time.Now().Add(time.Hour))
+	revokedID, _, _ := Parse(revoked)
+	_ = s.Delete(ctx, revokedID)
+
+	_ = s.SaveOwner(ctx, "demoted@test.com", claims.RoleUser, []string{"AAA"})
+	demoted := newKey("demoted@test.com", claims.RoleAdmin, []string{"AAA", "BBB"}, time.Now().Add(time.Hour))
+
+	_ = s.SaveOwner(ctx, "revoked@test.com", claims.RoleUser, []string{"AAA"})
+	ownerRevoked := newKey("revoked@test.com", claims.RoleUser, []string{"AAA"}, time.Now().Add(time.Hour))
+	_ = s.RevokeOwnerKeys(ctx, "revoked@test.com")
+
+	// the key is created in the millisecond the keys are revoked in, before the revocation
+	_ = s.SaveOwner(ctx, "samems@test.com", claims.RoleUser, []string{"AAA"})
+	sameMilli := newKey("samems@test.com", claims.RoleUser, []string{"AAA"}, time.Now().Add(time.Hour))
+	sameMilliID, _, _ := Parse(sameMilli)
+	revokedAt := time.Now().Truncate(time.Millisecond)
+	s.keys[sameMilliID].CreatedOn = revokedAt.Add(100 * time.Microsecond)
+	s.owners["samems@test.com"].KeysRevokedAt = revokedAt.Add(900 * time.Microsecond)
+
+	unknownOwner := newKey("deleted@test.com", claims.RoleUser, []string{"AAA"}, time.Now().Add(time.Hour))
+
+	tests := []struct {
+		name       string
+		key        string
+		wantUserID string
+		wantRole   claims.Role
+		wantScopes []string
+		wantErr    error
+	}{
+		{name: "SUCCESS", key: valid, wantUserID: "user@test.com", wantRole: claims.RoleUser, wantScopes: []string{"AAA"}},
+		{name: "SUCCESS - owner demoted and removed from scope", key: demoted, wantUserID: "demoted@test.com", wantRole: claims.RoleUser, wantScopes: []string{"AAA"}},
+		{name: "FAILURE - expired", key: expired, wantErr: ErrExpiredKey},
+		{name: "FAILURE - revoked", key: revoked, wantErr: ErrInvalidKey},
+		{name: "FAILURE - owner keys revoked", key: ownerRevoked, wantErr: ErrInvalidKey},
+		{name: "FAILURE - owner keys revoked in the millisecond the key is created", key: sameMilli, wantErr: ErrInvalidKey},
+		{name: "FAILURE - owner unknown", key: unknownOwner, wantErr: ErrInvalidKey},
+		{name: "FAILURE - wrong secret", key: Prefix + id + ".secret", wantErr: ErrInvalidKey},
+		{name: "FAILURE - no prefix", key: valid[len(Prefix):], wantErr: ErrInvalidKey},
+		{name: "FAILURE - no secret", key: Prefix + id, wantErr: ErrInvalidKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Verify(ctx, s, tt.key)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+			if assert.Empty(t, err) {
+				keyID, _, _ := Parse(tt.key)
+				assert.Equal(t, tt.wantUserID, got.UserID)
+				assert.Equal(t, tt.wantRole, got.Role)
+				assert.Equal(t, tt.wantScopes, got.Socpes)
+				assert.Equal(t, keyID, got.Id)
+				assert.True(t, IsKeyClaims(got))
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package apikey
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	keyPrefix = "APIKey_"
+	// owners are kept without expiry, revoked keys must never become valid again
+	ownerPrefix = "APIKeyOwner_"
+
+	ownerRoleField          = "role"
+	ownerScopesField        = "scopes"
+	ownerKeysRevokedAtField = "keys_revoked_at"
+)
+
+type redisStore struct {
+	r *redis.Client
+}
+
+// NewStore returns a redis backed implementation of Store.
+func NewStore(r *redis.Client) Store {
+	return &redisStore{r: r}
+}
+
+// Save implements Store Save function.
+func (s *redisStore) Save(ctx context.Context, k *Key) error {
+	ttl := time.Until(k.ExpiresAt)
+	if ttl <= 0 {
+		// key is already expired
+		return nil
+	}
+	b, err := json.Marshal(k)
+	if err != nil {
+		return err
+	}
+	return s.r.Set(ctx, keyPrefix+k.ID, b, ttl).Err()
+}
+
+// Get implements Store Get function.
+func (s *redisStore) Get(ctx context.Context, id string) (*Key, error) {
+	b, err := s.r.Get(ctx, keyPrefix+id).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, ErrInvalidKey
+		}
+		return nil, err
+	}
+	k := &Key{}
+	if err := json.Unmarshal(b, k); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// Delete implements Store Delete function.
+func (s *redisStore) Delete(ctx context.Context, ids ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	keys := make([]string, len(ids))
+	for i := range ids {
+		keys[i] = keyPrefix + ids[i]
+	}
+	return s.r.Del(ctx, keys...).Err()
+}
+
+// SaveOwner implements Store SaveOwner function.
+func (s *redisStore) SaveOwner(ctx context.Context, userID string, role claims.Role, scopes []string) error {
+	b, err := json.Marshal(scopes)
+	if err != nil {
+		return err
+	}
+	return s.r.HSet(ctx, ownerPrefix+userID, ownerRoleField, string(role), ownerScopesField, b).Err()
+}
+
+// RevokeOwnerKeys implements Store RevokeOwnerKeys function.
+func (s *redisStore) RevokeOwnerKeys(ctx context.Context, userID string) error {
+	return s.r.HSet(ctx, ownerPrefix+userID, ownerKeysRevokedAtField, time.Now().UnixMilli()).Err()
+}
+
+// Owner implements Store Owner function.
+func (s *redisStore) Owner(ctx context.Context, userID string) (*Owner, error) {
+	fields, err := s.r.HGetAll(ctx, ownerPrefix+userID).Result()
+	if err != nil {
+		return nil, err
+	}
+	role, ok := claims.ReturnRole(fields[ownerRoleField])
+	if !ok {
+		// owner was never saved or has been deleted
+		return nil, ErrInvalidKey
+	}
+	o := &Owner{Role: role}
+	if err := json.Unmarshal([]byte(fields[ownerScopesField]), &o.Scopes); err != nil {
+		return nil, err
+	}
+	if revokedAt, ok := fields[ownerKeysRevokedAtField]; ok {
+		ms, err := strconv.ParseInt(revokedAt, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		o.KeysRevokedAt = time.UnixMilli(ms)
+	}
+	return o, nil
+}
+
+// DeleteOwner implements Store DeleteOwner function.
+func (s *redisStore) DeleteOwner(ctx context.Context, userID string) error {
+	return s.r.Del(ctx, ownerPrefix+userID).Err()
+}
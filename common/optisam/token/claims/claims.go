@@ -22,9 +22,22 @@ type Claims struct {
 	Locale string
 	Role   Role
 	Socpes []string
+	// Groups are the fully qualified names of the groups user belongs to
+	Groups []string `json:",omitempty"`
+	// IssuedAtMs is the time the token was issued at in unix milliseconds
+	IssuedAtMs int64 `json:"iat_ms,omitempty"`
 	jwt.StandardClaims
 }
 
+// IssuedAtMillis returns the time the token was issued at in unix milliseconds,
+// tokens issued before IssuedAtMs was introduced fall back to IssuedAt.
+func (c *Claims) IssuedAtMillis() int64 {
+	if c.IssuedAtMs != 0 {
+		return c.IssuedAtMs
+	}
+	return c.IssuedAt * 1000
+}
+
 func ReturnRole(role string) (Role, bool) {
 	const noRole Role = ""
 	switch role {
@@ -38,3 +51,18 @@ func ReturnRole(role string) (Role, bool) {
 		return noRole, false
 	}
 }
+
+// Rank returns the rank of the role, a role has all the rights of the roles with a lower rank.
+// Unknown roles have the lowest rank.
+func (r Role) Rank() int {
+	switch r {
+	case RoleSuperAdmin:
+		return 3
+	case RoleAdmin:
+		return 2
+	case RoleUser:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -1,7 +1,9 @@
 package generator
 
 import (
+	"crypto/rand"
 	"crypto/rsa"
+	"encoding/hex"
 	"io/ioutil"
 	"time"
 
@@ -58,12 +60,26 @@ func (t *tokenGenerator) GenerateRefreshToken(osClaims *claims.Claims) (string,
 func (t *tokenGenerator) generateToken(sub string, expDur time.Duration, osClaims *claims.Claims) (string, error) {
 	tNow := time.Now().UTC()
 
+	// token id is needed to revoke this particular token
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
+	// callers can restrict the audience of a token and shorten its lifetime, never extend it
+	expiresAt := tNow.Add(expDur).Unix()
+	if osClaims.ExpiresAt != 0 && osClaims.ExpiresAt < expiresAt {
+		expiresAt = osClaims.ExpiresAt
+	}
 	osClaims.StandardClaims = jwt.StandardClaims{
-		ExpiresAt: tNow.Add(expDur).Unix(),
+		Id:        hex.EncodeToString(id),
+		Audience:  osClaims.Audience,
+		ExpiresAt: expiresAt,
 		IssuedAt:  tNow.Unix(),
 		Issuer:    "Orange",
 		Subject:   sub,
 	}
+	osClaims.IssuedAtMs = tNow.UnixMilli()
 
 	token := jwt.NewWithClaims(jwt.SigningMethodRS256, osClaims)
 	tokenStr, err := token.SignedString(t.signKey)
@@ -0,0 +1,72 @@
+package revocation
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	revokedTokenPrefix = "RevokedToken_"
+	revokedUserPrefix  = "RevokedUser_"
+	// legacyMillis is the smallest unix time in milliseconds stored for a user revocation,
+	// smaller values were stored in unix seconds.
+	legacyMillis = 1e12
+)
+
+type redisList struct {
+	r *redis.Client
+}
+
+// NewList returns a redis backed implementation of List.
+func NewList(r *redis.Client) List {
+	return &redisList{r: r}
+}
+
+// RevokeToken implements List RevokeToken function.
+func (l *redisList) RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		// token is already expired
+		return nil
+	}
+	return l.r.Set(ctx, revokedTokenPrefix+tokenID, 1, ttl).Err()
+}
+
+// RevokeUser implements List RevokeUser function.
+func (l *redisList) RevokeUser(ctx context.Context, userID string) error {
+	return l.r.Set(ctx, revokedUserPrefix+userID, time.Now().UnixMilli(), UserRevocationTTL).Err()
+}
+
+// IsRevoked implements List IsRevoked function.
+func (l *redisList) IsRevoked(ctx context.Context, tokenID, userID string, issuedAt int64) (bool, error) {
+	keys := []string{revokedUserPrefix + userID}
+	if tokenID != "" {
+		keys = append(keys, revokedTokenPrefix+tokenID)
+	}
+	vals, err := l.r.MGet(ctx, keys...).Result()
+	if err != nil {
+		return false, err
+	}
+	if len(vals) > 1 && vals[1] != nil {
+		return true, nil
+	}
+	if vals[0] == nil {
+		return false, nil
+	}
+	str, ok := vals[0].(string)
+	if !ok {
+		return false, nil
+	}
+	revokedAt, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return false, err
+	}
+	if revokedAt < legacyMillis {
+		// revocation stored in unix seconds before the switch to milliseconds
+		revokedAt *= 1000
+	}
+	return issuedAt < revokedAt, nil
+}
@@ -0,0 +1,27 @@
+package revocation
+
+import (
+	"context"
+	"time"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+)
+
+// UserRevocationTTL is the time a user revocation is kept, it must be greater than
+// the lifetime of any token issued to the user.
+const UserRevocationTTL = 7 * 24 * time.Hour
+
+// List is the list of revoked tokens shared by all the services.
+type List interface {
+	// RevokeToken revokes the token with given id until it expires
+	RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error
+	// RevokeUser revokes all the tokens issued to the user until now
+	RevokeUser(ctx context.Context, userID string) error
+	// IsRevoked checks if the token with given id issued to the user at issuedAt(unix milliseconds) is revoked
+	IsRevoked(ctx context.Context, tokenID, userID string, issuedAt int64) (bool, error)
+}
+
+// IsClaimsRevoked checks if the token carrying the claims is revoked.
+func IsClaimsRevoked(ctx context.Context, l List, clms *claims.Claims) (bool, error) {
+	return l.IsRevoked(ctx, clms.Id, clms.UserID, clms.IssuedAtMillis())
+}
@@ -0,0 +1,167 @@
+package workerqueue
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/job"
+	dbgen "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/repository/postgres/db"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/worker"
+
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrJobNotFound is returned when the job does not exist
+	ErrJobNotFound = errors.New("job not found")
+	// ErrJobNotDead is returned when replaying or discarding a job which is neither dead nor failed
+	ErrJobNotDead = errors.New("job is neither dead nor failed")
+	// ErrNoWorker is returned when replaying the jobs of a worker which is not registered in the queue
+	ErrNoWorker = errors.New("no worker registered for the jobs")
+)
+
+// ListJobs returns a page of the jobs of the worker with the status, most recent first, and the
+// total number of matching jobs. An empty worker or status matches every job.
+func (q *Queue) ListJobs(ctx context.Context, workerName string, status job.JobStatus, offset, limit int32) ([]job.Job, int32, error) {
+	rows, err := q.repo.ListJobs(ctx, dbgen.ListJobsParams{
+		IsType:   workerName != "",
+		Type:     workerName,
+		IsStatus: status != "",
+		Status:   dbgen.JobStatus(status),
+		PageSize: limit,
+		PageNum:  offset,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(rows) == 0 {
+		return nil, 0, nil
+	}
+	jobs := make([]job.Job, len(rows))
+	for i, r := range rows {
+		jobs[i] = job.Job{
+			JobID:      r.JobID,
+			Type:       sql.NullString{String: r.Type, Valid: true},
+			Status:     job.JobStatus(r.Status),
+			Comments:   r.Comments,
+			StartTime:  r.StartTime,
+			EndTime:    r.EndTime,
+			CreatedAt:  sql.NullTime{Time: r.CreatedAt, Valid: true},
+			RetryCount: r.RetryCount,
+			PPID:       r.Ppid.String,
+		}
+	}
+	return jobs, int32(rows[0].TotalRecords), nil
+}
+
+// GetJob returns the job with its payload
+func (q *Queue) GetJob(ctx context.Context, jobID int32) (*job.Job, error) {
+	j, err := q.repo.GetJob(ctx, jobID)
+	if err == sql.ErrNoRows {
+		return nil, ErrJobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return job.FromRepoJob(&j), nil
+}
+
+// ReplayJob pushes again a dead or failed job with its retries reset
+func (q *Queue) ReplayJob(ctx context.Context, jobID int32) error {
+	j, err := q.repo.GetJob(ctx, jobID)
+	if err == sql.ErrNoRows {
+		return ErrJobNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if !finished(job.JobStatus(j.Status)) {
+		return ErrJobNotDead
+	}
+	workers := q.workers[j.Type]
+	if len(workers) == 0 {
+		return ErrNoWorker
+	}
+	n, err := q.repo.ReplayJob(ctx, jobID)
+	if err != nil {
+		return err
+	}
+	// the job was replayed or discarded in between
+	if n == 0 {
+		return ErrJobNotDead
+	}
+	replayed := job.FromRepoJob(&j)
+	replayed.Type = sql.NullString{String: j.Type, Valid: true}
+	replayed.Status = job.JobStatusPENDING
+	replayed.Comments = sql.NullString{}
+	replayed.StartTime = sql.NullTime{}
+	replayed.EndTime = sql.NullTime{}
+	replayed.RetryCount = sql.NullInt32{Valid: true}
+	if r, ok := workers[0].(worker.Replayer); ok {
+		if err := r.Replay(ctx, replayed); err != nil {
+			// the job goes back to the dead-letter state as it was
+			if dberr := q.repo.UpdateJobStatusFailed(ctx, dbgen.UpdateJobStatusFailedParams{JobID: j.JobID, Status: j.Status, EndTime: j.EndTime, Comments: j.Comments, RetryCount: j.RetryCount}); dberr != nil {
+				logger.Log.Error("Error restoring job not replayed", zap.Int32("jobID", j.JobID), zap.Error(dberr))
+			}
+			return err
+		}
+	}
+	_, err = q.PushJob(jobContext(context.Background(), j.MetaData), *replayed, j.Type)
+	return err
+}
+
+// ReplayJobs replays all the dead or failed jobs of a worker, it returns the number of replayed jobs
+// and of the jobs which could not be replayed.
+func (q *Queue) ReplayJobs(ctx context.Context, workerName string, status job.JobStatus) (int32, int32, error) {
+	if !finished(status) {
+		return 0, 0, ErrJobNotDead
+	}
+	if len(q.workers[workerName]) == 0 {
+		return 0, 0, ErrNoWorker
+	}
+	ids, err := q.repo.ListJobIDs(ctx, dbgen.ListJobIDsParams{Type: workerName, Status: dbgen.JobStatus(status)})
+	if err != nil {
+		return 0, 0, err
+	}
+	var replayed, failed int32
+	for _, id := range ids {
+		if err := q.ReplayJob(ctx, id); err != nil {
+			logger.Log.Error("Error replaying job", zap.Int32("jobID", id), zap.Error(err))
+			failed++
+			continue
+		}
+		replayed++
+	}
+	return replayed, failed, nil
+}
+
+// DiscardJob deletes a dead or failed job
+func (q *Queue) DiscardJob(ctx context.Context, jobID int32) error {
+	n, err := q.repo.DiscardJob(ctx, jobID)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return nil
+	}
+	if _, err := q.repo.GetJob(ctx, jobID); err == sql.ErrNoRows {
+		return ErrJobNotFound
+	}
+	return ErrJobNotDead
+}
+
+// DiscardJobs deletes all the dead or failed jobs of a worker, it returns the number of deleted jobs
+func (q *Queue) DiscardJobs(ctx context.Context, workerName string, status job.JobStatus) (int32, error) {
+	if !finished(status) {
+		return 0, ErrJobNotDead
+	}
+	n, err := q.repo.DiscardJobs(ctx, dbgen.DiscardJobsParams{Type: workerName, Status: dbgen.JobStatus(status)})
+	return int32(n), err
+}
+
+// finished tells whether the jobs with the status can be replayed or discarded
+func finished(status job.JobStatus) bool {
+	return status == job.JobStatusDEAD || status == job.JobStatusFAILED
+}
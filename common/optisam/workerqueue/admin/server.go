@@ -0,0 +1,208 @@
+// Package admin serves the worker queue admin api, services mounting it register the server on their
+// grpc server and the handler on their gateway.
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue"
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/job"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/worker"
+
+	"github.com/golang/protobuf/ptypes"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//go:generate protoc --proto_path=../api/proto/v1 --proto_path=../../../third_party/ --go_out=paths=source_relative:../api/v1 --go-grpc_out=require_unimplemented_servers=false,paths=source_relative:../api/v1 workerqueue.proto
+//go:generate protoc --proto_path=../api/proto/v1 --proto_path=../../../third_party/ --grpc-gateway_out=paths=source_relative:../api/v1 workerqueue.proto
+//go:generate protoc --proto_path=../api/proto/v1 --proto_path=../../../third_party/ --openapiv2_out=logtostderr=true,json_names_for_fields=false:../api/swagger/v1 workerqueue.proto
+
+const maxPageSize = 200
+
+// Queue is the worker queue administered by the server
+type Queue interface {
+	ListJobs(ctx context.Context, workerName string, status job.JobStatus, offset, limit int32) ([]job.Job, int32, error)
+	GetJob(ctx context.Context, jobID int32) (*job.Job, error)
+	ReplayJob(ctx context.Context, jobID int32) error
+	ReplayJobs(ctx context.Context, workerName string, status job.JobStatus) (int32, int32, error)
+	DiscardJob(ctx context.Context, jobID int32) error
+	DiscardJobs(ctx context.Context, workerName string, status job.JobStatus) (int32, error)
+}
+
+type server struct {
+	queue Queue
+}
+
+var _ Queue = (*workerqueue.Queue)(nil)
+
+// NewServer returns the admin api of the queue
+func NewServer(queue Queue) v1.WorkerQueueAdminServer {
+	return &server{queue: queue}
+}
+
+func (s *server) ListJobs(ctx context.Context, req *v1.ListJobsRequest) (*v1.ListJobsResponse, error) {
+	if err := superAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.PageNum < 1 || req.PageSize < 1 || req.PageSize > maxPageSize {
+		return nil, status.Error(codes.InvalidArgument, "BadPagination")
+	}
+	jobStatus := job.JobStatus(req.Status)
+	if jobStatus != "" && !knownStatus(jobStatus) {
+		return nil, status.Error(codes.InvalidArgument, "BadJobStatus")
+	}
+	jobs, total, err := s.queue.ListJobs(ctx, req.Worker, jobStatus, (req.PageNum-1)*req.PageSize, req.PageSize)
+	if err != nil {
+		return nil, queueError(err)
+	}
+	resp := &v1.ListJobsResponse{TotalRecords: total, Jobs: make([]*v1.Job, len(jobs))}
+	for i := range jobs {
+		resp.Jobs[i] = jobProto(&jobs[i])
+	}
+	return resp, nil
+}
+
+func (s *server) GetJob(ctx context.Context, req *v1.GetJobRequest) (*v1.GetJobResponse, error) {
+	if err := superAdmin(ctx); err != nil {
+		return nil, err
+	}
+	j, err := s.queue.GetJob(ctx, req.JobId)
+	if err != nil {
+		return nil, queueError(err)
+	}
+	return &v1.GetJobResponse{Job: jobProto(j), Data: string(j.Data)}, nil
+}
+
+func (s *server) ReplayJob(ctx context.Context, req *v1.ReplayJobRequest) (*v1.ReplayJobResponse, error) {
+	if err := superAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.queue.ReplayJob(ctx, req.JobId); err != nil {
+		return nil, queueError(err)
+	}
+	return &v1.ReplayJobResponse{Success: true}, nil
+}
+
+func (s *server) ReplayJobs(ctx context.Context, req *v1.ReplayJobsRequest) (*v1.ReplayJobsResponse, error) {
+	if err := superAdmin(ctx); err != nil {
+		return nil, err
+	}
+	jobStatus, err := deadStatus(req.Worker, req.Status)
+	if err != nil {
+		return nil, err
+	}
+	replayed, failed, err := s.queue.ReplayJobs(ctx, req.Worker, jobStatus)
+	if err != nil {
+		return nil, queueError(err)
+	}
+	return &v1.ReplayJobsResponse{ReplayedJobs: replayed, FailedJobs: failed}, nil
+}
+
+func (s *server) DiscardJob(ctx context.Context, req *v1.DiscardJobRequest) (*v1.DiscardJobResponse, error) {
+	if err := superAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.queue.DiscardJob(ctx, req.JobId); err != nil {
+		return nil, queueError(err)
+	}
+	return &v1.DiscardJobResponse{Success: true}, nil
+}
+
+func (s *server) DiscardJobs(ctx context.Context, req *v1.DiscardJobsRequest) (*v1.DiscardJobsResponse, error) {
+	if err := superAdmin(ctx); err != nil {
+		return nil, err
+	}
+	jobStatus, err := deadStatus(req.Worker, req.Status)
+	if err != nil {
+		return nil, err
+	}
+	discarded, err := s.queue.DiscardJobs(ctx, req.Worker, jobStatus)
+	if err != nil {
+		return nil, queueError(err)
+	}
+	return &v1.DiscardJobsResponse{DiscardedJobs: discarded}, nil
+}
+
+// superAdmin checks the caller is a super admin, jobs belong to every scope
+func superAdmin(ctx context.Context) error {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if userClaims.Role != claims.RoleSuperAdmin {
+		return status.Error(codes.PermissionDenied, "RoleValidationError")
+	}
+	return nil
+}
+
+// deadStatus validates the worker and the status of bulk requests, the status defaults to DEAD
+func deadStatus(workerName, jobStatus string) (job.JobStatus, error) {
+	if workerName == "" {
+		return "", status.Error(codes.InvalidArgument, "WorkerRequired")
+	}
+	switch job.JobStatus(jobStatus) {
+	case "":
+		return job.JobStatusDEAD, nil
+	case job.JobStatusDEAD, job.JobStatusFAILED:
+		return job.JobStatus(jobStatus), nil
+	default:
+		return "", status.Error(codes.InvalidArgument, "BadJobStatus")
+	}
+}
+
+func knownStatus(s job.JobStatus) bool {
+	switch s {
+	case job.JobStatusPENDING, job.JobStatusRUNNING, job.JobStatusRETRY, job.JobStatusCOMPLETED, job.JobStatusFAILED, job.JobStatusDEAD:
+		return true
+	}
+	return false
+}
+
+func queueError(err error) error {
+	switch err {
+	case workerqueue.ErrJobNotFound:
+		return status.Error(codes.NotFound, "JobNotFound")
+	case workerqueue.ErrJobNotDead:
+		return status.Error(codes.FailedPrecondition, "JobNotDead")
+	case workerqueue.ErrNoWorker:
+		return status.Error(codes.FailedPrecondition, "NoWorkerForJob")
+	}
+	if errors.Is(err, worker.ErrNotReplayable) {
+		return status.Error(codes.FailedPrecondition, "JobNotReplayable")
+	}
+	return status.Error(codes.Internal, "QueueError")
+}
+
+func jobProto(j *job.Job) *v1.Job {
+	return &v1.Job{
+		JobId:      j.JobID,
+		Worker:     j.Type.String,
+		Status:     string(j.Status),
+		Comments:   j.Comments.String,
+		RetryCount: j.RetryCount.Int32,
+		CreatedAt:  timestamp(j.CreatedAt.Time),
+		StartTime:  nullTimestamp(j.StartTime.Time, j.StartTime.Valid),
+		EndTime:    nullTimestamp(j.EndTime.Time, j.EndTime.Valid),
+		Ppid:       j.PPID,
+		Priority:   int32(j.Priority),
+	}
+}
+
+func nullTimestamp(t time.Time, valid bool) *tspb.Timestamp {
+	if !valid {
+		return nil
+	}
+	return timestamp(t)
+}
+
+func timestamp(t time.Time) *tspb.Timestamp {
+	ts, _ := ptypes.TimestampProto(t)
+	return ts
+}
@@ -0,0 +1,194 @@
+package workerqueue
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/job"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/repository/mock"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/repository/postgres/db"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/worker"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type replayWorker struct {
+	replayed []int32
+	err      error
+}
+
+func (w *replayWorker) DoWork(context.Context, *job.Job) error { return nil }
+
+func (w *replayWorker) ID() string { return "test-worker" }
+
+func (w *replayWorker) Replay(_ context.Context, j *job.Job) error {
+	w.replayed = append(w.replayed, j.JobID)
+	return w.err
+}
+
+func newTestQueue(r *mock.MockWorkerqueue, w worker.Worker) *Queue {
+	q := &Queue{
+		repo:    r,
+		workers: map[string][]worker.Worker{w.ID(): {w}},
+	}
+	for i := range q.mq {
+		q.mq[i] = newMLQueue(10)
+	}
+	return q
+}
+
+func TestQueue_ReplayJob(t *testing.T) {
+	ctx := context.Background()
+	end := sql.NullTime{Time: time.Now(), Valid: true}
+	dead := db.Job{JobID: 7, Type: "test-worker", Status: db.JobStatusDEAD, Data: []byte(`{}`), MetaData: []byte(`{"scopes":["OFR"]}`),
+		Comments: sql.NullString{String: "dgraph unavailable", Valid: true}, EndTime: end, RetryCount: sql.NullInt32{Int32: 3, Valid: true}}
+	tests := []struct {
+		name      string
+		setup     func(r *mock.MockWorkerqueue, w *replayWorker)
+		wantErr   error
+		wantQueue int
+	}{
+		{
+			name: "SUCCESS - dead job is pushed again",
+			setup: func(r *mock.MockWorkerqueue, w *replayWorker) {
+				r.EXPECT().GetJob(ctx, int32(7)).Return(dead, nil)
+				r.EXPECT().ReplayJob(ctx, int32(7)).Return(int64(1), nil)
+			},
+			wantQueue: 1,
+		},
+		{
+			name: "FAILURE - job not found",
+			setup: func(r *mock.MockWorkerqueue, w *replayWorker) {
+				r.EXPECT().GetJob(ctx, int32(7)).Return(db.Job{}, sql.ErrNoRows)
+			},
+			wantErr: ErrJobNotFound,
+		},
+		{
+			name: "FAILURE - job is running",
+			setup: func(r *mock.MockWorkerqueue, w *replayWorker) {
+				running := dead
+				running.Status = db.JobStatusRUNNING
+				r.EXPECT().GetJob(ctx, int32(7)).Return(running, nil)
+			},
+			wantErr: ErrJobNotDead,
+		},
+		{
+			name: "FAILURE - no worker for the job",
+			setup: func(r *mock.MockWorkerqueue, w *replayWorker) {
+				other := dead
+				other.Type = "other-worker"
+				r.EXPECT().GetJob(ctx, int32(7)).Return(other, nil)
+			},
+			wantErr: ErrNoWorker,
+		},
+		{
+			name: "FAILURE - job replayed in between",
+			setup: func(r *mock.MockWorkerqueue, w *replayWorker) {
+				r.EXPECT().GetJob(ctx, int32(7)).Return(dead, nil)
+				r.EXPECT().ReplayJob(ctx, int32(7)).Return(int64(0), nil)
+			},
+			wantErr: ErrJobNotDead,
+		},
+		{
+			name: "FAILURE - worker refuses the replay, job is restored",
+			setup: func(r *mock.MockWorkerqueue, w *replayWorker) {
+				w.err = errors.New("refused")
+				r.EXPECT().GetJob(ctx, int32(7)).Return(dead, nil)
+				r.EXPECT().ReplayJob(ctx, int32(7)).Return(int64(1), nil)
+				r.EXPECT().UpdateJobStatusFailed(ctx, db.UpdateJobStatusFailedParams{JobID: 7, Status: db.JobStatusDEAD, EndTime: end, Comments: dead.Comments, RetryCount: dead.RetryCount}).Return(nil)
+			},
+			wantErr: errors.New("refused"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			r := mock.NewMockWorkerqueue(mockCtrl)
+			w := &replayWorker{}
+			tt.setup(r, w)
+			q := newTestQueue(r, w)
+			err := q.ReplayJob(ctx, 7)
+			assert.Equal(t, tt.wantErr, err)
+			if !assert.Equal(t, tt.wantQueue, q.CurrentSize()) || tt.wantQueue == 0 {
+				return
+			}
+			assert.Equal(t, []int32{7}, w.replayed)
+			jobC := q.PopJob()
+			assert.Equal(t, "test-worker", jobC.workerName)
+			assert.Equal(t, job.JobStatusPENDING, jobC.jobData.Status)
+			assert.Equal(t, int32(0), jobC.jobData.RetryCount.Int32)
+			assert.False(t, jobC.jobData.Comments.Valid)
+		})
+	}
+}
+
+func TestQueue_ReplayJobs(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	r := mock.NewMockWorkerqueue(mockCtrl)
+	w := &replayWorker{}
+	q := newTestQueue(r, w)
+
+	_, _, err := q.ReplayJobs(ctx, "test-worker", job.JobStatusCOMPLETED)
+	assert.Equal(t, ErrJobNotDead, err)
+	_, _, err = q.ReplayJobs(ctx, "other-worker", job.JobStatusDEAD)
+	assert.Equal(t, ErrNoWorker, err)
+
+	r.EXPECT().ListJobIDs(ctx, db.ListJobIDsParams{Type: "test-worker", Status: db.JobStatusDEAD}).Return([]int32{1, 2}, nil)
+	r.EXPECT().GetJob(ctx, int32(1)).Return(db.Job{JobID: 1, Type: "test-worker", Status: db.JobStatusDEAD}, nil)
+	r.EXPECT().ReplayJob(ctx, int32(1)).Return(int64(1), nil)
+	r.EXPECT().GetJob(ctx, int32(2)).Return(db.Job{JobID: 2, Type: "test-worker", Status: db.JobStatusCOMPLETED}, nil)
+	replayed, failed, err := q.ReplayJobs(ctx, "test-worker", job.JobStatusDEAD)
+	assert.Empty(t, err)
+	assert.Equal(t, int32(1), replayed)
+	assert.Equal(t, int32(1), failed)
+	assert.Equal(t, 1, q.CurrentSize())
+}
+
+func TestQueue_DiscardJob(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		setup   func(r *mock.MockWorkerqueue)
+		wantErr error
+	}{
+		{
+			name: "SUCCESS",
+			setup: func(r *mock.MockWorkerqueue) {
+				r.EXPECT().DiscardJob(ctx, int32(7)).Return(int64(1), nil)
+			},
+		},
+		{
+			name: "FAILURE - job not found",
+			setup: func(r *mock.MockWorkerqueue) {
+				r.EXPECT().DiscardJob(ctx, int32(7)).Return(int64(0), nil)
+				r.EXPECT().GetJob(ctx, int32(7)).Return(db.Job{}, sql.ErrNoRows)
+			},
+			wantErr: ErrJobNotFound,
+		},
+		{
+			name: "FAILURE - job is pending",
+			setup: func(r *mock.MockWorkerqueue) {
+				r.EXPECT().DiscardJob(ctx, int32(7)).Return(int64(0), nil)
+				r.EXPECT().GetJob(ctx, int32(7)).Return(db.Job{JobID: 7, Status: db.JobStatusPENDING}, nil)
+			},
+			wantErr: ErrJobNotDead,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			r := mock.NewMockWorkerqueue(mockCtrl)
+			tt.setup(r)
+			q := newTestQueue(r, &replayWorker{})
+			assert.Equal(t, tt.wantErr, q.DiscardJob(ctx, 7))
+		})
+	}
+}
@@ -0,0 +1,275 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// WorkerQueueAdminClient is the client API for WorkerQueueAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WorkerQueueAdminClient interface {
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error)
+	ReplayJob(ctx context.Context, in *ReplayJobRequest, opts ...grpc.CallOption) (*ReplayJobResponse, error)
+	ReplayJobs(ctx context.Context, in *ReplayJobsRequest, opts ...grpc.CallOption) (*ReplayJobsResponse, error)
+	DiscardJob(ctx context.Context, in *DiscardJobRequest, opts ...grpc.CallOption) (*DiscardJobResponse, error)
+	DiscardJobs(ctx context.Context, in *DiscardJobsRequest, opts ...grpc.CallOption) (*DiscardJobsResponse, error)
+}
+
+type workerQueueAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorkerQueueAdminClient(cc grpc.ClientConnInterface) WorkerQueueAdminClient {
+	return &workerQueueAdminClient{cc}
+}
+
+func (c *workerQueueAdminClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, "/optisam.workerqueue.v1.WorkerQueueAdmin/ListJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerQueueAdminClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error) {
+	out := new(GetJobResponse)
+	err := c.cc.Invoke(ctx, "/optisam.workerqueue.v1.WorkerQueueAdmin/GetJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerQueueAdminClient) ReplayJob(ctx context.Context, in *ReplayJobRequest, opts ...grpc.CallOption) (*ReplayJobResponse, error) {
+	out := new(ReplayJobResponse)
+	err := c.cc.Invoke(ctx, "/optisam.workerqueue.v1.WorkerQueueAdmin/ReplayJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerQueueAdminClient) ReplayJobs(ctx context.Context, in *ReplayJobsRequest, opts ...grpc.CallOption) (*ReplayJobsResponse, error) {
+	out := new(ReplayJobsResponse)
+	err := c.cc.Invoke(ctx, "/optisam.workerqueue.v1.WorkerQueueAdmin/ReplayJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerQueueAdminClient) DiscardJob(ctx context.Context, in *DiscardJobRequest, opts ...grpc.CallOption) (*DiscardJobResponse, error) {
+	out := new(DiscardJobResponse)
+	err := c.cc.Invoke(ctx, "/optisam.workerqueue.v1.WorkerQueueAdmin/DiscardJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerQueueAdminClient) DiscardJobs(ctx context.Context, in *DiscardJobsRequest, opts ...grpc.CallOption) (*DiscardJobsResponse, error) {
+	out := new(DiscardJobsResponse)
+	err := c.cc.Invoke(ctx, "/optisam.workerqueue.v1.WorkerQueueAdmin/DiscardJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkerQueueAdminServer is the server API for WorkerQueueAdmin service.
+// All implementations should embed UnimplementedWorkerQueueAdminServer
+// for forward compatibility
+type WorkerQueueAdminServer interface {
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error)
+	ReplayJob(context.Context, *ReplayJobRequest) (*ReplayJobResponse, error)
+	ReplayJobs(context.Context, *ReplayJobsRequest) (*ReplayJobsResponse, error)
+	DiscardJob(context.Context, *DiscardJobRequest) (*DiscardJobResponse, error)
+	DiscardJobs(context.Context, *DiscardJobsRequest) (*DiscardJobsResponse, error)
+}
+
+// UnimplementedWorkerQueueAdminServer should be embedded to have forward compatible implementations.
+type UnimplementedWorkerQueueAdminServer struct {
+}
+
+func (UnimplementedWorkerQueueAdminServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedWorkerQueueAdminServer) GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedWorkerQueueAdminServer) ReplayJob(context.Context, *ReplayJobRequest) (*ReplayJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayJob not implemented")
+}
+func (UnimplementedWorkerQueueAdminServer) ReplayJobs(context.Context, *ReplayJobsRequest) (*ReplayJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayJobs not implemented")
+}
+func (UnimplementedWorkerQueueAdminServer) DiscardJob(context.Context, *DiscardJobRequest) (*DiscardJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardJob not implemented")
+}
+func (UnimplementedWorkerQueueAdminServer) DiscardJobs(context.Context, *DiscardJobsRequest) (*DiscardJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardJobs not implemented")
+}
+
+// UnsafeWorkerQueueAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorkerQueueAdminServer will
+// result in compilation errors.
+type UnsafeWorkerQueueAdminServer interface {
+	mustEmbedUnimplementedWorkerQueueAdminServer()
+}
+
+func RegisterWorkerQueueAdminServer(s grpc.ServiceRegistrar, srv WorkerQueueAdminServer) {
+	s.RegisterService(&_WorkerQueueAdmin_serviceDesc, srv)
+}
+
+func _WorkerQueueAdmin_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerQueueAdminServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.workerqueue.v1.WorkerQueueAdmin/ListJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerQueueAdminServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerQueueAdmin_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerQueueAdminServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.workerqueue.v1.WorkerQueueAdmin/GetJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerQueueAdminServer).GetJob(ctx, req.(*GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerQueueAdmin_ReplayJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerQueueAdminServer).ReplayJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.workerqueue.v1.WorkerQueueAdmin/ReplayJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerQueueAdminServer).ReplayJob(ctx, req.(*ReplayJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerQueueAdmin_ReplayJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerQueueAdminServer).ReplayJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.workerqueue.v1.WorkerQueueAdmin/ReplayJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerQueueAdminServer).ReplayJobs(ctx, req.(*ReplayJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerQueueAdmin_DiscardJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerQueueAdminServer).DiscardJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.workerqueue.v1.WorkerQueueAdmin/DiscardJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerQueueAdminServer).DiscardJob(ctx, req.(*DiscardJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkerQueueAdmin_DiscardJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerQueueAdminServer).DiscardJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.workerqueue.v1.WorkerQueueAdmin/DiscardJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerQueueAdminServer).DiscardJobs(ctx, req.(*DiscardJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WorkerQueueAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "optisam.workerqueue.v1.WorkerQueueAdmin",
+	HandlerType: (*WorkerQueueAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListJobs",
+			Handler:    _WorkerQueueAdmin_ListJobs_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _WorkerQueueAdmin_GetJob_Handler,
+		},
+		{
+			MethodName: "ReplayJob",
+			Handler:    _WorkerQueueAdmin_ReplayJob_Handler,
+		},
+		{
+			MethodName: "ReplayJobs",
+			Handler:    _WorkerQueueAdmin_ReplayJobs_Handler,
+		},
+		{
+			MethodName: "DiscardJob",
+			Handler:    _WorkerQueueAdmin_DiscardJob_Handler,
+		},
+		{
+			MethodName: "DiscardJobs",
+			Handler:    _WorkerQueueAdmin_DiscardJobs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "workerqueue.proto",
+}
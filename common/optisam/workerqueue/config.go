@@ -8,4 +8,16 @@ type QueueConfig struct {
 	Retries      int
 	BaseDelay    time.Duration
 	IsMultiQueue bool
+	// LeaseTime in milliseconds is the time a job claimed by a queue is kept without heartbeat before another queue claims it
+	LeaseTime time.Duration
+	// Concurrency caps the jobs worked at once per worker name, names without cap are not limited
+	Concurrency map[string]int
+	// RateLimits throttles the calls of the workers per target service
+	RateLimits map[string]RateLimit
+}
+
+// RateLimit is the rate of calls per second allowed to a target service with the burst allowed above it
+type RateLimit struct {
+	Rate  float64
+	Burst int
 }
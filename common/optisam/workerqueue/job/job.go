@@ -19,6 +19,21 @@ const (
 	JobStatusFAILED    JobStatus = "FAILED"
 	JobStatusRETRY     JobStatus = "RETRY"
 	JobStatusRUNNING   JobStatus = "RUNNING"
+	// JobStatusDEAD is the status of the jobs which exhausted their retries, they stay in the
+	// dead-letter state until they are replayed or discarded
+	JobStatusDEAD JobStatus = "DEAD"
+)
+
+// Priority orders the jobs of a queue, the jobs of a higher priority are worked first
+type Priority int16
+
+const (
+	// PriorityLow is the priority of the bulk loads
+	PriorityLow Priority = -1
+	// PriorityNormal is the priority of the jobs which do not set one
+	PriorityNormal Priority = 0
+	// PriorityHigh is the priority of the interactive changes, they jump ahead of the bulk loads
+	PriorityHigh Priority = 1
 )
 
 func (e *JobStatus) Scan(src interface{}) error {
@@ -39,6 +54,7 @@ type Job struct {
 	RetryCount sql.NullInt32   `json:"retry_count"`
 	MetaData   metadata.MD     `json:"meta_data"`
 	PPID       string          `json:"pp_id"`
+	Priority   Priority        `json:"priority"`
 }
 
 // ToRepoJob handles data modelling from queue job to repo job
@@ -53,6 +69,7 @@ func ToRepoJob(j *Job) *dbgen.Job {
 		EndTime:    j.EndTime,
 		RetryCount: j.RetryCount,
 		Ppid:       sql.NullString{String: j.PPID},
+		Priority:   int16(j.Priority),
 	}
 }
 
@@ -74,5 +91,6 @@ func FromRepoJob(j *dbgen.Job) *Job {
 		RetryCount: j.RetryCount,
 		MetaData:   md,
 		PPID:       j.Ppid.String,
+		Priority:   Priority(j.Priority),
 	}
 }
@@ -4,9 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"fmt"
 	"math/rand"
 	"os"
 	"os/signal"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -42,6 +45,15 @@ type mlQueue struct {
 	mux       sync.Mutex
 }
 
+// priorities is the number of priority levels, each level has its own multilevel queue
+const priorities = int(job.PriorityHigh-job.PriorityLow) + 1
+
+// claimBatch is the number of pending jobs claimed at once
+const claimBatch = 1000
+
+// claimInterval is the time between two claims of the pending jobs
+const claimInterval = time.Second
+
 // Queue represents a queue
 type Queue struct {
 	// IsWorkerRegCompleted is using for avoid concurrent map read write failure.
@@ -53,8 +65,8 @@ type Queue struct {
 	ID string
 	// repo represents a handle to a repo struct wrapper to *sql.DB and generated queries
 	repo repoInterface.Workerqueue
-	// notifier is a chan used to signal workers there is a job to begin working
-	mq mlQueue
+	// mq holds the multilevel queue of each priority, from the lowest to the highest one
+	mq [priorities]*mlQueue
 	// queueSize is the size of notification channel
 	queueSize int
 
@@ -63,9 +75,25 @@ type Queue struct {
 
 	// exponential backoff for retires
 	baseDelay time.Duration
+
+	// owner names the queue in the leases of the jobs it claimed
+	owner sql.NullString
+
+	// leaseTime is the time a claimed job is kept by the queue without heartbeat
+	leaseTime time.Duration
 	// workers is a list of *Workers
 	workers map[string][]worker.Worker
 
+	// slots caps the jobs of a worker name worked at once
+	slots map[string]chan struct{}
+
+	// limits are the token buckets of the target services
+	limits map[string]*tokenBucket
+
+	// inflight holds the jobs being worked by the queue, only their leases are renewed
+	inflight    map[int32]struct{}
+	inflightMux sync.Mutex
+
 	// wg is used to help gracefully shutdown workers
 	wg *sync.WaitGroup
 
@@ -83,6 +111,8 @@ func NewQueue(ctx context.Context, queueID string, db *sql.DB, conf QueueConfig)
 	q.retries = 3                       // Default
 	q.IsMultiQueue = conf.IsMultiQueue
 	q.baseDelay = 3 * time.Second // Default
+	q.leaseTime = time.Minute     // Default
+	q.owner = sql.NullString{String: leaseOwner(queueID), Valid: true}
 
 	if conf.PollingRate > 0 {
 		q.PollRate = conf.PollingRate
@@ -96,34 +126,51 @@ func NewQueue(ctx context.Context, queueID string, db *sql.DB, conf QueueConfig)
 	if conf.Retries >= 0 {
 		q.retries = conf.Retries
 	}
+	if conf.LeaseTime > 0 {
+		q.leaseTime = conf.LeaseTime * time.Millisecond
+	}
 	// Multilevel Queue/channel created
-	temp := mlQueue{}
-	temp.notifier = make([]chan JobChan, 1)
-	temp.notifier[0] = make(chan JobChan, q.queueSize)
-	temp.total = 1
-	q.mq = temp
+	for i := range q.mq {
+		q.mq[i] = newMLQueue(q.queueSize)
+	}
+	q.slots = make(map[string]chan struct{})
+	for name, n := range conf.Concurrency {
+		if n > 0 {
+			q.slots[strings.ToLower(name)] = make(chan struct{}, n)
+		}
+	}
+	q.limits = make(map[string]*tokenBucket)
+	for target, l := range conf.RateLimits {
+		if l.Rate > 0 {
+			q.limits[strings.ToLower(target)] = newTokenBucket(l, time.Now())
+		}
+	}
+
+	q.inflight = make(map[int32]struct{})
 
 	m := make(map[string][]worker.Worker)
 	q.workers = m
 	var wg sync.WaitGroup
 	q.wg = &wg
 
-	// resume stopped jobs
-	err := q.ResumePendingJobs(ctx)
-	if err != nil {
-		logger.Log.Error("Unable to resume jobs from bucket: %s", zap.Error(err))
-		// Don't fail out, this isn't really fatal. But maybe it should be?
-	}
+	// the stopped jobs are resumed once the workers are registered
+	go q.keepLeases(ctx)
 	return q, nil
 }
 
 // Close attempts to graceful shutdown all workers in a queue and shutdown the db connection
 func (q *Queue) Close(ctx context.Context) {
 	q.wg.Wait()
-	for i := 0; i < q.mq.total; i++ {
-		close(q.mq.notifier[i])
+	for _, m := range q.mq {
+		for i := 0; i < m.total; i++ {
+			close(m.notifier[i])
+		}
 	}
 	q.workers = nil
+	// the jobs left in the queue are claimed by the other queues without waiting for their leases to expire
+	if _, err := q.repo.ReleaseLeases(ctx, q.owner); err != nil {
+		logger.Log.Error("Unable to release the leases of the queue", zap.Error(err))
+	}
 }
 
 // GetRetries return queue conf retry param
@@ -131,14 +178,14 @@ func (q *Queue) GetRetries() int32 {
 	return int32(q.retries)
 }
 
-// GetLength return no of msgs in queue/ith channel
+// GetLength return no of msgs in queue/ith channel of the normal priority
 func (q *Queue) GetLength() int32 {
-	return int32(len(q.mq.notifier[q.mq.pushIndex]))
+	return q.level(job.PriorityNormal).length()
 }
 
 // GetCapacity return queue's msg holding capacity, default it is 10K
 func (q *Queue) GetCapacity() int32 {
-	return int32(cap(q.mq.notifier[q.mq.pushIndex]))
+	return q.level(job.PriorityNormal).capacity()
 }
 
 // RegisterWorker contains the main loop for all Workers.
@@ -160,26 +207,19 @@ func (q *Queue) RegisterWorker(ctx context.Context, w worker.Worker) {
 	q.wg.Add(1)
 	// The big __main loop__ for workers.
 	go func() {
-		var jobC JobChan
-		var ok bool
 		for {
 			if q.IsWorkerRegCompleted == false {
 				time.Sleep(5 * time.Second)
 				continue
 			}
-			// receive a notification from the queue chan
 			select {
 			case <-ctx.Done():
 				q.wg.Done()
 				return
-			case jobC, ok = <-q.mq.notifier[q.mq.popIndex]:
-				if ok == false && q.IsMultiQueue {
-					q.mq.mux.Lock()
-					q.Shrink()
-					q.mq.mux.Unlock()
-					continue
-				}
-				// logger.Log.Debug("job is poped, queue stats", zap.Any("popIndex", q.mq.popIndex), zap.Any("queueSize", len(q.mq.notifier[q.mq.popIndex])))
+			default:
+			}
+			// receive a notification from the queue chan of the highest priority
+			if jobC, ok := q.nextJob(); ok {
 				md, _ := metadata.FromIncomingContext(jobC.ctx)
 				ctx = metadata.NewOutgoingContext(ctx, md)
 				ctx = metadata.NewIncomingContext(ctx, md)
@@ -193,13 +233,11 @@ func (q *Queue) RegisterWorker(ctx context.Context, w worker.Worker) {
 					w = q.workers[jobC.workerName][rand.Intn(lenWorker)] // nolint: gosec
 				}
 				if jobC.jobData.JobID == 0 {
-					mdCopy := md.Copy()
-					delete(mdCopy, "grpc-trace-bin")
-					delete(mdCopy, "grpc-tags-bin")
-					metaData, _ := json.Marshal(mdCopy)
+					metaData := jobMetaData(md)
 					repoJob := job.ToRepoJob(&jobC.jobData)
 					jobID, err := q.repo.CreateJob(ctx, dbgen.CreateJobParams{Type: repoJob.Type, Status: repoJob.Status, Data: repoJob.Data,
-						Comments: repoJob.Comments, StartTime: repoJob.StartTime, EndTime: repoJob.EndTime, MetaData: metaData, Ppid: sql.NullString{String: repoJob.Ppid.String, Valid: true}})
+						Comments: repoJob.Comments, StartTime: repoJob.StartTime, EndTime: repoJob.EndTime, MetaData: metaData, Ppid: sql.NullString{String: repoJob.Ppid.String, Valid: true}, Priority: repoJob.Priority,
+						LeaseOwner: q.owner, Secs: q.leaseTime.Seconds()})
 					if err != nil {
 						logger.Log.Error("Unable to push job to db: %s, requeueing the job", zap.Error(err))
 						q.PushJob(ctx, jobC.jobData, jobC.workerName)
@@ -208,10 +246,25 @@ func (q *Queue) RegisterWorker(ctx context.Context, w worker.Worker) {
 					jobC.jobID = jobID
 					jobC.jobData.JobID = jobID
 				}
+				release, ok := q.acquire(jobC.workerName)
+				if !ok {
+					// the worker works as many jobs as it may, the job goes back to the queue so that
+					// the jobs of the other workers are not held behind it
+					q.PushJob(jobC.ctx, jobC.jobData, jobC.workerName)
+					time.Sleep(q.PollRate)
+					continue
+				}
+				if !q.claim(ctx, jobC) {
+					release()
+					continue
+				}
+				q.working(jobC.jobID, true)
 				processing(ctx, q, w, jobC)
-			default:
-				time.Sleep(q.PollRate)
+				q.working(jobC.jobID, false)
+				release()
+				continue
 			}
+			time.Sleep(q.PollRate)
 		}
 	}()
 }
@@ -241,9 +294,9 @@ func processing(ctx context.Context, q *Queue, worker worker.Worker, jobC JobCha
 				q.PushJob(ctx, jobC.jobData, jobC.jobData.Type.String)
 			} else {
 				logger.Log.Error("Retries execceded for ", zap.Int32("jobId", jobC.jobData.JobID))
-				err = q.repo.UpdateJobStatusFailed(ctx, dbgen.UpdateJobStatusFailedParams{JobID: jobC.jobID, Status: "FAILED", EndTime: sql.NullTime{Time: time.Now(), Valid: true}, Comments: sql.NullString{String: err.Error(), Valid: true}, RetryCount: sql.NullInt32{Int32: jobC.jobData.RetryCount.Int32, Valid: true}})
+				err = q.repo.UpdateJobStatusFailed(ctx, dbgen.UpdateJobStatusFailedParams{JobID: jobC.jobID, Status: "DEAD", EndTime: sql.NullTime{Time: time.Now(), Valid: true}, Comments: sql.NullString{String: err.Error(), Valid: true}, RetryCount: sql.NullInt32{Int32: jobC.jobData.RetryCount.Int32, Valid: true}})
 				if err != nil {
-					logger.Log.Error("Error update status to failed for job: %s", zap.Error(err))
+					logger.Log.Error("Error update status to dead for job: %s", zap.Error(err))
 					q.PushJob(ctx, jobC.jobData, jobC.jobData.Type.String)
 				}
 				break
@@ -259,61 +312,171 @@ func processing(ctx context.Context, q *Queue, worker worker.Worker, jobC JobCha
 	}
 }
 
-// PushJob pushes a job to the queue and notifies workers
+// PushJob pushes a job to the queue of its priority and notifies workers
 func (q *Queue) PushJob(ctx context.Context, j job.Job, workerName string) (int32, error) {
-	q.mq.mux.Lock()
-	if q.GetLength() == q.GetCapacity() && q.IsMultiQueue {
-		q.Grow()
+	m := q.level(j.Priority)
+	m.mux.Lock()
+	if m.length() == m.capacity() && q.IsMultiQueue {
+		m.grow(q.queueSize)
 	}
-	q.mq.notifier[q.mq.pushIndex] <- JobChan{j.JobID, workerName, j, ctx}
-	// logger.Log.Debug("queue info", zap.Any("queueno", q.mq.pushIndex), zap.Any("queueLen", q.GetLength()))
-	q.mq.mux.Unlock()
+	m.notifier[m.pushIndex] <- JobChan{j.JobID, workerName, j, ctx}
+	// logger.Log.Debug("queue info", zap.Any("queueno", m.pushIndex), zap.Any("queueLen", m.length()))
+	m.mux.Unlock()
 	return 0, nil
 }
 
-// GetIthLength gives the current msg count in ith length
+// CreateJob inserts a job with the statements of tx rather than pushing it to the queue so that it is kept or
+// dropped with the changes of tx, a pending job is claimed by a queue once tx is committed.
+func CreateJob(ctx context.Context, tx *sql.Tx, j job.Job) (int32, error) {
+	return createJob(ctx, dbgen.New(tx), j)
+}
+
+func createJob(ctx context.Context, r repoInterface.Workerqueue, j job.Job) (int32, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	repoJob := job.ToRepoJob(&j)
+	return r.CreateJob(ctx, dbgen.CreateJobParams{Type: repoJob.Type, Status: repoJob.Status, Data: repoJob.Data,
+		Comments: repoJob.Comments, StartTime: repoJob.StartTime, EndTime: repoJob.EndTime, MetaData: jobMetaData(md), Ppid: sql.NullString{String: repoJob.Ppid.String, Valid: true}, Priority: repoJob.Priority})
+}
+
+// jobMetaData is the metadata kept with a job to work it with, the tracing metadata is dropped
+func jobMetaData(md metadata.MD) json.RawMessage {
+	mdCopy := md.Copy()
+	delete(mdCopy, "grpc-trace-bin")
+	delete(mdCopy, "grpc-tags-bin")
+	metaData, _ := json.Marshal(mdCopy)
+	return metaData
+}
+
+// GetIthLength gives the current msg count in ith length of the normal priority
 func (q *Queue) GetIthLength(i int) int32 {
-	return int32(len(q.mq.notifier[i]))
+	return int32(len(q.level(job.PriorityNormal).notifier[i]))
 }
 
-// Shrink shrinks the queue
+// Shrink shrinks the queue of the normal priority
 func (q *Queue) Shrink() {
-	if q.GetIthLength(q.mq.popIndex) == 0 && q.mq.pushIndex > 0 {
-		q.mq.notifier = q.mq.notifier[q.mq.popIndex+1:]
-		q.mq.total--
-		q.mq.pushIndex--
-		// log.Printf("After shrink new mq %+v", q.mq)
-		logger.Log.Error("WorkerQueue Stats", zap.Any("popindex", q.mq.popIndex), zap.Any("queueSize", len(q.mq.notifier[q.mq.popIndex])), zap.Any("totalChannelList", q.mq.total))
-	}
+	q.level(job.PriorityNormal).shrink()
 }
 
-// Grow dynmically changes the queue size as per msgs
+// Grow dynmically changes the queue size of the normal priority as per msgs
 func (q *Queue) Grow() {
-	if q.GetLength() == q.GetCapacity() {
-		temp := make(chan JobChan, q.queueSize)
-		q.mq.notifier = append(q.mq.notifier, temp)
-		close(q.mq.notifier[q.mq.pushIndex])
-		q.mq.total++
-		q.mq.pushIndex++
-		// log.Printf("After grow new mq %+v", q.mq)
-		logger.Log.Error("WorkerQueue Stats", zap.Any("pushindex", q.mq.pushIndex), zap.Any("queueSize", len(q.mq.notifier[q.mq.pushIndex-1])), zap.Any("totalChannelList", q.mq.total))
-	}
-
+	q.level(job.PriorityNormal).grow(q.queueSize)
 }
 
-// Pop get the data from queue
+// Pop get the data from queue of the normal priority
 func (q *Queue) PopJob() JobChan {
-	return <-q.mq.notifier[q.mq.popIndex]
+	m := q.level(job.PriorityNormal)
+	return <-m.notifier[m.popIndex]
 }
 
 // CurrentSize tells total msgs in queue
 func (q *Queue) CurrentSize() int {
-	return len(q.mq.notifier[q.mq.pushIndex])
+	size := 0
+	for _, m := range q.mq {
+		size += len(m.notifier[m.pushIndex])
+	}
+	return size
+}
+
+// level returns the multilevel queue of the priority, unknown priorities are bounded to the known ones
+func (q *Queue) level(p job.Priority) *mlQueue {
+	switch {
+	case p < job.PriorityLow:
+		p = job.PriorityLow
+	case p > job.PriorityHigh:
+		p = job.PriorityHigh
+	}
+	return q.mq[p-job.PriorityLow]
+}
+
+// nextJob pops a job of the highest priority holding one
+func (q *Queue) nextJob() (JobChan, bool) {
+	for i := len(q.mq) - 1; i >= 0; i-- {
+		m := q.mq[i]
+		select {
+		case jobC, ok := <-m.notifier[m.popIndex]:
+			if ok {
+				return jobC, true
+			}
+			// the bucket was closed when the queue grew, the next bucket is read on the next poll
+			if q.IsMultiQueue {
+				m.mux.Lock()
+				m.shrink()
+				m.mux.Unlock()
+			}
+			return JobChan{}, false
+		default:
+		}
+	}
+	return JobChan{}, false
+}
+
+// acquire takes a slot of the worker when its concurrency is capped, it fails without waiting when all
+// the slots are taken. The returned func releases the slot.
+func (q *Queue) acquire(workerName string) (func(), bool) {
+	slots, ok := q.slots[strings.ToLower(workerName)]
+	if !ok {
+		return func() {}, true
+	}
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, true
+	default:
+		return nil, false
+	}
 }
 
-// ResumePendingJobs loops through all pending jobs
+// Throttle waits for a token of the rate limit of the target service, targets without rate limit are not throttled
+func (q *Queue) Throttle(ctx context.Context, target string) error {
+	b, ok := q.limits[strings.ToLower(target)]
+	if !ok {
+		return nil
+	}
+	return b.wait(ctx)
+}
+
+func newMLQueue(size int) *mlQueue {
+	return &mlQueue{notifier: []chan JobChan{make(chan JobChan, size)}, total: 1}
+}
+
+func (m *mlQueue) length() int32 {
+	return int32(len(m.notifier[m.pushIndex]))
+}
+
+func (m *mlQueue) capacity() int32 {
+	return int32(cap(m.notifier[m.pushIndex]))
+}
+
+// shrink drops the emptied bucket
+func (m *mlQueue) shrink() {
+	if len(m.notifier[m.popIndex]) == 0 && m.pushIndex > 0 {
+		m.notifier = m.notifier[m.popIndex+1:]
+		m.total--
+		m.pushIndex--
+		logger.Log.Error("WorkerQueue Stats", zap.Any("popindex", m.popIndex), zap.Any("queueSize", len(m.notifier[m.popIndex])), zap.Any("totalChannelList", m.total))
+	}
+}
+
+// grow adds a bucket when the last one is full
+func (m *mlQueue) grow(size int) {
+	if m.length() == m.capacity() {
+		temp := make(chan JobChan, size)
+		m.notifier = append(m.notifier, temp)
+		close(m.notifier[m.pushIndex])
+		m.total++
+		m.pushIndex++
+		logger.Log.Error("WorkerQueue Stats", zap.Any("pushindex", m.pushIndex), zap.Any("queueSize", len(m.notifier[m.pushIndex-1])), zap.Any("totalChannelList", m.total))
+	}
+}
+
+// ResumePendingJobs claims the pending jobs which are not leased by another queue up to the jobs its workers
+// are free to work, the other pending jobs are left to the other queues.
+// The jobs of a crashed queue are claimed once its leases expired.
 func (q *Queue) ResumePendingJobs(ctx context.Context) error {
-	jobs, err := q.repo.GetJobsForRetry(ctx)
+	free := q.freeSlots()
+	if free <= 0 {
+		return nil
+	}
+	jobs, err := q.repo.ClaimJobs(ctx, dbgen.ClaimJobsParams{Owner: q.owner, LeaseSeconds: q.leaseTime.Seconds(), Batch: int32(free)})
 	if err != nil {
 		logger.Log.Error("Error getting jobs from DB %v", zap.Error(err))
 		return err
@@ -322,27 +485,120 @@ func (q *Queue) ResumePendingJobs(ctx context.Context) error {
 	for i, j := range jobs {
 		if j.RetryCount.Int32 < int32(q.retries) {
 			job := *(job.FromRepoJob(&jobs[i]))
-			// metaData := make(map[string]string)
-			md := metadata.MD{}
-			if j.MetaData != nil {
-				err := json.Unmarshal(j.MetaData, &md)
-				if err != nil {
-					logger.Log.Error("Error unmarshling meta data %s", zap.Error(err))
-				}
-			}
-			// for k, v := range metaData {
-			// 	md = metadata.Pairs(k, v)
-			// }
-			ctx = metadata.NewOutgoingContext(ctx, md)
-			ctx = metadata.NewIncomingContext(ctx, md)
-			q.PushJob(ctx, job, job.Type.String)
+			q.PushJob(jobContext(ctx, j.MetaData), job, job.Type.String)
 		} else {
 			logger.Log.Error("Error already retires execeeded for ", zap.Int32("jobID", j.JobID))
-			err = q.repo.UpdateJobStatusCompleted(ctx, dbgen.UpdateJobStatusCompletedParams{JobID: j.JobID, Status: "FAILED", EndTime: sql.NullTime{Time: time.Now(), Valid: true}})
+			err = q.repo.UpdateJobStatusCompleted(ctx, dbgen.UpdateJobStatusCompletedParams{JobID: j.JobID, Status: "DEAD", EndTime: sql.NullTime{Time: time.Now(), Valid: true}})
 			if err != nil {
-				logger.Log.Error("Error update status to failed for job: %s", zap.Error(err))
+				logger.Log.Error("Error update status to dead for job: %s", zap.Error(err))
 			}
 		}
 	}
 	return nil
 }
+
+// freeSlots is the number of jobs the queue may claim, each registered worker works one job at once
+func (q *Queue) freeSlots() int {
+	if !q.IsWorkerRegCompleted {
+		return 0
+	}
+	free := -q.CurrentSize()
+	for _, workers := range q.workers {
+		free += len(workers)
+	}
+	q.inflightMux.Lock()
+	free -= len(q.inflight)
+	q.inflightMux.Unlock()
+	if free > claimBatch {
+		free = claimBatch
+	}
+	return free
+}
+
+// working tracks the jobs being worked by the queue
+func (q *Queue) working(jobID int32, inflight bool) {
+	q.inflightMux.Lock()
+	defer q.inflightMux.Unlock()
+	if q.inflight == nil {
+		q.inflight = make(map[int32]struct{})
+	}
+	if inflight {
+		q.inflight[jobID] = struct{}{}
+	} else {
+		delete(q.inflight, jobID)
+	}
+}
+
+// claim takes the lease of a job read from db before working it, it fails when the job is claimed by another queue
+func (q *Queue) claim(ctx context.Context, jobC JobChan) bool {
+	claimed, err := q.repo.ClaimJob(ctx, dbgen.ClaimJobParams{Owner: q.owner, LeaseSeconds: q.leaseTime.Seconds(), JobID: jobC.jobData.JobID})
+	if err != nil {
+		logger.Log.Error("Unable to claim job, requeueing the job", zap.Int32("jobID", jobC.jobData.JobID), zap.Error(err))
+		q.PushJob(jobC.ctx, jobC.jobData, jobC.workerName)
+		return false
+	}
+	if claimed == 0 {
+		logger.Log.Info("Job is claimed by another queue", zap.Int32("jobID", jobC.jobData.JobID))
+		return false
+	}
+	return true
+}
+
+// keepLeases renews the leases of the jobs worked by the queue and claims the pending jobs,
+// the jobs of the crashed queues among them
+func (q *Queue) keepLeases(ctx context.Context) {
+	leases := time.NewTicker(q.leaseTime / 3)
+	defer leases.Stop()
+	claims := time.NewTicker(claimInterval)
+	defer claims.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-leases.C:
+			if err := q.renewLeases(ctx); err != nil {
+				logger.Log.Error("Unable to renew the leases of the queue", zap.Error(err))
+			}
+		case <-claims.C:
+			if err := q.ResumePendingJobs(ctx); err != nil {
+				logger.Log.Error("Unable to claim the pending jobs", zap.Error(err))
+			}
+		}
+	}
+}
+
+// renewLeases renews the leases of the jobs being worked, the jobs waiting in the queue keep the lease of
+// their claim and are claimed again before being worked
+func (q *Queue) renewLeases(ctx context.Context) error {
+	q.inflightMux.Lock()
+	ids := make([]int32, 0, len(q.inflight))
+	for id := range q.inflight {
+		ids = append(ids, id)
+	}
+	q.inflightMux.Unlock()
+	if len(ids) == 0 {
+		return nil
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	_, err := q.repo.RenewLeases(ctx, dbgen.RenewLeasesParams{LeaseSeconds: q.leaseTime.Seconds(), Owner: q.owner, JobIds: ids})
+	return err
+}
+
+// leaseOwner names the queue of a pod, the start time tells apart the queues of a restarted pod
+func leaseOwner(queueID string) string {
+	host, _ := os.Hostname()
+	return fmt.Sprintf("%s/%s/%d", host, queueID, time.Now().UnixNano())
+}
+
+// jobContext returns the context carrying the grpc metadata saved with a job
+func jobContext(ctx context.Context, metaData json.RawMessage) context.Context {
+	md := metadata.MD{}
+	if metaData != nil {
+		err := json.Unmarshal(metaData, &md)
+		if err != nil {
+			logger.Log.Error("Error unmarshling meta data %s", zap.Error(err))
+		}
+	}
+	ctx = metadata.NewOutgoingContext(ctx, md)
+	return metadata.NewIncomingContext(ctx, md)
+}
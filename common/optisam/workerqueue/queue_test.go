@@ -2,11 +2,15 @@ package workerqueue
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"sync"
 	"testing"
 	"time"
 
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/job"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/repository"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/repository/mock"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/repository/postgres/db"
@@ -14,6 +18,7 @@ import (
 	workermock "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/worker/mock"
 
 	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestQueue_RegisterWorker(t *testing.T) {
@@ -170,3 +175,196 @@ func TestQueue_RegisterWorker(t *testing.T) {
 		})
 	}
 }
+
+func TestQueue_nextJob(t *testing.T) {
+	q := &Queue{}
+	for i := range q.mq {
+		q.mq[i] = newMLQueue(10)
+	}
+	ctx := context.Background()
+	for _, p := range []job.Priority{job.PriorityLow, job.PriorityNormal, job.PriorityHigh, job.Priority(5), job.PriorityNormal} {
+		q.PushJob(ctx, job.Job{JobID: int32(p), Priority: p}, "test-worker")
+	}
+	var got []int32
+	for {
+		jobC, ok := q.nextJob()
+		if !ok {
+			break
+		}
+		got = append(got, jobC.jobID)
+	}
+	want := []int32{1, 5, 0, 0, -1}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("nextJob order: got %v, want %v", got, want)
+	}
+}
+
+func TestQueue_acquire(t *testing.T) {
+	q := &Queue{slots: map[string]chan struct{}{"capped": make(chan struct{}, 1)}}
+	release, ok := q.acquire("Capped")
+	if !ok {
+		t.Fatal("first slot: not acquired")
+	}
+	if _, ok := q.acquire("capped"); ok {
+		t.Error("slot acquired above concurrency")
+	}
+	release()
+	if _, ok := q.acquire("capped"); !ok {
+		t.Error("released slot: not acquired")
+	}
+	if _, ok := q.acquire("free"); !ok {
+		t.Error("worker without concurrency: not acquired")
+	}
+}
+
+func TestQueue_ResumePendingJobs(t *testing.T) {
+	ctx := context.Background()
+	owner := sql.NullString{String: "pod/test-queue/1", Valid: true}
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	w := workermock.NewMockWorker(mockCtrl)
+	newQueue := func(r *mock.MockWorkerqueue, inflight ...int32) *Queue {
+		q := &Queue{IsWorkerRegCompleted: true, repo: r, retries: 3, owner: owner, leaseTime: time.Minute, queueSize: 10,
+			workers: map[string][]worker.Worker{"test-worker": {w, w, w}}}
+		for i := range q.mq {
+			q.mq[i] = newMLQueue(q.queueSize)
+		}
+		for _, id := range inflight {
+			q.working(id, true)
+		}
+		return q
+	}
+
+	t.Run("claims up to the free workers", func(t *testing.T) {
+		r := mock.NewMockWorkerqueue(mockCtrl)
+		exhausted := db.Job{JobID: 3, Type: "test-worker", Status: db.JobStatusRETRY, RetryCount: sql.NullInt32{Int32: 3, Valid: true}}
+		gomock.InOrder(
+			r.EXPECT().ClaimJobs(ctx, db.ClaimJobsParams{Owner: owner, LeaseSeconds: 60, Batch: 2}).Return([]db.Job{{JobID: 2, Type: "test-worker", Status: db.JobStatusPENDING}, exhausted}, nil),
+			r.EXPECT().UpdateJobStatusCompleted(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, arg db.UpdateJobStatusCompletedParams) error {
+				if arg.JobID != 3 || arg.Status != db.JobStatusDEAD {
+					t.Errorf("exhausted job: got %d %s, want 3 DEAD", arg.JobID, arg.Status)
+				}
+				return nil
+			}),
+		)
+		q := newQueue(r, 1)
+		if err := q.ResumePendingJobs(ctx); err != nil {
+			t.Fatalf("ResumePendingJobs: got %v, want nil", err)
+		}
+		if got := q.CurrentSize(); got != 1 {
+			t.Errorf("resumed jobs: got %d, want 1", got)
+		}
+	})
+
+	t.Run("busy workers claim nothing", func(t *testing.T) {
+		r := mock.NewMockWorkerqueue(mockCtrl)
+		q := newQueue(r, 1, 2, 3)
+		if err := q.ResumePendingJobs(ctx); err != nil {
+			t.Fatalf("ResumePendingJobs: got %v, want nil", err)
+		}
+	})
+
+	t.Run("unregistered workers claim nothing", func(t *testing.T) {
+		r := mock.NewMockWorkerqueue(mockCtrl)
+		q := newQueue(r)
+		q.IsWorkerRegCompleted = false
+		if err := q.ResumePendingJobs(ctx); err != nil {
+			t.Fatalf("ResumePendingJobs: got %v, want nil", err)
+		}
+	})
+}
+
+func TestQueue_renewLeases(t *testing.T) {
+	ctx := context.Background()
+	owner := sql.NullString{String: "pod/test-queue/1", Valid: true}
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	r := mock.NewMockWorkerqueue(mockCtrl)
+	q := &Queue{repo: r, owner: owner, leaseTime: time.Minute}
+	if err := q.renewLeases(ctx); err != nil {
+		t.Fatalf("renewLeases without jobs: got %v, want nil", err)
+	}
+	q.working(7, true)
+	q.working(4, true)
+	q.working(5, true)
+	q.working(5, false)
+	r.EXPECT().RenewLeases(ctx, db.RenewLeasesParams{LeaseSeconds: 60, Owner: owner, JobIds: []int32{4, 7}}).Return(int64(2), nil)
+	if err := q.renewLeases(ctx); err != nil {
+		t.Fatalf("renewLeases: got %v, want nil", err)
+	}
+}
+
+func TestQueue_claim(t *testing.T) {
+	ctx := context.Background()
+	owner := sql.NullString{String: "pod/test-queue/1", Valid: true}
+	tests := []struct {
+		name      string
+		setup     func(r *mock.MockWorkerqueue)
+		want      bool
+		wantQueue int
+	}{
+		{
+			name: "SUCCESS - job is claimed",
+			setup: func(r *mock.MockWorkerqueue) {
+				r.EXPECT().ClaimJob(ctx, db.ClaimJobParams{Owner: owner, LeaseSeconds: 60, JobID: 7}).Return(int64(1), nil)
+			},
+			want: true,
+		},
+		{
+			name: "FAILURE - job is claimed by another queue",
+			setup: func(r *mock.MockWorkerqueue) {
+				r.EXPECT().ClaimJob(ctx, db.ClaimJobParams{Owner: owner, LeaseSeconds: 60, JobID: 7}).Return(int64(0), nil)
+			},
+		},
+		{
+			name: "FAILURE - db error, job is requeued",
+			setup: func(r *mock.MockWorkerqueue) {
+				r.EXPECT().ClaimJob(ctx, db.ClaimJobParams{Owner: owner, LeaseSeconds: 60, JobID: 7}).Return(int64(0), errors.New("db down"))
+			},
+			wantQueue: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			r := mock.NewMockWorkerqueue(mockCtrl)
+			tt.setup(r)
+			q := &Queue{repo: r, owner: owner, leaseTime: time.Minute}
+			for i := range q.mq {
+				q.mq[i] = newMLQueue(10)
+			}
+			if got := q.claim(ctx, JobChan{jobID: 7, workerName: "test-worker", jobData: job.Job{JobID: 7}, ctx: ctx}); got != tt.want {
+				t.Errorf("claim: got %v, want %v", got, tt.want)
+			}
+			if got := q.CurrentSize(); got != tt.wantQueue {
+				t.Errorf("queued jobs: got %d, want %d", got, tt.wantQueue)
+			}
+		})
+	}
+}
+
+func Test_createJob(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token", "grpc-trace-bin", "trace"))
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	r := mock.NewMockWorkerqueue(mockCtrl)
+	// the job is not leased, a queue claims it once it is committed
+	r.EXPECT().CreateJob(ctx, db.CreateJobParams{
+		Type:     "test-worker",
+		Status:   db.JobStatusPENDING,
+		Data:     json.RawMessage(`{"id":1}`),
+		MetaData: json.RawMessage(`{"authorization":["Bearer token"]}`),
+		Ppid:     sql.NullString{Valid: true},
+		Priority: int16(job.PriorityLow),
+	}).Return(int32(5), nil)
+	got, err := createJob(ctx, r, job.Job{
+		Type:     sql.NullString{String: "test-worker", Valid: true},
+		Status:   job.JobStatusPENDING,
+		Data:     json.RawMessage(`{"id":1}`),
+		Priority: job.PriorityLow,
+	})
+	if err != nil || got != 5 {
+		t.Errorf("createJob: got %d %v, want 5 nil", got, err)
+	}
+}
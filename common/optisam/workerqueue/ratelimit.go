@@ -0,0 +1,66 @@
+package workerqueue
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// tokenBucket allows Rate tokens per second up to Burst tokens at once
+type tokenBucket struct {
+	mux    sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(l RateLimit, now time.Time) *tokenBucket {
+	burst := float64(l.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{rate: l.Rate, burst: burst, tokens: burst, last: now}
+}
+
+// reserve takes a token and returns the time to wait before using it, tokens may go negative
+// so waiting callers are served in order
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	if now.After(b.last) {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel gives back a reserved token which is not used
+func (b *tokenBucket) cancel() {
+	b.mux.Lock()
+	b.tokens++
+	b.mux.Unlock()
+}
+
+func (b *tokenBucket) wait(ctx context.Context) error {
+	d := b.reserve(time.Now())
+	if d == 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	}
+}
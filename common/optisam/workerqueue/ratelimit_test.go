@@ -0,0 +1,45 @@
+package workerqueue
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTokenBucket_reserve(t *testing.T) {
+	now := time.Now()
+	b := newTokenBucket(RateLimit{Rate: 2, Burst: 2}, now)
+	for i := 0; i < 2; i++ {
+		if d := b.reserve(now); d != 0 {
+			t.Fatalf("burst token %d: got wait %v, want 0", i, d)
+		}
+	}
+	if d := b.reserve(now); d != 500*time.Millisecond {
+		t.Errorf("token above burst: got wait %v, want 500ms", d)
+	}
+	if d := b.reserve(now); d != time.Second {
+		t.Errorf("second token above burst: got wait %v, want 1s", d)
+	}
+	// tokens are refilled at the rate without going above the burst
+	if d := b.reserve(now.Add(10 * time.Second)); d != 0 {
+		t.Errorf("refilled token: got wait %v, want 0", d)
+	}
+	if b.tokens != 1 {
+		t.Errorf("refilled tokens: got %v, want 1", b.tokens)
+	}
+}
+
+func TestQueue_Throttle(t *testing.T) {
+	q := &Queue{limits: map[string]*tokenBucket{"product": newTokenBucket(RateLimit{Rate: 1, Burst: 1}, time.Now())}}
+	if err := q.Throttle(context.Background(), "Product"); err != nil {
+		t.Fatalf("first call: got %v, want nil", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := q.Throttle(ctx, "product"); err != context.DeadlineExceeded {
+		t.Errorf("throttled call: got %v, want %v", err, context.DeadlineExceeded)
+	}
+	if err := q.Throttle(ctx, "account"); err != nil {
+		t.Errorf("target without rate limit: got %v, want nil", err)
+	}
+}
@@ -6,6 +6,7 @@ package mock
 
 import (
 	context "context"
+	sql "database/sql"
 	gomock "github.com/golang/mock/gomock"
 	db "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/repository/postgres/db"
 	reflect "reflect"
@@ -34,6 +35,36 @@ func (m *MockWorkerqueue) EXPECT() *MockWorkerqueueMockRecorder {
 	return m.recorder
 }
 
+// ClaimJob mocks base method
+func (m *MockWorkerqueue) ClaimJob(arg0 context.Context, arg1 db.ClaimJobParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimJob", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimJob indicates an expected call of ClaimJob
+func (mr *MockWorkerqueueMockRecorder) ClaimJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimJob", reflect.TypeOf((*MockWorkerqueue)(nil).ClaimJob), arg0, arg1)
+}
+
+// ClaimJobs mocks base method
+func (m *MockWorkerqueue) ClaimJobs(arg0 context.Context, arg1 db.ClaimJobsParams) ([]db.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimJobs", arg0, arg1)
+	ret0, _ := ret[0].([]db.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimJobs indicates an expected call of ClaimJobs
+func (mr *MockWorkerqueueMockRecorder) ClaimJobs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimJobs", reflect.TypeOf((*MockWorkerqueue)(nil).ClaimJobs), arg0, arg1)
+}
+
 // CreateJob mocks base method
 func (m *MockWorkerqueue) CreateJob(arg0 context.Context, arg1 db.CreateJobParams) (int32, error) {
 	m.ctrl.T.Helper()
@@ -49,6 +80,36 @@ func (mr *MockWorkerqueueMockRecorder) CreateJob(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJob", reflect.TypeOf((*MockWorkerqueue)(nil).CreateJob), arg0, arg1)
 }
 
+// DiscardJob mocks base method
+func (m *MockWorkerqueue) DiscardJob(arg0 context.Context, arg1 int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscardJob", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscardJob indicates an expected call of DiscardJob
+func (mr *MockWorkerqueueMockRecorder) DiscardJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscardJob", reflect.TypeOf((*MockWorkerqueue)(nil).DiscardJob), arg0, arg1)
+}
+
+// DiscardJobs mocks base method
+func (m *MockWorkerqueue) DiscardJobs(arg0 context.Context, arg1 db.DiscardJobsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscardJobs", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscardJobs indicates an expected call of DiscardJobs
+func (mr *MockWorkerqueueMockRecorder) DiscardJobs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscardJobs", reflect.TypeOf((*MockWorkerqueue)(nil).DiscardJobs), arg0, arg1)
+}
+
 // GetJob mocks base method
 func (m *MockWorkerqueue) GetJob(arg0 context.Context, arg1 int32) (db.Job, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +155,81 @@ func (mr *MockWorkerqueueMockRecorder) GetJobsForRetry(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobsForRetry", reflect.TypeOf((*MockWorkerqueue)(nil).GetJobsForRetry), arg0)
 }
 
+// ListJobIDs mocks base method
+func (m *MockWorkerqueue) ListJobIDs(arg0 context.Context, arg1 db.ListJobIDsParams) ([]int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobIDs", arg0, arg1)
+	ret0, _ := ret[0].([]int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobIDs indicates an expected call of ListJobIDs
+func (mr *MockWorkerqueueMockRecorder) ListJobIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobIDs", reflect.TypeOf((*MockWorkerqueue)(nil).ListJobIDs), arg0, arg1)
+}
+
+// ListJobs mocks base method
+func (m *MockWorkerqueue) ListJobs(arg0 context.Context, arg1 db.ListJobsParams) ([]db.ListJobsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobs", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListJobsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobs indicates an expected call of ListJobs
+func (mr *MockWorkerqueueMockRecorder) ListJobs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockWorkerqueue)(nil).ListJobs), arg0, arg1)
+}
+
+// ReleaseLeases mocks base method
+func (m *MockWorkerqueue) ReleaseLeases(arg0 context.Context, arg1 sql.NullString) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseLeases", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseLeases indicates an expected call of ReleaseLeases
+func (mr *MockWorkerqueueMockRecorder) ReleaseLeases(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseLeases", reflect.TypeOf((*MockWorkerqueue)(nil).ReleaseLeases), arg0, arg1)
+}
+
+// RenewLeases mocks base method
+func (m *MockWorkerqueue) RenewLeases(arg0 context.Context, arg1 db.RenewLeasesParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewLeases", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenewLeases indicates an expected call of RenewLeases
+func (mr *MockWorkerqueueMockRecorder) RenewLeases(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewLeases", reflect.TypeOf((*MockWorkerqueue)(nil).RenewLeases), arg0, arg1)
+}
+
+// ReplayJob mocks base method
+func (m *MockWorkerqueue) ReplayJob(arg0 context.Context, arg1 int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayJob", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayJob indicates an expected call of ReplayJob
+func (mr *MockWorkerqueueMockRecorder) ReplayJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayJob", reflect.TypeOf((*MockWorkerqueue)(nil).ReplayJob), arg0, arg1)
+}
+
 // UpdateJobStatusCompleted mocks base method
 func (m *MockWorkerqueue) UpdateJobStatusCompleted(arg0 context.Context, arg1 db.UpdateJobStatusCompletedParams) error {
 	m.ctrl.T.Helper()
@@ -20,6 +20,7 @@ const (
 	JobStatusFAILED    JobStatus = "FAILED"
 	JobStatusRETRY     JobStatus = "RETRY"
 	JobStatusRUNNING   JobStatus = "RUNNING"
+	JobStatusDEAD      JobStatus = "DEAD"
 )
 
 func (e *JobStatus) Scan(src interface{}) error {
@@ -58,15 +59,18 @@ func (ns NullJobStatus) Value() (driver.Value, error) {
 }
 
 type Job struct {
-	JobID      int32           `json:"job_id"`
-	Type       string          `json:"type"`
-	Status     JobStatus       `json:"status"`
-	Data       json.RawMessage `json:"data"`
-	Comments   sql.NullString  `json:"comments"`
-	StartTime  sql.NullTime    `json:"start_time"`
-	EndTime    sql.NullTime    `json:"end_time"`
-	CreatedAt  time.Time       `json:"created_at"`
-	RetryCount sql.NullInt32   `json:"retry_count"`
-	MetaData   json.RawMessage `json:"meta_data"`
-	Ppid       sql.NullString  `json:"ppid"`
+	JobID          int32           `json:"job_id"`
+	Type           string          `json:"type"`
+	Status         JobStatus       `json:"status"`
+	Data           json.RawMessage `json:"data"`
+	Comments       sql.NullString  `json:"comments"`
+	StartTime      sql.NullTime    `json:"start_time"`
+	EndTime        sql.NullTime    `json:"end_time"`
+	CreatedAt      time.Time       `json:"created_at"`
+	RetryCount     sql.NullInt32   `json:"retry_count"`
+	MetaData       json.RawMessage `json:"meta_data"`
+	Ppid           sql.NullString  `json:"ppid"`
+	Priority       int16           `json:"priority"`
+	LeaseOwner     sql.NullString  `json:"lease_owner"`
+	LeaseExpiresAt sql.NullTime    `json:"lease_expires_at"`
 }
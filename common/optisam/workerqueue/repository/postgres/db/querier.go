@@ -6,13 +6,23 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
 type Querier interface {
+	ClaimJob(ctx context.Context, arg ClaimJobParams) (int64, error)
+	ClaimJobs(ctx context.Context, arg ClaimJobsParams) ([]Job, error)
 	CreateJob(ctx context.Context, arg CreateJobParams) (int32, error)
+	DiscardJob(ctx context.Context, jobID int32) (int64, error)
+	DiscardJobs(ctx context.Context, arg DiscardJobsParams) (int64, error)
 	GetJob(ctx context.Context, jobID int32) (Job, error)
 	GetJobs(ctx context.Context) ([]Job, error)
 	GetJobsForRetry(ctx context.Context) ([]Job, error)
+	ListJobIDs(ctx context.Context, arg ListJobIDsParams) ([]int32, error)
+	ListJobs(ctx context.Context, arg ListJobsParams) ([]ListJobsRow, error)
+	ReleaseLeases(ctx context.Context, leaseOwner sql.NullString) (int64, error)
+	RenewLeases(ctx context.Context, arg RenewLeasesParams) (int64, error)
+	ReplayJob(ctx context.Context, jobID int32) (int64, error)
 	UpdateJobStatusCompleted(ctx context.Context, arg UpdateJobStatusCompletedParams) error
 	UpdateJobStatusFailed(ctx context.Context, arg UpdateJobStatusFailedParams) error
 	UpdateJobStatusRetry(ctx context.Context, arg UpdateJobStatusRetryParams) error
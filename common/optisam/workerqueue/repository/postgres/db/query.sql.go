@@ -9,21 +9,106 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const claimJob = `-- name: ClaimJob :execrows
+UPDATE jobs SET lease_owner = $1, lease_expires_at = NOW() + make_interval(secs => $2)
+WHERE job_id = $3
+  AND status NOT IN ('FAILED', 'COMPLETED', 'DEAD')
+  AND (lease_owner = $1 OR lease_owner IS NULL OR lease_expires_at < NOW())
+`
+
+type ClaimJobParams struct {
+	Owner        sql.NullString `json:"owner"`
+	LeaseSeconds float64        `json:"lease_seconds"`
+	JobID        int32          `json:"job_id"`
+}
+
+func (q *Queries) ClaimJob(ctx context.Context, arg ClaimJobParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, claimJob, arg.Owner, arg.LeaseSeconds, arg.JobID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const claimJobs = `-- name: ClaimJobs :many
+UPDATE jobs SET lease_owner = $1, lease_expires_at = NOW() + make_interval(secs => $2)
+WHERE job_id IN (
+  SELECT job_id FROM jobs
+  WHERE status NOT IN ('FAILED', 'COMPLETED', 'DEAD')
+    AND (lease_owner IS NULL OR lease_expires_at < NOW())
+    AND lease_owner IS DISTINCT FROM $1
+  ORDER BY priority DESC, job_id
+  LIMIT $3
+  FOR UPDATE SKIP LOCKED
 )
+RETURNING job_id, type, status, data, comments, start_time, end_time, created_at, retry_count, meta_data, ppid, priority, lease_owner, lease_expires_at
+`
+
+type ClaimJobsParams struct {
+	Owner        sql.NullString `json:"owner"`
+	LeaseSeconds float64        `json:"lease_seconds"`
+	Batch        int32          `json:"batch"`
+}
+
+func (q *Queries) ClaimJobs(ctx context.Context, arg ClaimJobsParams) ([]Job, error) {
+	rows, err := q.db.QueryContext(ctx, claimJobs, arg.Owner, arg.LeaseSeconds, arg.Batch)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Job
+	for rows.Next() {
+		var i Job
+		if err := rows.Scan(
+			&i.JobID,
+			&i.Type,
+			&i.Status,
+			&i.Data,
+			&i.Comments,
+			&i.StartTime,
+			&i.EndTime,
+			&i.CreatedAt,
+			&i.RetryCount,
+			&i.MetaData,
+			&i.Ppid,
+			&i.Priority,
+			&i.LeaseOwner,
+			&i.LeaseExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
 
 const createJob = `-- name: CreateJob :one
-INSERT INTO jobs (type,status,data,comments,start_time,end_time,meta_data,ppid) VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING job_id
+INSERT INTO jobs (type,status,data,comments,start_time,end_time,meta_data,ppid,priority,lease_owner,lease_expires_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,NOW() + make_interval(secs => $11)) RETURNING job_id
 `
 
 type CreateJobParams struct {
-	Type      string          `json:"type"`
-	Status    JobStatus       `json:"status"`
-	Data      json.RawMessage `json:"data"`
-	Comments  sql.NullString  `json:"comments"`
-	StartTime sql.NullTime    `json:"start_time"`
-	EndTime   sql.NullTime    `json:"end_time"`
-	MetaData  json.RawMessage `json:"meta_data"`
-	Ppid      sql.NullString  `json:"ppid"`
+	Type       string          `json:"type"`
+	Status     JobStatus       `json:"status"`
+	Data       json.RawMessage `json:"data"`
+	Comments   sql.NullString  `json:"comments"`
+	StartTime  sql.NullTime    `json:"start_time"`
+	EndTime    sql.NullTime    `json:"end_time"`
+	MetaData   json.RawMessage `json:"meta_data"`
+	Ppid       sql.NullString  `json:"ppid"`
+	Priority   int16           `json:"priority"`
+	LeaseOwner sql.NullString  `json:"lease_owner"`
+	Secs       float64         `json:"secs"`
 }
 
 func (q *Queries) CreateJob(ctx context.Context, arg CreateJobParams) (int32, error) {
@@ -36,14 +121,46 @@ func (q *Queries) CreateJob(ctx context.Context, arg CreateJobParams) (int32, er
 		arg.EndTime,
 		arg.MetaData,
 		arg.Ppid,
+		arg.Priority,
+		arg.LeaseOwner,
+		arg.Secs,
 	)
 	var job_id int32
 	err := row.Scan(&job_id)
 	return job_id, err
 }
 
+const discardJob = `-- name: DiscardJob :execrows
+DELETE FROM jobs WHERE job_id = $1 AND status IN ('DEAD', 'FAILED')
+`
+
+func (q *Queries) DiscardJob(ctx context.Context, jobID int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, discardJob, jobID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const discardJobs = `-- name: DiscardJobs :execrows
+DELETE FROM jobs WHERE type = $1 AND status = $2 AND status IN ('DEAD', 'FAILED')
+`
+
+type DiscardJobsParams struct {
+	Type   string    `json:"type"`
+	Status JobStatus `json:"status"`
+}
+
+func (q *Queries) DiscardJobs(ctx context.Context, arg DiscardJobsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, discardJobs, arg.Type, arg.Status)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getJob = `-- name: GetJob :one
-SELECT job_id, type, status, data, comments, start_time, end_time, created_at, retry_count, meta_data, ppid FROM jobs
+SELECT job_id, type, status, data, comments, start_time, end_time, created_at, retry_count, meta_data, ppid, priority, lease_owner, lease_expires_at FROM jobs
 WHERE job_id = $1
 `
 
@@ -62,12 +179,15 @@ func (q *Queries) GetJob(ctx context.Context, jobID int32) (Job, error) {
 		&i.RetryCount,
 		&i.MetaData,
 		&i.Ppid,
+		&i.Priority,
+		&i.LeaseOwner,
+		&i.LeaseExpiresAt,
 	)
 	return i, err
 }
 
 const getJobs = `-- name: GetJobs :many
-SELECT job_id, type, status, data, comments, start_time, end_time, created_at, retry_count, meta_data, ppid FROM jobs
+SELECT job_id, type, status, data, comments, start_time, end_time, created_at, retry_count, meta_data, ppid, priority, lease_owner, lease_expires_at FROM jobs
 `
 
 func (q *Queries) GetJobs(ctx context.Context) ([]Job, error) {
@@ -91,6 +211,9 @@ func (q *Queries) GetJobs(ctx context.Context) ([]Job, error) {
 			&i.RetryCount,
 			&i.MetaData,
 			&i.Ppid,
+			&i.Priority,
+			&i.LeaseOwner,
+			&i.LeaseExpiresAt,
 		); err != nil {
 			return nil, err
 		}
@@ -106,7 +229,7 @@ func (q *Queries) GetJobs(ctx context.Context) ([]Job, error) {
 }
 
 const getJobsForRetry = `-- name: GetJobsForRetry :many
-SELECT job_id, type, status, data, comments, start_time, end_time, created_at, retry_count, meta_data, ppid FROM Jobs WHERE status  not in ('FAILED' ,'COMPLETED')
+SELECT job_id, type, status, data, comments, start_time, end_time, created_at, retry_count, meta_data, ppid, priority, lease_owner, lease_expires_at FROM Jobs WHERE status  not in ('FAILED' ,'COMPLETED', 'DEAD')
 `
 
 func (q *Queries) GetJobsForRetry(ctx context.Context) ([]Job, error) {
@@ -130,6 +253,115 @@ func (q *Queries) GetJobsForRetry(ctx context.Context) ([]Job, error) {
 			&i.RetryCount,
 			&i.MetaData,
 			&i.Ppid,
+			&i.Priority,
+			&i.LeaseOwner,
+			&i.LeaseExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listJobIDs = `-- name: ListJobIDs :many
+SELECT job_id FROM jobs WHERE type = $1 AND status = $2 ORDER BY job_id
+`
+
+type ListJobIDsParams struct {
+	Type   string    `json:"type"`
+	Status JobStatus `json:"status"`
+}
+
+func (q *Queries) ListJobIDs(ctx context.Context, arg ListJobIDsParams) ([]int32, error) {
+	rows, err := q.db.QueryContext(ctx, listJobIDs, arg.Type, arg.Status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var job_id int32
+		if err := rows.Scan(&job_id); err != nil {
+			return nil, err
+		}
+		items = append(items, job_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listJobs = `-- name: ListJobs :many
+SELECT count(*) OVER() AS total_records, job_id, type, status, comments, start_time, end_time, created_at, retry_count, ppid, priority FROM jobs
+WHERE
+  (CASE WHEN $1::bool THEN type = $2 ELSE TRUE END)
+  AND (CASE WHEN $3::bool THEN status = $4 ELSE TRUE END)
+ORDER BY job_id DESC
+LIMIT $5 OFFSET $6
+`
+
+type ListJobsParams struct {
+	IsType   bool      `json:"is_type"`
+	Type     string    `json:"type"`
+	IsStatus bool      `json:"is_status"`
+	Status   JobStatus `json:"status"`
+	PageSize int32     `json:"page_size"`
+	PageNum  int32     `json:"page_num"`
+}
+
+type ListJobsRow struct {
+	TotalRecords int64          `json:"total_records"`
+	JobID        int32          `json:"job_id"`
+	Type         string         `json:"type"`
+	Status       JobStatus      `json:"status"`
+	Comments     sql.NullString `json:"comments"`
+	StartTime    sql.NullTime   `json:"start_time"`
+	EndTime      sql.NullTime   `json:"end_time"`
+	CreatedAt    time.Time      `json:"created_at"`
+	RetryCount   sql.NullInt32  `json:"retry_count"`
+	Ppid         sql.NullString `json:"ppid"`
+	Priority     int16          `json:"priority"`
+}
+
+func (q *Queries) ListJobs(ctx context.Context, arg ListJobsParams) ([]ListJobsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listJobs,
+		arg.IsType,
+		arg.Type,
+		arg.IsStatus,
+		arg.Status,
+		arg.PageSize,
+		arg.PageNum,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListJobsRow
+	for rows.Next() {
+		var i ListJobsRow
+		if err := rows.Scan(
+			&i.TotalRecords,
+			&i.JobID,
+			&i.Type,
+			&i.Status,
+			&i.Comments,
+			&i.StartTime,
+			&i.EndTime,
+			&i.CreatedAt,
+			&i.RetryCount,
+			&i.Ppid,
+			&i.Priority,
 		); err != nil {
 			return nil, err
 		}
@@ -144,6 +376,50 @@ func (q *Queries) GetJobsForRetry(ctx context.Context) ([]Job, error) {
 	return items, nil
 }
 
+const releaseLeases = `-- name: ReleaseLeases :execrows
+UPDATE jobs SET lease_owner = NULL, lease_expires_at = NULL
+WHERE lease_owner = $1 AND status NOT IN ('FAILED', 'COMPLETED', 'DEAD')
+`
+
+func (q *Queries) ReleaseLeases(ctx context.Context, leaseOwner sql.NullString) (int64, error) {
+	result, err := q.db.ExecContext(ctx, releaseLeases, leaseOwner)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const renewLeases = `-- name: RenewLeases :execrows
+UPDATE jobs SET lease_expires_at = NOW() + make_interval(secs => $1)
+WHERE lease_owner = $2 AND job_id = ANY($3::INTEGER[]) AND status NOT IN ('FAILED', 'COMPLETED', 'DEAD')
+`
+
+type RenewLeasesParams struct {
+	LeaseSeconds float64        `json:"lease_seconds"`
+	Owner        sql.NullString `json:"owner"`
+	JobIds       []int32        `json:"job_ids"`
+}
+
+func (q *Queries) RenewLeases(ctx context.Context, arg RenewLeasesParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, renewLeases, arg.LeaseSeconds, arg.Owner, pq.Array(arg.JobIds))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const replayJob = `-- name: ReplayJob :execrows
+UPDATE jobs SET status = 'PENDING', retry_count = 0, comments = NULL, start_time = NULL, end_time = NULL, lease_owner = NULL, lease_expires_at = NULL WHERE job_id = $1 AND status IN ('DEAD', 'FAILED')
+`
+
+func (q *Queries) ReplayJob(ctx context.Context, jobID int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, replayJob, jobID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateJobStatusCompleted = `-- name: UpdateJobStatusCompleted :exec
 UPDATE jobs SET status = $2,end_time = $3 WHERE job_id = $1
 `
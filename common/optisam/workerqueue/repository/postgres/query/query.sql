@@ -6,10 +6,10 @@ WHERE job_id = $1;
 SELECT * FROM jobs ;
 
 -- name: GetJobsForRetry :many
-SELECT * FROM Jobs WHERE status  not in ('FAILED' ,'COMPLETED');
+SELECT * FROM Jobs WHERE status  not in ('FAILED' ,'COMPLETED', 'DEAD');
 
 -- name: CreateJob :one
-INSERT INTO jobs (type,status,data,comments,start_time,end_time,meta_data,ppid) VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING job_id;
+INSERT INTO jobs (type,status,data,comments,start_time,end_time,meta_data,ppid,priority,lease_owner,lease_expires_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,NOW() + make_interval(secs => $11)) RETURNING job_id;
 
 
 -- name: UpdateJobStatusRunning :exec
@@ -22,4 +22,51 @@ UPDATE jobs SET status = $2,end_time = $3 WHERE job_id = $1;
 UPDATE jobs SET status = $2,retry_count = retry_count + 1 WHERE job_id = $1;
 
 -- name: UpdateJobStatusFailed :exec
-UPDATE jobs SET status = $2, end_time = $3, comments = $4 , retry_count = $5 where job_id = $1;
\ No newline at end of file
+UPDATE jobs SET status = $2, end_time = $3, comments = $4 , retry_count = $5 where job_id = $1;
+
+-- name: ListJobs :many
+SELECT count(*) OVER() AS total_records, job_id, type, status, comments, start_time, end_time, created_at, retry_count, ppid, priority FROM jobs
+WHERE
+  (CASE WHEN @is_type::bool THEN type = @type ELSE TRUE END)
+  AND (CASE WHEN @is_status::bool THEN status = @status ELSE TRUE END)
+ORDER BY job_id DESC
+LIMIT @page_size OFFSET @page_num;
+
+-- name: ListJobIDs :many
+SELECT job_id FROM jobs WHERE type = $1 AND status = $2 ORDER BY job_id;
+
+-- name: ReplayJob :execrows
+UPDATE jobs SET status = 'PENDING', retry_count = 0, comments = NULL, start_time = NULL, end_time = NULL, lease_owner = NULL, lease_expires_at = NULL WHERE job_id = $1 AND status IN ('DEAD', 'FAILED');
+
+-- name: DiscardJob :execrows
+DELETE FROM jobs WHERE job_id = $1 AND status IN ('DEAD', 'FAILED');
+
+-- name: DiscardJobs :execrows
+DELETE FROM jobs WHERE type = $1 AND status = $2 AND status IN ('DEAD', 'FAILED');
+
+-- name: ClaimJobs :many
+UPDATE jobs SET lease_owner = @owner, lease_expires_at = NOW() + make_interval(secs => @lease_seconds)
+WHERE job_id IN (
+  SELECT job_id FROM jobs
+  WHERE status NOT IN ('FAILED', 'COMPLETED', 'DEAD')
+    AND (lease_owner IS NULL OR lease_expires_at < NOW())
+    AND lease_owner IS DISTINCT FROM @owner
+  ORDER BY priority DESC, job_id
+  LIMIT @batch
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: ClaimJob :execrows
+UPDATE jobs SET lease_owner = @owner, lease_expires_at = NOW() + make_interval(secs => @lease_seconds)
+WHERE job_id = @job_id
+  AND status NOT IN ('FAILED', 'COMPLETED', 'DEAD')
+  AND (lease_owner = @owner OR lease_owner IS NULL OR lease_expires_at < NOW());
+
+-- name: RenewLeases :execrows
+UPDATE jobs SET lease_expires_at = NOW() + make_interval(secs => @lease_seconds)
+WHERE lease_owner = @owner AND job_id = ANY(@job_ids::INTEGER[]) AND status NOT IN ('FAILED', 'COMPLETED', 'DEAD');
+
+-- name: ReleaseLeases :execrows
+UPDATE jobs SET lease_owner = NULL, lease_expires_at = NULL
+WHERE lease_owner = $1 AND status NOT IN ('FAILED', 'COMPLETED', 'DEAD');
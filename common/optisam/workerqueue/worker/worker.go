@@ -2,6 +2,7 @@ package worker
 
 import (
 	"context"
+	"errors"
 
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/job"
 )
@@ -16,3 +17,12 @@ type Worker interface {
 	// it is primarily used for logging purposes
 	ID() string
 }
+
+// ErrNotReplayable is returned by Replay for the jobs which hold nothing the worker could do again
+var ErrNotReplayable = errors.New("job cannot be replayed")
+
+// Replayer is implemented by the workers which have to take back their own bookkeeping of a dead
+// or failed job before it is replayed, the job is not replayed when Replay returns an error.
+type Replayer interface {
+	Replay(context.Context, *job.Job) error
+}
@@ -2,9 +2,16 @@ package rbac
 
 default allow = false
 
-# Allow admins to do anything.
+# Allow admins to do anything but administering the worker queue.
 allow {
 	roles["Admin"][input.role]
+	not startswith(input.api, "/optisam.workerqueue.v1.WorkerQueueAdmin/")
+}
+
+# Only super admins administer the worker queue, its jobs belong to every scope.
+allow {
+	startswith(input.api, "/optisam.workerqueue.v1.WorkerQueueAdmin/")
+	input.role = "SuperAdmin"
 }
 
 # Normal Users
@@ -1159,6 +1159,10 @@ func (m *DataAnalysisResponse) Validate() error {
 
 	// no validation rules for Description
 
+	// no validation rules for RuleErrors
+
+	// no validation rules for RuleWarnings
+
 	return nil
 }
 
@@ -1741,6 +1745,23 @@ func (m *DashboardQualityOverviewResponse) Validate() error {
 		return nil
 	}
 
+	for idx, item := range m.GetRuleViolations() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return DashboardQualityOverviewResponseValidationError{
+					field:  fmt.Sprintf("RuleViolations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -1801,6 +1822,73 @@ var _ interface {
 	ErrorName() string
 } = DashboardQualityOverviewResponseValidationError{}
 
+// Validate checks the field values on RuleViolations with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *RuleViolations) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Entity
+
+	return nil
+}
+
+// RuleViolationsValidationError is the validation error returned by
+// RuleViolations.Validate if the designated constraints aren't met.
+type RuleViolationsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RuleViolationsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RuleViolationsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RuleViolationsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RuleViolationsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RuleViolationsValidationError) ErrorName() string { return "RuleViolationsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RuleViolationsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRuleViolations.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RuleViolationsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RuleViolationsValidationError{}
+
 // Validate checks the field values on NotifyUploadRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -1833,6 +1921,12 @@ func (m *NotifyUploadRequest) Validate() error {
 
 	// no validation rules for AnalysisId
 
+	// no validation rules for MappingProfile
+
+	// no validation rules for Transactional
+
+	// no validation rules for SyncMode
+
 	return nil
 }
 
@@ -1969,48 +2063,36 @@ var _ interface {
 	ErrorName() string
 } = NotifyUploadResponseValidationError{}
 
-// Validate checks the field values on ListFailedRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *ListFailedRequest) Validate() error {
+// Validate checks the field values on PreviewUploadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *PreviewUploadRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if utf8.RuneCountInString(m.GetScope()) < 1 {
-		return ListFailedRequestValidationError{
+	if !_PreviewUploadRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return PreviewUploadRequestValidationError{
 			field:  "Scope",
-			reason: "value length must be at least 1 runes",
-		}
-	}
-
-	if m.GetUploadId() <= 0 {
-		return ListFailedRequestValidationError{
-			field:  "UploadId",
-			reason: "value must be greater than 0",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
 		}
 	}
 
-	if m.GetPageNum() < 1 {
-		return ListFailedRequestValidationError{
-			field:  "PageNum",
-			reason: "value must be greater than or equal to 1",
+	if len(m.GetFiles()) < 1 {
+		return PreviewUploadRequestValidationError{
+			field:  "Files",
+			reason: "value must contain at least 1 item(s)",
 		}
 	}
 
-	if m.GetPageSize() < 1 {
-		return ListFailedRequestValidationError{
-			field:  "PageSize",
-			reason: "value must be greater than or equal to 1",
-		}
-	}
+	// no validation rules for MappingProfile
 
 	return nil
 }
 
-// ListFailedRequestValidationError is the validation error returned by
-// ListFailedRequest.Validate if the designated constraints aren't met.
-type ListFailedRequestValidationError struct {
+// PreviewUploadRequestValidationError is the validation error returned by
+// PreviewUploadRequest.Validate if the designated constraints aren't met.
+type PreviewUploadRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2018,24 +2100,24 @@ type ListFailedRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListFailedRequestValidationError) Field() string { return e.field }
+func (e PreviewUploadRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListFailedRequestValidationError) Reason() string { return e.reason }
+func (e PreviewUploadRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListFailedRequestValidationError) Cause() error { return e.cause }
+func (e PreviewUploadRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListFailedRequestValidationError) Key() bool { return e.key }
+func (e PreviewUploadRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListFailedRequestValidationError) ErrorName() string {
-	return "ListFailedRequestValidationError"
+func (e PreviewUploadRequestValidationError) ErrorName() string {
+	return "PreviewUploadRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListFailedRequestValidationError) Error() string {
+func (e PreviewUploadRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2047,14 +2129,14 @@ func (e ListFailedRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListFailedRequest.%s: %s%s",
+		"invalid %sPreviewUploadRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListFailedRequestValidationError{}
+var _ error = PreviewUploadRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2062,25 +2144,27 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListFailedRequestValidationError{}
+} = PreviewUploadRequestValidationError{}
 
-// Validate checks the field values on ListFailedResponse with the rules
+var _PreviewUploadRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on PreviewUploadResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ListFailedResponse) Validate() error {
+func (m *PreviewUploadResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetFailedRecords() {
+	for idx, item := range m.GetFiles() {
 		_, _ = idx, item
 
 		if v, ok := interface{}(item).(interface {
 			Validate() error
 		}); ok {
 			if err := v.Validate(); err != nil {
-				return ListFailedResponseValidationError{
-					field:  fmt.Sprintf("FailedRecords[%v]", idx),
+				return PreviewUploadResponseValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -2089,14 +2173,46 @@ func (m *ListFailedResponse) Validate() error {
 
 	}
 
-	// no validation rules for TotalRecords
+	for idx, item := range m.GetImpacts() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return PreviewUploadResponseValidationError{
+					field:  fmt.Sprintf("Impacts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDanglingReferences() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return PreviewUploadResponseValidationError{
+					field:  fmt.Sprintf("DanglingReferences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	return nil
 }
 
-// ListFailedResponseValidationError is the validation error returned by
-// ListFailedResponse.Validate if the designated constraints aren't met.
-type ListFailedResponseValidationError struct {
+// PreviewUploadResponseValidationError is the validation error returned by
+// PreviewUploadResponse.Validate if the designated constraints aren't met.
+type PreviewUploadResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2104,24 +2220,24 @@ type ListFailedResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListFailedResponseValidationError) Field() string { return e.field }
+func (e PreviewUploadResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListFailedResponseValidationError) Reason() string { return e.reason }
+func (e PreviewUploadResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListFailedResponseValidationError) Cause() error { return e.cause }
+func (e PreviewUploadResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListFailedResponseValidationError) Key() bool { return e.key }
+func (e PreviewUploadResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListFailedResponseValidationError) ErrorName() string {
-	return "ListFailedResponseValidationError"
+func (e PreviewUploadResponseValidationError) ErrorName() string {
+	return "PreviewUploadResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListFailedResponseValidationError) Error() string {
+func (e PreviewUploadResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2133,14 +2249,14 @@ func (e ListFailedResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListFailedResponse.%s: %s%s",
+		"invalid %sPreviewUploadResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListFailedResponseValidationError{}
+var _ error = PreviewUploadResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2148,26 +2264,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListFailedResponseValidationError{}
+} = PreviewUploadResponseValidationError{}
 
-// Validate checks the field values on FailedRecord with the rules defined in
+// Validate checks the field values on FilePreview with the rules defined in
 // the proto definition for this message. If any rules are violated, an error
 // is returned.
-func (m *FailedRecord) Validate() error {
+func (m *FilePreview) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Data
+	// no validation rules for FileName
 
-	// no validation rules for Reason
+	// no validation rules for FileType
+
+	// no validation rules for TotalRecords
+
+	// no validation rules for InvalidRecords
+
+	for idx, item := range m.GetDuplicates() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return FilePreviewValidationError{
+					field:  fmt.Sprintf("Duplicates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for FailureReason
 
 	return nil
 }
 
-// FailedRecordValidationError is the validation error returned by
-// FailedRecord.Validate if the designated constraints aren't met.
-type FailedRecordValidationError struct {
+// FilePreviewValidationError is the validation error returned by
+// FilePreview.Validate if the designated constraints aren't met.
+type FilePreviewValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2175,22 +2314,22 @@ type FailedRecordValidationError struct {
 }
 
 // Field function returns field value.
-func (e FailedRecordValidationError) Field() string { return e.field }
+func (e FilePreviewValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FailedRecordValidationError) Reason() string { return e.reason }
+func (e FilePreviewValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FailedRecordValidationError) Cause() error { return e.cause }
+func (e FilePreviewValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FailedRecordValidationError) Key() bool { return e.key }
+func (e FilePreviewValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FailedRecordValidationError) ErrorName() string { return "FailedRecordValidationError" }
+func (e FilePreviewValidationError) ErrorName() string { return "FilePreviewValidationError" }
 
 // Error satisfies the builtin error interface
-func (e FailedRecordValidationError) Error() string {
+func (e FilePreviewValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2202,14 +2341,14 @@ func (e FailedRecordValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFailedRecord.%s: %s%s",
+		"invalid %sFilePreview.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FailedRecordValidationError{}
+var _ error = FilePreviewValidationError{}
 
 var _ interface {
 	Field() string
@@ -2217,64 +2356,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FailedRecordValidationError{}
+} = FilePreviewValidationError{}
 
-// Validate checks the field values on ListUploadRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *ListUploadRequest) Validate() error {
+// Validate checks the field values on EntityImpact with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *EntityImpact) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if val := m.GetPageNum(); val < 1 || val >= 1000 {
-		return ListUploadRequestValidationError{
-			field:  "PageNum",
-			reason: "value must be inside range [1, 1000)",
-		}
-	}
-
-	if val := m.GetPageSize(); val < 10 || val > 200 {
-		return ListUploadRequestValidationError{
-			field:  "PageSize",
-			reason: "value must be inside range [10, 200]",
-		}
-	}
+	// no validation rules for Entity
 
-	if _, ok := ListUploadRequest_SortBy_name[int32(m.GetSortBy())]; !ok {
-		return ListUploadRequestValidationError{
-			field:  "SortBy",
-			reason: "value must be one of the defined enum values",
-		}
-	}
+	// no validation rules for Inserts
 
-	if _, ok := ListUploadRequest_SortOrder_name[int32(m.GetSortOrder())]; !ok {
-		return ListUploadRequestValidationError{
-			field:  "SortOrder",
-			reason: "value must be one of the defined enum values",
-		}
-	}
+	// no validation rules for Updates
 
-	if !_ListUploadRequest_Scope_Pattern.MatchString(m.GetScope()) {
-		return ListUploadRequestValidationError{
-			field:  "Scope",
-			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
-		}
-	}
+	// no validation rules for Deletes
 
-	if m.GetGlobalFileId() < 0 {
-		return ListUploadRequestValidationError{
-			field:  "GlobalFileId",
-			reason: "value must be greater than or equal to 0",
-		}
-	}
+	// no validation rules for Missing
 
 	return nil
 }
 
-// ListUploadRequestValidationError is the validation error returned by
-// ListUploadRequest.Validate if the designated constraints aren't met.
-type ListUploadRequestValidationError struct {
+// EntityImpactValidationError is the validation error returned by
+// EntityImpact.Validate if the designated constraints aren't met.
+type EntityImpactValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2282,24 +2389,22 @@ type ListUploadRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListUploadRequestValidationError) Field() string { return e.field }
+func (e EntityImpactValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListUploadRequestValidationError) Reason() string { return e.reason }
+func (e EntityImpactValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListUploadRequestValidationError) Cause() error { return e.cause }
+func (e EntityImpactValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListUploadRequestValidationError) Key() bool { return e.key }
+func (e EntityImpactValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListUploadRequestValidationError) ErrorName() string {
-	return "ListUploadRequestValidationError"
-}
+func (e EntityImpactValidationError) ErrorName() string { return "EntityImpactValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListUploadRequestValidationError) Error() string {
+func (e EntityImpactValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2311,14 +2416,14 @@ func (e ListUploadRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListUploadRequest.%s: %s%s",
+		"invalid %sEntityImpact.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListUploadRequestValidationError{}
+var _ error = EntityImpactValidationError{}
 
 var _ interface {
 	Field() string
@@ -2326,43 +2431,3265 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListUploadRequestValidationError{}
-
-var _ListUploadRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+} = EntityImpactValidationError{}
+
+// Validate checks the field values on DanglingReference with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *DanglingReference) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for FileName
+
+	// no validation rules for Entity
+
+	// no validation rules for Id
+
+	// no validation rules for ReferencedEntity
+
+	// no validation rules for ReferencedId
+
+	return nil
+}
+
+// DanglingReferenceValidationError is the validation error returned by
+// DanglingReference.Validate if the designated constraints aren't met.
+type DanglingReferenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DanglingReferenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DanglingReferenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DanglingReferenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DanglingReferenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DanglingReferenceValidationError) ErrorName() string {
+	return "DanglingReferenceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DanglingReferenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDanglingReference.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DanglingReferenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DanglingReferenceValidationError{}
+
+// Validate checks the field values on ListFailedRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ListFailedRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetScope()) < 1 {
+		return ListFailedRequestValidationError{
+			field:  "Scope",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if m.GetUploadId() <= 0 {
+		return ListFailedRequestValidationError{
+			field:  "UploadId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if m.GetPageNum() < 1 {
+		return ListFailedRequestValidationError{
+			field:  "PageNum",
+			reason: "value must be greater than or equal to 1",
+		}
+	}
+
+	if m.GetPageSize() < 1 {
+		return ListFailedRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 1",
+		}
+	}
+
+	return nil
+}
+
+// ListFailedRequestValidationError is the validation error returned by
+// ListFailedRequest.Validate if the designated constraints aren't met.
+type ListFailedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFailedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFailedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFailedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFailedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFailedRequestValidationError) ErrorName() string {
+	return "ListFailedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFailedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFailedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFailedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFailedRequestValidationError{}
+
+// Validate checks the field values on ListFailedResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListFailedResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetFailedRecords() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListFailedResponseValidationError{
+					field:  fmt.Sprintf("FailedRecords[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalRecords
+
+	return nil
+}
+
+// ListFailedResponseValidationError is the validation error returned by
+// ListFailedResponse.Validate if the designated constraints aren't met.
+type ListFailedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFailedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFailedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFailedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFailedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFailedResponseValidationError) ErrorName() string {
+	return "ListFailedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFailedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFailedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFailedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFailedResponseValidationError{}
+
+// Validate checks the field values on FailedRecord with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *FailedRecord) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Data
+
+	// no validation rules for Reason
+
+	return nil
+}
+
+// FailedRecordValidationError is the validation error returned by
+// FailedRecord.Validate if the designated constraints aren't met.
+type FailedRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FailedRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FailedRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FailedRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FailedRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FailedRecordValidationError) ErrorName() string { return "FailedRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FailedRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFailedRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FailedRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FailedRecordValidationError{}
+
+// Validate checks the field values on RetryFailedRecordsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RetryFailedRecordsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_RetryFailedRecordsRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return RetryFailedRecordsRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	if m.GetUploadId() <= 0 {
+		return RetryFailedRecordsRequestValidationError{
+			field:  "UploadId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetFile()) < 1 {
+		return RetryFailedRecordsRequestValidationError{
+			field:  "File",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// RetryFailedRecordsRequestValidationError is the validation error returned by
+// RetryFailedRecordsRequest.Validate if the designated constraints aren't met.
+type RetryFailedRecordsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryFailedRecordsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryFailedRecordsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryFailedRecordsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryFailedRecordsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryFailedRecordsRequestValidationError) ErrorName() string {
+	return "RetryFailedRecordsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetryFailedRecordsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryFailedRecordsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryFailedRecordsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryFailedRecordsRequestValidationError{}
+
+var _RetryFailedRecordsRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on RetryFailedRecordsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RetryFailedRecordsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for RetriedRecords
+
+	for idx, item := range m.GetSkippedRows() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return RetryFailedRecordsResponseValidationError{
+					field:  fmt.Sprintf("SkippedRows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// RetryFailedRecordsResponseValidationError is the validation error returned
+// by RetryFailedRecordsResponse.Validate if the designated constraints aren't met.
+type RetryFailedRecordsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryFailedRecordsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryFailedRecordsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryFailedRecordsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryFailedRecordsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryFailedRecordsResponseValidationError) ErrorName() string {
+	return "RetryFailedRecordsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetryFailedRecordsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryFailedRecordsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryFailedRecordsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryFailedRecordsResponseValidationError{}
+
+// Validate checks the field values on SkippedRow with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *SkippedRow) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Row
+
+	// no validation rules for Reason
+
+	return nil
+}
+
+// SkippedRowValidationError is the validation error returned by
+// SkippedRow.Validate if the designated constraints aren't met.
+type SkippedRowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkippedRowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkippedRowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkippedRowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkippedRowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkippedRowValidationError) ErrorName() string { return "SkippedRowValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SkippedRowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkippedRow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkippedRowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkippedRowValidationError{}
+
+// Validate checks the field values on ListUploadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ListUploadRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if val := m.GetPageNum(); val < 1 || val >= 1000 {
+		return ListUploadRequestValidationError{
+			field:  "PageNum",
+			reason: "value must be inside range [1, 1000)",
+		}
+	}
+
+	if val := m.GetPageSize(); val < 10 || val > 200 {
+		return ListUploadRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [10, 200]",
+		}
+	}
+
+	if _, ok := ListUploadRequest_SortBy_name[int32(m.GetSortBy())]; !ok {
+		return ListUploadRequestValidationError{
+			field:  "SortBy",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	if _, ok := ListUploadRequest_SortOrder_name[int32(m.GetSortOrder())]; !ok {
+		return ListUploadRequestValidationError{
+			field:  "SortOrder",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	if !_ListUploadRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return ListUploadRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	if m.GetGlobalFileId() < 0 {
+		return ListUploadRequestValidationError{
+			field:  "GlobalFileId",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	return nil
+}
+
+// ListUploadRequestValidationError is the validation error returned by
+// ListUploadRequest.Validate if the designated constraints aren't met.
+type ListUploadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUploadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUploadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUploadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUploadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUploadRequestValidationError) ErrorName() string {
+	return "ListUploadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUploadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUploadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUploadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUploadRequestValidationError{}
+
+var _ListUploadRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
 
 // Validate checks the field values on ListUploadResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ListUploadResponse) Validate() error {
+func (m *ListUploadResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for TotalRecords
+
+	for idx, item := range m.GetUploads() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListUploadResponseValidationError{
+					field:  fmt.Sprintf("Uploads[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListUploadResponseValidationError is the validation error returned by
+// ListUploadResponse.Validate if the designated constraints aren't met.
+type ListUploadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUploadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUploadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUploadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUploadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUploadResponseValidationError) ErrorName() string {
+	return "ListUploadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUploadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUploadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUploadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUploadResponseValidationError{}
+
+// Validate checks the field values on Upload with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Upload) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UploadId
+
+	// no validation rules for Scope
+
+	// no validation rules for FileName
+
+	// no validation rules for Status
+
+	// no validation rules for UploadedBy
+
+	if v, ok := interface{}(m.GetUploadedOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return UploadValidationError{
+				field:  "UploadedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TotalRecords
+
+	// no validation rules for SuccessRecords
+
+	// no validation rules for FailedRecords
+
+	// no validation rules for Comments
+
+	// no validation rules for ErrorFileApi
+
+	// no validation rules for InsertedRecords
+
+	// no validation rules for UpdatedRecords
+
+	// no validation rules for DeletedRecords
+
+	// no validation rules for ProcessedRecords
+
+	for idx, item := range m.GetRejectedRows() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return UploadValidationError{
+					field:  fmt.Sprintf("RejectedRows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// UploadValidationError is the validation error returned by Upload.Validate if
+// the designated constraints aren't met.
+type UploadValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadValidationError) ErrorName() string { return "UploadValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UploadValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpload.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadValidationError{}
+
+// Validate checks the field values on RejectedRow with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *RejectedRow) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Sheet
+
+	// no validation rules for Row
+
+	// no validation rules for Cell
+
+	// no validation rules for Action
+
+	// no validation rules for Reason
+
+	return nil
+}
+
+// RejectedRowValidationError is the validation error returned by
+// RejectedRow.Validate if the designated constraints aren't met.
+type RejectedRowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectedRowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectedRowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectedRowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectedRowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectedRowValidationError) ErrorName() string { return "RejectedRowValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RejectedRowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectedRow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectedRowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectedRowValidationError{}
+
+// Validate checks the field values on DeleteInventoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteInventoryRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_DeleteInventoryRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return DeleteInventoryRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	if _, ok := _DeleteInventoryRequest_DeletionType_InLookup[m.GetDeletionType()]; !ok {
+		return DeleteInventoryRequestValidationError{
+			field:  "DeletionType",
+			reason: "value must be in list [0 1 2]",
+		}
+	}
+
+	return nil
+}
+
+// DeleteInventoryRequestValidationError is the validation error returned by
+// DeleteInventoryRequest.Validate if the designated constraints aren't met.
+type DeleteInventoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteInventoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteInventoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteInventoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteInventoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteInventoryRequestValidationError) ErrorName() string {
+	return "DeleteInventoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteInventoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteInventoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteInventoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteInventoryRequestValidationError{}
+
+var _DeleteInventoryRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+var _DeleteInventoryRequest_DeletionType_InLookup = map[DeleteInventoryRequestDeletionTypes]struct{}{
+	0: {},
+	1: {},
+	2: {},
+}
+
+// Validate checks the field values on DeleteInventoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteInventoryResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Success
+
+	return nil
+}
+
+// DeleteInventoryResponseValidationError is the validation error returned by
+// DeleteInventoryResponse.Validate if the designated constraints aren't met.
+type DeleteInventoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteInventoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteInventoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteInventoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteInventoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteInventoryResponseValidationError) ErrorName() string {
+	return "DeleteInventoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteInventoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteInventoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteInventoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteInventoryResponseValidationError{}
+
+// Validate checks the field values on MappingProfile with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *MappingProfile) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 64 {
+		return MappingProfileValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+	}
+
+	if !_MappingProfile_Scope_Pattern.MatchString(m.GetScope()) {
+		return MappingProfileValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	if len(m.GetFiles()) < 1 {
+		return MappingProfileValidationError{
+			field:  "Files",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return MappingProfileValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for CreatedBy
+
+	if v, ok := interface{}(m.GetCreatedOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return MappingProfileValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UpdatedBy
+
+	if v, ok := interface{}(m.GetUpdatedOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return MappingProfileValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// MappingProfileValidationError is the validation error returned by
+// MappingProfile.Validate if the designated constraints aren't met.
+type MappingProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MappingProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MappingProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MappingProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MappingProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MappingProfileValidationError) ErrorName() string { return "MappingProfileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MappingProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMappingProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MappingProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MappingProfileValidationError{}
+
+var _MappingProfile_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on FileMapping with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *FileMapping) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if _, ok := _FileMapping_FileType_InLookup[m.GetFileType()]; !ok {
+		return FileMappingValidationError{
+			field:  "FileType",
+			reason: "value must be in list [products applications applications_products products_equipments application_equipments products_acquiredrights]",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetDelimiter()) > 1 {
+		return FileMappingValidationError{
+			field:  "Delimiter",
+			reason: "value length must be at most 1 runes",
+		}
+	}
+
+	if len(m.GetFields()) < 1 {
+		return FileMappingValidationError{
+			field:  "Fields",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetFields() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return FileMappingValidationError{
+					field:  fmt.Sprintf("Fields[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// FileMappingValidationError is the validation error returned by
+// FileMapping.Validate if the designated constraints aren't met.
+type FileMappingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FileMappingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FileMappingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FileMappingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FileMappingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FileMappingValidationError) ErrorName() string { return "FileMappingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FileMappingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFileMapping.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FileMappingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FileMappingValidationError{}
+
+var _FileMapping_FileType_InLookup = map[string]struct{}{
+	"products":                {},
+	"applications":            {},
+	"applications_products":   {},
+	"products_equipments":     {},
+	"application_equipments":  {},
+	"products_acquiredrights": {},
+}
+
+// Validate checks the field values on FieldMapping with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *FieldMapping) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetField()) < 1 {
+		return FieldMappingValidationError{
+			field:  "Field",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if _, ok := FieldMapping_Operation_name[int32(m.GetOperation())]; !ok {
+		return FieldMappingValidationError{
+			field:  "Operation",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	// no validation rules for Value
+
+	// no validation rules for Separator
+
+	if m.GetIndex() < 0 {
+		return FieldMappingValidationError{
+			field:  "Index",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	// no validation rules for DefaultValue
+
+	// no validation rules for DateFormat
+
+	if utf8.RuneCountInString(m.GetDecimalSeparator()) > 1 {
+		return FieldMappingValidationError{
+			field:  "DecimalSeparator",
+			reason: "value length must be at most 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetThousandsSeparator()) > 1 {
+		return FieldMappingValidationError{
+			field:  "ThousandsSeparator",
+			reason: "value length must be at most 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// FieldMappingValidationError is the validation error returned by
+// FieldMapping.Validate if the designated constraints aren't met.
+type FieldMappingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FieldMappingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FieldMappingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FieldMappingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FieldMappingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FieldMappingValidationError) ErrorName() string { return "FieldMappingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FieldMappingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFieldMapping.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FieldMappingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FieldMappingValidationError{}
+
+// Validate checks the field values on ListMappingProfilesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListMappingProfilesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_ListMappingProfilesRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return ListMappingProfilesRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	return nil
+}
+
+// ListMappingProfilesRequestValidationError is the validation error returned
+// by ListMappingProfilesRequest.Validate if the designated constraints aren't met.
+type ListMappingProfilesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMappingProfilesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMappingProfilesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMappingProfilesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMappingProfilesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMappingProfilesRequestValidationError) ErrorName() string {
+	return "ListMappingProfilesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMappingProfilesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMappingProfilesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMappingProfilesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMappingProfilesRequestValidationError{}
+
+var _ListMappingProfilesRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on ListMappingProfilesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListMappingProfilesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetProfiles() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListMappingProfilesResponseValidationError{
+					field:  fmt.Sprintf("Profiles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListMappingProfilesResponseValidationError is the validation error returned
+// by ListMappingProfilesResponse.Validate if the designated constraints
+// aren't met.
+type ListMappingProfilesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMappingProfilesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMappingProfilesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMappingProfilesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMappingProfilesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMappingProfilesResponseValidationError) ErrorName() string {
+	return "ListMappingProfilesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMappingProfilesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMappingProfilesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMappingProfilesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMappingProfilesResponseValidationError{}
+
+// Validate checks the field values on DeleteMappingProfileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteMappingProfileRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		return DeleteMappingProfileRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if !_DeleteMappingProfileRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return DeleteMappingProfileRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	return nil
+}
+
+// DeleteMappingProfileRequestValidationError is the validation error returned
+// by DeleteMappingProfileRequest.Validate if the designated constraints
+// aren't met.
+type DeleteMappingProfileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteMappingProfileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteMappingProfileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteMappingProfileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteMappingProfileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteMappingProfileRequestValidationError) ErrorName() string {
+	return "DeleteMappingProfileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteMappingProfileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteMappingProfileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteMappingProfileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteMappingProfileRequestValidationError{}
+
+var _DeleteMappingProfileRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on DeleteMappingProfileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteMappingProfileResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Success
+
+	return nil
+}
+
+// DeleteMappingProfileResponseValidationError is the validation error returned
+// by DeleteMappingProfileResponse.Validate if the designated constraints
+// aren't met.
+type DeleteMappingProfileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteMappingProfileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteMappingProfileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteMappingProfileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteMappingProfileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteMappingProfileResponseValidationError) ErrorName() string {
+	return "DeleteMappingProfileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteMappingProfileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteMappingProfileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteMappingProfileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteMappingProfileResponseValidationError{}
+
+// Validate checks the field values on ListInjectionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListInjectionsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_ListInjectionsRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return ListInjectionsRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	return nil
+}
+
+// ListInjectionsRequestValidationError is the validation error returned by
+// ListInjectionsRequest.Validate if the designated constraints aren't met.
+type ListInjectionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInjectionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInjectionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInjectionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInjectionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInjectionsRequestValidationError) ErrorName() string {
+	return "ListInjectionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInjectionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInjectionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInjectionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInjectionsRequestValidationError{}
+
+var _ListInjectionsRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on ListInjectionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListInjectionsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetInjections() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListInjectionsResponseValidationError{
+					field:  fmt.Sprintf("Injections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListInjectionsResponseValidationError is the validation error returned by
+// ListInjectionsResponse.Validate if the designated constraints aren't met.
+type ListInjectionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInjectionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInjectionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInjectionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInjectionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInjectionsResponseValidationError) ErrorName() string {
+	return "ListInjectionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInjectionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInjectionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInjectionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInjectionsResponseValidationError{}
+
+// Validate checks the field values on Injection with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Injection) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for InjectionId
+
+	// no validation rules for Scope
+
+	// no validation rules for Status
+
+	// no validation rules for TotalFiles
+
+	// no validation rules for StagedFiles
+
+	// no validation rules for TotalJobs
+
+	// no validation rules for DoneJobs
+
+	// no validation rules for FailedJobs
+
+	// no validation rules for CreatedBy
+
+	if v, ok := interface{}(m.GetCreatedOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return InjectionValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdatedOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return InjectionValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Comments
+
+	// no validation rules for CompensatingJobs
+
+	// no validation rules for CompensatedJobs
+
+	// no validation rules for FailedCompensations
+
+	return nil
+}
+
+// InjectionValidationError is the validation error returned by
+// Injection.Validate if the designated constraints aren't met.
+type InjectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InjectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InjectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InjectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InjectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InjectionValidationError) ErrorName() string { return "InjectionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InjectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInjection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InjectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InjectionValidationError{}
+
+// Validate checks the field values on RollbackInjectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RollbackInjectionRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetInjectionId() <= 0 {
+		return RollbackInjectionRequestValidationError{
+			field:  "InjectionId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if !_RollbackInjectionRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return RollbackInjectionRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	return nil
+}
+
+// RollbackInjectionRequestValidationError is the validation error returned by
+// RollbackInjectionRequest.Validate if the designated constraints aren't met.
+type RollbackInjectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackInjectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackInjectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackInjectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackInjectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackInjectionRequestValidationError) ErrorName() string {
+	return "RollbackInjectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackInjectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackInjectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackInjectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackInjectionRequestValidationError{}
+
+var _RollbackInjectionRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on RollbackInjectionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RollbackInjectionResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Success
+
+	// no validation rules for CompensatingJobs
+
+	// no validation rules for NotReverted
+
+	return nil
+}
+
+// RollbackInjectionResponseValidationError is the validation error returned by
+// RollbackInjectionResponse.Validate if the designated constraints aren't met.
+type RollbackInjectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackInjectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackInjectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackInjectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackInjectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackInjectionResponseValidationError) ErrorName() string {
+	return "RollbackInjectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackInjectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackInjectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackInjectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackInjectionResponseValidationError{}
+
+// Validate checks the field values on Connector with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Connector) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_Connector_Name_Pattern.MatchString(m.GetName()) {
+		return ConnectorValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9_-]{1,64}$\"",
+		}
+	}
+
+	if !_Connector_Scope_Pattern.MatchString(m.GetScope()) {
+		return ConnectorValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	if !_Connector_FileType_Pattern.MatchString(m.GetFileType()) {
+		return ConnectorValidationError{
+			field:  "FileType",
+			reason: "value does not match regex pattern \"^(products|applications|applications_products|products_equipments|application_equipments|products_acquiredrights|applications_instances|instances_products|equipment_[a-z0-9]+)$\"",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetSchedule()) < 1 {
+		return ConnectorValidationError{
+			field:  "Schedule",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Enabled
+
+	// no validation rules for MappingProfile
+
+	// no validation rules for CreatedBy
+
+	if v, ok := interface{}(m.GetCreatedOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ConnectorValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UpdatedBy
+
+	if v, ok := interface{}(m.GetUpdatedOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ConnectorValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetLastRunOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ConnectorValidationError{
+				field:  "LastRunOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastStatus
+
+	// no validation rules for LastError
+
+	// no validation rules for LastUploadId
+
+	switch m.Source.(type) {
+
+	case *Connector_Sql:
+
+		if v, ok := interface{}(m.GetSql()).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectorValidationError{
+					field:  "Sql",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Connector_Rest:
+
+		if v, ok := interface{}(m.GetRest()).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectorValidationError{
+					field:  "Rest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Connector_Directory:
+
+		if v, ok := interface{}(m.GetDirectory()).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ConnectorValidationError{
+					field:  "Directory",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		return ConnectorValidationError{
+			field:  "Source",
+			reason: "value is required",
+		}
+
+	}
+
+	return nil
+}
+
+// ConnectorValidationError is the validation error returned by
+// Connector.Validate if the designated constraints aren't met.
+type ConnectorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConnectorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConnectorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConnectorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConnectorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConnectorValidationError) ErrorName() string { return "ConnectorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConnectorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConnector.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConnectorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConnectorValidationError{}
+
+var _Connector_Name_Pattern = regexp.MustCompile("^[a-zA-Z0-9_-]{1,64}$")
+
+var _Connector_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+var _Connector_FileType_Pattern = regexp.MustCompile("^(products|applications|applications_products|products_equipments|application_equipments|products_acquiredrights|applications_instances|instances_products|equipment_[a-z0-9]+)$")
+
+// Validate checks the field values on SqlSource with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *SqlSource) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetDriver()) < 1 {
+		return SqlSourceValidationError{
+			field:  "Driver",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetDsn()) < 1 {
+		return SqlSourceValidationError{
+			field:  "Dsn",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetQuery()) < 1 {
+		return SqlSourceValidationError{
+			field:  "Query",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// SqlSourceValidationError is the validation error returned by
+// SqlSource.Validate if the designated constraints aren't met.
+type SqlSourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SqlSourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SqlSourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SqlSourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SqlSourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SqlSourceValidationError) ErrorName() string { return "SqlSourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SqlSourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSqlSource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SqlSourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SqlSourceValidationError{}
+
+// Validate checks the field values on RestSource with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *RestSource) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		return RestSourceValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+	} else if !uri.IsAbs() {
+		return RestSourceValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+	}
+
+	// no validation rules for Headers
+
+	// no validation rules for RecordsPath
+
+	// no validation rules for NextPath
+
+	// no validation rules for PageParam
+
+	// no validation rules for FirstPage
+
+	// no validation rules for PageSizeParam
+
+	if m.GetPageSize() < 0 {
+		return RestSourceValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	if m.GetMaxPages() < 0 {
+		return RestSourceValidationError{
+			field:  "MaxPages",
+			reason: "value must be greater than or equal to 0",
+		}
+	}
+
+	return nil
+}
+
+// RestSourceValidationError is the validation error returned by
+// RestSource.Validate if the designated constraints aren't met.
+type RestSourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestSourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestSourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestSourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestSourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestSourceValidationError) ErrorName() string { return "RestSourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RestSourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestSource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestSourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestSourceValidationError{}
+
+// Validate checks the field values on DirectorySource with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *DirectorySource) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetPath()) < 1 {
+		return DirectorySourceValidationError{
+			field:  "Path",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Pattern
+
+	return nil
+}
+
+// DirectorySourceValidationError is the validation error returned by
+// DirectorySource.Validate if the designated constraints aren't met.
+type DirectorySourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DirectorySourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DirectorySourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DirectorySourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DirectorySourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DirectorySourceValidationError) ErrorName() string { return "DirectorySourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DirectorySourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDirectorySource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DirectorySourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DirectorySourceValidationError{}
+
+// Validate checks the field values on ListConnectorsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListConnectorsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_ListConnectorsRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return ListConnectorsRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	return nil
+}
+
+// ListConnectorsRequestValidationError is the validation error returned by
+// ListConnectorsRequest.Validate if the designated constraints aren't met.
+type ListConnectorsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConnectorsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConnectorsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConnectorsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConnectorsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConnectorsRequestValidationError) ErrorName() string {
+	return "ListConnectorsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConnectorsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConnectorsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConnectorsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConnectorsRequestValidationError{}
+
+var _ListConnectorsRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on ListConnectorsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListConnectorsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetConnectors() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListConnectorsResponseValidationError{
+					field:  fmt.Sprintf("Connectors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListConnectorsResponseValidationError is the validation error returned by
+// ListConnectorsResponse.Validate if the designated constraints aren't met.
+type ListConnectorsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConnectorsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConnectorsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConnectorsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConnectorsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConnectorsResponseValidationError) ErrorName() string {
+	return "ListConnectorsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConnectorsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConnectorsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConnectorsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConnectorsResponseValidationError{}
+
+// Validate checks the field values on DeleteConnectorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteConnectorRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		return DeleteConnectorRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if !_DeleteConnectorRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return DeleteConnectorRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	return nil
+}
+
+// DeleteConnectorRequestValidationError is the validation error returned by
+// DeleteConnectorRequest.Validate if the designated constraints aren't met.
+type DeleteConnectorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteConnectorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteConnectorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteConnectorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteConnectorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteConnectorRequestValidationError) ErrorName() string {
+	return "DeleteConnectorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteConnectorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteConnectorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteConnectorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteConnectorRequestValidationError{}
+
+var _DeleteConnectorRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on DeleteConnectorResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteConnectorResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Success
+
+	return nil
+}
+
+// DeleteConnectorResponseValidationError is the validation error returned by
+// DeleteConnectorResponse.Validate if the designated constraints aren't met.
+type DeleteConnectorResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteConnectorResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteConnectorResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteConnectorResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteConnectorResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteConnectorResponseValidationError) ErrorName() string {
+	return "DeleteConnectorResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteConnectorResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteConnectorResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteConnectorResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteConnectorResponseValidationError{}
+
+// Validate checks the field values on RunConnectorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RunConnectorRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		return RunConnectorRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if !_RunConnectorRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return RunConnectorRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	return nil
+}
+
+// RunConnectorRequestValidationError is the validation error returned by
+// RunConnectorRequest.Validate if the designated constraints aren't met.
+type RunConnectorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RunConnectorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RunConnectorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RunConnectorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RunConnectorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RunConnectorRequestValidationError) ErrorName() string {
+	return "RunConnectorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RunConnectorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRunConnectorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RunConnectorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RunConnectorRequestValidationError{}
+
+var _RunConnectorRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on RunConnectorResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RunConnectorResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Success
+
+	// no validation rules for UploadId
+
+	return nil
+}
+
+// RunConnectorResponseValidationError is the validation error returned by
+// RunConnectorResponse.Validate if the designated constraints aren't met.
+type RunConnectorResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RunConnectorResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RunConnectorResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RunConnectorResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RunConnectorResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RunConnectorResponseValidationError) ErrorName() string {
+	return "RunConnectorResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RunConnectorResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRunConnectorResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RunConnectorResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RunConnectorResponseValidationError{}
+
+// Validate checks the field values on QualityRule with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *QualityRule) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for TotalRecords
+	if !_QualityRule_Name_Pattern.MatchString(m.GetName()) {
+		return QualityRuleValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9_-]{1,64}$\"",
+		}
+	}
 
-	for idx, item := range m.GetUploads() {
-		_, _ = idx, item
+	if !_QualityRule_Scope_Pattern.MatchString(m.GetScope()) {
+		return QualityRuleValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
 
-		if v, ok := interface{}(item).(interface {
-			Validate() error
-		}); ok {
-			if err := v.Validate(); err != nil {
-				return ListUploadResponseValidationError{
-					field:  fmt.Sprintf("Uploads[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
+	if _, ok := _QualityRule_Entity_InLookup[m.GetEntity()]; !ok {
+		return QualityRuleValidationError{
+			field:  "Entity",
+			reason: "value must be in list [applications products equipments acqrights]",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetField()) < 1 {
+		return QualityRuleValidationError{
+			field:  "Field",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if _, ok := _QualityRule_Operator_InLookup[m.GetOperator()]; !ok {
+		return QualityRuleValidationError{
+			field:  "Operator",
+			reason: "value must be in list [required range one_of regex in_catalog]",
+		}
+	}
+
+	if v, ok := interface{}(m.GetMin()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return QualityRuleValidationError{
+				field:  "Min",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetMax()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return QualityRuleValidationError{
+				field:  "Max",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Pattern
+
+	// no validation rules for Severity
+
+	// no validation rules for Description
+
+	// no validation rules for Enabled
+
+	// no validation rules for CreatedBy
+
+	if v, ok := interface{}(m.GetCreatedOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return QualityRuleValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
+	// no validation rules for UpdatedBy
+
+	if v, ok := interface{}(m.GetUpdatedOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return QualityRuleValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	return nil
 }
 
-// ListUploadResponseValidationError is the validation error returned by
-// ListUploadResponse.Validate if the designated constraints aren't met.
-type ListUploadResponseValidationError struct {
+// QualityRuleValidationError is the validation error returned by
+// QualityRule.Validate if the designated constraints aren't met.
+type QualityRuleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2370,24 +5697,22 @@ type ListUploadResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListUploadResponseValidationError) Field() string { return e.field }
+func (e QualityRuleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListUploadResponseValidationError) Reason() string { return e.reason }
+func (e QualityRuleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListUploadResponseValidationError) Cause() error { return e.cause }
+func (e QualityRuleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListUploadResponseValidationError) Key() bool { return e.key }
+func (e QualityRuleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListUploadResponseValidationError) ErrorName() string {
-	return "ListUploadResponseValidationError"
-}
+func (e QualityRuleValidationError) ErrorName() string { return "QualityRuleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListUploadResponseValidationError) Error() string {
+func (e QualityRuleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2399,14 +5724,14 @@ func (e ListUploadResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListUploadResponse.%s: %s%s",
+		"invalid %sQualityRule.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListUploadResponseValidationError{}
+var _ error = QualityRuleValidationError{}
 
 var _ interface {
 	Field() string
@@ -2414,53 +5739,134 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListUploadResponseValidationError{}
+} = QualityRuleValidationError{}
 
-// Validate checks the field values on Upload with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Upload) Validate() error {
+var _QualityRule_Name_Pattern = regexp.MustCompile("^[a-zA-Z0-9_-]{1,64}$")
+
+var _QualityRule_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+var _QualityRule_Entity_InLookup = map[string]struct{}{
+	"applications": {},
+	"products":     {},
+	"equipments":   {},
+	"acqrights":    {},
+}
+
+var _QualityRule_Operator_InLookup = map[string]struct{}{
+	"required":   {},
+	"range":      {},
+	"one_of":     {},
+	"regex":      {},
+	"in_catalog": {},
+}
+
+// Validate checks the field values on ListQualityRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListQualityRulesRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for UploadId
+	if !_ListQualityRulesRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return ListQualityRulesRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
 
-	// no validation rules for Scope
+	return nil
+}
 
-	// no validation rules for FileName
+// ListQualityRulesRequestValidationError is the validation error returned by
+// ListQualityRulesRequest.Validate if the designated constraints aren't met.
+type ListQualityRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	// no validation rules for Status
+// Field function returns field value.
+func (e ListQualityRulesRequestValidationError) Field() string { return e.field }
 
-	// no validation rules for UploadedBy
+// Reason function returns reason value.
+func (e ListQualityRulesRequestValidationError) Reason() string { return e.reason }
 
-	if v, ok := interface{}(m.GetUploadedOn()).(interface {
-		Validate() error
-	}); ok {
-		if err := v.Validate(); err != nil {
-			return UploadValidationError{
-				field:  "UploadedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// Cause function returns cause value.
+func (e ListQualityRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQualityRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQualityRulesRequestValidationError) ErrorName() string {
+	return "ListQualityRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQualityRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	// no validation rules for TotalRecords
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-	// no validation rules for SuccessRecords
+	return fmt.Sprintf(
+		"invalid %sListQualityRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-	// no validation rules for FailedRecords
+var _ error = ListQualityRulesRequestValidationError{}
 
-	// no validation rules for Comments
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQualityRulesRequestValidationError{}
 
-	// no validation rules for ErrorFileApi
+var _ListQualityRulesRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on ListQualityRulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListQualityRulesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListQualityRulesResponseValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	return nil
 }
 
-// UploadValidationError is the validation error returned by Upload.Validate if
-// the designated constraints aren't met.
-type UploadValidationError struct {
+// ListQualityRulesResponseValidationError is the validation error returned by
+// ListQualityRulesResponse.Validate if the designated constraints aren't met.
+type ListQualityRulesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2468,22 +5874,24 @@ type UploadValidationError struct {
 }
 
 // Field function returns field value.
-func (e UploadValidationError) Field() string { return e.field }
+func (e ListQualityRulesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UploadValidationError) Reason() string { return e.reason }
+func (e ListQualityRulesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UploadValidationError) Cause() error { return e.cause }
+func (e ListQualityRulesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UploadValidationError) Key() bool { return e.key }
+func (e ListQualityRulesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UploadValidationError) ErrorName() string { return "UploadValidationError" }
+func (e ListQualityRulesResponseValidationError) ErrorName() string {
+	return "ListQualityRulesResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UploadValidationError) Error() string {
+func (e ListQualityRulesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2495,14 +5903,14 @@ func (e UploadValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpload.%s: %s%s",
+		"invalid %sListQualityRulesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UploadValidationError{}
+var _ error = ListQualityRulesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2510,36 +5918,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UploadValidationError{}
+} = ListQualityRulesResponseValidationError{}
 
-// Validate checks the field values on DeleteInventoryRequest with the rules
+// Validate checks the field values on DeleteQualityRuleRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *DeleteInventoryRequest) Validate() error {
+func (m *DeleteQualityRuleRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if !_DeleteInventoryRequest_Scope_Pattern.MatchString(m.GetScope()) {
-		return DeleteInventoryRequestValidationError{
-			field:  "Scope",
-			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		return DeleteQualityRuleRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
 		}
 	}
 
-	if _, ok := _DeleteInventoryRequest_DeletionType_InLookup[m.GetDeletionType()]; !ok {
-		return DeleteInventoryRequestValidationError{
-			field:  "DeletionType",
-			reason: "value must be in list [0 1 2]",
+	if !_DeleteQualityRuleRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return DeleteQualityRuleRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
 		}
 	}
 
 	return nil
 }
 
-// DeleteInventoryRequestValidationError is the validation error returned by
-// DeleteInventoryRequest.Validate if the designated constraints aren't met.
-type DeleteInventoryRequestValidationError struct {
+// DeleteQualityRuleRequestValidationError is the validation error returned by
+// DeleteQualityRuleRequest.Validate if the designated constraints aren't met.
+type DeleteQualityRuleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2547,24 +5955,24 @@ type DeleteInventoryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteInventoryRequestValidationError) Field() string { return e.field }
+func (e DeleteQualityRuleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteInventoryRequestValidationError) Reason() string { return e.reason }
+func (e DeleteQualityRuleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteInventoryRequestValidationError) Cause() error { return e.cause }
+func (e DeleteQualityRuleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteInventoryRequestValidationError) Key() bool { return e.key }
+func (e DeleteQualityRuleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteInventoryRequestValidationError) ErrorName() string {
-	return "DeleteInventoryRequestValidationError"
+func (e DeleteQualityRuleRequestValidationError) ErrorName() string {
+	return "DeleteQualityRuleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteInventoryRequestValidationError) Error() string {
+func (e DeleteQualityRuleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2576,14 +5984,14 @@ func (e DeleteInventoryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteInventoryRequest.%s: %s%s",
+		"invalid %sDeleteQualityRuleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteInventoryRequestValidationError{}
+var _ error = DeleteQualityRuleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2591,20 +5999,14 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteInventoryRequestValidationError{}
-
-var _DeleteInventoryRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+} = DeleteQualityRuleRequestValidationError{}
 
-var _DeleteInventoryRequest_DeletionType_InLookup = map[DeleteInventoryRequestDeletionTypes]struct{}{
-	0: {},
-	1: {},
-	2: {},
-}
+var _DeleteQualityRuleRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
 
-// Validate checks the field values on DeleteInventoryResponse with the rules
+// Validate checks the field values on DeleteQualityRuleResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *DeleteInventoryResponse) Validate() error {
+func (m *DeleteQualityRuleResponse) Validate() error {
 	if m == nil {
 		return nil
 	}
@@ -2614,9 +6016,9 @@ func (m *DeleteInventoryResponse) Validate() error {
 	return nil
 }
 
-// DeleteInventoryResponseValidationError is the validation error returned by
-// DeleteInventoryResponse.Validate if the designated constraints aren't met.
-type DeleteInventoryResponseValidationError struct {
+// DeleteQualityRuleResponseValidationError is the validation error returned by
+// DeleteQualityRuleResponse.Validate if the designated constraints aren't met.
+type DeleteQualityRuleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2624,24 +6026,24 @@ type DeleteInventoryResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteInventoryResponseValidationError) Field() string { return e.field }
+func (e DeleteQualityRuleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteInventoryResponseValidationError) Reason() string { return e.reason }
+func (e DeleteQualityRuleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteInventoryResponseValidationError) Cause() error { return e.cause }
+func (e DeleteQualityRuleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteInventoryResponseValidationError) Key() bool { return e.key }
+func (e DeleteQualityRuleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteInventoryResponseValidationError) ErrorName() string {
-	return "DeleteInventoryResponseValidationError"
+func (e DeleteQualityRuleResponseValidationError) ErrorName() string {
+	return "DeleteQualityRuleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteInventoryResponseValidationError) Error() string {
+func (e DeleteQualityRuleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2653,14 +6055,14 @@ func (e DeleteInventoryResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteInventoryResponse.%s: %s%s",
+		"invalid %sDeleteQualityRuleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteInventoryResponseValidationError{}
+var _ error = DeleteQualityRuleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2668,4 +6070,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteInventoryResponseValidationError{}
+} = DeleteQualityRuleResponseValidationError{}
@@ -56,6 +56,66 @@ func (mr *MockDpsServiceClientMockRecorder) CancelUpload(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUpload", reflect.TypeOf((*MockDpsServiceClient)(nil).CancelUpload), varargs...)
 }
 
+// CreateConnector mocks base method.
+func (m *MockDpsServiceClient) CreateConnector(ctx context.Context, in *v1.Connector, opts ...grpc.CallOption) (*v1.Connector, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateConnector", varargs...)
+	ret0, _ := ret[0].(*v1.Connector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateConnector indicates an expected call of CreateConnector.
+func (mr *MockDpsServiceClientMockRecorder) CreateConnector(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConnector", reflect.TypeOf((*MockDpsServiceClient)(nil).CreateConnector), varargs...)
+}
+
+// CreateMappingProfile mocks base method.
+func (m *MockDpsServiceClient) CreateMappingProfile(ctx context.Context, in *v1.MappingProfile, opts ...grpc.CallOption) (*v1.MappingProfile, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateMappingProfile", varargs...)
+	ret0, _ := ret[0].(*v1.MappingProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMappingProfile indicates an expected call of CreateMappingProfile.
+func (mr *MockDpsServiceClientMockRecorder) CreateMappingProfile(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMappingProfile", reflect.TypeOf((*MockDpsServiceClient)(nil).CreateMappingProfile), varargs...)
+}
+
+// CreateQualityRule mocks base method.
+func (m *MockDpsServiceClient) CreateQualityRule(ctx context.Context, in *v1.QualityRule, opts ...grpc.CallOption) (*v1.QualityRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateQualityRule", varargs...)
+	ret0, _ := ret[0].(*v1.QualityRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateQualityRule indicates an expected call of CreateQualityRule.
+func (mr *MockDpsServiceClientMockRecorder) CreateQualityRule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQualityRule", reflect.TypeOf((*MockDpsServiceClient)(nil).CreateQualityRule), varargs...)
+}
+
 // DashboardQualityOverview mocks base method.
 func (m *MockDpsServiceClient) DashboardQualityOverview(ctx context.Context, in *v1.DashboardQualityOverviewRequest, opts ...grpc.CallOption) (*v1.DashboardQualityOverviewResponse, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +156,26 @@ func (mr *MockDpsServiceClientMockRecorder) DataAnalysis(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DataAnalysis", reflect.TypeOf((*MockDpsServiceClient)(nil).DataAnalysis), varargs...)
 }
 
+// DeleteConnector mocks base method.
+func (m *MockDpsServiceClient) DeleteConnector(ctx context.Context, in *v1.DeleteConnectorRequest, opts ...grpc.CallOption) (*v1.DeleteConnectorResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteConnector", varargs...)
+	ret0, _ := ret[0].(*v1.DeleteConnectorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConnector indicates an expected call of DeleteConnector.
+func (mr *MockDpsServiceClientMockRecorder) DeleteConnector(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConnector", reflect.TypeOf((*MockDpsServiceClient)(nil).DeleteConnector), varargs...)
+}
+
 // DeleteInventory mocks base method.
 func (m *MockDpsServiceClient) DeleteInventory(ctx context.Context, in *v1.DeleteInventoryRequest, opts ...grpc.CallOption) (*v1.DeleteInventoryResponse, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +196,46 @@ func (mr *MockDpsServiceClientMockRecorder) DeleteInventory(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInventory", reflect.TypeOf((*MockDpsServiceClient)(nil).DeleteInventory), varargs...)
 }
 
+// DeleteMappingProfile mocks base method.
+func (m *MockDpsServiceClient) DeleteMappingProfile(ctx context.Context, in *v1.DeleteMappingProfileRequest, opts ...grpc.CallOption) (*v1.DeleteMappingProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteMappingProfile", varargs...)
+	ret0, _ := ret[0].(*v1.DeleteMappingProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMappingProfile indicates an expected call of DeleteMappingProfile.
+func (mr *MockDpsServiceClientMockRecorder) DeleteMappingProfile(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMappingProfile", reflect.TypeOf((*MockDpsServiceClient)(nil).DeleteMappingProfile), varargs...)
+}
+
+// DeleteQualityRule mocks base method.
+func (m *MockDpsServiceClient) DeleteQualityRule(ctx context.Context, in *v1.DeleteQualityRuleRequest, opts ...grpc.CallOption) (*v1.DeleteQualityRuleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteQualityRule", varargs...)
+	ret0, _ := ret[0].(*v1.DeleteQualityRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteQualityRule indicates an expected call of DeleteQualityRule.
+func (mr *MockDpsServiceClientMockRecorder) DeleteQualityRule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQualityRule", reflect.TypeOf((*MockDpsServiceClient)(nil).DeleteQualityRule), varargs...)
+}
+
 // DropUploadedFileData mocks base method.
 func (m *MockDpsServiceClient) DropUploadedFileData(ctx context.Context, in *v1.DropUploadedFileDataRequest, opts ...grpc.CallOption) (*v1.DropUploadedFileDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +296,26 @@ func (mr *MockDpsServiceClientMockRecorder) GetAnalysisFileInfo(ctx, in interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalysisFileInfo", reflect.TypeOf((*MockDpsServiceClient)(nil).GetAnalysisFileInfo), varargs...)
 }
 
+// ListConnectors mocks base method.
+func (m *MockDpsServiceClient) ListConnectors(ctx context.Context, in *v1.ListConnectorsRequest, opts ...grpc.CallOption) (*v1.ListConnectorsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConnectors", varargs...)
+	ret0, _ := ret[0].(*v1.ListConnectorsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConnectors indicates an expected call of ListConnectors.
+func (mr *MockDpsServiceClientMockRecorder) ListConnectors(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConnectors", reflect.TypeOf((*MockDpsServiceClient)(nil).ListConnectors), varargs...)
+}
+
 // ListDeletionRecords mocks base method.
 func (m *MockDpsServiceClient) ListDeletionRecords(ctx context.Context, in *v1.ListDeletionRequest, opts ...grpc.CallOption) (*v1.ListDeletionResponse, error) {
 	m.ctrl.T.Helper()
@@ -216,6 +356,66 @@ func (mr *MockDpsServiceClientMockRecorder) ListFailedRecord(ctx, in interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFailedRecord", reflect.TypeOf((*MockDpsServiceClient)(nil).ListFailedRecord), varargs...)
 }
 
+// ListInjections mocks base method.
+func (m *MockDpsServiceClient) ListInjections(ctx context.Context, in *v1.ListInjectionsRequest, opts ...grpc.CallOption) (*v1.ListInjectionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInjections", varargs...)
+	ret0, _ := ret[0].(*v1.ListInjectionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInjections indicates an expected call of ListInjections.
+func (mr *MockDpsServiceClientMockRecorder) ListInjections(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInjections", reflect.TypeOf((*MockDpsServiceClient)(nil).ListInjections), varargs...)
+}
+
+// ListMappingProfiles mocks base method.
+func (m *MockDpsServiceClient) ListMappingProfiles(ctx context.Context, in *v1.ListMappingProfilesRequest, opts ...grpc.CallOption) (*v1.ListMappingProfilesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListMappingProfiles", varargs...)
+	ret0, _ := ret[0].(*v1.ListMappingProfilesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMappingProfiles indicates an expected call of ListMappingProfiles.
+func (mr *MockDpsServiceClientMockRecorder) ListMappingProfiles(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMappingProfiles", reflect.TypeOf((*MockDpsServiceClient)(nil).ListMappingProfiles), varargs...)
+}
+
+// ListQualityRules mocks base method.
+func (m *MockDpsServiceClient) ListQualityRules(ctx context.Context, in *v1.ListQualityRulesRequest, opts ...grpc.CallOption) (*v1.ListQualityRulesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListQualityRules", varargs...)
+	ret0, _ := ret[0].(*v1.ListQualityRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQualityRules indicates an expected call of ListQualityRules.
+func (mr *MockDpsServiceClientMockRecorder) ListQualityRules(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQualityRules", reflect.TypeOf((*MockDpsServiceClient)(nil).ListQualityRules), varargs...)
+}
+
 // ListUploadData mocks base method.
 func (m *MockDpsServiceClient) ListUploadData(ctx context.Context, in *v1.ListUploadRequest, opts ...grpc.CallOption) (*v1.ListUploadResponse, error) {
 	m.ctrl.T.Helper()
@@ -296,6 +496,86 @@ func (mr *MockDpsServiceClientMockRecorder) NotifyUpload(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyUpload", reflect.TypeOf((*MockDpsServiceClient)(nil).NotifyUpload), varargs...)
 }
 
+// PreviewUpload mocks base method.
+func (m *MockDpsServiceClient) PreviewUpload(ctx context.Context, in *v1.PreviewUploadRequest, opts ...grpc.CallOption) (*v1.PreviewUploadResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PreviewUpload", varargs...)
+	ret0, _ := ret[0].(*v1.PreviewUploadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewUpload indicates an expected call of PreviewUpload.
+func (mr *MockDpsServiceClientMockRecorder) PreviewUpload(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewUpload", reflect.TypeOf((*MockDpsServiceClient)(nil).PreviewUpload), varargs...)
+}
+
+// RetryFailedRecords mocks base method.
+func (m *MockDpsServiceClient) RetryFailedRecords(ctx context.Context, in *v1.RetryFailedRecordsRequest, opts ...grpc.CallOption) (*v1.RetryFailedRecordsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RetryFailedRecords", varargs...)
+	ret0, _ := ret[0].(*v1.RetryFailedRecordsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryFailedRecords indicates an expected call of RetryFailedRecords.
+func (mr *MockDpsServiceClientMockRecorder) RetryFailedRecords(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryFailedRecords", reflect.TypeOf((*MockDpsServiceClient)(nil).RetryFailedRecords), varargs...)
+}
+
+// RollbackInjection mocks base method.
+func (m *MockDpsServiceClient) RollbackInjection(ctx context.Context, in *v1.RollbackInjectionRequest, opts ...grpc.CallOption) (*v1.RollbackInjectionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RollbackInjection", varargs...)
+	ret0, _ := ret[0].(*v1.RollbackInjectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackInjection indicates an expected call of RollbackInjection.
+func (mr *MockDpsServiceClientMockRecorder) RollbackInjection(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackInjection", reflect.TypeOf((*MockDpsServiceClient)(nil).RollbackInjection), varargs...)
+}
+
+// RunConnector mocks base method.
+func (m *MockDpsServiceClient) RunConnector(ctx context.Context, in *v1.RunConnectorRequest, opts ...grpc.CallOption) (*v1.RunConnectorResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunConnector", varargs...)
+	ret0, _ := ret[0].(*v1.RunConnectorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunConnector indicates an expected call of RunConnector.
+func (mr *MockDpsServiceClientMockRecorder) RunConnector(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunConnector", reflect.TypeOf((*MockDpsServiceClient)(nil).RunConnector), varargs...)
+}
+
 // StoreCoreFactorReference mocks base method.
 func (m *MockDpsServiceClient) StoreCoreFactorReference(ctx context.Context, in *v1.StoreReferenceDataRequest, opts ...grpc.CallOption) (*v1.StoreReferenceDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -316,6 +596,66 @@ func (mr *MockDpsServiceClientMockRecorder) StoreCoreFactorReference(ctx, in int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreCoreFactorReference", reflect.TypeOf((*MockDpsServiceClient)(nil).StoreCoreFactorReference), varargs...)
 }
 
+// UpdateConnector mocks base method.
+func (m *MockDpsServiceClient) UpdateConnector(ctx context.Context, in *v1.Connector, opts ...grpc.CallOption) (*v1.Connector, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateConnector", varargs...)
+	ret0, _ := ret[0].(*v1.Connector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateConnector indicates an expected call of UpdateConnector.
+func (mr *MockDpsServiceClientMockRecorder) UpdateConnector(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConnector", reflect.TypeOf((*MockDpsServiceClient)(nil).UpdateConnector), varargs...)
+}
+
+// UpdateMappingProfile mocks base method.
+func (m *MockDpsServiceClient) UpdateMappingProfile(ctx context.Context, in *v1.MappingProfile, opts ...grpc.CallOption) (*v1.MappingProfile, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateMappingProfile", varargs...)
+	ret0, _ := ret[0].(*v1.MappingProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMappingProfile indicates an expected call of UpdateMappingProfile.
+func (mr *MockDpsServiceClientMockRecorder) UpdateMappingProfile(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMappingProfile", reflect.TypeOf((*MockDpsServiceClient)(nil).UpdateMappingProfile), varargs...)
+}
+
+// UpdateQualityRule mocks base method.
+func (m *MockDpsServiceClient) UpdateQualityRule(ctx context.Context, in *v1.QualityRule, opts ...grpc.CallOption) (*v1.QualityRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateQualityRule", varargs...)
+	ret0, _ := ret[0].(*v1.QualityRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateQualityRule indicates an expected call of UpdateQualityRule.
+func (mr *MockDpsServiceClientMockRecorder) UpdateQualityRule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQualityRule", reflect.TypeOf((*MockDpsServiceClient)(nil).UpdateQualityRule), varargs...)
+}
+
 // ViewCoreFactorLogs mocks base method.
 func (m *MockDpsServiceClient) ViewCoreFactorLogs(ctx context.Context, in *v1.ViewCoreFactorLogsRequest, opts ...grpc.CallOption) (*v1.ViewCoreFactorLogsResponse, error) {
 	m.ctrl.T.Helper()
@@ -394,6 +734,51 @@ func (mr *MockDpsServiceServerMockRecorder) CancelUpload(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUpload", reflect.TypeOf((*MockDpsServiceServer)(nil).CancelUpload), arg0, arg1)
 }
 
+// CreateConnector mocks base method.
+func (m *MockDpsServiceServer) CreateConnector(arg0 context.Context, arg1 *v1.Connector) (*v1.Connector, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateConnector", arg0, arg1)
+	ret0, _ := ret[0].(*v1.Connector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateConnector indicates an expected call of CreateConnector.
+func (mr *MockDpsServiceServerMockRecorder) CreateConnector(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConnector", reflect.TypeOf((*MockDpsServiceServer)(nil).CreateConnector), arg0, arg1)
+}
+
+// CreateMappingProfile mocks base method.
+func (m *MockDpsServiceServer) CreateMappingProfile(arg0 context.Context, arg1 *v1.MappingProfile) (*v1.MappingProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMappingProfile", arg0, arg1)
+	ret0, _ := ret[0].(*v1.MappingProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMappingProfile indicates an expected call of CreateMappingProfile.
+func (mr *MockDpsServiceServerMockRecorder) CreateMappingProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMappingProfile", reflect.TypeOf((*MockDpsServiceServer)(nil).CreateMappingProfile), arg0, arg1)
+}
+
+// CreateQualityRule mocks base method.
+func (m *MockDpsServiceServer) CreateQualityRule(arg0 context.Context, arg1 *v1.QualityRule) (*v1.QualityRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQualityRule", arg0, arg1)
+	ret0, _ := ret[0].(*v1.QualityRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateQualityRule indicates an expected call of CreateQualityRule.
+func (mr *MockDpsServiceServerMockRecorder) CreateQualityRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQualityRule", reflect.TypeOf((*MockDpsServiceServer)(nil).CreateQualityRule), arg0, arg1)
+}
+
 // DashboardQualityOverview mocks base method.
 func (m *MockDpsServiceServer) DashboardQualityOverview(arg0 context.Context, arg1 *v1.DashboardQualityOverviewRequest) (*v1.DashboardQualityOverviewResponse, error) {
 	m.ctrl.T.Helper()
@@ -424,6 +809,21 @@ func (mr *MockDpsServiceServerMockRecorder) DataAnalysis(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DataAnalysis", reflect.TypeOf((*MockDpsServiceServer)(nil).DataAnalysis), arg0, arg1)
 }
 
+// DeleteConnector mocks base method.
+func (m *MockDpsServiceServer) DeleteConnector(arg0 context.Context, arg1 *v1.DeleteConnectorRequest) (*v1.DeleteConnectorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConnector", arg0, arg1)
+	ret0, _ := ret[0].(*v1.DeleteConnectorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConnector indicates an expected call of DeleteConnector.
+func (mr *MockDpsServiceServerMockRecorder) DeleteConnector(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConnector", reflect.TypeOf((*MockDpsServiceServer)(nil).DeleteConnector), arg0, arg1)
+}
+
 // DeleteInventory mocks base method.
 func (m *MockDpsServiceServer) DeleteInventory(arg0 context.Context, arg1 *v1.DeleteInventoryRequest) (*v1.DeleteInventoryResponse, error) {
 	m.ctrl.T.Helper()
@@ -439,6 +839,36 @@ func (mr *MockDpsServiceServerMockRecorder) DeleteInventory(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInventory", reflect.TypeOf((*MockDpsServiceServer)(nil).DeleteInventory), arg0, arg1)
 }
 
+// DeleteMappingProfile mocks base method.
+func (m *MockDpsServiceServer) DeleteMappingProfile(arg0 context.Context, arg1 *v1.DeleteMappingProfileRequest) (*v1.DeleteMappingProfileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMappingProfile", arg0, arg1)
+	ret0, _ := ret[0].(*v1.DeleteMappingProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMappingProfile indicates an expected call of DeleteMappingProfile.
+func (mr *MockDpsServiceServerMockRecorder) DeleteMappingProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMappingProfile", reflect.TypeOf((*MockDpsServiceServer)(nil).DeleteMappingProfile), arg0, arg1)
+}
+
+// DeleteQualityRule mocks base method.
+func (m *MockDpsServiceServer) DeleteQualityRule(arg0 context.Context, arg1 *v1.DeleteQualityRuleRequest) (*v1.DeleteQualityRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQualityRule", arg0, arg1)
+	ret0, _ := ret[0].(*v1.DeleteQualityRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteQualityRule indicates an expected call of DeleteQualityRule.
+func (mr *MockDpsServiceServerMockRecorder) DeleteQualityRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQualityRule", reflect.TypeOf((*MockDpsServiceServer)(nil).DeleteQualityRule), arg0, arg1)
+}
+
 // DropUploadedFileData mocks base method.
 func (m *MockDpsServiceServer) DropUploadedFileData(arg0 context.Context, arg1 *v1.DropUploadedFileDataRequest) (*v1.DropUploadedFileDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -484,6 +914,21 @@ func (mr *MockDpsServiceServerMockRecorder) GetAnalysisFileInfo(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalysisFileInfo", reflect.TypeOf((*MockDpsServiceServer)(nil).GetAnalysisFileInfo), arg0, arg1)
 }
 
+// ListConnectors mocks base method.
+func (m *MockDpsServiceServer) ListConnectors(arg0 context.Context, arg1 *v1.ListConnectorsRequest) (*v1.ListConnectorsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConnectors", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ListConnectorsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConnectors indicates an expected call of ListConnectors.
+func (mr *MockDpsServiceServerMockRecorder) ListConnectors(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConnectors", reflect.TypeOf((*MockDpsServiceServer)(nil).ListConnectors), arg0, arg1)
+}
+
 // ListDeletionRecords mocks base method.
 func (m *MockDpsServiceServer) ListDeletionRecords(arg0 context.Context, arg1 *v1.ListDeletionRequest) (*v1.ListDeletionResponse, error) {
 	m.ctrl.T.Helper()
@@ -514,6 +959,51 @@ func (mr *MockDpsServiceServerMockRecorder) ListFailedRecord(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFailedRecord", reflect.TypeOf((*MockDpsServiceServer)(nil).ListFailedRecord), arg0, arg1)
 }
 
+// ListInjections mocks base method.
+func (m *MockDpsServiceServer) ListInjections(arg0 context.Context, arg1 *v1.ListInjectionsRequest) (*v1.ListInjectionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInjections", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ListInjectionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInjections indicates an expected call of ListInjections.
+func (mr *MockDpsServiceServerMockRecorder) ListInjections(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInjections", reflect.TypeOf((*MockDpsServiceServer)(nil).ListInjections), arg0, arg1)
+}
+
+// ListMappingProfiles mocks base method.
+func (m *MockDpsServiceServer) ListMappingProfiles(arg0 context.Context, arg1 *v1.ListMappingProfilesRequest) (*v1.ListMappingProfilesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMappingProfiles", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ListMappingProfilesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMappingProfiles indicates an expected call of ListMappingProfiles.
+func (mr *MockDpsServiceServerMockRecorder) ListMappingProfiles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMappingProfiles", reflect.TypeOf((*MockDpsServiceServer)(nil).ListMappingProfiles), arg0, arg1)
+}
+
+// ListQualityRules mocks base method.
+func (m *MockDpsServiceServer) ListQualityRules(arg0 context.Context, arg1 *v1.ListQualityRulesRequest) (*v1.ListQualityRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQualityRules", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ListQualityRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQualityRules indicates an expected call of ListQualityRules.
+func (mr *MockDpsServiceServerMockRecorder) ListQualityRules(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQualityRules", reflect.TypeOf((*MockDpsServiceServer)(nil).ListQualityRules), arg0, arg1)
+}
+
 // ListUploadData mocks base method.
 func (m *MockDpsServiceServer) ListUploadData(arg0 context.Context, arg1 *v1.ListUploadRequest) (*v1.ListUploadResponse, error) {
 	m.ctrl.T.Helper()
@@ -574,6 +1064,66 @@ func (mr *MockDpsServiceServerMockRecorder) NotifyUpload(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyUpload", reflect.TypeOf((*MockDpsServiceServer)(nil).NotifyUpload), arg0, arg1)
 }
 
+// PreviewUpload mocks base method.
+func (m *MockDpsServiceServer) PreviewUpload(arg0 context.Context, arg1 *v1.PreviewUploadRequest) (*v1.PreviewUploadResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewUpload", arg0, arg1)
+	ret0, _ := ret[0].(*v1.PreviewUploadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewUpload indicates an expected call of PreviewUpload.
+func (mr *MockDpsServiceServerMockRecorder) PreviewUpload(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewUpload", reflect.TypeOf((*MockDpsServiceServer)(nil).PreviewUpload), arg0, arg1)
+}
+
+// RetryFailedRecords mocks base method.
+func (m *MockDpsServiceServer) RetryFailedRecords(arg0 context.Context, arg1 *v1.RetryFailedRecordsRequest) (*v1.RetryFailedRecordsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryFailedRecords", arg0, arg1)
+	ret0, _ := ret[0].(*v1.RetryFailedRecordsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryFailedRecords indicates an expected call of RetryFailedRecords.
+func (mr *MockDpsServiceServerMockRecorder) RetryFailedRecords(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryFailedRecords", reflect.TypeOf((*MockDpsServiceServer)(nil).RetryFailedRecords), arg0, arg1)
+}
+
+// RollbackInjection mocks base method.
+func (m *MockDpsServiceServer) RollbackInjection(arg0 context.Context, arg1 *v1.RollbackInjectionRequest) (*v1.RollbackInjectionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackInjection", arg0, arg1)
+	ret0, _ := ret[0].(*v1.RollbackInjectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackInjection indicates an expected call of RollbackInjection.
+func (mr *MockDpsServiceServerMockRecorder) RollbackInjection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackInjection", reflect.TypeOf((*MockDpsServiceServer)(nil).RollbackInjection), arg0, arg1)
+}
+
+// RunConnector mocks base method.
+func (m *MockDpsServiceServer) RunConnector(arg0 context.Context, arg1 *v1.RunConnectorRequest) (*v1.RunConnectorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunConnector", arg0, arg1)
+	ret0, _ := ret[0].(*v1.RunConnectorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunConnector indicates an expected call of RunConnector.
+func (mr *MockDpsServiceServerMockRecorder) RunConnector(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunConnector", reflect.TypeOf((*MockDpsServiceServer)(nil).RunConnector), arg0, arg1)
+}
+
 // StoreCoreFactorReference mocks base method.
 func (m *MockDpsServiceServer) StoreCoreFactorReference(arg0 context.Context, arg1 *v1.StoreReferenceDataRequest) (*v1.StoreReferenceDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -589,6 +1139,51 @@ func (mr *MockDpsServiceServerMockRecorder) StoreCoreFactorReference(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreCoreFactorReference", reflect.TypeOf((*MockDpsServiceServer)(nil).StoreCoreFactorReference), arg0, arg1)
 }
 
+// UpdateConnector mocks base method.
+func (m *MockDpsServiceServer) UpdateConnector(arg0 context.Context, arg1 *v1.Connector) (*v1.Connector, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConnector", arg0, arg1)
+	ret0, _ := ret[0].(*v1.Connector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateConnector indicates an expected call of UpdateConnector.
+func (mr *MockDpsServiceServerMockRecorder) UpdateConnector(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConnector", reflect.TypeOf((*MockDpsServiceServer)(nil).UpdateConnector), arg0, arg1)
+}
+
+// UpdateMappingProfile mocks base method.
+func (m *MockDpsServiceServer) UpdateMappingProfile(arg0 context.Context, arg1 *v1.MappingProfile) (*v1.MappingProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMappingProfile", arg0, arg1)
+	ret0, _ := ret[0].(*v1.MappingProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMappingProfile indicates an expected call of UpdateMappingProfile.
+func (mr *MockDpsServiceServerMockRecorder) UpdateMappingProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMappingProfile", reflect.TypeOf((*MockDpsServiceServer)(nil).UpdateMappingProfile), arg0, arg1)
+}
+
+// UpdateQualityRule mocks base method.
+func (m *MockDpsServiceServer) UpdateQualityRule(arg0 context.Context, arg1 *v1.QualityRule) (*v1.QualityRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQualityRule", arg0, arg1)
+	ret0, _ := ret[0].(*v1.QualityRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateQualityRule indicates an expected call of UpdateQualityRule.
+func (mr *MockDpsServiceServerMockRecorder) UpdateQualityRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQualityRule", reflect.TypeOf((*MockDpsServiceServer)(nil).UpdateQualityRule), arg0, arg1)
+}
+
 // ViewCoreFactorLogs mocks base method.
 func (m *MockDpsServiceServer) ViewCoreFactorLogs(arg0 context.Context, arg1 *v1.ViewCoreFactorLogsRequest) (*v1.ViewCoreFactorLogsResponse, error) {
 	m.ctrl.T.Helper()
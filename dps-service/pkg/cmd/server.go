@@ -38,6 +38,7 @@ import (
 	"go.uber.org/zap"
 
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/admin"
 
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -267,16 +268,23 @@ func RunServer() error {
 		logger.Log.Fatal("Failed to get verify key")
 	}
 
+	apiKeys := iam.NewAPIKeyStore(cfg.IAM)
+
 	// This is one time
 	cron.ConfigInit(cfg.Cron)
 
 	// cron Job
-	cronJob.Init(*Queue, fmt.Sprintf("http://%s/api/v1/token", cfg.HTTPServers.Address["auth"]), cfg.FilesLocation, cfg.ArchiveLocation, cfg.RawdataLocation, v1API, verifyKey, cfg.IAM.APIKey, dbObj, cfg.WaitLimitCount, cfg.Application)
+	cronJob.Init(*Queue, cfg.FilesLocation, cfg.ArchiveLocation, cfg.RawdataLocation, v1API, dbObj, cfg.WaitLimitCount, cfg.Nifi, v1.NewGlobalFileChecker(dbObj), cfg.Application, apiKeys)
 
 	// Below command will trigger the cron job as soon as the service starts
 	cronJob.Job()
 	cron.AddCronJob(cronJob.Job)
 
+	// connectors pull the inventory of the scopes on their own schedules
+	if err := v1.StartConnectors(ctx, v1API, cronJob.CronContext); err != nil {
+		logger.Log.Error("Failed to schedule connectors", zap.Error(err))
+	}
+
 	// get Authorization Policy
 	authZPolicies, err := iam.NewOPA(ctx, cfg.IAM.RegoPath)
 	if err != nil {
@@ -289,9 +297,14 @@ func RunServer() error {
 		}
 	}()
 
+	auditPublisher, err := iam.NewAuditPublisher(cfg.IAM)
+	if err != nil {
+		logger.Log.Fatal("Failed to create audit publisher", zap.Error(err))
+	}
+	revoked := iam.NewRevocationList(cfg.IAM)
 	go func() {
-		_ = rest.RunServer(ctx, cfg.GRPCPort, cfg.HTTPPort, verifyKey)
+		_ = rest.RunServer(ctx, cfg.GRPCPort, cfg.HTTPPort, verifyKey, revoked, apiKeys)
 	}()
 
-	return grpc.RunServer(ctx, v1API, cfg.GRPCPort, verifyKey, authZPolicies, cfg.IAM.APIKey)
+	return grpc.RunServer(ctx, v1API, admin.NewServer(Queue), cfg.GRPCPort, verifyKey, authZPolicies, cfg.IAM.APIKey, revoked, apiKeys, auditPublisher)
 }
@@ -1,7 +1,6 @@
 package config
 
 import (
-	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/config"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/cron"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/grpc"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/iam"
@@ -97,8 +96,27 @@ type Config struct {
 	// WaitlimitCount is max limit counter for global file not processed/missed
 	WaitLimitCount int
 
+	// Nifi leaves the global files to NiFi, they are split by the service otherwise
+	Nifi bool
+
+	// StreamBatchSize is the number of rows of a streamed csv file injected at once
+	StreamBatchSize int
+
+	// Connectors limits what the pull connectors may reach
+	Connectors ConnectorsConfig
+
 	//Application cred.
-	Application config.Application
+	Application ApplicationConfig
+}
+
+// ConnectorsConfig limits what the pull connectors may reach
+type ConnectorsConfig struct {
+	// DirectoryRoot holds a directory per scope, the directory connectors read under the one of their scope
+	DirectoryRoot string
+	// AllowedHosts are the hosts, with their port if any, the REST connectors may call
+	AllowedHosts []string
+	// SecretKey is the base64 AES key encrypting the credentials of the connectors at rest
+	SecretKey string
 }
 
 type httpConfg struct {
@@ -111,6 +129,12 @@ type Server struct {
 	Port string
 }
 
+// ApplicationConfig holds the service account the service calls the other services with
+type ApplicationConfig struct {
+	// APIKey is the personal api key of the service account
+	APIKey string
+}
+
 // InstrumentationConfig represents the instrumentation related configuration.
 type InstrumentationConfig struct {
 	// Instrumentation HTTP server address
@@ -142,6 +166,10 @@ func (c Config) Validate() error {
 		return errors.New("environment is required")
 	}
 
+	if c.Application.APIKey == "" {
+		return errors.New("application.apikey is required")
+	}
+
 	if err := c.Instrumentation.Validate(); err != nil {
 		return err
 	}
@@ -189,6 +217,7 @@ func Configure(v *viper.Viper, p *pflag.FlagSet) {
 	v.SetDefault("environment", "production")
 	v.SetDefault("debug", false)
 	v.SetDefault("shutdownTimeout", 15*time.Second)
+	v.SetDefault("streamBatchSize", 1000)
 
 	// Log configuration
 	v.SetDefault("log.LogLevel", -1)
@@ -219,14 +248,12 @@ func Configure(v *viper.Viper, p *pflag.FlagSet) {
 	_ = v.BindEnv("database.migration.version", "MIG_VERSION")
 	_ = v.BindEnv("database.migration.direction", "MIG_DIR")
 
+	// Connectors secret key
+	_ = v.BindEnv("connectors.secretkey", "CONNECTORS_SECRET_KEY")
+
 	// PKI configuration
 	v.SetDefault("pki.publickeypath", ".")
 
-	_ = v.BindEnv("application.usernameadmin", "APP_ADMIN_USERNAME")
-	_ = v.BindEnv("application.passwordadmin", "APP_ADMIN_PASSWORD")
-	_ = v.BindEnv("application.usernamesuperadmin", "APP_SUPER_ADMIN_USERNAME")
-	_ = v.BindEnv("application.passwordsuperadmin", "APP_SUPER_ADMIN_PASSWORD")
-	_ = v.BindEnv("application.usernameuser", "APP_USER_USERNAME")
-	_ = v.BindEnv("application.passworduser", "APP_USER_PASSWORD")
+	_ = v.BindEnv("application.apikey", "APP_API_KEY")
 
 }
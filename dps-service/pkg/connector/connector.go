@@ -0,0 +1,221 @@
+package connector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/cron"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"go.uber.org/zap"
+)
+
+// Run statuses of a connector
+const (
+	StatusSuccess string = "SUCCESS"
+	StatusEmpty   string = "EMPTY"
+	StatusFailed  string = "FAILED"
+)
+
+// Source pulls the records of a connector into files of dir,
+// name gives the name of a pulled file from its extension.
+type Source interface {
+	Pull(ctx context.Context, dir string, name func(ext string) string) ([]string, error)
+}
+
+// SQLConfig is the stored source of a SQL connector
+type SQLConfig struct {
+	Driver string `json:"driver"`
+	DSN    string `json:"dsn"`
+	Query  string `json:"query"`
+}
+
+// RESTConfig is the stored source of a REST connector
+type RESTConfig struct {
+	URL           string            `json:"url"`
+	Headers       map[string]string `json:"headers,omitempty"`
+	RecordsPath   string            `json:"records_path,omitempty"`
+	NextPath      string            `json:"next_path,omitempty"`
+	PageParam     string            `json:"page_param,omitempty"`
+	FirstPage     int               `json:"first_page,omitempty"`
+	PageSizeParam string            `json:"page_size_param,omitempty"`
+	PageSize      int               `json:"page_size,omitempty"`
+	MaxPages      int               `json:"max_pages,omitempty"`
+}
+
+// DirectoryConfig is the stored source of a directory connector
+type DirectoryConfig struct {
+	Path    string `json:"path"`
+	Pattern string `json:"pattern,omitempty"`
+}
+
+// Options limit what the sources of the connectors may reach
+type Options struct {
+	// DirectoryRoot holds a directory per scope, the directory connectors read under the one of their scope
+	DirectoryRoot string
+	// AllowedHosts are the hosts, with their port if any, the REST connectors may call
+	AllowedHosts []string
+	// Sealer decrypts the credentials of the stored sources
+	Sealer *Sealer
+}
+
+// NewSource gives the source stored for the connector
+func NewSource(c db.Connector, opts Options) (Source, error) {
+	data, err := opts.Sealer.OpenSource(c.SourceType, c.Source)
+	if err != nil {
+		return nil, err
+	}
+	switch c.SourceType {
+	case db.ConnectorSourceSQL:
+		cfg := SQLConfig{}
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return nil, err
+		}
+		return &sqlSource{cfg: cfg}, nil
+	case db.ConnectorSourceREST:
+		cfg := RESTConfig{}
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return nil, err
+		}
+		return &restSource{cfg: cfg, hosts: opts.AllowedHosts}, nil
+	case db.ConnectorSourceDIRECTORY:
+		cfg := DirectoryConfig{}
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return nil, err
+		}
+		if cfg.Path, err = DirectoryPath(opts.DirectoryRoot, c.Scope, cfg.Path); err != nil {
+			return nil, err
+		}
+		return &directorySource{cfg: cfg}, nil
+	}
+	return nil, fmt.Errorf("unknown connector source %s", c.SourceType)
+}
+
+// Pull writes the records of the connector into files of dir named after its scope and file type,
+// no file is written when the source has no record.
+func Pull(ctx context.Context, c db.Connector, dir string, opts Options) ([]string, error) {
+	src, err := NewSource(c, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	stamp := time.Now().UnixNano()
+	n := 0
+	// the file worker reads the file type after the scope, the prefix keeps the pulls apart
+	name := func(ext string) string {
+		n++
+		return fmt.Sprintf("%d-%d_%s_%s%s", stamp, n, c.Scope, strings.ToLower(c.FileType), strings.ToLower(ext))
+	}
+	return src.Pull(ctx, dir, name)
+}
+
+// Scheduler pulls the enabled connectors on their schedules, a connector is not pulled twice at once by the process.
+// Every replica schedules the connectors, the pulls are claimed in the database by a single one.
+type Scheduler struct {
+	cron    *cron.Scheduler
+	run     func(scope, name string)
+	mu      sync.Mutex
+	running map[string]bool
+	specs   map[string]string
+}
+
+// NewScheduler gives a scheduler calling run for the connectors due
+func NewScheduler(run func(scope, name string)) *Scheduler {
+	return &Scheduler{
+		cron:    cron.NewScheduler(),
+		run:     run,
+		running: make(map[string]bool),
+		specs:   make(map[string]string),
+	}
+}
+
+// Schedule (re)schedules the connector when its schedule changed, a disabled connector is removed from the schedules
+func (s *Scheduler) Schedule(c db.Connector) error {
+	if !c.Enabled {
+		s.Remove(c.Scope, c.Name)
+		return nil
+	}
+	key := connectorKey(c.Scope, c.Name)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.specs[key] == c.Schedule {
+		return nil
+	}
+	scope, name := c.Scope, c.Name
+	if err := s.cron.Schedule(key, c.Schedule, func() {
+		s.run(scope, name)
+	}); err != nil {
+		return err
+	}
+	s.specs[key] = c.Schedule
+	return nil
+}
+
+// Remove stops pulling the connector
+func (s *Scheduler) Remove(scope, name string) {
+	key := connectorKey(scope, name)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cron.Remove(key)
+	delete(s.specs, key)
+}
+
+// Begin marks the connector as pulled, it is false when a pull of the connector is running
+func (s *Scheduler) Begin(scope, name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := connectorKey(scope, name)
+	if s.running[key] {
+		return false
+	}
+	s.running[key] = true
+	return true
+}
+
+// End marks the pull of the connector as done
+func (s *Scheduler) End(scope, name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.running, connectorKey(scope, name))
+}
+
+// Load schedules exactly the enabled connectors, the connectors deleted or disabled by another replica are removed
+func (s *Scheduler) Load(connectors []db.Connector) {
+	enabled := make(map[string]bool, len(connectors))
+	for _, c := range connectors {
+		enabled[connectorKey(c.Scope, c.Name)] = true
+		if err := s.Schedule(c); err != nil {
+			logger.Log.Error("Failed to schedule connector", zap.String("scope", c.Scope), zap.String("name", c.Name), zap.Error(err))
+		}
+	}
+	s.mu.Lock()
+	var removed []string
+	for key := range s.specs {
+		if !enabled[key] {
+			removed = append(removed, key)
+		}
+	}
+	s.mu.Unlock()
+	for _, key := range removed {
+		scope, name := splitKey(key)
+		s.Remove(scope, name)
+	}
+}
+
+func connectorKey(scope, name string) string {
+	return scope + "/" + name
+}
+
+func splitKey(key string) (scope, name string) {
+	parts := strings.SplitN(key, "/", 2)
+	return parts[0], parts[1]
+}
@@ -0,0 +1,140 @@
+package connector
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/constants"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"go.uber.org/zap"
+)
+
+// processedDir is the subdirectory of the drop where the pulled files are moved
+const processedDir = "processed"
+
+// directorySource pulls the data files dropped in a directory
+type directorySource struct {
+	cfg DirectoryConfig
+}
+
+// DirectoryPath gives the directory read by a directory connector of the scope,
+// the path is relative to the directory of the scope under the root and cannot leave it.
+func DirectoryPath(root, scope, path string) (string, error) {
+	if root == "" {
+		return "", errors.New("connector directory root is not configured")
+	}
+	if filepath.IsAbs(path) {
+		return "", errors.New("directory path must be relative to the directory of the scope")
+	}
+	scopeDir := filepath.Join(root, scope)
+	dir := filepath.Join(scopeDir, path)
+	if !within(scopeDir, dir) {
+		return "", errors.New("directory path leaves the directory of the scope")
+	}
+	// the links are followed once the directory exists
+	if realDir, err := filepath.EvalSymlinks(dir); err == nil {
+		realScopeDir, err := filepath.EvalSymlinks(scopeDir)
+		if err != nil || !within(realScopeDir, realDir) {
+			return "", errors.New("directory path leaves the directory of the scope")
+		}
+	}
+	return dir, nil
+}
+
+// ValidatePattern checks the file pattern of a directory connector, it matches the names of the files of the directory only
+func ValidatePattern(pattern string) error {
+	if strings.ContainsRune(pattern, '/') || strings.ContainsRune(pattern, filepath.Separator) || pattern == ".." {
+		return errors.New("file pattern must match file names of the directory")
+	}
+	if _, err := filepath.Match(pattern, ""); err != nil {
+		return errors.New("invalid file pattern")
+	}
+	return nil
+}
+
+func within(root, dir string) bool {
+	rel, err := filepath.Rel(root, dir)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func (s *directorySource) Pull(ctx context.Context, dir string, name func(ext string) string) ([]string, error) {
+	pattern := s.cfg.Pattern
+	if pattern == "" {
+		pattern = "*"
+	}
+	if err := ValidatePattern(pattern); err != nil {
+		return nil, err
+	}
+	matches, err := filepath.Glob(filepath.Join(s.cfg.Path, pattern))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(matches)
+	var files []string
+	for _, src := range matches {
+		if err := ctx.Err(); err != nil {
+			return files, err
+		}
+		// the links could lead out of the directory
+		info, err := os.Lstat(src)
+		if err != nil || !info.Mode().IsRegular() {
+			continue
+		}
+		if !supportedFile(src) {
+			logger.Log.Warn("Connector skipped a file which is not a data file", zap.String("file", src))
+			continue
+		}
+		fileName := name(filepath.Ext(src))
+		if err := copyFile(src, filepath.Join(dir, fileName)); err != nil {
+			return files, err
+		}
+		// the file is pulled only once
+		if err := moveToProcessed(src); err != nil {
+			os.Remove(filepath.Join(dir, fileName))
+			return files, err
+		}
+		files = append(files, fileName)
+	}
+	return files, nil
+}
+
+func supportedFile(file string) bool {
+	switch strings.ToUpper(filepath.Ext(file)) {
+	case constants.FileExtension, constants.JSONExtension, constants.NDJSONExtension, constants.ParquetExtension:
+		return true
+	}
+	return false
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(dst)
+		return err
+	}
+	return out.Close()
+}
+
+func moveToProcessed(src string) error {
+	processed := filepath.Join(filepath.Dir(src), processedDir)
+	if err := os.MkdirAll(processed, os.ModePerm); err != nil {
+		return err
+	}
+	return os.Rename(src, filepath.Join(processed, filepath.Base(src)))
+}
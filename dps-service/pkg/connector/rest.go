@@ -0,0 +1,207 @@
+package connector
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultMaxPages = 100
+	restTimeout     = time.Minute
+)
+
+// restSource pulls the json records of an endpoint, following its next page url or its page number,
+// only the allowed hosts are called.
+type restSource struct {
+	cfg    RESTConfig
+	hosts  []string
+	client *http.Client
+}
+
+// ValidateURL checks that the url of a REST connector is an http url of one of the allowed hosts
+func ValidateURL(rawURL string, hosts []string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("url scheme %q is not http", u.Scheme)
+	}
+	for _, host := range hosts {
+		if strings.EqualFold(host, u.Host) || strings.EqualFold(host, u.Hostname()) {
+			return nil
+		}
+	}
+	return fmt.Errorf("host %q is not allowed", u.Host)
+}
+
+func (s *restSource) Pull(ctx context.Context, dir string, name func(ext string) string) ([]string, error) {
+	client := &http.Client{Timeout: restTimeout}
+	if s.client != nil {
+		*client = *s.client
+	}
+	// the redirections are allowed hosts too
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) >= 10 {
+			return errors.New("too many redirects")
+		}
+		return ValidateURL(req.URL.String(), s.hosts)
+	}
+	maxPages := s.cfg.MaxPages
+	if maxPages <= 0 {
+		maxPages = defaultMaxPages
+	}
+	var records []map[string]interface{}
+	next, page := s.cfg.URL, s.cfg.FirstPage
+	for i := 0; next != ""; i++ {
+		if i == maxPages {
+			logger.Log.Warn("Connector stopped at the page limit", zap.String("url", s.cfg.URL), zap.Int("pages", maxPages))
+			break
+		}
+		pageURL, err := s.pageURL(next, page)
+		if err != nil {
+			return nil, err
+		}
+		if err := ValidateURL(pageURL, s.hosts); err != nil {
+			return nil, err
+		}
+		body, err := s.get(ctx, client, pageURL)
+		if err != nil {
+			return nil, err
+		}
+		pageRecords, err := restRecords(body, s.cfg.RecordsPath)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, pageRecords...)
+		switch {
+		case s.cfg.NextPath != "":
+			next, err = nextURL(pageURL, lookup(body, s.cfg.NextPath))
+			if err != nil {
+				return nil, err
+			}
+		case s.cfg.PageParam != "" && len(pageRecords) > 0 && (s.cfg.PageSize <= 0 || len(pageRecords) >= s.cfg.PageSize):
+			page++
+		default:
+			next = ""
+		}
+	}
+	columns, rows := recordsTable(records)
+	return writeTable(dir, name, columns, rows)
+}
+
+// pageURL sets the page number and size of the request when the endpoint is paged by number,
+// the next page urls given by the endpoint are used as they are
+func (s *restSource) pageURL(next string, page int) (string, error) {
+	u, err := url.Parse(next)
+	if err != nil {
+		return "", err
+	}
+	if s.cfg.PageParam == "" || s.cfg.NextPath != "" {
+		return u.String(), nil
+	}
+	q := u.Query()
+	q.Set(s.cfg.PageParam, strconv.Itoa(page))
+	if s.cfg.PageSizeParam != "" && s.cfg.PageSize > 0 {
+		q.Set(s.cfg.PageSizeParam, strconv.Itoa(s.cfg.PageSize))
+	}
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+func (s *restSource) get(ctx context.Context, client *http.Client, pageURL string) (interface{}, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	for k, v := range s.cfg.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("%s answered %d", req.URL.Host, resp.StatusCode)
+	}
+	var body interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&body); err != nil {
+		return nil, fmt.Errorf("%s answered no json: %v", req.URL.Host, err)
+	}
+	return body, nil
+}
+
+// restRecords gives the records at the dotted path of the body
+func restRecords(body interface{}, path string) ([]map[string]interface{}, error) {
+	val := lookup(body, path)
+	if val == nil {
+		return nil, nil
+	}
+	list, ok := val.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("records at %q are not a list", path)
+	}
+	records := make([]map[string]interface{}, 0, len(list))
+	for _, item := range list {
+		rec, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("record at %q is not an object", path)
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+// lookup gives the value at the dotted path of the body, the body itself for an empty path
+func lookup(body interface{}, path string) interface{} {
+	if path == "" {
+		return body
+	}
+	val := body
+	for _, key := range strings.Split(path, ".") {
+		obj, ok := val.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		val = obj[key]
+	}
+	return val
+}
+
+// nextURL resolves the url of the next page, empty on the last page
+func nextURL(current string, next interface{}) (string, error) {
+	ref, ok := next.(string)
+	if !ok || ref == "" {
+		return "", nil
+	}
+	base, err := url.Parse(current)
+	if err != nil {
+		return "", err
+	}
+	u, err := base.Parse(ref)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
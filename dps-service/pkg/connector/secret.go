@@ -0,0 +1,168 @@
+package connector
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+)
+
+// Masked replaces the credentials of the sources in the responses, a masked value sent back keeps the stored one
+const Masked = "REDACTED"
+
+// sealedPrefix marks the encrypted values of the stored sources
+const sealedPrefix = "sealed:"
+
+// Errors of the credentials of the sources
+var (
+	ErrNoSecretKey      = errors.New("connector secret key is not configured")
+	ErrMaskedCredential = errors.New("masked credential without a stored one")
+)
+
+// Sealer encrypts the credentials of the connector sources at rest with AES-GCM
+type Sealer struct {
+	aead cipher.AEAD
+}
+
+// NewSealer gives the sealer of the base64 encoded AES key, no sealer is given without a key
+func NewSealer(key string) (*Sealer, error) {
+	if key == "" {
+		return nil, nil
+	}
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Sealer{aead: aead}, nil
+}
+
+// Seal encrypts the value, an empty value is kept empty
+func (s *Sealer) Seal(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	if s == nil {
+		return "", ErrNoSecretKey
+	}
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	return sealedPrefix + base64.StdEncoding.EncodeToString(s.aead.Seal(nonce, nonce, []byte(value), nil)), nil
+}
+
+// Open decrypts a value given by Seal, a value stored before the credentials were sealed is given as it is
+func (s *Sealer) Open(value string) (string, error) {
+	if !strings.HasPrefix(value, sealedPrefix) {
+		return value, nil
+	}
+	if s == nil {
+		return "", ErrNoSecretKey
+	}
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, sealedPrefix))
+	if err != nil {
+		return "", err
+	}
+	size := s.aead.NonceSize()
+	if len(raw) < size {
+		return "", errors.New("sealed value is too short")
+	}
+	plain, err := s.aead.Open(nil, raw[:size], raw[size:], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
+
+// SealSource encrypts the credentials of the source before it is stored,
+// the masked credentials are replaced by the ones of the stored source.
+func (s *Sealer) SealSource(sourceType db.ConnectorSource, data, stored json.RawMessage) (json.RawMessage, error) {
+	switch sourceType {
+	case db.ConnectorSourceSQL:
+		cfg, old := SQLConfig{}, SQLConfig{}
+		if err := unmarshalSources(data, &cfg, stored, &old); err != nil {
+			return nil, err
+		}
+		var err error
+		if cfg.DSN == Masked {
+			if old.DSN == "" {
+				return nil, ErrMaskedCredential
+			}
+			cfg.DSN = old.DSN
+		} else if cfg.DSN, err = s.Seal(cfg.DSN); err != nil {
+			return nil, err
+		}
+		return json.Marshal(cfg)
+	case db.ConnectorSourceREST:
+		cfg, old := RESTConfig{}, RESTConfig{}
+		if err := unmarshalSources(data, &cfg, stored, &old); err != nil {
+			return nil, err
+		}
+		for k, v := range cfg.Headers {
+			var err error
+			if v == Masked {
+				if old.Headers[k] == "" {
+					return nil, ErrMaskedCredential
+				}
+				cfg.Headers[k] = old.Headers[k]
+			} else if cfg.Headers[k], err = s.Seal(v); err != nil {
+				return nil, err
+			}
+		}
+		return json.Marshal(cfg)
+	}
+	return data, nil
+}
+
+// OpenSource decrypts the credentials of the stored source
+func (s *Sealer) OpenSource(sourceType db.ConnectorSource, data json.RawMessage) (json.RawMessage, error) {
+	switch sourceType {
+	case db.ConnectorSourceSQL:
+		cfg := SQLConfig{}
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return nil, err
+		}
+		var err error
+		if cfg.DSN, err = s.Open(cfg.DSN); err != nil {
+			return nil, err
+		}
+		return json.Marshal(cfg)
+	case db.ConnectorSourceREST:
+		cfg := RESTConfig{}
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return nil, err
+		}
+		for k, v := range cfg.Headers {
+			var err error
+			if cfg.Headers[k], err = s.Open(v); err != nil {
+				return nil, err
+			}
+		}
+		return json.Marshal(cfg)
+	}
+	return data, nil
+}
+
+func unmarshalSources(data json.RawMessage, cfg interface{}, stored json.RawMessage, old interface{}) error {
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return err
+	}
+	if len(stored) == 0 {
+		return nil
+	}
+	return json.Unmarshal(stored, old)
+}
@@ -0,0 +1,66 @@
+package connector
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// sqlSource pulls the rows of a query, the columns are the headers of the file
+type sqlSource struct {
+	cfg SQLConfig
+}
+
+// ValidateDriver checks that the database/sql driver is registered in the service
+func ValidateDriver(driver string) error {
+	for _, d := range sql.Drivers() {
+		if d == driver {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown sql driver %s", driver)
+}
+
+func (s *sqlSource) Pull(ctx context.Context, dir string, name func(ext string) string) ([]string, error) {
+	if err := ValidateDriver(s.cfg.Driver); err != nil {
+		return nil, err
+	}
+	conn, err := sql.Open(s.cfg.Driver, s.cfg.DSN)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	rows, err := conn.QueryContext(ctx, s.cfg.Query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	for i := range columns {
+		columns[i] = strings.ToLower(columns[i])
+	}
+	var table [][]string
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		ptrs := make([]interface{}, len(columns))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+		row := make([]string, len(columns))
+		for i, v := range values {
+			row[i] = cellString(v)
+		}
+		table = append(table, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return writeTable(dir, name, columns, table)
+}
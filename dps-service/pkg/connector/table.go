@@ -0,0 +1,103 @@
+package connector
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/constants"
+)
+
+// cells cannot hold the delimiter nor a line break of the file
+var cellReplacer = strings.NewReplacer(constants.DELIMETER, " ", "\r\n", " ", "\n", " ", "\r", " ")
+
+// writeTable writes the rows as a csv data file, it gives no file when there is no row
+func writeTable(dir string, name func(ext string) string, columns []string, rows [][]string) ([]string, error) {
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	fileName := name(constants.FileExtension)
+	f, err := os.Create(filepath.Join(dir, fileName))
+	if err != nil {
+		return nil, err
+	}
+	w := bufio.NewWriter(f)
+	lines := append([][]string{columns}, rows...)
+	for _, line := range lines {
+		cells := make([]string, len(line))
+		for i, cell := range line {
+			cells[i] = cellReplacer.Replace(cell)
+		}
+		if _, err := fmt.Fprintln(w, strings.Join(cells, constants.DELIMETER)); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	return []string{fileName}, nil
+}
+
+// recordsTable gives the columns of all the records, sorted, and a row per record
+func recordsTable(records []map[string]interface{}) ([]string, [][]string) {
+	keys := make(map[string]bool)
+	for _, rec := range records {
+		for k := range rec {
+			keys[k] = true
+		}
+	}
+	columns := make([]string, 0, len(keys))
+	for k := range keys {
+		columns = append(columns, k)
+	}
+	sort.Strings(columns)
+	rows := make([][]string, 0, len(records))
+	for _, rec := range records {
+		row := make([]string, len(columns))
+		for i, col := range columns {
+			row[i] = cellString(rec[col])
+		}
+		rows = append(rows, row)
+	}
+	return columns, rows
+}
+
+// cellString writes the value as read back by the file worker
+func cellString(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case []byte:
+		return string(val)
+	case json.Number:
+		return val.String()
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(val), 'f', -1, 32)
+	case int64:
+		return strconv.FormatInt(val, 10)
+	case bool:
+		return strconv.FormatBool(val)
+	case time.Time:
+		return val.Format(time.RFC3339)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
@@ -13,6 +13,9 @@ import (
 
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
 	rest_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/rest"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/revocation"
+	wqv1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/api/v1"
 
 	"google.golang.org/protobuf/encoding/protojson"
 
@@ -24,7 +27,7 @@ import (
 )
 
 // RunServer runs HTTP/REST gateway
-func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.PublicKey) error {
+func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.PublicKey, revoked revocation.List, keys apikey.Store) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -49,10 +52,7 @@ func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.Pu
 		Addr: ":" + httpPort,
 		// Handler: &ochttp.Handler{
 		Handler: &ochttp.Handler{Handler: rest_middleware.AddCORS([]string{"*"},
-			// rest_middleware.ValidateAuth(verifyKey,
-			// rest_middleware.AddLogger(logger.Log,
-			muxHTTP),
-		// )},
+			rest_middleware.ValidateAuthWithAPIKeys(verifyKey, revoked, keys, muxHTTP)),
 		},
 	}
 
@@ -95,5 +95,8 @@ func newGateway(ctx context.Context, grpcPort string) (http.Handler, error) {
 	if error := v1.RegisterDpsServiceHandler(ctx, muxGateway, conn); error != nil {
 		return nil, error
 	}
+	if error := wqv1.RegisterWorkerQueueAdminHandler(ctx, muxGateway, conn); error != nil {
+		return nil, error
+	}
 	return muxGateway, err
 }
@@ -0,0 +1,283 @@
+package quality
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/constants"
+)
+
+// Entities checked by the rules
+const (
+	Applications string = "applications"
+	Products     string = "products"
+	Equipments   string = "equipments"
+	AcqRights    string = "acqrights"
+)
+
+// Operators of the rules
+const (
+	Required  string = "required"
+	Range     string = "range"
+	OneOf     string = "one_of"
+	Regex     string = "regex"
+	InCatalog string = "in_catalog"
+)
+
+// Params is the stored configuration of the operator of a rule
+type Params struct {
+	Min     *float64 `json:"min,omitempty"`
+	Max     *float64 `json:"max,omitempty"`
+	Values  []string `json:"values,omitempty"`
+	Pattern string   `json:"pattern,omitempty"`
+}
+
+// aliases gives per entity the headers of the analysis sheets naming the same field as the injected files
+var aliases = map[string]map[string][]string{
+	Products:     {"name": {"product_name"}, "version": {"product_version"}, "editor": {"product_editor"}},
+	Applications: {"name": {"application_name"}},
+	AcqRights:    {"editor": {"product_editor"}, "maintenance_licenses": {"maintenance_licences"}, "last_purchased_order": {"last_po"}, "corporate_sourcing_contract": {"csc"}},
+}
+
+// Rule is a compiled rule of a scope
+type Rule struct {
+	Name     string
+	Entity   string
+	Field    string
+	Operator string
+	Params   Params
+	Severity db.RuleSeverity
+	Message  string
+	values   map[string]bool
+	re       *regexp.Regexp
+}
+
+// Violation is a rule broken by a record, Field is the header of the record holding the value
+type Violation struct {
+	Rule  *Rule
+	Field string
+}
+
+// IsError tells whether the record breaking the rule is rejected
+func (v Violation) IsError() bool {
+	return v.Rule.Severity == db.RuleSeverityERROR
+}
+
+// Set is the set of the enabled rules of a scope
+type Set struct {
+	rules   map[string][]*Rule
+	editors map[string]bool
+}
+
+// Validate checks the params of an operator
+func Validate(operator string, params Params) error {
+	switch operator {
+	case Required, InCatalog:
+	case Range:
+		if params.Min == nil && params.Max == nil {
+			return errors.New("min or max is missing")
+		}
+		if params.Min != nil && params.Max != nil && *params.Min > *params.Max {
+			return errors.New("min is greater than max")
+		}
+	case OneOf:
+		if len(params.Values) == 0 {
+			return errors.New("values are missing")
+		}
+	case Regex:
+		if _, err := regexp.Compile(params.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern: %v", err)
+		}
+	default:
+		return fmt.Errorf("unknown operator %s", operator)
+	}
+	return nil
+}
+
+// New compiles the stored rules of a scope
+func New(rules []db.QualityRule) (*Set, error) {
+	s := &Set{rules: make(map[string][]*Rule)}
+	for _, r := range rules {
+		rule := &Rule{
+			Name:     r.Name,
+			Entity:   r.Entity,
+			Field:    r.Field,
+			Operator: r.Operator,
+			Severity: r.Severity,
+			Message:  r.Description,
+		}
+		if len(r.Params) > 0 {
+			if err := json.Unmarshal(r.Params, &rule.Params); err != nil {
+				return nil, fmt.Errorf("rule %s: %v", r.Name, err)
+			}
+		}
+		if err := Validate(rule.Operator, rule.Params); err != nil {
+			return nil, fmt.Errorf("rule %s: %v", r.Name, err)
+		}
+		switch rule.Operator {
+		case OneOf:
+			rule.values = make(map[string]bool, len(rule.Params.Values))
+			for _, v := range rule.Params.Values {
+				rule.values[strings.ToLower(v)] = true
+			}
+		case Regex:
+			rule.re = regexp.MustCompile(rule.Params.Pattern)
+		}
+		if rule.Message == "" {
+			rule.Message = defaultMessage(rule)
+		}
+		s.rules[rule.Entity] = append(s.rules[rule.Entity], rule)
+	}
+	return s, nil
+}
+
+func defaultMessage(r *Rule) string {
+	switch r.Operator {
+	case Required:
+		return fmt.Sprintf("%s is required", r.Field)
+	case Range:
+		switch {
+		case r.Params.Max == nil:
+			return fmt.Sprintf("%s must be at least %v", r.Field, *r.Params.Min)
+		case r.Params.Min == nil:
+			return fmt.Sprintf("%s must be at most %v", r.Field, *r.Params.Max)
+		}
+		return fmt.Sprintf("%s must be between %v and %v", r.Field, *r.Params.Min, *r.Params.Max)
+	case OneOf:
+		return fmt.Sprintf("%s must be one of %s", r.Field, strings.Join(r.Params.Values, ", "))
+	case Regex:
+		return fmt.Sprintf("%s must match %s", r.Field, r.Params.Pattern)
+	default:
+		return fmt.Sprintf("%s must exist in catalog", r.Field)
+	}
+}
+
+// Rules gives the rules of the entity
+func (s *Set) Rules(entity string) []*Rule {
+	if s == nil {
+		return nil
+	}
+	return s.rules[entity]
+}
+
+// NeedsCatalog tells whether a rule checks the editors of the catalog
+func (s *Set) NeedsCatalog() bool {
+	if s == nil {
+		return false
+	}
+	for _, rules := range s.rules {
+		for _, r := range rules {
+			if r.Operator == InCatalog {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SetCatalog keeps the editors of the catalog checked by the in_catalog rules
+func (s *Set) SetCatalog(editors []string) {
+	s.editors = make(map[string]bool, len(editors))
+	for _, ed := range editors {
+		s.editors[strings.ToLower(strings.TrimSpace(ed))] = true
+	}
+}
+
+// Check gives the rules of the entity broken by the record,
+// the rules of fields absent from the record are skipped as the fields are not given by the file.
+func (s *Set) Check(entity string, record map[string]string) (violations []Violation) {
+	for _, r := range s.Rules(entity) {
+		field, ok := lookup(entity, r.Field, record)
+		if !ok {
+			continue
+		}
+		if !s.holds(r, strings.TrimSpace(record[field])) {
+			violations = append(violations, Violation{Rule: r, Field: field})
+		}
+	}
+	return
+}
+
+func lookup(entity, field string, record map[string]string) (string, bool) {
+	if _, ok := record[field]; ok {
+		return field, true
+	}
+	for _, alias := range aliases[entity][field] {
+		if _, ok := record[alias]; ok {
+			return alias, true
+		}
+	}
+	return "", false
+}
+
+// holds tells whether the value keeps the rule, only the required rules check the empty values
+func (s *Set) holds(r *Rule, val string) bool {
+	if val == "" {
+		return r.Operator != Required
+	}
+	switch r.Operator {
+	case Range:
+		num, err := strconv.ParseFloat(val, 64)
+		return err == nil && (r.Params.Min == nil || num >= *r.Params.Min) && (r.Params.Max == nil || num <= *r.Params.Max)
+	case OneOf:
+		return r.values[strings.ToLower(val)]
+	case Regex:
+		return r.re.MatchString(val)
+	case InCatalog:
+		// without catalog the rule is not checked
+		return s.editors == nil || s.editors[strings.ToLower(val)]
+	}
+	return true
+}
+
+// HasError tells whether one of the violations rejects the record
+func HasError(violations []Violation) bool {
+	for _, v := range violations {
+		if v.IsError() {
+			return true
+		}
+	}
+	return false
+}
+
+// EntityOfFileType gives the entity of the records of an injected file type, empty when no rule applies
+func EntityOfFileType(fileType string) string {
+	switch {
+	case fileType == constants.PRODUCTS:
+		return Products
+	case fileType == constants.APPLICATIONS:
+		return Applications
+	case fileType == constants.ProductsAcquiredRights:
+		return AcqRights
+	case strings.HasPrefix(fileType, "EQUIPMENT_"):
+		return Equipments
+	}
+	return ""
+}
+
+// Tally counts the violations of each rule
+type Tally map[*Rule]int32
+
+// Add counts the violations
+func (t Tally) Add(violations []Violation) {
+	for _, v := range violations {
+		t[v.Rule]++
+	}
+}
+
+// Counts gives the number of violations of the ERROR and of the WARNING rules
+func (t Tally) Counts() (errs, warnings int32) {
+	for r, n := range t {
+		if r.Severity == db.RuleSeverityERROR {
+			errs += n
+		} else {
+			warnings += n
+		}
+	}
+	return
+}
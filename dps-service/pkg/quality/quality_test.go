@@ -0,0 +1,211 @@
+package quality
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testRule(name, entity, field, operator, params string, severity db.RuleSeverity) db.QualityRule {
+	return db.QualityRule{Scope: "scope1", Name: name, Entity: entity, Field: field, Operator: operator, Params: json.RawMessage(params), Severity: severity}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   []db.QualityRule
+		wantErr bool
+	}{
+		{
+			name: "SuccessCaseNoError",
+			rules: []db.QualityRule{
+				testRule("cpu", Equipments, "cpu", Range, `{"min":1,"max":256}`, db.RuleSeverityERROR),
+				testRule("sku", AcqRights, "sku", Regex, `{"pattern":"^[A-Z]+-[0-9]+$"}`, db.RuleSeverityWARNING),
+				testRule("owner", Applications, "owner", Required, ``, db.RuleSeverityWARNING),
+				testRule("ram", Equipments, "ram", Range, `{"min":1}`, db.RuleSeverityERROR),
+				testRule("vcpu", Equipments, "vcpu", Range, `{"max":64}`, db.RuleSeverityERROR),
+			},
+		},
+		{
+			name:    "FailureCaseNoBound",
+			rules:   []db.QualityRule{testRule("cpu", Equipments, "cpu", Range, `{}`, db.RuleSeverityERROR)},
+			wantErr: true,
+		},
+		{
+			name:    "FailureCaseMinGreaterThanMax",
+			rules:   []db.QualityRule{testRule("cpu", Equipments, "cpu", Range, `{"min":10,"max":1}`, db.RuleSeverityERROR)},
+			wantErr: true,
+		},
+		{
+			name:    "FailureCaseUnknownOperator",
+			rules:   []db.QualityRule{testRule("cpu", Equipments, "cpu", "between", `{}`, db.RuleSeverityERROR)},
+			wantErr: true,
+		},
+		{
+			name:    "FailureCaseBadParams",
+			rules:   []db.QualityRule{testRule("cpu", Equipments, "cpu", Range, `{"min":"one"}`, db.RuleSeverityERROR)},
+			wantErr: true,
+		},
+		{
+			name:    "FailureCaseBadPattern",
+			rules:   []db.QualityRule{testRule("sku", AcqRights, "sku", Regex, `{"pattern":"("}`, db.RuleSeverityERROR)},
+			wantErr: true,
+		},
+		{
+			name:    "FailureCaseNoValues",
+			rules:   []db.QualityRule{testRule("domain", Applications, "domain", OneOf, `{}`, db.RuleSeverityERROR)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.rules)
+			assert.Equal(t, tt.wantErr, err != nil, err)
+		})
+	}
+}
+
+func TestSet_Check(t *testing.T) {
+	set, err := New([]db.QualityRule{
+		testRule("cpu", Equipments, "cpu", Range, `{"min":1,"max":256}`, db.RuleSeverityERROR),
+		testRule("ram", Equipments, "ram", Range, `{"min":0.5}`, db.RuleSeverityERROR),
+		testRule("domain", Applications, "domain", OneOf, `{"values":["Finance","HR"]}`, db.RuleSeverityWARNING),
+		testRule("owner", Applications, "owner", Required, `{}`, db.RuleSeverityERROR),
+		testRule("editor", Products, "editor", InCatalog, `{}`, db.RuleSeverityERROR),
+		testRule("sku", AcqRights, "sku", Regex, `{"pattern":"^[A-Z]+-[0-9]+$"}`, db.RuleSeverityWARNING),
+	})
+	if !assert.Empty(t, err) {
+		return
+	}
+	assert.True(t, set.NeedsCatalog())
+	set.SetCatalog([]string{"Oracle", " IBM "})
+	tests := []struct {
+		name   string
+		entity string
+		record map[string]string
+		want   []string
+		isErr  bool
+	}{
+		{
+			name:   "cpu in range",
+			entity: Equipments,
+			record: map[string]string{"cpu": "256"},
+		},
+		{
+			name:   "cpu out of range",
+			entity: Equipments,
+			record: map[string]string{"cpu": "512"},
+			want:   []string{"cpu"},
+			isErr:  true,
+		},
+		{
+			name:   "ram has no max",
+			entity: Equipments,
+			record: map[string]string{"ram": "4096"},
+		},
+		{
+			name:   "ram below min",
+			entity: Equipments,
+			record: map[string]string{"ram": "0.25"},
+			want:   []string{"ram"},
+			isErr:  true,
+		},
+		{
+			name:   "cpu not a number",
+			entity: Equipments,
+			record: map[string]string{"cpu": "many"},
+			want:   []string{"cpu"},
+			isErr:  true,
+		},
+		{
+			name:   "empty cpu is not checked",
+			entity: Equipments,
+			record: map[string]string{"cpu": ""},
+		},
+		{
+			name:   "field not given by the file",
+			entity: Equipments,
+			record: map[string]string{"equipment_id": "e1"},
+		},
+		{
+			name:   "domain is a warning",
+			entity: Applications,
+			record: map[string]string{"domain": "Sales", "owner": "me"},
+			want:   []string{"domain"},
+		},
+		{
+			name:   "domain ignores case, owner required",
+			entity: Applications,
+			record: map[string]string{"domain": "finance", "owner": " "},
+			want:   []string{"owner"},
+			isErr:  true,
+		},
+		{
+			name:   "editor in catalog",
+			entity: Products,
+			record: map[string]string{"editor": "ibm"},
+		},
+		{
+			name:   "analysis header of editor",
+			entity: Products,
+			record: map[string]string{"product_editor": "Microsoft"},
+			want:   []string{"product_editor"},
+			isErr:  true,
+		},
+		{
+			name:   "sku pattern",
+			entity: AcqRights,
+			record: map[string]string{"sku": "sku1"},
+			want:   []string{"sku"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := set.Check(tt.entity, tt.record)
+			var fields []string
+			for _, v := range got {
+				fields = append(fields, v.Field)
+			}
+			assert.Equal(t, tt.want, fields)
+			assert.Equal(t, tt.isErr, HasError(got))
+		})
+	}
+}
+
+func TestSet_CheckWithoutCatalog(t *testing.T) {
+	set, err := New([]db.QualityRule{testRule("editor", Products, "editor", InCatalog, `{}`, db.RuleSeverityERROR)})
+	if !assert.Empty(t, err) {
+		return
+	}
+	assert.Empty(t, set.Check(Products, map[string]string{"editor": "Oracle"}))
+	var none *Set
+	assert.Empty(t, none.Check(Products, map[string]string{"editor": "Oracle"}))
+	assert.False(t, none.NeedsCatalog())
+}
+
+func TestTally(t *testing.T) {
+	set, err := New([]db.QualityRule{
+		testRule("cpu", Equipments, "cpu", Range, `{"min":1,"max":256}`, db.RuleSeverityERROR),
+		testRule("ram", Equipments, "ram", Range, `{"min":1,"max":1024}`, db.RuleSeverityWARNING),
+	})
+	if !assert.Empty(t, err) {
+		return
+	}
+	tally := make(Tally)
+	tally.Add(set.Check(Equipments, map[string]string{"cpu": "0", "ram": "0"}))
+	tally.Add(set.Check(Equipments, map[string]string{"cpu": "0", "ram": "2"}))
+	errs, warnings := tally.Counts()
+	assert.Equal(t, int32(2), errs)
+	assert.Equal(t, int32(1), warnings)
+}
+
+func TestEntityOfFileType(t *testing.T) {
+	assert.Equal(t, Products, EntityOfFileType("PRODUCTS"))
+	assert.Equal(t, Applications, EntityOfFileType("APPLICATIONS"))
+	assert.Equal(t, AcqRights, EntityOfFileType("PRODUCTS_ACQUIREDRIGHTS"))
+	assert.Equal(t, Equipments, EntityOfFileType("EQUIPMENT_SERVER"))
+	assert.Equal(t, "", EntityOfFileType("APPLICATIONS_PRODUCTS"))
+}
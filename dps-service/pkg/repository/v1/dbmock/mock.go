@@ -6,6 +6,7 @@ package mock
 
 import (
 	context "context"
+	json "encoding/json"
 	db "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
 	reflect "reflect"
 
@@ -35,6 +36,79 @@ func (m *MockDps) EXPECT() *MockDpsMockRecorder {
 	return m.recorder
 }
 
+// AbortInjectionFiles mocks base method.
+func (m *MockDps) AbortInjectionFiles(arg0 context.Context, arg1 db.AbortInjectionFilesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortInjectionFiles", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AbortInjectionFiles indicates an expected call of AbortInjectionFiles.
+func (mr *MockDpsMockRecorder) AbortInjectionFiles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortInjectionFiles", reflect.TypeOf((*MockDps)(nil).AbortInjectionFiles), arg0, arg1)
+}
+
+// AddFileTotalRecords mocks base method.
+func (m *MockDps) AddFileTotalRecords(arg0 context.Context, arg1 db.AddFileTotalRecordsParams) (db.AddFileTotalRecordsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFileTotalRecords", arg0, arg1)
+	ret0, _ := ret[0].(db.AddFileTotalRecordsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddFileTotalRecords indicates an expected call of AddFileTotalRecords.
+func (mr *MockDpsMockRecorder) AddFileTotalRecords(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFileTotalRecords", reflect.TypeOf((*MockDps)(nil).AddFileTotalRecords), arg0, arg1)
+}
+
+// ClaimConnectorRun mocks base method.
+func (m *MockDps) ClaimConnectorRun(arg0 context.Context, arg1 db.ClaimConnectorRunParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimConnectorRun", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimConnectorRun indicates an expected call of ClaimConnectorRun.
+func (mr *MockDpsMockRecorder) ClaimConnectorRun(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimConnectorRun", reflect.TypeOf((*MockDps)(nil).ClaimConnectorRun), arg0, arg1)
+}
+
+// CompensationJobDone mocks base method.
+func (m *MockDps) CompensationJobDone(arg0 context.Context, arg1 db.CompensationJobDoneParams) (db.Injection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompensationJobDone", arg0, arg1)
+	ret0, _ := ret[0].(db.Injection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompensationJobDone indicates an expected call of CompensationJobDone.
+func (mr *MockDpsMockRecorder) CompensationJobDone(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompensationJobDone", reflect.TypeOf((*MockDps)(nil).CompensationJobDone), arg0, arg1)
+}
+
+// DeleteConnector mocks base method.
+func (m *MockDps) DeleteConnector(arg0 context.Context, arg1 db.DeleteConnectorParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConnector", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConnector indicates an expected call of DeleteConnector.
+func (mr *MockDpsMockRecorder) DeleteConnector(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConnector", reflect.TypeOf((*MockDps)(nil).DeleteConnector), arg0, arg1)
+}
+
 // DeleteCoreFactorReference mocks base method.
 func (m *MockDps) DeleteCoreFactorReference(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -49,6 +123,132 @@ func (mr *MockDpsMockRecorder) DeleteCoreFactorReference(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCoreFactorReference", reflect.TypeOf((*MockDps)(nil).DeleteCoreFactorReference), arg0)
 }
 
+// DeleteInjectionFileJobs mocks base method.
+func (m *MockDps) DeleteInjectionFileJobs(arg0 context.Context, arg1 db.DeleteInjectionFileJobsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInjectionFileJobs", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteInjectionFileJobs indicates an expected call of DeleteInjectionFileJobs.
+func (mr *MockDpsMockRecorder) DeleteInjectionFileJobs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInjectionFileJobs", reflect.TypeOf((*MockDps)(nil).DeleteInjectionFileJobs), arg0, arg1)
+}
+
+// DeleteInjectionJobs mocks base method.
+func (m *MockDps) DeleteInjectionJobs(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInjectionJobs", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteInjectionJobs indicates an expected call of DeleteInjectionJobs.
+func (mr *MockDpsMockRecorder) DeleteInjectionJobs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInjectionJobs", reflect.TypeOf((*MockDps)(nil).DeleteInjectionJobs), arg0, arg1)
+}
+
+// DeleteInjections mocks base method.
+func (m *MockDps) DeleteInjections(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInjections", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteInjections indicates an expected call of DeleteInjections.
+func (mr *MockDpsMockRecorder) DeleteInjections(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInjections", reflect.TypeOf((*MockDps)(nil).DeleteInjections), arg0, arg1)
+}
+
+// DeleteMappingProfile mocks base method.
+func (m *MockDps) DeleteMappingProfile(arg0 context.Context, arg1 db.DeleteMappingProfileParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMappingProfile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMappingProfile indicates an expected call of DeleteMappingProfile.
+func (mr *MockDpsMockRecorder) DeleteMappingProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMappingProfile", reflect.TypeOf((*MockDps)(nil).DeleteMappingProfile), arg0, arg1)
+}
+
+// DeleteQualityRule mocks base method.
+func (m *MockDps) DeleteQualityRule(arg0 context.Context, arg1 db.DeleteQualityRuleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQualityRule", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQualityRule indicates an expected call of DeleteQualityRule.
+func (mr *MockDpsMockRecorder) DeleteQualityRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQualityRule", reflect.TypeOf((*MockDps)(nil).DeleteQualityRule), arg0, arg1)
+}
+
+// DeleteStreamCheckpoint mocks base method.
+func (m *MockDps) DeleteStreamCheckpoint(arg0 context.Context, arg1 db.DeleteStreamCheckpointParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStreamCheckpoint", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStreamCheckpoint indicates an expected call of DeleteStreamCheckpoint.
+func (mr *MockDpsMockRecorder) DeleteStreamCheckpoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStreamCheckpoint", reflect.TypeOf((*MockDps)(nil).DeleteStreamCheckpoint), arg0, arg1)
+}
+
+// DeleteSyncedRecord mocks base method.
+func (m *MockDps) DeleteSyncedRecord(arg0 context.Context, arg1 db.DeleteSyncedRecordParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSyncedRecord", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSyncedRecord indicates an expected call of DeleteSyncedRecord.
+func (mr *MockDpsMockRecorder) DeleteSyncedRecord(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSyncedRecord", reflect.TypeOf((*MockDps)(nil).DeleteSyncedRecord), arg0, arg1)
+}
+
+// DeleteSyncedRecords mocks base method.
+func (m *MockDps) DeleteSyncedRecords(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSyncedRecords", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSyncedRecords indicates an expected call of DeleteSyncedRecords.
+func (mr *MockDpsMockRecorder) DeleteSyncedRecords(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSyncedRecords", reflect.TypeOf((*MockDps)(nil).DeleteSyncedRecords), arg0, arg1)
+}
+
+// DiscardInjection mocks base method.
+func (m *MockDps) DiscardInjection(arg0 context.Context, arg1 db.DiscardInjectionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscardInjection", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DiscardInjection indicates an expected call of DiscardInjection.
+func (mr *MockDpsMockRecorder) DiscardInjection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscardInjection", reflect.TypeOf((*MockDps)(nil).DiscardInjection), arg0, arg1)
+}
+
 // DropFileRecords mocks base method.
 func (m *MockDps) DropFileRecords(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -63,6 +263,20 @@ func (mr *MockDpsMockRecorder) DropFileRecords(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropFileRecords", reflect.TypeOf((*MockDps)(nil).DropFileRecords), arg0, arg1)
 }
 
+// FinishInjectionCompensation mocks base method.
+func (m *MockDps) FinishInjectionCompensation(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishInjectionCompensation", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishInjectionCompensation indicates an expected call of FinishInjectionCompensation.
+func (mr *MockDpsMockRecorder) FinishInjectionCompensation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishInjectionCompensation", reflect.TypeOf((*MockDps)(nil).FinishInjectionCompensation), arg0, arg1)
+}
+
 // GetActiveGID mocks base method.
 func (m *MockDps) GetActiveGID(arg0 context.Context, arg1 string) (int32, error) {
 	m.ctrl.T.Helper()
@@ -93,6 +307,21 @@ func (mr *MockDpsMockRecorder) GetAllDataFileStatusByGID(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDataFileStatusByGID", reflect.TypeOf((*MockDps)(nil).GetAllDataFileStatusByGID), arg0, arg1)
 }
 
+// GetConnector mocks base method.
+func (m *MockDps) GetConnector(arg0 context.Context, arg1 db.GetConnectorParams) (db.Connector, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConnector", arg0, arg1)
+	ret0, _ := ret[0].(db.Connector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnector indicates an expected call of GetConnector.
+func (mr *MockDpsMockRecorder) GetConnector(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnector", reflect.TypeOf((*MockDps)(nil).GetConnector), arg0, arg1)
+}
+
 // GetCoreFactorList mocks base method.
 func (m *MockDps) GetCoreFactorList(arg0 context.Context) ([]db.CoreFactorReference, error) {
 	m.ctrl.T.Helper()
@@ -138,6 +367,21 @@ func (mr *MockDpsMockRecorder) GetCoreFactorReferences(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCoreFactorReferences", reflect.TypeOf((*MockDps)(nil).GetCoreFactorReferences), arg0, arg1)
 }
 
+// GetDataFile mocks base method.
+func (m *MockDps) GetDataFile(arg0 context.Context, arg1 int32) (db.UploadedDataFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataFile", arg0, arg1)
+	ret0, _ := ret[0].(db.UploadedDataFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataFile indicates an expected call of GetDataFile.
+func (mr *MockDpsMockRecorder) GetDataFile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataFile", reflect.TypeOf((*MockDps)(nil).GetDataFile), arg0, arg1)
+}
+
 // GetDataFileRecords mocks base method.
 func (m *MockDps) GetDataFileRecords(arg0 context.Context, arg1 db.GetDataFileRecordsParams) (db.GetDataFileRecordsRow, error) {
 	m.ctrl.T.Helper()
@@ -183,6 +427,21 @@ func (mr *MockDpsMockRecorder) GetEntityMonthWise(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityMonthWise", reflect.TypeOf((*MockDps)(nil).GetEntityMonthWise), arg0, arg1)
 }
 
+// GetFailedJobs mocks base method.
+func (m *MockDps) GetFailedJobs(arg0 context.Context, arg1 json.RawMessage) ([]db.GetFailedJobsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFailedJobs", arg0, arg1)
+	ret0, _ := ret[0].([]db.GetFailedJobsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFailedJobs indicates an expected call of GetFailedJobs.
+func (mr *MockDpsMockRecorder) GetFailedJobs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailedJobs", reflect.TypeOf((*MockDps)(nil).GetFailedJobs), arg0, arg1)
+}
+
 // GetFailedRecord mocks base method.
 func (m *MockDps) GetFailedRecord(arg0 context.Context, arg1 db.GetFailedRecordParams) ([]db.GetFailedRecordRow, error) {
 	m.ctrl.T.Helper()
@@ -243,6 +502,21 @@ func (mr *MockDpsMockRecorder) GetGlobalFileInfo(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGlobalFileInfo", reflect.TypeOf((*MockDps)(nil).GetGlobalFileInfo), arg0, arg1)
 }
 
+// GetInjection mocks base method.
+func (m *MockDps) GetInjection(arg0 context.Context, arg1 int32) (db.Injection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInjection", arg0, arg1)
+	ret0, _ := ret[0].(db.Injection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInjection indicates an expected call of GetInjection.
+func (mr *MockDpsMockRecorder) GetInjection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInjection", reflect.TypeOf((*MockDps)(nil).GetInjection), arg0, arg1)
+}
+
 // GetInjectionStatus mocks base method.
 func (m *MockDps) GetInjectionStatus(arg0 context.Context, arg1 string) (int64, error) {
 	m.ctrl.T.Helper()
@@ -258,6 +532,81 @@ func (mr *MockDpsMockRecorder) GetInjectionStatus(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInjectionStatus", reflect.TypeOf((*MockDps)(nil).GetInjectionStatus), arg0, arg1)
 }
 
+// GetLatestInjection mocks base method.
+func (m *MockDps) GetLatestInjection(arg0 context.Context, arg1 string) (db.Injection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestInjection", arg0, arg1)
+	ret0, _ := ret[0].(db.Injection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestInjection indicates an expected call of GetLatestInjection.
+func (mr *MockDpsMockRecorder) GetLatestInjection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestInjection", reflect.TypeOf((*MockDps)(nil).GetLatestInjection), arg0, arg1)
+}
+
+// GetMappingProfile mocks base method.
+func (m *MockDps) GetMappingProfile(arg0 context.Context, arg1 db.GetMappingProfileParams) (db.MappingProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMappingProfile", arg0, arg1)
+	ret0, _ := ret[0].(db.MappingProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMappingProfile indicates an expected call of GetMappingProfile.
+func (mr *MockDpsMockRecorder) GetMappingProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMappingProfile", reflect.TypeOf((*MockDps)(nil).GetMappingProfile), arg0, arg1)
+}
+
+// GetQualityResultsMonthWise mocks base method.
+func (m *MockDps) GetQualityResultsMonthWise(arg0 context.Context, arg1 db.GetQualityResultsMonthWiseParams) ([]db.GetQualityResultsMonthWiseRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQualityResultsMonthWise", arg0, arg1)
+	ret0, _ := ret[0].([]db.GetQualityResultsMonthWiseRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQualityResultsMonthWise indicates an expected call of GetQualityResultsMonthWise.
+func (mr *MockDpsMockRecorder) GetQualityResultsMonthWise(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQualityResultsMonthWise", reflect.TypeOf((*MockDps)(nil).GetQualityResultsMonthWise), arg0, arg1)
+}
+
+// GetQualityRule mocks base method.
+func (m *MockDps) GetQualityRule(arg0 context.Context, arg1 db.GetQualityRuleParams) (db.QualityRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQualityRule", arg0, arg1)
+	ret0, _ := ret[0].(db.QualityRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQualityRule indicates an expected call of GetQualityRule.
+func (mr *MockDpsMockRecorder) GetQualityRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQualityRule", reflect.TypeOf((*MockDps)(nil).GetQualityRule), arg0, arg1)
+}
+
+// GetStreamCheckpoint mocks base method.
+func (m *MockDps) GetStreamCheckpoint(arg0 context.Context, arg1 db.GetStreamCheckpointParams) (db.StreamCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStreamCheckpoint", arg0, arg1)
+	ret0, _ := ret[0].(db.StreamCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStreamCheckpoint indicates an expected call of GetStreamCheckpoint.
+func (mr *MockDpsMockRecorder) GetStreamCheckpoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamCheckpoint", reflect.TypeOf((*MockDps)(nil).GetStreamCheckpoint), arg0, arg1)
+}
+
 // GetTransformedGlobalFileInfo mocks base method.
 func (m *MockDps) GetTransformedGlobalFileInfo(arg0 context.Context) ([]db.GetTransformedGlobalFileInfoRow, error) {
 	m.ctrl.T.Helper()
@@ -273,6 +622,121 @@ func (mr *MockDpsMockRecorder) GetTransformedGlobalFileInfo(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransformedGlobalFileInfo", reflect.TypeOf((*MockDps)(nil).GetTransformedGlobalFileInfo), arg0)
 }
 
+// InjectionFileStaged mocks base method.
+func (m *MockDps) InjectionFileStaged(arg0 context.Context, arg1 db.InjectionFileStagedParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectionFileStaged", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InjectionFileStaged indicates an expected call of InjectionFileStaged.
+func (mr *MockDpsMockRecorder) InjectionFileStaged(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectionFileStaged", reflect.TypeOf((*MockDps)(nil).InjectionFileStaged), arg0, arg1)
+}
+
+// InjectionJobDone mocks base method.
+func (m *MockDps) InjectionJobDone(arg0 context.Context, arg1 db.InjectionJobDoneParams) (db.Injection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InjectionJobDone", arg0, arg1)
+	ret0, _ := ret[0].(db.Injection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InjectionJobDone indicates an expected call of InjectionJobDone.
+func (mr *MockDpsMockRecorder) InjectionJobDone(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InjectionJobDone", reflect.TypeOf((*MockDps)(nil).InjectionJobDone), arg0, arg1)
+}
+
+// InsertConnector mocks base method.
+func (m *MockDps) InsertConnector(arg0 context.Context, arg1 db.InsertConnectorParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertConnector", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertConnector indicates an expected call of InsertConnector.
+func (mr *MockDpsMockRecorder) InsertConnector(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertConnector", reflect.TypeOf((*MockDps)(nil).InsertConnector), arg0, arg1)
+}
+
+// InsertInjection mocks base method.
+func (m *MockDps) InsertInjection(arg0 context.Context, arg1 db.InsertInjectionParams) (db.Injection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertInjection", arg0, arg1)
+	ret0, _ := ret[0].(db.Injection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertInjection indicates an expected call of InsertInjection.
+func (mr *MockDpsMockRecorder) InsertInjection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertInjection", reflect.TypeOf((*MockDps)(nil).InsertInjection), arg0, arg1)
+}
+
+// InsertInjectionJob mocks base method.
+func (m *MockDps) InsertInjectionJob(arg0 context.Context, arg1 db.InsertInjectionJobParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertInjectionJob", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertInjectionJob indicates an expected call of InsertInjectionJob.
+func (mr *MockDpsMockRecorder) InsertInjectionJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertInjectionJob", reflect.TypeOf((*MockDps)(nil).InsertInjectionJob), arg0, arg1)
+}
+
+// InsertInjectionSnapshot mocks base method.
+func (m *MockDps) InsertInjectionSnapshot(arg0 context.Context, arg1 db.InsertInjectionSnapshotParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertInjectionSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertInjectionSnapshot indicates an expected call of InsertInjectionSnapshot.
+func (mr *MockDpsMockRecorder) InsertInjectionSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertInjectionSnapshot", reflect.TypeOf((*MockDps)(nil).InsertInjectionSnapshot), arg0, arg1)
+}
+
+// InsertMappingProfile mocks base method.
+func (m *MockDps) InsertMappingProfile(arg0 context.Context, arg1 db.InsertMappingProfileParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertMappingProfile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertMappingProfile indicates an expected call of InsertMappingProfile.
+func (mr *MockDpsMockRecorder) InsertMappingProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMappingProfile", reflect.TypeOf((*MockDps)(nil).InsertMappingProfile), arg0, arg1)
+}
+
+// InsertQualityRule mocks base method.
+func (m *MockDps) InsertQualityRule(arg0 context.Context, arg1 db.InsertQualityRuleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertQualityRule", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertQualityRule indicates an expected call of InsertQualityRule.
+func (mr *MockDpsMockRecorder) InsertQualityRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertQualityRule", reflect.TypeOf((*MockDps)(nil).InsertQualityRule), arg0, arg1)
+}
+
 // InsertUploadedData mocks base method.
 func (m *MockDps) InsertUploadedData(arg0 context.Context, arg1 db.InsertUploadedDataParams) (db.UploadedDataFile, error) {
 	m.ctrl.T.Helper()
@@ -303,6 +767,21 @@ func (mr *MockDpsMockRecorder) InsertUploadedMetaData(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUploadedMetaData", reflect.TypeOf((*MockDps)(nil).InsertUploadedMetaData), arg0, arg1)
 }
 
+// ListConnectors mocks base method.
+func (m *MockDps) ListConnectors(arg0 context.Context, arg1 string) ([]db.Connector, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConnectors", arg0, arg1)
+	ret0, _ := ret[0].([]db.Connector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConnectors indicates an expected call of ListConnectors.
+func (mr *MockDpsMockRecorder) ListConnectors(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConnectors", reflect.TypeOf((*MockDps)(nil).ListConnectors), arg0, arg1)
+}
+
 // ListDeletionRecrods mocks base method.
 func (m *MockDps) ListDeletionRecrods(arg0 context.Context, arg1 db.ListDeletionRecrodsParams) ([]db.ListDeletionRecrodsRow, error) {
 	m.ctrl.T.Helper()
@@ -318,6 +797,141 @@ func (mr *MockDpsMockRecorder) ListDeletionRecrods(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletionRecrods", reflect.TypeOf((*MockDps)(nil).ListDeletionRecrods), arg0, arg1)
 }
 
+// ListEnabledConnectors mocks base method.
+func (m *MockDps) ListEnabledConnectors(arg0 context.Context) ([]db.Connector, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnabledConnectors", arg0)
+	ret0, _ := ret[0].([]db.Connector)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnabledConnectors indicates an expected call of ListEnabledConnectors.
+func (mr *MockDpsMockRecorder) ListEnabledConnectors(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnabledConnectors", reflect.TypeOf((*MockDps)(nil).ListEnabledConnectors), arg0)
+}
+
+// ListEnabledQualityRules mocks base method.
+func (m *MockDps) ListEnabledQualityRules(arg0 context.Context, arg1 string) ([]db.QualityRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnabledQualityRules", arg0, arg1)
+	ret0, _ := ret[0].([]db.QualityRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnabledQualityRules indicates an expected call of ListEnabledQualityRules.
+func (mr *MockDpsMockRecorder) ListEnabledQualityRules(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnabledQualityRules", reflect.TypeOf((*MockDps)(nil).ListEnabledQualityRules), arg0, arg1)
+}
+
+// ListInjectionJobs mocks base method.
+func (m *MockDps) ListInjectionJobs(arg0 context.Context, arg1 int32) ([]db.InjectionJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInjectionJobs", arg0, arg1)
+	ret0, _ := ret[0].([]db.InjectionJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInjectionJobs indicates an expected call of ListInjectionJobs.
+func (mr *MockDpsMockRecorder) ListInjectionJobs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInjectionJobs", reflect.TypeOf((*MockDps)(nil).ListInjectionJobs), arg0, arg1)
+}
+
+// ListInjectionSnapshots mocks base method.
+func (m *MockDps) ListInjectionSnapshots(arg0 context.Context, arg1 int32) ([]db.InjectionSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInjectionSnapshots", arg0, arg1)
+	ret0, _ := ret[0].([]db.InjectionSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInjectionSnapshots indicates an expected call of ListInjectionSnapshots.
+func (mr *MockDpsMockRecorder) ListInjectionSnapshots(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInjectionSnapshots", reflect.TypeOf((*MockDps)(nil).ListInjectionSnapshots), arg0, arg1)
+}
+
+// ListInjections mocks base method.
+func (m *MockDps) ListInjections(arg0 context.Context, arg1 string) ([]db.Injection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInjections", arg0, arg1)
+	ret0, _ := ret[0].([]db.Injection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInjections indicates an expected call of ListInjections.
+func (mr *MockDpsMockRecorder) ListInjections(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInjections", reflect.TypeOf((*MockDps)(nil).ListInjections), arg0, arg1)
+}
+
+// ListMappingProfiles mocks base method.
+func (m *MockDps) ListMappingProfiles(arg0 context.Context, arg1 string) ([]db.MappingProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMappingProfiles", arg0, arg1)
+	ret0, _ := ret[0].([]db.MappingProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMappingProfiles indicates an expected call of ListMappingProfiles.
+func (mr *MockDpsMockRecorder) ListMappingProfiles(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMappingProfiles", reflect.TypeOf((*MockDps)(nil).ListMappingProfiles), arg0, arg1)
+}
+
+// ListQualityRules mocks base method.
+func (m *MockDps) ListQualityRules(arg0 context.Context, arg1 string) ([]db.QualityRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQualityRules", arg0, arg1)
+	ret0, _ := ret[0].([]db.QualityRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQualityRules indicates an expected call of ListQualityRules.
+func (mr *MockDpsMockRecorder) ListQualityRules(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQualityRules", reflect.TypeOf((*MockDps)(nil).ListQualityRules), arg0, arg1)
+}
+
+// ListSyncedRecords mocks base method.
+func (m *MockDps) ListSyncedRecords(arg0 context.Context, arg1 db.ListSyncedRecordsParams) ([]db.SyncedRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSyncedRecords", arg0, arg1)
+	ret0, _ := ret[0].([]db.SyncedRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSyncedRecords indicates an expected call of ListSyncedRecords.
+func (mr *MockDpsMockRecorder) ListSyncedRecords(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSyncedRecords", reflect.TypeOf((*MockDps)(nil).ListSyncedRecords), arg0, arg1)
+}
+
+// ListSyncedRecordsByKeys mocks base method.
+func (m *MockDps) ListSyncedRecordsByKeys(arg0 context.Context, arg1 db.ListSyncedRecordsByKeysParams) ([]db.SyncedRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSyncedRecordsByKeys", arg0, arg1)
+	ret0, _ := ret[0].([]db.SyncedRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSyncedRecordsByKeys indicates an expected call of ListSyncedRecordsByKeys.
+func (mr *MockDpsMockRecorder) ListSyncedRecordsByKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSyncedRecordsByKeys", reflect.TypeOf((*MockDps)(nil).ListSyncedRecordsByKeys), arg0, arg1)
+}
+
 // ListUploadedDataFiles mocks base method.
 func (m *MockDps) ListUploadedDataFiles(arg0 context.Context, arg1 db.ListUploadedDataFilesParams) ([]db.ListUploadedDataFilesRow, error) {
 	m.ctrl.T.Helper()
@@ -377,6 +991,49 @@ func (mr *MockDpsMockRecorder) LogCoreFactor(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCoreFactor", reflect.TypeOf((*MockDps)(nil).LogCoreFactor), arg0, arg1)
 }
 
+// RestoreFailedJob mocks base method.
+func (m *MockDps) RestoreFailedJob(arg0 context.Context, arg1 db.RestoreFailedJobParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreFailedJob", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreFailedJob indicates an expected call of RestoreFailedJob.
+func (mr *MockDpsMockRecorder) RestoreFailedJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreFailedJob", reflect.TypeOf((*MockDps)(nil).RestoreFailedJob), arg0, arg1)
+}
+
+// RetryFailedJob mocks base method.
+func (m *MockDps) RetryFailedJob(arg0 context.Context, arg1 db.RetryFailedJobParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryFailedJob", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetryFailedJob indicates an expected call of RetryFailedJob.
+func (mr *MockDpsMockRecorder) RetryFailedJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryFailedJob", reflect.TypeOf((*MockDps)(nil).RetryFailedJob), arg0, arg1)
+}
+
+// RetryFileFailedRecords mocks base method.
+func (m *MockDps) RetryFileFailedRecords(arg0 context.Context, arg1 db.RetryFileFailedRecordsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetryFileFailedRecords", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetryFileFailedRecords indicates an expected call of RetryFileFailedRecords.
+func (mr *MockDpsMockRecorder) RetryFileFailedRecords(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryFileFailedRecords", reflect.TypeOf((*MockDps)(nil).RetryFileFailedRecords), arg0, arg1)
+}
+
 // SetDeletionActive mocks base method.
 func (m *MockDps) SetDeletionActive(arg0 context.Context, arg1 db.SetDeletionActiveParams) (int32, error) {
 	m.ctrl.T.Helper()
@@ -392,6 +1049,49 @@ func (mr *MockDpsMockRecorder) SetDeletionActive(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDeletionActive", reflect.TypeOf((*MockDps)(nil).SetDeletionActive), arg0, arg1)
 }
 
+// SetInjectionStatus mocks base method.
+func (m *MockDps) SetInjectionStatus(arg0 context.Context, arg1 db.SetInjectionStatusParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetInjectionStatus", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetInjectionStatus indicates an expected call of SetInjectionStatus.
+func (mr *MockDpsMockRecorder) SetInjectionStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInjectionStatus", reflect.TypeOf((*MockDps)(nil).SetInjectionStatus), arg0, arg1)
+}
+
+// StageInjectionFile mocks base method.
+func (m *MockDps) StageInjectionFile(arg0 context.Context, arg1 db.StageInjectionFileParams) (db.Injection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StageInjectionFile", arg0, arg1)
+	ret0, _ := ret[0].(db.Injection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StageInjectionFile indicates an expected call of StageInjectionFile.
+func (mr *MockDpsMockRecorder) StageInjectionFile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StageInjectionFile", reflect.TypeOf((*MockDps)(nil).StageInjectionFile), arg0, arg1)
+}
+
+// StartInjectionCompensation mocks base method.
+func (m *MockDps) StartInjectionCompensation(arg0 context.Context, arg1 db.StartInjectionCompensationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartInjectionCompensation", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartInjectionCompensation indicates an expected call of StartInjectionCompensation.
+func (mr *MockDpsMockRecorder) StartInjectionCompensation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartInjectionCompensation", reflect.TypeOf((*MockDps)(nil).StartInjectionCompensation), arg0, arg1)
+}
+
 // StoreCoreFactorReferences mocks base method.
 func (m *MockDps) StoreCoreFactorReferences(arg0 context.Context, arg1 map[string]map[string]string) error {
 	m.ctrl.T.Helper()
@@ -406,6 +1106,34 @@ func (mr *MockDpsMockRecorder) StoreCoreFactorReferences(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreCoreFactorReferences", reflect.TypeOf((*MockDps)(nil).StoreCoreFactorReferences), arg0, arg1)
 }
 
+// UpdateConnector mocks base method.
+func (m *MockDps) UpdateConnector(arg0 context.Context, arg1 db.UpdateConnectorParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConnector", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateConnector indicates an expected call of UpdateConnector.
+func (mr *MockDpsMockRecorder) UpdateConnector(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConnector", reflect.TypeOf((*MockDps)(nil).UpdateConnector), arg0, arg1)
+}
+
+// UpdateConnectorRun mocks base method.
+func (m *MockDps) UpdateConnectorRun(arg0 context.Context, arg1 db.UpdateConnectorRunParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConnectorRun", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateConnectorRun indicates an expected call of UpdateConnectorRun.
+func (mr *MockDpsMockRecorder) UpdateConnectorRun(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConnectorRun", reflect.TypeOf((*MockDps)(nil).UpdateConnectorRun), arg0, arg1)
+}
+
 // UpdateDeletionStatus mocks base method.
 func (m *MockDps) UpdateDeletionStatus(arg0 context.Context, arg1 db.UpdateDeletionStatusParams) error {
 	m.ctrl.T.Helper()
@@ -449,6 +1177,34 @@ func (mr *MockDpsMockRecorder) UpdateFileFailure(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileFailure", reflect.TypeOf((*MockDps)(nil).UpdateFileFailure), arg0, arg1)
 }
 
+// UpdateFileProcessedRecords mocks base method.
+func (m *MockDps) UpdateFileProcessedRecords(arg0 context.Context, arg1 db.UpdateFileProcessedRecordsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFileProcessedRecords", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFileProcessedRecords indicates an expected call of UpdateFileProcessedRecords.
+func (mr *MockDpsMockRecorder) UpdateFileProcessedRecords(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileProcessedRecords", reflect.TypeOf((*MockDps)(nil).UpdateFileProcessedRecords), arg0, arg1)
+}
+
+// UpdateFileRejectedRows mocks base method.
+func (m *MockDps) UpdateFileRejectedRows(arg0 context.Context, arg1 db.UpdateFileRejectedRowsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFileRejectedRows", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFileRejectedRows indicates an expected call of UpdateFileRejectedRows.
+func (mr *MockDpsMockRecorder) UpdateFileRejectedRows(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileRejectedRows", reflect.TypeOf((*MockDps)(nil).UpdateFileRejectedRows), arg0, arg1)
+}
+
 // UpdateFileStatus mocks base method.
 func (m *MockDps) UpdateFileStatus(arg0 context.Context, arg1 db.UpdateFileStatusParams) error {
 	m.ctrl.T.Helper()
@@ -493,6 +1249,20 @@ func (mr *MockDpsMockRecorder) UpdateFileSuccessRecord(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileSuccessRecord", reflect.TypeOf((*MockDps)(nil).UpdateFileSuccessRecord), arg0, arg1)
 }
 
+// UpdateFileSyncRecords mocks base method.
+func (m *MockDps) UpdateFileSyncRecords(arg0 context.Context, arg1 db.UpdateFileSyncRecordsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFileSyncRecords", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFileSyncRecords indicates an expected call of UpdateFileSyncRecords.
+func (mr *MockDpsMockRecorder) UpdateFileSyncRecords(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFileSyncRecords", reflect.TypeOf((*MockDps)(nil).UpdateFileSyncRecords), arg0, arg1)
+}
+
 // UpdateFileTotalRecord mocks base method.
 func (m *MockDps) UpdateFileTotalRecord(arg0 context.Context, arg1 db.UpdateFileTotalRecordParams) error {
 	m.ctrl.T.Helper()
@@ -521,3 +1291,73 @@ func (mr *MockDpsMockRecorder) UpdateGlobalFileStatus(arg0, arg1 interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGlobalFileStatus", reflect.TypeOf((*MockDps)(nil).UpdateGlobalFileStatus), arg0, arg1)
 }
+
+// UpdateMappingProfile mocks base method.
+func (m *MockDps) UpdateMappingProfile(arg0 context.Context, arg1 db.UpdateMappingProfileParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMappingProfile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMappingProfile indicates an expected call of UpdateMappingProfile.
+func (mr *MockDpsMockRecorder) UpdateMappingProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMappingProfile", reflect.TypeOf((*MockDps)(nil).UpdateMappingProfile), arg0, arg1)
+}
+
+// UpdateQualityRule mocks base method.
+func (m *MockDps) UpdateQualityRule(arg0 context.Context, arg1 db.UpdateQualityRuleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQualityRule", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateQualityRule indicates an expected call of UpdateQualityRule.
+func (mr *MockDpsMockRecorder) UpdateQualityRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQualityRule", reflect.TypeOf((*MockDps)(nil).UpdateQualityRule), arg0, arg1)
+}
+
+// UpsertQualityResult mocks base method.
+func (m *MockDps) UpsertQualityResult(arg0 context.Context, arg1 db.UpsertQualityResultParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertQualityResult", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertQualityResult indicates an expected call of UpsertQualityResult.
+func (mr *MockDpsMockRecorder) UpsertQualityResult(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertQualityResult", reflect.TypeOf((*MockDps)(nil).UpsertQualityResult), arg0, arg1)
+}
+
+// UpsertStreamCheckpoint mocks base method.
+func (m *MockDps) UpsertStreamCheckpoint(arg0 context.Context, arg1 db.UpsertStreamCheckpointParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertStreamCheckpoint", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertStreamCheckpoint indicates an expected call of UpsertStreamCheckpoint.
+func (mr *MockDpsMockRecorder) UpsertStreamCheckpoint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertStreamCheckpoint", reflect.TypeOf((*MockDps)(nil).UpsertStreamCheckpoint), arg0, arg1)
+}
+
+// UpsertSyncedRecord mocks base method.
+func (m *MockDps) UpsertSyncedRecord(arg0 context.Context, arg1 db.UpsertSyncedRecordParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertSyncedRecord", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertSyncedRecord indicates an expected call of UpsertSyncedRecord.
+func (mr *MockDpsMockRecorder) UpsertSyncedRecord(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSyncedRecord", reflect.TypeOf((*MockDps)(nil).UpsertSyncedRecord), arg0, arg1)
+}
@@ -9,6 +9,26 @@ import (
 	"time"
 )
 
+type ConnectorSource string
+
+const (
+	ConnectorSourceSQL       ConnectorSource = "SQL"
+	ConnectorSourceREST      ConnectorSource = "REST"
+	ConnectorSourceDIRECTORY ConnectorSource = "DIRECTORY"
+)
+
+func (e *ConnectorSource) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ConnectorSource(s)
+	case string:
+		*e = ConnectorSource(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ConnectorSource: %T", src)
+	}
+	return nil
+}
+
 type DataType string
 
 const (
@@ -49,6 +69,29 @@ func (e *DeletionType) Scan(src interface{}) error {
 	return nil
 }
 
+type InjectionStatus string
+
+const (
+	InjectionStatusSTAGING      InjectionStatus = "STAGING"
+	InjectionStatusAPPLYING     InjectionStatus = "APPLYING"
+	InjectionStatusCOMMITTED    InjectionStatus = "COMMITTED"
+	InjectionStatusDISCARDED    InjectionStatus = "DISCARDED"
+	InjectionStatusCOMPENSATED  InjectionStatus = "COMPENSATED"
+	InjectionStatusCOMPENSATING InjectionStatus = "COMPENSATING"
+)
+
+func (e *InjectionStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = InjectionStatus(s)
+	case string:
+		*e = InjectionStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for InjectionStatus: %T", src)
+	}
+	return nil
+}
+
 type JobStatus string
 
 const (
@@ -57,6 +100,7 @@ const (
 	JobStatusFAILED    JobStatus = "FAILED"
 	JobStatusRETRY     JobStatus = "RETRY"
 	JobStatusRUNNING   JobStatus = "RUNNING"
+	JobStatusDEAD      JobStatus = "DEAD"
 )
 
 func (e *JobStatus) Scan(src interface{}) error {
@@ -71,6 +115,25 @@ func (e *JobStatus) Scan(src interface{}) error {
 	return nil
 }
 
+type RuleSeverity string
+
+const (
+	RuleSeverityERROR   RuleSeverity = "ERROR"
+	RuleSeverityWARNING RuleSeverity = "WARNING"
+)
+
+func (e *RuleSeverity) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RuleSeverity(s)
+	case string:
+		*e = RuleSeverity(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RuleSeverity: %T", src)
+	}
+	return nil
+}
+
 type ScopeTypes string
 
 const (
@@ -90,6 +153,26 @@ func (e *ScopeTypes) Scan(src interface{}) error {
 	return nil
 }
 
+type SyncMode string
+
+const (
+	SyncModeFULL     SyncMode = "FULL"
+	SyncModeDELTA    SyncMode = "DELTA"
+	SyncModeSNAPSHOT SyncMode = "SNAPSHOT"
+)
+
+func (e *SyncMode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SyncMode(s)
+	case string:
+		*e = SyncMode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SyncMode: %T", src)
+	}
+	return nil
+}
+
 type UploadStatus string
 
 const (
@@ -116,6 +199,26 @@ func (e *UploadStatus) Scan(src interface{}) error {
 	return nil
 }
 
+type Connector struct {
+	Scope          string          `json:"scope"`
+	Name           string          `json:"name"`
+	FileType       string          `json:"file_type"`
+	Schedule       string          `json:"schedule"`
+	Enabled        bool            `json:"enabled"`
+	MappingProfile string          `json:"mapping_profile"`
+	SourceType     ConnectorSource `json:"source_type"`
+	Source         json.RawMessage `json:"source"`
+	CreatedBy      string          `json:"created_by"`
+	CreatedOn      time.Time       `json:"created_on"`
+	UpdatedBy      sql.NullString  `json:"updated_by"`
+	UpdatedOn      sql.NullTime    `json:"updated_on"`
+	LastRunOn      sql.NullTime    `json:"last_run_on"`
+	LastStatus     string          `json:"last_status"`
+	LastError      string          `json:"last_error"`
+	LastUploadID   int32           `json:"last_upload_id"`
+	ClaimedOn      sql.NullTime    `json:"claimed_on"`
+}
+
 type CoreFactorLog struct {
 	UploadID   int32     `json:"upload_id"`
 	FileName   string    `json:"file_name"`
@@ -140,6 +243,44 @@ type DeletionAudit struct {
 	UpdatedOn    sql.NullTime   `json:"updated_on"`
 }
 
+type Injection struct {
+	InjectionID         int32           `json:"injection_id"`
+	Scope               string          `json:"scope"`
+	Status              InjectionStatus `json:"status"`
+	TotalFiles          int32           `json:"total_files"`
+	StagedFiles         int32           `json:"staged_files"`
+	TotalJobs           int32           `json:"total_jobs"`
+	DoneJobs            int32           `json:"done_jobs"`
+	FailedJobs          int32           `json:"failed_jobs"`
+	CreatedBy           string          `json:"created_by"`
+	CreatedOn           time.Time       `json:"created_on"`
+	UpdatedOn           sql.NullTime    `json:"updated_on"`
+	Comments            sql.NullString  `json:"comments"`
+	CompensatingJobs    int32           `json:"compensating_jobs"`
+	CompensatedJobs     int32           `json:"compensated_jobs"`
+	FailedCompensations int32           `json:"failed_compensations"`
+}
+
+type InjectionFile struct {
+	InjectionID int32  `json:"injection_id"`
+	FileName    string `json:"file_name"`
+}
+
+type InjectionJob struct {
+	InjectionID int32           `json:"injection_id"`
+	JobSeq      int32           `json:"job_seq"`
+	Data        json.RawMessage `json:"data"`
+	FileName    string          `json:"file_name"`
+}
+
+type InjectionSnapshot struct {
+	InjectionID int32           `json:"injection_id"`
+	Entity      string          `json:"entity"`
+	RecordKey   string          `json:"record_key"`
+	Existed     bool            `json:"existed"`
+	Before      json.RawMessage `json:"before"`
+}
+
 type Job struct {
 	JobID      int32           `json:"job_id"`
 	Type       string          `json:"type"`
@@ -153,20 +294,83 @@ type Job struct {
 	MetaData   json.RawMessage `json:"meta_data"`
 }
 
+type MappingProfile struct {
+	Scope     string          `json:"scope"`
+	Name      string          `json:"name"`
+	Mappings  json.RawMessage `json:"mappings"`
+	CreatedBy string          `json:"created_by"`
+	CreatedOn time.Time       `json:"created_on"`
+	UpdatedBy sql.NullString  `json:"updated_by"`
+	UpdatedOn sql.NullTime    `json:"updated_on"`
+}
+
+type QualityResult struct {
+	UploadID   int32        `json:"upload_id"`
+	FileName   string       `json:"file_name"`
+	RuleName   string       `json:"rule_name"`
+	Scope      string       `json:"scope"`
+	Entity     string       `json:"entity"`
+	Severity   RuleSeverity `json:"severity"`
+	Violations int32        `json:"violations"`
+	CreatedOn  time.Time    `json:"created_on"`
+}
+
+type QualityRule struct {
+	Scope       string          `json:"scope"`
+	Name        string          `json:"name"`
+	Entity      string          `json:"entity"`
+	Field       string          `json:"field"`
+	Operator    string          `json:"operator"`
+	Params      json.RawMessage `json:"params"`
+	Severity    RuleSeverity    `json:"severity"`
+	Description string          `json:"description"`
+	Enabled     bool            `json:"enabled"`
+	CreatedBy   string          `json:"created_by"`
+	CreatedOn   time.Time       `json:"created_on"`
+	UpdatedBy   sql.NullString  `json:"updated_by"`
+	UpdatedOn   sql.NullTime    `json:"updated_on"`
+}
+
+type StreamCheckpoint struct {
+	UploadID  int32           `json:"upload_id"`
+	FileName  string          `json:"file_name"`
+	RowsRead  int32           `json:"rows_read"`
+	State     json.RawMessage `json:"state"`
+	UpdatedOn time.Time       `json:"updated_on"`
+}
+
+type SyncedRecord struct {
+	Scope     string          `json:"scope"`
+	Entity    string          `json:"entity"`
+	RecordKey string          `json:"record_key"`
+	Hash      string          `json:"hash"`
+	Data      json.RawMessage `json:"data"`
+	UploadID  int32           `json:"upload_id"`
+	UpdatedOn time.Time       `json:"updated_on"`
+}
+
 type UploadedDataFile struct {
-	UploadID       int32          `json:"upload_id"`
-	Gid            int32          `json:"gid"`
-	Scope          string         `json:"scope"`
-	DataType       DataType       `json:"data_type"`
-	FileName       string         `json:"file_name"`
-	Status         UploadStatus   `json:"status"`
-	UploadedBy     string         `json:"uploaded_by"`
-	UploadedOn     time.Time      `json:"uploaded_on"`
-	UpdatedOn      sql.NullTime   `json:"updated_on"`
-	TotalRecords   int32          `json:"total_records"`
-	SuccessRecords int32          `json:"success_records"`
-	FailedRecords  int32          `json:"failed_records"`
-	Comments       sql.NullString `json:"comments"`
-	ScopeType      ScopeTypes     `json:"scope_type"`
-	AnalysisID     sql.NullString `json:"analysis_id"`
+	UploadID         int32           `json:"upload_id"`
+	Gid              int32           `json:"gid"`
+	Scope            string          `json:"scope"`
+	DataType         DataType        `json:"data_type"`
+	FileName         string          `json:"file_name"`
+	Status           UploadStatus    `json:"status"`
+	UploadedBy       string          `json:"uploaded_by"`
+	UploadedOn       time.Time       `json:"uploaded_on"`
+	UpdatedOn        sql.NullTime    `json:"updated_on"`
+	TotalRecords     int32           `json:"total_records"`
+	SuccessRecords   int32           `json:"success_records"`
+	FailedRecords    int32           `json:"failed_records"`
+	Comments         sql.NullString  `json:"comments"`
+	ScopeType        ScopeTypes      `json:"scope_type"`
+	AnalysisID       sql.NullString  `json:"analysis_id"`
+	MappingProfile   string          `json:"mapping_profile"`
+	InjectionID      int32           `json:"injection_id"`
+	SyncMode         SyncMode        `json:"sync_mode"`
+	InsertedRecords  int32           `json:"inserted_records"`
+	UpdatedRecords   int32           `json:"updated_records"`
+	DeletedRecords   int32           `json:"deleted_records"`
+	ProcessedRecords int32           `json:"processed_records"`
+	RejectedRows     json.RawMessage `json:"rejected_rows"`
 }
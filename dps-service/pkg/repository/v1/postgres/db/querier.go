@@ -4,41 +4,100 @@ package db
 
 import (
 	"context"
+	"encoding/json"
 )
 
 type Querier interface {
+	AbortInjectionFiles(ctx context.Context, arg AbortInjectionFilesParams) error
+	AddFileTotalRecords(ctx context.Context, arg AddFileTotalRecordsParams) (AddFileTotalRecordsRow, error)
+	ClaimConnectorRun(ctx context.Context, arg ClaimConnectorRunParams) (int64, error)
+	CompensationJobDone(ctx context.Context, arg CompensationJobDoneParams) (Injection, error)
+	DeleteConnector(ctx context.Context, arg DeleteConnectorParams) error
 	DeleteCoreFactorReference(ctx context.Context) error
+	DeleteInjectionFileJobs(ctx context.Context, arg DeleteInjectionFileJobsParams) error
+	DeleteInjectionJobs(ctx context.Context, injectionID int32) error
+	DeleteInjections(ctx context.Context, scope string) error
+	DeleteMappingProfile(ctx context.Context, arg DeleteMappingProfileParams) error
+	DeleteQualityRule(ctx context.Context, arg DeleteQualityRuleParams) error
+	DeleteStreamCheckpoint(ctx context.Context, arg DeleteStreamCheckpointParams) error
+	DeleteSyncedRecord(ctx context.Context, arg DeleteSyncedRecordParams) error
+	DeleteSyncedRecords(ctx context.Context, scope string) error
+	DiscardInjection(ctx context.Context, arg DiscardInjectionParams) error
 	DropFileRecords(ctx context.Context, scope string) error
+	FinishInjectionCompensation(ctx context.Context, injectionID int32) error
 	GetActiveGID(ctx context.Context, scope string) (int32, error)
 	GetAllDataFileStatusByGID(ctx context.Context, gid int32) ([]UploadStatus, error)
+	GetConnector(ctx context.Context, arg GetConnectorParams) (Connector, error)
 	GetCoreFactorList(ctx context.Context) ([]CoreFactorReference, error)
 	GetCoreFactorLogs(ctx context.Context) ([]CoreFactorLog, error)
 	GetCoreFactorReferences(ctx context.Context, arg GetCoreFactorReferencesParams) ([]GetCoreFactorReferencesRow, error)
+	GetDataFile(ctx context.Context, uploadID int32) (UploadedDataFile, error)
 	GetDataFileRecords(ctx context.Context, arg GetDataFileRecordsParams) (GetDataFileRecordsRow, error)
 	GetDeletionStatus(ctx context.Context, scope string) (int64, error)
 	GetEntityMonthWise(ctx context.Context, arg GetEntityMonthWiseParams) ([]GetEntityMonthWiseRow, error)
+	GetFailedJobs(ctx context.Context, data json.RawMessage) ([]GetFailedJobsRow, error)
 	GetFailedRecord(ctx context.Context, arg GetFailedRecordParams) ([]GetFailedRecordRow, error)
 	GetFailureReasons(ctx context.Context, arg GetFailureReasonsParams) ([]GetFailureReasonsRow, error)
 	GetFileStatus(ctx context.Context, arg GetFileStatusParams) (UploadStatus, error)
 	GetGlobalFileInfo(ctx context.Context, arg GetGlobalFileInfoParams) (GetGlobalFileInfoRow, error)
+	GetInjection(ctx context.Context, injectionID int32) (Injection, error)
 	GetInjectionStatus(ctx context.Context, scope string) (int64, error)
+	GetLatestInjection(ctx context.Context, scope string) (Injection, error)
+	GetMappingProfile(ctx context.Context, arg GetMappingProfileParams) (MappingProfile, error)
+	GetQualityResultsMonthWise(ctx context.Context, arg GetQualityResultsMonthWiseParams) ([]GetQualityResultsMonthWiseRow, error)
+	GetQualityRule(ctx context.Context, arg GetQualityRuleParams) (QualityRule, error)
+	GetStreamCheckpoint(ctx context.Context, arg GetStreamCheckpointParams) (StreamCheckpoint, error)
 	GetTransformedGlobalFileInfo(ctx context.Context) ([]GetTransformedGlobalFileInfoRow, error)
+	InjectionFileStaged(ctx context.Context, arg InjectionFileStagedParams) (bool, error)
+	InjectionJobDone(ctx context.Context, arg InjectionJobDoneParams) (Injection, error)
+	InsertConnector(ctx context.Context, arg InsertConnectorParams) error
+	InsertInjection(ctx context.Context, arg InsertInjectionParams) (Injection, error)
+	InsertInjectionJob(ctx context.Context, arg InsertInjectionJobParams) error
+	InsertInjectionSnapshot(ctx context.Context, arg InsertInjectionSnapshotParams) error
+	InsertMappingProfile(ctx context.Context, arg InsertMappingProfileParams) error
+	InsertQualityRule(ctx context.Context, arg InsertQualityRuleParams) error
 	InsertUploadedData(ctx context.Context, arg InsertUploadedDataParams) (UploadedDataFile, error)
 	InsertUploadedMetaData(ctx context.Context, arg InsertUploadedMetaDataParams) (UploadedDataFile, error)
+	ListConnectors(ctx context.Context, scope string) ([]Connector, error)
 	ListDeletionRecrods(ctx context.Context, arg ListDeletionRecrodsParams) ([]ListDeletionRecrodsRow, error)
+	ListEnabledConnectors(ctx context.Context) ([]Connector, error)
+	ListEnabledQualityRules(ctx context.Context, scope string) ([]QualityRule, error)
+	ListInjectionJobs(ctx context.Context, injectionID int32) ([]InjectionJob, error)
+	ListInjectionSnapshots(ctx context.Context, injectionID int32) ([]InjectionSnapshot, error)
+	ListInjections(ctx context.Context, scope string) ([]Injection, error)
+	ListMappingProfiles(ctx context.Context, scope string) ([]MappingProfile, error)
+	ListQualityRules(ctx context.Context, scope string) ([]QualityRule, error)
+	ListSyncedRecords(ctx context.Context, arg ListSyncedRecordsParams) ([]SyncedRecord, error)
+	ListSyncedRecordsByKeys(ctx context.Context, arg ListSyncedRecordsByKeysParams) ([]SyncedRecord, error)
 	ListUploadedDataFiles(ctx context.Context, arg ListUploadedDataFilesParams) ([]ListUploadedDataFilesRow, error)
 	ListUploadedGlobalDataFiles(ctx context.Context, arg ListUploadedGlobalDataFilesParams) ([]ListUploadedGlobalDataFilesRow, error)
 	ListUploadedMetaDataFiles(ctx context.Context, arg ListUploadedMetaDataFilesParams) ([]ListUploadedMetaDataFilesRow, error)
 	LogCoreFactor(ctx context.Context, fileName string) error
+	RestoreFailedJob(ctx context.Context, arg RestoreFailedJobParams) error
+	RetryFailedJob(ctx context.Context, arg RetryFailedJobParams) (int64, error)
+	RetryFileFailedRecords(ctx context.Context, arg RetryFileFailedRecordsParams) error
 	SetDeletionActive(ctx context.Context, arg SetDeletionActiveParams) (int32, error)
+	SetInjectionStatus(ctx context.Context, arg SetInjectionStatusParams) error
+	StageInjectionFile(ctx context.Context, arg StageInjectionFileParams) (Injection, error)
+	StartInjectionCompensation(ctx context.Context, arg StartInjectionCompensationParams) error
+	UpdateConnector(ctx context.Context, arg UpdateConnectorParams) error
+	UpdateConnectorRun(ctx context.Context, arg UpdateConnectorRunParams) error
 	UpdateDeletionStatus(ctx context.Context, arg UpdateDeletionStatusParams) error
 	UpdateFileFailedRecord(ctx context.Context, arg UpdateFileFailedRecordParams) (UpdateFileFailedRecordRow, error)
 	UpdateFileFailure(ctx context.Context, arg UpdateFileFailureParams) error
+	UpdateFileProcessedRecords(ctx context.Context, arg UpdateFileProcessedRecordsParams) error
+	UpdateFileRejectedRows(ctx context.Context, arg UpdateFileRejectedRowsParams) error
 	UpdateFileStatus(ctx context.Context, arg UpdateFileStatusParams) error
 	UpdateFileStatusCancelled(ctx context.Context, arg UpdateFileStatusCancelledParams) (UploadStatus, error)
 	UpdateFileSuccessRecord(ctx context.Context, arg UpdateFileSuccessRecordParams) (UpdateFileSuccessRecordRow, error)
+	UpdateFileSyncRecords(ctx context.Context, arg UpdateFileSyncRecordsParams) error
 	UpdateFileTotalRecord(ctx context.Context, arg UpdateFileTotalRecordParams) error
 	UpdateGlobalFileStatus(ctx context.Context, arg UpdateGlobalFileStatusParams) (string, error)
+	UpdateMappingProfile(ctx context.Context, arg UpdateMappingProfileParams) error
+	UpdateQualityRule(ctx context.Context, arg UpdateQualityRuleParams) error
+	UpsertQualityResult(ctx context.Context, arg UpsertQualityResultParams) error
+	UpsertStreamCheckpoint(ctx context.Context, arg UpsertStreamCheckpointParams) error
+	UpsertSyncedRecord(ctx context.Context, arg UpsertSyncedRecordParams) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -12,6 +12,108 @@ import (
 	"github.com/lib/pq"
 )
 
+const abortInjectionFiles = `-- name: AbortInjectionFiles :exec
+UPDATE uploaded_data_files SET status = 'FAILED', comments = $2, updated_on = NOW() WHERE injection_id = $1 AND status <> 'FAILED'
+`
+
+type AbortInjectionFilesParams struct {
+	InjectionID int32          `json:"injection_id"`
+	Comments    sql.NullString `json:"comments"`
+}
+
+func (q *Queries) AbortInjectionFiles(ctx context.Context, arg AbortInjectionFilesParams) error {
+	_, err := q.db.ExecContext(ctx, abortInjectionFiles, arg.InjectionID, arg.Comments)
+	return err
+}
+
+const addFileTotalRecords = `-- name: AddFileTotalRecords :one
+UPDATE uploaded_data_files SET total_records = total_records + $3 WHERE upload_id = $1 AND file_name = $2 RETURNING   total_records = (success_records) as isSuccess, total_records = (failed_records) as isFailed, total_records = (success_records + failed_records) as isPartial
+`
+
+type AddFileTotalRecordsParams struct {
+	UploadID     int32  `json:"upload_id"`
+	FileName     string `json:"file_name"`
+	TotalRecords int32  `json:"total_records"`
+}
+
+type AddFileTotalRecordsRow struct {
+	Issuccess bool `json:"issuccess"`
+	Isfailed  bool `json:"isfailed"`
+	Ispartial bool `json:"ispartial"`
+}
+
+func (q *Queries) AddFileTotalRecords(ctx context.Context, arg AddFileTotalRecordsParams) (AddFileTotalRecordsRow, error) {
+	row := q.db.QueryRowContext(ctx, addFileTotalRecords, arg.UploadID, arg.FileName, arg.TotalRecords)
+	var i AddFileTotalRecordsRow
+	err := row.Scan(&i.Issuccess, &i.Isfailed, &i.Ispartial)
+	return i, err
+}
+
+const claimConnectorRun = `-- name: ClaimConnectorRun :execrows
+UPDATE connectors SET claimed_on = $1
+WHERE scope = $2 AND name = $3 AND enabled = TRUE AND (claimed_on IS NULL OR claimed_on < $1)
+`
+
+type ClaimConnectorRunParams struct {
+	RunOn sql.NullTime `json:"run_on"`
+	Scope string       `json:"scope"`
+	Name  string       `json:"name"`
+}
+
+func (q *Queries) ClaimConnectorRun(ctx context.Context, arg ClaimConnectorRunParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, claimConnectorRun, arg.RunOn, arg.Scope, arg.Name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const compensationJobDone = `-- name: CompensationJobDone :one
+UPDATE injections SET compensated_jobs = compensated_jobs + 1, failed_compensations = failed_compensations + $2, updated_on = NOW() WHERE injection_id = $1 RETURNING injection_id, scope, status, total_files, staged_files, total_jobs, done_jobs, failed_jobs, created_by, created_on, updated_on, comments, compensating_jobs, compensated_jobs, failed_compensations
+`
+
+type CompensationJobDoneParams struct {
+	InjectionID         int32 `json:"injection_id"`
+	FailedCompensations int32 `json:"failed_compensations"`
+}
+
+func (q *Queries) CompensationJobDone(ctx context.Context, arg CompensationJobDoneParams) (Injection, error) {
+	row := q.db.QueryRowContext(ctx, compensationJobDone, arg.InjectionID, arg.FailedCompensations)
+	var i Injection
+	err := row.Scan(
+		&i.InjectionID,
+		&i.Scope,
+		&i.Status,
+		&i.TotalFiles,
+		&i.StagedFiles,
+		&i.TotalJobs,
+		&i.DoneJobs,
+		&i.FailedJobs,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.UpdatedOn,
+		&i.Comments,
+		&i.CompensatingJobs,
+		&i.CompensatedJobs,
+		&i.FailedCompensations,
+	)
+	return i, err
+}
+
+const deleteConnector = `-- name: DeleteConnector :exec
+DELETE FROM connectors WHERE scope = $1 AND name = $2
+`
+
+type DeleteConnectorParams struct {
+	Scope string `json:"scope"`
+	Name  string `json:"name"`
+}
+
+func (q *Queries) DeleteConnector(ctx context.Context, arg DeleteConnectorParams) error {
+	_, err := q.db.ExecContext(ctx, deleteConnector, arg.Scope, arg.Name)
+	return err
+}
+
 const deleteCoreFactorReference = `-- name: DeleteCoreFactorReference :exec
 delete from core_factor_references
 `
@@ -21,6 +123,118 @@ func (q *Queries) DeleteCoreFactorReference(ctx context.Context) error {
 	return err
 }
 
+const deleteInjectionFileJobs = `-- name: DeleteInjectionFileJobs :exec
+DELETE FROM injection_jobs WHERE injection_id = $1 AND file_name = $2
+`
+
+type DeleteInjectionFileJobsParams struct {
+	InjectionID int32  `json:"injection_id"`
+	FileName    string `json:"file_name"`
+}
+
+func (q *Queries) DeleteInjectionFileJobs(ctx context.Context, arg DeleteInjectionFileJobsParams) error {
+	_, err := q.db.ExecContext(ctx, deleteInjectionFileJobs, arg.InjectionID, arg.FileName)
+	return err
+}
+
+const deleteInjectionJobs = `-- name: DeleteInjectionJobs :exec
+DELETE FROM injection_jobs WHERE injection_id = $1
+`
+
+func (q *Queries) DeleteInjectionJobs(ctx context.Context, injectionID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteInjectionJobs, injectionID)
+	return err
+}
+
+const deleteInjections = `-- name: DeleteInjections :exec
+DELETE FROM injections WHERE scope = $1
+`
+
+func (q *Queries) DeleteInjections(ctx context.Context, scope string) error {
+	_, err := q.db.ExecContext(ctx, deleteInjections, scope)
+	return err
+}
+
+const deleteMappingProfile = `-- name: DeleteMappingProfile :exec
+DELETE FROM mapping_profiles WHERE scope = $1 AND name = $2
+`
+
+type DeleteMappingProfileParams struct {
+	Scope string `json:"scope"`
+	Name  string `json:"name"`
+}
+
+func (q *Queries) DeleteMappingProfile(ctx context.Context, arg DeleteMappingProfileParams) error {
+	_, err := q.db.ExecContext(ctx, deleteMappingProfile, arg.Scope, arg.Name)
+	return err
+}
+
+const deleteQualityRule = `-- name: DeleteQualityRule :exec
+DELETE FROM quality_rules WHERE scope = $1 AND name = $2
+`
+
+type DeleteQualityRuleParams struct {
+	Scope string `json:"scope"`
+	Name  string `json:"name"`
+}
+
+func (q *Queries) DeleteQualityRule(ctx context.Context, arg DeleteQualityRuleParams) error {
+	_, err := q.db.ExecContext(ctx, deleteQualityRule, arg.Scope, arg.Name)
+	return err
+}
+
+const deleteStreamCheckpoint = `-- name: DeleteStreamCheckpoint :exec
+DELETE FROM stream_checkpoints WHERE upload_id = $1 AND file_name = $2
+`
+
+type DeleteStreamCheckpointParams struct {
+	UploadID int32  `json:"upload_id"`
+	FileName string `json:"file_name"`
+}
+
+func (q *Queries) DeleteStreamCheckpoint(ctx context.Context, arg DeleteStreamCheckpointParams) error {
+	_, err := q.db.ExecContext(ctx, deleteStreamCheckpoint, arg.UploadID, arg.FileName)
+	return err
+}
+
+const deleteSyncedRecord = `-- name: DeleteSyncedRecord :exec
+DELETE FROM synced_records WHERE scope = $1 AND entity = $2 AND record_key = $3
+`
+
+type DeleteSyncedRecordParams struct {
+	Scope     string `json:"scope"`
+	Entity    string `json:"entity"`
+	RecordKey string `json:"record_key"`
+}
+
+func (q *Queries) DeleteSyncedRecord(ctx context.Context, arg DeleteSyncedRecordParams) error {
+	_, err := q.db.ExecContext(ctx, deleteSyncedRecord, arg.Scope, arg.Entity, arg.RecordKey)
+	return err
+}
+
+const deleteSyncedRecords = `-- name: DeleteSyncedRecords :exec
+DELETE FROM synced_records WHERE scope = $1
+`
+
+func (q *Queries) DeleteSyncedRecords(ctx context.Context, scope string) error {
+	_, err := q.db.ExecContext(ctx, deleteSyncedRecords, scope)
+	return err
+}
+
+const discardInjection = `-- name: DiscardInjection :exec
+UPDATE injections SET status = 'DISCARDED', comments = $2, updated_on = NOW() WHERE injection_id = $1 AND status = 'STAGING'
+`
+
+type DiscardInjectionParams struct {
+	InjectionID int32          `json:"injection_id"`
+	Comments    sql.NullString `json:"comments"`
+}
+
+func (q *Queries) DiscardInjection(ctx context.Context, arg DiscardInjectionParams) error {
+	_, err := q.db.ExecContext(ctx, discardInjection, arg.InjectionID, arg.Comments)
+	return err
+}
+
 const dropFileRecords = `-- name: DropFileRecords :exec
 delete from uploaded_data_files where scope = $1
 `
@@ -30,6 +244,15 @@ func (q *Queries) DropFileRecords(ctx context.Context, scope string) error {
 	return err
 }
 
+const finishInjectionCompensation = `-- name: FinishInjectionCompensation :exec
+UPDATE injections SET status = 'COMPENSATED', updated_on = NOW() WHERE injection_id = $1 AND status = 'COMPENSATING'
+`
+
+func (q *Queries) FinishInjectionCompensation(ctx context.Context, injectionID int32) error {
+	_, err := q.db.ExecContext(ctx, finishInjectionCompensation, injectionID)
+	return err
+}
+
 const getActiveGID = `-- name: GetActiveGID :one
 select upload_id from uploaded_data_files where scope = $1 and data_type ='GLOBALDATA' and status in ('UPLOADED' , 'PROCESSED')
 `
@@ -68,6 +291,40 @@ func (q *Queries) GetAllDataFileStatusByGID(ctx context.Context, gid int32) ([]U
 	return items, nil
 }
 
+const getConnector = `-- name: GetConnector :one
+SELECT scope, name, file_type, schedule, enabled, mapping_profile, source_type, source, created_by, created_on, updated_by, updated_on, last_run_on, last_status, last_error, last_upload_id, claimed_on FROM connectors WHERE scope = $1 AND name = $2
+`
+
+type GetConnectorParams struct {
+	Scope string `json:"scope"`
+	Name  string `json:"name"`
+}
+
+func (q *Queries) GetConnector(ctx context.Context, arg GetConnectorParams) (Connector, error) {
+	row := q.db.QueryRowContext(ctx, getConnector, arg.Scope, arg.Name)
+	var i Connector
+	err := row.Scan(
+		&i.Scope,
+		&i.Name,
+		&i.FileType,
+		&i.Schedule,
+		&i.Enabled,
+		&i.MappingProfile,
+		&i.SourceType,
+		&i.Source,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.UpdatedBy,
+		&i.UpdatedOn,
+		&i.LastRunOn,
+		&i.LastStatus,
+		&i.LastError,
+		&i.LastUploadID,
+		&i.ClaimedOn,
+	)
+	return i, err
+}
+
 const getCoreFactorList = `-- name: GetCoreFactorList :many
 select id, manufacturer, model, core_factor  from core_factor_references
 `
@@ -173,6 +430,41 @@ func (q *Queries) GetCoreFactorReferences(ctx context.Context, arg GetCoreFactor
 	return items, nil
 }
 
+const getDataFile = `-- name: GetDataFile :one
+SELECT upload_id, gid, scope, data_type, file_name, status, uploaded_by, uploaded_on, updated_on, total_records, success_records, failed_records, comments, scope_type, analysis_id, mapping_profile, injection_id, sync_mode, inserted_records, updated_records, deleted_records, processed_records, rejected_rows FROM uploaded_data_files WHERE upload_id = $1 AND data_type = 'DATA'
+`
+
+func (q *Queries) GetDataFile(ctx context.Context, uploadID int32) (UploadedDataFile, error) {
+	row := q.db.QueryRowContext(ctx, getDataFile, uploadID)
+	var i UploadedDataFile
+	err := row.Scan(
+		&i.UploadID,
+		&i.Gid,
+		&i.Scope,
+		&i.DataType,
+		&i.FileName,
+		&i.Status,
+		&i.UploadedBy,
+		&i.UploadedOn,
+		&i.UpdatedOn,
+		&i.TotalRecords,
+		&i.SuccessRecords,
+		&i.FailedRecords,
+		&i.Comments,
+		&i.ScopeType,
+		&i.AnalysisID,
+		&i.MappingProfile,
+		&i.InjectionID,
+		&i.SyncMode,
+		&i.InsertedRecords,
+		&i.UpdatedRecords,
+		&i.DeletedRecords,
+		&i.ProcessedRecords,
+		&i.RejectedRows,
+	)
+	return i, err
+}
+
 const getDataFileRecords = `-- name: GetDataFileRecords :one
 select coalesce(sum(total_records),0)::BIGINT as total_records, coalesce(sum(failed_records),0) ::BIGINT as failed_records from  uploaded_data_files where  date(uploaded_on) >= make_date($1,$2,$3)   and scope = $4  and  file_name SIMILAR TO $5
 `
@@ -270,8 +562,47 @@ func (q *Queries) GetEntityMonthWise(ctx context.Context, arg GetEntityMonthWise
 	return items, nil
 }
 
+const getFailedJobs = `-- name: GetFailedJobs :many
+SELECT job_id, status, comments, data from jobs where status IN ('FAILED', 'DEAD') and data -> 'UploadID' = $1 and type = 'API_WORKER' ORDER BY job_id
+`
+
+type GetFailedJobsRow struct {
+	JobID    int32           `json:"job_id"`
+	Status   JobStatus       `json:"status"`
+	Comments sql.NullString  `json:"comments"`
+	Data     json.RawMessage `json:"data"`
+}
+
+func (q *Queries) GetFailedJobs(ctx context.Context, data json.RawMessage) ([]GetFailedJobsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getFailedJobs, data)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetFailedJobsRow
+	for rows.Next() {
+		var i GetFailedJobsRow
+		if err := rows.Scan(
+			&i.JobID,
+			&i.Status,
+			&i.Comments,
+			&i.Data,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getFailedRecord = `-- name: GetFailedRecord :many
-SELECT count(*) OVER() AS totalRecords, comments, data -> 'Data' as record from jobs where status = 'FAILED' and data -> 'UploadID' = $1 and type = 'API_WORKER' limit $2 offset $3
+SELECT count(*) OVER() AS totalRecords, comments, data -> 'Data' as record from jobs where status IN ('FAILED', 'DEAD') and data -> 'UploadID' = $1 and type = 'API_WORKER' limit $2 offset $3
 `
 
 type GetFailedRecordParams struct {
@@ -310,7 +641,7 @@ func (q *Queries) GetFailedRecord(ctx context.Context, arg GetFailedRecordParams
 }
 
 const getFailureReasons = `-- name: GetFailureReasons :many
-select count(TYPE) as failed_records,comments from jobs where status = 'FAILED' and type in ('FILE_WORKER', 'API_WORKER') and end_time >= make_date($1,$2,$3) and (data -> 'Data' ->> 'scope'  = $4 or data ->> 'scope' = $4 ) and data -> 'Data' -> 'metadata_type' is NULL group by (2)
+select count(TYPE) as failed_records,comments from jobs where status IN ('FAILED', 'DEAD') and type in ('FILE_WORKER', 'API_WORKER') and end_time >= make_date($1,$2,$3) and (data -> 'Data' ->> 'scope'  = $4 or data ->> 'scope' = $4 ) and data -> 'Data' -> 'metadata_type' is NULL group by (2)
 `
 
 type GetFailureReasonsParams struct {
@@ -397,6 +728,33 @@ func (q *Queries) GetGlobalFileInfo(ctx context.Context, arg GetGlobalFileInfoPa
 	return i, err
 }
 
+const getInjection = `-- name: GetInjection :one
+SELECT injection_id, scope, status, total_files, staged_files, total_jobs, done_jobs, failed_jobs, created_by, created_on, updated_on, comments, compensating_jobs, compensated_jobs, failed_compensations FROM injections WHERE injection_id = $1
+`
+
+func (q *Queries) GetInjection(ctx context.Context, injectionID int32) (Injection, error) {
+	row := q.db.QueryRowContext(ctx, getInjection, injectionID)
+	var i Injection
+	err := row.Scan(
+		&i.InjectionID,
+		&i.Scope,
+		&i.Status,
+		&i.TotalFiles,
+		&i.StagedFiles,
+		&i.TotalJobs,
+		&i.DoneJobs,
+		&i.FailedJobs,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.UpdatedOn,
+		&i.Comments,
+		&i.CompensatingJobs,
+		&i.CompensatedJobs,
+		&i.FailedCompensations,
+	)
+	return i, err
+}
+
 const getInjectionStatus = `-- name: GetInjectionStatus :one
 select count(file_name) from uploaded_data_files where scope = $1 and status in ('PENDING', 'INPROGRESS','UPLOADED' , 'PROCESSED')
 `
@@ -408,33 +766,97 @@ func (q *Queries) GetInjectionStatus(ctx context.Context, scope string) (int64,
 	return count, err
 }
 
-const getTransformedGlobalFileInfo = `-- name: GetTransformedGlobalFileInfo :many
-select upload_id, scope, scope_type,file_name ,status from uploaded_data_files where  data_type = 'GLOBALDATA' and (status = 'UPLOADED' or status = 'PROCESSED')
+const getLatestInjection = `-- name: GetLatestInjection :one
+SELECT injection_id, scope, status, total_files, staged_files, total_jobs, done_jobs, failed_jobs, created_by, created_on, updated_on, comments, compensating_jobs, compensated_jobs, failed_compensations FROM injections WHERE scope = $1 AND status <> 'DISCARDED' ORDER BY injection_id DESC LIMIT 1
 `
 
-type GetTransformedGlobalFileInfoRow struct {
-	UploadID  int32        `json:"upload_id"`
-	Scope     string       `json:"scope"`
-	ScopeType ScopeTypes   `json:"scope_type"`
-	FileName  string       `json:"file_name"`
-	Status    UploadStatus `json:"status"`
+func (q *Queries) GetLatestInjection(ctx context.Context, scope string) (Injection, error) {
+	row := q.db.QueryRowContext(ctx, getLatestInjection, scope)
+	var i Injection
+	err := row.Scan(
+		&i.InjectionID,
+		&i.Scope,
+		&i.Status,
+		&i.TotalFiles,
+		&i.StagedFiles,
+		&i.TotalJobs,
+		&i.DoneJobs,
+		&i.FailedJobs,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.UpdatedOn,
+		&i.Comments,
+		&i.CompensatingJobs,
+		&i.CompensatedJobs,
+		&i.FailedCompensations,
+	)
+	return i, err
 }
 
-func (q *Queries) GetTransformedGlobalFileInfo(ctx context.Context) ([]GetTransformedGlobalFileInfoRow, error) {
-	rows, err := q.db.QueryContext(ctx, getTransformedGlobalFileInfo)
+const getMappingProfile = `-- name: GetMappingProfile :one
+SELECT scope, name, mappings, created_by, created_on, updated_by, updated_on FROM mapping_profiles WHERE scope = $1 AND name = $2
+`
+
+type GetMappingProfileParams struct {
+	Scope string `json:"scope"`
+	Name  string `json:"name"`
+}
+
+func (q *Queries) GetMappingProfile(ctx context.Context, arg GetMappingProfileParams) (MappingProfile, error) {
+	row := q.db.QueryRowContext(ctx, getMappingProfile, arg.Scope, arg.Name)
+	var i MappingProfile
+	err := row.Scan(
+		&i.Scope,
+		&i.Name,
+		&i.Mappings,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.UpdatedBy,
+		&i.UpdatedOn,
+	)
+	return i, err
+}
+
+const getQualityResultsMonthWise = `-- name: GetQualityResultsMonthWise :many
+SELECT entity, severity, date_trunc('month', created_on)::DATE as month, SUM(violations)::BIGINT as violations FROM quality_results WHERE scope = $1 AND DATE(created_on) < make_date($2,$3,1) AND created_on >= make_date($4,$5,1)
+GROUP BY (1,2,3)
+`
+
+type GetQualityResultsMonthWiseParams struct {
+	Scope   string `json:"scope"`
+	Year    int32  `json:"year"`
+	Month   int32  `json:"month"`
+	Year_2  int32  `json:"year_2"`
+	Month_2 int32  `json:"month_2"`
+}
+
+type GetQualityResultsMonthWiseRow struct {
+	Entity     string       `json:"entity"`
+	Severity   RuleSeverity `json:"severity"`
+	Month      time.Time    `json:"month"`
+	Violations int64        `json:"violations"`
+}
+
+func (q *Queries) GetQualityResultsMonthWise(ctx context.Context, arg GetQualityResultsMonthWiseParams) ([]GetQualityResultsMonthWiseRow, error) {
+	rows, err := q.db.QueryContext(ctx, getQualityResultsMonthWise,
+		arg.Scope,
+		arg.Year,
+		arg.Month,
+		arg.Year_2,
+		arg.Month_2,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetTransformedGlobalFileInfoRow
+	var items []GetQualityResultsMonthWiseRow
 	for rows.Next() {
-		var i GetTransformedGlobalFileInfoRow
+		var i GetQualityResultsMonthWiseRow
 		if err := rows.Scan(
-			&i.UploadID,
-			&i.Scope,
-			&i.ScopeType,
-			&i.FileName,
-			&i.Status,
+			&i.Entity,
+			&i.Severity,
+			&i.Month,
+			&i.Violations,
 		); err != nil {
 			return nil, err
 		}
@@ -449,57 +871,374 @@ func (q *Queries) GetTransformedGlobalFileInfo(ctx context.Context) ([]GetTransf
 	return items, nil
 }
 
-const insertUploadedData = `-- name: InsertUploadedData :one
-INSERT INTO uploaded_data_files (scope,data_type,file_name,uploaded_by,gid,status,scope_type, analysis_id)
-VALUES($1,$2,$3,$4,$5,$6,$7,$8) returning upload_id, gid, scope, data_type, file_name, status, uploaded_by, uploaded_on, updated_on, total_records, success_records, failed_records, comments, scope_type, analysis_id
+const getQualityRule = `-- name: GetQualityRule :one
+SELECT scope, name, entity, field, operator, params, severity, description, enabled, created_by, created_on, updated_by, updated_on FROM quality_rules WHERE scope = $1 AND name = $2
 `
 
-type InsertUploadedDataParams struct {
-	Scope      string         `json:"scope"`
-	DataType   DataType       `json:"data_type"`
-	FileName   string         `json:"file_name"`
-	UploadedBy string         `json:"uploaded_by"`
-	Gid        int32          `json:"gid"`
-	Status     UploadStatus   `json:"status"`
-	ScopeType  ScopeTypes     `json:"scope_type"`
-	AnalysisID sql.NullString `json:"analysis_id"`
+type GetQualityRuleParams struct {
+	Scope string `json:"scope"`
+	Name  string `json:"name"`
 }
 
-func (q *Queries) InsertUploadedData(ctx context.Context, arg InsertUploadedDataParams) (UploadedDataFile, error) {
-	row := q.db.QueryRowContext(ctx, insertUploadedData,
-		arg.Scope,
-		arg.DataType,
-		arg.FileName,
-		arg.UploadedBy,
-		arg.Gid,
-		arg.Status,
-		arg.ScopeType,
-		arg.AnalysisID,
-	)
-	var i UploadedDataFile
+func (q *Queries) GetQualityRule(ctx context.Context, arg GetQualityRuleParams) (QualityRule, error) {
+	row := q.db.QueryRowContext(ctx, getQualityRule, arg.Scope, arg.Name)
+	var i QualityRule
 	err := row.Scan(
-		&i.UploadID,
-		&i.Gid,
 		&i.Scope,
-		&i.DataType,
-		&i.FileName,
-		&i.Status,
-		&i.UploadedBy,
-		&i.UploadedOn,
+		&i.Name,
+		&i.Entity,
+		&i.Field,
+		&i.Operator,
+		&i.Params,
+		&i.Severity,
+		&i.Description,
+		&i.Enabled,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.UpdatedBy,
 		&i.UpdatedOn,
-		&i.TotalRecords,
+	)
+	return i, err
+}
+
+const getStreamCheckpoint = `-- name: GetStreamCheckpoint :one
+SELECT upload_id, file_name, rows_read, state, updated_on FROM stream_checkpoints WHERE upload_id = $1 AND file_name = $2
+`
+
+type GetStreamCheckpointParams struct {
+	UploadID int32  `json:"upload_id"`
+	FileName string `json:"file_name"`
+}
+
+func (q *Queries) GetStreamCheckpoint(ctx context.Context, arg GetStreamCheckpointParams) (StreamCheckpoint, error) {
+	row := q.db.QueryRowContext(ctx, getStreamCheckpoint, arg.UploadID, arg.FileName)
+	var i StreamCheckpoint
+	err := row.Scan(
+		&i.UploadID,
+		&i.FileName,
+		&i.RowsRead,
+		&i.State,
+		&i.UpdatedOn,
+	)
+	return i, err
+}
+
+const getTransformedGlobalFileInfo = `-- name: GetTransformedGlobalFileInfo :many
+select upload_id, scope, scope_type,file_name ,status from uploaded_data_files where  data_type = 'GLOBALDATA' and (status = 'UPLOADED' or status = 'PROCESSED')
+`
+
+type GetTransformedGlobalFileInfoRow struct {
+	UploadID  int32        `json:"upload_id"`
+	Scope     string       `json:"scope"`
+	ScopeType ScopeTypes   `json:"scope_type"`
+	FileName  string       `json:"file_name"`
+	Status    UploadStatus `json:"status"`
+}
+
+func (q *Queries) GetTransformedGlobalFileInfo(ctx context.Context) ([]GetTransformedGlobalFileInfoRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTransformedGlobalFileInfo)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTransformedGlobalFileInfoRow
+	for rows.Next() {
+		var i GetTransformedGlobalFileInfoRow
+		if err := rows.Scan(
+			&i.UploadID,
+			&i.Scope,
+			&i.ScopeType,
+			&i.FileName,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const injectionFileStaged = `-- name: InjectionFileStaged :one
+SELECT EXISTS(SELECT 1 FROM injection_files WHERE injection_id = $1 AND file_name = $2)
+`
+
+type InjectionFileStagedParams struct {
+	InjectionID int32  `json:"injection_id"`
+	FileName    string `json:"file_name"`
+}
+
+func (q *Queries) InjectionFileStaged(ctx context.Context, arg InjectionFileStagedParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, injectionFileStaged, arg.InjectionID, arg.FileName)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const injectionJobDone = `-- name: InjectionJobDone :one
+UPDATE injections SET done_jobs = done_jobs + 1, failed_jobs = failed_jobs + $2, updated_on = NOW() WHERE injection_id = $1 RETURNING injection_id, scope, status, total_files, staged_files, total_jobs, done_jobs, failed_jobs, created_by, created_on, updated_on, comments, compensating_jobs, compensated_jobs, failed_compensations
+`
+
+type InjectionJobDoneParams struct {
+	InjectionID int32 `json:"injection_id"`
+	FailedJobs  int32 `json:"failed_jobs"`
+}
+
+func (q *Queries) InjectionJobDone(ctx context.Context, arg InjectionJobDoneParams) (Injection, error) {
+	row := q.db.QueryRowContext(ctx, injectionJobDone, arg.InjectionID, arg.FailedJobs)
+	var i Injection
+	err := row.Scan(
+		&i.InjectionID,
+		&i.Scope,
+		&i.Status,
+		&i.TotalFiles,
+		&i.StagedFiles,
+		&i.TotalJobs,
+		&i.DoneJobs,
+		&i.FailedJobs,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.UpdatedOn,
+		&i.Comments,
+		&i.CompensatingJobs,
+		&i.CompensatedJobs,
+		&i.FailedCompensations,
+	)
+	return i, err
+}
+
+const insertConnector = `-- name: InsertConnector :exec
+INSERT INTO connectors (scope,name,file_type,schedule,enabled,mapping_profile,source_type,source,created_by)
+VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9)
+`
+
+type InsertConnectorParams struct {
+	Scope          string          `json:"scope"`
+	Name           string          `json:"name"`
+	FileType       string          `json:"file_type"`
+	Schedule       string          `json:"schedule"`
+	Enabled        bool            `json:"enabled"`
+	MappingProfile string          `json:"mapping_profile"`
+	SourceType     ConnectorSource `json:"source_type"`
+	Source         json.RawMessage `json:"source"`
+	CreatedBy      string          `json:"created_by"`
+}
+
+func (q *Queries) InsertConnector(ctx context.Context, arg InsertConnectorParams) error {
+	_, err := q.db.ExecContext(ctx, insertConnector,
+		arg.Scope,
+		arg.Name,
+		arg.FileType,
+		arg.Schedule,
+		arg.Enabled,
+		arg.MappingProfile,
+		arg.SourceType,
+		arg.Source,
+		arg.CreatedBy,
+	)
+	return err
+}
+
+const insertInjection = `-- name: InsertInjection :one
+INSERT INTO injections (scope,total_files,created_by)
+VALUES($1,$2,$3) returning injection_id, scope, status, total_files, staged_files, total_jobs, done_jobs, failed_jobs, created_by, created_on, updated_on, comments, compensating_jobs, compensated_jobs, failed_compensations
+`
+
+type InsertInjectionParams struct {
+	Scope      string `json:"scope"`
+	TotalFiles int32  `json:"total_files"`
+	CreatedBy  string `json:"created_by"`
+}
+
+func (q *Queries) InsertInjection(ctx context.Context, arg InsertInjectionParams) (Injection, error) {
+	row := q.db.QueryRowContext(ctx, insertInjection, arg.Scope, arg.TotalFiles, arg.CreatedBy)
+	var i Injection
+	err := row.Scan(
+		&i.InjectionID,
+		&i.Scope,
+		&i.Status,
+		&i.TotalFiles,
+		&i.StagedFiles,
+		&i.TotalJobs,
+		&i.DoneJobs,
+		&i.FailedJobs,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.UpdatedOn,
+		&i.Comments,
+		&i.CompensatingJobs,
+		&i.CompensatedJobs,
+		&i.FailedCompensations,
+	)
+	return i, err
+}
+
+const insertInjectionJob = `-- name: InsertInjectionJob :exec
+INSERT INTO injection_jobs (injection_id,file_name,data)
+VALUES($1,$2,$3)
+`
+
+type InsertInjectionJobParams struct {
+	InjectionID int32           `json:"injection_id"`
+	FileName    string          `json:"file_name"`
+	Data        json.RawMessage `json:"data"`
+}
+
+func (q *Queries) InsertInjectionJob(ctx context.Context, arg InsertInjectionJobParams) error {
+	_, err := q.db.ExecContext(ctx, insertInjectionJob, arg.InjectionID, arg.FileName, arg.Data)
+	return err
+}
+
+const insertInjectionSnapshot = `-- name: InsertInjectionSnapshot :exec
+INSERT INTO injection_snapshots (injection_id,entity,record_key,existed,before)
+VALUES($1,$2,$3,$4,$5) ON CONFLICT DO NOTHING
+`
+
+type InsertInjectionSnapshotParams struct {
+	InjectionID int32           `json:"injection_id"`
+	Entity      string          `json:"entity"`
+	RecordKey   string          `json:"record_key"`
+	Existed     bool            `json:"existed"`
+	Before      json.RawMessage `json:"before"`
+}
+
+func (q *Queries) InsertInjectionSnapshot(ctx context.Context, arg InsertInjectionSnapshotParams) error {
+	_, err := q.db.ExecContext(ctx, insertInjectionSnapshot,
+		arg.InjectionID,
+		arg.Entity,
+		arg.RecordKey,
+		arg.Existed,
+		arg.Before,
+	)
+	return err
+}
+
+const insertMappingProfile = `-- name: InsertMappingProfile :exec
+INSERT INTO mapping_profiles (scope,name,mappings,created_by)
+VALUES($1,$2,$3,$4)
+`
+
+type InsertMappingProfileParams struct {
+	Scope     string          `json:"scope"`
+	Name      string          `json:"name"`
+	Mappings  json.RawMessage `json:"mappings"`
+	CreatedBy string          `json:"created_by"`
+}
+
+func (q *Queries) InsertMappingProfile(ctx context.Context, arg InsertMappingProfileParams) error {
+	_, err := q.db.ExecContext(ctx, insertMappingProfile,
+		arg.Scope,
+		arg.Name,
+		arg.Mappings,
+		arg.CreatedBy,
+	)
+	return err
+}
+
+const insertQualityRule = `-- name: InsertQualityRule :exec
+INSERT INTO quality_rules (scope,name,entity,field,operator,params,severity,description,enabled,created_by)
+VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)
+`
+
+type InsertQualityRuleParams struct {
+	Scope       string          `json:"scope"`
+	Name        string          `json:"name"`
+	Entity      string          `json:"entity"`
+	Field       string          `json:"field"`
+	Operator    string          `json:"operator"`
+	Params      json.RawMessage `json:"params"`
+	Severity    RuleSeverity    `json:"severity"`
+	Description string          `json:"description"`
+	Enabled     bool            `json:"enabled"`
+	CreatedBy   string          `json:"created_by"`
+}
+
+func (q *Queries) InsertQualityRule(ctx context.Context, arg InsertQualityRuleParams) error {
+	_, err := q.db.ExecContext(ctx, insertQualityRule,
+		arg.Scope,
+		arg.Name,
+		arg.Entity,
+		arg.Field,
+		arg.Operator,
+		arg.Params,
+		arg.Severity,
+		arg.Description,
+		arg.Enabled,
+		arg.CreatedBy,
+	)
+	return err
+}
+
+const insertUploadedData = `-- name: InsertUploadedData :one
+INSERT INTO uploaded_data_files (scope,data_type,file_name,uploaded_by,gid,status,scope_type, analysis_id, mapping_profile, injection_id, sync_mode)
+VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11) returning upload_id, gid, scope, data_type, file_name, status, uploaded_by, uploaded_on, updated_on, total_records, success_records, failed_records, comments, scope_type, analysis_id, mapping_profile, injection_id, sync_mode, inserted_records, updated_records, deleted_records, processed_records, rejected_rows
+`
+
+type InsertUploadedDataParams struct {
+	Scope          string         `json:"scope"`
+	DataType       DataType       `json:"data_type"`
+	FileName       string         `json:"file_name"`
+	UploadedBy     string         `json:"uploaded_by"`
+	Gid            int32          `json:"gid"`
+	Status         UploadStatus   `json:"status"`
+	ScopeType      ScopeTypes     `json:"scope_type"`
+	AnalysisID     sql.NullString `json:"analysis_id"`
+	MappingProfile string         `json:"mapping_profile"`
+	InjectionID    int32          `json:"injection_id"`
+	SyncMode       SyncMode       `json:"sync_mode"`
+}
+
+func (q *Queries) InsertUploadedData(ctx context.Context, arg InsertUploadedDataParams) (UploadedDataFile, error) {
+	row := q.db.QueryRowContext(ctx, insertUploadedData,
+		arg.Scope,
+		arg.DataType,
+		arg.FileName,
+		arg.UploadedBy,
+		arg.Gid,
+		arg.Status,
+		arg.ScopeType,
+		arg.AnalysisID,
+		arg.MappingProfile,
+		arg.InjectionID,
+		arg.SyncMode,
+	)
+	var i UploadedDataFile
+	err := row.Scan(
+		&i.UploadID,
+		&i.Gid,
+		&i.Scope,
+		&i.DataType,
+		&i.FileName,
+		&i.Status,
+		&i.UploadedBy,
+		&i.UploadedOn,
+		&i.UpdatedOn,
+		&i.TotalRecords,
 		&i.SuccessRecords,
 		&i.FailedRecords,
 		&i.Comments,
 		&i.ScopeType,
 		&i.AnalysisID,
+		&i.MappingProfile,
+		&i.InjectionID,
+		&i.SyncMode,
+		&i.InsertedRecords,
+		&i.UpdatedRecords,
+		&i.DeletedRecords,
+		&i.ProcessedRecords,
+		&i.RejectedRows,
 	)
 	return i, err
 }
 
 const insertUploadedMetaData = `-- name: InsertUploadedMetaData :one
 INSERT INTO uploaded_data_files (file_name,uploaded_by)
-VALUES($1,$2) returning upload_id, gid, scope, data_type, file_name, status, uploaded_by, uploaded_on, updated_on, total_records, success_records, failed_records, comments, scope_type, analysis_id
+VALUES($1,$2) returning upload_id, gid, scope, data_type, file_name, status, uploaded_by, uploaded_on, updated_on, total_records, success_records, failed_records, comments, scope_type, analysis_id, mapping_profile, injection_id, sync_mode, inserted_records, updated_records, deleted_records, processed_records, rejected_rows
 `
 
 type InsertUploadedMetaDataParams struct {
@@ -526,83 +1265,487 @@ func (q *Queries) InsertUploadedMetaData(ctx context.Context, arg InsertUploaded
 		&i.Comments,
 		&i.ScopeType,
 		&i.AnalysisID,
+		&i.MappingProfile,
+		&i.InjectionID,
+		&i.SyncMode,
+		&i.InsertedRecords,
+		&i.UpdatedRecords,
+		&i.DeletedRecords,
+		&i.ProcessedRecords,
+		&i.RejectedRows,
 	)
 	return i, err
 }
 
-const listDeletionRecrods = `-- name: ListDeletionRecrods :many
-SELECT count(*) OVER() AS totalRecords,id, scope, deletion_type, status, reason, created_by, created_on, updated_on from
-deletion_audit
-WHERE
-  scope = $1
-ORDER BY
-  CASE WHEN $2::bool THEN deletion_type END asc,
-  CASE WHEN $3::bool THEN deletion_type END desc,
-  CASE WHEN $4::bool THEN status END asc,
-  CASE WHEN $5::bool THEN status END desc,
-  CASE WHEN $6::bool THEN created_by END asc,
-  CASE WHEN $7::bool THEN created_by END desc,
-  CASE WHEN $8::bool THEN created_on END asc,
-  CASE WHEN $9::bool THEN created_on END desc
-  LIMIT $11 OFFSET $10
+const listConnectors = `-- name: ListConnectors :many
+SELECT scope, name, file_type, schedule, enabled, mapping_profile, source_type, source, created_by, created_on, updated_by, updated_on, last_run_on, last_status, last_error, last_upload_id, claimed_on FROM connectors WHERE scope = $1 ORDER BY name
+`
+
+func (q *Queries) ListConnectors(ctx context.Context, scope string) ([]Connector, error) {
+	rows, err := q.db.QueryContext(ctx, listConnectors, scope)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Connector
+	for rows.Next() {
+		var i Connector
+		if err := rows.Scan(
+			&i.Scope,
+			&i.Name,
+			&i.FileType,
+			&i.Schedule,
+			&i.Enabled,
+			&i.MappingProfile,
+			&i.SourceType,
+			&i.Source,
+			&i.CreatedBy,
+			&i.CreatedOn,
+			&i.UpdatedBy,
+			&i.UpdatedOn,
+			&i.LastRunOn,
+			&i.LastStatus,
+			&i.LastError,
+			&i.LastUploadID,
+			&i.ClaimedOn,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDeletionRecrods = `-- name: ListDeletionRecrods :many
+SELECT count(*) OVER() AS totalRecords,id, scope, deletion_type, status, reason, created_by, created_on, updated_on from
+deletion_audit
+WHERE
+  scope = $1
+ORDER BY
+  CASE WHEN $2::bool THEN deletion_type END asc,
+  CASE WHEN $3::bool THEN deletion_type END desc,
+  CASE WHEN $4::bool THEN status END asc,
+  CASE WHEN $5::bool THEN status END desc,
+  CASE WHEN $6::bool THEN created_by END asc,
+  CASE WHEN $7::bool THEN created_by END desc,
+  CASE WHEN $8::bool THEN created_on END asc,
+  CASE WHEN $9::bool THEN created_on END desc
+  LIMIT $11 OFFSET $10
+`
+
+type ListDeletionRecrodsParams struct {
+	Scope            string `json:"scope"`
+	DeletionTypeAsc  bool   `json:"deletion_type_asc"`
+	DeletionTypeDesc bool   `json:"deletion_type_desc"`
+	StatusAsc        bool   `json:"status_asc"`
+	StatusDesc       bool   `json:"status_desc"`
+	CreatedByAsc     bool   `json:"created_by_asc"`
+	CreatedByDesc    bool   `json:"created_by_desc"`
+	CreatedOnAsc     bool   `json:"created_on_asc"`
+	CreatedOnDesc    bool   `json:"created_on_desc"`
+	PageNum          int32  `json:"page_num"`
+	PageSize         int32  `json:"page_size"`
+}
+
+type ListDeletionRecrodsRow struct {
+	Totalrecords int64          `json:"totalrecords"`
+	ID           int32          `json:"id"`
+	Scope        string         `json:"scope"`
+	DeletionType DeletionType   `json:"deletion_type"`
+	Status       UploadStatus   `json:"status"`
+	Reason       sql.NullString `json:"reason"`
+	CreatedBy    string         `json:"created_by"`
+	CreatedOn    time.Time      `json:"created_on"`
+	UpdatedOn    sql.NullTime   `json:"updated_on"`
+}
+
+func (q *Queries) ListDeletionRecrods(ctx context.Context, arg ListDeletionRecrodsParams) ([]ListDeletionRecrodsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDeletionRecrods,
+		arg.Scope,
+		arg.DeletionTypeAsc,
+		arg.DeletionTypeDesc,
+		arg.StatusAsc,
+		arg.StatusDesc,
+		arg.CreatedByAsc,
+		arg.CreatedByDesc,
+		arg.CreatedOnAsc,
+		arg.CreatedOnDesc,
+		arg.PageNum,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDeletionRecrodsRow
+	for rows.Next() {
+		var i ListDeletionRecrodsRow
+		if err := rows.Scan(
+			&i.Totalrecords,
+			&i.ID,
+			&i.Scope,
+			&i.DeletionType,
+			&i.Status,
+			&i.Reason,
+			&i.CreatedBy,
+			&i.CreatedOn,
+			&i.UpdatedOn,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEnabledConnectors = `-- name: ListEnabledConnectors :many
+SELECT scope, name, file_type, schedule, enabled, mapping_profile, source_type, source, created_by, created_on, updated_by, updated_on, last_run_on, last_status, last_error, last_upload_id, claimed_on FROM connectors WHERE enabled = TRUE
+`
+
+func (q *Queries) ListEnabledConnectors(ctx context.Context) ([]Connector, error) {
+	rows, err := q.db.QueryContext(ctx, listEnabledConnectors)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Connector
+	for rows.Next() {
+		var i Connector
+		if err := rows.Scan(
+			&i.Scope,
+			&i.Name,
+			&i.FileType,
+			&i.Schedule,
+			&i.Enabled,
+			&i.MappingProfile,
+			&i.SourceType,
+			&i.Source,
+			&i.CreatedBy,
+			&i.CreatedOn,
+			&i.UpdatedBy,
+			&i.UpdatedOn,
+			&i.LastRunOn,
+			&i.LastStatus,
+			&i.LastError,
+			&i.LastUploadID,
+			&i.ClaimedOn,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEnabledQualityRules = `-- name: ListEnabledQualityRules :many
+SELECT scope, name, entity, field, operator, params, severity, description, enabled, created_by, created_on, updated_by, updated_on FROM quality_rules WHERE scope = $1 AND enabled = TRUE ORDER BY name
+`
+
+func (q *Queries) ListEnabledQualityRules(ctx context.Context, scope string) ([]QualityRule, error) {
+	rows, err := q.db.QueryContext(ctx, listEnabledQualityRules, scope)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QualityRule
+	for rows.Next() {
+		var i QualityRule
+		if err := rows.Scan(
+			&i.Scope,
+			&i.Name,
+			&i.Entity,
+			&i.Field,
+			&i.Operator,
+			&i.Params,
+			&i.Severity,
+			&i.Description,
+			&i.Enabled,
+			&i.CreatedBy,
+			&i.CreatedOn,
+			&i.UpdatedBy,
+			&i.UpdatedOn,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInjectionJobs = `-- name: ListInjectionJobs :many
+SELECT injection_id, job_seq, data, file_name FROM injection_jobs WHERE injection_id = $1 ORDER BY job_seq
+`
+
+func (q *Queries) ListInjectionJobs(ctx context.Context, injectionID int32) ([]InjectionJob, error) {
+	rows, err := q.db.QueryContext(ctx, listInjectionJobs, injectionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InjectionJob
+	for rows.Next() {
+		var i InjectionJob
+		if err := rows.Scan(
+			&i.InjectionID,
+			&i.JobSeq,
+			&i.Data,
+			&i.FileName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInjections = `-- name: ListInjections :many
+SELECT injection_id, scope, status, total_files, staged_files, total_jobs, done_jobs, failed_jobs, created_by, created_on, updated_on, comments, compensating_jobs, compensated_jobs, failed_compensations FROM injections WHERE scope = $1 ORDER BY injection_id DESC
+`
+
+func (q *Queries) ListInjections(ctx context.Context, scope string) ([]Injection, error) {
+	rows, err := q.db.QueryContext(ctx, listInjections, scope)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Injection
+	for rows.Next() {
+		var i Injection
+		if err := rows.Scan(
+			&i.InjectionID,
+			&i.Scope,
+			&i.Status,
+			&i.TotalFiles,
+			&i.StagedFiles,
+			&i.TotalJobs,
+			&i.DoneJobs,
+			&i.FailedJobs,
+			&i.CreatedBy,
+			&i.CreatedOn,
+			&i.UpdatedOn,
+			&i.Comments,
+			&i.CompensatingJobs,
+			&i.CompensatedJobs,
+			&i.FailedCompensations,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInjectionSnapshots = `-- name: ListInjectionSnapshots :many
+SELECT injection_id, entity, record_key, existed, before FROM injection_snapshots WHERE injection_id = $1
+`
+
+func (q *Queries) ListInjectionSnapshots(ctx context.Context, injectionID int32) ([]InjectionSnapshot, error) {
+	rows, err := q.db.QueryContext(ctx, listInjectionSnapshots, injectionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []InjectionSnapshot
+	for rows.Next() {
+		var i InjectionSnapshot
+		if err := rows.Scan(
+			&i.InjectionID,
+			&i.Entity,
+			&i.RecordKey,
+			&i.Existed,
+			&i.Before,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMappingProfiles = `-- name: ListMappingProfiles :many
+SELECT scope, name, mappings, created_by, created_on, updated_by, updated_on FROM mapping_profiles WHERE scope = $1 ORDER BY name
+`
+
+func (q *Queries) ListMappingProfiles(ctx context.Context, scope string) ([]MappingProfile, error) {
+	rows, err := q.db.QueryContext(ctx, listMappingProfiles, scope)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MappingProfile
+	for rows.Next() {
+		var i MappingProfile
+		if err := rows.Scan(
+			&i.Scope,
+			&i.Name,
+			&i.Mappings,
+			&i.CreatedBy,
+			&i.CreatedOn,
+			&i.UpdatedBy,
+			&i.UpdatedOn,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listQualityRules = `-- name: ListQualityRules :many
+SELECT scope, name, entity, field, operator, params, severity, description, enabled, created_by, created_on, updated_by, updated_on FROM quality_rules WHERE scope = $1 ORDER BY name
+`
+
+func (q *Queries) ListQualityRules(ctx context.Context, scope string) ([]QualityRule, error) {
+	rows, err := q.db.QueryContext(ctx, listQualityRules, scope)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QualityRule
+	for rows.Next() {
+		var i QualityRule
+		if err := rows.Scan(
+			&i.Scope,
+			&i.Name,
+			&i.Entity,
+			&i.Field,
+			&i.Operator,
+			&i.Params,
+			&i.Severity,
+			&i.Description,
+			&i.Enabled,
+			&i.CreatedBy,
+			&i.CreatedOn,
+			&i.UpdatedBy,
+			&i.UpdatedOn,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSyncedRecords = `-- name: ListSyncedRecords :many
+SELECT scope, entity, record_key, hash, data, upload_id, updated_on FROM synced_records WHERE scope = $1 AND entity = $2
 `
 
-type ListDeletionRecrodsParams struct {
-	Scope            string `json:"scope"`
-	DeletionTypeAsc  bool   `json:"deletion_type_asc"`
-	DeletionTypeDesc bool   `json:"deletion_type_desc"`
-	StatusAsc        bool   `json:"status_asc"`
-	StatusDesc       bool   `json:"status_desc"`
-	CreatedByAsc     bool   `json:"created_by_asc"`
-	CreatedByDesc    bool   `json:"created_by_desc"`
-	CreatedOnAsc     bool   `json:"created_on_asc"`
-	CreatedOnDesc    bool   `json:"created_on_desc"`
-	PageNum          int32  `json:"page_num"`
-	PageSize         int32  `json:"page_size"`
+type ListSyncedRecordsParams struct {
+	Scope  string `json:"scope"`
+	Entity string `json:"entity"`
 }
 
-type ListDeletionRecrodsRow struct {
-	Totalrecords int64          `json:"totalrecords"`
-	ID           int32          `json:"id"`
-	Scope        string         `json:"scope"`
-	DeletionType DeletionType   `json:"deletion_type"`
-	Status       UploadStatus   `json:"status"`
-	Reason       sql.NullString `json:"reason"`
-	CreatedBy    string         `json:"created_by"`
-	CreatedOn    time.Time      `json:"created_on"`
-	UpdatedOn    sql.NullTime   `json:"updated_on"`
+func (q *Queries) ListSyncedRecords(ctx context.Context, arg ListSyncedRecordsParams) ([]SyncedRecord, error) {
+	rows, err := q.db.QueryContext(ctx, listSyncedRecords, arg.Scope, arg.Entity)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SyncedRecord
+	for rows.Next() {
+		var i SyncedRecord
+		if err := rows.Scan(
+			&i.Scope,
+			&i.Entity,
+			&i.RecordKey,
+			&i.Hash,
+			&i.Data,
+			&i.UploadID,
+			&i.UpdatedOn,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) ListDeletionRecrods(ctx context.Context, arg ListDeletionRecrodsParams) ([]ListDeletionRecrodsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listDeletionRecrods,
-		arg.Scope,
-		arg.DeletionTypeAsc,
-		arg.DeletionTypeDesc,
-		arg.StatusAsc,
-		arg.StatusDesc,
-		arg.CreatedByAsc,
-		arg.CreatedByDesc,
-		arg.CreatedOnAsc,
-		arg.CreatedOnDesc,
-		arg.PageNum,
-		arg.PageSize,
-	)
+const listSyncedRecordsByKeys = `-- name: ListSyncedRecordsByKeys :many
+SELECT scope, entity, record_key, hash, data, upload_id, updated_on FROM synced_records WHERE scope = $1 AND entity = $2 AND record_key = ANY($3::TEXT[])
+`
+
+type ListSyncedRecordsByKeysParams struct {
+	Scope      string   `json:"scope"`
+	Entity     string   `json:"entity"`
+	RecordKeys []string `json:"record_keys"`
+}
+
+func (q *Queries) ListSyncedRecordsByKeys(ctx context.Context, arg ListSyncedRecordsByKeysParams) ([]SyncedRecord, error) {
+	rows, err := q.db.QueryContext(ctx, listSyncedRecordsByKeys, arg.Scope, arg.Entity, pq.Array(arg.RecordKeys))
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListDeletionRecrodsRow
+	var items []SyncedRecord
 	for rows.Next() {
-		var i ListDeletionRecrodsRow
+		var i SyncedRecord
 		if err := rows.Scan(
-			&i.Totalrecords,
-			&i.ID,
 			&i.Scope,
-			&i.DeletionType,
-			&i.Status,
-			&i.Reason,
-			&i.CreatedBy,
-			&i.CreatedOn,
+			&i.Entity,
+			&i.RecordKey,
+			&i.Hash,
+			&i.Data,
+			&i.UploadID,
 			&i.UpdatedOn,
 		); err != nil {
 			return nil, err
@@ -619,7 +1762,7 @@ func (q *Queries) ListDeletionRecrods(ctx context.Context, arg ListDeletionRecro
 }
 
 const listUploadedDataFiles = `-- name: ListUploadedDataFiles :many
-SELECT count(*) OVER() AS totalRecords,upload_id, gid, scope, data_type, file_name, status, uploaded_by, uploaded_on, updated_on, total_records, success_records, failed_records, comments, scope_type, analysis_id from
+SELECT count(*) OVER() AS totalRecords,upload_id, gid, scope, data_type, file_name, status, uploaded_by, uploaded_on, updated_on, total_records, success_records, failed_records, comments, scope_type, analysis_id, mapping_profile, injection_id, sync_mode, inserted_records, updated_records, deleted_records, processed_records, rejected_rows from
 uploaded_data_files
 WHERE
     scope = ANY($1::TEXT[])
@@ -665,22 +1808,30 @@ type ListUploadedDataFilesParams struct {
 }
 
 type ListUploadedDataFilesRow struct {
-	Totalrecords   int64          `json:"totalrecords"`
-	UploadID       int32          `json:"upload_id"`
-	Gid            int32          `json:"gid"`
-	Scope          string         `json:"scope"`
-	DataType       DataType       `json:"data_type"`
-	FileName       string         `json:"file_name"`
-	Status         UploadStatus   `json:"status"`
-	UploadedBy     string         `json:"uploaded_by"`
-	UploadedOn     time.Time      `json:"uploaded_on"`
-	UpdatedOn      sql.NullTime   `json:"updated_on"`
-	TotalRecords   int32          `json:"total_records"`
-	SuccessRecords int32          `json:"success_records"`
-	FailedRecords  int32          `json:"failed_records"`
-	Comments       sql.NullString `json:"comments"`
-	ScopeType      ScopeTypes     `json:"scope_type"`
-	AnalysisID     sql.NullString `json:"analysis_id"`
+	Totalrecords     int64           `json:"totalrecords"`
+	UploadID         int32           `json:"upload_id"`
+	Gid              int32           `json:"gid"`
+	Scope            string          `json:"scope"`
+	DataType         DataType        `json:"data_type"`
+	FileName         string          `json:"file_name"`
+	Status           UploadStatus    `json:"status"`
+	UploadedBy       string          `json:"uploaded_by"`
+	UploadedOn       time.Time       `json:"uploaded_on"`
+	UpdatedOn        sql.NullTime    `json:"updated_on"`
+	TotalRecords     int32           `json:"total_records"`
+	SuccessRecords   int32           `json:"success_records"`
+	FailedRecords    int32           `json:"failed_records"`
+	Comments         sql.NullString  `json:"comments"`
+	ScopeType        ScopeTypes      `json:"scope_type"`
+	AnalysisID       sql.NullString  `json:"analysis_id"`
+	MappingProfile   string          `json:"mapping_profile"`
+	InjectionID      int32           `json:"injection_id"`
+	SyncMode         SyncMode        `json:"sync_mode"`
+	InsertedRecords  int32           `json:"inserted_records"`
+	UpdatedRecords   int32           `json:"updated_records"`
+	DeletedRecords   int32           `json:"deleted_records"`
+	ProcessedRecords int32           `json:"processed_records"`
+	RejectedRows     json.RawMessage `json:"rejected_rows"`
 }
 
 func (q *Queries) ListUploadedDataFiles(ctx context.Context, arg ListUploadedDataFilesParams) ([]ListUploadedDataFilesRow, error) {
@@ -726,6 +1877,14 @@ func (q *Queries) ListUploadedDataFiles(ctx context.Context, arg ListUploadedDat
 			&i.Comments,
 			&i.ScopeType,
 			&i.AnalysisID,
+			&i.MappingProfile,
+			&i.InjectionID,
+			&i.SyncMode,
+			&i.InsertedRecords,
+			&i.UpdatedRecords,
+			&i.DeletedRecords,
+			&i.ProcessedRecords,
+			&i.RejectedRows,
 		); err != nil {
 			return nil, err
 		}
@@ -741,7 +1900,7 @@ func (q *Queries) ListUploadedDataFiles(ctx context.Context, arg ListUploadedDat
 }
 
 const listUploadedGlobalDataFiles = `-- name: ListUploadedGlobalDataFiles :many
-SELECT count(*) OVER() AS totalRecords,upload_id, gid, scope, data_type, file_name, status, uploaded_by, uploaded_on, updated_on, total_records, success_records, failed_records, comments, scope_type, analysis_id from
+SELECT count(*) OVER() AS totalRecords,upload_id, gid, scope, data_type, file_name, status, uploaded_by, uploaded_on, updated_on, total_records, success_records, failed_records, comments, scope_type, analysis_id, mapping_profile, injection_id, sync_mode, inserted_records, updated_records, deleted_records, processed_records, rejected_rows from
 uploaded_data_files
 WHERE
   scope = ANY($1::TEXT[])
@@ -781,22 +1940,30 @@ type ListUploadedGlobalDataFilesParams struct {
 }
 
 type ListUploadedGlobalDataFilesRow struct {
-	Totalrecords   int64          `json:"totalrecords"`
-	UploadID       int32          `json:"upload_id"`
-	Gid            int32          `json:"gid"`
-	Scope          string         `json:"scope"`
-	DataType       DataType       `json:"data_type"`
-	FileName       string         `json:"file_name"`
-	Status         UploadStatus   `json:"status"`
-	UploadedBy     string         `json:"uploaded_by"`
-	UploadedOn     time.Time      `json:"uploaded_on"`
-	UpdatedOn      sql.NullTime   `json:"updated_on"`
-	TotalRecords   int32          `json:"total_records"`
-	SuccessRecords int32          `json:"success_records"`
-	FailedRecords  int32          `json:"failed_records"`
-	Comments       sql.NullString `json:"comments"`
-	ScopeType      ScopeTypes     `json:"scope_type"`
-	AnalysisID     sql.NullString `json:"analysis_id"`
+	Totalrecords     int64           `json:"totalrecords"`
+	UploadID         int32           `json:"upload_id"`
+	Gid              int32           `json:"gid"`
+	Scope            string          `json:"scope"`
+	DataType         DataType        `json:"data_type"`
+	FileName         string          `json:"file_name"`
+	Status           UploadStatus    `json:"status"`
+	UploadedBy       string          `json:"uploaded_by"`
+	UploadedOn       time.Time       `json:"uploaded_on"`
+	UpdatedOn        sql.NullTime    `json:"updated_on"`
+	TotalRecords     int32           `json:"total_records"`
+	SuccessRecords   int32           `json:"success_records"`
+	FailedRecords    int32           `json:"failed_records"`
+	Comments         sql.NullString  `json:"comments"`
+	ScopeType        ScopeTypes      `json:"scope_type"`
+	AnalysisID       sql.NullString  `json:"analysis_id"`
+	MappingProfile   string          `json:"mapping_profile"`
+	InjectionID      int32           `json:"injection_id"`
+	SyncMode         SyncMode        `json:"sync_mode"`
+	InsertedRecords  int32           `json:"inserted_records"`
+	UpdatedRecords   int32           `json:"updated_records"`
+	DeletedRecords   int32           `json:"deleted_records"`
+	ProcessedRecords int32           `json:"processed_records"`
+	RejectedRows     json.RawMessage `json:"rejected_rows"`
 }
 
 func (q *Queries) ListUploadedGlobalDataFiles(ctx context.Context, arg ListUploadedGlobalDataFilesParams) ([]ListUploadedGlobalDataFilesRow, error) {
@@ -841,6 +2008,14 @@ func (q *Queries) ListUploadedGlobalDataFiles(ctx context.Context, arg ListUploa
 			&i.Comments,
 			&i.ScopeType,
 			&i.AnalysisID,
+			&i.MappingProfile,
+			&i.InjectionID,
+			&i.SyncMode,
+			&i.InsertedRecords,
+			&i.UpdatedRecords,
+			&i.DeletedRecords,
+			&i.ProcessedRecords,
+			&i.RejectedRows,
 		); err != nil {
 			return nil, err
 		}
@@ -856,7 +2031,7 @@ func (q *Queries) ListUploadedGlobalDataFiles(ctx context.Context, arg ListUploa
 }
 
 const listUploadedMetaDataFiles = `-- name: ListUploadedMetaDataFiles :many
-SELECT count(*) OVER() AS totalRecords,upload_id, gid, scope, data_type, file_name, status, uploaded_by, uploaded_on, updated_on, total_records, success_records, failed_records, comments, scope_type, analysis_id from
+SELECT count(*) OVER() AS totalRecords,upload_id, gid, scope, data_type, file_name, status, uploaded_by, uploaded_on, updated_on, total_records, success_records, failed_records, comments, scope_type, analysis_id, mapping_profile, injection_id, sync_mode, inserted_records, updated_records, deleted_records, processed_records, rejected_rows from
 uploaded_data_files
 WHERE
   scope = ANY($1::TEXT[])
@@ -896,22 +2071,30 @@ type ListUploadedMetaDataFilesParams struct {
 }
 
 type ListUploadedMetaDataFilesRow struct {
-	Totalrecords   int64          `json:"totalrecords"`
-	UploadID       int32          `json:"upload_id"`
-	Gid            int32          `json:"gid"`
-	Scope          string         `json:"scope"`
-	DataType       DataType       `json:"data_type"`
-	FileName       string         `json:"file_name"`
-	Status         UploadStatus   `json:"status"`
-	UploadedBy     string         `json:"uploaded_by"`
-	UploadedOn     time.Time      `json:"uploaded_on"`
-	UpdatedOn      sql.NullTime   `json:"updated_on"`
-	TotalRecords   int32          `json:"total_records"`
-	SuccessRecords int32          `json:"success_records"`
-	FailedRecords  int32          `json:"failed_records"`
-	Comments       sql.NullString `json:"comments"`
-	ScopeType      ScopeTypes     `json:"scope_type"`
-	AnalysisID     sql.NullString `json:"analysis_id"`
+	Totalrecords     int64           `json:"totalrecords"`
+	UploadID         int32           `json:"upload_id"`
+	Gid              int32           `json:"gid"`
+	Scope            string          `json:"scope"`
+	DataType         DataType        `json:"data_type"`
+	FileName         string          `json:"file_name"`
+	Status           UploadStatus    `json:"status"`
+	UploadedBy       string          `json:"uploaded_by"`
+	UploadedOn       time.Time       `json:"uploaded_on"`
+	UpdatedOn        sql.NullTime    `json:"updated_on"`
+	TotalRecords     int32           `json:"total_records"`
+	SuccessRecords   int32           `json:"success_records"`
+	FailedRecords    int32           `json:"failed_records"`
+	Comments         sql.NullString  `json:"comments"`
+	ScopeType        ScopeTypes      `json:"scope_type"`
+	AnalysisID       sql.NullString  `json:"analysis_id"`
+	MappingProfile   string          `json:"mapping_profile"`
+	InjectionID      int32           `json:"injection_id"`
+	SyncMode         SyncMode        `json:"sync_mode"`
+	InsertedRecords  int32           `json:"inserted_records"`
+	UpdatedRecords   int32           `json:"updated_records"`
+	DeletedRecords   int32           `json:"deleted_records"`
+	ProcessedRecords int32           `json:"processed_records"`
+	RejectedRows     json.RawMessage `json:"rejected_rows"`
 }
 
 func (q *Queries) ListUploadedMetaDataFiles(ctx context.Context, arg ListUploadedMetaDataFilesParams) ([]ListUploadedMetaDataFilesRow, error) {
@@ -956,6 +2139,14 @@ func (q *Queries) ListUploadedMetaDataFiles(ctx context.Context, arg ListUploade
 			&i.Comments,
 			&i.ScopeType,
 			&i.AnalysisID,
+			&i.MappingProfile,
+			&i.InjectionID,
+			&i.SyncMode,
+			&i.InsertedRecords,
+			&i.UpdatedRecords,
+			&i.DeletedRecords,
+			&i.ProcessedRecords,
+			&i.RejectedRows,
 		); err != nil {
 			return nil, err
 		}
@@ -979,6 +2170,53 @@ func (q *Queries) LogCoreFactor(ctx context.Context, fileName string) error {
 	return err
 }
 
+const restoreFailedJob = `-- name: RestoreFailedJob :exec
+UPDATE jobs SET status = $2, comments = $3 WHERE job_id = $1
+`
+
+type RestoreFailedJobParams struct {
+	JobID    int32          `json:"job_id"`
+	Status   JobStatus      `json:"status"`
+	Comments sql.NullString `json:"comments"`
+}
+
+func (q *Queries) RestoreFailedJob(ctx context.Context, arg RestoreFailedJobParams) error {
+	_, err := q.db.ExecContext(ctx, restoreFailedJob, arg.JobID, arg.Status, arg.Comments)
+	return err
+}
+
+const retryFailedJob = `-- name: RetryFailedJob :execrows
+UPDATE jobs SET status = 'COMPLETED', comments = $2, end_time = NOW() WHERE job_id = $1 AND status IN ('FAILED', 'DEAD') AND type = 'API_WORKER'
+`
+
+type RetryFailedJobParams struct {
+	JobID    int32          `json:"job_id"`
+	Comments sql.NullString `json:"comments"`
+}
+
+func (q *Queries) RetryFailedJob(ctx context.Context, arg RetryFailedJobParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, retryFailedJob, arg.JobID, arg.Comments)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const retryFileFailedRecords = `-- name: RetryFileFailedRecords :exec
+UPDATE uploaded_data_files SET failed_records = failed_records - $3, status = 'INPROGRESS', updated_on = NOW() where upload_id = $1 AND file_name = $2
+`
+
+type RetryFileFailedRecordsParams struct {
+	UploadID      int32  `json:"upload_id"`
+	FileName      string `json:"file_name"`
+	FailedRecords int32  `json:"failed_records"`
+}
+
+func (q *Queries) RetryFileFailedRecords(ctx context.Context, arg RetryFileFailedRecordsParams) error {
+	_, err := q.db.ExecContext(ctx, retryFileFailedRecords, arg.UploadID, arg.FileName, arg.FailedRecords)
+	return err
+}
+
 const setDeletionActive = `-- name: SetDeletionActive :one
 insert into deletion_audit (scope,deletion_type,created_by) values ($1,$2,$3) returning id
 `
@@ -996,6 +2234,127 @@ func (q *Queries) SetDeletionActive(ctx context.Context, arg SetDeletionActivePa
 	return id, err
 }
 
+const setInjectionStatus = `-- name: SetInjectionStatus :exec
+UPDATE injections SET status = $2, comments = $3, updated_on = NOW() WHERE injection_id = $1
+`
+
+type SetInjectionStatusParams struct {
+	InjectionID int32           `json:"injection_id"`
+	Status      InjectionStatus `json:"status"`
+	Comments    sql.NullString  `json:"comments"`
+}
+
+func (q *Queries) SetInjectionStatus(ctx context.Context, arg SetInjectionStatusParams) error {
+	_, err := q.db.ExecContext(ctx, setInjectionStatus, arg.InjectionID, arg.Status, arg.Comments)
+	return err
+}
+
+const stageInjectionFile = `-- name: StageInjectionFile :one
+WITH staged AS (
+  INSERT INTO injection_files (injection_id,file_name) VALUES ($1,$2) ON CONFLICT DO NOTHING RETURNING injection_id
+)
+UPDATE injections SET staged_files = staged_files + 1, total_jobs = total_jobs + $3, updated_on = NOW()
+FROM staged WHERE injections.injection_id = staged.injection_id AND injections.status = 'STAGING' RETURNING injections.injection_id, injections.scope, injections.status, injections.total_files, injections.staged_files, injections.total_jobs, injections.done_jobs, injections.failed_jobs, injections.created_by, injections.created_on, injections.updated_on, injections.comments, injections.compensating_jobs, injections.compensated_jobs, injections.failed_compensations
+`
+
+type StageInjectionFileParams struct {
+	InjectionID int32  `json:"injection_id"`
+	FileName    string `json:"file_name"`
+	TotalJobs   int32  `json:"total_jobs"`
+}
+
+func (q *Queries) StageInjectionFile(ctx context.Context, arg StageInjectionFileParams) (Injection, error) {
+	row := q.db.QueryRowContext(ctx, stageInjectionFile, arg.InjectionID, arg.FileName, arg.TotalJobs)
+	var i Injection
+	err := row.Scan(
+		&i.InjectionID,
+		&i.Scope,
+		&i.Status,
+		&i.TotalFiles,
+		&i.StagedFiles,
+		&i.TotalJobs,
+		&i.DoneJobs,
+		&i.FailedJobs,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.UpdatedOn,
+		&i.Comments,
+		&i.CompensatingJobs,
+		&i.CompensatedJobs,
+		&i.FailedCompensations,
+	)
+	return i, err
+}
+
+const startInjectionCompensation = `-- name: StartInjectionCompensation :exec
+UPDATE injections SET status = 'COMPENSATING', compensating_jobs = $2, compensated_jobs = 0, failed_compensations = 0, comments = $3, updated_on = NOW() WHERE injection_id = $1
+`
+
+type StartInjectionCompensationParams struct {
+	InjectionID      int32          `json:"injection_id"`
+	CompensatingJobs int32          `json:"compensating_jobs"`
+	Comments         sql.NullString `json:"comments"`
+}
+
+func (q *Queries) StartInjectionCompensation(ctx context.Context, arg StartInjectionCompensationParams) error {
+	_, err := q.db.ExecContext(ctx, startInjectionCompensation, arg.InjectionID, arg.CompensatingJobs, arg.Comments)
+	return err
+}
+
+const updateConnector = `-- name: UpdateConnector :exec
+UPDATE connectors SET file_type = $3, schedule = $4, enabled = $5, mapping_profile = $6, source_type = $7, source = $8, updated_by = $9, updated_on = NOW() WHERE scope = $1 AND name = $2
+`
+
+type UpdateConnectorParams struct {
+	Scope          string          `json:"scope"`
+	Name           string          `json:"name"`
+	FileType       string          `json:"file_type"`
+	Schedule       string          `json:"schedule"`
+	Enabled        bool            `json:"enabled"`
+	MappingProfile string          `json:"mapping_profile"`
+	SourceType     ConnectorSource `json:"source_type"`
+	Source         json.RawMessage `json:"source"`
+	UpdatedBy      sql.NullString  `json:"updated_by"`
+}
+
+func (q *Queries) UpdateConnector(ctx context.Context, arg UpdateConnectorParams) error {
+	_, err := q.db.ExecContext(ctx, updateConnector,
+		arg.Scope,
+		arg.Name,
+		arg.FileType,
+		arg.Schedule,
+		arg.Enabled,
+		arg.MappingProfile,
+		arg.SourceType,
+		arg.Source,
+		arg.UpdatedBy,
+	)
+	return err
+}
+
+const updateConnectorRun = `-- name: UpdateConnectorRun :exec
+UPDATE connectors SET last_run_on = NOW(), last_status = $3, last_error = $4, last_upload_id = $5 WHERE scope = $1 AND name = $2
+`
+
+type UpdateConnectorRunParams struct {
+	Scope        string `json:"scope"`
+	Name         string `json:"name"`
+	LastStatus   string `json:"last_status"`
+	LastError    string `json:"last_error"`
+	LastUploadID int32  `json:"last_upload_id"`
+}
+
+func (q *Queries) UpdateConnectorRun(ctx context.Context, arg UpdateConnectorRunParams) error {
+	_, err := q.db.ExecContext(ctx, updateConnectorRun,
+		arg.Scope,
+		arg.Name,
+		arg.LastStatus,
+		arg.LastError,
+		arg.LastUploadID,
+	)
+	return err
+}
+
 const updateDeletionStatus = `-- name: UpdateDeletionStatus :exec
 update deletion_audit set status = $1 , reason = $2 where id = $3
 `
@@ -1055,6 +2414,37 @@ func (q *Queries) UpdateFileFailure(ctx context.Context, arg UpdateFileFailurePa
 	return err
 }
 
+const updateFileProcessedRecords = `-- name: UpdateFileProcessedRecords :exec
+UPDATE uploaded_data_files SET processed_records = $3 WHERE upload_id = $1 AND file_name = $2
+`
+
+type UpdateFileProcessedRecordsParams struct {
+	UploadID         int32           `json:"upload_id"`
+	FileName         string          `json:"file_name"`
+	ProcessedRecords int32           `json:"processed_records"`
+	RejectedRows     json.RawMessage `json:"rejected_rows"`
+}
+
+func (q *Queries) UpdateFileProcessedRecords(ctx context.Context, arg UpdateFileProcessedRecordsParams) error {
+	_, err := q.db.ExecContext(ctx, updateFileProcessedRecords, arg.UploadID, arg.FileName, arg.ProcessedRecords)
+	return err
+}
+
+const updateFileRejectedRows = `-- name: UpdateFileRejectedRows :exec
+UPDATE uploaded_data_files SET rejected_rows = $3 WHERE upload_id = $1 AND file_name = $2
+`
+
+type UpdateFileRejectedRowsParams struct {
+	UploadID     int32           `json:"upload_id"`
+	FileName     string          `json:"file_name"`
+	RejectedRows json.RawMessage `json:"rejected_rows"`
+}
+
+func (q *Queries) UpdateFileRejectedRows(ctx context.Context, arg UpdateFileRejectedRowsParams) error {
+	_, err := q.db.ExecContext(ctx, updateFileRejectedRows, arg.UploadID, arg.FileName, arg.RejectedRows)
+	return err
+}
+
 const updateFileStatus = `-- name: UpdateFileStatus :exec
 UPDATE uploaded_data_files SET status = $1, updated_on = NOW() , comments = $2 where upload_id = $3 AND file_name = $4
 `
@@ -1122,6 +2512,29 @@ func (q *Queries) UpdateFileSuccessRecord(ctx context.Context, arg UpdateFileSuc
 	return i, err
 }
 
+const updateFileSyncRecords = `-- name: UpdateFileSyncRecords :exec
+UPDATE uploaded_data_files SET inserted_records = $3, updated_records = $4, deleted_records = $5 WHERE upload_id = $1 AND file_name = $2
+`
+
+type UpdateFileSyncRecordsParams struct {
+	UploadID        int32  `json:"upload_id"`
+	FileName        string `json:"file_name"`
+	InsertedRecords int32  `json:"inserted_records"`
+	UpdatedRecords  int32  `json:"updated_records"`
+	DeletedRecords  int32  `json:"deleted_records"`
+}
+
+func (q *Queries) UpdateFileSyncRecords(ctx context.Context, arg UpdateFileSyncRecordsParams) error {
+	_, err := q.db.ExecContext(ctx, updateFileSyncRecords,
+		arg.UploadID,
+		arg.FileName,
+		arg.InsertedRecords,
+		arg.UpdatedRecords,
+		arg.DeletedRecords,
+	)
+	return err
+}
+
 const updateFileTotalRecord = `-- name: UpdateFileTotalRecord :exec
 UPDATE uploaded_data_files SET total_records = $1 , failed_records = $2  where upload_id = $3 AND file_name = $4
 `
@@ -1143,6 +2556,27 @@ func (q *Queries) UpdateFileTotalRecord(ctx context.Context, arg UpdateFileTotal
 	return err
 }
 
+const updateMappingProfile = `-- name: UpdateMappingProfile :exec
+UPDATE mapping_profiles SET mappings = $3, updated_by = $4, updated_on = NOW() WHERE scope = $1 AND name = $2
+`
+
+type UpdateMappingProfileParams struct {
+	Scope     string          `json:"scope"`
+	Name      string          `json:"name"`
+	Mappings  json.RawMessage `json:"mappings"`
+	UpdatedBy sql.NullString  `json:"updated_by"`
+}
+
+func (q *Queries) UpdateMappingProfile(ctx context.Context, arg UpdateMappingProfileParams) error {
+	_, err := q.db.ExecContext(ctx, updateMappingProfile,
+		arg.Scope,
+		arg.Name,
+		arg.Mappings,
+		arg.UpdatedBy,
+	)
+	return err
+}
+
 const updateGlobalFileStatus = `-- name: UpdateGlobalFileStatus :one
 update uploaded_data_files 
 set status = (CASE
@@ -1163,3 +2597,117 @@ func (q *Queries) UpdateGlobalFileStatus(ctx context.Context, arg UpdateGlobalFi
 	err := row.Scan(&scope)
 	return scope, err
 }
+
+const updateQualityRule = `-- name: UpdateQualityRule :exec
+UPDATE quality_rules SET entity = $3, field = $4, operator = $5, params = $6, severity = $7, description = $8, enabled = $9, updated_by = $10, updated_on = NOW() WHERE scope = $1 AND name = $2
+`
+
+type UpdateQualityRuleParams struct {
+	Scope       string          `json:"scope"`
+	Name        string          `json:"name"`
+	Entity      string          `json:"entity"`
+	Field       string          `json:"field"`
+	Operator    string          `json:"operator"`
+	Params      json.RawMessage `json:"params"`
+	Severity    RuleSeverity    `json:"severity"`
+	Description string          `json:"description"`
+	Enabled     bool            `json:"enabled"`
+	UpdatedBy   sql.NullString  `json:"updated_by"`
+}
+
+func (q *Queries) UpdateQualityRule(ctx context.Context, arg UpdateQualityRuleParams) error {
+	_, err := q.db.ExecContext(ctx, updateQualityRule,
+		arg.Scope,
+		arg.Name,
+		arg.Entity,
+		arg.Field,
+		arg.Operator,
+		arg.Params,
+		arg.Severity,
+		arg.Description,
+		arg.Enabled,
+		arg.UpdatedBy,
+	)
+	return err
+}
+
+const upsertQualityResult = `-- name: UpsertQualityResult :exec
+INSERT INTO quality_results (upload_id,file_name,rule_name,scope,entity,severity,violations)
+VALUES($1,$2,$3,$4,$5,$6,$7)
+ON CONFLICT (upload_id,file_name,rule_name) DO UPDATE SET violations = EXCLUDED.violations, created_on = NOW()
+`
+
+type UpsertQualityResultParams struct {
+	UploadID   int32        `json:"upload_id"`
+	FileName   string       `json:"file_name"`
+	RuleName   string       `json:"rule_name"`
+	Scope      string       `json:"scope"`
+	Entity     string       `json:"entity"`
+	Severity   RuleSeverity `json:"severity"`
+	Violations int32        `json:"violations"`
+}
+
+func (q *Queries) UpsertQualityResult(ctx context.Context, arg UpsertQualityResultParams) error {
+	_, err := q.db.ExecContext(ctx, upsertQualityResult,
+		arg.UploadID,
+		arg.FileName,
+		arg.RuleName,
+		arg.Scope,
+		arg.Entity,
+		arg.Severity,
+		arg.Violations,
+	)
+	return err
+}
+
+const upsertStreamCheckpoint = `-- name: UpsertStreamCheckpoint :exec
+INSERT INTO stream_checkpoints (upload_id,file_name,rows_read,state)
+VALUES($1,$2,$3,$4)
+ON CONFLICT (upload_id,file_name)
+DO UPDATE SET rows_read = $3, state = $4, updated_on = NOW()
+`
+
+type UpsertStreamCheckpointParams struct {
+	UploadID int32           `json:"upload_id"`
+	FileName string          `json:"file_name"`
+	RowsRead int32           `json:"rows_read"`
+	State    json.RawMessage `json:"state"`
+}
+
+func (q *Queries) UpsertStreamCheckpoint(ctx context.Context, arg UpsertStreamCheckpointParams) error {
+	_, err := q.db.ExecContext(ctx, upsertStreamCheckpoint,
+		arg.UploadID,
+		arg.FileName,
+		arg.RowsRead,
+		arg.State,
+	)
+	return err
+}
+
+const upsertSyncedRecord = `-- name: UpsertSyncedRecord :exec
+INSERT INTO synced_records (scope,entity,record_key,hash,data,upload_id)
+VALUES($1,$2,$3,$4,$5,$6)
+ON CONFLICT (scope,entity,record_key)
+DO UPDATE SET hash = $4, data = $5, upload_id = $6, updated_on = NOW()
+`
+
+type UpsertSyncedRecordParams struct {
+	Scope     string          `json:"scope"`
+	Entity    string          `json:"entity"`
+	RecordKey string          `json:"record_key"`
+	Hash      string          `json:"hash"`
+	Data      json.RawMessage `json:"data"`
+	UploadID  int32           `json:"upload_id"`
+}
+
+func (q *Queries) UpsertSyncedRecord(ctx context.Context, arg UpsertSyncedRecordParams) error {
+	_, err := q.db.ExecContext(ctx, upsertSyncedRecord,
+		arg.Scope,
+		arg.Entity,
+		arg.RecordKey,
+		arg.Hash,
+		arg.Data,
+		arg.UploadID,
+	)
+	return err
+}
@@ -1,6 +1,6 @@
 -- name: InsertUploadedData :one
-INSERT INTO uploaded_data_files (scope,data_type,file_name,uploaded_by,gid,status,scope_type, analysis_id)
-VALUES($1,$2,$3,$4,$5,$6,$7,$8) returning *;
+INSERT INTO uploaded_data_files (scope,data_type,file_name,uploaded_by,gid,status,scope_type, analysis_id, mapping_profile, injection_id, sync_mode)
+VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11) returning *;
 
 -- name: InsertUploadedMetaData :one
 INSERT INTO uploaded_data_files (file_name,uploaded_by)
@@ -77,14 +77,29 @@ ORDER BY
 
 
 -- name: GetFailedRecord :many
-SELECT count(*) OVER() AS totalRecords, comments, data -> 'Data' as record from jobs where status = 'FAILED' and data -> 'UploadID' = $1 and type = 'API_WORKER' limit $2 offset $3;
+SELECT count(*) OVER() AS totalRecords, comments, data -> 'Data' as record from jobs where status IN ('FAILED', 'DEAD') and data -> 'UploadID' = $1 and type = 'API_WORKER' limit $2 offset $3;
+
+-- name: GetFailedJobs :many
+SELECT job_id, status, comments, data from jobs where status IN ('FAILED', 'DEAD') and data -> 'UploadID' = $1 and type = 'API_WORKER' ORDER BY job_id;
+
+-- name: GetDataFile :one
+SELECT * FROM uploaded_data_files WHERE upload_id = $1 AND data_type = 'DATA';
+
+-- name: RetryFailedJob :execrows
+UPDATE jobs SET status = 'COMPLETED', comments = $2, end_time = NOW() WHERE job_id = $1 AND status IN ('FAILED', 'DEAD') AND type = 'API_WORKER';
+
+-- name: RestoreFailedJob :exec
+UPDATE jobs SET status = $2, comments = $3 WHERE job_id = $1;
+
+-- name: RetryFileFailedRecords :exec
+UPDATE uploaded_data_files SET failed_records = failed_records - $3, status = 'INPROGRESS', updated_on = NOW() where upload_id = $1 AND file_name = $2;
 
 -- name: GetEntityMonthWise :many
 select sum(success_records), lower(file_name) as filename, EXTRACT(month from uploaded_on) as month, EXTRACT(year from uploaded_on) as year from  uploaded_data_files where  DATE(uploaded_on)  < make_date($1,$2,1) and  uploaded_on >= make_date($3,$4,1)  and scope = $5  and status = 'SUCCESS'  and  file_name SIMILAR TO $6
 group by ( 2,3,4)  order by 3 desc , 4 DESC ;
 
 -- name: GetFailureReasons :many
-select count(TYPE) as failed_records,comments from jobs where status = 'FAILED' and type in ('FILE_WORKER', 'API_WORKER') and end_time >= make_date($1,$2,$3) and (data -> 'Data' ->> 'scope'  = $4 or data ->> 'scope' = $4 ) and data -> 'Data' -> 'metadata_type' is NULL group by (2);
+select count(TYPE) as failed_records,comments from jobs where status IN ('FAILED', 'DEAD') and type in ('FILE_WORKER', 'API_WORKER') and end_time >= make_date($1,$2,$3) and (data -> 'Data' ->> 'scope'  = $4 or data ->> 'scope' = $4 ) and data -> 'Data' -> 'metadata_type' is NULL group by (2);
 
 -- name: GetDataFileRecords :one
 select coalesce(sum(total_records),0)::BIGINT as total_records, coalesce(sum(failed_records),0) ::BIGINT as failed_records from  uploaded_data_files where  date(uploaded_on) >= make_date($1,$2,$3)   and scope = $4  and  file_name SIMILAR TO $5;
@@ -175,4 +190,183 @@ insert into core_factor_logs (file_name)values($1);
 delete from core_factor_references;
 
 -- name: GetGlobalFileInfo :one
-select analysis_id, file_name,scope_type, upload_id from uploaded_data_files where data_type = 'GLOBALDATA' and  scope = $1 and upload_id = $2 ;
\ No newline at end of file
+select analysis_id, file_name,scope_type, upload_id from uploaded_data_files where data_type = 'GLOBALDATA' and  scope = $1 and upload_id = $2 ;
+
+-- name: InsertMappingProfile :exec
+INSERT INTO mapping_profiles (scope,name,mappings,created_by)
+VALUES($1,$2,$3,$4);
+
+-- name: UpdateMappingProfile :exec
+UPDATE mapping_profiles SET mappings = $3, updated_by = $4, updated_on = NOW() WHERE scope = $1 AND name = $2;
+
+-- name: GetMappingProfile :one
+SELECT * FROM mapping_profiles WHERE scope = $1 AND name = $2;
+
+-- name: ListMappingProfiles :many
+SELECT * FROM mapping_profiles WHERE scope = $1 ORDER BY name;
+
+-- name: DeleteMappingProfile :exec
+DELETE FROM mapping_profiles WHERE scope = $1 AND name = $2;
+
+-- name: InsertInjection :one
+INSERT INTO injections (scope,total_files,created_by)
+VALUES($1,$2,$3) returning *;
+
+-- name: GetInjection :one
+SELECT * FROM injections WHERE injection_id = $1;
+
+-- name: GetLatestInjection :one
+SELECT * FROM injections WHERE scope = $1 AND status <> 'DISCARDED' ORDER BY injection_id DESC LIMIT 1;
+
+-- name: ListInjections :many
+SELECT * FROM injections WHERE scope = $1 ORDER BY injection_id DESC;
+
+-- name: StageInjectionFile :one
+WITH staged AS (
+  INSERT INTO injection_files (injection_id,file_name) VALUES ($1,$2) ON CONFLICT DO NOTHING RETURNING injection_id
+)
+UPDATE injections SET staged_files = staged_files + 1, total_jobs = total_jobs + $3, updated_on = NOW()
+FROM staged WHERE injections.injection_id = staged.injection_id AND injections.status = 'STAGING' RETURNING injections.*;
+
+-- name: InjectionFileStaged :one
+SELECT EXISTS(SELECT 1 FROM injection_files WHERE injection_id = $1 AND file_name = $2);
+
+-- name: DiscardInjection :exec
+UPDATE injections SET status = 'DISCARDED', comments = $2, updated_on = NOW() WHERE injection_id = $1 AND status = 'STAGING';
+
+-- name: SetInjectionStatus :exec
+UPDATE injections SET status = $2, comments = $3, updated_on = NOW() WHERE injection_id = $1;
+
+-- name: InjectionJobDone :one
+UPDATE injections SET done_jobs = done_jobs + 1, failed_jobs = failed_jobs + $2, updated_on = NOW() WHERE injection_id = $1 RETURNING *;
+
+-- name: StartInjectionCompensation :exec
+UPDATE injections SET status = 'COMPENSATING', compensating_jobs = $2, compensated_jobs = 0, failed_compensations = 0, comments = $3, updated_on = NOW() WHERE injection_id = $1;
+
+-- name: CompensationJobDone :one
+UPDATE injections SET compensated_jobs = compensated_jobs + 1, failed_compensations = failed_compensations + $2, updated_on = NOW() WHERE injection_id = $1 RETURNING *;
+
+-- name: FinishInjectionCompensation :exec
+UPDATE injections SET status = 'COMPENSATED', updated_on = NOW() WHERE injection_id = $1 AND status = 'COMPENSATING';
+
+-- name: DeleteInjections :exec
+DELETE FROM injections WHERE scope = $1;
+
+-- name: AbortInjectionFiles :exec
+UPDATE uploaded_data_files SET status = 'FAILED', comments = $2, updated_on = NOW() WHERE injection_id = $1 AND status <> 'FAILED';
+
+-- name: InsertInjectionJob :exec
+INSERT INTO injection_jobs (injection_id,file_name,data)
+VALUES($1,$2,$3);
+
+-- name: ListInjectionJobs :many
+SELECT * FROM injection_jobs WHERE injection_id = $1 ORDER BY job_seq;
+
+-- name: DeleteInjectionJobs :exec
+DELETE FROM injection_jobs WHERE injection_id = $1;
+
+-- name: DeleteInjectionFileJobs :exec
+DELETE FROM injection_jobs WHERE injection_id = $1 AND file_name = $2;
+
+-- name: InsertInjectionSnapshot :exec
+INSERT INTO injection_snapshots (injection_id,entity,record_key,existed,before)
+VALUES($1,$2,$3,$4,$5) ON CONFLICT DO NOTHING;
+
+-- name: ListInjectionSnapshots :many
+SELECT * FROM injection_snapshots WHERE injection_id = $1;
+
+-- name: ListSyncedRecords :many
+SELECT * FROM synced_records WHERE scope = $1 AND entity = $2;
+
+-- name: ListSyncedRecordsByKeys :many
+SELECT * FROM synced_records WHERE scope = @scope AND entity = @entity AND record_key = ANY(@record_keys::TEXT[]);
+
+-- name: UpsertSyncedRecord :exec
+INSERT INTO synced_records (scope,entity,record_key,hash,data,upload_id)
+VALUES($1,$2,$3,$4,$5,$6)
+ON CONFLICT (scope,entity,record_key)
+DO UPDATE SET hash = $4, data = $5, upload_id = $6, updated_on = NOW();
+
+-- name: DeleteSyncedRecord :exec
+DELETE FROM synced_records WHERE scope = $1 AND entity = $2 AND record_key = $3;
+
+-- name: DeleteSyncedRecords :exec
+DELETE FROM synced_records WHERE scope = $1;
+
+-- name: UpdateFileSyncRecords :exec
+UPDATE uploaded_data_files SET inserted_records = $3, updated_records = $4, deleted_records = $5 WHERE upload_id = $1 AND file_name = $2;
+
+-- name: UpdateFileProcessedRecords :exec
+UPDATE uploaded_data_files SET processed_records = $3 WHERE upload_id = $1 AND file_name = $2;
+
+-- name: UpdateFileRejectedRows :exec
+UPDATE uploaded_data_files SET rejected_rows = $3 WHERE upload_id = $1 AND file_name = $2;
+
+-- name: AddFileTotalRecords :one
+UPDATE uploaded_data_files SET total_records = total_records + $3 WHERE upload_id = $1 AND file_name = $2 RETURNING   total_records = (success_records) as isSuccess, total_records = (failed_records) as isFailed, total_records = (success_records + failed_records) as isPartial;
+
+-- name: GetStreamCheckpoint :one
+SELECT * FROM stream_checkpoints WHERE upload_id = $1 AND file_name = $2;
+
+-- name: UpsertStreamCheckpoint :exec
+INSERT INTO stream_checkpoints (upload_id,file_name,rows_read,state)
+VALUES($1,$2,$3,$4)
+ON CONFLICT (upload_id,file_name)
+DO UPDATE SET rows_read = $3, state = $4, updated_on = NOW();
+
+-- name: DeleteStreamCheckpoint :exec
+DELETE FROM stream_checkpoints WHERE upload_id = $1 AND file_name = $2;
+
+-- name: InsertConnector :exec
+INSERT INTO connectors (scope,name,file_type,schedule,enabled,mapping_profile,source_type,source,created_by)
+VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9);
+
+-- name: UpdateConnector :exec
+UPDATE connectors SET file_type = $3, schedule = $4, enabled = $5, mapping_profile = $6, source_type = $7, source = $8, updated_by = $9, updated_on = NOW() WHERE scope = $1 AND name = $2;
+
+-- name: GetConnector :one
+SELECT * FROM connectors WHERE scope = $1 AND name = $2;
+
+-- name: ListConnectors :many
+SELECT * FROM connectors WHERE scope = $1 ORDER BY name;
+
+-- name: ListEnabledConnectors :many
+SELECT * FROM connectors WHERE enabled = TRUE;
+
+-- name: DeleteConnector :exec
+DELETE FROM connectors WHERE scope = $1 AND name = $2;
+
+-- name: UpdateConnectorRun :exec
+UPDATE connectors SET last_run_on = NOW(), last_status = $3, last_error = $4, last_upload_id = $5 WHERE scope = $1 AND name = $2;
+
+-- name: ClaimConnectorRun :execrows
+UPDATE connectors SET claimed_on = @run_on
+WHERE scope = @scope AND name = @name AND enabled = TRUE AND (claimed_on IS NULL OR claimed_on < @run_on);
+
+-- name: InsertQualityRule :exec
+INSERT INTO quality_rules (scope,name,entity,field,operator,params,severity,description,enabled,created_by)
+VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,$10);
+
+-- name: UpdateQualityRule :exec
+UPDATE quality_rules SET entity = $3, field = $4, operator = $5, params = $6, severity = $7, description = $8, enabled = $9, updated_by = $10, updated_on = NOW() WHERE scope = $1 AND name = $2;
+
+-- name: GetQualityRule :one
+SELECT * FROM quality_rules WHERE scope = $1 AND name = $2;
+
+-- name: ListQualityRules :many
+SELECT * FROM quality_rules WHERE scope = $1 ORDER BY name;
+
+-- name: ListEnabledQualityRules :many
+SELECT * FROM quality_rules WHERE scope = $1 AND enabled = TRUE ORDER BY name;
+
+-- name: DeleteQualityRule :exec
+DELETE FROM quality_rules WHERE scope = $1 AND name = $2;
+
+-- name: UpsertQualityResult :exec
+INSERT INTO quality_results (upload_id,file_name,rule_name,scope,entity,severity,violations)
+VALUES($1,$2,$3,$4,$5,$6,$7)
+ON CONFLICT (upload_id,file_name,rule_name) DO UPDATE SET violations = EXCLUDED.violations, created_on = NOW();
+
+-- name: GetQualityResultsMonthWise :many
+SELECT entity, severity, date_trunc('month', created_on)::DATE as month, SUM(violations)::BIGINT as violations FROM quality_results WHERE scope = $1 AND DATE(created_on) < make_date($2,$3,1) AND created_on >= make_date($4,$5,1)
+GROUP BY (1,2,3);
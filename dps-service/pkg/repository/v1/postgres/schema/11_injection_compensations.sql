@@ -0,0 +1,26 @@
+-- +migrate Up notransaction
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TYPE injection_status RENAME VALUE 'ROLLED_BACK' TO 'COMPENSATED';
+ALTER TYPE injection_status ADD VALUE IF NOT EXISTS 'COMPENSATING';
+
+ALTER TABLE injections ADD COLUMN IF NOT EXISTS compensating_jobs INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE injections ADD COLUMN IF NOT EXISTS compensated_jobs INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE injections ADD COLUMN IF NOT EXISTS failed_compensations INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE injection_jobs ADD COLUMN IF NOT EXISTS file_name VARCHAR NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS injection_files (
+    injection_id INTEGER NOT NULL REFERENCES injections (injection_id) ON DELETE CASCADE,
+    file_name VARCHAR NOT NULL,
+    PRIMARY KEY(injection_id,file_name)
+);
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE IF EXISTS injection_files;
+ALTER TABLE injection_jobs DROP COLUMN IF EXISTS file_name;
+ALTER TABLE injections DROP COLUMN IF EXISTS failed_compensations;
+ALTER TABLE injections DROP COLUMN IF EXISTS compensated_jobs;
+ALTER TABLE injections DROP COLUMN IF EXISTS compensating_jobs;
+UPDATE injections SET status = 'COMPENSATED' WHERE status = 'COMPENSATING';
+ALTER TYPE injection_status RENAME VALUE 'COMPENSATED' TO 'ROLLED_BACK';
@@ -0,0 +1,7 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE connectors ADD COLUMN IF NOT EXISTS claimed_on TIMESTAMP;
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE connectors DROP COLUMN IF EXISTS claimed_on;
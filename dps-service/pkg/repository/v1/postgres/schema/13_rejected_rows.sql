@@ -0,0 +1,7 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE uploaded_data_files ADD COLUMN IF NOT EXISTS rejected_rows JSONB NOT NULL DEFAULT '[]';
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE uploaded_data_files DROP COLUMN IF EXISTS rejected_rows;
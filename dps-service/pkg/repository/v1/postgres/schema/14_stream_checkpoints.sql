@@ -0,0 +1,14 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE IF NOT EXISTS stream_checkpoints (
+    upload_id INTEGER NOT NULL,
+    file_name VARCHAR NOT NULL,
+    rows_read INTEGER NOT NULL DEFAULT 0,
+    state JSONB NOT NULL DEFAULT '{}',
+    updated_on TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY(upload_id,file_name)
+);
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE stream_checkpoints;
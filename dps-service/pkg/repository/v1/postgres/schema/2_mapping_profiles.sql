@@ -0,0 +1,19 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE IF NOT EXISTS mapping_profiles (
+    scope VARCHAR NOT NULL,
+    name VARCHAR NOT NULL,
+    mappings JSONB NOT NULL DEFAULT '[]',
+    created_by VARCHAR NOT NULL,
+    created_on TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_by VARCHAR,
+    updated_on TIMESTAMP,
+    PRIMARY KEY(scope,name)
+);
+
+ALTER TABLE uploaded_data_files ADD COLUMN IF NOT EXISTS mapping_profile VARCHAR NOT NULL DEFAULT '';
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE uploaded_data_files DROP COLUMN IF EXISTS mapping_profile;
+DROP TABLE mapping_profiles;
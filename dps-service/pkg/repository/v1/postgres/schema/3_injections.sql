@@ -0,0 +1,44 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TYPE injection_status AS ENUM ('STAGING', 'APPLYING', 'COMMITTED', 'DISCARDED', 'ROLLED_BACK');
+
+CREATE TABLE IF NOT EXISTS injections (
+    injection_id SERIAL PRIMARY KEY,
+    scope VARCHAR NOT NULL,
+    status injection_status NOT NULL DEFAULT 'STAGING',
+    total_files INTEGER NOT NULL,
+    staged_files INTEGER NOT NULL DEFAULT 0,
+    total_jobs INTEGER NOT NULL DEFAULT 0,
+    done_jobs INTEGER NOT NULL DEFAULT 0,
+    failed_jobs INTEGER NOT NULL DEFAULT 0,
+    created_by VARCHAR NOT NULL,
+    created_on TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_on TIMESTAMP,
+    comments VARCHAR
+);
+
+CREATE TABLE IF NOT EXISTS injection_jobs (
+    injection_id INTEGER NOT NULL REFERENCES injections (injection_id) ON DELETE CASCADE,
+    job_seq SERIAL,
+    data JSONB NOT NULL,
+    PRIMARY KEY(injection_id,job_seq)
+);
+
+CREATE TABLE IF NOT EXISTS injection_snapshots (
+    injection_id INTEGER NOT NULL REFERENCES injections (injection_id) ON DELETE CASCADE,
+    entity VARCHAR NOT NULL,
+    record_key VARCHAR NOT NULL,
+    existed BOOLEAN NOT NULL,
+    before JSONB NOT NULL DEFAULT '{}',
+    PRIMARY KEY(injection_id,entity,record_key)
+);
+
+ALTER TABLE uploaded_data_files ADD COLUMN IF NOT EXISTS injection_id INTEGER NOT NULL DEFAULT 0;
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE uploaded_data_files DROP COLUMN IF EXISTS injection_id;
+DROP TABLE injection_snapshots;
+DROP TABLE injection_jobs;
+DROP TABLE injections;
+DROP TYPE injection_status;
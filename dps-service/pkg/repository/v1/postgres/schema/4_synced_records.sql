@@ -0,0 +1,28 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TYPE sync_mode AS ENUM ('FULL', 'DELTA', 'SNAPSHOT');
+
+CREATE TABLE IF NOT EXISTS synced_records (
+    scope VARCHAR NOT NULL,
+    entity VARCHAR NOT NULL,
+    record_key VARCHAR NOT NULL,
+    hash VARCHAR NOT NULL,
+    data JSONB NOT NULL,
+    upload_id INTEGER NOT NULL,
+    updated_on TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY(scope,entity,record_key)
+);
+
+ALTER TABLE uploaded_data_files ADD COLUMN IF NOT EXISTS sync_mode sync_mode NOT NULL DEFAULT 'FULL';
+ALTER TABLE uploaded_data_files ADD COLUMN IF NOT EXISTS inserted_records INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE uploaded_data_files ADD COLUMN IF NOT EXISTS updated_records INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE uploaded_data_files ADD COLUMN IF NOT EXISTS deleted_records INTEGER NOT NULL DEFAULT 0;
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+ALTER TABLE uploaded_data_files DROP COLUMN IF EXISTS deleted_records;
+ALTER TABLE uploaded_data_files DROP COLUMN IF EXISTS updated_records;
+ALTER TABLE uploaded_data_files DROP COLUMN IF EXISTS inserted_records;
+ALTER TABLE uploaded_data_files DROP COLUMN IF EXISTS sync_mode;
+DROP TABLE synced_records;
+DROP TYPE sync_mode;
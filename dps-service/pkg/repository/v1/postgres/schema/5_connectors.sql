@@ -0,0 +1,28 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TYPE connector_source AS ENUM ('SQL', 'REST', 'DIRECTORY');
+
+CREATE TABLE IF NOT EXISTS connectors (
+    scope VARCHAR NOT NULL,
+    name VARCHAR NOT NULL,
+    file_type VARCHAR NOT NULL,
+    schedule VARCHAR NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    mapping_profile VARCHAR NOT NULL DEFAULT '',
+    source_type connector_source NOT NULL,
+    source JSONB NOT NULL DEFAULT '{}',
+    created_by VARCHAR NOT NULL,
+    created_on TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_by VARCHAR,
+    updated_on TIMESTAMP,
+    last_run_on TIMESTAMP,
+    last_status VARCHAR NOT NULL DEFAULT '',
+    last_error VARCHAR NOT NULL DEFAULT '',
+    last_upload_id INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY(scope,name)
+);
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE connectors;
+DROP TYPE connector_source;
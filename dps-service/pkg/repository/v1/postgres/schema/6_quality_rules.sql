@@ -0,0 +1,40 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TYPE rule_severity AS ENUM ('ERROR', 'WARNING');
+
+CREATE TABLE IF NOT EXISTS quality_rules (
+    scope VARCHAR NOT NULL,
+    name VARCHAR NOT NULL,
+    entity VARCHAR NOT NULL,
+    field VARCHAR NOT NULL,
+    operator VARCHAR NOT NULL,
+    params JSONB NOT NULL DEFAULT '{}',
+    severity rule_severity NOT NULL,
+    description VARCHAR NOT NULL DEFAULT '',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by VARCHAR NOT NULL,
+    created_on TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_by VARCHAR,
+    updated_on TIMESTAMP,
+    PRIMARY KEY(scope,name)
+);
+
+CREATE TABLE IF NOT EXISTS quality_results (
+    upload_id INTEGER NOT NULL,
+    file_name VARCHAR NOT NULL,
+    rule_name VARCHAR NOT NULL,
+    scope VARCHAR NOT NULL,
+    entity VARCHAR NOT NULL,
+    severity rule_severity NOT NULL,
+    violations INTEGER NOT NULL DEFAULT 0,
+    created_on TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY(upload_id,file_name,rule_name)
+);
+
+CREATE INDEX IF NOT EXISTS quality_results_scope_idx ON quality_results (scope, created_on);
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE quality_results;
+DROP TABLE quality_rules;
+DROP TYPE rule_severity;
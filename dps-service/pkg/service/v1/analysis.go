@@ -14,6 +14,8 @@ import (
 
 	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/api/v1"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/config"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/quality"
+	repo "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1"
 
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/helper"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
@@ -41,6 +43,8 @@ const (
 	BadReference           string = "badReference"
 	BadData                string = "badData"
 	isCatalog              string = "isCatalog"
+	QualityRuleError       string = "qualityRuleError"
+	QualityRuleWarning     string = "qualityRuleWarning"
 
 	// errors
 	BadFile              string = "BadOrCorruptFile"
@@ -90,7 +94,10 @@ var (
 		applications:    {"application_name": Info{Mandatory, STRING}, "application_id": Info{MandatoryWithBlank, STRING}, "product_name": Info{MandatoryWithBlank, STRING}, "product_version": Info{MandatoryWithBlank, STRING}, "product_editor": Info{MandatoryWithBlank, STRING}, "host_id": Info{Mandatory, STRING}, "domain": Info{MandatoryWithBlank, STRING}, "environment": Info{MandatoryWithBlank, STRING}},
 		desktops:        {"desktop_name": Info{MandatoryWithBlank, STRING}}}
 
-	actionAndColors map[string]string = map[string]string{Inconsistent1: "#FFC0CB", Inconsistent2: "#C0C0C0", WrongTypeField: "#FFA500", DuplicateLine: "#1986EE", MandatoryHeader: "#7FFD4", MissingField: "#008000", DuplicateHeader: "#808000", BadReference: "#F5CBA7", isCatalog: "#19EE3C"}
+	actionAndColors map[string]string = map[string]string{Inconsistent1: "#FFC0CB", Inconsistent2: "#C0C0C0", WrongTypeField: "#FFA500", DuplicateLine: "#1986EE", MandatoryHeader: "#7FFD4", MissingField: "#008000", DuplicateHeader: "#808000", BadReference: "#F5CBA7", isCatalog: "#19EE3C", QualityRuleError: "#FF4500", QualityRuleWarning: "#FFFF00"}
+
+	// sheetsEntities gives the entity of the quality rules checking the rows of each sheet
+	sheetsEntities map[string]string = map[string]string{servers: quality.Equipments, virtualMachines: quality.Equipments, desktops: quality.Equipments, products: quality.Products, applications: quality.Applications, acquiredRights: quality.AcqRights}
 
 	corefactors        map[string]*Node
 	isCoreFactorStored bool
@@ -118,9 +125,9 @@ type BadReferenceInfo struct {
 }
 
 // setCorefactorInCache
-func cacheCorefactor(ctx context.Context, d *dpsServiceServer) error {
+func cacheCorefactor(ctx context.Context, dpsRepo repo.Dps) error {
 	if !isCoreFactorCached() {
-		dbresp, err := d.dpsRepo.GetCoreFactorList(ctx)
+		dbresp, err := dpsRepo.GetCoreFactorList(ctx)
 		if err != nil {
 			logger.Log.Error("Failed to save core factor in cache", zap.Error(err))
 			return err
@@ -261,7 +268,7 @@ func (d *dpsServiceServer) DataAnalysis(ctx context.Context, req *v1.DataAnalysi
 	goodProductQueue := make(chan map[string]int, 1)
 	serverInfo := make(map[string]int)
 
-	if err := cacheCorefactor(ctx, d); err != nil {
+	if err := cacheCorefactor(ctx, d.dpsRepo); err != nil {
 		logger.Log.Error("Failed to create N-array cache of corefactor", zap.Error(err))
 		return &v1.DataAnalysisResponse{Description: "CoreFactorCachingError", Status: FAILED}, nil
 	}
@@ -270,30 +277,35 @@ func (d *dpsServiceServer) DataAnalysis(ctx context.Context, req *v1.DataAnalysi
 		logger.Log.Error("Failed to get catalog editors", zap.Error(err))
 	}
 	edList := res.EditorName
+	qualityRules := d.qualityRules(ctx, req.Scope)
+	if qualityRules.NeedsCatalog() && len(edList) > 0 {
+		qualityRules.SetCatalog(edList)
+	}
+	rules := newRuleChecker(qualityRules)
 	var g errgroup.Group
 	g.Go(func() error {
-		err := analyzeServerSheet(fp, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank, mainObjQueue, badServersQueue, headersIndex[servers])
+		err := analyzeServerSheet(fp, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank, mainObjQueue, badServersQueue, headersIndex[servers], rules)
 		return err
 	})
 
 	g.Go(func() error {
-		err := analyzeVirtualMachineSheet(fp, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank, mainObjQueue, badServersQueue, badVirtualMachinesQueue, headersIndex[virtualMachines], serverInfo)
+		err := analyzeVirtualMachineSheet(fp, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank, mainObjQueue, badServersQueue, badVirtualMachinesQueue, headersIndex[virtualMachines], serverInfo, rules)
 		return err
 	})
 	g.Go(func() error {
-		err := analyzeDesktopsSheet(fp, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank, mainObjQueue, headersIndex[desktops])
+		err := analyzeDesktopsSheet(fp, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank, mainObjQueue, headersIndex[desktops], rules)
 		return err
 	})
 	g.Go(func() error {
-		err := analyzeProductSheet(fp, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank, mainObjQueue, badVirtualMachinesQueue, serverInfo, headersIndex[products], metrics, edList, goodProductQueue)
+		err := analyzeProductSheet(fp, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank, mainObjQueue, badVirtualMachinesQueue, serverInfo, headersIndex[products], metrics, edList, goodProductQueue, rules)
 		return err
 	})
 	g.Go(func() error {
-		err := analyzeApplicationSheet(fp, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank, mainObjQueue, badVirtualMachinesQueue, headersIndex[applications], edList, goodProductQueue)
+		err := analyzeApplicationSheet(fp, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank, mainObjQueue, badVirtualMachinesQueue, headersIndex[applications], edList, goodProductQueue, rules)
 		return err
 	})
 	g.Go(func() error {
-		err := analyzeAcquiredRightSheet(fp, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank, mainObjQueue, headersIndex[acquiredRights], edList)
+		err := analyzeAcquiredRightSheet(fp, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank, mainObjQueue, headersIndex[acquiredRights], edList, rules)
 		return err
 	})
 	g.Go(func() error {
@@ -315,11 +327,13 @@ func (d *dpsServiceServer) DataAnalysis(ctx context.Context, req *v1.DataAnalysi
 		return &v1.DataAnalysisResponse{Description: description, Status: analysisStatus}, nil
 	}
 
+	ruleErrors, ruleWarnings := rules.counts()
 	return &v1.DataAnalysisResponse{
 		Report:      fmt.Sprintf("api/v1/import/download?fileName=%s&downloadType=analysis&scope=%s", req.File, req.Scope),
 		TargetFile:  fmt.Sprintf("api/v1/import/download?fileName=good_%s&downloadType=analysis&scope=%s", req.File, req.Scope),
 		ErrorFile:   fmt.Sprintf("api/v1/import/download?fileName=bad_%s&downloadType=error&scope=%s", req.File, req.Scope),
-		Description: description, Status: analysisStatus}, nil
+		Description: description, Status: analysisStatus,
+		RuleErrors: ruleErrors, RuleWarnings: ruleWarnings}, nil
 
 }
 
@@ -373,7 +387,7 @@ func isSheetMissing(fp *excel.File) error {
 	return nil
 }
 
-func analyzeServerSheet(fp *excel.File, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank chan map[string][][]string, mainObjQueue chan map[string][]ObjectCommentInfo, badServersQueue chan map[string]int, headersIndex map[string]int) error {
+func analyzeServerSheet(fp *excel.File, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank chan map[string][][]string, mainObjQueue chan map[string][]ObjectCommentInfo, badServersQueue chan map[string]int, headersIndex map[string]int, rules *ruleChecker) error {
 	logger.Log.Debug("Starting server=================================================")
 
 	checkDuplicates := make(map[string]int)
@@ -437,12 +451,13 @@ func analyzeServerSheet(fp *excel.File, goodObjQueue, badObjQueue, mainObjQueueW
 		continuousSpace = 0
 		if checkDuplicates[strings.Join(r, "|")] == 0 {
 			checkDuplicates[strings.Join(r, "|")] = i
-			objects, ok, err := handleMandatoryFieldMissingAndWrongType(fp, i+1, servers, headersIndex, r)
+			objects, ok, err := handleMandatoryFieldMissingAndWrongType(fp, i+1, servers, headersIndex, r, rules)
 			if err != nil {
 				logger.Log.Error("Error in handleMandatoryFieldMissingAndWrongType", zap.Any("sheet", servers), zap.Error(err))
 				return err
 			}
 			if ok {
+				mainObj[servers] = append(mainObj[servers], objects...)
 				obj, ok := handleServerSheetInconsistency(fp, serverID, r, inconsitency, headersIndex, i+1, r)
 				if ok {
 					goodObj = append(goodObj, r)
@@ -488,7 +503,7 @@ func analyzeServerSheet(fp *excel.File, goodObjQueue, badObjQueue, mainObjQueueW
 	return nil
 }
 
-func analyzeVirtualMachineSheet(fp *excel.File, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank chan map[string][][]string, mainObjQueue chan map[string][]ObjectCommentInfo, badServersQueue, badVirtualMachinesQueue chan map[string]int, headersIndex map[string]int, serverInfo map[string]int) error {
+func analyzeVirtualMachineSheet(fp *excel.File, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank chan map[string][][]string, mainObjQueue chan map[string][]ObjectCommentInfo, badServersQueue, badVirtualMachinesQueue chan map[string]int, headersIndex map[string]int, serverInfo map[string]int, rules *ruleChecker) error {
 	logger.Log.Debug("Starting partition=================================================")
 
 	checkDuplicates := make(map[string]int)
@@ -542,12 +557,13 @@ func analyzeVirtualMachineSheet(fp *excel.File, goodObjQueue, badObjQueue, mainO
 		continuousSpace = 0
 		if checkDuplicates[strings.Join(r, "|")] == 0 {
 			checkDuplicates[strings.Join(r, "|")] = i
-			objects, ok, err := handleMandatoryFieldMissingAndWrongType(fp, i+1, virtualMachines, headersIndex, r)
+			objects, ok, err := handleMandatoryFieldMissingAndWrongType(fp, i+1, virtualMachines, headersIndex, r, rules)
 			if err != nil {
 				logger.Log.Error("Error in handleMandatoryFieldMissingAndWrongType ", zap.Any("sheet", virtualMachines), zap.Error(err))
 				return err
 			}
 			if ok {
+				mainObj[virtualMachines] = append(mainObj[virtualMachines], objects...)
 				obj, ok := handleVirtualMachineInconsistency(fp, headersIndex, inconsitency, i+1)
 				if ok {
 					if _, y := virtualMachineInfo[serverID]; !y {
@@ -664,7 +680,7 @@ func getBadObjectsForComment(data BadReferenceInfo, key string, badObjectsForQue
 	return obj
 }
 
-func analyzeProductSheet(fp *excel.File, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank chan map[string][][]string, mainObjQueue chan map[string][]ObjectCommentInfo, badVirtualMachineQueue chan map[string]int, serverInfo map[string]int, headersIndex map[string]int, metrics *v1.GetAllocMetricDetailsResponse, edList []string, goodProductQueue chan map[string]int) error {
+func analyzeProductSheet(fp *excel.File, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank chan map[string][][]string, mainObjQueue chan map[string][]ObjectCommentInfo, badVirtualMachineQueue chan map[string]int, serverInfo map[string]int, headersIndex map[string]int, metrics *v1.GetAllocMetricDetailsResponse, edList []string, goodProductQueue chan map[string]int, rules *ruleChecker) error {
 	logger.Log.Debug("Starting product=================================================")
 
 	checkDuplicates := make(map[string]int)
@@ -741,11 +757,12 @@ func analyzeProductSheet(fp *excel.File, goodObjQueue, badObjQueue, mainObjQueue
 		continuousSpace = 0
 		if checkDuplicates[strings.Join(r, "|")] == 0 {
 			checkDuplicates[strings.Join(r, "|")] = i
-			objects, ok, err := handleMandatoryFieldMissingAndWrongType(fp, i+1, products, headersIndex, r)
+			objects, ok, err := handleMandatoryFieldMissingAndWrongType(fp, i+1, products, headersIndex, r, rules)
 			if err != nil {
 				return err
 			}
 			if ok {
+				mainObj[products] = append(mainObj[products], objects...)
 				obj, ObjCatalog, isHost, err := handleProductSheetInconsistency(fp, headersIndex, inconsitency, i+1, edList, serverInfo, badVirtualMachines)
 				if err != nil {
 					logger.Log.Error("handleProductSheetInconsistency err", zap.Error(err))
@@ -885,7 +902,7 @@ func analyzeProductSheet(fp *excel.File, goodObjQueue, badObjQueue, mainObjQueue
 	return nil
 }
 
-func analyzeApplicationSheet(fp *excel.File, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank chan map[string][][]string, mainObjQueue chan map[string][]ObjectCommentInfo, badVirtualMachineQueue chan map[string]int, headersIndex map[string]int, edList []string, goodProductQueue chan map[string]int) error {
+func analyzeApplicationSheet(fp *excel.File, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank chan map[string][][]string, mainObjQueue chan map[string][]ObjectCommentInfo, badVirtualMachineQueue chan map[string]int, headersIndex map[string]int, edList []string, goodProductQueue chan map[string]int, rules *ruleChecker) error {
 	logger.Log.Debug("Starting application=================================================")
 
 	checkDuplicates := make(map[string]int)
@@ -953,12 +970,13 @@ func analyzeApplicationSheet(fp *excel.File, goodObjQueue, badObjQueue, mainObjQ
 		continuousSpace = 0
 		if checkDuplicates[strings.Join(r, "|")] == 0 {
 			checkDuplicates[strings.Join(r, "|")] = i
-			objects, ok, err := handleMandatoryFieldMissingAndWrongType(fp, i+1, applications, headersIndex, r)
+			objects, ok, err := handleMandatoryFieldMissingAndWrongType(fp, i+1, applications, headersIndex, r, rules)
 			if err != nil {
 				logger.Log.Error("handleMandatoryFieldMissingAndWrongType error", zap.Error(err))
 				return err
 			}
 			if ok {
+				mainObj[applications] = append(mainObj[applications], objects...)
 				obj, ObjCatalog, ok, isHost, err := handleApplicationSheetInconsistency(fp, headersIndex, inconsitency, i+1, edList, goodProducts)
 				if err != nil {
 					logger.Log.Error("handleApplicationSheetInconsistency err", zap.Error(err))
@@ -1010,7 +1028,7 @@ func analyzeApplicationSheet(fp *excel.File, goodObjQueue, badObjQueue, mainObjQ
 	return nil
 }
 
-func analyzeAcquiredRightSheet(fp *excel.File, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank chan map[string][][]string, mainObjQueue chan map[string][]ObjectCommentInfo, headersIndex map[string]int, edList []string) error {
+func analyzeAcquiredRightSheet(fp *excel.File, goodObjQueue, badObjQueue, mainObjQueueWithoutBlank chan map[string][][]string, mainObjQueue chan map[string][]ObjectCommentInfo, headersIndex map[string]int, edList []string, rules *ruleChecker) error {
 	logger.Log.Debug("Starting acq=================================================")
 
 	checkDuplicates := make(map[string]int)
@@ -1076,12 +1094,13 @@ func analyzeAcquiredRightSheet(fp *excel.File, goodObjQueue, badObjQueue, mainOb
 		continuousSpace = 0
 		if checkDuplicates[strings.Join(r, "|")] == 0 {
 			checkDuplicates[strings.Join(r, "|")] = i
-			objects, ok, err := handleMandatoryFieldMissingAndWrongType(fp, i+1, acquiredRights, headersIndex, r)
+			objects, ok, err := handleMandatoryFieldMissingAndWrongType(fp, i+1, acquiredRights, headersIndex, r, rules)
 			if err != nil {
 				logger.Log.Error("handleMandatoryFieldMissingAndWrongType error", zap.Error(err))
 				return err
 			}
 			if ok {
+				mainObj[acquiredRights] = append(mainObj[acquiredRights], objects...)
 				obj, objCatalog, ok, err := handleAcquiredRightSheetInconsistency(fp, headersIndex, inconsitency, len(r), i+1, edList, r)
 				if err != nil {
 					logger.Log.Error("handleAcquiredRightSheetInconsistency err", zap.Error(err))
@@ -1127,7 +1146,7 @@ func analyzeAcquiredRightSheet(fp *excel.File, goodObjQueue, badObjQueue, mainOb
 	return nil
 }
 
-func analyzeDesktopsSheet(fp *excel.File, goodObjQueue, badObjQueue chan map[string][][]string, mainObjQueueWithoutBlank chan map[string][][]string, mainObjQueue chan map[string][]ObjectCommentInfo, headersIndex map[string]int) error {
+func analyzeDesktopsSheet(fp *excel.File, goodObjQueue, badObjQueue chan map[string][][]string, mainObjQueueWithoutBlank chan map[string][][]string, mainObjQueue chan map[string][]ObjectCommentInfo, headersIndex map[string]int, rules *ruleChecker) error {
 	logger.Log.Debug("Starting desktops=================================================")
 
 	checkDuplicates := make(map[string]int)
@@ -1176,12 +1195,13 @@ func analyzeDesktopsSheet(fp *excel.File, goodObjQueue, badObjQueue chan map[str
 		continuousSpace = 0
 		if checkDuplicates[strings.Join(r, "|")] == 0 {
 			checkDuplicates[strings.Join(r, "|")] = i
-			objects, ok, err := handleMandatoryFieldMissingAndWrongType(fp, i+1, desktops, headersIndex, r)
+			objects, ok, err := handleMandatoryFieldMissingAndWrongType(fp, i+1, desktops, headersIndex, r, rules)
 			if err != nil {
 				logger.Log.Error("handleMandatoryFieldMissingAndWrongType error", zap.Error(err))
 				return err
 			}
 			if ok {
+				mainObj[desktops] = append(mainObj[desktops], objects...)
 				goodObj = append(goodObj, r)
 			} else {
 				badObj = append(badObj, r)
@@ -1960,7 +1980,9 @@ func isTypeMatched(data string, expectedType int) bool {
 	return true
 }
 
-func handleMandatoryFieldMissingAndWrongType(fp *excel.File, yLen int, sheetName string, header map[string]int, row []string) ([]ObjectCommentInfo, bool, error) {
+// handleMandatoryFieldMissingAndWrongType checks the fields of the row and the quality rules of the scope,
+// the row is kept with the broken WARNING rules in its comments.
+func handleMandatoryFieldMissingAndWrongType(fp *excel.File, yLen int, sheetName string, header map[string]int, row []string, rules *ruleChecker) ([]ObjectCommentInfo, bool, error) {
 	var Objects []ObjectCommentInfo
 	isGoodObject := true
 	for k, v := range header {
@@ -2025,5 +2047,66 @@ func handleMandatoryFieldMissingAndWrongType(fp *excel.File, yLen int, sheetName
 			isGoodObject = false
 		}
 	}
-	return Objects, isGoodObject, nil
+	ruleObjects, ok, err := rules.check(yLen, sheetName, header, row)
+	if err != nil {
+		return nil, isGoodObject, err
+	}
+	return append(Objects, ruleObjects...), isGoodObject && ok, nil
+}
+
+// ruleChecker checks the rows of the analysed sheets with the quality rules of the scope and counts the broken rules
+type ruleChecker struct {
+	rules *quality.Set
+	mu    sync.Mutex
+	tally quality.Tally
+}
+
+func newRuleChecker(rules *quality.Set) *ruleChecker {
+	if rules == nil {
+		return nil
+	}
+	return &ruleChecker{rules: rules, tally: make(quality.Tally)}
+}
+
+// check gives the comments of the rules broken by the row, the row is bad when an ERROR rule is broken
+func (c *ruleChecker) check(yLen int, sheetName string, header map[string]int, row []string) ([]ObjectCommentInfo, bool, error) {
+	if c == nil {
+		return nil, true, nil
+	}
+	record := make(map[string]string, len(header))
+	for k, v := range header {
+		record[k] = ""
+		if v <= len(row) {
+			record[k] = row[v-1]
+		}
+	}
+	violations := c.rules.Check(sheetsEntities[sheetName], record)
+	if len(violations) == 0 {
+		return nil, true, nil
+	}
+	c.mu.Lock()
+	c.tally.Add(violations)
+	c.mu.Unlock()
+	objects := make([]ObjectCommentInfo, 0, len(violations))
+	for _, v := range violations {
+		colName, err := excel.ColumnNumberToName(header[v.Field])
+		if err != nil {
+			logger.Log.Error("Failed to get column-name", zap.Error(err), zap.Any("sheet", sheetName), zap.Any("action", "QualityRules"))
+			return nil, true, err
+		}
+		action := QualityRuleWarning
+		if v.IsError() {
+			action = QualityRuleError
+		}
+		objects = append(objects, ObjectCommentInfo{Msg: v.Rule.Message, Action: action, Column: fmt.Sprintf("%s%d", colName, yLen)})
+	}
+	return objects, !quality.HasError(violations), nil
+}
+
+// counts gives the number of broken ERROR and WARNING rules
+func (c *ruleChecker) counts() (errs, warnings int32) {
+	if c == nil {
+		return 0, 0
+	}
+	return c.tally.Counts()
 }
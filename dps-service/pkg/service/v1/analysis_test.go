@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
@@ -108,6 +109,10 @@ func Test_DataAnalysis(t *testing.T) {
 				mockEquip.EXPECT().GetMetrics(gomock.Any(), gomock.Any()).Return(&equipV1.GetMetricsResponse{Name: []string{"m1"}, Type: []string{"oracle.nup.standard"}}, nil)
 				mockRepository.EXPECT().GetCoreFactorList(ctx).Return([]db.CoreFactorReference{{Model: "str", Manufacturer: "string", CoreFactor: "1", ID: int32(1)}}, nil)
 				mockProd.EXPECT().GetAllEditorsCatalog(ctx, gomock.Any()).Return(&prodV1.GetAllEditorsCatalogResponse{EditorName: []string{"oracle", "Oracle"}}, nil)
+				mockRepository.EXPECT().ListEnabledQualityRules(ctx, "AAK").Return([]db.QualityRule{
+					{Scope: "AAK", Name: "cores", Entity: "equipments", Field: "cores_per_processor", Operator: "range", Params: json.RawMessage(`{"min":1,"max":2}`), Severity: db.RuleSeverityWARNING},
+					{Scope: "AAK", Name: "editor", Entity: "products", Field: "editor", Operator: "in_catalog", Params: json.RawMessage(`{}`), Severity: db.RuleSeverityERROR},
+				}, nil)
 			},
 			output:  &v1.DataAnalysisResponse{Status: FAILED},
 			wantErr: false,
@@ -133,6 +138,7 @@ func Test_DataAnalysis(t *testing.T) {
 				mockEquip.EXPECT().GetMetrics(gomock.Any(), gomock.Any()).Return(&equipV1.GetMetricsResponse{}, nil)
 				mockRepository.EXPECT().GetCoreFactorList(ctx).Return([]db.CoreFactorReference{{Model: "str", Manufacturer: "string", CoreFactor: "1", ID: int32(1)}}, nil).AnyTimes()
 				mockProd.EXPECT().GetAllEditorsCatalog(ctx, gomock.Any()).Return(&prodV1.GetAllEditorsCatalogResponse{EditorName: []string{"oracle", "Oracle"}}, nil)
+				mockRepository.EXPECT().ListEnabledQualityRules(ctx, "AAK").Return(nil, nil).AnyTimes()
 			},
 			output:  &v1.DataAnalysisResponse{Status: FAILED},
 			wantErr: false,
@@ -158,6 +164,7 @@ func Test_DataAnalysis(t *testing.T) {
 				mockEquip.EXPECT().GetMetrics(gomock.Any(), gomock.Any()).Return(&equipV1.GetMetricsResponse{Name: []string{"m1"}, Type: []string{"oracle.nup.standard"}}, nil)
 				mockRepository.EXPECT().GetCoreFactorList(ctx).Return([]db.CoreFactorReference{{Model: "str", Manufacturer: "string", CoreFactor: "1", ID: int32(1)}}, nil).AnyTimes()
 				mockProd.EXPECT().GetAllEditorsCatalog(ctx, gomock.Any()).Return(&prodV1.GetAllEditorsCatalogResponse{EditorName: []string{"oracle", "Oracle"}}, nil)
+				mockRepository.EXPECT().ListEnabledQualityRules(ctx, "AAK").Return(nil, nil).AnyTimes()
 			},
 			output:  &v1.DataAnalysisResponse{Status: FAILED},
 			wantErr: false,
@@ -183,6 +190,7 @@ func Test_DataAnalysis(t *testing.T) {
 				mockEquip.EXPECT().GetMetrics(gomock.Any(), gomock.Any()).Return(&equipV1.GetMetricsResponse{Name: []string{"m1"}, Type: []string{"type"}}, nil)
 				mockRepository.EXPECT().GetCoreFactorList(ctx).Return([]db.CoreFactorReference{{Model: "str", Manufacturer: "string", CoreFactor: "1", ID: int32(1)}}, nil).AnyTimes()
 				mockProd.EXPECT().GetAllEditorsCatalog(ctx, gomock.Any()).Return(&prodV1.GetAllEditorsCatalogResponse{EditorName: []string{"oracle", "Oracle"}}, nil)
+				mockRepository.EXPECT().ListEnabledQualityRules(ctx, "AAK").Return(nil, nil).AnyTimes()
 			},
 			output:  &v1.DataAnalysisResponse{Status: FAILED},
 			wantErr: false,
@@ -208,6 +216,7 @@ func Test_DataAnalysis(t *testing.T) {
 				mockEquip.EXPECT().GetMetrics(gomock.Any(), gomock.Any()).Return(&equipV1.GetMetricsResponse{Name: []string{"m1"}, Type: []string{"type"}}, nil)
 				mockRepository.EXPECT().GetCoreFactorList(ctx).Return([]db.CoreFactorReference{{Model: "str", Manufacturer: "string", CoreFactor: "1", ID: int32(1)}}, nil).AnyTimes()
 				mockProd.EXPECT().GetAllEditorsCatalog(ctx, gomock.Any()).Return(&prodV1.GetAllEditorsCatalogResponse{EditorName: []string{"oracle", "Oracle"}}, nil)
+				mockRepository.EXPECT().ListEnabledQualityRules(ctx, "AAK").Return(nil, nil).AnyTimes()
 			},
 			output:  &v1.DataAnalysisResponse{Status: FAILED},
 			wantErr: false,
@@ -1,13 +1,36 @@
 package v1
 
 import (
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/config"
+
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
 )
 
+// testSecretKey seals the credentials of the connectors in the tests
+const testSecretKey = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+
 func TestMain(m *testing.M) {
 	logger.Init(-1, "")
-	os.Exit(m.Run())
+	// the configuration is only set once, uploaded files are read from a temporary directory
+	filesLocation, err := ioutil.TempDir("", "dps-files")
+	if err != nil {
+		panic(err)
+	}
+	config.SetConfig(config.Config{
+		RawdataLocation: "testdata",
+		FilesLocation:   filesLocation,
+		Connectors: config.ConnectorsConfig{
+			DirectoryRoot: filepath.Join(filesLocation, "connectors"),
+			AllowedHosts:  []string{"cmdb", "127.0.0.1"},
+			SecretKey:     testSecretKey,
+		},
+	})
+	code := m.Run()
+	os.RemoveAll(filesLocation)
+	os.Exit(code)
 }
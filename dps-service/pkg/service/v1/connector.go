@@ -0,0 +1,479 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/config"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/connector"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/cron"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/helper"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// connectorUploader is the uploader of the files pulled by a connector
+const connectorUploader = "connector/"
+
+// connectorSyncInterval is how often a replica reloads the connectors changed through the other replicas
+const connectorSyncInterval = time.Minute
+
+// StartConnectors schedules the pulls of the enabled connectors until the context is done,
+// every replica schedules the connectors but a pull is claimed by a single one.
+// The scheduled pulls are authenticated with the context of the cron jobs.
+func StartConnectors(ctx context.Context, srv v1.DpsServiceServer, cronContext func() (context.Context, error)) error {
+	d, ok := srv.(*dpsServiceServer)
+	if !ok {
+		return errors.New("connectors are scheduled by the dps server only")
+	}
+	d.connectors = connector.NewScheduler(func(scope, name string) {
+		if err := d.runScheduledConnector(cronContext, scope, name, time.Now().Truncate(time.Minute)); err != nil {
+			logger.Log.Error("Connector pull failed", zap.String("scope", scope), zap.String("name", name), zap.Error(err))
+		}
+	})
+	if err := d.loadConnectors(ctx); err != nil {
+		return err
+	}
+	go func() {
+		ticker := time.NewTicker(connectorSyncInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := d.loadConnectors(ctx); err != nil {
+					logger.Log.Error("Failed to reload connectors", zap.Error(err))
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (d *dpsServiceServer) loadConnectors(ctx context.Context) error {
+	connectors, err := d.dpsRepo.ListEnabledConnectors(ctx)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	d.connectors.Load(connectors)
+	return nil
+}
+
+// runScheduledConnector pulls the connector due at runOn, nothing is pulled when another replica
+// already claimed the pull.
+func (d *dpsServiceServer) runScheduledConnector(cronContext func() (context.Context, error), scope, name string, runOn time.Time) error {
+	runCtx, err := cronContext()
+	if err != nil {
+		return err
+	}
+	claimed, err := d.dpsRepo.ClaimConnectorRun(runCtx, db.ClaimConnectorRunParams{
+		RunOn: sql.NullTime{Time: runOn, Valid: true},
+		Scope: scope,
+		Name:  name,
+	})
+	if err != nil || claimed == 0 {
+		return err
+	}
+	c, err := d.dpsRepo.GetConnector(runCtx, db.GetConnectorParams{Scope: scope, Name: name})
+	if err != nil {
+		return err
+	}
+	_, err = d.runConnector(runCtx, c)
+	return err
+}
+
+// CreateConnector creates a connector of the scope and schedules its pulls
+func (d *dpsServiceServer) CreateConnector(ctx context.Context, req *v1.Connector) (*v1.Connector, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	sourceType, source, err := d.validateConnector(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := d.dpsRepo.GetConnector(ctx, db.GetConnectorParams{Scope: req.Scope, Name: req.Name}); err == nil {
+		return nil, status.Error(codes.AlreadyExists, "ConnectorExists")
+	} else if err != sql.ErrNoRows {
+		logger.Log.Error("Failed to get connector", zap.Error(err), zap.String("name", req.Name))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	if err := d.dpsRepo.InsertConnector(ctx, db.InsertConnectorParams{
+		Scope:          req.Scope,
+		Name:           req.Name,
+		FileType:       req.FileType,
+		Schedule:       req.Schedule,
+		Enabled:        req.Enabled,
+		MappingProfile: req.MappingProfile,
+		SourceType:     sourceType,
+		Source:         source,
+		CreatedBy:      userClaims.UserID,
+	}); err != nil {
+		logger.Log.Error("Failed to insert connector", zap.Error(err), zap.String("name", req.Name))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	return d.scheduleConnector(ctx, req.Scope, req.Name)
+}
+
+// UpdateConnector replaces the source and the schedule of an existing connector
+func (d *dpsServiceServer) UpdateConnector(ctx context.Context, req *v1.Connector) (*v1.Connector, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	stored, err := d.dpsRepo.GetConnector(ctx, db.GetConnectorParams{Scope: req.Scope, Name: req.Name})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "ConnectorNotFound")
+		}
+		logger.Log.Error("Failed to get connector", zap.Error(err), zap.String("name", req.Name))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	// the masked credentials keep the stored ones
+	sourceType, source, err := d.validateConnector(ctx, req, &stored)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.dpsRepo.UpdateConnector(ctx, db.UpdateConnectorParams{
+		Scope:          req.Scope,
+		Name:           req.Name,
+		FileType:       req.FileType,
+		Schedule:       req.Schedule,
+		Enabled:        req.Enabled,
+		MappingProfile: req.MappingProfile,
+		SourceType:     sourceType,
+		Source:         source,
+		UpdatedBy:      sql.NullString{String: userClaims.UserID, Valid: true},
+	}); err != nil {
+		logger.Log.Error("Failed to update connector", zap.Error(err), zap.String("name", req.Name))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	return d.scheduleConnector(ctx, req.Scope, req.Name)
+}
+
+// ListConnectors lists the connectors of the scope with their last pull
+func (d *dpsServiceServer) ListConnectors(ctx context.Context, req *v1.ListConnectorsRequest) (*v1.ListConnectorsResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	dbresp, err := d.dpsRepo.ListConnectors(ctx, req.Scope)
+	if err != nil {
+		logger.Log.Error("Failed to list connectors", zap.Error(err), zap.String("scope", req.Scope))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	resp := &v1.ListConnectorsResponse{Connectors: make([]*v1.Connector, 0, len(dbresp))}
+	for _, c := range dbresp {
+		conn, err := connectorToProto(c)
+		if err != nil {
+			return nil, err
+		}
+		resp.Connectors = append(resp.Connectors, conn)
+	}
+	return resp, nil
+}
+
+// DeleteConnector deletes a connector of the scope and stops its pulls
+func (d *dpsServiceServer) DeleteConnector(ctx context.Context, req *v1.DeleteConnectorRequest) (*v1.DeleteConnectorResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return &v1.DeleteConnectorResponse{Success: false}, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		return &v1.DeleteConnectorResponse{Success: false}, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	if err := d.dpsRepo.DeleteConnector(ctx, db.DeleteConnectorParams{Scope: req.Scope, Name: req.Name}); err != nil {
+		logger.Log.Error("Failed to delete connector", zap.Error(err), zap.String("name", req.Name))
+		return &v1.DeleteConnectorResponse{Success: false}, status.Error(codes.Internal, "DBError")
+	}
+	if d.connectors != nil {
+		d.connectors.Remove(req.Scope, req.Name)
+	}
+	return &v1.DeleteConnectorResponse{Success: true}, nil
+}
+
+// RunConnector pulls the connector now, out of its schedule
+func (d *dpsServiceServer) RunConnector(ctx context.Context, req *v1.RunConnectorRequest) (*v1.RunConnectorResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return &v1.RunConnectorResponse{Success: false}, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		return &v1.RunConnectorResponse{Success: false}, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	c, err := d.dpsRepo.GetConnector(ctx, db.GetConnectorParams{Scope: req.Scope, Name: req.Name})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &v1.RunConnectorResponse{Success: false}, status.Error(codes.NotFound, "ConnectorNotFound")
+		}
+		logger.Log.Error("Failed to get connector", zap.Error(err), zap.String("name", req.Name))
+		return &v1.RunConnectorResponse{Success: false}, status.Error(codes.Internal, "DBError")
+	}
+	return d.runConnector(ctx, c)
+}
+
+// runConnector pulls the files of the connector and uploads them as data files of its scope
+func (d *dpsServiceServer) runConnector(ctx context.Context, c db.Connector) (*v1.RunConnectorResponse, error) {
+	if d.connectors != nil {
+		if !d.connectors.Begin(c.Scope, c.Name) {
+			return &v1.RunConnectorResponse{Success: false}, status.Error(codes.FailedPrecondition, "ConnectorRunning")
+		}
+		defer d.connectors.End(c.Scope, c.Name)
+	}
+	dir := config.GetConfig().FilesLocation
+	opts, err := connectorOptions()
+	if err != nil {
+		logger.Log.Error("Failed to get the options of the connectors", zap.Error(err))
+		return &v1.RunConnectorResponse{Success: false}, status.Error(codes.Internal, "ConnectorConfigError")
+	}
+	files, err := connector.Pull(ctx, c, dir, opts)
+	if err != nil {
+		logger.Log.Error("Failed to pull connector", zap.String("scope", c.Scope), zap.String("name", c.Name), zap.Error(err))
+		removeFiles(dir, files)
+		d.connectorRun(ctx, c, connector.StatusFailed, err.Error(), 0)
+		return &v1.RunConnectorResponse{Success: false}, status.Error(codes.Internal, "ConnectorPullFailed")
+	}
+	if len(files) == 0 {
+		d.connectorRun(ctx, c, connector.StatusEmpty, "", 0)
+		return &v1.RunConnectorResponse{Success: true}, nil
+	}
+	resp, err := d.NotifyUpload(ctx, &v1.NotifyUploadRequest{
+		Scope:          c.Scope,
+		Type:           "data",
+		Files:          files,
+		UploadedBy:     connectorUploader + c.Name,
+		MappingProfile: c.MappingProfile,
+	})
+	if err != nil {
+		removeFiles(dir, files)
+		d.connectorRun(ctx, c, connector.StatusFailed, status.Convert(err).Message(), 0)
+		return &v1.RunConnectorResponse{Success: false}, err
+	}
+	uploadID := resp.GetFileUploadId()[files[0]]
+	d.connectorRun(ctx, c, connector.StatusSuccess, "", uploadID)
+	return &v1.RunConnectorResponse{Success: true, UploadId: uploadID, Files: files}, nil
+}
+
+// connectorRun keeps the outcome of the last pull of the connector
+func (d *dpsServiceServer) connectorRun(ctx context.Context, c db.Connector, runStatus, reason string, uploadID int32) {
+	if err := d.dpsRepo.UpdateConnectorRun(ctx, db.UpdateConnectorRunParams{
+		Scope:        c.Scope,
+		Name:         c.Name,
+		LastStatus:   runStatus,
+		LastError:    reason,
+		LastUploadID: uploadID,
+	}); err != nil {
+		logger.Log.Error("Failed to update connector run", zap.String("scope", c.Scope), zap.String("name", c.Name), zap.Error(err))
+	}
+}
+
+func removeFiles(dir string, files []string) {
+	for _, f := range files {
+		if err := os.Remove(filepath.Join(dir, f)); err != nil {
+			logger.Log.Error("Failed to remove pulled file", zap.String("file", f), zap.Error(err))
+		}
+	}
+}
+
+// connectorOptions gives the limits of the connector sources set by the configuration
+func connectorOptions() (connector.Options, error) {
+	cfg := config.GetConfig().Connectors
+	sealer, err := connector.NewSealer(cfg.SecretKey)
+	if err != nil {
+		return connector.Options{}, err
+	}
+	return connector.Options{DirectoryRoot: cfg.DirectoryRoot, AllowedHosts: cfg.AllowedHosts, Sealer: sealer}, nil
+}
+
+// validateConnector checks the schedule and the source of the connector and marshals the source as stored,
+// the credentials of the source are encrypted and the masked ones are taken from the stored connector if any.
+func (d *dpsServiceServer) validateConnector(ctx context.Context, req *v1.Connector, stored *db.Connector) (db.ConnectorSource, json.RawMessage, error) {
+	opts, err := connectorOptions()
+	if err != nil {
+		logger.Log.Error("Failed to get the options of the connectors", zap.Error(err))
+		return "", nil, status.Error(codes.Internal, "ConnectorConfigError")
+	}
+	if err := cron.ValidateSpec(req.Schedule); err != nil {
+		return "", nil, status.Errorf(codes.InvalidArgument, "invalid schedule: %v", err)
+	}
+	if req.MappingProfile != "" {
+		if _, err := d.getMappingProfile(ctx, req.Scope, req.MappingProfile); err != nil {
+			if status.Code(err) == codes.NotFound {
+				return "", nil, status.Error(codes.InvalidArgument, "MappingProfileNotFound")
+			}
+			return "", nil, err
+		}
+	}
+	var sourceType db.ConnectorSource
+	var source interface{}
+	switch src := req.Source.(type) {
+	case *v1.Connector_Sql:
+		if err := connector.ValidateDriver(src.Sql.Driver); err != nil {
+			return "", nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		sourceType = db.ConnectorSourceSQL
+		source = connector.SQLConfig{Driver: src.Sql.Driver, DSN: src.Sql.Dsn, Query: src.Sql.Query}
+	case *v1.Connector_Rest:
+		if err := connector.ValidateURL(src.Rest.Url, opts.AllowedHosts); err != nil {
+			return "", nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		sourceType = db.ConnectorSourceREST
+		source = connector.RESTConfig{
+			URL:           src.Rest.Url,
+			Headers:       src.Rest.Headers,
+			RecordsPath:   src.Rest.RecordsPath,
+			NextPath:      src.Rest.NextPath,
+			PageParam:     src.Rest.PageParam,
+			FirstPage:     int(src.Rest.FirstPage),
+			PageSizeParam: src.Rest.PageSizeParam,
+			PageSize:      int(src.Rest.PageSize),
+			MaxPages:      int(src.Rest.MaxPages),
+		}
+	case *v1.Connector_Directory:
+		if _, err := connector.DirectoryPath(opts.DirectoryRoot, req.Scope, src.Directory.Path); err != nil {
+			return "", nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := connector.ValidatePattern(src.Directory.Pattern); err != nil {
+			return "", nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		sourceType = db.ConnectorSourceDIRECTORY
+		source = connector.DirectoryConfig{Path: src.Directory.Path, Pattern: src.Directory.Pattern}
+	default:
+		return "", nil, status.Error(codes.InvalidArgument, "SourceMissing")
+	}
+	data, err := json.Marshal(source)
+	if err != nil {
+		logger.Log.Error("Failed to marshal connector source", zap.Error(err))
+		return "", nil, status.Error(codes.Internal, "MarshalError")
+	}
+	var storedSource json.RawMessage
+	if stored != nil && stored.SourceType == sourceType {
+		storedSource = stored.Source
+	}
+	data, err = opts.Sealer.SealSource(sourceType, data, storedSource)
+	switch err {
+	case nil:
+		return sourceType, data, nil
+	case connector.ErrNoSecretKey:
+		return "", nil, status.Error(codes.FailedPrecondition, "ConnectorSecretKeyMissing")
+	case connector.ErrMaskedCredential:
+		return "", nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	logger.Log.Error("Failed to seal connector source", zap.Error(err))
+	return "", nil, status.Error(codes.Internal, "SealError")
+}
+
+// scheduleConnector schedules the connector as stored
+func (d *dpsServiceServer) scheduleConnector(ctx context.Context, scope, name string) (*v1.Connector, error) {
+	c, err := d.dpsRepo.GetConnector(ctx, db.GetConnectorParams{Scope: scope, Name: name})
+	if err != nil {
+		logger.Log.Error("Failed to get connector", zap.Error(err), zap.String("name", name))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	if d.connectors != nil {
+		if err := d.connectors.Schedule(c); err != nil {
+			logger.Log.Error("Failed to schedule connector", zap.Error(err), zap.String("name", name))
+			return nil, status.Error(codes.Internal, "ScheduleError")
+		}
+	}
+	return connectorToProto(c)
+}
+
+func (d *dpsServiceServer) getConnector(ctx context.Context, scope, name string) (*v1.Connector, error) {
+	c, err := d.dpsRepo.GetConnector(ctx, db.GetConnectorParams{Scope: scope, Name: name})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "ConnectorNotFound")
+		}
+		logger.Log.Error("Failed to get connector", zap.Error(err), zap.String("name", name))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	return connectorToProto(c)
+}
+
+func connectorToProto(c db.Connector) (*v1.Connector, error) {
+	conn := &v1.Connector{
+		Name:           c.Name,
+		Scope:          c.Scope,
+		FileType:       c.FileType,
+		Schedule:       c.Schedule,
+		Enabled:        c.Enabled,
+		MappingProfile: c.MappingProfile,
+		CreatedBy:      c.CreatedBy,
+		UpdatedBy:      c.UpdatedBy.String,
+		LastStatus:     c.LastStatus,
+		LastError:      c.LastError,
+		LastUploadId:   c.LastUploadID,
+	}
+	conn.CreatedOn, _ = ptypes.TimestampProto(c.CreatedOn)
+	if c.UpdatedOn.Valid {
+		conn.UpdatedOn, _ = ptypes.TimestampProto(c.UpdatedOn.Time)
+	}
+	if c.LastRunOn.Valid {
+		conn.LastRunOn, _ = ptypes.TimestampProto(c.LastRunOn.Time)
+	}
+	var err error
+	switch c.SourceType {
+	case db.ConnectorSourceSQL:
+		cfg := connector.SQLConfig{}
+		err = json.Unmarshal(c.Source, &cfg)
+		conn.Source = &v1.Connector_Sql{Sql: &v1.SqlSource{Driver: cfg.Driver, Dsn: mask(cfg.DSN), Query: cfg.Query}}
+	case db.ConnectorSourceREST:
+		cfg := connector.RESTConfig{}
+		err = json.Unmarshal(c.Source, &cfg)
+		for k, v := range cfg.Headers {
+			cfg.Headers[k] = mask(v)
+		}
+		conn.Source = &v1.Connector_Rest{Rest: &v1.RestSource{
+			Url:           cfg.URL,
+			Headers:       cfg.Headers,
+			RecordsPath:   cfg.RecordsPath,
+			NextPath:      cfg.NextPath,
+			PageParam:     cfg.PageParam,
+			FirstPage:     int32(cfg.FirstPage),
+			PageSizeParam: cfg.PageSizeParam,
+			PageSize:      int32(cfg.PageSize),
+			MaxPages:      int32(cfg.MaxPages),
+		}}
+	case db.ConnectorSourceDIRECTORY:
+		cfg := connector.DirectoryConfig{}
+		err = json.Unmarshal(c.Source, &cfg)
+		conn.Source = &v1.Connector_Directory{Directory: &v1.DirectorySource{Path: cfg.Path, Pattern: cfg.Pattern}}
+	}
+	if err != nil {
+		logger.Log.Error("Failed to unmarshal connector source", zap.Error(err), zap.String("name", c.Name))
+		return nil, status.Error(codes.Internal, "UnmarshalError")
+	}
+	return conn, nil
+}
+
+// mask hides the credential of a source in the responses
+func mask(credential string) string {
+	if credential == "" {
+		return ""
+	}
+	return connector.Masked
+}
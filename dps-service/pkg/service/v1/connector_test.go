@@ -0,0 +1,591 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue"
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/connector"
+	repo "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1"
+	dbmock "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/dbmock"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testConnector = &v1.Connector{
+	Name:     "drop",
+	Scope:    "scope1",
+	FileType: "products",
+	Schedule: "0 2 * * *",
+	Enabled:  true,
+	Source:   &v1.Connector_Directory{Directory: &v1.DirectorySource{Path: "drop", Pattern: "*.csv"}},
+}
+
+const testConnectorSource = `{"path":"drop","pattern":"*.csv"}`
+
+func Test_dpsServiceServer_CreateConnector(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"scope1", "scope2", "scope3"},
+	})
+	createdOn := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+	createdOnProto, _ := ptypes.TimestampProto(createdOn)
+	var mockCtrl *gomock.Controller
+	var rep repo.Dps
+	var queue workerqueue.Workerqueue
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		input    *v1.Connector
+		setup    func()
+		output   *v1.Connector
+		wantCode codes.Code
+	}{
+		{
+			name:  "SuccessCaseNoError",
+			ctx:   ctx,
+			input: testConnector,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				gomock.InOrder(
+					mockRepository.EXPECT().GetConnector(ctx, db.GetConnectorParams{Scope: "scope1", Name: "drop"}).Times(1).Return(db.Connector{}, sql.ErrNoRows),
+					mockRepository.EXPECT().InsertConnector(ctx, db.InsertConnectorParams{
+						Scope:      "scope1",
+						Name:       "drop",
+						FileType:   "products",
+						Schedule:   "0 2 * * *",
+						Enabled:    true,
+						SourceType: db.ConnectorSourceDIRECTORY,
+						Source:     json.RawMessage(testConnectorSource),
+						CreatedBy:  "admin@superuser.com",
+					}).Times(1).Return(nil),
+					mockRepository.EXPECT().GetConnector(ctx, db.GetConnectorParams{Scope: "scope1", Name: "drop"}).Times(1).Return(db.Connector{
+						Scope:      "scope1",
+						Name:       "drop",
+						FileType:   "products",
+						Schedule:   "0 2 * * *",
+						Enabled:    true,
+						SourceType: db.ConnectorSourceDIRECTORY,
+						Source:     json.RawMessage(testConnectorSource),
+						CreatedBy:  "admin@superuser.com",
+						CreatedOn:  createdOn,
+					}, nil),
+				)
+			},
+			output: &v1.Connector{
+				Name:      "drop",
+				Scope:     "scope1",
+				FileType:  "products",
+				Schedule:  "0 2 * * *",
+				Enabled:   true,
+				Source:    &v1.Connector_Directory{Directory: &v1.DirectorySource{Path: "drop", Pattern: "*.csv"}},
+				CreatedBy: "admin@superuser.com",
+				CreatedOn: createdOnProto,
+			},
+		},
+		{
+			name:     "FailureCaseClaimsNotFound",
+			ctx:      context.Background(),
+			input:    testConnector,
+			setup:    func() {},
+			wantCode: codes.Internal,
+		},
+		{
+			name:     "FailureCaseScopeValidation",
+			ctx:      ctx,
+			input:    &v1.Connector{Name: "drop", Scope: "scope4"},
+			setup:    func() {},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "FailureCaseInvalidSchedule",
+			ctx:  ctx,
+			input: &v1.Connector{
+				Name:     "drop",
+				Scope:    "scope1",
+				FileType: "products",
+				Schedule: "every night",
+				Source:   testConnector.Source,
+			},
+			setup:    func() {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "FailureCaseAbsoluteDirectory",
+			ctx:  ctx,
+			input: &v1.Connector{
+				Name:     "drop",
+				Scope:    "scope1",
+				FileType: "products",
+				Schedule: "0 2 * * *",
+				Source:   &v1.Connector_Directory{Directory: &v1.DirectorySource{Path: "/etc"}},
+			},
+			setup:    func() {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "FailureCaseDirectoryOfAnotherScope",
+			ctx:  ctx,
+			input: &v1.Connector{
+				Name:     "drop",
+				Scope:    "scope1",
+				FileType: "products",
+				Schedule: "0 2 * * *",
+				Source:   &v1.Connector_Directory{Directory: &v1.DirectorySource{Path: "../scope2/drop"}},
+			},
+			setup:    func() {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "FailureCaseHostNotAllowed",
+			ctx:  ctx,
+			input: &v1.Connector{
+				Name:     "cmdb",
+				Scope:    "scope1",
+				FileType: "products",
+				Schedule: "0 2 * * *",
+				Source:   &v1.Connector_Rest{Rest: &v1.RestSource{Url: "http://169.254.169.254/latest/meta-data"}},
+			},
+			setup:    func() {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "FailureCaseMaskedCredential",
+			ctx:  ctx,
+			input: &v1.Connector{
+				Name:     "cmdb",
+				Scope:    "scope1",
+				FileType: "products",
+				Schedule: "0 2 * * *",
+				Source:   &v1.Connector_Rest{Rest: &v1.RestSource{Url: "http://cmdb/products", Headers: map[string]string{"Authorization": connector.Masked}}},
+			},
+			setup:    func() {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "FailureCaseUnknownDriver",
+			ctx:  ctx,
+			input: &v1.Connector{
+				Name:     "cmdb",
+				Scope:    "scope1",
+				FileType: "products",
+				Schedule: "0 2 * * *",
+				Source:   &v1.Connector_Sql{Sql: &v1.SqlSource{Driver: "oracle", Dsn: "dsn", Query: "select 1"}},
+			},
+			setup:    func() {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:  "FailureCaseMappingProfileNotFound",
+			ctx:   ctx,
+			input: &v1.Connector{Name: "drop", Scope: "scope1", FileType: "products", Schedule: "0 2 * * *", MappingProfile: "sccm", Source: testConnector.Source},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetMappingProfile(ctx, db.GetMappingProfileParams{Scope: "scope1", Name: "sccm"}).Times(1).Return(db.MappingProfile{}, sql.ErrNoRows)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:  "FailureCaseConnectorExists",
+			ctx:   ctx,
+			input: testConnector,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetConnector(ctx, db.GetConnectorParams{Scope: "scope1", Name: "drop"}).Times(1).Return(db.Connector{Scope: "scope1", Name: "drop"}, nil)
+			},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:  "FailureCaseInsertError",
+			ctx:   ctx,
+			input: testConnector,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetConnector(ctx, db.GetConnectorParams{Scope: "scope1", Name: "drop"}).Times(1).Return(db.Connector{}, sql.ErrNoRows)
+				mockRepository.EXPECT().InsertConnector(ctx, gomock.Any()).Times(1).Return(errors.New("DBError"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			d := NewDpsServiceServer(rep, queue, nil)
+			got, err := d.CreateConnector(tt.ctx, tt.input)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			if !assert.Empty(t, err) {
+				return
+			}
+			assert.Equal(t, tt.output.String(), got.String())
+		})
+	}
+}
+
+func Test_dpsServiceServer_UpdateConnector(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"scope1"},
+	})
+	sealer, _ := connector.NewSealer(testSecretKey)
+	stored, _ := sealer.SealSource(db.ConnectorSourceREST, json.RawMessage(`{"url":"http://cmdb/apps","headers":{"Authorization":"Bearer token"}}`), nil)
+	storedConnector := db.Connector{
+		Scope:      "scope1",
+		Name:       "cmdb",
+		FileType:   "applications",
+		Schedule:   "@daily",
+		SourceType: db.ConnectorSourceREST,
+		Source:     stored,
+	}
+	input := &v1.Connector{
+		Name:     "cmdb",
+		Scope:    "scope1",
+		FileType: "applications",
+		Schedule: "@daily",
+		Source:   &v1.Connector_Rest{Rest: &v1.RestSource{Url: "http://cmdb/v2/apps", Headers: map[string]string{"Authorization": connector.Masked}}},
+	}
+	var mockCtrl *gomock.Controller
+	var rep repo.Dps
+	var queue workerqueue.Workerqueue
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		input    *v1.Connector
+		setup    func()
+		wantCode codes.Code
+	}{
+		{
+			name:  "SuccessCaseMaskedCredentialKept",
+			ctx:   ctx,
+			input: input,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				gomock.InOrder(
+					mockRepository.EXPECT().GetConnector(ctx, db.GetConnectorParams{Scope: "scope1", Name: "cmdb"}).Times(1).Return(storedConnector, nil),
+					mockRepository.EXPECT().UpdateConnector(ctx, gomock.Any()).Times(1).DoAndReturn(func(_ context.Context, arg db.UpdateConnectorParams) error {
+						opened, err := sealer.OpenSource(arg.SourceType, arg.Source)
+						assert.Empty(t, err)
+						assert.JSONEq(t, `{"url":"http://cmdb/v2/apps","headers":{"Authorization":"Bearer token"}}`, string(opened))
+						assert.NotContains(t, string(arg.Source), "Bearer token")
+						return nil
+					}),
+					mockRepository.EXPECT().GetConnector(ctx, db.GetConnectorParams{Scope: "scope1", Name: "cmdb"}).Times(1).Return(storedConnector, nil),
+				)
+			},
+		},
+		{
+			name:  "FailureCaseConnectorNotFound",
+			ctx:   ctx,
+			input: input,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetConnector(ctx, db.GetConnectorParams{Scope: "scope1", Name: "cmdb"}).Times(1).Return(db.Connector{}, sql.ErrNoRows)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:  "FailureCaseMaskedCredentialOfAnotherSource",
+			ctx:   ctx,
+			input: input,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetConnector(ctx, db.GetConnectorParams{Scope: "scope1", Name: "cmdb"}).Times(1).Return(db.Connector{
+					Scope:      "scope1",
+					Name:       "cmdb",
+					SourceType: db.ConnectorSourceDIRECTORY,
+					Source:     json.RawMessage(testConnectorSource),
+				}, nil)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "FailureCaseScopeValidation",
+			ctx:      ctx,
+			input:    &v1.Connector{Name: "cmdb", Scope: "scope2"},
+			setup:    func() {},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			d := NewDpsServiceServer(rep, queue, nil)
+			got, err := d.UpdateConnector(tt.ctx, tt.input)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			if !assert.Empty(t, err) {
+				return
+			}
+			assert.Equal(t, connector.Masked, got.GetRest().Headers["Authorization"])
+		})
+	}
+}
+
+func Test_dpsServiceServer_ListConnectors(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"scope1"},
+	})
+	createdOn := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+	createdOnProto, _ := ptypes.TimestampProto(createdOn)
+	lastRunOn := time.Date(2021, 5, 2, 2, 0, 0, 0, time.UTC)
+	lastRunOnProto, _ := ptypes.TimestampProto(lastRunOn)
+	var mockCtrl *gomock.Controller
+	var rep repo.Dps
+	var queue workerqueue.Workerqueue
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		input    *v1.ListConnectorsRequest
+		setup    func()
+		output   *v1.ListConnectorsResponse
+		wantCode codes.Code
+	}{
+		{
+			name:  "SuccessCaseNoError",
+			ctx:   ctx,
+			input: &v1.ListConnectorsRequest{Scope: "scope1"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().ListConnectors(ctx, "scope1").Times(1).Return([]db.Connector{
+					{
+						Scope:        "scope1",
+						Name:         "cmdb",
+						FileType:     "applications",
+						Schedule:     "@daily",
+						Enabled:      true,
+						SourceType:   db.ConnectorSourceREST,
+						Source:       json.RawMessage(`{"url":"http://cmdb/apps","headers":{"Authorization":"sealed:c2VjcmV0"},"records_path":"items","page_param":"page","first_page":1}`),
+						CreatedBy:    "admin@superuser.com",
+						CreatedOn:    createdOn,
+						LastRunOn:    sql.NullTime{Time: lastRunOn, Valid: true},
+						LastStatus:   connector.StatusSuccess,
+						LastUploadID: 12,
+					},
+				}, nil)
+			},
+			output: &v1.ListConnectorsResponse{
+				Connectors: []*v1.Connector{
+					{
+						Name:         "cmdb",
+						Scope:        "scope1",
+						FileType:     "applications",
+						Schedule:     "@daily",
+						Enabled:      true,
+						Source:       &v1.Connector_Rest{Rest: &v1.RestSource{Url: "http://cmdb/apps", Headers: map[string]string{"Authorization": connector.Masked}, RecordsPath: "items", PageParam: "page", FirstPage: 1}},
+						CreatedBy:    "admin@superuser.com",
+						CreatedOn:    createdOnProto,
+						LastRunOn:    lastRunOnProto,
+						LastStatus:   connector.StatusSuccess,
+						LastUploadId: 12,
+					},
+				},
+			},
+		},
+		{
+			name:     "FailureCaseScopeValidation",
+			ctx:      ctx,
+			input:    &v1.ListConnectorsRequest{Scope: "scope2"},
+			setup:    func() {},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:  "FailureCaseDBError",
+			ctx:   ctx,
+			input: &v1.ListConnectorsRequest{Scope: "scope1"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().ListConnectors(ctx, "scope1").Times(1).Return(nil, errors.New("DBError"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			d := NewDpsServiceServer(rep, queue, nil)
+			got, err := d.ListConnectors(tt.ctx, tt.input)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			if !assert.Empty(t, err) {
+				return
+			}
+			assert.Equal(t, tt.output.String(), got.String())
+		})
+	}
+}
+
+func Test_dpsServiceServer_DeleteConnector(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"scope1"},
+	})
+	var mockCtrl *gomock.Controller
+	var rep repo.Dps
+	var queue workerqueue.Workerqueue
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		input    *v1.DeleteConnectorRequest
+		setup    func()
+		wantCode codes.Code
+	}{
+		{
+			name:  "SuccessCaseNoError",
+			ctx:   ctx,
+			input: &v1.DeleteConnectorRequest{Scope: "scope1", Name: "drop"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().DeleteConnector(ctx, db.DeleteConnectorParams{Scope: "scope1", Name: "drop"}).Times(1).Return(nil)
+			},
+		},
+		{
+			name:     "FailureCaseScopeValidation",
+			ctx:      ctx,
+			input:    &v1.DeleteConnectorRequest{Scope: "scope2", Name: "drop"},
+			setup:    func() {},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:  "FailureCaseDBError",
+			ctx:   ctx,
+			input: &v1.DeleteConnectorRequest{Scope: "scope1", Name: "drop"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().DeleteConnector(ctx, db.DeleteConnectorParams{Scope: "scope1", Name: "drop"}).Times(1).Return(errors.New("DBError"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			d := NewDpsServiceServer(rep, queue, nil)
+			got, err := d.DeleteConnector(tt.ctx, tt.input)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantCode == codes.OK, got.Success)
+		})
+	}
+}
+
+func Test_dpsServiceServer_RunConnector(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"scope1"},
+	})
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer down.Close()
+	var mockCtrl *gomock.Controller
+	var rep repo.Dps
+	var queue workerqueue.Workerqueue
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		input    *v1.RunConnectorRequest
+		setup    func()
+		wantCode codes.Code
+	}{
+		{
+			name:  "FailureCaseSourceDown",
+			ctx:   ctx,
+			input: &v1.RunConnectorRequest{Scope: "scope1", Name: "cmdb"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				gomock.InOrder(
+					mockRepository.EXPECT().GetConnector(ctx, db.GetConnectorParams{Scope: "scope1", Name: "cmdb"}).Times(1).Return(db.Connector{
+						Scope:      "scope1",
+						Name:       "cmdb",
+						FileType:   "applications",
+						SourceType: db.ConnectorSourceREST,
+						Source:     json.RawMessage(`{"url":"` + down.URL + `"}`),
+					}, nil),
+					mockRepository.EXPECT().UpdateConnectorRun(ctx, gomock.Any()).Times(1).DoAndReturn(func(_ context.Context, arg db.UpdateConnectorRunParams) error {
+						assert.Equal(t, connector.StatusFailed, arg.LastStatus)
+						assert.NotEmpty(t, arg.LastError)
+						return nil
+					}),
+				)
+			},
+			wantCode: codes.Internal,
+		},
+		{
+			name:  "FailureCaseConnectorNotFound",
+			ctx:   ctx,
+			input: &v1.RunConnectorRequest{Scope: "scope1", Name: "cmdb"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetConnector(ctx, db.GetConnectorParams{Scope: "scope1", Name: "cmdb"}).Times(1).Return(db.Connector{}, sql.ErrNoRows)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "FailureCaseScopeValidation",
+			ctx:      ctx,
+			input:    &v1.RunConnectorRequest{Scope: "scope2", Name: "cmdb"},
+			setup:    func() {},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			d := NewDpsServiceServer(rep, queue, nil)
+			got, err := d.RunConnector(tt.ctx, tt.input)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.False(t, got.Success)
+		})
+	}
+}
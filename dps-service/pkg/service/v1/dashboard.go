@@ -8,6 +8,7 @@ import (
 	"time"
 
 	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/quality"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
 
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/helper"
@@ -102,9 +103,52 @@ func (d *dpsServiceServer) DashboardQualityOverview(ctx context.Context, req *v1
 			resp.Equipments[i] = float32(math.Round(float64(resp.Equipments[i]*100)) / 100)
 		}
 	}
+
+	violations, err := d.dpsRepo.GetQualityResultsMonthWise(ctx, db.GetQualityResultsMonthWiseParams{
+		Scope:   req.Scope,
+		Year:    int32(currYear),
+		Month:   int32(currMonth),
+		Year_2:  int32(endYear),
+		Month_2: int32(endMonth),
+	})
+	if err != nil {
+		logger.Log.Error("Failed to fetch quality results from DB ", zap.Error(err))
+		return &v1.DashboardQualityOverviewResponse{}, status.Error(codes.Internal, "DBError")
+	}
+	resp.RuleViolations = ruleViolations(violations, currYear, currMonth, int(req.NoOfDataPoints))
 	return &resp, nil
 }
 
+// ruleViolations gives per entity the broken quality rules of each month before the current one, latest first
+func ruleViolations(res []db.GetQualityResultsMonthWiseRow, currYear int, currMonth time.Month, noOfDataPoints int) []*v1.RuleViolations {
+	entities := []string{quality.Applications, quality.Products, quality.Equipments, quality.AcqRights}
+	byEntity := make(map[string]*v1.RuleViolations, len(entities))
+	resp := make([]*v1.RuleViolations, 0, len(entities))
+	for _, entity := range entities {
+		byEntity[entity] = &v1.RuleViolations{
+			Entity:   entity,
+			Errors:   make([]int32, noOfDataPoints),
+			Warnings: make([]int32, noOfDataPoints),
+		}
+		resp = append(resp, byEntity[entity])
+	}
+	for i := 0; i < noOfDataPoints; i++ {
+		year, month, _ := time.Date(currYear, currMonth-time.Month(1+i), 1, 0, 0, 0, 0, time.UTC).Date()
+		for _, val := range res {
+			v, ok := byEntity[val.Entity]
+			if !ok || val.Month.Year() != year || val.Month.Month() != month {
+				continue
+			}
+			if val.Severity == db.RuleSeverityERROR {
+				v.Errors[i] += int32(val.Violations)
+			} else {
+				v.Warnings[i] += int32(val.Violations)
+			}
+		}
+	}
+	return resp
+}
+
 // func (d *dpsServiceServer) DashboardDataFailureRate(ctx context.Context, req *v1.DataFailureRateRequest) (*v1.DataFailureRateResponse, error) {
 // 	resp := &v1.DataFailureRateResponse{}
 // 	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
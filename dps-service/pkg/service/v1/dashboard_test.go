@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
@@ -18,6 +19,7 @@ import (
 	prodV1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/thirdparty/product-service/pkg/api/v1"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 )
 
 var (
@@ -84,10 +86,31 @@ func Test_DashboardQualityOverview(t *testing.T) {
 					{Filename: "aak_products_acquiredrights.csv", Sum: int64(10)},
 					{Filename: "aak_equipments.csv", Sum: int64(10)},
 				}, nil)
+				mockRepository.EXPECT().GetQualityResultsMonthWise(gomock.Any(), gomock.Any()).Return([]db.GetQualityResultsMonthWiseRow{
+					{Entity: "products", Severity: db.RuleSeverityERROR, Month: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), Violations: 3},
+				}, nil)
 			},
 			output:  &v1.DashboardQualityOverviewResponse{},
 			wantErr: false,
 		},
+		{
+			name: "quality results db err",
+			ctx:  ctx,
+			input: &v1.DashboardQualityOverviewRequest{
+				Scope:          "AAK",
+				NoOfDataPoints: int32(10),
+			},
+			setup: func(*v1.DashboardQualityOverviewRequest) {
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetEntityMonthWise(gomock.Any(), gomock.Any()).Return([]db.GetEntityMonthWiseRow{
+					{Filename: "aak_applications.csv", Sum: int64(10)},
+				}, nil)
+				mockRepository.EXPECT().GetQualityResultsMonthWise(gomock.Any(), gomock.Any()).Return(nil, errors.New("err"))
+			},
+			output:  &v1.DashboardQualityOverviewResponse{},
+			wantErr: true,
+		},
 		{
 			name: "correct db err",
 			ctx:  ctx,
@@ -129,6 +152,31 @@ func Test_DashboardQualityOverview(t *testing.T) {
 	}
 }
 
+func Test_ruleViolations(t *testing.T) {
+	month := func(year int, m time.Month) time.Time {
+		return time.Date(year, m, 1, 0, 0, 0, 0, time.UTC)
+	}
+	got := ruleViolations([]db.GetQualityResultsMonthWiseRow{
+		{Entity: "products", Severity: db.RuleSeverityERROR, Month: month(2024, time.February), Violations: 4},
+		{Entity: "products", Severity: db.RuleSeverityWARNING, Month: month(2024, time.January), Violations: 2},
+		{Entity: "equipments", Severity: db.RuleSeverityERROR, Month: month(2023, time.December), Violations: 5},
+		{Entity: "equipments", Severity: db.RuleSeverityERROR, Month: month(2023, time.June), Violations: 7},
+		{Entity: "equipments", Severity: db.RuleSeverityERROR, Month: month(2023, time.February), Violations: 6},
+		{Entity: "unknown", Severity: db.RuleSeverityERROR, Month: month(2024, time.February), Violations: 1},
+	}, 2024, time.March, 3)
+	want := []*v1.RuleViolations{
+		{Entity: "applications", Errors: []int32{0, 0, 0}, Warnings: []int32{0, 0, 0}},
+		{Entity: "products", Errors: []int32{4, 0, 0}, Warnings: []int32{0, 2, 0}},
+		{Entity: "equipments", Errors: []int32{0, 0, 5}, Warnings: []int32{0, 0, 0}},
+		{Entity: "acqrights", Errors: []int32{0, 0, 0}, Warnings: []int32{0, 0, 0}},
+	}
+	if assert.Len(t, got, len(want)) {
+		for i := range want {
+			assert.Equal(t, want[i].String(), got[i].String())
+		}
+	}
+}
+
 // func Test_ListFailureReasons(t *testing.T) {
 // 	var mockCtrl *gomock.Controller
 // 	var rep repo.Dps
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -22,8 +23,10 @@ import (
 
 	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/api/v1"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/config"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/connector"
 	repo "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/transform"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/constants"
 
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/helper"
@@ -49,12 +52,14 @@ type dpsServiceServer struct {
 	product     prodV1.ProductServiceClient
 	account     accv1.AccountServiceClient
 	metric      metv1.MetricServiceClient
+	connectors  *connector.Scheduler
 }
 
 const (
 	ERROR         string = "error"
 	ANALYSED      string = "analysed"
 	SOURCE        string = "source"
+	FAILEDRECORDS string = "failed"
 	USERCANCELLED string = "User Cancelled the Job"
 )
 
@@ -85,6 +90,10 @@ func (d *dpsServiceServer) GetAnalysisFileInfo(ctx context.Context, req *v1.GetA
 		return nil, status.Error(codes.PermissionDenied, "RoleValidationError")
 	}
 
+	if req.FileType == FAILEDRECORDS {
+		return d.errorWorkbookInfo(ctx, req)
+	}
+
 	resp, err := d.dpsRepo.GetGlobalFileInfo(ctx, db.GetGlobalFileInfoParams{Scope: req.Scope, UploadID: req.UploadId})
 	if err != nil {
 		logger.Log.Error("Failed to get globalFileInfo", zap.Error(err))
@@ -175,6 +184,19 @@ func (d *dpsServiceServer) DropUploadedFileData(ctx context.Context, req *v1.Dro
 			Success: false,
 		}, status.Error(codes.Internal, err.Error())
 	}
+	// the previous states kept by the injections are no longer valid
+	if err := d.dpsRepo.DeleteInjections(ctx, req.Scope); err != nil {
+		logger.Log.Error("Failed to delete injections", zap.Error(err))
+		return &v1.DropUploadedFileDataResponse{
+			Success: false,
+		}, status.Error(codes.Internal, err.Error())
+	}
+	if err := d.dpsRepo.DeleteSyncedRecords(ctx, req.Scope); err != nil {
+		logger.Log.Error("Failed to delete synced records", zap.Error(err))
+		return &v1.DropUploadedFileDataResponse{
+			Success: false,
+		}, status.Error(codes.Internal, err.Error())
+	}
 	return &v1.DropUploadedFileDataResponse{
 		Success: true,
 	}, nil
@@ -232,28 +254,34 @@ func (d *dpsServiceServer) ListFailedRecord(ctx context.Context, req *v1.ListFai
 		}
 		temp.Reason = tmp.Comments.String
 		totalRecords = int32(tmp.Totalrecords)
-		temp.Data = make(map[string]string)
-		for k, i := range resp {
-			val := ""
-			switch v := i.(type) {
-			case int, int32, int64:
-				val = fmt.Sprintf("%d", v)
-			case float64, float32:
-				val = fmt.Sprintf("%f", v)
-			case string:
-				val = v
-			default:
-				x, _ := json.Marshal(v)
-				val = string(x)
-			}
-			temp.Data[k] = val
-		}
+		temp.Data = failedRecordData(resp)
 		out = append(out, temp)
 	}
 
 	return &v1.ListFailedResponse{FailedRecords: out, TotalRecords: totalRecords}, nil
 }
 
+// failedRecordData formats the values of a failed record as strings
+func failedRecordData(record map[string]interface{}) map[string]string {
+	data := make(map[string]string)
+	for k, i := range record {
+		val := ""
+		switch v := i.(type) {
+		case int, int32, int64:
+			val = fmt.Sprintf("%d", v)
+		case float64, float32:
+			val = fmt.Sprintf("%f", v)
+		case string:
+			val = v
+		default:
+			x, _ := json.Marshal(v)
+			val = string(x)
+		}
+		data[k] = val
+	}
+	return data
+}
+
 // NotifyUpload tells dps to process a batch of files of a scope
 func (d *dpsServiceServer) NotifyUpload(ctx context.Context, req *v1.NotifyUploadRequest) (*v1.NotifyUploadResponse, error) { //nolint
 	out := v1.NotifyUploadResponse{Success: true}
@@ -299,6 +327,53 @@ func (d *dpsServiceServer) NotifyUpload(ctx context.Context, req *v1.NotifyUploa
 	if req.GetScopeType() != v1.NotifyUploadRequest_GENERIC {
 		scopeType = db.ScopeTypesSPECIFIC
 	}
+	if req.GetMappingProfile() != "" {
+		if _, err := d.dpsRepo.GetMappingProfile(ctx, db.GetMappingProfileParams{Scope: req.Scope, Name: req.GetMappingProfile()}); err != nil {
+			if err == sql.ErrNoRows {
+				return nil, status.Error(codes.InvalidArgument, "MappingProfileNotFound")
+			}
+			logger.Log.Sugar().Errorf("Failed to get mapping profile", zap.Error(err))
+			return nil, status.Error(codes.Internal, "DBError")
+		}
+	}
+
+	syncMode := db.SyncModeFULL
+	if req.GetSyncMode() != v1.NotifyUploadRequest_FULL {
+		if datatype != db.DataTypeDATA {
+			return nil, status.Error(codes.InvalidArgument, "SyncModeOfDataOnly")
+		}
+		syncMode = db.SyncMode(req.GetSyncMode().String())
+	}
+	if syncMode == db.SyncModeSNAPSHOT {
+		for _, file := range req.GetFiles() {
+			if !snapshotSupported(file, req.GetScope()) {
+				return nil, status.Error(codes.InvalidArgument, "SnapshotNotSupported")
+			}
+		}
+	}
+
+	var injectionID int32
+	if req.GetTransactional() {
+		if datatype != db.DataTypeDATA {
+			return nil, status.Error(codes.InvalidArgument, "TransactionalUploadOfDataOnly")
+		}
+		var files int32
+		for _, file := range req.GetFiles() {
+			if strings.TrimSpace(file) != "" {
+				files++
+			}
+		}
+		inj, err := d.dpsRepo.InsertInjection(ctx, db.InsertInjectionParams{
+			Scope:      req.GetScope(),
+			TotalFiles: files,
+			CreatedBy:  req.GetUploadedBy(),
+		})
+		if err != nil {
+			logger.Log.Sugar().Errorf("Failed to insert injection", zap.Error(err))
+			return nil, status.Error(codes.Internal, "DBError")
+		}
+		injectionID = inj.InjectionID
+	}
 
 	for _, file := range req.GetFiles() {
 		if strings.TrimSpace(file) == "" {
@@ -325,14 +400,17 @@ func (d *dpsServiceServer) NotifyUpload(ctx context.Context, req *v1.NotifyUploa
 
 		// TODO will go in import service in future also handle txn for multiple files if one fails
 		dbresp, err := d.dpsRepo.InsertUploadedData(ctx, db.InsertUploadedDataParams{
-			FileName:   fileToSave,
-			DataType:   datatype,
-			Scope:      req.GetScope(),
-			UploadedBy: req.GetUploadedBy(),
-			Gid:        gid,
-			Status:     fileStatus,
-			ScopeType:  scopeType,
-			AnalysisID: sql.NullString{String: req.AnalysisId, Valid: true},
+			FileName:       fileToSave,
+			DataType:       datatype,
+			Scope:          req.GetScope(),
+			UploadedBy:     req.GetUploadedBy(),
+			Gid:            gid,
+			Status:         fileStatus,
+			ScopeType:      scopeType,
+			AnalysisID:     sql.NullString{String: req.AnalysisId, Valid: true},
+			MappingProfile: req.GetMappingProfile(),
+			InjectionID:    injectionID,
+			SyncMode:       syncMode,
 		})
 		if err != nil {
 			logger.Log.Sugar().Errorf("Failed to insert file record in dps, err :", zap.Error(err), zap.Any("file", fileToSave))
@@ -356,9 +434,8 @@ func (d *dpsServiceServer) NotifyUpload(ctx context.Context, req *v1.NotifyUploa
 			if err != nil {
 				logger.Log.Sugar().Debug("Failed to push the job ", zap.String("file", file), zap.String("fileType", req.GetType()))
 			}
-		} else {
-			out.FileUploadId[fileToSave] = dbresp.UploadID
 		}
+		out.FileUploadId[fileToSave] = dbresp.UploadID
 	}
 
 	return &out, nil
@@ -420,6 +497,10 @@ func (d *dpsServiceServer) ListUploadData(ctx context.Context, req *v1.ListUploa
 		apiresp.Uploads[i].SuccessRecords = dbresp[i].SuccessRecords
 		apiresp.Uploads[i].TotalRecords = dbresp[i].TotalRecords
 		apiresp.Uploads[i].Comments = dbresp[i].Comments.String
+		apiresp.Uploads[i].InsertedRecords = dbresp[i].InsertedRecords
+		apiresp.Uploads[i].UpdatedRecords = dbresp[i].UpdatedRecords
+		apiresp.Uploads[i].DeletedRecords = dbresp[i].DeletedRecords
+		apiresp.Uploads[i].ProcessedRecords = dbresp[i].ProcessedRecords
 	}
 	return apiresp, nil
 }
@@ -567,6 +648,10 @@ func (d *dpsServiceServer) DeleteInventory(ctx context.Context, req *v1.DeleteIn
 		ID:     deletionID}); err != nil {
 		logger.Log.Error("Failed to update deletion  status ", zap.Any("scope", req.Scope), zap.Error(err))
 	}
+	// the next uploads are compared with the remaining inventory
+	if err := d.dpsRepo.DeleteSyncedRecords(ctx, req.Scope); err != nil {
+		logger.Log.Error("Failed to delete synced records", zap.Any("scope", req.Scope), zap.Error(err))
+	}
 
 	if req.DeletionType != v1.DeleteInventoryRequest_ACQRIGHTS {
 		if resp, err := d.product.CreateDashboardUpdateJob(ctx, &prodV1.CreateDashboardUpdateJobRequest{Scope: req.Scope}); err != nil || !resp.Success {
@@ -642,10 +727,28 @@ func (d *dpsServiceServer) ListUploadGlobalData(ctx context.Context, req *v1.Lis
 		apiresp.Uploads[i].UploadedBy = dbresp[i].UploadedBy
 		apiresp.Uploads[i].UploadedOn, _ = ptypes.TimestampProto(dbresp[i].UploadedOn)
 		apiresp.Uploads[i].Comments = dbresp[i].Comments.String
+		apiresp.Uploads[i].RejectedRows = rejectedRowsToProto(dbresp[i].RejectedRows)
 	}
 	return apiresp, nil
 }
 
+// rejectedRowsToProto gives the rows left out of the data files of a global file split by dps
+func rejectedRowsToProto(data json.RawMessage) []*v1.RejectedRow {
+	if len(data) == 0 {
+		return nil
+	}
+	var rowErrors []transform.RowError
+	if err := json.Unmarshal(data, &rowErrors); err != nil {
+		logger.Log.Error("Failed to unmarshal rejected rows", zap.Error(err))
+		return nil
+	}
+	var rows []*v1.RejectedRow
+	for _, r := range rowErrors {
+		rows = append(rows, &v1.RejectedRow{Sheet: r.Sheet, Row: int32(r.Row), Cell: r.Cell, Action: r.Action, Reason: r.Reason})
+	}
+	return rows
+}
+
 func (d *dpsServiceServer) ListDeletionRecords(ctx context.Context, req *v1.ListDeletionRequest) (*v1.ListDeletionResponse, error) {
 	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
 	if !ok {
@@ -729,6 +832,17 @@ func (d *dpsServiceServer) isDeletionActive(ctx context.Context, scope, deletion
 	return id, false
 }
 
+// snapshotSupported tells if the records missing from the file can be deleted, products and equipments
+// cannot be deleted one by one so their files are not uploaded as snapshots
+func snapshotSupported(file, scope string) bool {
+	if temp := strings.Split(file, constants.NifiFileDelimeter); len(temp) == 3 {
+		file = temp[2]
+	}
+	fileType := strings.TrimSuffix(strings.ToUpper(file), strings.ToUpper(filepath.Ext(file)))
+	fileType = strings.TrimPrefix(fileType, strings.ToUpper(scope)+"_")
+	return fileType != constants.PRODUCTS && !strings.HasPrefix(fileType, "EQUIPMENT_")
+}
+
 func (d *dpsServiceServer) isInjectionActive(ctx context.Context, scope string) bool {
 	count, err := d.dpsRepo.GetInjectionStatus(ctx, scope)
 	if err != nil {
@@ -176,6 +176,7 @@ func Test_NotifyUpload(t *testing.T) {
 					Status:     db.UploadStatusPENDING,
 					ScopeType:  db.ScopeTypesGENERIC,
 					AnalysisID: sql.NullString{String: "", Valid: true},
+					SyncMode:   db.SyncModeFULL,
 				}).Return(db.UploadedDataFile{
 					UploadID:       int32(1),
 					Scope:          "Scope1",
@@ -243,6 +244,7 @@ func Test_NotifyUpload(t *testing.T) {
 					Status:     db.UploadStatusPENDING,
 					ScopeType:  db.ScopeTypesGENERIC,
 					AnalysisID: sql.NullString{String: "", Valid: true},
+					SyncMode:   db.SyncModeFULL,
 				}).Return(db.UploadedDataFile{
 					UploadID:       int32(1),
 					Scope:          "Scope1",
@@ -303,6 +305,69 @@ func Test_NotifyUpload(t *testing.T) {
 			output:  &v1.NotifyUploadResponse{Success: true},
 			wantErr: true,
 		},
+		{
+			name: "sync mode of metadata",
+			ctx:  ctx,
+			input: &v1.NotifyUploadRequest{
+				Scope:      "Scope1",
+				Type:       "metadata",
+				UploadedBy: "admin@test.com",
+				Files:      []string{"Scope1_metadata.csv"},
+				SyncMode:   v1.NotifyUploadRequest_DELTA,
+			},
+			setup: func(*v1.NotifyUploadRequest) {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := dbmock.NewMockDps(mockCtrl)
+
+				rep = mockRepo
+				mockRepo.EXPECT().GetDeletionStatus(ctx, "Scope1").Return(int64(0), nil).Times(1)
+				mockRepo.EXPECT().GetInjectionStatus(ctx, "Scope1").Return(int64(0), nil).Times(1)
+			},
+			output:  &v1.NotifyUploadResponse{Success: false},
+			wantErr: true,
+		},
+		{
+			name: "snapshot of products",
+			ctx:  ctx,
+			input: &v1.NotifyUploadRequest{
+				Scope:      "Scope1",
+				Type:       "data",
+				UploadedBy: "admin@test.com",
+				Files:      []string{"Scope1_applications.csv", "Scope1_products.csv"},
+				SyncMode:   v1.NotifyUploadRequest_SNAPSHOT,
+			},
+			setup: func(*v1.NotifyUploadRequest) {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := dbmock.NewMockDps(mockCtrl)
+
+				rep = mockRepo
+				mockRepo.EXPECT().GetDeletionStatus(ctx, "Scope1").Return(int64(0), nil).Times(1)
+				mockRepo.EXPECT().GetInjectionStatus(ctx, "Scope1").Return(int64(0), nil).Times(1)
+			},
+			output:  &v1.NotifyUploadResponse{Success: false},
+			wantErr: true,
+		},
+		{
+			name: "snapshot of equipments",
+			ctx:  ctx,
+			input: &v1.NotifyUploadRequest{
+				Scope:      "Scope1",
+				Type:       "data",
+				UploadedBy: "admin@test.com",
+				Files:      []string{"Scope1_equipment_server.csv"},
+				SyncMode:   v1.NotifyUploadRequest_SNAPSHOT,
+			},
+			setup: func(*v1.NotifyUploadRequest) {
+				mockCtrl = gomock.NewController(t)
+				mockRepo := dbmock.NewMockDps(mockCtrl)
+
+				rep = mockRepo
+				mockRepo.EXPECT().GetDeletionStatus(ctx, "Scope1").Return(int64(0), nil).Times(1)
+				mockRepo.EXPECT().GetInjectionStatus(ctx, "Scope1").Return(int64(0), nil).Times(1)
+			},
+			output:  &v1.NotifyUploadResponse{Success: false},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -379,6 +444,7 @@ func Test_dpsServiceServer_ListUploadGlobalData(t *testing.T) {
 						UploadedBy:   "dummy2",
 						UploadedOn:   ut,
 						AnalysisID:   sql.NullString{String: "121", Valid: true},
+						RejectedRows: json.RawMessage(`[{"sheet":"servers","row":3,"cell":"B3","action":"wrongTypeField","reason":"wrong type"}]`),
 					}}, nil)
 
 			},
@@ -401,6 +467,9 @@ func Test_dpsServiceServer_ListUploadGlobalData(t *testing.T) {
 						Status:     "UPLOADED",
 						UploadedBy: "dummy2",
 						UploadedOn: gt,
+						RejectedRows: []*v1.RejectedRow{
+							{Sheet: "servers", Row: 3, Cell: "B3", Action: "wrongTypeField", Reason: "wrong type"},
+						},
 					},
 				},
 			},
@@ -714,6 +783,7 @@ func Test_dpsServiceServer_DeleteInventory(t *testing.T) {
 					Status: db.UploadStatusSUCCESS,
 					Reason: sql.NullString{String: "", Valid: true},
 					ID:     int32(1)}).Return(nil).Times(1)
+				mockRepo.EXPECT().DeleteSyncedRecords(ctx, "Scope1").Return(nil).Times(1)
 			},
 			want: &v1.DeleteInventoryResponse{
 				Success: true,
@@ -863,6 +933,8 @@ func Test_DropUploadedFileData(t *testing.T) {
 				mockRepo := dbmock.NewMockDps(mockCtrl)
 				rep = mockRepo
 				mockRepo.EXPECT().DropFileRecords(ctx, "Scope2").Return(nil).Times(1)
+				mockRepo.EXPECT().DeleteInjections(ctx, "Scope2").Return(nil).Times(1)
+				mockRepo.EXPECT().DeleteSyncedRecords(ctx, "Scope2").Return(nil).Times(1)
 			},
 			wantErr: false,
 			ctx:     ctx,
@@ -875,6 +947,8 @@ func Test_DropUploadedFileData(t *testing.T) {
 				mockRepo := dbmock.NewMockDps(mockCtrl)
 				rep = mockRepo
 				mockRepo.EXPECT().DropFileRecords(ctx, "Scope1").Return(nil).Times(1)
+				mockRepo.EXPECT().DeleteInjections(ctx, "Scope1").Return(nil).Times(1)
+				mockRepo.EXPECT().DeleteSyncedRecords(ctx, "Scope1").Return(nil).Times(1)
 			},
 			wantErr: false,
 			ctx:     ctx,
@@ -0,0 +1,137 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	apiworker "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/api_worker"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/models"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/helper"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorWorkbookInfo writes the error workbook of a finished data upload with its failed records
+func (d *dpsServiceServer) errorWorkbookInfo(ctx context.Context, req *v1.GetAnalysisFileInfoRequest) (*v1.GetAnalysisFileInfoResponse, error) {
+	file, err := d.finishedDataFile(ctx, req.Scope, req.UploadId)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := apiworker.WriteErrorWorkbook(ctx, d.dpsRepo, file)
+	if err != nil {
+		logger.Log.Error("Failed to write error workbook", zap.Error(err), zap.Int32("uploadID", req.UploadId))
+		return nil, status.Error(codes.Internal, "ErrorWorkbookFailure")
+	}
+	if rows == 0 {
+		return nil, status.Error(codes.NotFound, "NoFailedRecord")
+	}
+	return &v1.GetAnalysisFileInfoResponse{FileName: apiworker.ErrorWorkbookName(file), ScopeType: string(file.ScopeType)}, nil
+}
+
+// RetryFailedRecords retries the failed records of a data upload with the rows of its corrected error workbook,
+// the rows which are not failed records of the upload anymore are skipped.
+func (d *dpsServiceServer) RetryFailedRecords(ctx context.Context, req *v1.RetryFailedRecordsRequest) (*v1.RetryFailedRecordsResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	if userClaims.Role == claims.RoleUser {
+		return nil, status.Error(codes.PermissionDenied, "RoleValidationError")
+	}
+	if filepath.Base(req.File) != req.File {
+		return nil, status.Error(codes.InvalidArgument, "BadFileName")
+	}
+	file, err := d.finishedDataFile(ctx, req.Scope, req.UploadId)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := apiworker.ReadErrorWorkbook(filepath.Join(apiworker.ErrorWorkbookDir(req.Scope), req.File))
+	if err != nil {
+		logger.Log.Error("Failed to read error workbook", zap.Error(err), zap.String("file", req.File))
+		return nil, status.Error(codes.InvalidArgument, "BadErrorWorkbook")
+	}
+	if len(rows) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "NoRowToRetry")
+	}
+	failed, err := d.dpsRepo.GetFailedJobs(ctx, json.RawMessage(fmt.Sprintf("%d", file.UploadID)))
+	if err != nil {
+		logger.Log.Error("Failed to get failed jobs", zap.Error(err), zap.Int32("uploadID", file.UploadID))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	jobs := make(map[int32]db.GetFailedJobsRow, len(failed))
+	for _, f := range failed {
+		jobs[f.JobID] = f
+	}
+	resp := &v1.RetryFailedRecordsResponse{}
+	skip := func(row apiworker.CorrectedRow, reason string) {
+		resp.SkippedRows = append(resp.SkippedRows, &v1.SkippedRow{Row: int32(row.Row), Reason: reason})
+	}
+	for _, row := range rows {
+		f, ok := jobs[row.JobID]
+		if !ok {
+			skip(row, "NotAFailedRecord")
+			continue
+		}
+		// a record listed twice is retried once
+		delete(jobs, row.JobID)
+		var env models.Envlope
+		if err := json.Unmarshal(f.Data, &env); err != nil {
+			logger.Log.Error("Failed to unmarshal failed job", zap.Error(err), zap.Int32("job", f.JobID))
+			skip(row, "BadFailedRecord")
+			continue
+		}
+		if !apiworker.Retryable(env) {
+			skip(row, apiworker.ErrNotRetryable.Error())
+			continue
+		}
+		data, err := apiworker.CorrectRecord(env.Data, row.Values)
+		if err != nil {
+			skip(row, err.Error())
+			continue
+		}
+		retried, err := apiworker.RetryFailedJob(ctx, d.dpsRepo, d.queue, file, f, data)
+		if err == apiworker.ErrRecordCountChanged || err == apiworker.ErrNotRetryable {
+			skip(row, err.Error())
+			continue
+		}
+		if err != nil {
+			logger.Log.Error("Failed to retry failed job", zap.Error(err), zap.Int32("job", f.JobID))
+			return nil, status.Error(codes.Internal, "RetryFailed")
+		}
+		if !retried {
+			skip(row, "NotAFailedRecord")
+			continue
+		}
+		resp.RetriedRecords++
+	}
+	return resp, nil
+}
+
+// finishedDataFile gives the data upload of the scope once its records are processed
+func (d *dpsServiceServer) finishedDataFile(ctx context.Context, scope string, uploadID int32) (db.UploadedDataFile, error) {
+	file, err := d.dpsRepo.GetDataFile(ctx, uploadID)
+	if err == sql.ErrNoRows || (err == nil && file.Scope != scope) {
+		return file, status.Error(codes.NotFound, "UploadNotFound")
+	} else if err != nil {
+		logger.Log.Error("Failed to get data file", zap.Error(err), zap.Int32("uploadID", uploadID))
+		return file, status.Error(codes.Internal, "DBError")
+	}
+	if file.Status != db.UploadStatusFAILED && file.Status != db.UploadStatusPARTIAL {
+		return file, status.Error(codes.FailedPrecondition, "UploadNotFinishedWithFailures")
+	}
+	return file, nil
+}
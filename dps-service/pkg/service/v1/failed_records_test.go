@@ -0,0 +1,370 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/job"
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/api/v1"
+	repo "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1"
+	dbmock "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/dbmock"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	queuemock "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/queuemock"
+	apiworker "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/api_worker"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/constants"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/models"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	excel "github.com/xuri/excelize/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func failedJob(t *testing.T, id int32, rpc, record, reason string) db.GetFailedJobsRow {
+	data, err := json.Marshal(models.Envlope{
+		Data:          json.RawMessage(record),
+		TargetService: constants.ProdService,
+		TargetAction:  constants.UPSERT,
+		TargetRPC:     rpc,
+		FileName:      "TST_products.csv",
+		UploadID:      7,
+		InjectionID:   3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db.GetFailedJobsRow{JobID: id, Status: db.JobStatusDEAD, Comments: sql.NullString{String: reason, Valid: true}, Data: data}
+}
+
+func Test_dpsServiceServer_GetAnalysisFileInfo_failedRecords(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"TST", "OTH"},
+	})
+	defer os.RemoveAll(filepath.Join("testdata", "TST"))
+	file := db.UploadedDataFile{UploadID: 7, Scope: "TST", FileName: "TST_products.csv", Status: db.UploadStatusPARTIAL, ScopeType: db.ScopeTypesSPECIFIC}
+	var mockCtrl *gomock.Controller
+	var rep repo.Dps
+	var queue workerqueue.Workerqueue
+	tests := []struct {
+		name     string
+		input    *v1.GetAnalysisFileInfoRequest
+		setup    func()
+		output   *v1.GetAnalysisFileInfoResponse
+		rows     [][]string
+		wantCode codes.Code
+	}{
+		{
+			name:  "SuccessCaseNoError",
+			input: &v1.GetAnalysisFileInfoRequest{Scope: "TST", UploadId: 7, FileType: FAILEDRECORDS},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetDataFile(ctx, int32(7)).Times(1).Return(file, nil)
+				mockRepository.EXPECT().GetFailedJobs(ctx, json.RawMessage("7")).Times(1).Return([]db.GetFailedJobsRow{
+					failedJob(t, 11, constants.PRODUCTS, `{"swid_tag":"p 1","name":"Oracle","is_option":true,"count":3}`, "rpc error: code = InvalidArgument desc = invalid UpsertProductRequest.SwidTag: value does not match regex pattern"),
+					failedJob(t, 12, constants.PRODUCTS, `{"swid_tag":"p2","applications":{"application_id":["a1"]}}`, "context deadline exceeded"),
+				}, nil)
+			},
+			output: &v1.GetAnalysisFileInfoResponse{FileName: "failed_7_TST_products.xlsx", ScopeType: "SPECIFIC"},
+			rows: [][]string{
+				{"job_id", "applications", "count", "is_option", "name", "swid_tag", "reason", "column_hint"},
+				{"11", "", "3", "true", "Oracle", "p 1", "rpc error: code = InvalidArgument desc = invalid UpsertProductRequest.SwidTag: value does not match regex pattern", "swid_tag"},
+				{"12", `{"application_id":["a1"]}`, "", "", "", "p2", "context deadline exceeded"},
+			},
+		},
+		{
+			name:  "no failed record",
+			input: &v1.GetAnalysisFileInfoRequest{Scope: "TST", UploadId: 7, FileType: FAILEDRECORDS},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetDataFile(ctx, int32(7)).Times(1).Return(file, nil)
+				mockRepository.EXPECT().GetFailedJobs(ctx, json.RawMessage("7")).Times(1).Return(nil, nil)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:  "upload of another scope",
+			input: &v1.GetAnalysisFileInfoRequest{Scope: "OTH", UploadId: 7, FileType: FAILEDRECORDS},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetDataFile(ctx, int32(7)).Times(1).Return(file, nil)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:  "upload in progress",
+			input: &v1.GetAnalysisFileInfoRequest{Scope: "TST", UploadId: 7, FileType: FAILEDRECORDS},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				inProgress := file
+				inProgress.Status = db.UploadStatusINPROGRESS
+				mockRepository.EXPECT().GetDataFile(ctx, int32(7)).Times(1).Return(inProgress, nil)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:  "dberror",
+			input: &v1.GetAnalysisFileInfoRequest{Scope: "TST", UploadId: 7, FileType: FAILEDRECORDS},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetDataFile(ctx, int32(7)).Times(1).Return(file, nil)
+				mockRepository.EXPECT().GetFailedJobs(ctx, json.RawMessage("7")).Times(1).Return(nil, errors.New("db error"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			obj := NewDpsServiceServer(rep, queue, nil)
+			got, err := obj.GetAnalysisFileInfo(ctx, tt.input)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.output == nil {
+				return
+			}
+			assert.Equal(t, tt.output, got)
+			fp, err := excel.OpenFile(filepath.Join(apiworker.ErrorWorkbookDir("TST"), got.FileName))
+			if !assert.Empty(t, err) {
+				return
+			}
+			rows, err := fp.GetRows(apiworker.FailedRecordsSheet)
+			assert.Empty(t, err)
+			assert.Equal(t, tt.rows, rows)
+		})
+	}
+}
+
+// writeCorrectedWorkbook writes a corrected error workbook in the errors directory of the scope
+func writeCorrectedWorkbook(t *testing.T, scope, name string, rows [][]interface{}) {
+	fp := excel.NewFile()
+	fp.SetSheetName("Sheet1", apiworker.FailedRecordsSheet)
+	for i := range rows {
+		if err := fp.SetSheetRow(apiworker.FailedRecordsSheet, fmt.Sprintf("A%d", i+1), &rows[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.MkdirAll(apiworker.ErrorWorkbookDir(scope), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := fp.SaveAs(filepath.Join(apiworker.ErrorWorkbookDir(scope), name)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func Test_dpsServiceServer_RetryFailedRecords(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"TST", "OTH"},
+	})
+	userCtx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "user@test.com",
+		Role:   "User",
+		Socpes: []string{"TST"},
+	})
+	defer os.RemoveAll(filepath.Join("testdata", "TST"))
+	writeCorrectedWorkbook(t, "TST", "corrected.xlsx", [][]interface{}{
+		{"job_id", "applications", "count", "name", "swid_tag", "reason", "column_hint"},
+		{11, "", 3, "Oracle", "p_1", "invalid UpsertProductRequest.SwidTag", "swid_tag"},
+		{12, "", "three", "", "p2", "", ""},
+		{99, "", "", "", "p9", "", ""},
+		{},
+		{13, `{"application_id":["a1"]}`, "", "", "p3", "", ""},
+		{14, "", "", "", "p4", "", ""},
+		{15, "", "", "", "", "InsufficentData", ""},
+	})
+	file := db.UploadedDataFile{UploadID: 7, Scope: "TST", FileName: "TST_products.csv", Status: db.UploadStatusFAILED}
+	failed := []db.GetFailedJobsRow{
+		failedJob(t, 11, constants.PRODUCTS, `{"swid_tag":"p 1","name":"Oracle","count":3,"scope":"TST"}`, "invalid UpsertProductRequest.SwidTag"),
+		failedJob(t, 12, constants.PRODUCTS, `{"swid_tag":"p2","count":1}`, "timeout"),
+		failedJob(t, 13, constants.ApplicationsProducts, `{"swid_tag":"p3","applications":{"application_id":["a1","a2"]}}`, "timeout"),
+		failedJob(t, 14, constants.PRODUCTS, `{"swid_tag":"p4"}`, "timeout"),
+		// the invalid records of the file are kept as failed jobs without a call
+		{JobID: 15, Status: db.JobStatusFAILED, Comments: sql.NullString{String: "InsufficentData", Valid: true}, Data: json.RawMessage(`{"Data":{"AtLineNo":"4"},"UploadID":7,"FileName":"TST_products.csv","scope":"TST"}`)},
+	}
+	var mockCtrl *gomock.Controller
+	var rep repo.Dps
+	var queue workerqueue.Workerqueue
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		input    *v1.RetryFailedRecordsRequest
+		setup    func()
+		output   *v1.RetryFailedRecordsResponse
+		wantCode codes.Code
+	}{
+		{
+			name:  "SuccessCaseNoError",
+			ctx:   ctx,
+			input: &v1.RetryFailedRecordsRequest{Scope: "TST", UploadId: 7, File: "corrected.xlsx"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				mockQueue := queuemock.NewMockWorkerqueue(mockCtrl)
+				rep = mockRepository
+				queue = mockQueue
+				mockRepository.EXPECT().GetDataFile(ctx, int32(7)).Times(1).Return(file, nil)
+				mockRepository.EXPECT().GetFailedJobs(ctx, json.RawMessage("7")).Times(1).Return(failed, nil)
+				mockRepository.EXPECT().RetryFailedJob(ctx, db.RetryFailedJobParams{JobID: 11, Comments: sql.NullString{String: "RETRIED", Valid: true}}).Times(1).Return(int64(1), nil)
+				mockRepository.EXPECT().RetryFileFailedRecords(ctx, db.RetryFileFailedRecordsParams{UploadID: 7, FileName: "TST_products.csv", FailedRecords: 1}).Times(1).Return(nil)
+				mockQueue.EXPECT().PushJob(ctx, gomock.Any(), constants.APIWORKER).Times(1).DoAndReturn(func(_ context.Context, j job.Job, _ string) (int32, error) {
+					var env models.Envlope
+					if assert.Empty(t, json.Unmarshal(j.Data, &env)) {
+						assert.JSONEq(t, `{"swid_tag":"p_1","name":"Oracle","count":3,"scope":"TST"}`, string(env.Data))
+						assert.Equal(t, int32(0), env.InjectionID)
+						assert.Equal(t, int32(7), env.UploadID)
+					}
+					assert.Equal(t, job.JobStatusPENDING, j.Status)
+					return 21, nil
+				})
+				mockRepository.EXPECT().RetryFailedJob(ctx, db.RetryFailedJobParams{JobID: 14, Comments: sql.NullString{String: "RETRIED", Valid: true}}).Times(1).Return(int64(0), nil)
+			},
+			output: &v1.RetryFailedRecordsResponse{
+				RetriedRecords: 1,
+				SkippedRows: []*v1.SkippedRow{
+					{Row: 3, Reason: "column count expects a number"},
+					{Row: 4, Reason: "NotAFailedRecord"},
+					{Row: 6, Reason: apiworker.ErrRecordCountChanged.Error()},
+					{Row: 7, Reason: "NotAFailedRecord"},
+					{Row: 8, Reason: apiworker.ErrNotRetryable.Error()},
+				},
+			},
+		},
+		{
+			name:  "push error puts the job back",
+			ctx:   ctx,
+			input: &v1.RetryFailedRecordsRequest{Scope: "TST", UploadId: 7, File: "corrected.xlsx"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				mockQueue := queuemock.NewMockWorkerqueue(mockCtrl)
+				rep = mockRepository
+				queue = mockQueue
+				mockRepository.EXPECT().GetDataFile(ctx, int32(7)).Times(1).Return(file, nil)
+				mockRepository.EXPECT().GetFailedJobs(ctx, json.RawMessage("7")).Times(1).Return(failed, nil)
+				mockRepository.EXPECT().RetryFailedJob(ctx, gomock.Any()).Times(1).Return(int64(1), nil)
+				mockRepository.EXPECT().RetryFileFailedRecords(ctx, gomock.Any()).Times(1).Return(nil)
+				mockQueue.EXPECT().PushJob(ctx, gomock.Any(), constants.APIWORKER).Times(1).Return(int32(0), errors.New("queue error"))
+				mockRepository.EXPECT().RestoreFailedJob(ctx, db.RestoreFailedJobParams{JobID: 11, Status: db.JobStatusDEAD, Comments: failed[0].Comments}).Times(1).Return(nil)
+				mockRepository.EXPECT().UpdateFileFailedRecord(ctx, db.UpdateFileFailedRecordParams{UploadID: 7, FileName: "TST_products.csv", FailedRecords: 1}).Times(1).Return(db.UpdateFileFailedRecordRow{Isfailed: true}, nil)
+				mockRepository.EXPECT().UpdateFileStatus(ctx, db.UpdateFileStatusParams{Status: db.UploadStatusFAILED, UploadID: 7, FileName: "TST_products.csv"}).Times(1).Return(nil)
+			},
+			wantCode: codes.Internal,
+		},
+		{
+			name:  "workbook not found",
+			ctx:   ctx,
+			input: &v1.RetryFailedRecordsRequest{Scope: "TST", UploadId: 7, File: "missing.xlsx"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetDataFile(ctx, int32(7)).Times(1).Return(file, nil)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:  "file outside of the errors directory",
+			ctx:   ctx,
+			input: &v1.RetryFailedRecordsRequest{Scope: "TST", UploadId: 7, File: "../corrected.xlsx"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = dbmock.NewMockDps(mockCtrl)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:  "upload not found",
+			ctx:   ctx,
+			input: &v1.RetryFailedRecordsRequest{Scope: "TST", UploadId: 8, File: "corrected.xlsx"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetDataFile(ctx, int32(8)).Times(1).Return(db.UploadedDataFile{}, sql.ErrNoRows)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:  "upload succeeded",
+			ctx:   ctx,
+			input: &v1.RetryFailedRecordsRequest{Scope: "TST", UploadId: 7, File: "corrected.xlsx"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				succeeded := file
+				succeeded.Status = db.UploadStatusSUCCESS
+				mockRepository.EXPECT().GetDataFile(ctx, int32(7)).Times(1).Return(succeeded, nil)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:  "scope out of context",
+			ctx:   ctx,
+			input: &v1.RetryFailedRecordsRequest{Scope: "ABC", UploadId: 7, File: "corrected.xlsx"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = dbmock.NewMockDps(mockCtrl)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:  "user role",
+			ctx:   userCtx,
+			input: &v1.RetryFailedRecordsRequest{Scope: "TST", UploadId: 7, File: "corrected.xlsx"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = dbmock.NewMockDps(mockCtrl)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			obj := NewDpsServiceServer(rep, queue, nil)
+			got, err := obj.RetryFailedRecords(tt.ctx, tt.input)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.output != nil {
+				assert.Equal(t, tt.output, got)
+			}
+		})
+	}
+}
+
+func Test_RetryFailedJob_placeholder(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	// neither the job nor the file are updated and nothing is pushed
+	mockRepository := dbmock.NewMockDps(mockCtrl)
+	mockQueue := queuemock.NewMockWorkerqueue(mockCtrl)
+	file := db.UploadedDataFile{UploadID: 7, Scope: "TST", FileName: "TST_products.csv", Status: db.UploadStatusFAILED}
+	placeholder := db.GetFailedJobsRow{JobID: 15, Status: db.JobStatusFAILED, Data: json.RawMessage(`{"Data":{"swidtag":"p1"},"UploadID":7,"FileName":"TST_products.csv","Scope":"TST"}`)}
+	retried, err := apiworker.RetryFailedJob(context.Background(), mockRepository, mockQueue, file, placeholder, json.RawMessage(`{"swidtag":"p1"}`))
+	assert.False(t, retried)
+	assert.Equal(t, apiworker.ErrNotRetryable, err)
+}
@@ -0,0 +1,107 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	apiworker "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/api_worker"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/helper"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListInjections lists the transactional injections of the scope, latest first
+func (d *dpsServiceServer) ListInjections(ctx context.Context, req *v1.ListInjectionsRequest) (*v1.ListInjectionsResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	dbresp, err := d.dpsRepo.ListInjections(ctx, req.Scope)
+	if err != nil {
+		logger.Log.Error("Failed to list injections", zap.Error(err), zap.String("scope", req.Scope))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	resp := &v1.ListInjectionsResponse{Injections: make([]*v1.Injection, 0, len(dbresp))}
+	for _, inj := range dbresp {
+		resp.Injections = append(resp.Injections, injectionToProto(inj))
+	}
+	return resp, nil
+}
+
+// RollbackInjection compensates the injection with the state of the inventory of the scope before it,
+// only the latest injection of the scope can be rolled back once all its jobs are done.
+// The compensation is best-effort, the created records are not deleted and are told in the response.
+func (d *dpsServiceServer) RollbackInjection(ctx context.Context, req *v1.RollbackInjectionRequest) (*v1.RollbackInjectionResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return &v1.RollbackInjectionResponse{Success: false}, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		return &v1.RollbackInjectionResponse{Success: false}, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	inj, err := d.dpsRepo.GetInjection(ctx, req.InjectionId)
+	if err == sql.ErrNoRows || (err == nil && inj.Scope != req.Scope) {
+		return &v1.RollbackInjectionResponse{Success: false}, status.Error(codes.NotFound, "InjectionNotFound")
+	} else if err != nil {
+		logger.Log.Error("Failed to get injection", zap.Error(err), zap.Int32("injection", req.InjectionId))
+		return &v1.RollbackInjectionResponse{Success: false}, status.Error(codes.Internal, "DBError")
+	}
+	if inj.Status != db.InjectionStatusCOMMITTED {
+		return &v1.RollbackInjectionResponse{Success: false}, status.Error(codes.FailedPrecondition, "InjectionNotCommitted")
+	}
+	latest, err := d.dpsRepo.GetLatestInjection(ctx, req.Scope)
+	if err != nil {
+		logger.Log.Error("Failed to get latest injection", zap.Error(err), zap.String("scope", req.Scope))
+		return &v1.RollbackInjectionResponse{Success: false}, status.Error(codes.Internal, "DBError")
+	}
+	// the previous state of an older injection may have been changed by the later ones
+	if latest.InjectionID != inj.InjectionID {
+		return &v1.RollbackInjectionResponse{Success: false}, status.Error(codes.FailedPrecondition, "InjectionNotLatest")
+	}
+	if d.isInjectionActive(ctx, req.Scope) {
+		return &v1.RollbackInjectionResponse{Success: false}, status.Error(codes.FailedPrecondition, "Injection is already running")
+	}
+	pushed, notReverted, err := apiworker.RollbackInjection(ctx, d.dpsRepo, d.queue, inj)
+	if err != nil {
+		return &v1.RollbackInjectionResponse{Success: false}, status.Error(codes.Internal, "RollbackFailed")
+	}
+	return &v1.RollbackInjectionResponse{
+		Success:          true,
+		CompensatingJobs: pushed,
+		NotReverted:      notReverted,
+	}, nil
+}
+
+func injectionToProto(inj db.Injection) *v1.Injection {
+	resp := &v1.Injection{
+		InjectionId:         inj.InjectionID,
+		Scope:               inj.Scope,
+		Status:              string(inj.Status),
+		TotalFiles:          inj.TotalFiles,
+		StagedFiles:         inj.StagedFiles,
+		TotalJobs:           inj.TotalJobs,
+		DoneJobs:            inj.DoneJobs,
+		FailedJobs:          inj.FailedJobs,
+		CreatedBy:           inj.CreatedBy,
+		Comments:            inj.Comments.String,
+		CompensatingJobs:    inj.CompensatingJobs,
+		CompensatedJobs:     inj.CompensatedJobs,
+		FailedCompensations: inj.FailedCompensations,
+	}
+	resp.CreatedOn, _ = ptypes.TimestampProto(inj.CreatedOn)
+	if inj.UpdatedOn.Valid {
+		resp.UpdatedOn, _ = ptypes.TimestampProto(inj.UpdatedOn.Time)
+	}
+	return resp
+}
@@ -0,0 +1,291 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue"
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/api/v1"
+	repo "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1"
+	dbmock "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/dbmock"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	queuemock "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/queuemock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_dpsServiceServer_ListInjections(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"scope1", "scope2", "scope3"},
+	})
+	createdOn := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+	createdOnProto, _ := ptypes.TimestampProto(createdOn)
+	var mockCtrl *gomock.Controller
+	var rep repo.Dps
+	var queue workerqueue.Queue
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		input    *v1.ListInjectionsRequest
+		setup    func()
+		output   *v1.ListInjectionsResponse
+		wantCode codes.Code
+	}{
+		{
+			name:  "SuccessCaseNoError",
+			ctx:   ctx,
+			input: &v1.ListInjectionsRequest{Scope: "scope1"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().ListInjections(ctx, "scope1").Times(1).Return([]db.Injection{
+					{
+						InjectionID: 2,
+						Scope:       "scope1",
+						Status:      db.InjectionStatusCOMMITTED,
+						TotalFiles:  2,
+						StagedFiles: 2,
+						TotalJobs:   10,
+						DoneJobs:    10,
+						CreatedBy:   "admin@superuser.com",
+						CreatedOn:   createdOn,
+					},
+				}, nil)
+			},
+			output: &v1.ListInjectionsResponse{Injections: []*v1.Injection{
+				{
+					InjectionId: 2,
+					Scope:       "scope1",
+					Status:      "COMMITTED",
+					TotalFiles:  2,
+					StagedFiles: 2,
+					TotalJobs:   10,
+					DoneJobs:    10,
+					CreatedBy:   "admin@superuser.com",
+					CreatedOn:   createdOnProto,
+				},
+			}},
+		},
+		{
+			name:  "claims not found",
+			ctx:   context.Background(),
+			input: &v1.ListInjectionsRequest{Scope: "scope1"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = dbmock.NewMockDps(mockCtrl)
+			},
+			wantCode: codes.Internal,
+		},
+		{
+			name:  "scope out of context",
+			ctx:   ctx,
+			input: &v1.ListInjectionsRequest{Scope: "scope6"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = dbmock.NewMockDps(mockCtrl)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:  "dberror",
+			ctx:   ctx,
+			input: &v1.ListInjectionsRequest{Scope: "scope1"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().ListInjections(ctx, "scope1").Times(1).Return(nil, errors.New("db error"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			obj := NewDpsServiceServer(rep, &queue, nil)
+			got, err := obj.ListInjections(tt.ctx, tt.input)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.output != nil {
+				assert.Equal(t, tt.output, got)
+			}
+		})
+	}
+}
+
+func Test_dpsServiceServer_RollbackInjection(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"scope1", "scope2", "scope3"},
+	})
+	committed := db.Injection{InjectionID: 2, Scope: "scope1", Status: db.InjectionStatusCOMMITTED, TotalFiles: 1, StagedFiles: 1, TotalJobs: 2, DoneJobs: 2}
+	var mockCtrl *gomock.Controller
+	var rep repo.Dps
+	var queue workerqueue.Workerqueue
+	tests := []struct {
+		name     string
+		input    *v1.RollbackInjectionRequest
+		setup    func()
+		output   *v1.RollbackInjectionResponse
+		wantCode codes.Code
+	}{
+		{
+			name:  "SuccessCaseNoError",
+			input: &v1.RollbackInjectionRequest{InjectionId: 2, Scope: "scope1"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				mockQueue := queuemock.NewMockWorkerqueue(mockCtrl)
+				rep = mockRepository
+				queue = mockQueue
+				gomock.InOrder(
+					mockRepository.EXPECT().GetInjection(ctx, int32(2)).Times(1).Return(committed, nil),
+					mockRepository.EXPECT().GetLatestInjection(ctx, "scope1").Times(1).Return(committed, nil),
+					mockRepository.EXPECT().GetInjectionStatus(ctx, "scope1").Times(1).Return(int64(0), nil),
+					mockRepository.EXPECT().ListInjectionJobs(ctx, int32(2)).Times(1).Return([]db.InjectionJob{
+						{InjectionID: 2, JobSeq: 1, Data: json.RawMessage(`{"Data":{"application_id":"a1","scope":"scope1"},"TargetService":"application","TargetAction":"UPSERT","TargetRPC":"APPLICATIONS","InjectionID":2}`)},
+						{InjectionID: 2, JobSeq: 2, Data: json.RawMessage(`{"Data":{"application_id":"a2","scope":"scope1"},"TargetService":"application","TargetAction":"UPSERT","TargetRPC":"APPLICATIONS","InjectionID":2}`)},
+					}, nil),
+					mockRepository.EXPECT().ListInjectionSnapshots(ctx, int32(2)).Times(1).Return([]db.InjectionSnapshot{
+						{InjectionID: 2, Entity: "APPLICATIONS", RecordKey: "a1", Existed: true, Before: json.RawMessage(`{"application_id":"a1","name":"old","scope":"scope1"}`)},
+						{InjectionID: 2, Entity: "APPLICATIONS", RecordKey: "a2", Existed: false, Before: json.RawMessage(`{}`)},
+						{InjectionID: 2, Entity: "PRODUCTS", RecordKey: "p1", Existed: false, Before: json.RawMessage(`{}`)},
+					}, nil),
+				)
+				mockRepository.EXPECT().StartInjectionCompensation(ctx, db.StartInjectionCompensationParams{
+					InjectionID:      2,
+					CompensatingJobs: 2,
+					Comments:         sql.NullString{String: "created records not deleted: 1 PRODUCTS", Valid: true},
+				}).Times(1).Return(nil)
+				mockRepository.EXPECT().DeleteSyncedRecords(ctx, "scope1").Times(1).Return(nil)
+				mockQueue.EXPECT().PushJob(ctx, gomock.Any(), "API_WORKER").Times(2).Return(int32(1), nil)
+			},
+			output: &v1.RollbackInjectionResponse{
+				Success:          true,
+				CompensatingJobs: 2,
+				NotReverted:      map[string]int32{"PRODUCTS": 1},
+			},
+		},
+		{
+			name:  "nothing to compensate",
+			input: &v1.RollbackInjectionRequest{InjectionId: 2, Scope: "scope1"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetInjection(ctx, int32(2)).Times(1).Return(committed, nil)
+				mockRepository.EXPECT().GetLatestInjection(ctx, "scope1").Times(1).Return(committed, nil)
+				mockRepository.EXPECT().GetInjectionStatus(ctx, "scope1").Times(1).Return(int64(0), nil)
+				mockRepository.EXPECT().ListInjectionJobs(ctx, int32(2)).Times(1).Return([]db.InjectionJob{}, nil)
+				mockRepository.EXPECT().ListInjectionSnapshots(ctx, int32(2)).Times(1).Return([]db.InjectionSnapshot{
+					{InjectionID: 2, Entity: "PRODUCTS", RecordKey: "p1", Existed: false, Before: json.RawMessage(`{}`)},
+				}, nil)
+				gomock.InOrder(
+					mockRepository.EXPECT().StartInjectionCompensation(ctx, db.StartInjectionCompensationParams{
+						InjectionID: 2,
+						Comments:    sql.NullString{String: "created records not deleted: 1 PRODUCTS", Valid: true},
+					}).Times(1).Return(nil),
+					mockRepository.EXPECT().DeleteSyncedRecords(ctx, "scope1").Times(1).Return(nil),
+					mockRepository.EXPECT().FinishInjectionCompensation(ctx, int32(2)).Times(1).Return(nil),
+				)
+			},
+			output: &v1.RollbackInjectionResponse{
+				Success:     true,
+				NotReverted: map[string]int32{"PRODUCTS": 1},
+			},
+		},
+		{
+			name:  "injection of another scope",
+			input: &v1.RollbackInjectionRequest{InjectionId: 2, Scope: "scope2"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetInjection(ctx, int32(2)).Times(1).Return(committed, nil)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:  "injection not found",
+			input: &v1.RollbackInjectionRequest{InjectionId: 3, Scope: "scope1"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetInjection(ctx, int32(3)).Times(1).Return(db.Injection{}, sql.ErrNoRows)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:  "injection not committed",
+			input: &v1.RollbackInjectionRequest{InjectionId: 2, Scope: "scope1"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetInjection(ctx, int32(2)).Times(1).Return(db.Injection{InjectionID: 2, Scope: "scope1", Status: db.InjectionStatusAPPLYING}, nil)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:  "injection not latest",
+			input: &v1.RollbackInjectionRequest{InjectionId: 2, Scope: "scope1"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetInjection(ctx, int32(2)).Times(1).Return(committed, nil)
+				mockRepository.EXPECT().GetLatestInjection(ctx, "scope1").Times(1).Return(db.Injection{InjectionID: 3, Scope: "scope1"}, nil)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:  "injection running",
+			input: &v1.RollbackInjectionRequest{InjectionId: 2, Scope: "scope1"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetInjection(ctx, int32(2)).Times(1).Return(committed, nil)
+				mockRepository.EXPECT().GetLatestInjection(ctx, "scope1").Times(1).Return(committed, nil)
+				mockRepository.EXPECT().GetInjectionStatus(ctx, "scope1").Times(1).Return(int64(1), nil)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:  "scope out of context",
+			input: &v1.RollbackInjectionRequest{InjectionId: 2, Scope: "scope6"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = dbmock.NewMockDps(mockCtrl)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			obj := NewDpsServiceServer(rep, queue, nil)
+			got, err := obj.RollbackInjection(ctx, tt.input)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.output != nil {
+				assert.Equal(t, tt.output, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/constants"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/models"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/helper"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateMappingProfile creates a named mapping profile in the scope
+func (d *dpsServiceServer) CreateMappingProfile(ctx context.Context, req *v1.MappingProfile) (*v1.MappingProfile, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	mappings, err := fileMappingsFromProto(req.Files)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := d.dpsRepo.GetMappingProfile(ctx, db.GetMappingProfileParams{Scope: req.Scope, Name: req.Name}); err == nil {
+		return nil, status.Error(codes.AlreadyExists, "MappingProfileExists")
+	} else if err != sql.ErrNoRows {
+		logger.Log.Error("Failed to get mapping profile", zap.Error(err), zap.String("name", req.Name))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	if err := d.dpsRepo.InsertMappingProfile(ctx, db.InsertMappingProfileParams{
+		Scope:     req.Scope,
+		Name:      req.Name,
+		Mappings:  mappings,
+		CreatedBy: userClaims.UserID,
+	}); err != nil {
+		logger.Log.Error("Failed to insert mapping profile", zap.Error(err), zap.String("name", req.Name))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	return d.getMappingProfile(ctx, req.Scope, req.Name)
+}
+
+// UpdateMappingProfile replaces the file mappings of an existing profile
+func (d *dpsServiceServer) UpdateMappingProfile(ctx context.Context, req *v1.MappingProfile) (*v1.MappingProfile, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	mappings, err := fileMappingsFromProto(req.Files)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := d.getMappingProfile(ctx, req.Scope, req.Name); err != nil {
+		return nil, err
+	}
+	if err := d.dpsRepo.UpdateMappingProfile(ctx, db.UpdateMappingProfileParams{
+		Scope:     req.Scope,
+		Name:      req.Name,
+		Mappings:  mappings,
+		UpdatedBy: sql.NullString{String: userClaims.UserID, Valid: true},
+	}); err != nil {
+		logger.Log.Error("Failed to update mapping profile", zap.Error(err), zap.String("name", req.Name))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	return d.getMappingProfile(ctx, req.Scope, req.Name)
+}
+
+// ListMappingProfiles lists the mapping profiles of the scope
+func (d *dpsServiceServer) ListMappingProfiles(ctx context.Context, req *v1.ListMappingProfilesRequest) (*v1.ListMappingProfilesResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	dbresp, err := d.dpsRepo.ListMappingProfiles(ctx, req.Scope)
+	if err != nil {
+		logger.Log.Error("Failed to list mapping profiles", zap.Error(err), zap.String("scope", req.Scope))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	resp := &v1.ListMappingProfilesResponse{Profiles: make([]*v1.MappingProfile, 0, len(dbresp))}
+	for _, p := range dbresp {
+		profile, err := mappingProfileToProto(p)
+		if err != nil {
+			return nil, err
+		}
+		resp.Profiles = append(resp.Profiles, profile)
+	}
+	return resp, nil
+}
+
+// DeleteMappingProfile deletes a mapping profile of the scope
+func (d *dpsServiceServer) DeleteMappingProfile(ctx context.Context, req *v1.DeleteMappingProfileRequest) (*v1.DeleteMappingProfileResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return &v1.DeleteMappingProfileResponse{Success: false}, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		return &v1.DeleteMappingProfileResponse{Success: false}, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	if err := d.dpsRepo.DeleteMappingProfile(ctx, db.DeleteMappingProfileParams{Scope: req.Scope, Name: req.Name}); err != nil {
+		logger.Log.Error("Failed to delete mapping profile", zap.Error(err), zap.String("name", req.Name))
+		return &v1.DeleteMappingProfileResponse{Success: false}, status.Error(codes.Internal, "DBError")
+	}
+	return &v1.DeleteMappingProfileResponse{Success: true}, nil
+}
+
+func (d *dpsServiceServer) getMappingProfile(ctx context.Context, scope, name string) (*v1.MappingProfile, error) {
+	dbresp, err := d.dpsRepo.GetMappingProfile(ctx, db.GetMappingProfileParams{Scope: scope, Name: name})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "MappingProfileNotFound")
+		}
+		logger.Log.Error("Failed to get mapping profile", zap.Error(err), zap.String("name", name))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	return mappingProfileToProto(dbresp)
+}
+
+// fileMappingsFromProto validates the file mappings and marshals them as stored in the profile
+func fileMappingsFromProto(files []*v1.FileMapping) (json.RawMessage, error) {
+	mappings := make([]models.FileMapping, 0, len(files))
+	for _, f := range files {
+		fileType := strings.ToUpper(f.FileType)
+		for _, m := range mappings {
+			if m.FileType == fileType {
+				return nil, status.Errorf(codes.InvalidArgument, "file type %s is mapped twice", f.FileType)
+			}
+		}
+		mapping := models.FileMapping{FileType: fileType, Delimiter: f.Delimiter}
+		fields := make(map[string]bool)
+		for _, field := range f.Fields {
+			name := strings.ToLower(strings.TrimSpace(field.Field))
+			if fields[name] {
+				return nil, status.Errorf(codes.InvalidArgument, "field %s is mapped twice", field.Field)
+			}
+			fields[name] = true
+			if err := validateFieldMapping(field); err != nil {
+				return nil, err
+			}
+			mapping.Fields = append(mapping.Fields, models.FieldMapping{
+				Field:              name,
+				Operation:          field.Operation.String(),
+				Sources:            field.Sources,
+				Value:              field.Value,
+				Separator:          field.Separator,
+				Index:              int(field.Index),
+				DefaultValue:       field.DefaultValue,
+				DateFormat:         field.DateFormat,
+				DecimalSeparator:   field.DecimalSeparator,
+				ThousandsSeparator: field.ThousandsSeparator,
+			})
+		}
+		mappings = append(mappings, mapping)
+	}
+	data, err := json.Marshal(mappings)
+	if err != nil {
+		logger.Log.Error("Failed to marshal mapping profile", zap.Error(err))
+		return nil, status.Error(codes.Internal, "MarshalError")
+	}
+	return data, nil
+}
+
+func validateFieldMapping(field *v1.FieldMapping) error {
+	for _, val := range []string{field.Field, field.Value, field.Separator, field.DefaultValue} {
+		if strings.Contains(val, constants.DELIMETER) {
+			return status.Errorf(codes.InvalidArgument, "field %s cannot contain %s", field.Field, constants.DELIMETER)
+		}
+	}
+	switch field.Operation {
+	case v1.FieldMapping_COPY:
+		if len(field.Sources) != 1 {
+			return status.Errorf(codes.InvalidArgument, "field %s needs one source column to copy", field.Field)
+		}
+	case v1.FieldMapping_CONSTANT:
+		if len(field.Sources) != 0 || field.Value == "" {
+			return status.Errorf(codes.InvalidArgument, "field %s needs a value and no source column", field.Field)
+		}
+	case v1.FieldMapping_CONCAT:
+		if len(field.Sources) < 2 {
+			return status.Errorf(codes.InvalidArgument, "field %s needs at least two source columns to concatenate", field.Field)
+		}
+	case v1.FieldMapping_SPLIT:
+		if len(field.Sources) != 1 || field.Separator == "" {
+			return status.Errorf(codes.InvalidArgument, "field %s needs one source column and a separator to split", field.Field)
+		}
+	}
+	if field.DateFormat != "" {
+		// a layout without any date element is formatted as itself
+		ref := time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC).Format(field.DateFormat)
+		if _, err := time.Parse(field.DateFormat, ref); err != nil || ref == field.DateFormat {
+			return status.Errorf(codes.InvalidArgument, "field %s has an invalid date format", field.Field)
+		}
+	}
+	if field.DecimalSeparator != "" && field.DecimalSeparator == field.ThousandsSeparator {
+		return status.Errorf(codes.InvalidArgument, "field %s has the same decimal and thousands separators", field.Field)
+	}
+	return nil
+}
+
+func mappingProfileToProto(p db.MappingProfile) (*v1.MappingProfile, error) {
+	var mappings []models.FileMapping
+	if err := json.Unmarshal(p.Mappings, &mappings); err != nil {
+		logger.Log.Error("Failed to unmarshal mapping profile", zap.Error(err), zap.String("name", p.Name))
+		return nil, status.Error(codes.Internal, "UnmarshalError")
+	}
+	profile := &v1.MappingProfile{
+		Name:      p.Name,
+		Scope:     p.Scope,
+		CreatedBy: p.CreatedBy,
+		UpdatedBy: p.UpdatedBy.String,
+	}
+	profile.CreatedOn, _ = ptypes.TimestampProto(p.CreatedOn)
+	if p.UpdatedOn.Valid {
+		profile.UpdatedOn, _ = ptypes.TimestampProto(p.UpdatedOn.Time)
+	}
+	for _, m := range mappings {
+		file := &v1.FileMapping{FileType: strings.ToLower(m.FileType), Delimiter: m.Delimiter}
+		for _, f := range m.Fields {
+			file.Fields = append(file.Fields, &v1.FieldMapping{
+				Field:              f.Field,
+				Operation:          v1.FieldMapping_Operation(v1.FieldMapping_Operation_value[f.Operation]),
+				Sources:            f.Sources,
+				Value:              f.Value,
+				Separator:          f.Separator,
+				Index:              int32(f.Index),
+				DefaultValue:       f.DefaultValue,
+				DateFormat:         f.DateFormat,
+				DecimalSeparator:   f.DecimalSeparator,
+				ThousandsSeparator: f.ThousandsSeparator,
+			})
+		}
+		profile.Files = append(profile.Files, file)
+	}
+	return profile, nil
+}
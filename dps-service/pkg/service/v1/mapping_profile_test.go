@@ -0,0 +1,363 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue"
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/api/v1"
+	repo "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1"
+	dbmock "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/dbmock"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testMappingProfile = &v1.MappingProfile{
+	Name:  "sccm",
+	Scope: "scope1",
+	Files: []*v1.FileMapping{
+		{
+			FileType:  "products",
+			Delimiter: ",",
+			Fields: []*v1.FieldMapping{
+				{Field: "swidtag", Sources: []string{"Product Code"}},
+				{Field: "name", Operation: v1.FieldMapping_CONCAT, Sources: []string{"Publisher", "Product"}, Separator: " "},
+				{Field: "flag", Operation: v1.FieldMapping_CONSTANT, Value: "1"},
+			},
+		},
+	},
+}
+
+const testMappings = `[{"file_type":"PRODUCTS","delimiter":",","fields":[{"field":"swidtag","operation":"COPY","sources":["Product Code"]},{"field":"name","operation":"CONCAT","sources":["Publisher","Product"],"separator":" "},{"field":"flag","operation":"CONSTANT","value":"1"}]}]`
+
+func Test_dpsServiceServer_CreateMappingProfile(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"scope1", "scope2", "scope3"},
+	})
+	createdOn := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+	createdOnProto, _ := ptypes.TimestampProto(createdOn)
+	var mockCtrl *gomock.Controller
+	var rep repo.Dps
+	var queue workerqueue.Queue
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		input    *v1.MappingProfile
+		setup    func()
+		output   *v1.MappingProfile
+		wantCode codes.Code
+	}{
+		{
+			name:  "SuccessCaseNoError",
+			ctx:   ctx,
+			input: testMappingProfile,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				gomock.InOrder(
+					mockRepository.EXPECT().GetMappingProfile(ctx, db.GetMappingProfileParams{Scope: "scope1", Name: "sccm"}).Times(1).Return(db.MappingProfile{}, sql.ErrNoRows),
+					mockRepository.EXPECT().InsertMappingProfile(ctx, db.InsertMappingProfileParams{
+						Scope:     "scope1",
+						Name:      "sccm",
+						Mappings:  json.RawMessage(testMappings),
+						CreatedBy: "admin@superuser.com",
+					}).Times(1).Return(nil),
+					mockRepository.EXPECT().GetMappingProfile(ctx, db.GetMappingProfileParams{Scope: "scope1", Name: "sccm"}).Times(1).Return(db.MappingProfile{
+						Scope:     "scope1",
+						Name:      "sccm",
+						Mappings:  json.RawMessage(testMappings),
+						CreatedBy: "admin@superuser.com",
+						CreatedOn: createdOn,
+					}, nil),
+				)
+			},
+			output: &v1.MappingProfile{
+				Name:      "sccm",
+				Scope:     "scope1",
+				Files:     testMappingProfile.Files,
+				CreatedBy: "admin@superuser.com",
+				CreatedOn: createdOnProto,
+			},
+		},
+		{
+			name:  "claims not found",
+			ctx:   context.Background(),
+			input: testMappingProfile,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = dbmock.NewMockDps(mockCtrl)
+			},
+			wantCode: codes.Internal,
+		},
+		{
+			name:  "scope out of context",
+			ctx:   ctx,
+			input: &v1.MappingProfile{Name: "sccm", Scope: "scope6", Files: testMappingProfile.Files},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = dbmock.NewMockDps(mockCtrl)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name: "copy without source",
+			ctx:  ctx,
+			input: &v1.MappingProfile{Name: "sccm", Scope: "scope1", Files: []*v1.FileMapping{
+				{FileType: "products", Fields: []*v1.FieldMapping{{Field: "swidtag"}}},
+			}},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = dbmock.NewMockDps(mockCtrl)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "invalid date format",
+			ctx:  ctx,
+			input: &v1.MappingProfile{Name: "sccm", Scope: "scope1", Files: []*v1.FileMapping{
+				{FileType: "products_acquiredrights", Fields: []*v1.FieldMapping{{Field: "maintenance_start", Sources: []string{"start"}, DateFormat: "dd/mm/yyyy"}}},
+			}},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = dbmock.NewMockDps(mockCtrl)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "file type mapped twice",
+			ctx:  ctx,
+			input: &v1.MappingProfile{Name: "sccm", Scope: "scope1", Files: []*v1.FileMapping{
+				testMappingProfile.Files[0],
+				testMappingProfile.Files[0],
+			}},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = dbmock.NewMockDps(mockCtrl)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:  "profile already exists",
+			ctx:   ctx,
+			input: testMappingProfile,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetMappingProfile(ctx, gomock.Any()).Times(1).Return(db.MappingProfile{Scope: "scope1", Name: "sccm"}, nil)
+			},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:  "dberror",
+			ctx:   ctx,
+			input: testMappingProfile,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetMappingProfile(ctx, gomock.Any()).Times(1).Return(db.MappingProfile{}, sql.ErrNoRows)
+				mockRepository.EXPECT().InsertMappingProfile(ctx, gomock.Any()).Times(1).Return(errors.New("db error"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			obj := NewDpsServiceServer(rep, &queue, nil)
+			got, err := obj.CreateMappingProfile(tt.ctx, tt.input)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.output != nil {
+				assert.Equal(t, tt.output, got)
+			}
+		})
+	}
+}
+
+func Test_dpsServiceServer_UpdateMappingProfile(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"scope1", "scope2", "scope3"},
+	})
+	var mockCtrl *gomock.Controller
+	var rep repo.Dps
+	var queue workerqueue.Queue
+	tests := []struct {
+		name     string
+		setup    func()
+		wantCode codes.Code
+	}{
+		{
+			name: "SuccessCaseNoError",
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				profile := db.MappingProfile{Scope: "scope1", Name: "sccm", Mappings: json.RawMessage(testMappings)}
+				gomock.InOrder(
+					mockRepository.EXPECT().GetMappingProfile(ctx, db.GetMappingProfileParams{Scope: "scope1", Name: "sccm"}).Times(1).Return(profile, nil),
+					mockRepository.EXPECT().UpdateMappingProfile(ctx, db.UpdateMappingProfileParams{
+						Scope:     "scope1",
+						Name:      "sccm",
+						Mappings:  json.RawMessage(testMappings),
+						UpdatedBy: sql.NullString{String: "admin@superuser.com", Valid: true},
+					}).Times(1).Return(nil),
+					mockRepository.EXPECT().GetMappingProfile(ctx, db.GetMappingProfileParams{Scope: "scope1", Name: "sccm"}).Times(1).Return(profile, nil),
+				)
+			},
+		},
+		{
+			name: "profile not found",
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetMappingProfile(ctx, gomock.Any()).Times(1).Return(db.MappingProfile{}, sql.ErrNoRows)
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			obj := NewDpsServiceServer(rep, &queue, nil)
+			_, err := obj.UpdateMappingProfile(ctx, testMappingProfile)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func Test_dpsServiceServer_ListMappingProfiles(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"scope1", "scope2", "scope3"},
+	})
+	var mockCtrl *gomock.Controller
+	var rep repo.Dps
+	var queue workerqueue.Queue
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		input    *v1.ListMappingProfilesRequest
+		setup    func()
+		output   int
+		wantCode codes.Code
+	}{
+		{
+			name:  "SuccessCaseNoError",
+			ctx:   ctx,
+			input: &v1.ListMappingProfilesRequest{Scope: "scope1"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().ListMappingProfiles(ctx, "scope1").Times(1).Return([]db.MappingProfile{
+					{Scope: "scope1", Name: "sccm", Mappings: json.RawMessage(testMappings)},
+					{Scope: "scope1", Name: "empty", Mappings: json.RawMessage(`[]`)},
+				}, nil)
+			},
+			output: 2,
+		},
+		{
+			name:  "scope out of context",
+			ctx:   ctx,
+			input: &v1.ListMappingProfilesRequest{Scope: "scope6"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = dbmock.NewMockDps(mockCtrl)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:  "dberror",
+			ctx:   ctx,
+			input: &v1.ListMappingProfilesRequest{Scope: "scope1"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().ListMappingProfiles(ctx, "scope1").Times(1).Return(nil, errors.New("db error"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			obj := NewDpsServiceServer(rep, &queue, nil)
+			got, err := obj.ListMappingProfiles(tt.ctx, tt.input)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if err == nil {
+				assert.Len(t, got.Profiles, tt.output)
+				assert.Equal(t, testMappingProfile.Files, got.Profiles[0].Files)
+			}
+		})
+	}
+}
+
+func Test_dpsServiceServer_DeleteMappingProfile(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"scope1", "scope2", "scope3"},
+	})
+	var mockCtrl *gomock.Controller
+	var rep repo.Dps
+	var queue workerqueue.Queue
+	tests := []struct {
+		name     string
+		input    *v1.DeleteMappingProfileRequest
+		setup    func()
+		wantCode codes.Code
+	}{
+		{
+			name:  "SuccessCaseNoError",
+			input: &v1.DeleteMappingProfileRequest{Scope: "scope1", Name: "sccm"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().DeleteMappingProfile(ctx, db.DeleteMappingProfileParams{Scope: "scope1", Name: "sccm"}).Times(1).Return(nil)
+			},
+		},
+		{
+			name:  "scope out of context",
+			input: &v1.DeleteMappingProfileRequest{Scope: "scope6", Name: "sccm"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = dbmock.NewMockDps(mockCtrl)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			obj := NewDpsServiceServer(rep, &queue, nil)
+			got, err := obj.DeleteMappingProfile(ctx, tt.input)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, err == nil, got.Success)
+		})
+	}
+}
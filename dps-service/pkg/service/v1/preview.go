@@ -0,0 +1,608 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	appV1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/thirdparty/application-service/pkg/api/v1"
+
+	prodV1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/thirdparty/product-service/pkg/api/v1"
+
+	equipV1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/thirdparty/equipment-service/pkg/api/v1"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/constants"
+	fileworker "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/file_worker"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/models"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/helper"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	previewPageSize int32 = 200
+	// page_num of the list APIs is lower than 1000
+	previewMaxPages int32 = 999
+	// equipmentEntity is the referenced entity of the equipment ids of the link files, their type is not known
+	equipmentEntity string = "equipment"
+)
+
+// errTooManyRecords is returned rather than a preview missing the records past the pages the services let list
+var errTooManyRecords = fmt.Errorf("more than %d records to preview", previewPageSize*previewMaxPages)
+
+// PreviewUpload parses and validates the files as NotifyUpload would and reports what their injection would change.
+// Nothing is written, the existing records are only read from the product, application and equipment services.
+func (d *dpsServiceServer) PreviewUpload(ctx context.Context, req *v1.PreviewUploadRequest) (*v1.PreviewUploadResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "ClaimsNotFoundError")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	var mappings []models.FileMapping
+	if req.GetMappingProfile() != "" {
+		dbresp, err := d.dpsRepo.GetMappingProfile(ctx, db.GetMappingProfileParams{Scope: req.Scope, Name: req.GetMappingProfile()})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, status.Error(codes.InvalidArgument, "MappingProfileNotFound")
+			}
+			logger.Log.Error("Failed to get mapping profile", zap.Error(err), zap.String("profile", req.GetMappingProfile()))
+			return nil, status.Error(codes.Internal, "DBError")
+		}
+		if err := json.Unmarshal(dbresp.Mappings, &mappings); err != nil {
+			logger.Log.Error("Failed to unmarshal mapping profile", zap.Error(err), zap.String("profile", req.GetMappingProfile()))
+			return nil, status.Error(codes.Internal, "BadMappingProfile")
+		}
+	}
+
+	p := &uploadPreview{d: d, scope: req.Scope, impacts: make(map[string]*v1.EntityImpact)}
+	resp := &v1.PreviewUploadResponse{}
+	var files []previewFile
+	for _, file := range req.GetFiles() {
+		if strings.TrimSpace(file) == "" {
+			continue
+		}
+		if strings.ContainsAny(file, `/\`) {
+			return nil, status.Error(codes.InvalidArgument, "InvalidFileName")
+		}
+		name := file
+		if temp := strings.Split(file, constants.NifiFileDelimeter); len(temp) == 3 {
+			name = temp[2]
+		}
+		data, err := fileworker.ReadFile(req.Scope, file, mappings)
+		preview := &v1.FilePreview{
+			FileName:       name,
+			FileType:       strings.ToLower(data.FileType),
+			TotalRecords:   data.TotalCount,
+			InvalidRecords: data.InvalidCount,
+			Duplicates:     duplicatesToProto(data.DuplicateRecords),
+		}
+		for _, row := range data.InvalidDataRowNum {
+			preview.InvalidRows = append(preview.InvalidRows, int32(row))
+		}
+		resp.Files = append(resp.Files, preview)
+		if err != nil {
+			preview.FailureReason = data.FileFailureReason
+			if preview.FailureReason == "" {
+				preview.FailureReason = constants.BadFile
+			}
+			continue
+		}
+		files = append(files, previewFile{preview: preview, data: data})
+	}
+
+	if err := p.addFileRecords(ctx, files); err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		if err := p.addImpacts(ctx, f); err != nil {
+			return nil, err
+		}
+	}
+	for _, impact := range p.impacts {
+		resp.Impacts = append(resp.Impacts, impact)
+	}
+	sort.Slice(resp.Impacts, func(i, j int) bool {
+		return resp.Impacts[i].Entity < resp.Impacts[j].Entity
+	})
+	sort.SliceStable(p.dangling, func(i, j int) bool {
+		a, b := p.dangling[i], p.dangling[j]
+		if a.FileName != b.FileName {
+			return a.FileName < b.FileName
+		}
+		if a.Id != b.Id {
+			return a.Id < b.Id
+		}
+		return a.ReferencedId < b.ReferencedId
+	})
+	resp.DanglingReferences = p.dangling
+	return resp, nil
+}
+
+type previewFile struct {
+	preview *v1.FilePreview
+	data    models.FileData
+}
+
+// uploadPreview holds the records known before and after the upload.
+// The existing records are fetched once, when a file needs them.
+type uploadPreview struct {
+	d     *dpsServiceServer
+	scope string
+
+	products     map[string]bool
+	applications map[string]bool
+	acqRights    map[string]bool
+	eqTypes      map[string]*equipV1.EquipmentType
+	equipments   map[string]map[string]bool
+
+	// records of the files, applications deleted by the upload are false
+	fileProducts     map[string]bool
+	fileApplications map[string]bool
+	fileEquipments   map[string]map[string]bool
+
+	impacts  map[string]*v1.EntityImpact
+	dangling []*v1.DanglingReference
+}
+
+// addFileRecords registers the records created or deleted by the files so that the links between files are resolved
+func (p *uploadPreview) addFileRecords(ctx context.Context, files []previewFile) error {
+	p.fileProducts = make(map[string]bool)
+	p.fileApplications = make(map[string]bool)
+	p.fileEquipments = make(map[string]map[string]bool)
+	for _, f := range files {
+		for swidTag := range f.data.Products {
+			p.fileProducts[swidTag] = true
+		}
+		for id, app := range f.data.Applications {
+			p.fileApplications[id] = app.Action == constants.UPSERT
+		}
+		for eqType, records := range f.data.Equipments {
+			eqTypes, err := p.equipmentTypes(ctx)
+			if err != nil {
+				return err
+			}
+			t, ok := eqTypes[strings.ToLower(eqType)]
+			if !ok {
+				f.preview.FailureReason = "EquipmentTypeNotFound"
+				continue
+			}
+			ids := p.fileEquipments[t.Type]
+			if ids == nil {
+				ids = make(map[string]bool)
+				p.fileEquipments[t.Type] = ids
+			}
+			for _, rec := range records {
+				if id := equipmentAttributeValue(rec, t, func(a *equipV1.Attribute) bool { return a.PrimaryKey }); id != "" {
+					ids[id] = true
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// addImpacts counts the records written by the file and reports its references to unknown records
+func (p *uploadPreview) addImpacts(ctx context.Context, f previewFile) error {
+	if f.preview.FailureReason != "" {
+		return nil
+	}
+	data := f.data
+	entity := strings.ToLower(data.FileType)
+	switch data.FileType {
+	case constants.PRODUCTS:
+		existing, err := p.existingProducts(ctx)
+		if err != nil {
+			return err
+		}
+		// products are always upserted, whatever their flag
+		for swidTag := range data.Products {
+			p.addUpsert(entity, existing[swidTag])
+		}
+	case constants.APPLICATIONS:
+		existing, err := p.existingApplications(ctx)
+		if err != nil {
+			return err
+		}
+		for id, app := range data.Applications {
+			if app.Action == constants.UPSERT {
+				p.addUpsert(entity, existing[id])
+			} else {
+				p.addDelete(entity, existing[id])
+			}
+		}
+	case constants.ProductsAcquiredRights:
+		existing, err := p.existingAcqRights(ctx)
+		if err != nil {
+			return err
+		}
+		for sku := range data.AcqRights {
+			p.addUpsert(entity, existing[sku])
+		}
+	case constants.ApplicationsProducts:
+		for action, links := range data.AppProducts {
+			for swidTag, appIDs := range links {
+				for _, appID := range appIDs {
+					if err := p.addLink(ctx, f.preview.FileName, entity, action,
+						linkEnd{constants.PRODUCTS, swidTag}, linkEnd{constants.APPLICATIONS, appID}); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	case constants.ProductsEquipments:
+		for action, links := range data.ProdEquipments {
+			for swidTag, equipments := range links {
+				for _, equip := range equipments {
+					if err := p.addLink(ctx, f.preview.FileName, entity, action,
+						linkEnd{constants.PRODUCTS, swidTag}, linkEnd{equipmentEntity, equip.EquipID}); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	case constants.ApplicationEquipments:
+		for action, links := range data.EquipApplications {
+			for appID, equipIDs := range links {
+				for _, equipID := range equipIDs {
+					if err := p.addLink(ctx, f.preview.FileName, entity, action,
+						linkEnd{constants.APPLICATIONS, appID}, linkEnd{equipmentEntity, equipID}); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	default:
+		for eqType, records := range data.Equipments {
+			if err := p.addEquipments(ctx, f.preview.FileName, eqType, records); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (p *uploadPreview) addEquipments(ctx context.Context, fileName, eqType string, records []map[string]interface{}) error {
+	eqTypes, err := p.equipmentTypes(ctx)
+	if err != nil {
+		return err
+	}
+	t := eqTypes[strings.ToLower(eqType)]
+	existing, err := p.existingEquipments(ctx, t)
+	if err != nil {
+		return err
+	}
+	entity := "equipment_" + t.Type
+	isPrimaryKey := func(a *equipV1.Attribute) bool { return a.PrimaryKey }
+	isParent := func(a *equipV1.Attribute) bool { return a.ParentIdentifier }
+	for _, rec := range records {
+		id := equipmentAttributeValue(rec, t, isPrimaryKey)
+		if id == "" {
+			continue
+		}
+		p.addUpsert(entity, existing[id])
+		parentID := equipmentAttributeValue(rec, t, isParent)
+		if parentID == "" || t.ParentType == "" {
+			continue
+		}
+		parent, ok := eqTypes[strings.ToLower(t.ParentType)]
+		if !ok {
+			continue
+		}
+		known, err := p.existingEquipments(ctx, parent)
+		if err != nil {
+			return err
+		}
+		if !known[parentID] && !p.fileEquipments[parent.Type][parentID] {
+			p.dangling = append(p.dangling, &v1.DanglingReference{
+				FileName:         fileName,
+				Entity:           entity,
+				Id:               id,
+				ReferencedEntity: "equipment_" + parent.Type,
+				ReferencedId:     parentID,
+			})
+		}
+	}
+	return nil
+}
+
+type linkEnd struct {
+	entity string
+	id     string
+}
+
+// addLink counts a link between two records, links which are upserted must reference known records
+func (p *uploadPreview) addLink(ctx context.Context, fileName, entity, action string, a, b linkEnd) error {
+	impact := p.impact(entity)
+	if action != constants.UPSERT {
+		impact.Deletes++
+		return nil
+	}
+	impact.Inserts++
+	for _, ends := range [][2]linkEnd{{a, b}, {b, a}} {
+		known, err := p.isKnown(ctx, ends[0])
+		if err != nil {
+			return err
+		}
+		if known {
+			continue
+		}
+		p.dangling = append(p.dangling, &v1.DanglingReference{
+			FileName:         fileName,
+			Entity:           entity,
+			Id:               ends[1].id,
+			ReferencedEntity: strings.ToLower(ends[0].entity),
+			ReferencedId:     ends[0].id,
+		})
+	}
+	return nil
+}
+
+// isKnown tells if the record exists after the upload
+func (p *uploadPreview) isKnown(ctx context.Context, end linkEnd) (bool, error) {
+	switch end.entity {
+	case constants.PRODUCTS:
+		existing, err := p.existingProducts(ctx)
+		return existing[end.id] || p.fileProducts[end.id], err
+	case constants.APPLICATIONS:
+		existing, err := p.existingApplications(ctx)
+		if upserted, ok := p.fileApplications[end.id]; ok {
+			return upserted, err
+		}
+		return existing[end.id], err
+	}
+	eqTypes, err := p.equipmentTypes(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, t := range eqTypes {
+		existing, err := p.existingEquipments(ctx, t)
+		if err != nil {
+			return false, err
+		}
+		if existing[end.id] || p.fileEquipments[t.Type][end.id] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (p *uploadPreview) impact(entity string) *v1.EntityImpact {
+	impact, ok := p.impacts[entity]
+	if !ok {
+		impact = &v1.EntityImpact{Entity: entity}
+		p.impacts[entity] = impact
+	}
+	return impact
+}
+
+func (p *uploadPreview) addUpsert(entity string, exists bool) {
+	if exists {
+		p.impact(entity).Updates++
+	} else {
+		p.impact(entity).Inserts++
+	}
+}
+
+func (p *uploadPreview) addDelete(entity string, exists bool) {
+	if exists {
+		p.impact(entity).Deletes++
+	} else {
+		p.impact(entity).Missing++
+	}
+}
+
+func (p *uploadPreview) existingProducts(ctx context.Context) (map[string]bool, error) {
+	if p.products != nil {
+		return p.products, nil
+	}
+	ids := make(map[string]bool)
+	err := listPages(func(page int32) (int32, error) {
+		resp, err := p.d.product.ListProducts(ctx, &prodV1.ListProductsRequest{
+			PageNum:  page,
+			PageSize: previewPageSize,
+			SortBy:   "swidtag",
+			Scopes:   []string{p.scope},
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, prod := range resp.Products {
+			ids[prod.SwidTag] = true
+		}
+		return resp.TotalRecords, nil
+	})
+	if err != nil {
+		logger.Log.Error("Failed to list products", zap.Error(err), zap.String("scope", p.scope))
+		return nil, listError(err, "unable to get products")
+	}
+	p.products = ids
+	return ids, nil
+}
+
+func (p *uploadPreview) existingApplications(ctx context.Context) (map[string]bool, error) {
+	if p.applications != nil {
+		return p.applications, nil
+	}
+	ids := make(map[string]bool)
+	err := listPages(func(page int32) (int32, error) {
+		resp, err := p.d.application.ListApplications(ctx, &appV1.ListApplicationsRequest{
+			PageNum:  page,
+			PageSize: previewPageSize,
+			SortBy:   appV1.ListApplicationsRequest_name,
+			Scopes:   []string{p.scope},
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, app := range resp.Applications {
+			ids[app.ApplicationId] = true
+		}
+		return resp.TotalRecords, nil
+	})
+	if err != nil {
+		logger.Log.Error("Failed to list applications", zap.Error(err), zap.String("scope", p.scope))
+		return nil, listError(err, "unable to get applications")
+	}
+	p.applications = ids
+	return ids, nil
+}
+
+func (p *uploadPreview) existingAcqRights(ctx context.Context) (map[string]bool, error) {
+	if p.acqRights != nil {
+		return p.acqRights, nil
+	}
+	skus := make(map[string]bool)
+	err := listPages(func(page int32) (int32, error) {
+		resp, err := p.d.product.ListAcqRights(ctx, &prodV1.ListAcqRightsRequest{
+			PageNum:  page,
+			PageSize: previewPageSize,
+			Scopes:   []string{p.scope},
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, acq := range resp.AcquiredRights {
+			skus[acq.SKU] = true
+		}
+		return resp.TotalRecords, nil
+	})
+	if err != nil {
+		logger.Log.Error("Failed to list acquired rights", zap.Error(err), zap.String("scope", p.scope))
+		return nil, listError(err, "unable to get acquired rights")
+	}
+	p.acqRights = skus
+	return skus, nil
+}
+
+// equipmentTypes returns the equipment types of the scope by their type in lower case
+func (p *uploadPreview) equipmentTypes(ctx context.Context) (map[string]*equipV1.EquipmentType, error) {
+	if p.eqTypes != nil {
+		return p.eqTypes, nil
+	}
+	resp, err := p.d.equipment.EquipmentsTypes(ctx, &equipV1.EquipmentTypesRequest{Scopes: []string{p.scope}})
+	if err != nil {
+		logger.Log.Error("Failed to list equipment types", zap.Error(err), zap.String("scope", p.scope))
+		return nil, status.Error(codes.Internal, "unable to get equipment types")
+	}
+	p.eqTypes = make(map[string]*equipV1.EquipmentType)
+	for _, t := range resp.EquipmentTypes {
+		p.eqTypes[strings.ToLower(t.Type)] = t
+	}
+	return p.eqTypes, nil
+}
+
+func (p *uploadPreview) existingEquipments(ctx context.Context, eqType *equipV1.EquipmentType) (map[string]bool, error) {
+	if ids, ok := p.equipments[eqType.Type]; ok {
+		return ids, nil
+	}
+	var primaryKey string
+	for _, attr := range eqType.Attributes {
+		if attr.PrimaryKey {
+			primaryKey = attr.Name
+		}
+	}
+	ids := make(map[string]bool)
+	err := listPages(func(page int32) (int32, error) {
+		resp, err := p.d.equipment.ListEquipments(ctx, &equipV1.ListEquipmentsRequest{
+			TypeId:    eqType.ID,
+			PageNum:   page,
+			PageSize:  previewPageSize,
+			SortBy:    primaryKey,
+			SortOrder: equipV1.SortOrder_ASC,
+			Scopes:    []string{p.scope},
+		})
+		if err != nil {
+			return 0, err
+		}
+		var equipments []map[string]interface{}
+		if len(resp.Equipments) > 0 {
+			if err := json.Unmarshal(resp.Equipments, &equipments); err != nil {
+				return 0, err
+			}
+		}
+		for _, equip := range equipments {
+			if id, ok := equip[primaryKey].(string); ok {
+				ids[id] = true
+			}
+		}
+		return resp.TotalRecords, nil
+	})
+	if err != nil {
+		logger.Log.Error("Failed to list equipments", zap.Error(err), zap.String("type", eqType.Type), zap.String("scope", p.scope))
+		return nil, listError(err, "unable to get equipments")
+	}
+	if p.equipments == nil {
+		p.equipments = make(map[string]map[string]bool)
+	}
+	p.equipments[eqType.Type] = ids
+	return ids, nil
+}
+
+// listPages calls list with the page numbers until all the records are read, list returns the total number of records
+func listPages(list func(page int32) (int32, error)) error {
+	for page := int32(1); page <= previewMaxPages; page++ {
+		total, err := list(page)
+		if err != nil {
+			return err
+		}
+		if page*previewPageSize >= total {
+			return nil
+		}
+	}
+	return errTooManyRecords
+}
+
+// listError returns the status of a failed listing of the existing records of the scope
+func listError(err error, msg string) error {
+	if errors.Is(err, errTooManyRecords) {
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	}
+	return status.Error(codes.Internal, msg)
+}
+
+// equipmentAttributeValue returns the value of the file column mapped to the first attribute matching the predicate
+func equipmentAttributeValue(rec map[string]interface{}, eqType *equipV1.EquipmentType, match func(*equipV1.Attribute) bool) string {
+	for _, attr := range eqType.Attributes {
+		if !match(attr) {
+			continue
+		}
+		// columns are matched to the attributes regardless of their case, as when the equipment is upserted
+		for k, v := range rec {
+			if strings.EqualFold(k, attr.MappedTo) && v != nil {
+				return fmt.Sprint(v)
+			}
+		}
+		return ""
+	}
+	return ""
+}
+
+// duplicatesToProto formats the duplicate records of a file as failed records
+func duplicatesToProto(records []interface{}) []*v1.FailedRecord {
+	var out []*v1.FailedRecord
+	for _, rec := range records {
+		data, err := json.Marshal(rec)
+		if err != nil {
+			continue
+		}
+		fields := make(map[string]interface{})
+		if err := json.Unmarshal(data, &fields); err != nil {
+			continue
+		}
+		out = append(out, &v1.FailedRecord{Data: failedRecordData(fields), Reason: "DuplicateRecord"})
+	}
+	return out
+}
@@ -0,0 +1,250 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/config"
+	dbmock "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/dbmock"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	appV1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/thirdparty/application-service/pkg/api/v1"
+	appmock "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/thirdparty/application-service/pkg/api/v1/mock"
+	equipV1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/thirdparty/equipment-service/pkg/api/v1"
+	eqmock "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/thirdparty/equipment-service/pkg/api/v1/mock"
+	prodV1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/thirdparty/product-service/pkg/api/v1"
+	prodmock "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/thirdparty/product-service/pkg/api/v1/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_dpsServiceServer_PreviewUpload(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"scope1", "scope2"},
+	})
+	dir := config.GetConfig().FilesLocation
+	files := map[string]string{
+		"scope1_products.csv": "swidtag;version;editor;name;flag\n" +
+			"p1;12;Oracle;Oracle DB;1\n" +
+			"p2;11;IBM;DB2;1\n" +
+			"p2;11;IBM;DB2;1\n" +
+			";1;IBM;no swidtag;1\n",
+		"1_scope1_temp.xlsx#1_scope1#scope1_applications.json": `[{"application_id":"a1","name":"app1","environment":"prod","domain":"finance","flag":1},
+			{"application_id":"a2","name":"app2","environment":"dev","domain":"hr","flag":0},
+			{"application_id":"a3","name":"app3","environment":"dev","domain":"hr","flag":0}]`,
+		"scope1_applications_products.csv": "application_id;swidtag;flag\n" +
+			"a1;p2;1\n" +
+			"a2;p1;1\n" +
+			"a1;p9;1\n" +
+			"a1;p1;0\n",
+		"scope1_equipment_server.csv": "server_code;cluster_code\n" +
+			"srv1;cl1\n" +
+			"srv2;cl9\n",
+		"scope1_application_equipments.csv": "application_id;equipment_id;flag\n" +
+			"a1;srv2;1\n" +
+			"a1;srv9;1\n",
+		"scope1_equipment_vcenter.csv":       "vcenter_code\nvc1\n",
+		"scope1_products_acquiredRights.csv": "",
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	eqTypes := []*equipV1.EquipmentType{
+		{
+			ID: "0x1", Type: "server", ParentType: "cluster",
+			Attributes: []*equipV1.Attribute{
+				{Name: "server_code", PrimaryKey: true, MappedTo: "server_code"},
+				{Name: "parent", ParentIdentifier: true, MappedTo: "cluster_code"},
+			},
+		},
+		{
+			ID: "0x2", Type: "cluster",
+			Attributes: []*equipV1.Attribute{{Name: "cluster_code", PrimaryKey: true, MappedTo: "cluster_code"}},
+		},
+	}
+	var mockCtrl *gomock.Controller
+	var d *dpsServiceServer
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		input    *v1.PreviewUploadRequest
+		setup    func()
+		output   *v1.PreviewUploadResponse
+		wantCode codes.Code
+	}{
+		{
+			name: "SuccessCaseNoError",
+			ctx:  ctx,
+			input: &v1.PreviewUploadRequest{
+				Scope: "scope1",
+				Files: []string{
+					"scope1_products.csv",
+					"1_scope1_temp.xlsx#1_scope1#scope1_applications.json",
+					"scope1_applications_products.csv",
+					"scope1_equipment_server.csv",
+					"scope1_application_equipments.csv",
+					"scope1_equipment_vcenter.csv",
+					"scope1_products_acquiredRights.csv",
+				},
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				prod := prodmock.NewMockProductServiceClient(mockCtrl)
+				app := appmock.NewMockApplicationServiceClient(mockCtrl)
+				equip := eqmock.NewMockEquipmentServiceClient(mockCtrl)
+				d = &dpsServiceServer{product: prod, application: app, equipment: equip}
+				prod.EXPECT().ListProducts(ctx, &prodV1.ListProductsRequest{
+					PageNum: 1, PageSize: 200, SortBy: "swidtag", Scopes: []string{"scope1"},
+				}).Times(1).Return(&prodV1.ListProductsResponse{
+					TotalRecords: 1,
+					Products:     []*prodV1.Product{{SwidTag: "p1"}},
+				}, nil)
+				app.EXPECT().ListApplications(ctx, &appV1.ListApplicationsRequest{
+					PageNum: 1, PageSize: 200, Scopes: []string{"scope1"},
+				}).Times(1).Return(&appV1.ListApplicationsResponse{
+					TotalRecords: 2,
+					Applications: []*appV1.Application{{ApplicationId: "a1"}, {ApplicationId: "a2"}},
+				}, nil)
+				equip.EXPECT().EquipmentsTypes(ctx, &equipV1.EquipmentTypesRequest{Scopes: []string{"scope1"}}).Times(1).Return(&equipV1.EquipmentTypesResponse{
+					EquipmentTypes: eqTypes,
+				}, nil)
+				equip.EXPECT().ListEquipments(ctx, &equipV1.ListEquipmentsRequest{
+					TypeId: "0x1", PageNum: 1, PageSize: 200, SortBy: "server_code", SortOrder: equipV1.SortOrder_ASC, Scopes: []string{"scope1"},
+				}).Times(1).Return(&equipV1.ListEquipmentsResponse{
+					TotalRecords: 1,
+					Equipments:   []byte(`[{"ID":"0x10","server_code":"srv1"}]`),
+				}, nil)
+				equip.EXPECT().ListEquipments(ctx, &equipV1.ListEquipmentsRequest{
+					TypeId: "0x2", PageNum: 1, PageSize: 200, SortBy: "cluster_code", SortOrder: equipV1.SortOrder_ASC, Scopes: []string{"scope1"},
+				}).Times(1).Return(&equipV1.ListEquipmentsResponse{
+					TotalRecords: 1,
+					Equipments:   []byte(`[{"ID":"0x20","cluster_code":"cl1"}]`),
+				}, nil)
+			},
+			output: &v1.PreviewUploadResponse{
+				Files: []*v1.FilePreview{
+					{
+						FileName: "scope1_products.csv", FileType: "products", TotalRecords: 4, InvalidRecords: 1, InvalidRows: []int32{4},
+						Duplicates: []*v1.FailedRecord{{
+							Data:   map[string]string{"SwidTag": "p2", "Name": "DB2", "Version": "11", "Editor": "IBM", "Action": "UPSERT"},
+							Reason: "DuplicateRecord",
+						}},
+					},
+					{FileName: "scope1_applications.json", FileType: "applications", TotalRecords: 3},
+					{FileName: "scope1_applications_products.csv", FileType: "applications_products", TotalRecords: 4},
+					{FileName: "scope1_equipment_server.csv", FileType: "equipment_server", TotalRecords: 2},
+					{FileName: "scope1_application_equipments.csv", FileType: "application_equipments", TotalRecords: 2},
+					{FileName: "scope1_equipment_vcenter.csv", FileType: "equipment_vcenter", TotalRecords: 1, FailureReason: "EquipmentTypeNotFound"},
+					{FileName: "scope1_products_acquiredRights.csv", FailureReason: "EmptyFile"},
+				},
+				Impacts: []*v1.EntityImpact{
+					{Entity: "application_equipments", Inserts: 2},
+					{Entity: "applications", Updates: 1, Deletes: 1, Missing: 1},
+					{Entity: "applications_products", Inserts: 3, Deletes: 1},
+					{Entity: "equipment_server", Inserts: 1, Updates: 1},
+					{Entity: "products", Inserts: 1, Updates: 1},
+				},
+				DanglingReferences: []*v1.DanglingReference{
+					{FileName: "scope1_application_equipments.csv", Entity: "application_equipments", Id: "a1", ReferencedEntity: "equipment", ReferencedId: "srv9"},
+					{FileName: "scope1_applications_products.csv", Entity: "applications_products", Id: "a1", ReferencedEntity: "products", ReferencedId: "p9"},
+					{FileName: "scope1_applications_products.csv", Entity: "applications_products", Id: "p1", ReferencedEntity: "applications", ReferencedId: "a2"},
+					{FileName: "scope1_equipment_server.csv", Entity: "equipment_server", Id: "srv2", ReferencedEntity: "equipment_cluster", ReferencedId: "cl9"},
+				},
+			},
+		},
+		{
+			name:  "FailureCaseClaimsNotFound",
+			ctx:   context.Background(),
+			input: &v1.PreviewUploadRequest{Scope: "scope1", Files: []string{"scope1_products.csv"}},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				d = &dpsServiceServer{}
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:  "FailureCaseScopeValidationError",
+			ctx:   ctx,
+			input: &v1.PreviewUploadRequest{Scope: "scope3", Files: []string{"scope3_products.csv"}},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				d = &dpsServiceServer{}
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:  "FailureCaseFileOutsideUploadDirectory",
+			ctx:   ctx,
+			input: &v1.PreviewUploadRequest{Scope: "scope1", Files: []string{"../scope1_products.csv"}},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				d = &dpsServiceServer{}
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:  "FailureCaseMappingProfileNotFound",
+			ctx:   ctx,
+			input: &v1.PreviewUploadRequest{Scope: "scope1", Files: []string{"scope1_products.csv"}, MappingProfile: "sccm"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep := dbmock.NewMockDps(mockCtrl)
+				d = &dpsServiceServer{dpsRepo: rep}
+				rep.EXPECT().GetMappingProfile(ctx, db.GetMappingProfileParams{Scope: "scope1", Name: "sccm"}).Times(1).Return(db.MappingProfile{}, sql.ErrNoRows)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:  "FailureCaseProductServiceError",
+			ctx:   ctx,
+			input: &v1.PreviewUploadRequest{Scope: "scope1", Files: []string{"scope1_products.csv"}},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				prod := prodmock.NewMockProductServiceClient(mockCtrl)
+				d = &dpsServiceServer{product: prod}
+				prod.EXPECT().ListProducts(ctx, gomock.Any()).Times(1).Return(nil, errors.New("service error"))
+			},
+			wantCode: codes.Internal,
+		},
+		{
+			name:  "FailureCaseTooManyProducts",
+			ctx:   ctx,
+			input: &v1.PreviewUploadRequest{Scope: "scope1", Files: []string{"scope1_products.csv"}},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				prod := prodmock.NewMockProductServiceClient(mockCtrl)
+				d = &dpsServiceServer{product: prod}
+				prod.EXPECT().ListProducts(ctx, gomock.Any()).Times(int(previewMaxPages)).Return(&prodV1.ListProductsResponse{
+					TotalRecords: previewPageSize*previewMaxPages + 1,
+				}, nil)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			got, err := d.PreviewUpload(tt.ctx, tt.input)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output, got)
+		})
+	}
+}
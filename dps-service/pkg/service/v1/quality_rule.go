@@ -0,0 +1,239 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/quality"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/helper"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateQualityRule creates a data quality rule of the scope
+func (d *dpsServiceServer) CreateQualityRule(ctx context.Context, req *v1.QualityRule) (*v1.QualityRule, error) {
+	userClaims, err := adminClaims(ctx, req.Scope)
+	if err != nil {
+		return nil, err
+	}
+	params, err := validateQualityRule(req)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := d.dpsRepo.GetQualityRule(ctx, db.GetQualityRuleParams{Scope: req.Scope, Name: req.Name}); err == nil {
+		return nil, status.Error(codes.AlreadyExists, "QualityRuleExists")
+	} else if err != sql.ErrNoRows {
+		logger.Log.Error("Failed to get quality rule", zap.Error(err), zap.String("name", req.Name))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	if err := d.dpsRepo.InsertQualityRule(ctx, db.InsertQualityRuleParams{
+		Scope:       req.Scope,
+		Name:        req.Name,
+		Entity:      req.Entity,
+		Field:       req.Field,
+		Operator:    req.Operator,
+		Params:      params,
+		Severity:    db.RuleSeverity(req.Severity.String()),
+		Description: req.Description,
+		Enabled:     req.Enabled,
+		CreatedBy:   userClaims.UserID,
+	}); err != nil {
+		logger.Log.Error("Failed to insert quality rule", zap.Error(err), zap.String("name", req.Name))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	return d.getQualityRule(ctx, req.Scope, req.Name)
+}
+
+// UpdateQualityRule replaces the check of an existing quality rule
+func (d *dpsServiceServer) UpdateQualityRule(ctx context.Context, req *v1.QualityRule) (*v1.QualityRule, error) {
+	userClaims, err := adminClaims(ctx, req.Scope)
+	if err != nil {
+		return nil, err
+	}
+	params, err := validateQualityRule(req)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := d.getQualityRule(ctx, req.Scope, req.Name); err != nil {
+		return nil, err
+	}
+	if err := d.dpsRepo.UpdateQualityRule(ctx, db.UpdateQualityRuleParams{
+		Scope:       req.Scope,
+		Name:        req.Name,
+		Entity:      req.Entity,
+		Field:       req.Field,
+		Operator:    req.Operator,
+		Params:      params,
+		Severity:    db.RuleSeverity(req.Severity.String()),
+		Description: req.Description,
+		Enabled:     req.Enabled,
+		UpdatedBy:   sql.NullString{String: userClaims.UserID, Valid: true},
+	}); err != nil {
+		logger.Log.Error("Failed to update quality rule", zap.Error(err), zap.String("name", req.Name))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	return d.getQualityRule(ctx, req.Scope, req.Name)
+}
+
+// ListQualityRules lists the quality rules of the scope
+func (d *dpsServiceServer) ListQualityRules(ctx context.Context, req *v1.ListQualityRulesRequest) (*v1.ListQualityRulesResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	dbresp, err := d.dpsRepo.ListQualityRules(ctx, req.Scope)
+	if err != nil {
+		logger.Log.Error("Failed to list quality rules", zap.Error(err), zap.String("scope", req.Scope))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	resp := &v1.ListQualityRulesResponse{Rules: make([]*v1.QualityRule, 0, len(dbresp))}
+	for _, r := range dbresp {
+		rule, err := qualityRuleToProto(r)
+		if err != nil {
+			return nil, err
+		}
+		resp.Rules = append(resp.Rules, rule)
+	}
+	return resp, nil
+}
+
+// DeleteQualityRule deletes a quality rule of the scope
+func (d *dpsServiceServer) DeleteQualityRule(ctx context.Context, req *v1.DeleteQualityRuleRequest) (*v1.DeleteQualityRuleResponse, error) {
+	if _, err := adminClaims(ctx, req.Scope); err != nil {
+		return &v1.DeleteQualityRuleResponse{Success: false}, err
+	}
+	if err := d.dpsRepo.DeleteQualityRule(ctx, db.DeleteQualityRuleParams{Scope: req.Scope, Name: req.Name}); err != nil {
+		logger.Log.Error("Failed to delete quality rule", zap.Error(err), zap.String("name", req.Name))
+		return &v1.DeleteQualityRuleResponse{Success: false}, status.Error(codes.Internal, "DBError")
+	}
+	return &v1.DeleteQualityRuleResponse{Success: true}, nil
+}
+
+// adminClaims gives the claims of an admin of the scope
+func adminClaims(ctx context.Context, scope string) (*claims.Claims, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !helper.Contains(userClaims.Socpes, scope) {
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	if userClaims.Role == claims.RoleUser {
+		return nil, status.Error(codes.PermissionDenied, "RoleValidationError")
+	}
+	return userClaims, nil
+}
+
+// validateQualityRule checks the params of the operator of the rule and marshals them as stored
+func validateQualityRule(req *v1.QualityRule) (json.RawMessage, error) {
+	params := quality.Params{Min: doubleValue(req.Min), Max: doubleValue(req.Max), Values: req.Values, Pattern: req.Pattern}
+	if err := quality.Validate(req.Operator, params); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// only the params of the operator are kept
+	switch req.Operator {
+	case quality.Range:
+		params = quality.Params{Min: params.Min, Max: params.Max}
+	case quality.OneOf:
+		params = quality.Params{Values: req.Values}
+	case quality.Regex:
+		params = quality.Params{Pattern: req.Pattern}
+	default:
+		params = quality.Params{}
+	}
+	data, err := json.Marshal(params)
+	if err != nil {
+		logger.Log.Error("Failed to marshal quality rule params", zap.Error(err))
+		return nil, status.Error(codes.Internal, "MarshalError")
+	}
+	return data, nil
+}
+
+// doubleValue gives the bound of a range rule, nil when it is omitted
+func doubleValue(v *wrappers.DoubleValue) *float64 {
+	if v == nil {
+		return nil
+	}
+	val := v.GetValue()
+	return &val
+}
+
+func doubleProto(v *float64) *wrappers.DoubleValue {
+	if v == nil {
+		return nil
+	}
+	return &wrappers.DoubleValue{Value: *v}
+}
+
+func (d *dpsServiceServer) getQualityRule(ctx context.Context, scope, name string) (*v1.QualityRule, error) {
+	r, err := d.dpsRepo.GetQualityRule(ctx, db.GetQualityRuleParams{Scope: scope, Name: name})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.NotFound, "QualityRuleNotFound")
+		}
+		logger.Log.Error("Failed to get quality rule", zap.Error(err), zap.String("name", name))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	return qualityRuleToProto(r)
+}
+
+func qualityRuleToProto(r db.QualityRule) (*v1.QualityRule, error) {
+	params := quality.Params{}
+	if err := json.Unmarshal(r.Params, &params); err != nil {
+		logger.Log.Error("Failed to unmarshal quality rule params", zap.Error(err), zap.String("name", r.Name))
+		return nil, status.Error(codes.Internal, "UnmarshalError")
+	}
+	rule := &v1.QualityRule{
+		Name:        r.Name,
+		Scope:       r.Scope,
+		Entity:      r.Entity,
+		Field:       r.Field,
+		Operator:    r.Operator,
+		Min:         doubleProto(params.Min),
+		Max:         doubleProto(params.Max),
+		Values:      params.Values,
+		Pattern:     params.Pattern,
+		Severity:    v1.QualityRule_Severity(v1.QualityRule_Severity_value[string(r.Severity)]),
+		Description: r.Description,
+		Enabled:     r.Enabled,
+		CreatedBy:   r.CreatedBy,
+		UpdatedBy:   r.UpdatedBy.String,
+	}
+	rule.CreatedOn, _ = ptypes.TimestampProto(r.CreatedOn)
+	if r.UpdatedOn.Valid {
+		rule.UpdatedOn, _ = ptypes.TimestampProto(r.UpdatedOn.Time)
+	}
+	return rule, nil
+}
+
+// qualityRules gives the enabled quality rules of the scope, nil when they cannot be read
+func (d *dpsServiceServer) qualityRules(ctx context.Context, scope string) *quality.Set {
+	rules, err := d.dpsRepo.ListEnabledQualityRules(ctx, scope)
+	if err != nil && err != sql.ErrNoRows {
+		logger.Log.Error("Failed to list quality rules", zap.Error(err), zap.String("scope", scope))
+		return nil
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+	set, err := quality.New(rules)
+	if err != nil {
+		logger.Log.Error("Failed to compile quality rules", zap.Error(err), zap.String("scope", scope))
+		return nil
+	}
+	return set
+}
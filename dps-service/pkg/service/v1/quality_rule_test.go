@@ -0,0 +1,540 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue"
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/api/v1"
+	repo "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1"
+	dbmock "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/dbmock"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testQualityRule = &v1.QualityRule{
+	Name:     "cpu",
+	Scope:    "scope1",
+	Entity:   "equipments",
+	Field:    "cpu",
+	Operator: "range",
+	Min:      &wrappers.DoubleValue{Value: 1},
+	Max:      &wrappers.DoubleValue{Value: 256},
+	Values:   []string{"ignored"},
+	Severity: v1.QualityRule_ERROR,
+	Enabled:  true,
+}
+
+func Test_dpsServiceServer_CreateQualityRule(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"scope1", "scope2", "scope3"},
+	})
+	userCtx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "user@test.com",
+		Role:   "User",
+		Socpes: []string{"scope1"},
+	})
+	createdOn := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+	createdOnProto, _ := ptypes.TimestampProto(createdOn)
+	var mockCtrl *gomock.Controller
+	var rep repo.Dps
+	var queue workerqueue.Workerqueue
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		input    *v1.QualityRule
+		setup    func()
+		output   *v1.QualityRule
+		wantCode codes.Code
+	}{
+		{
+			name:  "SuccessCaseNoError",
+			ctx:   ctx,
+			input: testQualityRule,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				gomock.InOrder(
+					mockRepository.EXPECT().GetQualityRule(ctx, db.GetQualityRuleParams{Scope: "scope1", Name: "cpu"}).Times(1).Return(db.QualityRule{}, sql.ErrNoRows),
+					mockRepository.EXPECT().InsertQualityRule(ctx, db.InsertQualityRuleParams{
+						Scope:     "scope1",
+						Name:      "cpu",
+						Entity:    "equipments",
+						Field:     "cpu",
+						Operator:  "range",
+						Params:    json.RawMessage(`{"min":1,"max":256}`),
+						Severity:  db.RuleSeverityERROR,
+						Enabled:   true,
+						CreatedBy: "admin@superuser.com",
+					}).Times(1).Return(nil),
+					mockRepository.EXPECT().GetQualityRule(ctx, db.GetQualityRuleParams{Scope: "scope1", Name: "cpu"}).Times(1).Return(db.QualityRule{
+						Scope:     "scope1",
+						Name:      "cpu",
+						Entity:    "equipments",
+						Field:     "cpu",
+						Operator:  "range",
+						Params:    json.RawMessage(`{"min":1,"max":256}`),
+						Severity:  db.RuleSeverityERROR,
+						Enabled:   true,
+						CreatedBy: "admin@superuser.com",
+						CreatedOn: createdOn,
+					}, nil),
+				)
+			},
+			output: &v1.QualityRule{
+				Name:      "cpu",
+				Scope:     "scope1",
+				Entity:    "equipments",
+				Field:     "cpu",
+				Operator:  "range",
+				Min:       &wrappers.DoubleValue{Value: 1},
+				Max:       &wrappers.DoubleValue{Value: 256},
+				Severity:  v1.QualityRule_ERROR,
+				Enabled:   true,
+				CreatedBy: "admin@superuser.com",
+				CreatedOn: createdOnProto,
+			},
+		},
+		{
+			name:  "SuccessCaseMinOnly",
+			ctx:   ctx,
+			input: &v1.QualityRule{Name: "ram", Scope: "scope1", Entity: "equipments", Field: "ram", Operator: "range", Min: &wrappers.DoubleValue{Value: 0}},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				gomock.InOrder(
+					mockRepository.EXPECT().GetQualityRule(ctx, db.GetQualityRuleParams{Scope: "scope1", Name: "ram"}).Times(1).Return(db.QualityRule{}, sql.ErrNoRows),
+					mockRepository.EXPECT().InsertQualityRule(ctx, db.InsertQualityRuleParams{
+						Scope:     "scope1",
+						Name:      "ram",
+						Entity:    "equipments",
+						Field:     "ram",
+						Operator:  "range",
+						Params:    json.RawMessage(`{"min":0}`),
+						Severity:  db.RuleSeverityERROR,
+						CreatedBy: "admin@superuser.com",
+					}).Times(1).Return(nil),
+					mockRepository.EXPECT().GetQualityRule(ctx, db.GetQualityRuleParams{Scope: "scope1", Name: "ram"}).Times(1).Return(db.QualityRule{
+						Scope:     "scope1",
+						Name:      "ram",
+						Entity:    "equipments",
+						Field:     "ram",
+						Operator:  "range",
+						Params:    json.RawMessage(`{"min":0}`),
+						Severity:  db.RuleSeverityERROR,
+						CreatedBy: "admin@superuser.com",
+						CreatedOn: createdOn,
+					}, nil),
+				)
+			},
+			output: &v1.QualityRule{
+				Name:      "ram",
+				Scope:     "scope1",
+				Entity:    "equipments",
+				Field:     "ram",
+				Operator:  "range",
+				Min:       &wrappers.DoubleValue{Value: 0},
+				Severity:  v1.QualityRule_ERROR,
+				CreatedBy: "admin@superuser.com",
+				CreatedOn: createdOnProto,
+			},
+		},
+		{
+			name:     "FailureCaseClaimsNotFound",
+			ctx:      context.Background(),
+			input:    testQualityRule,
+			setup:    func() {},
+			wantCode: codes.Internal,
+		},
+		{
+			name:     "FailureCaseScopeValidation",
+			ctx:      ctx,
+			input:    &v1.QualityRule{Name: "cpu", Scope: "scope4"},
+			setup:    func() {},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "FailureCaseRoleValidation",
+			ctx:      userCtx,
+			input:    testQualityRule,
+			setup:    func() {},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "FailureCaseBadRange",
+			ctx:      ctx,
+			input:    &v1.QualityRule{Name: "cpu", Scope: "scope1", Entity: "equipments", Field: "cpu", Operator: "range", Min: &wrappers.DoubleValue{Value: 10}, Max: &wrappers.DoubleValue{Value: 1}},
+			setup:    func() {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "FailureCaseNoBound",
+			ctx:      ctx,
+			input:    &v1.QualityRule{Name: "cpu", Scope: "scope1", Entity: "equipments", Field: "cpu", Operator: "range"},
+			setup:    func() {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "FailureCaseNoValues",
+			ctx:      ctx,
+			input:    &v1.QualityRule{Name: "domain", Scope: "scope1", Entity: "applications", Field: "domain", Operator: "one_of"},
+			setup:    func() {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "FailureCaseBadPattern",
+			ctx:      ctx,
+			input:    &v1.QualityRule{Name: "sku", Scope: "scope1", Entity: "acqrights", Field: "sku", Operator: "regex", Pattern: "("},
+			setup:    func() {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:  "FailureCaseQualityRuleExists",
+			ctx:   ctx,
+			input: testQualityRule,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetQualityRule(ctx, db.GetQualityRuleParams{Scope: "scope1", Name: "cpu"}).Times(1).Return(db.QualityRule{Scope: "scope1", Name: "cpu"}, nil)
+			},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:  "FailureCaseInsertError",
+			ctx:   ctx,
+			input: testQualityRule,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetQualityRule(ctx, db.GetQualityRuleParams{Scope: "scope1", Name: "cpu"}).Times(1).Return(db.QualityRule{}, sql.ErrNoRows)
+				mockRepository.EXPECT().InsertQualityRule(ctx, gomock.Any()).Times(1).Return(errors.New("DBError"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			d := NewDpsServiceServer(rep, queue, nil)
+			got, err := d.CreateQualityRule(tt.ctx, tt.input)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			if !assert.Empty(t, err) {
+				return
+			}
+			assert.Equal(t, tt.output.String(), got.String())
+		})
+	}
+}
+
+func Test_dpsServiceServer_UpdateQualityRule(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"scope1"},
+	})
+	createdOn := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+	updatedOn := time.Date(2021, 5, 3, 0, 0, 0, 0, time.UTC)
+	createdOnProto, _ := ptypes.TimestampProto(createdOn)
+	updatedOnProto, _ := ptypes.TimestampProto(updatedOn)
+	input := &v1.QualityRule{
+		Name:     "domain",
+		Scope:    "scope1",
+		Entity:   "applications",
+		Field:    "domain",
+		Operator: "one_of",
+		Values:   []string{"Finance", "HR"},
+		Severity: v1.QualityRule_WARNING,
+	}
+	var mockCtrl *gomock.Controller
+	var rep repo.Dps
+	var queue workerqueue.Workerqueue
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		input    *v1.QualityRule
+		setup    func()
+		output   *v1.QualityRule
+		wantCode codes.Code
+	}{
+		{
+			name:  "SuccessCaseNoError",
+			ctx:   ctx,
+			input: input,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				gomock.InOrder(
+					mockRepository.EXPECT().GetQualityRule(ctx, db.GetQualityRuleParams{Scope: "scope1", Name: "domain"}).Times(1).Return(db.QualityRule{
+						Scope:    "scope1",
+						Name:     "domain",
+						Entity:   "applications",
+						Field:    "domain",
+						Operator: "required",
+						Params:   json.RawMessage(`{}`),
+						Severity: db.RuleSeverityERROR,
+					}, nil),
+					mockRepository.EXPECT().UpdateQualityRule(ctx, db.UpdateQualityRuleParams{
+						Scope:     "scope1",
+						Name:      "domain",
+						Entity:    "applications",
+						Field:     "domain",
+						Operator:  "one_of",
+						Params:    json.RawMessage(`{"values":["Finance","HR"]}`),
+						Severity:  db.RuleSeverityWARNING,
+						UpdatedBy: sql.NullString{String: "admin@superuser.com", Valid: true},
+					}).Times(1).Return(nil),
+					mockRepository.EXPECT().GetQualityRule(ctx, db.GetQualityRuleParams{Scope: "scope1", Name: "domain"}).Times(1).Return(db.QualityRule{
+						Scope:     "scope1",
+						Name:      "domain",
+						Entity:    "applications",
+						Field:     "domain",
+						Operator:  "one_of",
+						Params:    json.RawMessage(`{"values":["Finance","HR"]}`),
+						Severity:  db.RuleSeverityWARNING,
+						CreatedBy: "admin@superuser.com",
+						CreatedOn: createdOn,
+						UpdatedBy: sql.NullString{String: "admin@superuser.com", Valid: true},
+						UpdatedOn: sql.NullTime{Time: updatedOn, Valid: true},
+					}, nil),
+				)
+			},
+			output: &v1.QualityRule{
+				Name:      "domain",
+				Scope:     "scope1",
+				Entity:    "applications",
+				Field:     "domain",
+				Operator:  "one_of",
+				Values:    []string{"Finance", "HR"},
+				Severity:  v1.QualityRule_WARNING,
+				CreatedBy: "admin@superuser.com",
+				CreatedOn: createdOnProto,
+				UpdatedBy: "admin@superuser.com",
+				UpdatedOn: updatedOnProto,
+			},
+		},
+		{
+			name:  "FailureCaseQualityRuleNotFound",
+			ctx:   ctx,
+			input: input,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetQualityRule(ctx, db.GetQualityRuleParams{Scope: "scope1", Name: "domain"}).Times(1).Return(db.QualityRule{}, sql.ErrNoRows)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:  "FailureCaseUpdateError",
+			ctx:   ctx,
+			input: input,
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetQualityRule(ctx, db.GetQualityRuleParams{Scope: "scope1", Name: "domain"}).Times(1).Return(db.QualityRule{Params: json.RawMessage(`{}`)}, nil)
+				mockRepository.EXPECT().UpdateQualityRule(ctx, gomock.Any()).Times(1).Return(errors.New("DBError"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			d := NewDpsServiceServer(rep, queue, nil)
+			got, err := d.UpdateQualityRule(tt.ctx, tt.input)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			if !assert.Empty(t, err) {
+				return
+			}
+			assert.Equal(t, tt.output.String(), got.String())
+		})
+	}
+}
+
+func Test_dpsServiceServer_ListQualityRules(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "user@test.com",
+		Role:   "User",
+		Socpes: []string{"scope1"},
+	})
+	createdOn := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+	createdOnProto, _ := ptypes.TimestampProto(createdOn)
+	var mockCtrl *gomock.Controller
+	var rep repo.Dps
+	var queue workerqueue.Workerqueue
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		input    *v1.ListQualityRulesRequest
+		setup    func()
+		output   *v1.ListQualityRulesResponse
+		wantCode codes.Code
+	}{
+		{
+			name:  "SuccessCaseNoError",
+			ctx:   ctx,
+			input: &v1.ListQualityRulesRequest{Scope: "scope1"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().ListQualityRules(ctx, "scope1").Times(1).Return([]db.QualityRule{
+					{
+						Scope:       "scope1",
+						Name:        "editor",
+						Entity:      "products",
+						Field:       "editor",
+						Operator:    "in_catalog",
+						Params:      json.RawMessage(`{}`),
+						Severity:    db.RuleSeverityWARNING,
+						Description: "unknown editor",
+						Enabled:     true,
+						CreatedBy:   "admin@superuser.com",
+						CreatedOn:   createdOn,
+					},
+				}, nil)
+			},
+			output: &v1.ListQualityRulesResponse{
+				Rules: []*v1.QualityRule{
+					{
+						Name:        "editor",
+						Scope:       "scope1",
+						Entity:      "products",
+						Field:       "editor",
+						Operator:    "in_catalog",
+						Severity:    v1.QualityRule_WARNING,
+						Description: "unknown editor",
+						Enabled:     true,
+						CreatedBy:   "admin@superuser.com",
+						CreatedOn:   createdOnProto,
+					},
+				},
+			},
+		},
+		{
+			name:     "FailureCaseScopeValidation",
+			ctx:      ctx,
+			input:    &v1.ListQualityRulesRequest{Scope: "scope2"},
+			setup:    func() {},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:  "FailureCaseDBError",
+			ctx:   ctx,
+			input: &v1.ListQualityRulesRequest{Scope: "scope1"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().ListQualityRules(ctx, "scope1").Times(1).Return(nil, errors.New("DBError"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			d := NewDpsServiceServer(rep, queue, nil)
+			got, err := d.ListQualityRules(tt.ctx, tt.input)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			if !assert.Empty(t, err) {
+				return
+			}
+			assert.Equal(t, tt.output.String(), got.String())
+		})
+	}
+}
+
+func Test_dpsServiceServer_DeleteQualityRule(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"scope1"},
+	})
+	var mockCtrl *gomock.Controller
+	var rep repo.Dps
+	var queue workerqueue.Workerqueue
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		input    *v1.DeleteQualityRuleRequest
+		setup    func()
+		wantCode codes.Code
+	}{
+		{
+			name:  "SuccessCaseNoError",
+			ctx:   ctx,
+			input: &v1.DeleteQualityRuleRequest{Scope: "scope1", Name: "cpu"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().DeleteQualityRule(ctx, db.DeleteQualityRuleParams{Scope: "scope1", Name: "cpu"}).Times(1).Return(nil)
+			},
+		},
+		{
+			name:     "FailureCaseScopeValidation",
+			ctx:      ctx,
+			input:    &v1.DeleteQualityRuleRequest{Scope: "scope2", Name: "cpu"},
+			setup:    func() {},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:  "FailureCaseDBError",
+			ctx:   ctx,
+			input: &v1.DeleteQualityRuleRequest{Scope: "scope1", Name: "cpu"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockDps(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().DeleteQualityRule(ctx, gomock.Any()).Times(1).Return(errors.New("DBError"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			d := NewDpsServiceServer(rep, queue, nil)
+			got, err := d.DeleteQualityRule(tt.ctx, tt.input)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				assert.False(t, got.Success)
+				return
+			}
+			if !assert.Empty(t, err) {
+				return
+			}
+			assert.True(t, got.Success)
+		})
+	}
+}
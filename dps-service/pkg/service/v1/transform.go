@@ -0,0 +1,114 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	repo "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/transform"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	excel "github.com/xuri/excelize/v2"
+	"go.uber.org/zap"
+)
+
+// InvalidValueMsg is the reason of the rows with a value which cannot be written in a data file
+const InvalidValueMsg = "This value contains the delimiter ; or a line break."
+
+// globalFileChecker checks the global workbooks split by the transform as the data analysis does
+type globalFileChecker struct {
+	dpsRepo repo.Dps
+}
+
+// NewGlobalFileChecker gives the checker of the global workbooks split in place of NiFi
+func NewGlobalFileChecker(dpsRepo repo.Dps) transform.Checker {
+	return &globalFileChecker{dpsRepo: dpsRepo}
+}
+
+// CheckGlobalFile checks the workbook and its headers as in the data analysis, a row with a missing
+// mandatory value, a value of a wrong type or a value which cannot be written in a data file is left out
+func (c *globalFileChecker) CheckGlobalFile(ctx context.Context, workbook string) (map[string][]transform.Row, []transform.RowError, error) {
+	fp, err := excel.OpenFile(workbook)
+	if err != nil {
+		logger.Log.Error("Failed to open global file", zap.Error(err), zap.String("file", workbook))
+		return nil, nil, fmt.Errorf(BadFile)
+	}
+	if err := isSheetMissing(fp); err != nil {
+		return nil, nil, err
+	}
+	headersIndex, err := handleHeaders(fp, make(map[string]int))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s", strings.TrimPrefix(err.Error(), "analysis:"))
+	}
+	if err := cacheCorefactor(ctx, c.dpsRepo); err != nil {
+		return nil, nil, fmt.Errorf("CoreFactorCachingError")
+	}
+	var rowErrors []transform.RowError
+	sheetRows := make(map[string][]transform.Row)
+	for _, sheet := range transform.Sheets {
+		rows, errs, err := checkedSheetRows(fp, sheet, headersIndex[sheet])
+		if err != nil {
+			return nil, nil, err
+		}
+		sheetRows[sheet] = rows
+		rowErrors = append(rowErrors, errs...)
+	}
+	return sheetRows, rowErrors, nil
+}
+
+// CoreFactor gives the cached core factor of the cpu model
+func (c *globalFileChecker) CoreFactor(manufacturer, model string) float64 {
+	return getCoreFactor(manufacturer, model)
+}
+
+// checkedSheetRows gives the rows of the sheet which pass the mandatory and type checks of the
+// analysis and whose values can be written in a data file, the others are reported, blank rows are skipped
+func checkedSheetRows(fp *excel.File, sheet string, headers map[string]int) ([]transform.Row, []transform.RowError, error) {
+	rows, err := fp.GetRows(sheet)
+	if err != nil {
+		logger.Log.Error("Failed to read the sheet", zap.Error(err), zap.String("sheet", sheet))
+		return nil, nil, fmt.Errorf("%s is %s", sheet, BadSheet)
+	}
+	// a repeated header leaves a column out of the headers
+	width := 0
+	for _, col := range headers {
+		if col > width {
+			width = col
+		}
+	}
+	var good []transform.Row
+	var rowErrors []transform.RowError
+	for i := 1; i < len(rows); i++ {
+		row := make([]string, width)
+		copy(row, rows[i])
+		if strings.TrimSpace(strings.Join(row, "")) == "" {
+			continue
+		}
+		objects, ok, err := handleMandatoryFieldMissingAndWrongType(fp, i+1, sheet, headers, row, nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s is %s", sheet, BadSheet)
+		}
+		if !ok {
+			for _, obj := range objects {
+				rowErrors = append(rowErrors, transform.RowError{Sheet: sheet, Row: i + 1, Cell: obj.Column, Action: obj.Action, Reason: obj.Msg})
+			}
+			continue
+		}
+		r := make(transform.Row, len(headers))
+		writable := true
+		for h, col := range headers {
+			r[h] = row[col-1]
+			if !transform.Writable(r[h]) {
+				cell, _ := excel.CoordinatesToCellName(col, i+1)
+				rowErrors = append(rowErrors, transform.RowError{Sheet: sheet, Row: i + 1, Cell: cell, Action: transform.InvalidValue, Reason: InvalidValueMsg})
+				writable = false
+			}
+		}
+		if writable {
+			good = append(good, r)
+		}
+	}
+	return good, rowErrors, nil
+}
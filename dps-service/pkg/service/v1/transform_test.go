@@ -0,0 +1,141 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	dbmock "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/dbmock"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/transform"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	excel "github.com/xuri/excelize/v2"
+)
+
+// writeGlobalWorkbook writes a global workbook with all the headers of the template, the rows are given by header
+func writeGlobalWorkbook(t *testing.T, file string, rows map[string][]map[string]interface{}) {
+	fp := excel.NewFile()
+	for sheet, headers := range sheetsAndHeaders {
+		fp.NewSheet(sheet)
+		var names []string
+		for h := range headers {
+			names = append(names, h)
+		}
+		sort.Strings(names)
+		if sheet == servers {
+			names = append(names, "oracle_core_factor")
+		}
+		header := make([]interface{}, len(names))
+		for i, h := range names {
+			header[i] = h
+		}
+		if err := fp.SetSheetRow(sheet, "A1", &header); err != nil {
+			t.Fatal(err)
+		}
+		for i, r := range rows[sheet] {
+			row := make([]interface{}, len(names))
+			for j, h := range names {
+				row[j] = r[h]
+			}
+			if err := fp.SetSheetRow(sheet, fmt.Sprintf("A%d", i+2), &row); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	fp.DeleteSheet("Sheet1")
+	if err := fp.SaveAs(file); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readDataFile(t *testing.T, file string) string {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func Test_globalFileChecker_CheckGlobalFile(t *testing.T) {
+	dir := t.TempDir()
+	workbook := filepath.Join(dir, "12_global.xlsx")
+	writeGlobalWorkbook(t, workbook, map[string][]map[string]interface{}{
+		servers: {
+			{"server_id": "SRV1", "server_name": "Server 1", "cpu_model": "Xeon E5", "cpu_manufacturer": "Intel", "cores_per_processor": 8, "server_processors_numbers": 2, "cluster_name": "CL1", "vcenter_name": "VC1", "vcenter_version": "7.0"},
+			{"server_id": "SRV2", "cpu_model": "Power9", "cpu_manufacturer": "IBM", "cores_per_processor": "eight", "server_processors_numbers": 1},
+			{"server_id": "SRV3", "cpu_model": "Xeon E5", "cpu_manufacturer": "Intel", "cores_per_processor": 4, "server_processors_numbers": 1, "oracle_core_factor": 0.25, "cluster_name": "CL1", "vcenter_name": "VC1", "vcenter_version": "7.0"},
+		},
+		virtualMachines: {
+			{"vm_id": "VM1", "vm_name": "vm 1", "vcpu": 2, "server_id": "SRV1"},
+			{"vm_name": "no id", "server_id": "SRV1"},
+		},
+		products: {
+			{"product_name": "Oracle  Database", "product_editor": "Oracle", "product_version": "19c", "host_id": "VM1"},
+			{"product_name": "Oracle  Database", "product_editor": "Oracle", "product_version": "19c", "host_id": "SRV3"},
+			{"product_name": "Oracle;Database", "product_editor": "Oracle", "host_id": "SRV3"},
+			{"product_name": "Oracle\nDatabase", "product_editor": "Oracle", "host_id": "SRV3"},
+		},
+		applications: {
+			{"application_name": "Billing", "host_id": "VM1", "product_name": "Oracle  Database", "product_editor": "Oracle", "product_version": "19c"},
+		},
+		acquiredRights: {
+			{"sku": "SKU1", "product_name": "Oracle  Database", "product_editor": "Oracle", "product_version": "19c", "metric": "ops", "acquired_licenses": 10, "unit_price": 2.5, "maintenance_licences": 5, "maintenance_unit_price": 1},
+		},
+	})
+	out := t.TempDir()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockRepository := dbmock.NewMockDps(mockCtrl)
+	mockRepository.EXPECT().GetCoreFactorList(gomock.Any()).Return([]db.CoreFactorReference{
+		{Manufacturer: "default", Model: "default", CoreFactor: "1"},
+		{Manufacturer: "Intel", Model: "Xeon E5", CoreFactor: "0.5"},
+	}, nil).AnyTimes()
+	coreFactorUncached()
+	defer coreFactorUncached()
+	checker := NewGlobalFileChecker(mockRepository)
+	files, rowErrors, err := transform.GlobalFile(context.Background(), checker, "SCP", workbook, out, "12_global")
+	if !assert.Empty(t, err) {
+		return
+	}
+	assert.ElementsMatch(t, []string{
+		"12_global#SCP_equipment_server.csv",
+		"12_global#SCP_equipment_cluster.csv",
+		"12_global#SCP_equipment_vcenter.csv",
+		"12_global#SCP_equipment_virtualmachine.csv",
+		"12_global#SCP_products.csv",
+		"12_global#SCP_products_equipments.csv",
+		"12_global#SCP_applications.csv",
+		"12_global#SCP_applications_products.csv",
+		"12_global#SCP_application_equipments.csv",
+		"12_global#SCP_products_acquiredRights.csv",
+	}, files)
+	assert.Equal(t, "server_id;server_name;server_type;server_os;cpu_model;cpu_manufacturer;cores_per_processor;oracle_core_factor;ibm_pvu;sag_uvu;server_processors_numbers;hyperthreading;datacenter_name;environment;parent_id\n"+
+		"srv1;server 1;;;xeon e5;Intel;8;0.5;;;2;;;;cl1\n"+
+		"srv3;;;;xeon e5;Intel;4;0.25;;;1;;;;cl1\n", readDataFile(t, filepath.Join(out, "12_global#SCP_equipment_server.csv")))
+	assert.Equal(t, "cluster_name;parent_id\ncl1;vc1\n", readDataFile(t, filepath.Join(out, "12_global#SCP_equipment_cluster.csv")))
+	assert.Equal(t, "vm_id;vm_name;vcpu;environment;parent_id\nvm1;vm 1;2;;srv1\n", readDataFile(t, filepath.Join(out, "12_global#SCP_equipment_virtualmachine.csv")))
+	assert.Equal(t, "swidtag;name;editor;version;flag\nOracle_Database_Oracle_19c;Oracle  Database;Oracle;19c;1\n", readDataFile(t, filepath.Join(out, "12_global#SCP_products.csv")))
+	assert.Equal(t, "swidtag;equipment_id;allocated_metric;allocated_users;flag\nOracle_Database_Oracle_19c;vm1;;;1\nOracle_Database_Oracle_19c;srv3;;;1\n", readDataFile(t, filepath.Join(out, "12_global#SCP_products_equipments.csv")))
+	assert.Equal(t, "application_id;name;environment;domain;flag\nbilling;billing;;;1\n", readDataFile(t, filepath.Join(out, "12_global#SCP_applications.csv")))
+	assert.Contains(t, readDataFile(t, filepath.Join(out, "12_global#SCP_products_acquiredRights.csv")), "\nOracle_Database_Oracle_19c;SKU1;;;Oracle  Database;Oracle;19c;;ops;10;5;2.5;1;;;;;;25;5;30;1\n")
+	assert.ElementsMatch(t, []transform.RowError{
+		{Sheet: servers, Row: 3, Cell: "B3", Action: WrongTypeField, Reason: "This value is wrongType, expected :Positive integer."},
+		{Sheet: virtualMachines, Row: 3, Cell: "D3", Action: MissingField, Reason: "This mandatory value is missing."},
+		{Sheet: products, Row: 4, Cell: "E4", Action: BadData, Reason: "Semi-Colon is not allowed in data"},
+		{Sheet: products, Row: 5, Cell: "E5", Action: transform.InvalidValue, Reason: InvalidValueMsg},
+	}, rowErrors)
+
+	// a workbook without the sheets of the template is not transformed
+	bad := filepath.Join(dir, "13_bad.xlsx")
+	fp := excel.NewFile()
+	if err := fp.SaveAs(bad); err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = transform.GlobalFile(context.Background(), checker, "SCP", bad, out, "13_bad")
+	assert.Error(t, err)
+}
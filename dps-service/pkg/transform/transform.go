@@ -0,0 +1,283 @@
+// Package transform splits the global workbooks into the data files of their sheets, in place of NiFi.
+package transform
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/constants"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"go.uber.org/zap"
+)
+
+// Sheets of the global workbook split into data files
+const (
+	Servers         = "servers"
+	VirtualMachines = "virtualmachines"
+	Desktops        = "desktops"
+	Products        = "products"
+	Applications    = "applications"
+	AcquiredRights  = "acquiredRights"
+)
+
+// InvalidValue is the action of a row with a value which cannot be written in a data file
+const InvalidValue = "invalidValue"
+
+// Sheets are the sheets of the global workbook split into data files
+var Sheets = []string{Servers, VirtualMachines, Desktops, Products, Applications, AcquiredRights}
+
+// Row is a row of a sheet which passed the checks, its values are given by lower case header
+type Row map[string]string
+
+// RowError is a row of a global workbook left out of the data files
+type RowError struct {
+	Sheet  string `json:"sheet"`
+	Row    int    `json:"row"`
+	Cell   string `json:"cell"`
+	Action string `json:"action"`
+	Reason string `json:"reason"`
+}
+
+// Checker checks the global workbooks as the data analysis does
+type Checker interface {
+	// CheckGlobalFile gives the rows of the sheets of the workbook which pass the checks and the rows left out
+	CheckGlobalFile(ctx context.Context, workbook string) (map[string][]Row, []RowError, error)
+	// CoreFactor gives the oracle core factor of the cpu model
+	CoreFactor(manufacturer, model string) float64
+}
+
+// Writable tells that the value can be written in a cell of a data file, the data files
+// are read line by line and split on the delimiter which cannot be escaped
+func Writable(value string) bool {
+	return !strings.ContainsAny(value, constants.DELIMETER+"\r\n")
+}
+
+func (r Row) lower(header string) string {
+	return strings.ToLower(r[header])
+}
+
+// filled tells that none of the headers is blank
+func (r Row) filled(headers ...string) bool {
+	for _, h := range headers {
+		if r[h] == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// swidtag is the swidtag of the product of the row, it is built as NiFi did from its name, editor and version
+func (r Row) swidtag() string {
+	tag := r["product_name"] + "_" + r["product_editor"]
+	if r["product_version"] != "" {
+		tag += "_" + r["product_version"]
+	}
+	return strings.ReplaceAll(strings.Join(strings.Fields(tag), "_"), "-", "_")
+}
+
+// applicationID is the application of the row, its name when it has no id
+func (r Row) applicationID() string {
+	if r["application_id"] != "" {
+		return r.lower("application_id")
+	}
+	return r.lower("application_name")
+}
+
+// number is the value of a numeric column, 0 when it is blank
+func (r Row) number(header string) float64 {
+	v, _ := strconv.ParseFloat(r[header], 64)
+	return v
+}
+
+// dataFile is a data file split from a sheet of the global workbook, a row of the sheet
+// gives at most one record and identical records are written once
+type dataFile struct {
+	fileType string
+	sheet    string
+	headers  []string
+	record   func(r Row, c Checker) ([]string, bool)
+}
+
+var dataFiles = []dataFile{
+	{
+		fileType: "equipment_server",
+		sheet:    Servers,
+		headers:  []string{"server_id", "server_name", "server_type", "server_os", "cpu_model", "cpu_manufacturer", "cores_per_processor", "oracle_core_factor", "ibm_pvu", "sag_uvu", "server_processors_numbers", "hyperthreading", "datacenter_name", "environment", "parent_id"},
+		record: func(r Row, c Checker) ([]string, bool) {
+			if !r.filled("server_id", "cpu_model", "cores_per_processor", "cpu_manufacturer", "server_processors_numbers") {
+				return nil, false
+			}
+			coreFactor := r["oracle_core_factor"]
+			if coreFactor == "" {
+				coreFactor = formatNumber(c.CoreFactor(r["cpu_manufacturer"], r["cpu_model"]))
+			}
+			return []string{r.lower("server_id"), r.lower("server_name"), r.lower("server_type"), r.lower("server_os"), r.lower("cpu_model"), r["cpu_manufacturer"], r["cores_per_processor"], coreFactor, r["ibm_pvu"], r["sag_uvu"], r["server_processors_numbers"], r.lower("hyperthreading"), r.lower("datacenter_name"), r.lower("environment"), r.lower("cluster_name")}, true
+		},
+	},
+	{
+		fileType: "equipment_cluster",
+		sheet:    Servers,
+		headers:  []string{"cluster_name", "parent_id"},
+		record: func(r Row, _ Checker) ([]string, bool) {
+			return []string{r.lower("cluster_name"), r.lower("vcenter_name")}, r.filled("cluster_name")
+		},
+	},
+	{
+		fileType: "equipment_vcenter",
+		sheet:    Servers,
+		headers:  []string{"vcenter_name", "vcenter_version"},
+		record: func(r Row, _ Checker) ([]string, bool) {
+			return []string{r.lower("vcenter_name"), r.lower("vcenter_version")}, r.filled("vcenter_name")
+		},
+	},
+	{
+		fileType: "equipment_virtualmachine",
+		sheet:    VirtualMachines,
+		headers:  []string{"vm_id", "vm_name", "vcpu", "environment", "parent_id"},
+		record: func(r Row, _ Checker) ([]string, bool) {
+			return []string{r.lower("vm_id"), r.lower("vm_name"), r["vcpu"], r.lower("environment"), r.lower("server_id")}, r.filled("vm_id", "server_id")
+		},
+	},
+	{
+		fileType: "equipment_desktop",
+		sheet:    Desktops,
+		headers:  []string{"desktop_name"},
+		record: func(r Row, _ Checker) ([]string, bool) {
+			return []string{r.lower("desktop_name")}, r.filled("desktop_name")
+		},
+	},
+	{
+		fileType: "products",
+		sheet:    Products,
+		headers:  []string{"swidtag", "name", "editor", "version", "flag"},
+		record: func(r Row, _ Checker) ([]string, bool) {
+			return []string{r.swidtag(), r["product_name"], r["product_editor"], r["product_version"], "1"}, r.filled("product_name", "product_editor")
+		},
+	},
+	{
+		fileType: "products_equipments",
+		sheet:    Products,
+		headers:  []string{"swidtag", "equipment_id", "allocated_metric", "allocated_users", "flag"},
+		record: func(r Row, _ Checker) ([]string, bool) {
+			return []string{r.swidtag(), r.lower("host_id"), r["allocated_metric"], r["allocated_users"], "1"}, r.filled("product_name", "product_editor", "host_id")
+		},
+	},
+	{
+		fileType: "applications",
+		sheet:    Applications,
+		headers:  []string{"application_id", "name", "environment", "domain", "flag"},
+		record: func(r Row, _ Checker) ([]string, bool) {
+			return []string{r.applicationID(), r.lower("application_name"), r["environment"], r.lower("domain"), "1"}, r.filled("application_name")
+		},
+	},
+	{
+		fileType: "applications_products",
+		sheet:    Applications,
+		headers:  []string{"application_id", "swidtag", "flag"},
+		record: func(r Row, _ Checker) ([]string, bool) {
+			return []string{r.applicationID(), r.swidtag(), "1"}, r.filled("application_name", "product_name", "product_editor")
+		},
+	},
+	{
+		fileType: "application_equipments",
+		sheet:    Applications,
+		headers:  []string{"application_id", "equipment_id", "flag"},
+		record: func(r Row, _ Checker) ([]string, bool) {
+			return []string{r.applicationID(), r.lower("host_id"), "1"}, r.filled("application_name", "host_id")
+		},
+	},
+	{
+		fileType: "products_acquiredRights",
+		sheet:    AcquiredRights,
+		headers:  []string{"swidtag", "sku", "corporate_sourcing_contract", "ordering_date", "product_name", "editor", "product_version", "software_provider", "metric", "acquired_licenses", "maintenance_licenses", "unit_price", "maintenance_unit_price", "maintenance_provider", "maintenance_start", "maintenance_end", "last_purchased_order", "support_number", "total_license_cost", "total_maintenance_cost", "total_cost", "flag"},
+		record: func(r Row, _ Checker) ([]string, bool) {
+			if !r.filled("sku", "product_name", "product_editor", "metric", "acquired_licenses") {
+				return nil, false
+			}
+			licenseCost, maintenanceCost := "", formatNumber(r.number("maintenance_unit_price")*r.number("maintenance_licences"))
+			if r["unit_price"] != "" {
+				licenseCost = formatNumber(r.number("unit_price") * r.number("acquired_licenses"))
+			}
+			totalCost := formatNumber(r.number("unit_price")*r.number("acquired_licenses") + r.number("maintenance_unit_price")*r.number("maintenance_licences"))
+			return []string{r.swidtag(), r["sku"], r["csc"], r["ordering_date"], r["product_name"], r["product_editor"], r["product_version"], r["software_provider"], r["metric"], r["acquired_licenses"], r["maintenance_licences"], r["unit_price"], r["maintenance_unit_price"], r["maintenance_provider"], r["maintenance_start"], r["maintenance_end"], r["last_po"], r["support_number"], licenseCost, maintenanceCost, totalCost, "1"}, true
+		},
+	},
+}
+
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// GlobalFile splits the sheets of a global workbook of the scope into its data files in dir.
+// The files are named as NiFi named them, the prefix followed by the scope and the file type.
+// The rows failing the checks of the checker are left out of the files and reported.
+func GlobalFile(ctx context.Context, checker Checker, scope, workbook, dir, prefix string) ([]string, []RowError, error) {
+	sheetRows, rowErrors, err := checker.CheckGlobalFile(ctx, workbook)
+	if err != nil {
+		return nil, nil, err
+	}
+	var files []string
+	for _, df := range dataFiles {
+		fileName := fmt.Sprintf("%s%s%s_%s.csv", prefix, constants.NifiFileDelimeter, scope, df.fileType)
+		written, err := writeDataFile(filepath.Join(dir, fileName), df, sheetRows[df.sheet], checker)
+		if err != nil {
+			logger.Log.Error("Failed to write data file of global file", zap.Error(err), zap.String("file", fileName))
+			for _, f := range files {
+				os.Remove(filepath.Join(dir, f))
+			}
+			return nil, nil, fmt.Errorf("DataFileCreationError")
+		}
+		if written {
+			files = append(files, fileName)
+		}
+	}
+	return files, rowErrors, nil
+}
+
+// writeDataFile writes the distinct records of the rows, no file is written without record
+func writeDataFile(file string, df dataFile, rows []Row, checker Checker) (bool, error) {
+	var records []string
+	seen := make(map[string]bool)
+	for _, r := range rows {
+		rec, ok := df.record(r, checker)
+		if !ok {
+			continue
+		}
+		for _, v := range rec {
+			if !Writable(v) {
+				return false, fmt.Errorf("value %q of %s cannot be written", v, df.fileType)
+			}
+		}
+		line := strings.Join(rec, constants.DELIMETER)
+		if seen[line] {
+			continue
+		}
+		seen[line] = true
+		records = append(records, line)
+	}
+	if len(records) == 0 {
+		return false, nil
+	}
+	f, err := os.Create(file)
+	if err != nil {
+		return false, err
+	}
+	w := bufio.NewWriter(f)
+	fmt.Fprintln(w, strings.Join(df.headers, constants.DELIMETER))
+	for _, line := range records {
+		fmt.Fprintln(w, line)
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		os.Remove(file)
+		return false, err
+	}
+	return true, f.Close()
+}
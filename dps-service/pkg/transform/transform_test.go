@@ -0,0 +1,71 @@
+package transform
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testChecker gives the same checked rows for every workbook
+type testChecker struct {
+	rows      map[string][]Row
+	rowErrors []RowError
+	err       error
+}
+
+func (c testChecker) CheckGlobalFile(context.Context, string) (map[string][]Row, []RowError, error) {
+	return c.rows, c.rowErrors, c.err
+}
+
+func (c testChecker) CoreFactor(manufacturer, model string) float64 {
+	return 0.5
+}
+
+func Test_GlobalFile(t *testing.T) {
+	logger.Init(-1, "")
+	out := t.TempDir()
+	rejected := []RowError{{Sheet: Products, Row: 3, Cell: "A3", Action: InvalidValue, Reason: "delimiter"}}
+	checker := testChecker{
+		rows: map[string][]Row{
+			Servers: {
+				{"server_id": "SRV1", "cpu_model": "Xeon", "cpu_manufacturer": "Intel", "cores_per_processor": "8", "server_processors_numbers": "2"},
+			},
+			Products: {
+				{"product_name": "Oracle DB", "product_editor": "Oracle", "host_id": "SRV1"},
+				{"product_name": "Oracle DB", "product_editor": "Oracle", "host_id": "SRV1"},
+				{"product_name": "DB2"},
+			},
+		},
+		rowErrors: rejected,
+	}
+	files, rowErrors, err := GlobalFile(context.Background(), checker, "SCP", "global.xlsx", out, "12_global")
+	if !assert.Empty(t, err) {
+		return
+	}
+	assert.Equal(t, rejected, rowErrors)
+	assert.Equal(t, []string{"12_global#SCP_equipment_server.csv", "12_global#SCP_products.csv", "12_global#SCP_products_equipments.csv"}, files)
+	data, _ := ioutil.ReadFile(filepath.Join(out, "12_global#SCP_products.csv"))
+	assert.Equal(t, "swidtag;name;editor;version;flag\nOracle_DB_Oracle;Oracle DB;Oracle;;1\n", string(data))
+	data, _ = ioutil.ReadFile(filepath.Join(out, "12_global#SCP_equipment_server.csv"))
+	assert.Contains(t, string(data), "\nsrv1;;;;xeon;Intel;8;0.5;")
+
+	// a value which cannot be written fails the transformation without leaving data files
+	checker.rows[Products] = append(checker.rows[Products], Row{"product_name": "Oracle;DB", "product_editor": "Oracle"})
+	_, _, err = GlobalFile(context.Background(), checker, "SCP", "global.xlsx", t.TempDir(), "13_global")
+	assert.Error(t, err)
+
+	_, _, err = GlobalFile(context.Background(), testChecker{err: errors.New("BadOrCorruptFile")}, "SCP", "global.xlsx", out, "14_global")
+	assert.Error(t, err)
+}
+
+func Test_Writable(t *testing.T) {
+	assert.True(t, Writable("Oracle Database"))
+	assert.False(t, Writable("Oracle;Database"))
+	assert.False(t, Writable("Oracle\nDatabase"))
+}
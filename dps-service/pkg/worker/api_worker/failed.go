@@ -0,0 +1,358 @@
+package apiworker
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/config"
+	gendb "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/constants"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/models"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/job"
+
+	excel "github.com/xuri/excelize/v2"
+	"go.uber.org/zap"
+)
+
+// columns of the error workbook around the columns of the failed records
+const (
+	FailedRecordsSheet = "failed_records"
+	JobIDColumn        = "job_id"
+	ReasonColumn       = "reason"
+	ColumnHintColumn   = "column_hint"
+	retriedComment     = "RETRIED"
+)
+
+// ErrRecordCountChanged is returned when a corrected row does not hold as many records as the failed job
+var ErrRecordCountChanged = errors.New("the number of records of the row cannot change")
+
+// ErrNotRetryable is returned for the records rejected by the checks of the file, they have no call to retry
+var ErrNotRetryable = errors.New("the record was rejected by the checks of the file, it is retried by uploading the file again")
+
+// Retryable tells that the envelope holds a call of the api worker, the invalid, duplicate and
+// quality records of a file are kept as failed jobs without a call
+func Retryable(env models.Envlope) bool {
+	_, ok := dataToRPCMappings[env.TargetRPC][env.TargetAction]
+	return ok
+}
+
+// CorrectedRow is a row of a corrected error workbook
+type CorrectedRow struct {
+	Row    int
+	JobID  int32 // 0 when the job_id cell is not valid
+	Values map[string]string
+}
+
+// ErrorWorkbookName gives the name of the error workbook of the data file
+func ErrorWorkbookName(file gendb.UploadedDataFile) string {
+	return fmt.Sprintf("failed_%d_%s.xlsx", file.UploadID, strings.TrimSuffix(file.FileName, filepath.Ext(file.FileName)))
+}
+
+// ErrorWorkbookDir gives the errors directory of the scope which holds the error workbooks
+func ErrorWorkbookDir(scope string) string {
+	return fmt.Sprintf("%s/%s/errors", config.GetConfig().RawdataLocation, scope)
+}
+
+// WriteErrorWorkbook writes a workbook with a row for each failed job of the data file, its record,
+// the failure reason and the column the reason points at. It gives the number of rows, the workbook
+// is removed once no job is failed.
+func WriteErrorWorkbook(ctx context.Context, q gendb.Querier, file gendb.UploadedDataFile) (int, error) {
+	name := filepath.Join(ErrorWorkbookDir(file.Scope), ErrorWorkbookName(file))
+	failed, err := q.GetFailedJobs(ctx, json.RawMessage(fmt.Sprintf("%d", file.UploadID)))
+	if err != nil {
+		return 0, err
+	}
+	if len(failed) == 0 {
+		if err := os.Remove(name); err != nil && !os.IsNotExist(err) {
+			return 0, err
+		}
+		return 0, nil
+	}
+	records := make([]map[string]string, len(failed))
+	columnSet := make(map[string]bool)
+	for i, f := range failed {
+		var env models.Envlope
+		if err := json.Unmarshal(f.Data, &env); err != nil {
+			logger.Log.Error("Failed to unmarshal failed job", zap.Int32("job", f.JobID), zap.Error(err))
+			continue
+		}
+		if records[i], err = recordCells(env.Data); err != nil {
+			logger.Log.Error("Failed to read failed record", zap.Int32("job", f.JobID), zap.Error(err))
+			continue
+		}
+		for c := range records[i] {
+			columnSet[c] = true
+		}
+	}
+	columns := make([]string, 0, len(columnSet))
+	for c := range columnSet {
+		columns = append(columns, c)
+	}
+	sort.Strings(columns)
+
+	fp := excel.NewFile()
+	fp.SetSheetName("Sheet1", FailedRecordsSheet)
+	header := []interface{}{JobIDColumn}
+	for _, c := range columns {
+		header = append(header, c)
+	}
+	header = append(header, ReasonColumn, ColumnHintColumn)
+	if err := fp.SetSheetRow(FailedRecordsSheet, "A1", &header); err != nil {
+		return 0, err
+	}
+	for i, f := range failed {
+		row := []interface{}{f.JobID}
+		for _, c := range columns {
+			row = append(row, records[i][c])
+		}
+		row = append(row, f.Comments.String, columnHint(f.Comments.String, columns))
+		if err := fp.SetSheetRow(FailedRecordsSheet, fmt.Sprintf("A%d", i+2), &row); err != nil {
+			return 0, err
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(name), os.ModePerm); err != nil {
+		return 0, err
+	}
+	return len(failed), fp.SaveAs(name)
+}
+
+// recordCells formats the fields of a record as cells, the objects and lists are kept as json
+func recordCells(data json.RawMessage) (map[string]string, error) {
+	record := make(map[string]interface{})
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&record); err != nil {
+		return nil, err
+	}
+	cells := make(map[string]string, len(record))
+	for k, v := range record {
+		switch val := v.(type) {
+		case nil:
+			cells[k] = ""
+		case string:
+			cells[k] = val
+		case json.Number:
+			cells[k] = val.String()
+		case bool:
+			cells[k] = strconv.FormatBool(val)
+		default:
+			b, _ := json.Marshal(val)
+			cells[k] = string(b)
+		}
+	}
+	return cells, nil
+}
+
+// columnHint gives the first column named in the failure reason, the validation errors name the
+// field of the request as in "invalid UpsertProductRequest.SwidTag" for the column swid_tag.
+func columnHint(reason string, columns []string) string {
+	byName := make(map[string]string, len(columns))
+	for _, c := range columns {
+		byName[hintName(c)] = c
+	}
+	words := strings.FieldsFunc(reason, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+	for _, w := range words {
+		if c, ok := byName[hintName(w)]; ok {
+			return c
+		}
+	}
+	return ""
+}
+
+func hintName(s string) string {
+	return strings.ToLower(strings.ReplaceAll(s, "_", ""))
+}
+
+// ReadErrorWorkbook reads the rows of a corrected error workbook
+func ReadErrorWorkbook(file string) ([]CorrectedRow, error) {
+	fp, err := excel.OpenFile(file)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := fp.GetRows(FailedRecordsSheet)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("sheet %s is empty", FailedRecordsSheet)
+	}
+	headers := rows[0]
+	jobCol := -1
+	for i, h := range headers {
+		if h == JobIDColumn {
+			jobCol = i
+		}
+	}
+	if jobCol < 0 {
+		return nil, fmt.Errorf("column %s is missing", JobIDColumn)
+	}
+	var out []CorrectedRow
+	for i, row := range rows[1:] {
+		if strings.TrimSpace(strings.Join(row, "")) == "" {
+			continue
+		}
+		corrected := CorrectedRow{Row: i + 2, Values: make(map[string]string)}
+		for j, h := range headers {
+			val := ""
+			if j < len(row) {
+				val = row[j]
+			}
+			switch h {
+			case JobIDColumn:
+				if id, err := strconv.ParseInt(strings.TrimSpace(val), 10, 32); err == nil && id > 0 {
+					corrected.JobID = int32(id)
+				}
+			case ReasonColumn, ColumnHintColumn, "":
+			default:
+				corrected.Values[h] = val
+			}
+		}
+		out = append(out, corrected)
+	}
+	return out, nil
+}
+
+// CorrectRecord applies the corrected cells to the record of a failed job, the cells are converted
+// back to the type of the field in the record.
+func CorrectRecord(data json.RawMessage, values map[string]string) (json.RawMessage, error) {
+	record := make(map[string]interface{})
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&record); err != nil {
+		return nil, err
+	}
+	for col, val := range values {
+		old, ok := record[col]
+		switch old.(type) {
+		case json.Number:
+			val = strings.TrimSpace(val)
+			if val == "" {
+				delete(record, col)
+				continue
+			}
+			if _, err := strconv.ParseFloat(val, 64); err != nil {
+				return nil, fmt.Errorf("column %s expects a number", col)
+			}
+			record[col] = json.Number(val)
+		case bool:
+			val = strings.TrimSpace(val)
+			if val == "" {
+				delete(record, col)
+				continue
+			}
+			b, err := strconv.ParseBool(val)
+			if err != nil {
+				return nil, fmt.Errorf("column %s expects true or false", col)
+			}
+			record[col] = b
+		case map[string]interface{}, []interface{}:
+			var v interface{}
+			if err := json.Unmarshal([]byte(val), &v); err != nil {
+				return nil, fmt.Errorf("column %s expects a json value", col)
+			}
+			record[col] = v
+		default:
+			if !ok && val == "" {
+				continue
+			}
+			record[col] = val
+		}
+	}
+	return json.Marshal(record)
+}
+
+// RetryFailedJob pushes the failed job again with the corrected record, its records are taken out
+// of the failed records of the file until the new job is done. It returns false when the job is
+// not failed anymore.
+func RetryFailedJob(ctx context.Context, q gendb.Querier, queue workerqueue.Workerqueue, file gendb.UploadedDataFile, failed gendb.GetFailedJobsRow, data json.RawMessage) (bool, error) {
+	var env models.Envlope
+	if err := json.Unmarshal(failed.Data, &env); err != nil {
+		return false, err
+	}
+	if !Retryable(env) {
+		return false, ErrNotRetryable
+	}
+	count := GetDataCountInPayload(env.Data, env.TargetRPC)
+	if GetDataCountInPayload(data, env.TargetRPC) != count {
+		return false, ErrRecordCountChanged
+	}
+	env.Data = data
+	// the retry is not part of the transaction of the injection anymore
+	env.InjectionID = 0
+	payload, err := json.Marshal(env)
+	if err != nil {
+		return false, err
+	}
+	n, err := q.RetryFailedJob(ctx, gendb.RetryFailedJobParams{
+		JobID:    failed.JobID,
+		Comments: sql.NullString{String: retriedComment, Valid: true},
+	})
+	if err != nil || n == 0 {
+		return false, err
+	}
+	if err := q.RetryFileFailedRecords(ctx, gendb.RetryFileFailedRecordsParams{
+		UploadID:      file.UploadID,
+		FileName:      file.FileName,
+		FailedRecords: count,
+	}); err != nil {
+		restoreFailedJob(ctx, q, file, failed, 0)
+		return false, err
+	}
+	if _, err := queue.PushJob(ctx, job.Job{Type: constants.APITYPE, Status: job.JobStatusPENDING, Data: payload, Priority: job.PriorityHigh}, constants.APIWORKER); err != nil {
+		restoreFailedJob(ctx, q, file, failed, count)
+		return false, err
+	}
+	return true, nil
+}
+
+// restoreFailedJob puts back a job which could not be retried and its records in the failed records of the file
+func restoreFailedJob(ctx context.Context, q gendb.Querier, file gendb.UploadedDataFile, failed gendb.GetFailedJobsRow, count int32) {
+	if err := q.RestoreFailedJob(ctx, gendb.RestoreFailedJobParams{JobID: failed.JobID, Status: failed.Status, Comments: failed.Comments}); err != nil {
+		logger.Log.Error("Failed to restore failed job", zap.Int32("job", failed.JobID), zap.Error(err))
+	}
+	if count == 0 {
+		return
+	}
+	resp, err := q.UpdateFileFailedRecord(ctx, gendb.UpdateFileFailedRecordParams{
+		UploadID:      file.UploadID,
+		FileName:      file.FileName,
+		FailedRecords: count,
+	})
+	if err != nil {
+		logger.Log.Error("Failed to restore failed records", zap.Int32("uid", file.UploadID), zap.Error(err))
+		return
+	}
+	if fileStatus := recordsStatus(resp.Issuccess, resp.Isfailed, resp.Ispartial); fileStatus != "" {
+		if err := q.UpdateFileStatus(ctx, gendb.UpdateFileStatusParams{Status: fileStatus, UploadID: file.UploadID, FileName: file.FileName}); err != nil {
+			logger.Log.Error("Failed to restore file status", zap.Int32("uid", file.UploadID), zap.Error(err))
+		}
+	}
+}
+
+// recordsStatus gives the status of a data file once all its records are processed
+func recordsStatus(isSuccess, isFailed, isPartial bool) gendb.UploadStatus {
+	switch {
+	case isSuccess:
+		return gendb.UploadStatusSUCCESS
+	case isFailed:
+		return gendb.UploadStatusFAILED
+	case isPartial:
+		return gendb.UploadStatusPARTIAL
+	}
+	return ""
+}
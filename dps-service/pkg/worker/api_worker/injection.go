@@ -0,0 +1,697 @@
+package apiworker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	application "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/thirdparty/application-service/pkg/api/v1"
+	equipment "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/thirdparty/equipment-service/pkg/api/v1"
+	product "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/thirdparty/product-service/pkg/api/v1"
+
+	gendb "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/constants"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/models"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/job"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	snapshotPageSize int32 = 200
+	snapshotMaxPages int32 = 999
+)
+
+// linkSnapshot is the before image of the links of a product or an application
+type linkSnapshot struct {
+	IDs []string `json:"ids"`
+}
+
+// SnapshotReader reads the current state of the records targeted by the jobs of an injection
+type SnapshotReader struct {
+	scope        string
+	product      product.ProductServiceClient
+	application  application.ApplicationServiceClient
+	equipment    equipment.EquipmentServiceClient
+	applications map[string]*application.Application
+	acqRights    map[string]*product.AcqRights
+	eqTypes      map[string]*equipment.EquipmentType
+}
+
+// NewSnapshotReader gives a reader of the inventory of the scope
+func NewSnapshotReader(scope string, conn map[string]*grpc.ClientConn) *SnapshotReader {
+	return &SnapshotReader{
+		scope:       scope,
+		product:     product.NewProductServiceClient(conn[constants.ProdService]),
+		application: application.NewApplicationServiceClient(conn[constants.AppService]),
+		equipment:   equipment.NewEquipmentServiceClient(conn[constants.EquipService]),
+	}
+}
+
+// CaptureSnapshots stores the state of the records targeted by the jobs before they are applied,
+// only the first image of a record is kept.
+func CaptureSnapshots(ctx context.Context, q gendb.Querier, r *SnapshotReader, injectionID int32, envlopes []models.Envlope) error {
+	captured := make(map[string]bool)
+	for _, env := range envlopes {
+		snapshots, err := r.before(ctx, env)
+		if err != nil {
+			logger.Log.Error("Failed to read the state before injection", zap.Error(err), zap.Int32("injection", injectionID), zap.String("rpc", env.TargetRPC))
+			return err
+		}
+		for _, snap := range snapshots {
+			if captured[snap.Entity+"/"+snap.RecordKey] {
+				continue
+			}
+			captured[snap.Entity+"/"+snap.RecordKey] = true
+			snap.InjectionID = injectionID
+			if err := q.InsertInjectionSnapshot(ctx, snap); err != nil {
+				logger.Log.Error("Failed to store the state before injection", zap.Error(err), zap.Int32("injection", injectionID))
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// before gives the snapshots of the records targeted by the job
+func (r *SnapshotReader) before(ctx context.Context, env models.Envlope) ([]gendb.InsertInjectionSnapshotParams, error) {
+	switch env.TargetRPC {
+	case constants.PRODUCTS:
+		req := product.UpsertProductRequest{}
+		if err := json.Unmarshal(env.Data, &req); err != nil {
+			return nil, err
+		}
+		resp, err := r.product.GetProductDetail(ctx, &product.ProductRequest{SwidTag: req.SwidTag, Scope: r.scope})
+		if status.Code(err) == codes.NotFound {
+			return []gendb.InsertInjectionSnapshotParams{notExisting(env.TargetRPC, req.SwidTag)}, nil
+		} else if err != nil {
+			return nil, err
+		}
+		return existing(env.TargetRPC, req.SwidTag, product.UpsertProductRequest{
+			SwidTag: req.SwidTag,
+			Name:    resp.ProductName,
+			Version: resp.Version,
+			Editor:  resp.Editor,
+			Scope:   r.scope,
+		})
+
+	case constants.APPLICATIONS:
+		req := application.UpsertApplicationRequest{}
+		if err := json.Unmarshal(env.Data, &req); err != nil {
+			return nil, err
+		}
+		apps, err := r.existingApplications(ctx)
+		if err != nil {
+			return nil, err
+		}
+		app, ok := apps[req.ApplicationId]
+		if !ok && env.TargetAction == constants.DELETE {
+			return nil, nil
+		} else if !ok {
+			return []gendb.InsertInjectionSnapshotParams{notExisting(env.TargetRPC, req.ApplicationId)}, nil
+		}
+		return existing(env.TargetRPC, req.ApplicationId, application.UpsertApplicationRequest{
+			ApplicationId: app.ApplicationId,
+			Name:          app.Name,
+			Environment:   app.Environment,
+			Domain:        app.Domain,
+			Scope:         r.scope,
+		})
+
+	case constants.ProductsAcquiredRights:
+		req := product.UpsertAcqRightsRequest{}
+		if err := json.Unmarshal(env.Data, &req); err != nil {
+			return nil, err
+		}
+		acqs, err := r.existingAcqRights(ctx)
+		if err != nil {
+			return nil, err
+		}
+		acq, ok := acqs[req.Sku]
+		if !ok {
+			return []gendb.InsertInjectionSnapshotParams{notExisting(env.TargetRPC, req.Sku)}, nil
+		}
+		return existing(env.TargetRPC, req.Sku, acqRightToUpsert(acq, r.scope))
+
+	case constants.EQUIPMENTS:
+		req := models.EquipmentRequest{}
+		if err := json.Unmarshal(env.Data, &req); err != nil {
+			return nil, err
+		}
+		return r.equipmentBefore(ctx, env.TargetRPC, req)
+
+	case constants.ApplicationsProducts:
+		req := product.UpsertProductRequest{}
+		if err := json.Unmarshal(env.Data, &req); err != nil {
+			return nil, err
+		}
+		resp, err := r.product.GetApplicationsByProduct(ctx, &product.GetApplicationsByProductRequest{Scope: r.scope, Swidtag: req.SwidTag})
+		if err != nil && status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		return existing(env.TargetRPC, req.SwidTag, linkSnapshot{IDs: resp.GetApplicationId()})
+
+	case constants.ProductsEquipments:
+		req := product.UpsertProductRequest{}
+		if err := json.Unmarshal(env.Data, &req); err != nil {
+			return nil, err
+		}
+		resp, err := r.product.GetEquipmentsByProduct(ctx, &product.GetEquipmentsByProductRequest{Scope: r.scope, SwidTag: req.SwidTag})
+		if err != nil && status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		return existing(env.TargetRPC, req.SwidTag, linkSnapshot{IDs: resp.GetEquipmentId()})
+
+	case constants.ApplicationEquipments:
+		req := application.UpsertApplicationEquipRequest{}
+		if err := json.Unmarshal(env.Data, &req); err != nil {
+			return nil, err
+		}
+		resp, err := r.application.GetEquipmentsByApplication(ctx, &application.GetEquipmentsByApplicationRequest{Scope: r.scope, ApplicationId: req.ApplicationId})
+		if err != nil && status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		return existing(env.TargetRPC, req.ApplicationId, linkSnapshot{IDs: resp.GetEquipmentId()})
+	}
+	return nil, nil
+}
+
+func (r *SnapshotReader) equipmentBefore(ctx context.Context, entity string, req models.EquipmentRequest) ([]gendb.InsertInjectionSnapshotParams, error) {
+	eqType, id, err := r.equipmentID(ctx, req)
+	if err != nil || id == "" {
+		return nil, err
+	}
+	key := strings.ToLower(req.EqType) + "/" + id
+	resp, err := r.equipment.GetEquipment(ctx, &equipment.GetEquipmentRequest{TypeId: eqType.ID, EquipId: id, Scopes: []string{r.scope}})
+	if status.Code(err) == codes.NotFound {
+		return []gendb.InsertInjectionSnapshotParams{notExisting(entity, key)}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	// the equipment is read by attribute names and upserted by file columns
+	attrs := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(resp.Equipment), &attrs); err != nil {
+		return nil, err
+	}
+	old := make(map[string]interface{})
+	for _, attr := range eqType.Attributes {
+		if v, ok := attrs[attr.Name]; ok && attr.MappedTo != "" {
+			old[attr.MappedTo] = v
+		}
+	}
+	data, err := json.Marshal(old)
+	if err != nil {
+		return nil, err
+	}
+	return existing(entity, key, models.EquipmentRequest{Scope: r.scope, EqType: req.EqType, EqData: data})
+}
+
+// EquipmentKey gives the key of the equipment upserted by the request, empty when its type has no primary key
+func (r *SnapshotReader) EquipmentKey(ctx context.Context, req models.EquipmentRequest) (string, error) {
+	_, id, err := r.equipmentID(ctx, req)
+	if err != nil || id == "" {
+		return "", err
+	}
+	return strings.ToLower(req.EqType) + "/" + id, nil
+}
+
+// EquipmentIDColumn gives the column of the files of the equipment type holding the value of its primary key attribute,
+// empty when the type is not known or has no primary key
+func (r *SnapshotReader) EquipmentIDColumn(ctx context.Context, eqType string) (string, error) {
+	eqTypes, err := r.equipmentTypes(ctx)
+	if err != nil {
+		return "", err
+	}
+	t, ok := eqTypes[strings.ToLower(eqType)]
+	if !ok {
+		return "", nil
+	}
+	for _, attr := range t.Attributes {
+		if attr.PrimaryKey {
+			return attr.MappedTo, nil
+		}
+	}
+	return "", nil
+}
+
+// equipmentID gives the type of the equipment and the value of its primary key attribute
+func (r *SnapshotReader) equipmentID(ctx context.Context, req models.EquipmentRequest) (*equipment.EquipmentType, string, error) {
+	eqTypes, err := r.equipmentTypes(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	eqType, ok := eqTypes[strings.ToLower(req.EqType)]
+	if !ok {
+		return nil, "", nil
+	}
+	rec := make(map[string]interface{})
+	if err := json.Unmarshal(req.EqData, &rec); err != nil {
+		return nil, "", err
+	}
+	var id string
+	for _, attr := range eqType.Attributes {
+		if !attr.PrimaryKey {
+			continue
+		}
+		for k, v := range rec {
+			if strings.EqualFold(k, attr.MappedTo) && v != nil {
+				id = fmt.Sprint(v)
+			}
+		}
+	}
+	return eqType, id, nil
+}
+
+func (r *SnapshotReader) existingApplications(ctx context.Context) (map[string]*application.Application, error) {
+	if r.applications != nil {
+		return r.applications, nil
+	}
+	apps := make(map[string]*application.Application)
+	err := readPages(func(page int32) (int32, error) {
+		resp, err := r.application.ListApplications(ctx, &application.ListApplicationsRequest{
+			PageNum:  page,
+			PageSize: snapshotPageSize,
+			SortBy:   application.ListApplicationsRequest_name,
+			Scopes:   []string{r.scope},
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, app := range resp.Applications {
+			apps[app.ApplicationId] = app
+		}
+		return resp.TotalRecords, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.applications = apps
+	return apps, nil
+}
+
+func (r *SnapshotReader) existingAcqRights(ctx context.Context) (map[string]*product.AcqRights, error) {
+	if r.acqRights != nil {
+		return r.acqRights, nil
+	}
+	acqs := make(map[string]*product.AcqRights)
+	err := readPages(func(page int32) (int32, error) {
+		resp, err := r.product.ListAcqRights(ctx, &product.ListAcqRightsRequest{
+			PageNum:  page,
+			PageSize: snapshotPageSize,
+			Scopes:   []string{r.scope},
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, acq := range resp.AcquiredRights {
+			acqs[acq.SKU] = acq
+		}
+		return resp.TotalRecords, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.acqRights = acqs
+	return acqs, nil
+}
+
+func (r *SnapshotReader) equipmentTypes(ctx context.Context) (map[string]*equipment.EquipmentType, error) {
+	if r.eqTypes != nil {
+		return r.eqTypes, nil
+	}
+	resp, err := r.equipment.EquipmentsTypes(ctx, &equipment.EquipmentTypesRequest{Scopes: []string{r.scope}})
+	if err != nil {
+		return nil, err
+	}
+	r.eqTypes = make(map[string]*equipment.EquipmentType)
+	for _, t := range resp.EquipmentTypes {
+		r.eqTypes[strings.ToLower(t.Type)] = t
+	}
+	return r.eqTypes, nil
+}
+
+// readPages calls list for each page until the total number of records is read
+func readPages(list func(page int32) (int32, error)) error {
+	for page := int32(1); page <= snapshotMaxPages; page++ {
+		total, err := list(page)
+		if err != nil {
+			return err
+		}
+		if page*snapshotPageSize >= total {
+			break
+		}
+	}
+	return nil
+}
+
+func notExisting(entity, key string) gendb.InsertInjectionSnapshotParams {
+	return gendb.InsertInjectionSnapshotParams{Entity: entity, RecordKey: key, Before: json.RawMessage("{}")}
+}
+
+func existing(entity, key string, before interface{}) ([]gendb.InsertInjectionSnapshotParams, error) {
+	data, err := json.Marshal(before)
+	if err != nil {
+		return nil, err
+	}
+	return []gendb.InsertInjectionSnapshotParams{{Entity: entity, RecordKey: key, Existed: true, Before: data}}, nil
+}
+
+func acqRightToUpsert(acq *product.AcqRights, scope string) *product.UpsertAcqRightsRequest {
+	req := &product.UpsertAcqRightsRequest{
+		Sku:                       acq.SKU,
+		Swidtag:                   acq.SwidTag,
+		ProductName:               acq.ProductName,
+		ProductEditor:             acq.Editor,
+		Version:                   acq.Version,
+		MetricType:                acq.Metric,
+		NumLicensesAcquired:       acq.AcquiredLicensesNumber,
+		NumLicencesMaintainance:   acq.LicensesUnderMaintenanceNumber,
+		AvgUnitPrice:              acq.AvgLicenesUnitPrice,
+		AvgMaintenanceUnitPrice:   acq.AvgMaintenanceUnitPrice,
+		TotalPurchaseCost:         acq.TotalPurchaseCost,
+		TotalMaintenanceCost:      acq.TotalMaintenanceCost,
+		TotalCost:                 acq.TotalCost,
+		CorporateSourcingContract: acq.CorporateSourcingContract,
+		SoftwareProvider:          acq.SoftwareProvider,
+		MaintenanceProvider:       acq.MaintenanceProvider,
+		LastPurchasedOrder:        acq.LastPurchasedOrder,
+		SupportNumber:             acq.SupportNumber,
+		Repartition:               acq.Repartition,
+		Scope:                     scope,
+	}
+	if acq.StartOfMaintenance != nil {
+		req.StartOfMaintenance = acq.StartOfMaintenance.AsTime().Format(time.RFC3339)
+	}
+	if acq.EndOfMaintenance != nil {
+		req.EndOfMaintenance = acq.EndOfMaintenance.AsTime().Format(time.RFC3339)
+	}
+	if acq.OrderingDate != nil {
+		req.OrderingDate = acq.OrderingDate.AsTime().Format(time.RFC3339)
+	}
+	return req
+}
+
+// InjectionJobDone counts a done job of the injection and finishes the injection with its last job
+func InjectionJobDone(ctx context.Context, q gendb.Querier, queue workerqueue.Workerqueue, injectionID int32, failed bool) {
+	var failedJobs int32
+	if failed {
+		failedJobs = 1
+	}
+	inj, err := q.InjectionJobDone(ctx, gendb.InjectionJobDoneParams{InjectionID: injectionID, FailedJobs: failedJobs})
+	if err != nil {
+		logger.Log.Error("Failed to count injection job", zap.Error(err), zap.Int32("injection", injectionID))
+		return
+	}
+	if inj.Status != gendb.InjectionStatusAPPLYING || inj.DoneJobs != inj.TotalJobs {
+		return
+	}
+	FinishInjection(ctx, q, queue, inj)
+}
+
+// FinishInjection commits the injection when all its jobs succeeded, otherwise the injection is compensated
+func FinishInjection(ctx context.Context, q gendb.Querier, queue workerqueue.Workerqueue, inj gendb.Injection) {
+	if inj.FailedJobs == 0 {
+		if err := q.SetInjectionStatus(ctx, gendb.SetInjectionStatusParams{
+			InjectionID: inj.InjectionID,
+			Status:      gendb.InjectionStatusCOMMITTED,
+		}); err != nil {
+			logger.Log.Error("Failed to commit injection", zap.Error(err), zap.Int32("injection", inj.InjectionID))
+		}
+		return
+	}
+	logger.Log.Warn("Injection failed, compensating", zap.Int32("injection", inj.InjectionID), zap.Int32("failedJobs", inj.FailedJobs))
+	if _, _, err := RollbackInjection(ctx, q, queue, inj); err != nil {
+		logger.Log.Error("Failed to compensate injection", zap.Error(err), zap.Int32("injection", inj.InjectionID))
+	}
+}
+
+// RollbackInjection pushes the jobs compensating the injection with the state kept before it, it returns the number
+// of pushed jobs and per entity the created records which cannot be deleted.
+// The rollback is best-effort: the services have no transaction spanning the jobs, the injection is COMPENSATING
+// until every compensating job is done, then COMPENSATED, and its failed compensations and the records which
+// cannot be deleted are left in the inventory.
+func RollbackInjection(ctx context.Context, q gendb.Querier, queue workerqueue.Workerqueue, inj gendb.Injection) (int32, map[string]int32, error) {
+	jobs, err := q.ListInjectionJobs(ctx, inj.InjectionID)
+	if err != nil {
+		logger.Log.Error("Failed to list injection jobs", zap.Error(err), zap.Int32("injection", inj.InjectionID))
+		return 0, nil, err
+	}
+	snapshots, err := q.ListInjectionSnapshots(ctx, inj.InjectionID)
+	if err != nil {
+		logger.Log.Error("Failed to list injection snapshots", zap.Error(err), zap.Int32("injection", inj.InjectionID))
+		return 0, nil, err
+	}
+	compensations, notReverted := compensations(inj, jobs, snapshots)
+	// the jobs are counted before they are pushed so that the last one done finishes the compensation
+	if err := q.StartInjectionCompensation(ctx, gendb.StartInjectionCompensationParams{
+		InjectionID:      inj.InjectionID,
+		CompensatingJobs: int32(len(compensations)),
+		Comments:         notRevertedComments(notReverted),
+	}); err != nil {
+		logger.Log.Error("Failed to set injection status", zap.Error(err), zap.Int32("injection", inj.InjectionID))
+		return 0, notReverted, err
+	}
+	// the records synced by the injection no longer match the inventory
+	if err := q.DeleteSyncedRecords(ctx, inj.Scope); err != nil {
+		logger.Log.Error("Failed to delete synced records", zap.Error(err), zap.String("scope", inj.Scope))
+	}
+	if len(compensations) == 0 {
+		if err := q.FinishInjectionCompensation(ctx, inj.InjectionID); err != nil {
+			logger.Log.Error("Failed to set injection status", zap.Error(err), zap.Int32("injection", inj.InjectionID))
+			return 0, notReverted, err
+		}
+		return 0, notReverted, nil
+	}
+	var pushed int32
+	for _, c := range compensations {
+		data, err := json.Marshal(c)
+		if err == nil {
+			_, err = queue.PushJob(ctx, job.Job{Type: constants.APITYPE, Status: job.JobStatusPENDING, Data: data}, constants.APIWORKER)
+		}
+		if err != nil {
+			logger.Log.Error("Failed to push compensating job", zap.Error(err), zap.Int32("injection", inj.InjectionID))
+			CompensationJobDone(ctx, q, inj.InjectionID, true)
+			continue
+		}
+		pushed++
+	}
+	return pushed, notReverted, nil
+}
+
+// CompensationJobDone counts a done compensating job of the injection and finishes the compensation with its last job
+func CompensationJobDone(ctx context.Context, q gendb.Querier, injectionID int32, failed bool) {
+	var failedJobs int32
+	if failed {
+		failedJobs = 1
+	}
+	inj, err := q.CompensationJobDone(ctx, gendb.CompensationJobDoneParams{InjectionID: injectionID, FailedCompensations: failedJobs})
+	if err != nil {
+		logger.Log.Error("Failed to count compensating job", zap.Error(err), zap.Int32("injection", injectionID))
+		return
+	}
+	if inj.Status != gendb.InjectionStatusCOMPENSATING || inj.CompensatedJobs != inj.CompensatingJobs {
+		return
+	}
+	if inj.FailedCompensations > 0 {
+		logger.Log.Warn("Injection compensated with failures", zap.Int32("injection", injectionID), zap.Int32("failedCompensations", inj.FailedCompensations))
+	}
+	if err := q.FinishInjectionCompensation(ctx, injectionID); err != nil {
+		logger.Log.Error("Failed to set injection status", zap.Error(err), zap.Int32("injection", injectionID))
+	}
+}
+
+// notRevertedComments tells the records created by the injection which are left in the inventory
+func notRevertedComments(notReverted map[string]int32) sql.NullString {
+	if len(notReverted) == 0 {
+		return sql.NullString{}
+	}
+	entities := make([]string, 0, len(notReverted))
+	for entity := range notReverted {
+		entities = append(entities, entity)
+	}
+	sort.Strings(entities)
+	for i, entity := range entities {
+		entities[i] = fmt.Sprintf("%d %s", notReverted[entity], entity)
+	}
+	return sql.NullString{String: "created records not deleted: " + strings.Join(entities, ", "), Valid: true}
+}
+
+// compensations gives the jobs undoing the injection: the records are restored from their snapshot,
+// the created ones are deleted when the service allows it, and the changed links are reversed.
+func compensations(inj gendb.Injection, jobs []gendb.InjectionJob, snapshots []gendb.InjectionSnapshot) ([]models.Envlope, map[string]int32) {
+	var envlopes []models.Envlope
+	notReverted := make(map[string]int32)
+	before := make(map[string]gendb.InjectionSnapshot, len(snapshots))
+	for _, snap := range snapshots {
+		before[snap.Entity+"/"+snap.RecordKey] = snap
+		if isLink(snap.Entity) {
+			continue
+		}
+		env := models.Envlope{
+			TargetService: constants.SERVICES[snap.Entity][0],
+			TargetRPC:     snap.Entity,
+			InjectionID:   inj.InjectionID,
+			Rollback:      true,
+		}
+		if snap.Existed {
+			env.TargetAction = constants.UPSERT
+			env.Data = snap.Before
+			envlopes = append(envlopes, env)
+			continue
+		}
+		var del interface{}
+		switch snap.Entity {
+		case constants.APPLICATIONS:
+			del = &application.DeleteApplicationRequest{ApplicationId: snap.RecordKey}
+		case constants.ProductsAcquiredRights:
+			del = &product.DeleteAcqRightRequest{Sku: snap.RecordKey, Scope: inj.Scope}
+		default:
+			// products and equipments cannot be deleted one by one
+			notReverted[snap.Entity]++
+			continue
+		}
+		data, err := json.Marshal(del)
+		if err != nil {
+			logger.Log.Error("Failed to marshal compensating job", zap.Error(err))
+			continue
+		}
+		env.TargetAction = constants.DELETE
+		env.Data = data
+		envlopes = append(envlopes, env)
+	}
+	// the links are undone from the last job
+	for i := len(jobs) - 1; i >= 0; i-- {
+		env := models.Envlope{}
+		if err := json.Unmarshal(jobs[i].Data, &env); err != nil {
+			logger.Log.Error("Failed to unmarshal injection job", zap.Error(err), zap.Int32("injection", inj.InjectionID))
+			continue
+		}
+		if !isLink(env.TargetRPC) {
+			continue
+		}
+		data, err := reverseLinks(env, before)
+		if err != nil {
+			logger.Log.Error("Failed to build compensating job", zap.Error(err), zap.Int32("injection", inj.InjectionID))
+			continue
+		}
+		if data == nil {
+			continue
+		}
+		env.Data = data
+		env.Rollback = true
+		envlopes = append(envlopes, env)
+	}
+	return envlopes, notReverted
+}
+
+func isLink(entity string) bool {
+	return entity == constants.ApplicationsProducts || entity == constants.ProductsEquipments || entity == constants.ApplicationEquipments
+}
+
+// reverseLinks gives the payload removing the links added by the job which did not exist
+// and adding back the links deleted by the job which existed, nil when nothing changed
+func reverseLinks(env models.Envlope, before map[string]gendb.InjectionSnapshot) (json.RawMessage, error) {
+	switch env.TargetRPC {
+	case constants.ApplicationsProducts:
+		req := product.UpsertProductRequest{}
+		if err := json.Unmarshal(env.Data, &req); err != nil {
+			return nil, err
+		}
+		ids := changedLinks(before, env.TargetRPC, req.SwidTag, req.GetApplications().GetOperation(), req.GetApplications().GetApplicationId())
+		if len(ids) == 0 {
+			return nil, nil
+		}
+		return json.Marshal(product.UpsertProductRequest{
+			SwidTag:      req.SwidTag,
+			Scope:        req.Scope,
+			Applications: &product.UpsertProductRequestApplication{Operation: reverseOperation(req.GetApplications().GetOperation()), ApplicationId: ids},
+		})
+
+	case constants.ProductsEquipments:
+		req := product.UpsertProductRequest{}
+		if err := json.Unmarshal(env.Data, &req); err != nil {
+			return nil, err
+		}
+		var equipIDs []string
+		for _, user := range req.GetEquipments().GetEquipmentusers() {
+			equipIDs = append(equipIDs, user.EquipmentId)
+		}
+		ids := changedLinks(before, env.TargetRPC, req.SwidTag, req.GetEquipments().GetOperation(), equipIDs)
+		if len(ids) == 0 {
+			return nil, nil
+		}
+		var users []*product.UpsertProductRequestEquipmentEquipmentuser
+		for _, user := range req.GetEquipments().GetEquipmentusers() {
+			if contains(ids, user.EquipmentId) {
+				users = append(users, user)
+			}
+		}
+		return json.Marshal(product.UpsertProductRequest{
+			SwidTag:    req.SwidTag,
+			Scope:      req.Scope,
+			Equipments: &product.UpsertProductRequestEquipment{Operation: reverseOperation(req.GetEquipments().GetOperation()), Equipmentusers: users},
+		})
+
+	case constants.ApplicationEquipments:
+		req := application.UpsertApplicationEquipRequest{}
+		if err := json.Unmarshal(env.Data, &req); err != nil {
+			return nil, err
+		}
+		ids := changedLinks(before, env.TargetRPC, req.ApplicationId, req.GetEquipments().GetOperation(), req.GetEquipments().GetEquipmentId())
+		if len(ids) == 0 {
+			return nil, nil
+		}
+		return json.Marshal(application.UpsertApplicationEquipRequest{
+			ApplicationId: req.ApplicationId,
+			Scope:         req.Scope,
+			Equipments:    &application.UpsertApplicationEquipRequestEquipment{Operation: reverseOperation(req.GetEquipments().GetOperation()), EquipmentId: ids},
+		})
+	}
+	return nil, nil
+}
+
+// changedLinks gives the links changed by the operation: the added links which did not exist
+// and the deleted links which existed before the injection
+func changedLinks(before map[string]gendb.InjectionSnapshot, entity, key, operation string, ids []string) []string {
+	snap, ok := before[entity+"/"+key]
+	if !ok {
+		return nil
+	}
+	old := linkSnapshot{}
+	if err := json.Unmarshal(snap.Before, &old); err != nil {
+		return nil
+	}
+	var changed []string
+	for _, id := range ids {
+		if contains(old.IDs, id) == (operation == constants.APIAction[constants.DELETE]) {
+			changed = append(changed, id)
+		}
+	}
+	return changed
+}
+
+func reverseOperation(operation string) string {
+	if operation == constants.APIAction[constants.DELETE] {
+		return constants.APIAction[constants.UPSERT]
+	}
+	return constants.APIAction[constants.DELETE]
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
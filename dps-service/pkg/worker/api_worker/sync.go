@@ -0,0 +1,221 @@
+package apiworker
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	application "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/thirdparty/application-service/pkg/api/v1"
+	product "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/thirdparty/product-service/pkg/api/v1"
+
+	gendb "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/constants"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/models"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"go.uber.org/zap"
+)
+
+// SyncRecord is a record of the inventory upserted or deleted by a job,
+// a link is a record of its own so that the links of a product or an application are synced one by one
+type SyncRecord struct {
+	Entity  string
+	Key     string
+	Parent  string          // product or application of a link
+	Data    json.RawMessage // payload upserting the record alone
+	Deleted bool
+}
+
+// Hash gives the fingerprint of the record compared with the synced one
+func (r SyncRecord) Hash() string {
+	sum := sha256.Sum256(r.Data)
+	return hex.EncodeToString(sum[:])
+}
+
+// SyncRecords gives the records changed by the job, the equipments are known by the RecordKey of the job
+func SyncRecords(env models.Envlope) ([]SyncRecord, error) {
+	switch env.TargetRPC {
+	case constants.PRODUCTS:
+		req := product.UpsertProductRequest{}
+		if err := json.Unmarshal(env.Data, &req); err != nil {
+			return nil, err
+		}
+		return []SyncRecord{{Entity: env.TargetRPC, Key: req.SwidTag, Data: env.Data}}, nil
+
+	case constants.APPLICATIONS:
+		if env.TargetAction == constants.DELETE {
+			req := application.DeleteApplicationRequest{}
+			if err := json.Unmarshal(env.Data, &req); err != nil {
+				return nil, err
+			}
+			return []SyncRecord{{Entity: env.TargetRPC, Key: req.ApplicationId, Data: env.Data, Deleted: true}}, nil
+		}
+		req := application.UpsertApplicationRequest{}
+		if err := json.Unmarshal(env.Data, &req); err != nil {
+			return nil, err
+		}
+		return []SyncRecord{{Entity: env.TargetRPC, Key: req.ApplicationId, Data: env.Data}}, nil
+
+	case constants.ProductsAcquiredRights:
+		if env.TargetAction == constants.DELETE {
+			req := product.DeleteAcqRightRequest{}
+			if err := json.Unmarshal(env.Data, &req); err != nil {
+				return nil, err
+			}
+			return []SyncRecord{{Entity: env.TargetRPC, Key: req.Sku, Data: env.Data, Deleted: true}}, nil
+		}
+		req := product.UpsertAcqRightsRequest{}
+		if err := json.Unmarshal(env.Data, &req); err != nil {
+			return nil, err
+		}
+		return []SyncRecord{{Entity: env.TargetRPC, Key: req.Sku, Data: env.Data}}, nil
+
+	case constants.EQUIPMENTS:
+		if env.RecordKey == "" {
+			return nil, nil
+		}
+		return []SyncRecord{{Entity: env.TargetRPC, Key: env.RecordKey, Data: env.Data}}, nil
+
+	case constants.ApplicationsProducts:
+		req := product.UpsertProductRequest{}
+		if err := json.Unmarshal(env.Data, &req); err != nil {
+			return nil, err
+		}
+		var records []SyncRecord
+		for _, id := range req.GetApplications().GetApplicationId() {
+			data, err := json.Marshal(product.UpsertProductRequest{
+				SwidTag:      req.SwidTag,
+				Scope:        req.Scope,
+				Applications: &product.UpsertProductRequestApplication{Operation: constants.APIAction[constants.UPSERT], ApplicationId: []string{id}},
+			})
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, linkRecord(env.TargetRPC, req.SwidTag, id, req.GetApplications().GetOperation(), data))
+		}
+		return records, nil
+
+	case constants.ProductsEquipments:
+		req := product.UpsertProductRequest{}
+		if err := json.Unmarshal(env.Data, &req); err != nil {
+			return nil, err
+		}
+		var records []SyncRecord
+		for _, user := range req.GetEquipments().GetEquipmentusers() {
+			data, err := json.Marshal(product.UpsertProductRequest{
+				SwidTag:    req.SwidTag,
+				Scope:      req.Scope,
+				Equipments: &product.UpsertProductRequestEquipment{Operation: constants.APIAction[constants.UPSERT], Equipmentusers: []*product.UpsertProductRequestEquipmentEquipmentuser{user}},
+			})
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, linkRecord(env.TargetRPC, req.SwidTag, user.EquipmentId, req.GetEquipments().GetOperation(), data))
+		}
+		return records, nil
+
+	case constants.ApplicationEquipments:
+		req := application.UpsertApplicationEquipRequest{}
+		if err := json.Unmarshal(env.Data, &req); err != nil {
+			return nil, err
+		}
+		var records []SyncRecord
+		for _, id := range req.GetEquipments().GetEquipmentId() {
+			data, err := json.Marshal(application.UpsertApplicationEquipRequest{
+				ApplicationId: req.ApplicationId,
+				Scope:         req.Scope,
+				Equipments:    &application.UpsertApplicationEquipRequestEquipment{Operation: constants.APIAction[constants.UPSERT], EquipmentId: []string{id}},
+			})
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, linkRecord(env.TargetRPC, req.ApplicationId, id, req.GetEquipments().GetOperation(), data))
+		}
+		return records, nil
+	}
+	return nil, nil
+}
+
+func linkRecord(entity, parent, child, operation string, data json.RawMessage) SyncRecord {
+	return SyncRecord{
+		Entity:  entity,
+		Key:     parent + "/" + child,
+		Parent:  parent,
+		Data:    data,
+		Deleted: operation == constants.APIAction[constants.DELETE],
+	}
+}
+
+// LinksPayload gives the payload applying the operation to the links of the same product or application
+func LinksPayload(entity, operation string, links []SyncRecord) (json.RawMessage, error) {
+	switch entity {
+	case constants.ApplicationsProducts:
+		req := product.UpsertProductRequest{Applications: &product.UpsertProductRequestApplication{Operation: operation}}
+		for _, link := range links {
+			one := product.UpsertProductRequest{}
+			if err := json.Unmarshal(link.Data, &one); err != nil {
+				return nil, err
+			}
+			req.SwidTag, req.Scope = one.SwidTag, one.Scope
+			req.Applications.ApplicationId = append(req.Applications.ApplicationId, one.GetApplications().GetApplicationId()...)
+		}
+		return json.Marshal(&req)
+
+	case constants.ProductsEquipments:
+		req := product.UpsertProductRequest{Equipments: &product.UpsertProductRequestEquipment{Operation: operation}}
+		for _, link := range links {
+			one := product.UpsertProductRequest{}
+			if err := json.Unmarshal(link.Data, &one); err != nil {
+				return nil, err
+			}
+			req.SwidTag, req.Scope = one.SwidTag, one.Scope
+			req.Equipments.Equipmentusers = append(req.Equipments.Equipmentusers, one.GetEquipments().GetEquipmentusers()...)
+		}
+		return json.Marshal(&req)
+
+	case constants.ApplicationEquipments:
+		req := application.UpsertApplicationEquipRequest{Equipments: &application.UpsertApplicationEquipRequestEquipment{Operation: operation}}
+		for _, link := range links {
+			one := application.UpsertApplicationEquipRequest{}
+			if err := json.Unmarshal(link.Data, &one); err != nil {
+				return nil, err
+			}
+			req.ApplicationId, req.Scope = one.ApplicationId, one.Scope
+			req.Equipments.EquipmentId = append(req.Equipments.EquipmentId, one.GetEquipments().GetEquipmentId()...)
+		}
+		return json.Marshal(&req)
+	}
+	return nil, nil
+}
+
+// RecordSynced keeps the records applied by the job as the synced state of the scope
+func RecordSynced(ctx context.Context, q gendb.Querier, env models.Envlope) {
+	if env.Scope == "" {
+		return
+	}
+	records, err := SyncRecords(env)
+	if err != nil {
+		logger.Log.Error("Failed to read the synced records of the job", zap.Error(err), zap.String("rpc", env.TargetRPC))
+		return
+	}
+	for _, rec := range records {
+		if rec.Deleted {
+			err = q.DeleteSyncedRecord(ctx, gendb.DeleteSyncedRecordParams{Scope: env.Scope, Entity: rec.Entity, RecordKey: rec.Key})
+		} else {
+			err = q.UpsertSyncedRecord(ctx, gendb.UpsertSyncedRecordParams{
+				Scope:     env.Scope,
+				Entity:    rec.Entity,
+				RecordKey: rec.Key,
+				Hash:      rec.Hash(),
+				Data:      rec.Data,
+				UploadID:  env.UploadID,
+			})
+		}
+		// a record missing from the synced state is pushed again by the next delta
+		if err != nil {
+			logger.Log.Error("Failed to update the synced record", zap.Error(err), zap.String("entity", rec.Entity), zap.String("key", rec.Key))
+		}
+	}
+}
@@ -55,6 +55,7 @@ func init() {
 	dataToRPCMappings[constants.ApplicationsProducts][constants.UPSERT] = sendUpsertProductReq
 	dataToRPCMappings[constants.ProductsEquipments][constants.UPSERT] = sendUpsertProductReq
 	dataToRPCMappings[constants.ProductsAcquiredRights][constants.UPSERT] = sendUpsertAcqRightsReq
+	dataToRPCMappings[constants.ProductsAcquiredRights][constants.DELETE] = sendDeleteAcqRightReq
 }
 
 var rpcTimeOut time.Duration
@@ -178,6 +179,27 @@ func sendUpsertAcqRightsReq(ctx context.Context, data models.Envlope, cc grpc.Cl
 	return
 }
 
+func sendDeleteAcqRightReq(ctx context.Context, data models.Envlope, cc grpc.ClientConnInterface) (err error) {
+	var cancel context.CancelFunc
+	ctx, cancel = context.WithDeadline(ctx, time.Now().Add(time.Second*rpcTimeOut))
+	defer cancel()
+	appData := &product.DeleteAcqRightRequest{}
+	err = json.Unmarshal(data.Data, appData)
+	if err != nil {
+		logger.Log.Sugar().Errorf("Failed to marshal data ", "err", err.Error())
+		return
+	}
+	resp, err := product.NewProductServiceClient(cc).DeleteAcqRight(ctx, appData)
+	if err != nil {
+		logger.Log.Sugar().Errorf("FAILED sendDeleteAcqRightReq err :", "err", err, "for data ", appData)
+		return err
+	}
+	if !resp.Success {
+		logger.Log.Sugar().Errorf("FAILEDTOSEND")
+	}
+	return
+}
+
 func sendUpsertProductReq(ctx context.Context, data models.Envlope, cc grpc.ClientConnInterface) (err error) {
 	var cancel context.CancelFunc
 	ctx, cancel = context.WithDeadline(ctx, time.Now().Add(time.Second*rpcTimeOut))
@@ -19,6 +19,7 @@ import (
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/job"
+	queueworker "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/worker"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -53,7 +54,27 @@ func (w *worker) DoWork(ctx context.Context, j *job.Job) error {
 		logger.Log.Error("Failed to unmarshal job data ", zap.Error(err))
 		return err
 	}
+	if !Retryable(data) {
+		logger.Log.Error("Job has no call of the api worker", zap.String("rpc", data.TargetRPC), zap.String("action", data.TargetAction))
+		return ErrNotRetryable
+	}
+	if err = w.Queue.Throttle(ctx, data.TargetService); err != nil {
+		return err
+	}
 	err = dataToRPCMappings[data.TargetRPC][data.TargetAction](ctx, data, w.grpcServers[data.TargetService])
+	// compensating jobs of an injection are counted in the injection only, not in the file records
+	if data.Rollback {
+		if data.InjectionID > 0 && (err == nil || j.RetryCount.Int32 == w.Queue.GetRetries()) {
+			CompensationJobDone(ctx, w.Queries, data.InjectionID, err != nil)
+		}
+		return err
+	}
+	if err == nil {
+		RecordSynced(ctx, w.Queries, data)
+	}
+	if data.InjectionID > 0 && (err == nil || j.RetryCount.Int32 == w.Queue.GetRetries()) {
+		InjectionJobDone(ctx, w.Queries, w.Queue, data.InjectionID, err != nil)
+	}
 	if data.TargetAction != constants.DROP {
 		if err != nil {
 			if j.RetryCount.Int32 == w.Queue.GetRetries() {
@@ -70,6 +91,36 @@ func (w *worker) DoWork(ctx context.Context, j *job.Job) error {
 	return err
 }
 
+// ErrInjectionJob is returned when replaying a job applying an injection, it would be applied out of the injection
+var ErrInjectionJob = fmt.Errorf("%w: jobs of an injection are applied with their injection", queueworker.ErrNotReplayable)
+
+// Replay takes the records of a dead or failed job out of the failed records of its file before the job
+// is replayed, they are counted again when the replayed job is done.
+func (w *worker) Replay(ctx context.Context, j *job.Job) error {
+	var data models.Envlope
+	if err := json.Unmarshal(j.Data, &data); err != nil {
+		return err
+	}
+	// the records rejected by the checks of a file are kept as failed jobs without a call
+	if !Retryable(data) {
+		return queueworker.ErrNotReplayable
+	}
+	if data.Rollback {
+		return nil
+	}
+	if data.InjectionID > 0 {
+		return ErrInjectionJob
+	}
+	if data.TargetAction == constants.DROP {
+		return nil
+	}
+	return w.Queries.RetryFileFailedRecords(ctx, gendb.RetryFileFailedRecordsParams{
+		UploadID:      data.UploadID,
+		FileName:      data.FileName,
+		FailedRecords: GetDataCountInPayload(data.Data, data.TargetRPC),
+	})
+}
+
 func (w *worker) setFailedOrSuccessRecords(ctx context.Context, data models.Envlope, action string, jobId int32) error {
 	var fileNewStatus gendb.UploadStatus
 	var dbErr error
@@ -80,13 +131,7 @@ func (w *worker) setFailedOrSuccessRecords(ctx context.Context, data models.Envl
 			FileName:      data.FileName,
 			FailedRecords: dataCount,
 		})
-		if resp.Issuccess {
-			fileNewStatus = gendb.UploadStatusSUCCESS
-		} else if resp.Isfailed {
-			fileNewStatus = gendb.UploadStatusFAILED
-		} else if resp.Ispartial {
-			fileNewStatus = gendb.UploadStatusPARTIAL
-		}
+		fileNewStatus = recordsStatus(resp.Issuccess, resp.Isfailed, resp.Ispartial)
 		dbErr = err
 		logger.Log.Debug("UpdateFailedRecord", zap.Any("uid", data.UploadID), zap.Any("file", data.FileName), zap.Any("newfilestatus", fileNewStatus), zap.Any("DBError", dbErr))
 	} else if action == constants.SuccessData {
@@ -95,13 +140,7 @@ func (w *worker) setFailedOrSuccessRecords(ctx context.Context, data models.Envl
 			FileName:       data.FileName,
 			SuccessRecords: dataCount,
 		})
-		if resp.Issuccess {
-			fileNewStatus = gendb.UploadStatusSUCCESS
-		} else if resp.Isfailed {
-			fileNewStatus = gendb.UploadStatusFAILED
-		} else if resp.Ispartial {
-			fileNewStatus = gendb.UploadStatusPARTIAL
-		}
+		fileNewStatus = recordsStatus(resp.Issuccess, resp.Isfailed, resp.Ispartial)
 		dbErr = err
 		logger.Log.Debug("UpdateSuccessRecord", zap.Any("uid", data.UploadID), zap.Any("file", data.FileName), zap.Any("newfilestatus", fileNewStatus), zap.Any("DBError", dbErr))
 	} else {
@@ -88,6 +88,21 @@ const (
 	PARTIAL           string = "PARTIAL"
 )
 
+// Typed data files, the other data files are csv
+const (
+	JSONExtension    string = ".JSON"
+	NDJSONExtension  string = ".NDJSON"
+	ParquetExtension string = ".PARQUET"
+)
+
+// Mapping profile operations
+const (
+	MappingCopy     string = "COPY"
+	MappingConstant string = "CONSTANT"
+	MappingConcat   string = "CONCAT"
+	MappingSplit    string = "SPLIT"
+)
+
 // fileName to Services mapping
 var (
 	SERVICES = map[string][]string{
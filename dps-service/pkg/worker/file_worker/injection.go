@@ -0,0 +1,185 @@
+package fileworker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	gendb "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	apiworker "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/api_worker"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/constants"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/models"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/job"
+
+	"go.uber.org/zap"
+)
+
+// stageJobs keeps the jobs of the file until all the files of the injection are read,
+// the last staged file commits the injection.
+// The jobs and the staged file are written in one transaction, a retried file is staged once.
+func (w *worker) stageJobs(ctx context.Context, injectionID int32, fileName string, jobs []job.Job) (retErr error) {
+	staged, err := w.restageInjectionFile(ctx, injectionID, fileName)
+	if err != nil || staged {
+		return err
+	}
+	tx, err := w.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+				logger.Log.Error("Failed to rollback the staged jobs", zap.Int32("injection", injectionID), zap.Error(err))
+			}
+		}
+	}()
+	q := w.Queries.WithTx(tx)
+	if err := insertInjectionJobs(ctx, q, injectionID, fileName, jobs); err != nil {
+		return err
+	}
+	inj, err := q.StageInjectionFile(ctx, gendb.StageInjectionFileParams{InjectionID: injectionID, FileName: fileName, TotalJobs: int32(len(jobs))})
+	if err == sql.ErrNoRows {
+		if err := tx.Rollback(); err != nil {
+			return err
+		}
+		return w.resumeInjection(ctx, injectionID)
+	} else if err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return w.injectionFileStaged(ctx, inj)
+}
+
+// restageInjectionFile prepares a file of the injection to be staged again, the jobs inserted by a failed try
+// are deleted, it tells if the file was already staged in which case the injection is resumed.
+func (w *worker) restageInjectionFile(ctx context.Context, injectionID int32, fileName string) (bool, error) {
+	staged, err := w.Queries.InjectionFileStaged(ctx, gendb.InjectionFileStagedParams{InjectionID: injectionID, FileName: fileName})
+	if err != nil {
+		return false, err
+	}
+	if staged {
+		logger.Log.Info("Injection file already staged", zap.Int32("injection", injectionID), zap.String("file", fileName))
+		return true, w.resumeInjection(ctx, injectionID)
+	}
+	return false, w.Queries.DeleteInjectionFileJobs(ctx, gendb.DeleteInjectionFileJobsParams{InjectionID: injectionID, FileName: fileName})
+}
+
+// insertInjectionJobs keeps the jobs of a file of the injection until it is committed
+func insertInjectionJobs(ctx context.Context, q *gendb.Queries, injectionID int32, fileName string, jobs []job.Job) error {
+	for _, j := range jobs {
+		env := models.Envlope{}
+		if err := json.Unmarshal(j.Data, &env); err != nil {
+			return err
+		}
+		env.InjectionID = injectionID
+		data, err := json.Marshal(env)
+		if err != nil {
+			return err
+		}
+		if err := q.InsertInjectionJob(ctx, gendb.InsertInjectionJobParams{InjectionID: injectionID, FileName: fileName, Data: data}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stageInjectionFile counts a file of the injection as read with all its jobs inserted,
+// the last staged file commits the injection.
+func (w *worker) stageInjectionFile(ctx context.Context, injectionID int32, fileName string, totalJobs int32) error {
+	inj, err := w.Queries.StageInjectionFile(ctx, gendb.StageInjectionFileParams{InjectionID: injectionID, FileName: fileName, TotalJobs: totalJobs})
+	if err == sql.ErrNoRows {
+		return w.resumeInjection(ctx, injectionID)
+	} else if err != nil {
+		return err
+	}
+	return w.injectionFileStaged(ctx, inj)
+}
+
+// injectionFileStaged commits the injection once all its files are staged
+func (w *worker) injectionFileStaged(ctx context.Context, inj gendb.Injection) error {
+	if inj.StagedFiles < inj.TotalFiles {
+		return nil
+	}
+	return w.commitInjection(ctx, inj)
+}
+
+// resumeInjection carries on an injection whose file is not staged by this try,
+// either the file was staged by a previous try or another file of the injection failed.
+func (w *worker) resumeInjection(ctx context.Context, injectionID int32) error {
+	inj, err := w.Queries.GetInjection(ctx, injectionID)
+	if err != nil {
+		return err
+	}
+	switch inj.Status {
+	case gendb.InjectionStatusDISCARDED:
+		// another file of the injection failed, none of its jobs are pushed
+		logger.Log.Info("Injection is discarded", zap.Int32("injection", injectionID))
+		w.discardInjection(ctx, injectionID, "")
+	case gendb.InjectionStatusSTAGING:
+		// the previous try failed after staging the last file
+		return w.injectionFileStaged(ctx, inj)
+	}
+	return nil
+}
+
+// commitInjection keeps the state of the records before pushing all the jobs of the injection
+func (w *worker) commitInjection(ctx context.Context, inj gendb.Injection) error {
+	staged, err := w.Queries.ListInjectionJobs(ctx, inj.InjectionID)
+	if err != nil {
+		return err
+	}
+	envlopes := make([]models.Envlope, 0, len(staged))
+	for _, j := range staged {
+		env := models.Envlope{}
+		if err := json.Unmarshal(j.Data, &env); err != nil {
+			return err
+		}
+		envlopes = append(envlopes, env)
+	}
+	if err := apiworker.CaptureSnapshots(ctx, w.Queries, apiworker.NewSnapshotReader(inj.Scope, w.grpcServers), inj.InjectionID, envlopes); err != nil {
+		// without the previous state the injection could not be rolled back
+		w.discardInjection(ctx, inj.InjectionID, "SnapshotFailed")
+		return err
+	}
+	if err := w.Queries.SetInjectionStatus(ctx, gendb.SetInjectionStatusParams{InjectionID: inj.InjectionID, Status: gendb.InjectionStatusAPPLYING}); err != nil {
+		return err
+	}
+	inj.Status = gendb.InjectionStatusAPPLYING
+	logger.Log.Info("Injection committed", zap.Int32("injection", inj.InjectionID), zap.Int32("jobs", inj.TotalJobs))
+	if inj.TotalJobs == 0 {
+		apiworker.FinishInjection(ctx, w.Queries, w.Queue, inj)
+		return nil
+	}
+	for _, j := range staged {
+		if _, err := w.Queue.PushJob(ctx, job.Job{Type: constants.APITYPE, Status: job.JobStatusPENDING, Data: j.Data, Priority: job.PriorityLow}, constants.APIWORKER); err != nil {
+			logger.Log.Error("Job not pushed Successfully:", zap.Int32("injection", inj.InjectionID), zap.Error(err))
+			apiworker.InjectionJobDone(ctx, w.Queries, w.Queue, inj.InjectionID, true)
+		}
+	}
+	return nil
+}
+
+// discardInjection drops the staged jobs of the injection and fails all its files
+func (w *worker) discardInjection(ctx context.Context, injectionID int32, reason string) {
+	if reason != "" {
+		if err := w.Queries.DiscardInjection(ctx, gendb.DiscardInjectionParams{
+			InjectionID: injectionID,
+			Comments:    sql.NullString{String: reason, Valid: true},
+		}); err != nil {
+			logger.Log.Error("Failed to discard injection", zap.Int32("injection", injectionID), zap.Error(err))
+		}
+	}
+	if err := w.Queries.DeleteInjectionJobs(ctx, injectionID); err != nil {
+		logger.Log.Error("Failed to delete staged jobs", zap.Int32("injection", injectionID), zap.Error(err))
+	}
+	if err := w.Queries.AbortInjectionFiles(ctx, gendb.AbortInjectionFilesParams{
+		InjectionID: injectionID,
+		Comments:    sql.NullString{String: "InjectionDiscarded", Valid: true},
+	}); err != nil {
+		logger.Log.Error("Failed to fail the files of the injection", zap.Int32("injection", injectionID), zap.Error(err))
+	}
+}
@@ -0,0 +1,190 @@
+package fileworker
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	gendb "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/constants"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/models"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getFileMappings returns the file mappings of the profile given at upload
+func (w *worker) getFileMappings(ctx context.Context, scope, profile string) ([]models.FileMapping, error) {
+	dbresp, err := w.Queries.GetMappingProfile(ctx, gendb.GetMappingProfileParams{
+		Scope: scope,
+		Name:  profile,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Error(codes.Internal, "MappingProfileNotFound")
+		}
+		logger.Log.Error("Failed to get mapping profile", zap.Error(err), zap.String("profile", profile))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	var mappings []models.FileMapping
+	if err := json.Unmarshal(dbresp.Mappings, &mappings); err != nil {
+		logger.Log.Error("Failed to unmarshal mapping profile", zap.Error(err), zap.String("profile", profile))
+		return nil, status.Error(codes.Internal, "BadMappingProfile")
+	}
+	return mappings, nil
+}
+
+// getFileMapping returns the mapping of the file type if the profile has one
+func getFileMapping(mappings []models.FileMapping, fileType string) *models.FileMapping {
+	for i := range mappings {
+		if strings.EqualFold(mappings[i].FileType, fileType) {
+			return &mappings[i]
+		}
+	}
+	return nil
+}
+
+// mapColumns streams the source file in the shape expected for the file type.
+// The mapped fields come first, followed by the source columns which are not consumed by the mapping.
+// Rows which cannot be mapped are replaced by an empty line so that they are reported as invalid at the same line.
+func mapColumns(src io.Reader, mapping models.FileMapping) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeMappedColumns(pw, src, mapping))
+	}()
+	return pr
+}
+
+func writeMappedColumns(dst io.Writer, src io.Reader, mapping models.FileMapping) error {
+	delimiter := mapping.Delimiter
+	if delimiter == "" {
+		delimiter = constants.DELIMETER
+	}
+	scanner := bufio.NewScanner(src)
+	if !scanner.Scan() {
+		return scanner.Err()
+	}
+	sourceIndexes := make(map[string]int)
+	sourceHeaders := strings.Split(scanner.Text(), delimiter)
+	for i, h := range sourceHeaders {
+		sourceIndexes[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	consumed := make(map[string]bool)
+	var headers []string
+	for _, f := range mapping.Fields {
+		for _, col := range f.Sources {
+			col = strings.ToLower(strings.TrimSpace(col))
+			if _, ok := sourceIndexes[col]; !ok {
+				logger.Log.Error("mapped source column is missing", zap.String("column", col), zap.String("field", f.Field))
+				return status.Error(codes.Internal, "HeadersMissing")
+			}
+			consumed[col] = true
+		}
+		field := strings.ToLower(f.Field)
+		consumed[field] = true
+		headers = append(headers, field)
+	}
+	var kept []int
+	for i, h := range sourceHeaders {
+		h = strings.ToLower(strings.TrimSpace(h))
+		if !consumed[h] {
+			kept = append(kept, i)
+			headers = append(headers, h)
+		}
+	}
+	out := bufio.NewWriter(dst)
+	if _, err := fmt.Fprintln(out, strings.Join(headers, constants.DELIMETER)); err != nil {
+		return err
+	}
+	for scanner.Scan() {
+		cols := strings.Split(scanner.Text(), delimiter)
+		row, ok := mapRow(cols, len(sourceHeaders), kept, sourceIndexes, mapping.Fields)
+		if !ok {
+			row = ""
+		}
+		if _, err := fmt.Fprintln(out, row); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return out.Flush()
+}
+
+func mapRow(cols []string, width int, kept []int, sourceIndexes map[string]int, fields []models.FieldMapping) (string, bool) {
+	if len(cols) < width {
+		return "", false
+	}
+	values := make([]string, 0, len(fields)+len(kept))
+	for _, f := range fields {
+		val, err := mapField(cols, sourceIndexes, f)
+		if err != nil {
+			return "", false
+		}
+		values = append(values, val)
+	}
+	for _, i := range kept {
+		values = append(values, cols[i])
+	}
+	for _, val := range values {
+		if strings.Contains(val, constants.DELIMETER) {
+			return "", false
+		}
+	}
+	return strings.Join(values, constants.DELIMETER), true
+}
+
+func mapField(cols []string, sourceIndexes map[string]int, f models.FieldMapping) (string, error) {
+	var sources []string
+	for _, src := range f.Sources {
+		sources = append(sources, strings.TrimSpace(cols[sourceIndexes[strings.ToLower(strings.TrimSpace(src))]]))
+	}
+	var val string
+	switch f.Operation {
+	case constants.MappingConstant:
+		val = f.Value
+	case constants.MappingConcat:
+		val = strings.Join(sources, f.Separator)
+	case constants.MappingSplit:
+		if parts := strings.Split(sources[0], f.Separator); f.Index < len(parts) {
+			val = strings.TrimSpace(parts[f.Index])
+		}
+	default:
+		val = sources[0]
+	}
+	if val == "" {
+		val = f.DefaultValue
+	}
+	if val == "" {
+		return val, nil
+	}
+	if f.DateFormat != "" {
+		t, err := time.Parse(f.DateFormat, val)
+		if err != nil {
+			return "", err
+		}
+		val = t.Format(time.RFC3339)
+	}
+	if f.DecimalSeparator != "" || f.ThousandsSeparator != "" {
+		if f.ThousandsSeparator != "" {
+			val = strings.ReplaceAll(val, f.ThousandsSeparator, "")
+		}
+		if f.DecimalSeparator != "" {
+			val = strings.ReplaceAll(val, f.DecimalSeparator, ".")
+		}
+		if _, err := strconv.ParseFloat(val, 64); err != nil {
+			return "", err
+		}
+	}
+	return val, nil
+}
@@ -0,0 +1,104 @@
+package fileworker
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/models"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_mapColumns(t *testing.T) {
+	logger.Init(-1, "")
+	acqRights := models.FileMapping{
+		FileType:  "PRODUCTS_ACQUIREDRIGHTS",
+		Delimiter: "|",
+		Fields: []models.FieldMapping{
+			{Field: "sku", Operation: "COPY", Sources: []string{"Reference"}},
+			{Field: "product_name", Operation: "CONCAT", Sources: []string{"Publisher", "Product"}, Separator: " "},
+			{Field: "editor", Operation: "SPLIT", Sources: []string{"Contract"}, Separator: "/", Index: 1},
+			{Field: "flag", Operation: "CONSTANT", Value: "1"},
+			{Field: "metric", Operation: "COPY", Sources: []string{"Licence Type"}, DefaultValue: "ops"},
+			{Field: "maintenance_start", Operation: "COPY", Sources: []string{"Start"}, DateFormat: "02.01.2006"},
+			{Field: "unit_price", Operation: "COPY", Sources: []string{"Price"}, DecimalSeparator: ",", ThousandsSeparator: " "},
+		},
+	}
+	tests := []struct {
+		name    string
+		mapping models.FileMapping
+		input   string
+		output  string
+		wantErr bool
+	}{
+		{
+			name:    "rename, concat, split, constant, default, date and number",
+			mapping: acqRights,
+			input: "Reference|Publisher|Product|Contract|Licence Type|Start|Price|Swidtag\n" +
+				"sku1|Oracle|Database|C1/Oracle|nup|31.01.2021|1 234,5|swid1\n" +
+				"sku2|IBM|DB2|C2/IBM||01.02.2021|10|swid2\n",
+			output: "sku;product_name;editor;flag;metric;maintenance_start;unit_price;swidtag\n" +
+				"sku1;Oracle Database;Oracle;1;nup;2021-01-31T00:00:00Z;1234.5;swid1\n" +
+				"sku2;IBM DB2;IBM;1;ops;2021-02-01T00:00:00Z;10;swid2\n",
+		},
+		{
+			name:    "rows which cannot be mapped are emptied",
+			mapping: acqRights,
+			input: "Reference|Publisher|Product|Contract|Licence Type|Start|Price\n" +
+				"sku1|Oracle|Database|C1/Oracle|nup|2021-01-31|10\n" +
+				"sku2|IBM\n" +
+				"sku3|IBM|DB2|C2/IBM||01.02.2021|ten\n" +
+				"sku4|IBM|DB2|C2/IBM||01.02.2021|10\n",
+			output: "sku;product_name;editor;flag;metric;maintenance_start;unit_price\n" +
+				"\n" +
+				"\n" +
+				"\n" +
+				"sku4;IBM DB2;IBM;1;ops;2021-02-01T00:00:00Z;10\n",
+		},
+		{
+			name:    "source column missing",
+			mapping: acqRights,
+			input:   "Reference|Publisher\nsku1|Oracle\n",
+			wantErr: true,
+		},
+		{
+			name: "default delimiter and mapped field replacing a source column",
+			mapping: models.FileMapping{
+				FileType: "PRODUCTS",
+				Fields:   []models.FieldMapping{{Field: "name", Operation: "COPY", Sources: []string{"label"}}},
+			},
+			input:  "swidtag;Name;label\nswid1;n1;l1\n",
+			output: "name;swidtag\nl1;swid1\n",
+		},
+		{
+			name:    "empty file",
+			mapping: acqRights,
+			input:   "",
+			output:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := mapColumns(strings.NewReader(tt.input), tt.mapping)
+			defer r.Close()
+			got, err := ioutil.ReadAll(r)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("mapColumns() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				assert.Equal(t, tt.output, string(got))
+			}
+		})
+	}
+}
+
+func Test_getFileMapping(t *testing.T) {
+	mappings := []models.FileMapping{{FileType: "PRODUCTS"}, {FileType: "APPLICATIONS"}}
+	assert.Equal(t, &mappings[1], getFileMapping(mappings, "APPLICATIONS"))
+	assert.Nil(t, getFileMapping(mappings, "PRODUCTS_EQUIPMENTS"))
+	assert.Nil(t, getFileMapping(nil, "PRODUCTS"))
+}
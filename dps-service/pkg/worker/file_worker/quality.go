@@ -0,0 +1,236 @@
+package fileworker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strconv"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/quality"
+	gendb "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/constants"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/models"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/job"
+
+	prodV1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/thirdparty/product-service/pkg/api/v1"
+
+	"go.uber.org/zap"
+)
+
+// QualityRecord is a record of a file rejected by a quality rule of its scope
+type QualityRecord struct {
+	Data     interface{}
+	UploadID int32
+	FileName string
+	Scope    string
+}
+
+// rejectedRecord is a record breaking an ERROR rule, reason is the message of the rule
+type rejectedRecord struct {
+	data   interface{}
+	reason string
+}
+
+// qualityCheck is the check of the records of a file with the quality rules of its scope
+type qualityCheck struct {
+	entity   string
+	tally    quality.Tally
+	rejected []rejectedRecord
+	// keys of the rejected records, a snapshot does not delete them as missing
+	keys map[string]bool
+}
+
+// checkQualityRules checks the records to inject with the enabled quality rules of the scope,
+// the records breaking an ERROR rule are removed from data. The deleted records are not checked.
+func (w *worker) checkQualityRules(ctx context.Context, data *models.FileData) (qualityCheck, error) {
+	set, err := w.qualityRuleSet(ctx, data.Scope, data.FileType)
+	if err != nil || set == nil {
+		return qualityCheck{}, err
+	}
+	return applyQualityRules(set, data), nil
+}
+
+// qualityRuleSet gives the enabled quality rules of the scope, nil when none checks the files of the type
+func (w *worker) qualityRuleSet(ctx context.Context, scope, fileType string) (*quality.Set, error) {
+	if quality.EntityOfFileType(fileType) == "" {
+		return nil, nil
+	}
+	rules, err := w.Queries.ListEnabledQualityRules(ctx, scope)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	set, err := quality.New(rules)
+	if err != nil {
+		return nil, err
+	}
+	if set.NeedsCatalog() && w.product != nil {
+		res, err := w.product.GetAllEditorsCatalog(ctx, &prodV1.GetAllEditorsCatalogRequest{})
+		if err != nil {
+			logger.Log.Error("Failed to get catalog editors, the in_catalog rules are not checked", zap.Error(err))
+		} else {
+			set.SetCatalog(res.EditorName)
+		}
+	}
+	return set, nil
+}
+
+// applyQualityRules removes from data the records breaking an ERROR rule of the set
+func applyQualityRules(set *quality.Set, data *models.FileData) qualityCheck {
+	check := qualityCheck{entity: quality.EntityOfFileType(data.FileType), tally: make(quality.Tally), keys: make(map[string]bool)}
+	// keep tells whether the record is injected
+	keep := func(rec interface{}, key, action string, fields map[string]string) bool {
+		if action == constants.DELETE {
+			return true
+		}
+		violations := set.Check(check.entity, fields)
+		check.tally.Add(violations)
+		for _, v := range violations {
+			if v.IsError() {
+				check.rejected = append(check.rejected, rejectedRecord{data: rec, reason: v.Rule.Message})
+				if key != "" {
+					check.keys[key] = true
+				}
+				return false
+			}
+		}
+		return true
+	}
+	for k, p := range data.Products {
+		if !keep(p, p.SwidTag, p.Action, productFields(p)) {
+			delete(data.Products, k)
+		}
+	}
+	for k, a := range data.Applications {
+		if !keep(a, a.ID, a.Action, applicationFields(a)) {
+			delete(data.Applications, k)
+		}
+	}
+	for k, a := range data.AcqRights {
+		if !keep(a, a.Sku, a.Action, acqRightsFields(a)) {
+			delete(data.AcqRights, k)
+		}
+	}
+	for eqType, recs := range data.Equipments {
+		kept := recs[:0]
+		for _, rec := range recs {
+			if keep(rec, "", recordAction(rec), equipmentFields(rec)) {
+				kept = append(kept, rec)
+			}
+		}
+		data.Equipments[eqType] = kept
+	}
+	return check
+}
+
+func productFields(p models.ProductInfo) map[string]string {
+	return map[string]string{
+		constants.SWIDTAG: p.SwidTag,
+		constants.NAME:    p.Name,
+		constants.VERSION: p.Version,
+		constants.EDITOR:  p.Editor,
+	}
+}
+
+func applicationFields(a models.ApplicationInfo) map[string]string {
+	return map[string]string{
+		constants.APPID:       a.ID,
+		constants.NAME:        a.Name,
+		constants.VERSION:     a.Version,
+		constants.OWNER:       a.Owner,
+		constants.ENVIRONMENT: a.Environment,
+		constants.DOMAIN:      a.Domain,
+	}
+}
+
+func acqRightsFields(a models.AcqRightsInfo) map[string]string {
+	return map[string]string{
+		constants.SKU:                       a.Sku,
+		constants.SWIDTAG:                   a.SwidTag,
+		constants.PRODUCTNAME:               a.ProductName,
+		constants.PRODUCTVERSION:            a.Version,
+		constants.EDITOR:                    a.Editor,
+		constants.METRIC:                    a.Metric,
+		constants.ACQLICNO:                  strconv.Itoa(a.NumOfAcqLic),
+		constants.LICUNDERMAINTENANCENO:     strconv.Itoa(a.NumOfMaintenanceLic),
+		constants.AVGUNITPRICE:              formatNumber(a.AvgPrice),
+		constants.AVGMAINENANCEUNITPRICE:    formatNumber(a.AvgMaintenantPrice),
+		constants.TOTALPURCHASECOST:         formatNumber(a.TotalPurchasedCost),
+		constants.TOTALMAINENANCECOST:       formatNumber(a.TotalMaintenanceCost),
+		constants.TOTALCOST:                 formatNumber(a.TotalCost),
+		constants.StartOfMaintenance:        a.StartOfMaintenance,
+		constants.EndOfMaintenance:          a.EndOfMaintenance,
+		constants.OrderingDate:              a.OrderingDate,
+		constants.CorporateSourcingContract: a.CorporateSourcingContract,
+		constants.SoftwareProvider:          a.SoftwareProvider,
+		constants.LastPurchasedOrder:        a.LastPurchasedOrder,
+		constants.MaintenanceProvider:       a.MaintenanceProvider,
+		constants.SupportNumber:             a.SupportNumber,
+	}
+}
+
+func equipmentFields(rec map[string]interface{}) map[string]string {
+	fields := make(map[string]string, len(rec))
+	for k := range rec {
+		fields[k] = recordString(rec, k)
+	}
+	return fields
+}
+
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// saveQualityResults keeps the number of records of the file breaking each rule
+func (w *worker) saveQualityResults(ctx context.Context, check qualityCheck, data models.FileData, id int32, fileName string) {
+	for rule, n := range check.tally {
+		if err := w.Queries.UpsertQualityResult(ctx, gendb.UpsertQualityResultParams{
+			UploadID:   id,
+			FileName:   fileName,
+			RuleName:   rule.Name,
+			Scope:      data.Scope,
+			Entity:     rule.Entity,
+			Severity:   rule.Severity,
+			Violations: n,
+		}); err != nil {
+			logger.Log.Error("Failed to save quality result", zap.String("rule", rule.Name), zap.Any("file", fileName), zap.Error(err))
+		}
+	}
+}
+
+// setRejectedRecords records the records rejected by the quality rules as failed records of the file
+func setRejectedRecords(ctx context.Context, w *worker, check qualityCheck, data models.FileData, id int32, fileName string) {
+	for _, j := range rejectedRecordJobs(check, data, id, fileName) {
+		if _, err := w.Queue.PushJob(ctx, j, constants.APIWORKER); err != nil {
+			logger.Log.Error("Failed to upsert rejected-failed records, err ", zap.Error(err))
+		}
+	}
+}
+
+// rejectedRecordJobs gives the failed jobs recording the records rejected by the quality rules
+func rejectedRecordJobs(check qualityCheck, data models.FileData, id int32, fileName string) []job.Job {
+	jobs := make([]job.Job, 0, len(check.rejected))
+	for _, r := range check.rejected {
+		dataToPush, err := json.Marshal(QualityRecord{
+			Data:     r.data,
+			UploadID: id,
+			FileName: fileName,
+			Scope:    data.Scope,
+		})
+		if err != nil {
+			logger.Log.Error("Failed to marshal the rejected data, err ", zap.Error(err))
+			continue
+		}
+		jobs = append(jobs, job.Job{
+			Status:   job.JobStatusFAILED,
+			Comments: sql.NullString{String: r.reason, Valid: true},
+			Data:     dataToPush,
+			Type:     sql.NullString{String: constants.APIWORKER, Valid: true},
+		})
+	}
+	return jobs
+}
@@ -0,0 +1,72 @@
+package fileworker
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/quality"
+	gendb "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/constants"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_applyQualityRules(t *testing.T) {
+	set, err := quality.New([]gendb.QualityRule{
+		{Name: "domain", Entity: quality.Applications, Field: "domain", Operator: quality.OneOf, Params: json.RawMessage(`{"values":["Finance"]}`), Severity: gendb.RuleSeverityERROR},
+		{Name: "owner", Entity: quality.Applications, Field: "owner", Operator: quality.Required, Severity: gendb.RuleSeverityWARNING},
+		{Name: "cpu", Entity: quality.Equipments, Field: "cpu", Operator: quality.Range, Params: json.RawMessage(`{"min":1,"max":256}`), Severity: gendb.RuleSeverityERROR},
+	})
+	if !assert.Empty(t, err) {
+		return
+	}
+	t.Run("applications", func(t *testing.T) {
+		data := models.FileData{
+			FileType: constants.APPLICATIONS,
+			Applications: map[string]models.ApplicationInfo{
+				"a1": {ID: "a1", Domain: "Finance", Owner: "me", Action: constants.UPSERT},
+				"a2": {ID: "a2", Domain: "Sales", Action: constants.UPSERT},
+				"a3": {ID: "a3", Domain: "Sales", Action: constants.DELETE},
+			},
+		}
+		check := applyQualityRules(set, &data)
+		assert.Equal(t, []string{"a1", "a3"}, sortedKeys(data.Applications))
+		assert.Equal(t, map[string]bool{"a2": true}, check.keys)
+		if assert.Len(t, check.rejected, 1) {
+			assert.Equal(t, "domain must be one of Finance", check.rejected[0].reason)
+		}
+		errs, warnings := check.tally.Counts()
+		assert.Equal(t, int32(1), errs)
+		assert.Equal(t, int32(1), warnings)
+	})
+	t.Run("equipments", func(t *testing.T) {
+		data := models.FileData{
+			FileType: "EQUIPMENT_SERVER",
+			Equipments: map[string][]map[string]interface{}{
+				"SERVER": {
+					{"server_id": "s1", "cpu": json.Number("4")},
+					{"server_id": "s2", "cpu": json.Number("512")},
+					{"server_id": "s3", "cpu": float64(512), constants.FLAG: false},
+				},
+			},
+		}
+		check := applyQualityRules(set, &data)
+		if assert.Len(t, data.Equipments["SERVER"], 2) {
+			assert.Equal(t, "s1", data.Equipments["SERVER"][0]["server_id"])
+			assert.Equal(t, "s3", data.Equipments["SERVER"][1]["server_id"])
+		}
+		assert.Len(t, check.rejected, 1)
+		assert.Empty(t, check.keys)
+	})
+}
+
+func sortedKeys(apps map[string]models.ApplicationInfo) []string {
+	keys := make([]string, 0, len(apps))
+	for k := range apps {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
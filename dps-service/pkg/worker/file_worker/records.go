@@ -0,0 +1,439 @@
+package fileworker
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/config"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/constants"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/models"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/common"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// structuredToFileData reads a json, ndjson or parquet file of the given type.
+// Numbers and booleans keep the type they have in the file instead of being read back from text.
+// nolint: nakedret
+func structuredToFileData(fileType, fileName, format string) (resp models.FileData, err error) {
+	file := fmt.Sprintf("%s/%s", config.GetConfig().FilesLocation, fileName)
+	logger.Log.Info("Looking for typed file", zap.Any("file", file), zap.String("format", format))
+	records, err := readRecords(file, format)
+	if err != nil {
+		logger.Log.Error("Failed to read typed file", zap.Error(err), zap.Any("file", file))
+		resp.FileFailureReason = constants.BadFile
+		return resp, status.Error(codes.Internal, constants.BadFile)
+	}
+	if len(records) == 0 {
+		resp.FileFailureReason = "EmptyFile"
+		return resp, errors.New(resp.FileFailureReason)
+	}
+	// equipment attributes are named as in the metadata, the case of the keys is kept
+	if strings.Contains(fileType, "EQUIPMENT_") {
+		eqType := strings.Split(fileType, "_")[1]
+		resp = getEquipmentsFromRecords(eqType, records)
+		if len(resp.Equipments[eqType]) == 0 {
+			resp.FileFailureReason = constants.BadFile
+			return resp, errors.New("badfile")
+		}
+		resp.FileType = fileType
+		resp.TargetServices = constants.SERVICES[constants.EQUIPMENTS]
+		return
+	}
+	expectedHeaders, err := getHeadersForFileType(fileType)
+	if err != nil {
+		resp.FileFailureReason = err.Error()
+		return resp, status.Error(codes.Internal, "FileNotSupported")
+	}
+	records = lowerKeys(records)
+	if err = checkRecordFields(records, expectedHeaders); err != nil {
+		resp.FileFailureReason = err.Error()
+		return
+	}
+	switch fileType {
+	case constants.PRODUCTS:
+		resp = getProductsFromRecords(records)
+
+	case constants.APPLICATIONS:
+		resp = getApplicationsFromRecords(records)
+
+	case constants.ProductsAcquiredRights:
+		resp = getAcqRightsFromRecords(records)
+
+	default:
+		err = status.Error(codes.Internal, "FileNotSupported")
+		resp.FileFailureReason = err.Error()
+		return
+	}
+	resp.FileType = fileType
+	resp.TargetServices = constants.SERVICES[fileType]
+	return
+}
+
+// readRecords returns the objects of the file, a line of a ndjson file which is not an object is kept as a nil record
+func readRecords(file, format string) ([]map[string]interface{}, error) {
+	if format == constants.ParquetExtension {
+		return readParquetRecords(file)
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	switch format {
+	case constants.JSONExtension:
+		var records []map[string]interface{}
+		dec := json.NewDecoder(f)
+		dec.UseNumber()
+		if err := dec.Decode(&records); err != nil && err != io.EOF {
+			return nil, err
+		}
+		return records, nil
+	case constants.NDJSONExtension:
+		var records []map[string]interface{}
+		s := bufio.NewScanner(f)
+		for s.Scan() {
+			line := strings.TrimSpace(s.Text())
+			if line == "" {
+				continue
+			}
+			var rec map[string]interface{}
+			dec := json.NewDecoder(strings.NewReader(line))
+			dec.UseNumber()
+			if err := dec.Decode(&rec); err != nil {
+				rec = nil
+			}
+			records = append(records, rec)
+		}
+		return records, s.Err()
+	default:
+		return nil, status.Error(codes.Internal, "FileNotSupported")
+	}
+}
+
+func readParquetRecords(file string) ([]map[string]interface{}, error) {
+	fr, err := local.NewLocalFileReader(file)
+	if err != nil {
+		return nil, err
+	}
+	defer fr.Close()
+	pr, err := reader.NewParquetReader(fr, nil, 4)
+	if err != nil {
+		return nil, err
+	}
+	defer pr.ReadStop()
+	if pr.GetNumRows() == 0 {
+		return nil, nil
+	}
+	rows, err := pr.ReadByNumber(int(pr.GetNumRows()))
+	if err != nil {
+		return nil, err
+	}
+	// the fields of the rows are named after the columns of the root
+	sh := pr.SchemaHandler
+	columns := make(map[string]int32)
+	for idx, path := range sh.IndexMap {
+		if strings.Count(path, common.PAR_GO_PATH_DELIMITER) == 1 {
+			columns[sh.Infos[idx].InName] = idx
+		}
+	}
+	records := make([]map[string]interface{}, 0, len(rows))
+	for _, row := range rows {
+		v := reflect.ValueOf(row)
+		rec := make(map[string]interface{}, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			idx, ok := columns[v.Type().Field(i).Name]
+			if !ok {
+				continue
+			}
+			rec[sh.Infos[idx].ExName] = parquetValue(v.Field(i), sh.SchemaElements[idx])
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+// parquetValue returns the value with the types of a json file, dates and timestamps are formatted as RFC3339
+func parquetValue(v reflect.Value, el *parquet.SchemaElement) interface{} {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Int32, reflect.Int64:
+		i := v.Int()
+		if t, ok := parquetTime(i, el); ok {
+			return t
+		}
+		if el.IsSetConvertedType() && el.GetConvertedType() == parquet.ConvertedType_DECIMAL {
+			return float64(i) / math.Pow10(int(el.GetScale()))
+		}
+		return i
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.String:
+		return v.String()
+	default:
+		return v.Interface()
+	}
+}
+
+func parquetTime(i int64, el *parquet.SchemaElement) (string, bool) {
+	var t time.Time
+	lt := el.GetLogicalType()
+	switch {
+	case lt != nil && lt.IsSetTIMESTAMP():
+		switch unit := lt.GetTIMESTAMP().GetUnit(); {
+		case unit.IsSetMILLIS():
+			t = time.UnixMilli(i)
+		case unit.IsSetMICROS():
+			t = time.UnixMicro(i)
+		default:
+			t = time.Unix(0, i)
+		}
+	case lt != nil && lt.IsSetDATE(), el.IsSetConvertedType() && el.GetConvertedType() == parquet.ConvertedType_DATE:
+		t = time.Unix(i*24*60*60, 0)
+	case el.IsSetConvertedType() && el.GetConvertedType() == parquet.ConvertedType_TIMESTAMP_MILLIS:
+		t = time.UnixMilli(i)
+	case el.IsSetConvertedType() && el.GetConvertedType() == parquet.ConvertedType_TIMESTAMP_MICROS:
+		t = time.UnixMicro(i)
+	default:
+		return "", false
+	}
+	return t.UTC().Format(time.RFC3339), true
+}
+
+func lowerKeys(records []map[string]interface{}) []map[string]interface{} {
+	for i, rec := range records {
+		if rec == nil {
+			continue
+		}
+		lower := make(map[string]interface{}, len(rec))
+		for key, val := range rec {
+			lower[strings.ToLower(strings.TrimSpace(key))] = val
+		}
+		records[i] = lower
+	}
+	return records
+}
+
+// checkRecordFields is the counterpart of the headers check of csv files, each field must be set in one record at least
+func checkRecordFields(records []map[string]interface{}, expectedFields []string) error {
+	present := make(map[string]bool)
+	for _, rec := range records {
+		for key := range rec {
+			present[key] = true
+		}
+	}
+	for _, field := range expectedFields {
+		if !present[field] {
+			logger.Log.Error("mandatory field is missing", zap.String("field", field))
+			return status.Error(codes.Internal, "HeadersMissing")
+		}
+	}
+	return nil
+}
+
+func getProductsFromRecords(records []map[string]interface{}) (resp models.FileData) {
+	resp.Products = make(map[string]models.ProductInfo)
+	for _, rec := range records {
+		if swidtag := recordString(rec, constants.SWIDTAG); swidtag != "" {
+			data := models.ProductInfo{
+				Name:    recordString(rec, constants.NAME),
+				Version: recordString(rec, constants.VERSION),
+				Editor:  recordString(rec, constants.EDITOR),
+				SwidTag: swidtag,
+				Action:  recordAction(rec),
+			}
+			oldData, ok := resp.Products[data.SwidTag]
+			if ok {
+				resp.DuplicateRecords = append(resp.DuplicateRecords, oldData)
+			}
+			resp.Products[data.SwidTag] = data
+		} else {
+			resp.InvalidCount++
+			resp.InvalidDataRowNum = append(resp.InvalidDataRowNum, int(resp.TotalCount)+1)
+		}
+		resp.TotalCount++
+	}
+	return
+}
+
+func getApplicationsFromRecords(records []map[string]interface{}) (resp models.FileData) {
+	resp.Applications = make(map[string]models.ApplicationInfo)
+	for _, rec := range records {
+		if appID := recordString(rec, constants.APPID); appID != "" {
+			data := models.ApplicationInfo{
+				ID:          appID,
+				Name:        recordString(rec, constants.NAME),
+				Environment: recordString(rec, constants.ENVIRONMENT),
+				Domain:      recordString(rec, constants.DOMAIN),
+				Action:      recordAction(rec),
+			}
+			oldData, ok := resp.Applications[data.ID]
+			if ok {
+				resp.DuplicateRecords = append(resp.DuplicateRecords, oldData)
+			}
+			resp.Applications[data.ID] = data
+		} else {
+			resp.InvalidCount++
+			resp.InvalidDataRowNum = append(resp.InvalidDataRowNum, int(resp.TotalCount)+1)
+		}
+		resp.TotalCount++
+	}
+	return
+}
+
+func getAcqRightsFromRecords(records []map[string]interface{}) (resp models.FileData) {
+	resp.AcqRights = make(map[string]models.AcqRightsInfo)
+	for _, rec := range records {
+		if sku := recordString(rec, constants.SKU); sku != "" {
+			temp := models.AcqRightsInfo{
+				Version:                   recordString(rec, constants.PRODUCTVERSION),
+				SwidTag:                   recordString(rec, constants.SWIDTAG),
+				Sku:                       sku,
+				CorporateSourcingContract: recordString(rec, constants.CorporateSourcingContract),
+				OrderingDate:              recordString(rec, constants.OrderingDate),
+				ProductName:               recordString(rec, constants.PRODUCTNAME),
+				Editor:                    recordString(rec, constants.EDITOR),
+				Metric:                    recordString(rec, constants.METRIC),
+				SoftwareProvider:          recordString(rec, constants.SoftwareProvider),
+				NumOfAcqLic:               recordInt(rec, constants.ACQLICNO),
+				NumOfMaintenanceLic:       recordInt(rec, constants.LICUNDERMAINTENANCENO),
+				MaintenanceProvider:       recordString(rec, constants.MaintenanceProvider),
+				AvgPrice:                  recordFloat(rec, constants.AVGUNITPRICE),
+				AvgMaintenantPrice:        recordFloat(rec, constants.AVGMAINENANCEUNITPRICE),
+				TotalPurchasedCost:        recordFloat(rec, constants.TOTALPURCHASECOST),
+				TotalMaintenanceCost:      recordFloat(rec, constants.TOTALMAINENANCECOST),
+				TotalCost:                 recordFloat(rec, constants.TOTALCOST),
+				Action:                    recordAction(rec),
+				StartOfMaintenance:        recordString(rec, constants.StartOfMaintenance),
+				EndOfMaintenance:          recordString(rec, constants.EndOfMaintenance),
+				LastPurchasedOrder:        recordString(rec, constants.LastPurchasedOrder),
+				SupportNumber:             recordString(rec, constants.SupportNumber),
+			}
+			oldData, ok := resp.AcqRights[temp.Sku]
+			if ok {
+				resp.DuplicateRecords = append(resp.DuplicateRecords, oldData)
+			}
+			resp.AcqRights[temp.Sku] = temp
+		} else {
+			resp.InvalidCount++
+			resp.InvalidDataRowNum = append(resp.InvalidDataRowNum, int(resp.TotalCount)+1)
+		}
+		resp.TotalCount++
+	}
+	return
+}
+
+// getEquipmentsFromRecords keeps the records as they are so that the attributes are sent with their types
+func getEquipmentsFromRecords(eqType string, records []map[string]interface{}) (resp models.FileData) {
+	resp.Equipments = make(map[string][]map[string]interface{})
+	seen := make(map[string]bool)
+	for _, rec := range records {
+		if len(rec) > 0 {
+			// keys of a map are marshalled in order, identical records have the same key
+			key, _ := json.Marshal(rec)
+			if seen[string(key)] {
+				resp.DuplicateRecords = append(resp.DuplicateRecords, rec)
+			} else {
+				seen[string(key)] = true
+				resp.Equipments[eqType] = append(resp.Equipments[eqType], rec)
+			}
+		} else {
+			resp.InvalidCount++
+			resp.InvalidDataRowNum = append(resp.InvalidDataRowNum, int(resp.TotalCount)+1)
+		}
+		resp.TotalCount++
+	}
+	return
+}
+
+// recordString returns the value as it would be written in a csv file
+func recordString(rec map[string]interface{}, key string) string {
+	switch v := rec[key].(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
+
+func recordInt(rec map[string]interface{}, key string) int {
+	switch v := rec[key].(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return int(i)
+		}
+		f, _ := v.Float64()
+		return int(f)
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	case string:
+		i, _ := strconv.Atoi(v)
+		return i
+	default:
+		return 0
+	}
+}
+
+func recordFloat(rec map[string]interface{}, key string) float64 {
+	switch v := rec[key].(type) {
+	case json.Number:
+		f, _ := v.Float64()
+		return f
+	case int64:
+		return float64(v)
+	case float64:
+		return v
+	case string:
+		f, _ := strconv.ParseFloat(v, 64)
+		return f
+	default:
+		return 0
+	}
+}
+
+// recordAction returns the action of the flag, a boolean flag is read as 1 or 0
+func recordAction(rec map[string]interface{}) string {
+	if flag, ok := rec[constants.FLAG].(bool); ok {
+		if flag {
+			return constants.ActionType["1"]
+		}
+		return constants.ActionType["0"]
+	}
+	return constants.ActionType[recordString(rec, constants.FLAG)]
+}
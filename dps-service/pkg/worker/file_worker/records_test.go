@@ -0,0 +1,240 @@
+package fileworker
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/config"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/models"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+type parquetAcqRight struct {
+	Sku              string  `parquet:"name=SKU, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Swidtag          string  `parquet:"name=swidtag, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ProductName      string  `parquet:"name=product_name, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ProductVersion   *string `parquet:"name=product_version, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	Editor           string  `parquet:"name=editor, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Metric           string  `parquet:"name=metric, type=BYTE_ARRAY, convertedtype=UTF8"`
+	AcquiredLicenses int64   `parquet:"name=acquired_licenses, type=INT64"`
+	MaintenanceLic   int32   `parquet:"name=maintenance_licenses, type=INT32"`
+	UnitPrice        float64 `parquet:"name=unit_price, type=DOUBLE"`
+	MaintenancePrice float64 `parquet:"name=maintenance_unit_price, type=DOUBLE"`
+	LicenseCost      float64 `parquet:"name=total_license_cost, type=DOUBLE"`
+	MaintenanceCost  float64 `parquet:"name=total_maintenance_cost, type=DOUBLE"`
+	TotalCost        int64   `parquet:"name=total_cost, type=INT64, convertedtype=DECIMAL, scale=2, precision=18"`
+	Flag             bool    `parquet:"name=flag, type=BOOLEAN"`
+	MaintenanceStart int32   `parquet:"name=maintenance_start, type=INT32, convertedtype=DATE"`
+	MaintenanceEnd   int64   `parquet:"name=maintenance_end, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+}
+
+func writeParquetAcqRights(t *testing.T, file string, rows []parquetAcqRight) {
+	fw, err := local.NewLocalFileWriter(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pw, err := writer.NewParquetWriter(fw, new(parquetAcqRight), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range rows {
+		if err := pw.Write(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := pw.WriteStop(); err != nil {
+		t.Fatal(err)
+	}
+	fw.Close()
+}
+
+func Test_structuredToFileData(t *testing.T) {
+	logger.Init(-1, "")
+	dir := t.TempDir()
+	config.SetConfig(config.Config{FilesLocation: dir})
+	files := map[string]string{
+		"s1_products.json": `[{"SwidTag":"p1","Name":"Oracle DB","Version":12.2,"Editor":"Oracle","Flag":true},
+			{"SwidTag":"p1","Name":"Oracle DB","Version":"19","Editor":"Oracle","Flag":1},
+			{"Name":"no swidtag","Version":"1","Editor":"IBM","Flag":"1"},
+			{"SwidTag":"p2","Name":"DB2","Version":"11","Editor":"IBM","Flag":false}]`,
+		"s1_applications.ndjson": `{"application_id":"a1","name":"app1","environment":"prod","domain":"finance","flag":"1"}
+
+not a record
+{"application_id":"a2","name":"app2","environment":"dev","domain":"hr","flag":0}
+`,
+		"s1_equipment_server.json": `[{"server_id":"srv1","cores":8,"ratio":0.5,"virtual":true},
+			{"server_id":"srv1","cores":8,"ratio":0.5,"virtual":true},
+			{},
+			{"server_id":"srv2","cores":16,"ratio":1,"virtual":false}]`,
+		"s1_products.ndjson":          `{"swidtag":"p1","name":"Oracle DB","editor":"Oracle","flag":1}`,
+		"s1_products_equipments.json": `[{"equipment_id":"e1","swidtag":"p1","allocated_metric":"ops","allocated_users":"","flag":1}]`,
+		"s1_applications.json":        `{"application_id":"a1"}`,
+		"s1_equipment_cluster.json":   `[]`,
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	version := "12"
+	writeParquetAcqRights(t, filepath.Join(dir, "s1_products_acquiredRights.parquet"), []parquetAcqRight{
+		{
+			Sku: "sku1", Swidtag: "p1", ProductName: "Oracle DB", ProductVersion: &version, Editor: "Oracle", Metric: "ops",
+			AcquiredLicenses: 10, MaintenanceLic: 5, UnitPrice: 100.5, MaintenancePrice: 20, LicenseCost: 1005, MaintenanceCost: 100,
+			TotalCost: 110500, Flag: true, MaintenanceStart: 18628, MaintenanceEnd: 1640995200000,
+		},
+		{Sku: "", Swidtag: "p2", Flag: true},
+	})
+	tests := []struct {
+		name     string
+		fileType string
+		fileName string
+		format   string
+		want     models.FileData
+		wantErr  string
+	}{
+		{
+			name:     "json products with typed values",
+			fileType: "PRODUCTS",
+			fileName: "s1_products.json",
+			format:   ".JSON",
+			want: models.FileData{
+				Products: map[string]models.ProductInfo{
+					"p1": {SwidTag: "p1", Name: "Oracle DB", Version: "19", Editor: "Oracle", Action: "UPSERT"},
+					"p2": {SwidTag: "p2", Name: "DB2", Version: "11", Editor: "IBM", Action: "DELETE"},
+				},
+				DuplicateRecords:  []interface{}{models.ProductInfo{SwidTag: "p1", Name: "Oracle DB", Version: "12.2", Editor: "Oracle", Action: "UPSERT"}},
+				TotalCount:        4,
+				InvalidCount:      1,
+				InvalidDataRowNum: []int{3},
+				FileType:          "PRODUCTS",
+				TargetServices:    []string{"product"},
+			},
+		},
+		{
+			name:     "ndjson applications with a malformed line",
+			fileType: "APPLICATIONS",
+			fileName: "s1_applications.ndjson",
+			format:   ".NDJSON",
+			want: models.FileData{
+				Applications: map[string]models.ApplicationInfo{
+					"a1": {ID: "a1", Name: "app1", Environment: "prod", Domain: "finance", Action: "UPSERT"},
+					"a2": {ID: "a2", Name: "app2", Environment: "dev", Domain: "hr", Action: "DELETE"},
+				},
+				TotalCount:        3,
+				InvalidCount:      1,
+				InvalidDataRowNum: []int{2},
+				FileType:          "APPLICATIONS",
+				TargetServices:    []string{"application"},
+			},
+		},
+		{
+			name:     "parquet acquired rights with dates and decimals",
+			fileType: "PRODUCTS_ACQUIREDRIGHTS",
+			fileName: "s1_products_acquiredRights.parquet",
+			format:   ".PARQUET",
+			want: models.FileData{
+				AcqRights: map[string]models.AcqRightsInfo{
+					"sku1": {
+						Sku: "sku1", SwidTag: "p1", ProductName: "Oracle DB", Version: "12", Editor: "Oracle", Metric: "ops",
+						NumOfAcqLic: 10, NumOfMaintenanceLic: 5, AvgPrice: 100.5, AvgMaintenantPrice: 20, TotalPurchasedCost: 1005,
+						TotalMaintenanceCost: 100, TotalCost: 1105, Action: "UPSERT",
+						StartOfMaintenance: "2021-01-01T00:00:00Z", EndOfMaintenance: "2022-01-01T00:00:00Z",
+					},
+				},
+				TotalCount:        2,
+				InvalidCount:      1,
+				InvalidDataRowNum: []int{2},
+				FileType:          "PRODUCTS_ACQUIREDRIGHTS",
+				TargetServices:    []string{"product"},
+			},
+		},
+		{
+			name:     "json equipments keep the types of the attributes",
+			fileType: "EQUIPMENT_SERVER",
+			fileName: "s1_equipment_server.json",
+			format:   ".JSON",
+			want: models.FileData{
+				Equipments: map[string][]map[string]interface{}{
+					"SERVER": {
+						{"server_id": "srv1", "cores": json.Number("8"), "ratio": json.Number("0.5"), "virtual": true},
+						{"server_id": "srv2", "cores": json.Number("16"), "ratio": json.Number("1"), "virtual": false},
+					},
+				},
+				DuplicateRecords:  []interface{}{map[string]interface{}{"server_id": "srv1", "cores": json.Number("8"), "ratio": json.Number("0.5"), "virtual": true}},
+				TotalCount:        4,
+				InvalidCount:      1,
+				InvalidDataRowNum: []int{3},
+				FileType:          "EQUIPMENT_SERVER",
+				TargetServices:    []string{"equipment"},
+			},
+		},
+		{
+			name:     "mandatory fields missing",
+			fileType: "PRODUCTS",
+			fileName: "s1_products.ndjson",
+			format:   ".NDJSON",
+			wantErr:  "rpc error: code = Internal desc = HeadersMissing",
+		},
+		{
+			name:     "file type not supported",
+			fileType: "PRODUCTS_EQUIPMENTS",
+			fileName: "s1_products_equipments.json",
+			format:   ".JSON",
+			wantErr:  "rpc error: code = Internal desc = FileNotSupported",
+		},
+		{
+			name:     "json file is not an array",
+			fileType: "APPLICATIONS",
+			fileName: "s1_applications.json",
+			format:   ".JSON",
+			wantErr:  "BadFile",
+		},
+		{
+			name:     "empty file",
+			fileType: "EQUIPMENT_CLUSTER",
+			fileName: "s1_equipment_cluster.json",
+			format:   ".JSON",
+			wantErr:  "EmptyFile",
+		},
+		{
+			name:     "file not found",
+			fileType: "PRODUCTS",
+			fileName: "s1_products.parquet",
+			format:   ".PARQUET",
+			wantErr:  "BadFile",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := structuredToFileData(tt.fileType, tt.fileName, tt.format)
+			if tt.wantErr != "" {
+				assert.Error(t, err)
+				assert.Equal(t, tt.wantErr, got.FileFailureReason)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_getFileTypeFromFileName(t *testing.T) {
+	for fileName, want := range map[string]string{
+		"s1_products.csv":                          "PRODUCTS",
+		"s1_products_acquiredRights.parquet":       "PRODUCTS_ACQUIREDRIGHTS",
+		"s1_equipment_server.ndjson":               "EQUIPMENT_SERVER",
+		"1_s1_temp.xlsx#1_s1#s1_applications.json": "APPLICATIONS",
+	} {
+		got, err := getFileTypeFromFileName(fileName, "s1")
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+}
@@ -0,0 +1,745 @@
+package fileworker
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/config"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/quality"
+	gendb "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	apiworker "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/api_worker"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/constants"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/models"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/job"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+)
+
+// defaultStreamBatchSize is the number of rows injected at once when it is not configured
+const defaultStreamBatchSize = 1000
+
+// csvGetters read the rows of the csv files of each type but the equipments
+var csvGetters = map[string]func(*bufio.Scanner, models.HeadersInfo) (models.FileData, error){
+	constants.PRODUCTS:               getProducts,
+	constants.APPLICATIONS:           getApplications,
+	constants.ProductsAcquiredRights: getAcqRightsOfProducts,
+	constants.ApplicationsProducts:   getApplicationsAndProducts,
+	constants.ProductsEquipments:     getEquipmentsOfProducts,
+	constants.ApplicationEquipments:  getEquipmentsOnApplication,
+}
+
+// streamedFileType gives the type of a file injected while it is read, empty when the file is read at once.
+// The csv inventory files are streamed, the json and parquet files are read at once.
+func streamedFileType(jobData gendb.UploadedDataFile) string {
+	if strings.Contains(strings.ToUpper(jobData.FileName), constants.METADATA) || strings.ToUpper(path.Ext(jobData.FileName)) != constants.FileExtension {
+		return ""
+	}
+	fileType, err := getFileTypeFromFileName(jobData.FileName, jobData.Scope)
+	if err != nil {
+		return ""
+	}
+	if strings.Contains(fileType, "EQUIPMENT_") {
+		return fileType
+	}
+	if _, ok := csvGetters[fileType]; ok {
+		return fileType
+	}
+	return ""
+}
+
+// rowStream reads the rows of a csv file one at a time, the values are keyed by the headers of the file
+type rowStream struct {
+	scanner *bufio.Scanner
+	header  string
+	headers []string
+	line    int
+}
+
+// streamedRow is a row of a streamed file, rec is nil when the row misses columns
+type streamedRow struct {
+	line int
+	rec  map[string]interface{}
+}
+
+func newRowStream(r io.Reader) (*rowStream, error) {
+	s := bufio.NewScanner(r)
+	if !s.Scan() {
+		if s.Err() == nil {
+			return nil, errors.New("emptyfile")
+		}
+		// the headers of a mapped file may be missing
+		if st, ok := status.FromError(s.Err()); ok {
+			return nil, errors.New(st.Message())
+		}
+		return nil, errors.New("badfile")
+	}
+	return &rowStream{
+		scanner: s,
+		header:  s.Text(),
+		headers: strings.Split(s.Text(), constants.DELIMETER),
+	}, nil
+}
+
+// next reads and parses the next row of the file, false at the end of the file
+func (r *rowStream) next() (streamedRow, bool) {
+	line, row, ok := r.nextLine()
+	if !ok {
+		return streamedRow{}, false
+	}
+	return r.parse(line, row), true
+}
+
+// nextLine reads the next row of the file as it is, false at the end of the file
+func (r *rowStream) nextLine() (int, string, bool) {
+	if !r.scanner.Scan() {
+		return 0, "", false
+	}
+	r.line++
+	return r.line, r.scanner.Text(), true
+}
+
+// parse keys the values of the row by the headers of the file
+func (r *rowStream) parse(line int, row string) streamedRow {
+	res := streamedRow{line: line}
+	list := strings.Split(row, constants.DELIMETER)
+	if len(list) < len(r.headers) {
+		return res
+	}
+	res.rec = make(map[string]interface{}, len(list))
+	for i, val := range list {
+		header := ""
+		if i < len(r.headers) {
+			header = r.headers[i]
+		}
+		res.rec[header] = val
+	}
+	return res
+}
+
+func (r *rowStream) err() error {
+	return r.scanner.Err()
+}
+
+// countRows counts the rows of a csv file but its headers
+func countRows(file string) (int32, error) {
+	csvFile, err := os.Open(file)
+	if err != nil {
+		return 0, err
+	}
+	defer csvFile.Close()
+	s := bufio.NewScanner(csvFile)
+	var rows int32 = -1
+	for s.Scan() {
+		rows++
+	}
+	if rows < 0 {
+		rows = 0
+	}
+	return rows, s.Err()
+}
+
+// streamState is the progress of a streamed file kept with its checkpoint
+type streamState struct {
+	Inserted  int32 `json:"inserted"`
+	Updated   int32 `json:"updated"`
+	Deleted   int32 `json:"deleted"`
+	Unchanged int32 `json:"unchanged"`
+	Failed    int32 `json:"failed"`
+	Jobs      int32 `json:"jobs"`
+	// Violations are the records breaking each quality rule, by rule name
+	Violations map[string]int32 `json:"violations,omitempty"`
+}
+
+// fileStream is the state of a streamed file
+type fileStream struct {
+	w           *worker
+	data        models.FileData // the file without its records
+	injectionID int32
+	mode        gendb.SyncMode
+	reader      *apiworker.SnapshotReader
+	rules       *quality.Set
+	tally       quality.Tally
+	state       streamState
+	processed   int32
+	rows        *rowStream
+	headers     models.HeadersInfo
+	// keys of the records read so far, a record read again by a later batch is a duplicate
+	keys map[string]struct{}
+	// idColumn is the column of an equipment file holding the ids of its equipments, they are keyed by their id
+	idColumn string
+	// keys of the records of a snapshot, the synced records missing from it are deleted
+	seen map[string]bool
+}
+
+// streamFile injects a csv file by batches of rows while it is read, the memory used does not grow with the size
+// of the file but for the keys of its records kept to find the duplicates.
+// Each injected batch is checkpointed with the rows read so far, a retried file carries on after the last one.
+func (w *worker) streamFile(ctx context.Context, dataFromJob gendb.UploadedDataFile, fileType, fileNameInDB string, mapping *models.FileMapping) error {
+	file := fmt.Sprintf("%s/%s", config.GetConfig().FilesLocation, dataFromJob.FileName)
+	logger.Log.Info("Streaming file", zap.Any("file", file), zap.String("fileType", fileType))
+	// a mapped file keeps the rows of its source file
+	total, err := countRows(file)
+	if err != nil || total == 0 {
+		logger.Log.Error("Error reading file", zap.Error(err), zap.Any("file", file))
+		return w.fileFailed(ctx, dataFromJob, fileNameInDB, "badfile")
+	}
+	csvFile, err := os.Open(file)
+	if err != nil {
+		logger.Log.Error("Failed to open file", zap.Error(err), zap.Any("File", file))
+		return w.fileFailed(ctx, dataFromJob, fileNameInDB, constants.BadFile)
+	}
+	defer csvFile.Close()
+	var src io.Reader = csvFile
+	if mapping != nil {
+		mapped := mapColumns(csvFile, *mapping)
+		defer mapped.Close()
+		src = mapped
+	}
+	rows, err := newRowStream(src)
+	if err != nil {
+		return w.fileFailed(ctx, dataFromJob, fileNameInDB, err.Error())
+	}
+
+	data := models.FileData{
+		FileType:       fileType,
+		FileName:       fileNameInDB,
+		Scope:          dataFromJob.Scope,
+		UploadID:       dataFromJob.UploadID,
+		GlobalID:       dataFromJob.Gid,
+		TargetServices: constants.SERVICES[fileType],
+	}
+	if strings.Contains(fileType, "EQUIPMENT_") {
+		data.TargetServices = constants.SERVICES[constants.EQUIPMENTS]
+	}
+	if dataFromJob.FileName != fileNameInDB {
+		data.TransfromedFileName = dataFromJob.FileName
+	}
+	s := &fileStream{
+		w:           w,
+		data:        data,
+		injectionID: dataFromJob.InjectionID,
+		mode:        dataFromJob.SyncMode,
+		reader:      apiworker.NewSnapshotReader(data.Scope, w.grpcServers),
+		tally:       make(quality.Tally),
+		rows:        rows,
+		keys:        make(map[string]struct{}),
+		seen:        make(map[string]bool),
+	}
+	if s.mode == "" {
+		s.mode = gendb.SyncModeFULL
+	}
+	if strings.Contains(fileType, "EQUIPMENT_") {
+		column, err := s.reader.EquipmentIDColumn(ctx, strings.Split(fileType, "_")[1])
+		if err != nil {
+			logger.Log.Error("Failed to get the id column of the equipments of the file", zap.Any("filename", dataFromJob.FileName), zap.Error(err))
+			return err
+		}
+		for _, header := range rows.headers {
+			if column != "" && strings.EqualFold(header, column) {
+				s.idColumn = header
+			}
+		}
+	}
+	if _, ok := csvGetters[fileType]; ok {
+		expectedHeaders, err := getHeadersForFileType(fileType)
+		if err != nil {
+			return w.fileFailed(ctx, dataFromJob, fileNameInDB, err.Error())
+		}
+		if s.headers, err = getIndexOfHeaders(rows.header, expectedHeaders); err != nil {
+			return w.fileFailed(ctx, dataFromJob, fileNameInDB, status.Convert(err).Message())
+		}
+	}
+
+	cp, err := w.Queries.GetStreamCheckpoint(ctx, gendb.GetStreamCheckpointParams{UploadID: data.UploadID, FileName: fileNameInDB})
+	resumed := err == nil
+	if err != nil && err != sql.ErrNoRows {
+		logger.Log.Error("Failed to get the checkpoint of the file", zap.Any("filename", dataFromJob.FileName), zap.Error(err))
+		return err
+	}
+	if s.injectionID > 0 {
+		staged, err := s.restage(ctx, resumed)
+		if err != nil || staged {
+			return err
+		}
+	}
+	// the total is known before any job is pushed so that the file is not completed before it is fully read,
+	// a snapshot counts its missing records once all the rows are read
+	if s.mode == gendb.SyncModeSNAPSHOT {
+		total++
+	}
+	if err := w.Queries.UpdateFileTotalRecord(ctx, gendb.UpdateFileTotalRecordParams{
+		FileName:     fileNameInDB,
+		UploadID:     dataFromJob.UploadID,
+		TotalRecords: total,
+	}); err != nil {
+		logger.Log.Error("Failed to update total Records in DB for file ", zap.Any("filename", dataFromJob.FileName), zap.Error(err))
+		return err
+	}
+	if s.rules, err = w.qualityRuleSet(ctx, data.Scope, fileType); err != nil {
+		logger.Log.Error("Failed to check the quality rules of the file", zap.Any("filename", dataFromJob.FileName), zap.Error(err))
+		return err
+	}
+	size := config.GetConfig().StreamBatchSize
+	if size <= 0 {
+		size = defaultStreamBatchSize
+	}
+	if resumed {
+		if err := s.resume(ctx, cp, size); err != nil {
+			logger.Log.Error("Failed to resume the file from its checkpoint", zap.Any("filename", dataFromJob.FileName), zap.Error(err))
+			return err
+		}
+		logger.Log.Info("File resumed from its checkpoint", zap.Any("file", file), zap.Int32("rows", s.processed))
+	}
+	for first, lines := s.readBatch(size); len(lines) > 0; first, lines = s.readBatch(size) {
+		batch, err := s.batch(first, lines)
+		if err == nil {
+			err = s.flush(ctx, batch, int32(len(lines)))
+		}
+		if err != nil {
+			logger.Log.Error("Failed to inject a batch of the file", zap.Any("filename", dataFromJob.FileName), zap.Int32("processed", s.processed), zap.Error(err))
+			return err
+		}
+	}
+	if err := rows.err(); err != nil {
+		logger.Log.Error("Error reading file", zap.Error(err), zap.Any("file", file))
+		return w.fileFailed(ctx, dataFromJob, fileNameInDB, constants.BadFile)
+	}
+	logger.Log.Info("File processed ", zap.Any("file", file), zap.Int32("rows", s.processed))
+	return s.done(ctx)
+}
+
+// restage prepares the file of an injection to be staged, it tells if the file was already staged.
+// The jobs inserted by a previous try are kept up to its checkpoint.
+func (s *fileStream) restage(ctx context.Context, resumed bool) (bool, error) {
+	if !resumed {
+		return s.w.restageInjectionFile(ctx, s.injectionID, s.data.FileName)
+	}
+	staged, err := s.w.Queries.InjectionFileStaged(ctx, gendb.InjectionFileStagedParams{InjectionID: s.injectionID, FileName: s.data.FileName})
+	if err != nil || !staged {
+		return false, err
+	}
+	return true, s.w.resumeInjection(ctx, s.injectionID)
+}
+
+// readBatch reads at most size rows of the file, first is the line of the first row read
+func (s *fileStream) readBatch(size int) (first int, lines []string) {
+	for len(lines) < size {
+		line, row, ok := s.rows.nextLine()
+		if !ok {
+			break
+		}
+		if len(lines) == 0 {
+			first = line
+		}
+		lines = append(lines, row)
+	}
+	return first, lines
+}
+
+// batch reads the records of the rows of a batch, the records of the previous batches are duplicates
+func (s *fileStream) batch(first int, lines []string) (models.FileData, error) {
+	batch := s.data
+	batch.DuplicateRecords = make([]interface{}, 0)
+	if strings.Contains(batch.FileType, "EQUIPMENT_") {
+		eqType := strings.Split(batch.FileType, "_")[1]
+		recs := make([]map[string]interface{}, 0, len(lines))
+		for i, line := range lines {
+			row := s.rows.parse(first+i, line)
+			switch {
+			case row.rec == nil:
+				batch.InvalidCount++
+				batch.InvalidDataRowNum = append(batch.InvalidDataRowNum, row.line)
+			case s.knownEquipment(eqType, row.rec):
+				batch.DuplicateRecords = append(batch.DuplicateRecords, row.rec)
+			default:
+				recs = append(recs, row.rec)
+			}
+		}
+		batch.Equipments = map[string][]map[string]interface{}{eqType: recs}
+		return batch, nil
+	}
+	recs, err := csvGetters[batch.FileType](bufio.NewScanner(strings.NewReader(strings.Join(lines, "\n"))), s.headers)
+	if err != nil {
+		return batch, err
+	}
+	batch.Products = recs.Products
+	batch.Applications = recs.Applications
+	batch.AcqRights = recs.AcqRights
+	batch.AppProducts = recs.AppProducts
+	batch.ProdEquipments = recs.ProdEquipments
+	batch.EquipApplications = recs.EquipApplications
+	batch.InvalidCount = recs.InvalidCount
+	// the rows are numbered from the first row of the file
+	for _, n := range recs.InvalidDataRowNum {
+		batch.InvalidDataRowNum = append(batch.InvalidDataRowNum, n+first-1)
+	}
+	batch.DuplicateRecords = append(batch.DuplicateRecords, recs.DuplicateRecords...)
+	dropDuplicates(&batch, s.keys)
+	return batch, nil
+}
+
+// knownEquipment tells if an equipment with the id of the record was read before, the equipments without id
+// are not deduplicated
+func (s *fileStream) knownEquipment(eqType string, rec map[string]interface{}) bool {
+	id, _ := rec[s.idColumn].(string)
+	if s.idColumn == "" || id == "" {
+		return false
+	}
+	// the equipments are keyed as the synced ones
+	key := strings.ToLower(eqType) + "/" + id
+	if _, ok := s.keys[key]; ok {
+		return true
+	}
+	s.keys[key] = struct{}{}
+	return false
+}
+
+// dropDuplicates moves to the duplicate records of the batch its records whose key was read before,
+// the records are keyed as by the duplicate check of a file read at once
+func dropDuplicates(data *models.FileData, keys map[string]struct{}) {
+	known := func(key string) bool {
+		if _, ok := keys[key]; ok {
+			return true
+		}
+		keys[key] = struct{}{}
+		return false
+	}
+	for k, p := range data.Products {
+		if known(p.SwidTag) {
+			delete(data.Products, k)
+			data.DuplicateRecords = append(data.DuplicateRecords, p)
+		}
+	}
+	for k, a := range data.Applications {
+		if known(a.ID) {
+			delete(data.Applications, k)
+			data.DuplicateRecords = append(data.DuplicateRecords, a)
+		}
+	}
+	for k, a := range data.AcqRights {
+		if known(a.Sku) {
+			delete(data.AcqRights, k)
+			data.DuplicateRecords = append(data.DuplicateRecords, a)
+		}
+	}
+	for action, links := range data.AppProducts {
+		for prodID, appIDs := range links {
+			kept := appIDs[:0]
+			for _, appID := range appIDs {
+				if known(strings.Join([]string{action, prodID, appID}, constants.DELIMETER)) {
+					data.DuplicateRecords = append(data.DuplicateRecords, models.ProdApplink{ProdID: prodID, AppID: appID, Action: action})
+					continue
+				}
+				kept = append(kept, appID)
+			}
+			if len(kept) == 0 {
+				delete(links, prodID)
+			} else {
+				links[prodID] = kept
+			}
+		}
+	}
+	for _, links := range data.ProdEquipments {
+		for swidTag, equips := range links {
+			kept := equips[:0]
+			for _, e := range equips {
+				if known(e.SwidTag + "_" + e.EquipID) {
+					data.DuplicateRecords = append(data.DuplicateRecords, models.ProductEquipmentLink{
+						ProdID:          e.SwidTag,
+						EquipID:         e.EquipID,
+						AllocatedMetric: e.AllocatedMetric,
+						AllocatedUsers:  e.AllocatedUsers,
+						Action:          e.Action,
+					})
+					continue
+				}
+				kept = append(kept, e)
+			}
+			if len(kept) == 0 {
+				delete(links, swidTag)
+			} else {
+				links[swidTag] = kept
+			}
+		}
+	}
+	for action, links := range data.EquipApplications {
+		for appID, equipIDs := range links {
+			kept := equipIDs[:0]
+			for _, equipID := range equipIDs {
+				if known(strings.Join([]string{action, appID, equipID}, constants.DELIMETER)) {
+					data.DuplicateRecords = append(data.DuplicateRecords, models.EquipmentApplicationLink{AppID: appID, EquipID: equipID, Action: action})
+					continue
+				}
+				kept = append(kept, equipID)
+			}
+			if len(kept) == 0 {
+				delete(links, appID)
+			} else {
+				links[appID] = kept
+			}
+		}
+	}
+}
+
+// flush injects a batch of rows of the file and checkpoints it, rows is the number of rows read for the batch
+func (s *fileStream) flush(ctx context.Context, batch models.FileData, rows int32) error {
+	var check qualityCheck
+	if s.rules != nil {
+		check = applyQualityRules(s.rules, &batch)
+	}
+	jobs, err := createAPITypeJobs(ctx, batch, s.w)
+	if err != nil {
+		return err
+	}
+	jobs, counts, seen, err := s.w.syncBatch(ctx, batch, s.mode, jobs, s.reader)
+	if err != nil {
+		return err
+	}
+	s.snapshotSeen(seen, check)
+	failed := invalidRecordJobs(batch, batch.UploadID, batch.FileName)
+	failed = append(failed, duplicateRecordJobs(batch, batch.UploadID, batch.FileName)...)
+	failed = append(failed, rejectedRecordJobs(check, batch, batch.UploadID, batch.FileName)...)
+	for rule, n := range check.tally {
+		s.tally[rule] += n
+	}
+	s.state.Inserted += counts.inserted
+	s.state.Updated += counts.updated
+	s.state.Deleted += counts.deleted
+	s.state.Unchanged += counts.unchanged
+	s.state.Failed += batch.InvalidCount + int32(len(batch.DuplicateRecords)) + int32(len(check.rejected))
+	s.processed += rows
+	if s.injectionID > 0 {
+		// the jobs of a transactional injection are pushed when all its files are read
+		s.state.Jobs += int32(len(jobs))
+	}
+	return s.checkpoint(ctx, jobs, failed)
+}
+
+// snapshotSeen keeps the keys of the records of a snapshot, the rejected ones are not deleted as missing
+func (s *fileStream) snapshotSeen(seen map[string]bool, check qualityCheck) {
+	if s.mode != gendb.SyncModeSNAPSHOT {
+		return
+	}
+	for key := range seen {
+		s.seen[key] = true
+	}
+	for key := range check.keys {
+		s.seen[key] = true
+	}
+}
+
+// checkpoint keeps the rows read so far with the progress of the file, the jobs of the batch and the failed
+// records are inserted in the same transaction so that a retried file carries on after the last checkpointed
+// batch without injecting or recording its rows twice
+func (s *fileStream) checkpoint(ctx context.Context, jobs, failed []job.Job) (retErr error) {
+	s.state.Violations = make(map[string]int32, len(s.tally))
+	for rule, n := range s.tally {
+		s.state.Violations[rule.Name] = n
+	}
+	state, err := json.Marshal(s.state)
+	if err != nil {
+		return err
+	}
+	tx, err := s.w.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+				logger.Log.Error("Failed to rollback the checkpoint", zap.String("file", s.data.FileName), zap.Error(err))
+			}
+		}
+	}()
+	q := s.w.Queries.WithTx(tx)
+	if s.injectionID > 0 {
+		if err := insertInjectionJobs(ctx, q, s.injectionID, s.data.FileName, jobs); err != nil {
+			return err
+		}
+	} else {
+		for _, j := range jobs {
+			j.Priority = job.PriorityLow
+			if _, err := workerqueue.CreateJob(ctx, tx, j); err != nil {
+				return err
+			}
+		}
+	}
+	for _, j := range failed {
+		j.EndTime = sql.NullTime{Time: time.Now(), Valid: true}
+		if _, err := workerqueue.CreateJob(ctx, tx, j); err != nil {
+			return err
+		}
+	}
+	if err := q.UpsertStreamCheckpoint(ctx, gendb.UpsertStreamCheckpointParams{
+		UploadID: s.data.UploadID,
+		FileName: s.data.FileName,
+		RowsRead: s.processed,
+		State:    state,
+	}); err != nil {
+		return err
+	}
+	if err := q.UpdateFileProcessedRecords(ctx, gendb.UpdateFileProcessedRecordsParams{
+		UploadID:         s.data.UploadID,
+		FileName:         s.data.FileName,
+		ProcessedRecords: s.processed,
+	}); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// resume restores the progress of the file from its checkpoint, the checkpointed rows are read again
+// by batches of the same size to find the duplicates and the records of a snapshot but are not injected again
+func (s *fileStream) resume(ctx context.Context, cp gendb.StreamCheckpoint, size int) error {
+	if err := json.Unmarshal(cp.State, &s.state); err != nil {
+		return err
+	}
+	for _, rule := range s.rules.Rules(quality.EntityOfFileType(s.data.FileType)) {
+		if n, ok := s.state.Violations[rule.Name]; ok {
+			s.tally[rule] = n
+		}
+	}
+	for s.processed < cp.RowsRead {
+		n := size
+		if left := int(cp.RowsRead - s.processed); left < n {
+			n = left
+		}
+		first, lines := s.readBatch(n)
+		if len(lines) == 0 {
+			return s.rows.err()
+		}
+		batch, err := s.batch(first, lines)
+		if err != nil {
+			return err
+		}
+		s.processed += int32(len(lines))
+		if s.mode != gendb.SyncModeSNAPSHOT {
+			continue
+		}
+		var check qualityCheck
+		if s.rules != nil {
+			check = applyQualityRules(s.rules, &batch)
+		}
+		jobs, err := createAPITypeJobs(ctx, batch, s.w)
+		if err != nil {
+			return err
+		}
+		seen, err := batchKeys(ctx, batch, jobs, s.reader)
+		if err != nil {
+			return err
+		}
+		s.snapshotSeen(seen, check)
+	}
+	return nil
+}
+
+// done counts the records of the file which are not pushed once all its rows are read,
+// the synced records missing from a snapshot are deleted
+func (s *fileStream) done(ctx context.Context) error {
+	w, data := s.w, s.data
+	if s.mode == gendb.SyncModeSNAPSHOT {
+		if err := s.deleteAbsent(ctx); err != nil {
+			logger.Log.Error("Failed to delete the records missing from the snapshot", zap.Any("filename", data.FileName), zap.Error(err))
+			return err
+		}
+	}
+	if err := w.Queries.UpdateFileSyncRecords(ctx, gendb.UpdateFileSyncRecordsParams{
+		UploadID:        data.UploadID,
+		FileName:        data.FileName,
+		InsertedRecords: s.state.Inserted,
+		UpdatedRecords:  s.state.Updated,
+		DeletedRecords:  s.state.Deleted,
+	}); err != nil {
+		logger.Log.Error("Failed to update synced records in DB for file ", zap.Any("filename", data.FileName), zap.Error(err))
+		return err
+	}
+	if s.injectionID > 0 {
+		if err := w.stageInjectionFile(ctx, s.injectionID, data.FileName, s.state.Jobs); err != nil {
+			logger.Log.Error("Failed to stage the jobs of the injection", zap.Int32("injection", s.injectionID), zap.Error(err))
+			return err
+		}
+	}
+	if s.state.Failed > 0 {
+		resp, err := w.Queries.UpdateFileFailedRecord(ctx, gendb.UpdateFileFailedRecordParams{
+			UploadID:      data.UploadID,
+			FileName:      data.FileName,
+			FailedRecords: s.state.Failed,
+		})
+		if err == nil {
+			err = w.fileRecordsDone(ctx, data, data.UploadID, data.FileName, resp.Issuccess, resp.Isfailed, resp.Ispartial)
+		}
+		if err != nil {
+			logger.Log.Error("Failed to count the failed records of the file", zap.Any("filename", data.FileName), zap.Error(err))
+		}
+	}
+	// the unchanged records of a delta are not pushed again
+	if s.mode != gendb.SyncModeFULL && s.state.Unchanged > 0 {
+		if err := w.syncedRecordsDone(ctx, data, data.UploadID, data.FileName, s.state.Unchanged); err != nil {
+			logger.Log.Error("Failed to count the unchanged records of the file", zap.Any("filename", data.FileName), zap.Error(err))
+		}
+	}
+	w.saveQualityResults(ctx, qualityCheck{tally: s.tally}, data, data.UploadID, data.FileName)
+	if err := w.Queries.DeleteStreamCheckpoint(ctx, gendb.DeleteStreamCheckpointParams{UploadID: data.UploadID, FileName: data.FileName}); err != nil {
+		logger.Log.Error("Failed to delete the checkpoint of the file", zap.Any("filename", data.FileName), zap.Error(err))
+	}
+	return nil
+}
+
+// deleteAbsent deletes the synced records missing from the snapshot, the total of the file counted one more record
+// until they are known
+func (s *fileStream) deleteAbsent(ctx context.Context) error {
+	w, data := s.w, s.data
+	entity := syncEntity(data.FileType)
+	synced, err := w.Queries.ListSyncedRecords(ctx, gendb.ListSyncedRecordsParams{Scope: data.Scope, Entity: entity})
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	baseline := make(map[string]gendb.SyncedRecord, len(synced))
+	for _, rec := range synced {
+		baseline[rec.RecordKey] = rec
+	}
+	envlopes, absent, err := absentEnvlopes(data, entity, baseline, s.seen)
+	if err != nil {
+		return err
+	}
+	jobs, err := envlopeJobs(envlopes)
+	if err != nil {
+		return err
+	}
+	if s.injectionID > 0 {
+		if err := insertInjectionJobs(ctx, w.Queries, s.injectionID, data.FileName, jobs); err != nil {
+			return err
+		}
+		s.state.Jobs += int32(len(jobs))
+	} else {
+		for _, j := range jobs {
+			j.Priority = job.PriorityLow
+			if _, err := w.Queue.PushJob(ctx, j, constants.APIWORKER); err != nil {
+				logger.Log.Error("Job not pushed Successfully:", zap.Int32("job", j.JobID), zap.Error(err))
+			}
+		}
+	}
+	s.state.Deleted += absent
+	resp, err := w.Queries.AddFileTotalRecords(ctx, gendb.AddFileTotalRecordsParams{
+		UploadID:     data.UploadID,
+		FileName:     data.FileName,
+		TotalRecords: absent - 1,
+	})
+	if err != nil {
+		return err
+	}
+	return w.fileRecordsDone(ctx, data, data.UploadID, data.FileName, resp.Issuccess, resp.Isfailed, resp.Ispartial)
+}
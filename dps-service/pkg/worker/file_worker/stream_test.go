@@ -0,0 +1,197 @@
+package fileworker
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	gendb "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/constants"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_rowStream(t *testing.T) {
+	rows, err := newRowStream(strings.NewReader("server_id;cpu\ns1;4\ns2\ns1;4\ns3;8;extra\n"))
+	if !assert.Empty(t, err) {
+		return
+	}
+	var got []streamedRow
+	for row, ok := rows.next(); ok; row, ok = rows.next() {
+		got = append(got, row)
+	}
+	assert.Empty(t, rows.err())
+	assert.Equal(t, []streamedRow{
+		{line: 1, rec: map[string]interface{}{"server_id": "s1", "cpu": "4"}},
+		{line: 2},
+		{line: 3, rec: map[string]interface{}{"server_id": "s1", "cpu": "4"}},
+		{line: 4, rec: map[string]interface{}{"server_id": "s3", "cpu": "8", "": "extra"}},
+	}, got)
+
+	_, err = newRowStream(strings.NewReader(""))
+	assert.EqualError(t, err, "emptyfile")
+}
+
+func Test_countRows(t *testing.T) {
+	dir, err := ioutil.TempDir("", "stream")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	tests := []struct {
+		name    string
+		content string
+		want    int32
+	}{
+		{name: "rows", content: "server_id;cpu\ns1;4\ns2;8\n", want: 2},
+		{name: "headers only", content: "server_id;cpu\n", want: 0},
+		{name: "empty", content: "", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(dir, strings.ReplaceAll(tt.name, " ", "_")+".csv")
+			if err := ioutil.WriteFile(file, []byte(tt.content), 0600); err != nil {
+				t.Fatal(err)
+			}
+			got, err := countRows(file)
+			assert.Empty(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+	_, err = countRows(filepath.Join(dir, "missing.csv"))
+	assert.Error(t, err)
+}
+
+func Test_streamedFileType(t *testing.T) {
+	tests := []struct {
+		name string
+		file gendb.UploadedDataFile
+		want string
+	}{
+		{name: "equipment csv", file: gendb.UploadedDataFile{Scope: "OFR", FileName: "OFR_equipment_server.csv"}, want: "EQUIPMENT_SERVER"},
+		{name: "equipment parquet", file: gendb.UploadedDataFile{Scope: "OFR", FileName: "OFR_equipment_server.parquet"}},
+		{name: "products", file: gendb.UploadedDataFile{Scope: "OFR", FileName: "OFR_products.csv"}, want: "PRODUCTS"},
+		{name: "products json", file: gendb.UploadedDataFile{Scope: "OFR", FileName: "OFR_products.json"}},
+		{name: "metadata", file: gendb.UploadedDataFile{Scope: "OFR", FileName: "OFR_metadata_equipment_server.csv"}},
+		{name: "other scope", file: gendb.UploadedDataFile{Scope: "OFR", FileName: "AAK_equipment_server.csv"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, streamedFileType(tt.file))
+		})
+	}
+}
+
+func Test_fileStream_batch(t *testing.T) {
+	rows, err := newRowStream(strings.NewReader("swidtag;name;version;editor;flag\np1;n1;v1;e1;1\np2\np1;n1;v1;e1;1\np3;n3;v3;e3;1\np1;n9;v9;e9;1\n"))
+	if !assert.Empty(t, err) {
+		return
+	}
+	headers, err := getIndexOfHeaders(rows.header, []string{constants.SWIDTAG, constants.NAME, constants.VERSION, constants.EDITOR, constants.FLAG})
+	if !assert.Empty(t, err) {
+		return
+	}
+	s := &fileStream{
+		data:    models.FileData{FileType: constants.PRODUCTS, Scope: "OFR"},
+		rows:    rows,
+		headers: headers,
+		keys:    make(map[string]struct{}),
+	}
+	first, lines := s.readBatch(3)
+	assert.Equal(t, 1, first)
+	got, err := s.batch(first, lines)
+	if !assert.Empty(t, err) {
+		return
+	}
+	assert.Equal(t, map[string]models.ProductInfo{
+		"p1": {SwidTag: "p1", Name: "n1", Version: "v1", Editor: "e1", Action: constants.ActionType["1"]},
+	}, got.Products)
+	assert.Equal(t, int32(1), got.InvalidCount)
+	assert.Equal(t, []int{2}, got.InvalidDataRowNum)
+	assert.Len(t, got.DuplicateRecords, 1)
+
+	// the record of a previous batch is a duplicate
+	first, lines = s.readBatch(3)
+	assert.Equal(t, 4, first)
+	got, err = s.batch(first, lines)
+	if !assert.Empty(t, err) {
+		return
+	}
+	assert.Equal(t, map[string]models.ProductInfo{
+		"p3": {SwidTag: "p3", Name: "n3", Version: "v3", Editor: "e3", Action: constants.ActionType["1"]},
+	}, got.Products)
+	assert.Equal(t, []interface{}{models.ProductInfo{SwidTag: "p1", Name: "n9", Version: "v9", Editor: "e9", Action: constants.ActionType["1"]}}, got.DuplicateRecords)
+
+	_, lines = s.readBatch(3)
+	assert.Empty(t, lines)
+}
+
+func Test_fileStream_batchEquipments(t *testing.T) {
+	rows, err := newRowStream(strings.NewReader("server_id;cpu\ns1;4\ns2\ns1;8\n;2\n;2\n"))
+	if !assert.Empty(t, err) {
+		return
+	}
+	s := &fileStream{
+		data:     models.FileData{FileType: "EQUIPMENT_SERVER", Scope: "OFR"},
+		rows:     rows,
+		idColumn: "server_id",
+		keys:     map[string]struct{}{"server/s0": {}},
+	}
+	first, lines := s.readBatch(5)
+	got, err := s.batch(first, lines)
+	if !assert.Empty(t, err) {
+		return
+	}
+	// the equipments are duplicates on their id whatever their other attributes, the ones without id are kept
+	assert.Equal(t, map[string][]map[string]interface{}{"SERVER": {
+		{"server_id": "s1", "cpu": "4"},
+		{"server_id": "", "cpu": "2"},
+		{"server_id": "", "cpu": "2"},
+	}}, got.Equipments)
+	assert.Equal(t, []int{2}, got.InvalidDataRowNum)
+	assert.Equal(t, []interface{}{map[string]interface{}{"server_id": "s1", "cpu": "8"}}, got.DuplicateRecords)
+
+	rows, err = newRowStream(strings.NewReader("server_id;cpu\ns0;4\n"))
+	if !assert.Empty(t, err) {
+		return
+	}
+	s.rows = rows
+	first, lines = s.readBatch(5)
+	got, err = s.batch(first, lines)
+	if !assert.Empty(t, err) {
+		return
+	}
+	// the equipment of a previous batch is a duplicate
+	assert.Equal(t, map[string][]map[string]interface{}{"SERVER": {}}, got.Equipments)
+	assert.Len(t, got.DuplicateRecords, 1)
+}
+
+func Test_dropDuplicates(t *testing.T) {
+	keys := map[string]struct{}{
+		strings.Join([]string{constants.UPSERT, "a1", "e1"}, constants.DELIMETER): {},
+		"p1_e1": {},
+	}
+	data := models.FileData{
+		EquipApplications: map[string]map[string][]string{
+			constants.UPSERT: {"a1": {"e1", "e2"}, "a2": {"e1"}},
+			constants.DELETE: {"a1": {"e1"}},
+		},
+		ProdEquipments: map[string]map[string][]models.ProdEquipemtInfo{
+			constants.UPSERT: {"p1": {{SwidTag: "p1", EquipID: "e1", Action: constants.UPSERT}}},
+		},
+	}
+	dropDuplicates(&data, keys)
+	assert.Equal(t, map[string]map[string][]string{
+		constants.UPSERT: {"a1": {"e2"}, "a2": {"e1"}},
+		constants.DELETE: {"a1": {"e1"}},
+	}, data.EquipApplications)
+	assert.Equal(t, map[string]map[string][]models.ProdEquipemtInfo{constants.UPSERT: {}}, data.ProdEquipments)
+	assert.ElementsMatch(t, []interface{}{
+		models.EquipmentApplicationLink{AppID: "a1", EquipID: "e1", Action: constants.UPSERT},
+		models.ProductEquipmentLink{ProdID: "p1", EquipID: "e1", Action: constants.UPSERT},
+	}, data.DuplicateRecords)
+	assert.Len(t, keys, 5)
+}
@@ -0,0 +1,367 @@
+package fileworker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	application "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/thirdparty/application-service/pkg/api/v1"
+	product "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/thirdparty/product-service/pkg/api/v1"
+
+	gendb "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	apiworker "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/api_worker"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/constants"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/models"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/job"
+
+	"go.uber.org/zap"
+)
+
+// syncCounts are the records of a file compared with the records synced by the previous uploads
+type syncCounts struct {
+	inserted  int32
+	updated   int32
+	deleted   int32
+	unchanged int32
+	absent    int32 // records deleted as they are missing from the snapshot
+}
+
+// syncJobs compares the jobs of the file with the synced records of the scope, the unchanged records
+// are not pushed by a delta and the records missing from a snapshot are deleted, but the rejected ones
+func (w *worker) syncJobs(ctx context.Context, data models.FileData, mode gendb.SyncMode, jobs []job.Job, rejected map[string]bool) ([]job.Job, syncCounts, error) {
+	delta, counts, err := w.compareJobs(ctx, data, mode, jobs, rejected)
+	if err != nil && mode == gendb.SyncModeFULL {
+		// a full upload does not need the synced records
+		logger.Log.Error("Failed to count the synced records of the file", zap.String("file", data.FileName), zap.Error(err))
+		return jobs, syncCounts{}, nil
+	}
+	return delta, counts, err
+}
+
+func (w *worker) compareJobs(ctx context.Context, data models.FileData, mode gendb.SyncMode, jobs []job.Job, rejected map[string]bool) ([]job.Job, syncCounts, error) {
+	entity := syncEntity(data.FileType)
+	if entity == "" {
+		return jobs, syncCounts{}, nil
+	}
+	envlopes, err := keyedEnvlopes(ctx, data, jobs, apiworker.NewSnapshotReader(data.Scope, w.grpcServers))
+	if err != nil {
+		return nil, syncCounts{}, err
+	}
+	synced, err := w.Queries.ListSyncedRecords(ctx, gendb.ListSyncedRecordsParams{Scope: data.Scope, Entity: entity})
+	if err != nil && err != sql.ErrNoRows {
+		logger.Log.Error("Failed to list synced records", zap.Error(err), zap.String("scope", data.Scope), zap.String("entity", entity))
+		return nil, syncCounts{}, err
+	}
+	// an equipment file only holds the equipments of its type
+	prefix := ""
+	for eqType := range data.Equipments {
+		prefix = strings.ToLower(eqType) + "/"
+	}
+	baseline := make(map[string]gendb.SyncedRecord, len(synced))
+	for _, rec := range synced {
+		if strings.HasPrefix(rec.RecordKey, prefix) {
+			baseline[rec.RecordKey] = rec
+		}
+	}
+	envlopes, counts, seen, err := deltaEnvlopes(mode, envlopes, baseline)
+	if err != nil {
+		return nil, syncCounts{}, err
+	}
+	if mode == gendb.SyncModeSNAPSHOT {
+		for key := range rejected {
+			seen[key] = true
+		}
+		deletions, absent, err := absentEnvlopes(data, entity, baseline, seen)
+		if err != nil {
+			return nil, syncCounts{}, err
+		}
+		envlopes = append(envlopes, deletions...)
+		counts.absent = absent
+		counts.deleted += absent
+	}
+	jobs, err = envlopeJobs(envlopes)
+	if err != nil {
+		return nil, syncCounts{}, err
+	}
+	return jobs, counts, nil
+}
+
+// syncBatch compares a batch of the jobs of a streamed file with their synced records only, it gives the keys
+// of the records of the batch so that the synced records missing from a snapshot are deleted once the file is read.
+func (w *worker) syncBatch(ctx context.Context, data models.FileData, mode gendb.SyncMode, jobs []job.Job, reader *apiworker.SnapshotReader) ([]job.Job, syncCounts, map[string]bool, error) {
+	delta, counts, seen, err := w.compareBatch(ctx, data, mode, jobs, reader)
+	if err != nil && mode == gendb.SyncModeFULL {
+		logger.Log.Error("Failed to count the synced records of the batch", zap.String("file", data.FileName), zap.Error(err))
+		return jobs, syncCounts{}, nil, nil
+	}
+	return delta, counts, seen, err
+}
+
+func (w *worker) compareBatch(ctx context.Context, data models.FileData, mode gendb.SyncMode, jobs []job.Job, reader *apiworker.SnapshotReader) ([]job.Job, syncCounts, map[string]bool, error) {
+	entity := syncEntity(data.FileType)
+	if entity == "" {
+		return jobs, syncCounts{}, nil, nil
+	}
+	envlopes, err := keyedEnvlopes(ctx, data, jobs, reader)
+	if err != nil {
+		return nil, syncCounts{}, nil, err
+	}
+	keys, err := envlopeKeys(envlopes)
+	if err != nil {
+		return nil, syncCounts{}, nil, err
+	}
+	list := make([]string, 0, len(keys))
+	for key := range keys {
+		list = append(list, key)
+	}
+	synced, err := w.Queries.ListSyncedRecordsByKeys(ctx, gendb.ListSyncedRecordsByKeysParams{Scope: data.Scope, Entity: entity, RecordKeys: list})
+	if err != nil && err != sql.ErrNoRows {
+		logger.Log.Error("Failed to list synced records", zap.Error(err), zap.String("scope", data.Scope), zap.String("file", data.FileName))
+		return nil, syncCounts{}, nil, err
+	}
+	baseline := make(map[string]gendb.SyncedRecord, len(synced))
+	for _, rec := range synced {
+		baseline[rec.RecordKey] = rec
+	}
+	envlopes, counts, seen, err := deltaEnvlopes(mode, envlopes, baseline)
+	if err != nil {
+		return nil, syncCounts{}, nil, err
+	}
+	jobs, err = envlopeJobs(envlopes)
+	if err != nil {
+		return nil, syncCounts{}, nil, err
+	}
+	return jobs, counts, seen, nil
+}
+
+// batchKeys gives the keys of the synced records of the jobs of a batch
+func batchKeys(ctx context.Context, data models.FileData, jobs []job.Job, reader *apiworker.SnapshotReader) (map[string]bool, error) {
+	envlopes, err := keyedEnvlopes(ctx, data, jobs, reader)
+	if err != nil {
+		return nil, err
+	}
+	return envlopeKeys(envlopes)
+}
+
+func envlopeKeys(envlopes []models.Envlope) (map[string]bool, error) {
+	keys := make(map[string]bool, len(envlopes))
+	for _, env := range envlopes {
+		records, err := apiworker.SyncRecords(env)
+		if err != nil {
+			return nil, err
+		}
+		for _, rec := range records {
+			keys[rec.Key] = true
+		}
+	}
+	return keys, nil
+}
+
+// keyedEnvlopes reads the envlopes of the jobs, the equipments are keyed by the value of their primary key attribute
+func keyedEnvlopes(ctx context.Context, data models.FileData, jobs []job.Job, reader *apiworker.SnapshotReader) ([]models.Envlope, error) {
+	envlopes := make([]models.Envlope, 0, len(jobs))
+	for _, j := range jobs {
+		env := models.Envlope{}
+		if err := json.Unmarshal(j.Data, &env); err != nil {
+			return nil, err
+		}
+		env.Scope = data.Scope
+		if env.TargetRPC == constants.EQUIPMENTS {
+			req := models.EquipmentRequest{}
+			if err := json.Unmarshal(env.Data, &req); err != nil {
+				return nil, err
+			}
+			key, err := reader.EquipmentKey(ctx, req)
+			if err != nil {
+				logger.Log.Error("Failed to get the key of the equipment", zap.Error(err), zap.String("file", data.FileName))
+				return nil, err
+			}
+			env.RecordKey = key
+		}
+		envlopes = append(envlopes, env)
+	}
+	return envlopes, nil
+}
+
+func envlopeJobs(envlopes []models.Envlope) ([]job.Job, error) {
+	jobs := make([]job.Job, 0, len(envlopes))
+	for _, env := range envlopes {
+		b, err := json.Marshal(env)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job.Job{Status: job.JobStatusPENDING, Type: constants.APITYPE, Data: b})
+	}
+	return jobs, nil
+}
+
+// deltaEnvlopes counts the inserted, updated and deleted records of the jobs,
+// unless the file is fully synced the jobs only keep their changed records
+func deltaEnvlopes(mode gendb.SyncMode, envlopes []models.Envlope, baseline map[string]gendb.SyncedRecord) ([]models.Envlope, syncCounts, map[string]bool, error) {
+	var counts syncCounts
+	seen := make(map[string]bool)
+	delta := make([]models.Envlope, 0, len(envlopes))
+	for _, env := range envlopes {
+		records, err := apiworker.SyncRecords(env)
+		if err != nil {
+			return nil, syncCounts{}, nil, err
+		}
+		if len(records) == 0 {
+			// the records of the job cannot be compared
+			delta = append(delta, env)
+			continue
+		}
+		var changed []apiworker.SyncRecord
+		for _, rec := range records {
+			seen[rec.Key] = true
+			old, ok := baseline[rec.Key]
+			switch {
+			case rec.Deleted:
+				counts.deleted++
+			case !ok:
+				counts.inserted++
+			case old.Hash != rec.Hash():
+				counts.updated++
+			default:
+				counts.unchanged++
+				continue
+			}
+			changed = append(changed, rec)
+		}
+		if mode == gendb.SyncModeFULL || len(changed) == len(records) {
+			delta = append(delta, env)
+			continue
+		}
+		if len(changed) == 0 {
+			continue
+		}
+		// only links are grouped in a job
+		env.Data, err = apiworker.LinksPayload(env.TargetRPC, linksOperation(changed), changed)
+		if err != nil {
+			return nil, syncCounts{}, nil, err
+		}
+		delta = append(delta, env)
+	}
+	return delta, counts, seen, nil
+}
+
+func linksOperation(links []apiworker.SyncRecord) string {
+	if links[0].Deleted {
+		return constants.APIAction[constants.DELETE]
+	}
+	return constants.APIAction[constants.UPSERT]
+}
+
+// absentEnvlopes gives the jobs deleting the synced records missing from the snapshot,
+// products and equipments cannot be deleted one by one, their files are not uploaded as snapshots.
+func absentEnvlopes(data models.FileData, entity string, baseline map[string]gendb.SyncedRecord, seen map[string]bool) ([]models.Envlope, int32, error) {
+	var keys []string
+	for key := range baseline {
+		if !seen[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	var envlopes []models.Envlope
+	var absent int32
+	links := make(map[string][]apiworker.SyncRecord)
+	var parents []string
+	for _, key := range keys {
+		rec := baseline[key]
+		env := getEnvlope(data.TargetServices[0], entity, data.FileName, data.TransfromedFileName, data.UploadID, data.GlobalID)
+		env.Scope = data.Scope
+		var del interface{}
+		switch entity {
+		case constants.APPLICATIONS:
+			del = &application.DeleteApplicationRequest{ApplicationId: key}
+		case constants.ProductsAcquiredRights:
+			del = &product.DeleteAcqRightRequest{Sku: key, Scope: data.Scope}
+		case constants.ApplicationsProducts, constants.ProductsEquipments, constants.ApplicationEquipments:
+			records, err := apiworker.SyncRecords(models.Envlope{TargetRPC: entity, Data: rec.Data})
+			if err != nil || len(records) != 1 {
+				logger.Log.Error("Failed to read the synced link", zap.Error(err), zap.String("entity", entity), zap.String("key", key))
+				continue
+			}
+			link := records[0]
+			if _, ok := links[link.Parent]; !ok {
+				parents = append(parents, link.Parent)
+			}
+			links[link.Parent] = append(links[link.Parent], link)
+			continue
+		default:
+			continue
+		}
+		b, err := json.Marshal(del)
+		if err != nil {
+			return nil, 0, err
+		}
+		env.TargetAction = constants.DELETE
+		env.Data = b
+		envlopes = append(envlopes, env)
+		absent++
+	}
+	for _, parent := range parents {
+		b, err := apiworker.LinksPayload(entity, constants.APIAction[constants.DELETE], links[parent])
+		if err != nil {
+			return nil, 0, err
+		}
+		env := getEnvlope(data.TargetServices[0], entity, data.FileName, data.TransfromedFileName, data.UploadID, data.GlobalID)
+		env.Scope = data.Scope
+		env.TargetAction = constants.UPSERT
+		env.Data = b
+		envlopes = append(envlopes, env)
+		absent += int32(len(links[parent]))
+	}
+	return envlopes, absent, nil
+}
+
+// syncEntity gives the entity of the synced records of the file type, empty when they are not synced
+func syncEntity(fileType string) string {
+	if strings.Contains(fileType, "EQUIPMENT_") {
+		return constants.EQUIPMENTS
+	}
+	switch fileType {
+	case constants.PRODUCTS, constants.APPLICATIONS, constants.ProductsAcquiredRights,
+		constants.ApplicationsProducts, constants.ProductsEquipments, constants.ApplicationEquipments:
+		return fileType
+	}
+	return ""
+}
+
+// syncedRecordsDone counts the records which are not pushed again as successful
+func (w *worker) syncedRecordsDone(ctx context.Context, data models.FileData, uploadID int32, fileName string, unchanged int32) error {
+	resp, err := w.Queries.UpdateFileSuccessRecord(ctx, gendb.UpdateFileSuccessRecordParams{
+		UploadID:       uploadID,
+		FileName:       fileName,
+		SuccessRecords: unchanged,
+	})
+	if err != nil {
+		return err
+	}
+	return w.fileRecordsDone(ctx, data, uploadID, fileName, resp.Issuccess, resp.Isfailed, resp.Ispartial)
+}
+
+// fileRecordsDone sets the status of the file when all its records are counted
+func (w *worker) fileRecordsDone(ctx context.Context, data models.FileData, uploadID int32, fileName string, isSuccess, isFailed, isPartial bool) error {
+	var fileStatus gendb.UploadStatus
+	if isSuccess {
+		fileStatus = gendb.UploadStatusSUCCESS
+	} else if isFailed {
+		fileStatus = gendb.UploadStatusFAILED
+	} else if isPartial {
+		fileStatus = gendb.UploadStatusPARTIAL
+	}
+	if fileStatus == "" {
+		return nil
+	}
+	return apiworker.HandleDataFileStatus(ctx, w.Queries, fileStatus, models.Envlope{
+		FileName:            fileName,
+		TransfromedFileName: data.TransfromedFileName,
+		UploadID:            uploadID,
+		GlobalFileID:        data.GlobalID,
+	}, w.grpcServers["product"])
+}
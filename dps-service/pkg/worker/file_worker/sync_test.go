@@ -0,0 +1,147 @@
+package fileworker
+
+import (
+	"encoding/json"
+	"testing"
+
+	product "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/thirdparty/product-service/pkg/api/v1"
+
+	gendb "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/repository/v1/postgres/db"
+	apiworker "gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/api_worker"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/constants"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/dps-service/pkg/worker/models"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func syncedRecord(t *testing.T, env models.Envlope) gendb.SyncedRecord {
+	records, err := apiworker.SyncRecords(env)
+	if err != nil || len(records) != 1 {
+		t.Fatalf("cannot read the synced record: %v", err)
+	}
+	return gendb.SyncedRecord{Entity: records[0].Entity, RecordKey: records[0].Key, Hash: records[0].Hash(), Data: records[0].Data}
+}
+
+func Test_deltaEnvlopes(t *testing.T) {
+	logger.Init(-1, "")
+	app := func(id, name string) models.Envlope {
+		return models.Envlope{TargetRPC: constants.APPLICATIONS, TargetAction: constants.UPSERT,
+			Data: json.RawMessage(`{"application_id":"` + id + `","name":"` + name + `","scope":"s1"}`)}
+	}
+	appLinks := func(ids ...string) models.Envlope {
+		b, _ := json.Marshal(product.UpsertProductRequest{SwidTag: "p1", Scope: "s1",
+			Applications: &product.UpsertProductRequestApplication{Operation: "add", ApplicationId: ids}})
+		return models.Envlope{TargetRPC: constants.ApplicationsProducts, TargetAction: constants.UPSERT, Data: b}
+	}
+	apps := map[string]gendb.SyncedRecord{
+		"a1": syncedRecord(t, app("a1", "first")),
+		"a2": syncedRecord(t, app("a2", "old")),
+	}
+	links := map[string]gendb.SyncedRecord{
+		"p1/a1": syncedRecord(t, appLinks("a1")),
+	}
+	tests := []struct {
+		name     string
+		mode     gendb.SyncMode
+		envlopes []models.Envlope
+		baseline map[string]gendb.SyncedRecord
+		output   []models.Envlope
+		counts   syncCounts
+	}{
+		{
+			name:     "delta drops the unchanged records",
+			mode:     gendb.SyncModeDELTA,
+			envlopes: []models.Envlope{app("a1", "first"), app("a2", "new"), app("a3", "third")},
+			baseline: apps,
+			output:   []models.Envlope{app("a2", "new"), app("a3", "third")},
+			counts:   syncCounts{inserted: 1, updated: 1, unchanged: 1},
+		},
+		{
+			name:     "full keeps all the records",
+			mode:     gendb.SyncModeFULL,
+			envlopes: []models.Envlope{app("a1", "first"), app("a2", "new"), app("a3", "third")},
+			baseline: apps,
+			output:   []models.Envlope{app("a1", "first"), app("a2", "new"), app("a3", "third")},
+			counts:   syncCounts{inserted: 1, updated: 1, unchanged: 1},
+		},
+		{
+			name:     "delta keeps the new links only",
+			mode:     gendb.SyncModeDELTA,
+			envlopes: []models.Envlope{appLinks("a1", "a2")},
+			baseline: links,
+			output:   []models.Envlope{appLinks("a2")},
+			counts:   syncCounts{inserted: 1, unchanged: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, counts, _, err := deltaEnvlopes(tt.mode, tt.envlopes, tt.baseline)
+			if !assert.Empty(t, err) {
+				return
+			}
+			assert.Equal(t, tt.counts, counts)
+			if assert.Equal(t, len(tt.output), len(got)) {
+				for i := range got {
+					assert.JSONEq(t, string(tt.output[i].Data), string(got[i].Data))
+				}
+			}
+		})
+	}
+}
+
+func Test_absentEnvlopes(t *testing.T) {
+	logger.Init(-1, "")
+	data := models.FileData{Scope: "s1", FileName: "s1_applications.csv", TargetServices: []string{"application"}}
+	appLink := func(id string) models.Envlope {
+		b, _ := json.Marshal(product.UpsertProductRequest{SwidTag: "p1", Scope: "s1",
+			Applications: &product.UpsertProductRequestApplication{Operation: "add", ApplicationId: []string{id}}})
+		return models.Envlope{TargetRPC: constants.ApplicationsProducts, Data: b}
+	}
+	t.Run("absent applications are deleted", func(t *testing.T) {
+		baseline := map[string]gendb.SyncedRecord{"a1": {RecordKey: "a1"}, "a2": {RecordKey: "a2"}}
+		got, absent, err := absentEnvlopes(data, constants.APPLICATIONS, baseline, map[string]bool{"a1": true})
+		if !assert.Empty(t, err) {
+			return
+		}
+		assert.Equal(t, int32(1), absent)
+		if assert.Equal(t, 1, len(got)) {
+			assert.Equal(t, constants.DELETE, got[0].TargetAction)
+			assert.Equal(t, "s1", got[0].Scope)
+			assert.JSONEq(t, `{"application_id":"a2"}`, string(got[0].Data))
+		}
+	})
+	t.Run("absent links are removed from their product", func(t *testing.T) {
+		baseline := map[string]gendb.SyncedRecord{
+			"p1/a1": syncedRecord(t, appLink("a1")),
+			"p1/a2": syncedRecord(t, appLink("a2")),
+			"p1/a3": syncedRecord(t, appLink("a3")),
+		}
+		got, absent, err := absentEnvlopes(data, constants.ApplicationsProducts, baseline, map[string]bool{"p1/a1": true})
+		if !assert.Empty(t, err) {
+			return
+		}
+		assert.Equal(t, int32(2), absent)
+		if assert.Equal(t, 1, len(got)) {
+			req := product.UpsertProductRequest{}
+			assert.Empty(t, json.Unmarshal(got[0].Data, &req))
+			assert.Equal(t, "p1", req.SwidTag)
+			assert.Equal(t, "delete", req.GetApplications().GetOperation())
+			assert.Equal(t, []string{"a2", "a3"}, req.GetApplications().GetApplicationId())
+		}
+	})
+	t.Run("absent products are kept", func(t *testing.T) {
+		got, absent, err := absentEnvlopes(data, constants.PRODUCTS, map[string]gendb.SyncedRecord{"p1": {RecordKey: "p1"}}, map[string]bool{})
+		assert.Empty(t, err)
+		assert.Equal(t, int32(0), absent)
+		assert.Empty(t, got)
+	})
+}
+
+func Test_syncEntity(t *testing.T) {
+	assert.Equal(t, constants.EQUIPMENTS, syncEntity("EQUIPMENT_SERVER"))
+	assert.Equal(t, constants.APPLICATIONS, syncEntity(constants.APPLICATIONS))
+	assert.Equal(t, constants.ProductsAcquiredRights, syncEntity(constants.ProductsAcquiredRights))
+	assert.Equal(t, "", syncEntity(constants.METADATA))
+}
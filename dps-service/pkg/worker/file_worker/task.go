@@ -6,10 +6,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 
 	// acq "optisam-backend/acqrights-service/pkg/api/v1"
 	"os"
+	"path"
 	"strconv"
 	"strings"
 
@@ -42,12 +44,12 @@ func getFileTypeFromFileName(fileName, scope string) (fileType string, err error
 		err = status.Error(codes.Internal, "InvalidFileName")
 		return
 	}
-	fileType = strings.Split(strings.Split(fileName, sep)[1], constants.FileExtension)[0]
+	fileType = strings.TrimSuffix(strings.Split(fileName, sep)[1], path.Ext(fileName))
 	return
 }
 
 // nolint: nakedret
-func fileProcessing(jobData gendb.UploadedDataFile) (data models.FileData, err error) {
+func fileProcessing(jobData gendb.UploadedDataFile, mappings []models.FileMapping) (data models.FileData, err error) {
 	data.DuplicateRecords = make([]interface{}, 0)
 	var fileType string
 	var expectedHeaders []string
@@ -69,8 +71,18 @@ func fileProcessing(jobData gendb.UploadedDataFile) (data models.FileData, err e
 			data.FileFailureReason = err.Error()
 			return data, status.Error(codes.Internal, constants.BadFile)
 		}
-		// For equipment, dynamic processing is required
-		if strings.Contains(fileType, "EQUIPMENT_") {
+		// json, ndjson and parquet files are read with the types of their values
+		if format := strings.ToUpper(path.Ext(jobData.FileName)); format != constants.FileExtension {
+			data, err = structuredToFileData(fileType, jobData.FileName, format)
+			if err != nil {
+				logger.Log.Error("Failed to read data from typed file", zap.String("file", jobData.FileName), zap.Error(err))
+				if data.FileFailureReason == "" {
+					data.FileFailureReason = constants.BadFile
+				}
+				return
+			}
+		} else if strings.Contains(fileType, "EQUIPMENT_") {
+			// For equipment, dynamic processing is required
 			data, err = getEquipment(fileType, jobData.FileName)
 			if err != nil {
 				data.FileFailureReason = err.Error()
@@ -83,7 +95,7 @@ func fileProcessing(jobData gendb.UploadedDataFile) (data models.FileData, err e
 				data.FileFailureReason = err.Error()
 				return data, status.Error(codes.Internal, "FileNotSupported")
 			}
-			data, err = csvToFileData(fileType, jobData.FileName, expectedHeaders)
+			data, err = csvToFileData(fileType, jobData.FileName, expectedHeaders, getFileMapping(mappings, fileType))
 			if err != nil {
 				log.Println("Failed to read data from  file ", jobData.FileName, " with err ", err)
 				if data.FileFailureReason == "" {
@@ -100,6 +112,11 @@ func fileProcessing(jobData gendb.UploadedDataFile) (data models.FileData, err e
 	return
 }
 
+// ReadFile parses an uploaded file of the scope as it is read before its injection, nothing is written
+func ReadFile(scope, fileName string, mappings []models.FileMapping) (models.FileData, error) {
+	return fileProcessing(gendb.UploadedDataFile{Scope: scope, FileName: fileName}, mappings)
+}
+
 // Headers are updated, no No space is allowed in headers and these are case insensitive
 // nolint: nakedret
 func getHeadersForFileType(fileType string) (headers []string, err error) {
@@ -483,8 +500,9 @@ func getAcqRightsOfProducts(s *bufio.Scanner, headers models.HeadersInfo) (model
 	return resp, err
 }
 
+// csvToFileData reads the file of the given type, the columns are first transformed by the mapping if any
 // nolint: nakedret
-func csvToFileData(fileType, fileName string, expectedHeaders []string) (models.FileData, error) {
+func csvToFileData(fileType, fileName string, expectedHeaders []string, mapping *models.FileMapping) (models.FileData, error) {
 	var headers models.HeadersInfo
 	resp := models.FileData{}
 	var err error
@@ -497,12 +515,20 @@ func csvToFileData(fileType, fileName string, expectedHeaders []string) (models.
 		return resp, status.Error(codes.Internal, "BadFile")
 	}
 	defer csvFile.Close()
-	scanner := bufio.NewScanner(csvFile)
+	var src io.Reader = csvFile
+	if mapping != nil {
+		mapped := mapColumns(csvFile, *mapping)
+		defer mapped.Close()
+		src = mapped
+	}
+	scanner := bufio.NewScanner(src)
 	success := scanner.Scan()
 	if success == false {
 		err = scanner.Err()
 		if err == nil {
 			resp.FileFailureReason = "EmptyFile"
+		} else if st, ok := status.FromError(err); ok && mapping != nil {
+			resp.FileFailureReason = st.Message()
 		} else {
 			resp.FileFailureReason = constants.BadFile
 		}
@@ -1062,56 +1088,26 @@ func getDynamicEquipmentFromCsv(file string) (resp []map[string]interface{}, dup
 		return
 	}
 	defer csvFile.Close()
-	s := bufio.NewScanner(csvFile)
-	success := s.Scan()
-	if !success {
-		err = s.Err()
-		if err == nil {
-			err = errors.New("emptyfile")
-		} else {
-			err = errors.New("badfile")
-		}
+	rows, err := newRowStream(csvFile)
+	if err != nil {
 		return
 	}
-
-	headers := make(map[int]string)
-	for key, val := range strings.Split(s.Text(), constants.DELIMETER) {
-		headers[key] = val
-	}
-	hlen := len(headers)
-	records := make(map[string]bool)
-	for s.Scan() {
-		row := s.Text()
-		list := strings.Split(row, constants.DELIMETER)
+	seen := make(map[string]bool)
+	for row, ok := rows.next(); ok; row, ok = rows.next() {
 		// TODO should we allow this
-		if len(list) >= hlen {
-			temp := make(map[string]interface{})
-			for index, val := range list {
-				// var out interface{}
-				// var pErr error
-				// out, pErr = strconv.ParseInt(val, 10, 64)
-				// if pErr != nil {
-				// 	out, pErr = strconv.ParseFloat(val, 64)
-				// 	if pErr != nil {
-				// 		out, pErr = strconv.ParseBool(val)
-				// 		if pErr != nil {
-				// 			// the value is string
-				// 			out = val
-				// 		}
-				// 	}
-				// }
-				temp[headers[index]] = val
-			}
-			ok := records[row]
-			if ok {
-				duplicate = append(duplicate, temp)
-			} else {
-				records[row] = true
-				resp = append(resp, temp)
-			}
+		if row.rec == nil {
+			continue
+		}
+		// keys of a map are marshalled in order, identical records have the same key
+		key, _ := json.Marshal(row.rec)
+		if seen[string(key)] {
+			duplicate = append(duplicate, row.rec)
+		} else {
+			seen[string(key)] = true
+			resp = append(resp, row.rec)
 		}
 	}
-	err = s.Err()
+	err = rows.err()
 	if len(resp) == 0 || err != nil {
 		err = errors.New("badfile")
 		return
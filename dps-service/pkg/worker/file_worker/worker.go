@@ -22,17 +22,20 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 )
 
 type worker struct {
 	id string
 	*workerqueue.Queue
 	*gendb.Queries
-	product prodV1.ProductServiceClient
+	db          *sql.DB
+	product     prodV1.ProductServiceClient
+	grpcServers map[string]*grpc.ClientConn
 }
 
 func NewWorker(id string, queue *workerqueue.Queue, db *sql.DB, grpcServers map[string]*grpc.ClientConn) *worker { // nolint: golint
-	return &worker{id: id, Queue: queue, Queries: gendb.New(db), product: prodV1.NewProductServiceClient(grpcServers["product"])}
+	return &worker{id: id, Queue: queue, Queries: gendb.New(db), db: db, product: prodV1.NewProductServiceClient(grpcServers["product"]), grpcServers: grpcServers}
 }
 
 // ID gives unique id of worker
@@ -91,58 +94,138 @@ func (w *worker) DoWork(ctx context.Context, j *job.Job) error {
 		return err
 	}
 
-	data, err = fileProcessing(dataFromJob)
+	var mappings []models.FileMapping
+	if dataFromJob.MappingProfile != "" {
+		mappings, err = w.getFileMappings(ctx, dataFromJob.Scope, dataFromJob.MappingProfile)
+	}
+	if fileType := streamedFileType(dataFromJob); err == nil && fileType != "" {
+		// the csv files are injected while they are read
+		err = w.streamFile(ctx, dataFromJob, fileType, fileNameInDB, getFileMapping(mappings, fileType))
+		return err
+	}
+	if err == nil {
+		data, err = fileProcessing(dataFromJob, mappings)
+	} else {
+		data.FileFailureReason = status.Convert(err).Message()
+	}
 	// to store nifi transformed files as we keep scope_data ,not with global
 	if dataFromJob.FileName != fileNameInDB {
 		data.TransfromedFileName = dataFromJob.FileName
 	}
 	if err != nil {
 		logger.Log.Debug("Failed to process the file ", zap.Any("filename", dataFromJob.FileName), zap.Error(err))
-		er := w.Queries.UpdateFileFailure(ctx, gendb.UpdateFileFailureParams{
-			Status:   gendb.UploadStatusFAILED,
-			Comments: sql.NullString{String: data.FileFailureReason, Valid: true},
-			UploadID: dataFromJob.UploadID,
-			FileName: fileNameInDB,
-		})
-		if er != nil {
-			logger.Log.Debug("Failed to update file status ", zap.Any("filename", dataFromJob.FileName), zap.Error(err))
-			return er
-		}
-		return errors.New(data.FileFailureReason)
+		return w.fileFailed(ctx, dataFromJob, fileNameInDB, data.FileFailureReason)
 	}
 
 	logger.Log.Debug("file reading complete, stats:", zap.Any("TransformedFile", data.TransfromedFileName), zap.Any("file", data.FileName), zap.Any("totalRecord", data.TotalCount), zap.Any("Invalidrecords", data.InvalidCount), zap.Any("duplicateRecords", len(data.DuplicateRecords)))
 
+	check, err := w.checkQualityRules(ctx, &data)
+	if err != nil {
+		logger.Log.Error("Failed to check the quality rules of the file", zap.Any("filename", dataFromJob.FileName), zap.Error(err))
+		return err
+	}
+
+	oldName := data.FileName
+	data.FileName = fileNameInDB
+	jobs, err = createAPITypeJobs(ctx, data, w)
+	syncMode := dataFromJob.SyncMode
+	if syncMode == "" {
+		syncMode = gendb.SyncModeFULL
+	}
+	var synced syncCounts
+	var syncErr error
+	if err == nil {
+		jobs, synced, syncErr = w.syncJobs(ctx, data, syncMode, jobs, check.keys)
+	}
+	data.FileName = oldName
+	if syncErr != nil {
+		logger.Log.Error("Failed to compare the file with the synced records", zap.Any("filename", dataFromJob.FileName), zap.Error(syncErr))
+		return syncErr
+	}
+
 	err = w.Queries.UpdateFileTotalRecord(ctx, gendb.UpdateFileTotalRecordParams{
 		FileName:      fileNameInDB,
 		UploadID:      dataFromJob.UploadID,
-		TotalRecords:  data.TotalCount,
-		FailedRecords: data.InvalidCount + int32(len(data.DuplicateRecords)),
+		TotalRecords:  data.TotalCount + synced.absent,
+		FailedRecords: data.InvalidCount + int32(len(data.DuplicateRecords)) + int32(len(check.rejected)),
 	})
 	if err != nil {
 		logger.Log.Debug("Failed to update total Records in DB for file ", zap.Any("filename", dataFromJob.FileName), zap.Error(err))
 		return err
 	}
+	// the file is read at once
+	err = w.Queries.UpdateFileProcessedRecords(ctx, gendb.UpdateFileProcessedRecordsParams{
+		UploadID:         dataFromJob.UploadID,
+		FileName:         fileNameInDB,
+		ProcessedRecords: data.TotalCount,
+	})
+	if err != nil {
+		logger.Log.Error("Failed to update processed records in DB for file ", zap.Any("filename", dataFromJob.FileName), zap.Error(err))
+		return err
+	}
+	err = w.Queries.UpdateFileSyncRecords(ctx, gendb.UpdateFileSyncRecordsParams{
+		UploadID:        dataFromJob.UploadID,
+		FileName:        fileNameInDB,
+		InsertedRecords: synced.inserted,
+		UpdatedRecords:  synced.updated,
+		DeletedRecords:  synced.deleted,
+	})
+	if err != nil {
+		logger.Log.Error("Failed to update synced records in DB for file ", zap.Any("filename", dataFromJob.FileName), zap.Error(err))
+		return err
+	}
 
-	oldName := data.FileName
-	data.FileName = fileNameInDB
-	jobs, err = createAPITypeJobs(ctx, data, w)
-	data.FileName = oldName
+	if dataFromJob.InjectionID > 0 {
+		// the jobs of a transactional injection are pushed when all its files are read
+		if err = w.stageJobs(ctx, dataFromJob.InjectionID, fileNameInDB, jobs); err != nil {
+			logger.Log.Error("Failed to stage the jobs of the injection", zap.Int32("injection", dataFromJob.InjectionID), zap.Error(err))
+			return err
+		}
+	} else {
+		for _, j := range jobs {
+			// Will implement through workerpool
+			// file records are bulk loads, they are worked after the single record changes
+			j.Priority = job.PriorityLow
+			_, err := w.Queue.PushJob(ctx, j, constants.APIWORKER)
+			if err != nil {
+				logger.Log.Error("Job not pushed Successfully:", zap.Int32("job", j.JobID), zap.Error(err))
+			}
+		}
+	}
 
-	for _, job := range jobs {
-		// Will implement through workerpool
-		_, err := w.Queue.PushJob(ctx, job, constants.APIWORKER)
-		if err != nil {
-			logger.Log.Error("Job not pushed Successfully:", zap.Int32("job", job.JobID), zap.Error(err))
+	// the unchanged records of a delta are not pushed again
+	if syncMode != gendb.SyncModeFULL && synced.unchanged > 0 {
+		if er := w.syncedRecordsDone(ctx, data, dataFromJob.UploadID, fileNameInDB, synced.unchanged); er != nil {
+			logger.Log.Error("Failed to count the unchanged records of the file", zap.Any("filename", dataFromJob.FileName), zap.Error(er))
 		}
 	}
 
 	setInvalidRecords(ctx, w, data, dataFromJob.UploadID, fileNameInDB)
 	setDuplicateRecords(ctx, w, data, dataFromJob.UploadID, fileNameInDB)
+	setRejectedRecords(ctx, w, check, data, dataFromJob.UploadID, fileNameInDB)
+	w.saveQualityResults(ctx, check, data, dataFromJob.UploadID, fileNameInDB)
 
 	return nil
 }
 
+// fileFailed sets the file as failed for the reason, the transactional injection of the file is discarded
+func (w *worker) fileFailed(ctx context.Context, dataFromJob gendb.UploadedDataFile, fileNameInDB, reason string) error {
+	er := w.Queries.UpdateFileFailure(ctx, gendb.UpdateFileFailureParams{
+		Status:   gendb.UploadStatusFAILED,
+		Comments: sql.NullString{String: reason, Valid: true},
+		UploadID: dataFromJob.UploadID,
+		FileName: fileNameInDB,
+	})
+	if er != nil {
+		logger.Log.Debug("Failed to update file status ", zap.Any("filename", dataFromJob.FileName), zap.Error(er))
+		return er
+	}
+	if dataFromJob.InjectionID > 0 {
+		w.discardInjection(ctx, dataFromJob.InjectionID, reason)
+	}
+	return errors.New(reason)
+}
+
 type InvalidRecord struct {
 	Data struct {
 		AtLineNo string
@@ -160,7 +243,16 @@ type DuplicateRecord struct {
 }
 
 func setInvalidRecords(ctx context.Context, w *worker, data models.FileData, id int32, fileName string) {
+	for _, j := range invalidRecordJobs(data, id, fileName) {
+		if _, err := w.Queue.PushJob(ctx, j, constants.APIWORKER); err != nil {
+			log.Println("Failed to upsert invalid-failed records, err ", err)
+		}
+	}
+}
 
+// invalidRecordJobs gives the failed jobs recording the invalid records of the file
+func invalidRecordJobs(data models.FileData, id int32, fileName string) []job.Job {
+	jobs := make([]job.Job, 0, data.InvalidCount)
 	for i := 0; i < int(data.InvalidCount); i++ {
 		e := InvalidRecord{
 			Data:     struct{ AtLineNo string }{fmt.Sprintf("%d", data.InvalidDataRowNum[i])},
@@ -173,21 +265,27 @@ func setInvalidRecords(ctx context.Context, w *worker, data models.FileData, id
 			log.Println("Failed tp marshal the invalid data, err ", err)
 			continue
 		}
-		j := job.Job{
+		jobs = append(jobs, job.Job{
 			Status:   job.JobStatusFAILED,
 			Comments: sql.NullString{String: "InsufficentData", Valid: true},
 			Data:     dataToPush,
 			Type:     sql.NullString{String: constants.APIWORKER, Valid: true},
-		}
-		_, err = w.Queue.PushJob(ctx, j, constants.APIWORKER)
-		if err != nil {
-			log.Println("Failed to upsert invalid-failed records, err ", err)
-		}
+		})
 	}
+	return jobs
 }
 
 func setDuplicateRecords(ctx context.Context, w *worker, data models.FileData, id int32, fileName string) {
+	for _, j := range duplicateRecordJobs(data, id, fileName) {
+		if _, err := w.Queue.PushJob(ctx, j, constants.APIWORKER); err != nil {
+			logger.Log.Error("Failed to upsert duplicate-failed records, err ", zap.Error(err))
+		}
+	}
+}
 
+// duplicateRecordJobs gives the failed jobs recording the duplicate records of the file
+func duplicateRecordJobs(data models.FileData, id int32, fileName string) []job.Job {
+	jobs := make([]job.Job, 0, len(data.DuplicateRecords))
 	for i := 0; i < len(data.DuplicateRecords); i++ {
 		e := DuplicateRecord{
 			Data:     data.DuplicateRecords[i],
@@ -200,15 +298,12 @@ func setDuplicateRecords(ctx context.Context, w *worker, data models.FileData, i
 			logger.Log.Error("Failed tp marshal the duplicate data, err ", zap.Error(err))
 			continue
 		}
-		j := job.Job{
+		jobs = append(jobs, job.Job{
 			Status:   job.JobStatusFAILED,
 			Comments: sql.NullString{String: "DuplicateRecord", Valid: true},
 			Data:     dataToPush,
 			Type:     sql.NullString{String: constants.APIWORKER, Valid: true},
-		}
-		_, err = w.Queue.PushJob(ctx, j, constants.APIWORKER)
-		if err != nil {
-			logger.Log.Error("Failed to upsert duplicate-failed records, err ", zap.Error(err))
-		}
+		})
 	}
+	return jobs
 }
@@ -96,6 +96,27 @@ type HeadersInfo struct {
 	MaxIndexVal      int            // max val of index
 }
 
+// FileMapping is the part of a mapping profile which transforms the columns of a source file into the headers of FileType
+type FileMapping struct {
+	FileType  string         `json:"file_type"`
+	Delimiter string         `json:"delimiter,omitempty"` // delimiter of the source file
+	Fields    []FieldMapping `json:"fields"`
+}
+
+// FieldMapping computes the canonical header Field from the source columns
+type FieldMapping struct {
+	Field              string   `json:"field"`
+	Operation          string   `json:"operation"`
+	Sources            []string `json:"sources,omitempty"`
+	Value              string   `json:"value,omitempty"`
+	Separator          string   `json:"separator,omitempty"`
+	Index              int      `json:"index,omitempty"`
+	DefaultValue       string   `json:"default_value,omitempty"`
+	DateFormat         string   `json:"date_format,omitempty"`
+	DecimalSeparator   string   `json:"decimal_separator,omitempty"`
+	ThousandsSeparator string   `json:"thousands_separator,omitempty"`
+}
+
 type Envlope struct {
 	Data                json.RawMessage // tells data will be sent to that target
 	TargetService       string          // tells target service
@@ -105,6 +126,10 @@ type Envlope struct {
 	TransfromedFileName string
 	UploadID            int32
 	GlobalFileID        int32
+	InjectionID         int32  // set when the job belongs to a transactional injection
+	Rollback            bool   // tells the job compensates a job of the injection
+	Scope               string // set when the records of the job are tracked in the synced records of the scope
+	RecordKey           string // key of the record upserted by the job in the synced records
 }
 
 type EquipmentRequest struct {
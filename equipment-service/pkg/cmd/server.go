@@ -210,10 +210,16 @@ func RunServer() error {
 			logger.Log.Sugar().Debug("Recovered in RunServer", r)
 		}
 	}()
+	auditPublisher, err := iam.NewAuditPublisher(cfg.IAM)
+	if err != nil {
+		logger.Log.Fatal("Failed to create audit publisher", zap.Error(err))
+	}
 	// run HTTP gateway
 	fmt.Printf("%s - grpc port,%s - http port", cfg.GRPCPort, cfg.HTTPPort)
+	revoked := iam.NewRevocationList(cfg.IAM)
+	apiKeys := iam.NewAPIKeyStore(cfg.IAM)
 	go func() {
-		_ = rest.RunServer(ctx, cfg.GRPCPort, cfg.HTTPPort, verifyKey)
+		_ = rest.RunServer(ctx, cfg.GRPCPort, cfg.HTTPPort, verifyKey, revoked, apiKeys)
 	}()
-	return grpc.RunServer(ctx, v1API, cfg.GRPCPort, verifyKey, authZPolicies, cfg.IAM.APIKey)
+	return grpc.RunServer(ctx, v1API, cfg.GRPCPort, verifyKey, authZPolicies, cfg.IAM.APIKey, revoked, apiKeys, auditPublisher)
 }
@@ -5,26 +5,29 @@ import (
 	"crypto/rsa"
 	"log"
 	"net"
+	"optisam-backend/common/optisam/audit"
 	"optisam-backend/common/optisam/logger"
 	mw "optisam-backend/common/optisam/middleware/grpc"
+	"optisam-backend/common/optisam/opa"
+	"optisam-backend/common/optisam/token/apikey"
+	"optisam-backend/common/optisam/token/revocation"
 	v1 "optisam-backend/equipment-service/pkg/api/v1"
 	"os"
 	"os/signal"
 
-	"github.com/open-policy-agent/opa/rego"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
 )
 
 // RunServer runs gRPC service to publish Auth service
-func RunServer(ctx context.Context, v1API v1.EquipmentServiceServer, port string, verifyKey *rsa.PublicKey, p *rego.PreparedEvalQuery, apiKey string) error {
+func RunServer(ctx context.Context, v1API v1.EquipmentServiceServer, port string, verifyKey *rsa.PublicKey, p *opa.Policy, apiKey string, revoked revocation.List, keys apikey.Store, auditPublisher audit.Publisher) error {
 	listen, err := net.Listen("tcp", ":"+port)
 	if err != nil {
 		return err
 	}
 
 	// gRPC server statup options
-	opts := mw.Chained(logger.Log, verifyKey, p, apiKey)
+	opts := mw.Chained(logger.Log, verifyKey, p, apiKey, mw.WithRevocationList(revoked), mw.WithAPIKeyStore(keys), mw.WithAuditPublisher(auditPublisher))
 	opts = append(opts, grpc.StatsHandler(&ocgrpc.ServerHandler{}))
 	// add middleware
 	// opts = grpc_middleware.AddLogging(logger.Log, opts)
@@ -7,6 +7,8 @@ import (
 	"net/http/pprof"
 	"optisam-backend/common/optisam/logger"
 	rest_middleware "optisam-backend/common/optisam/middleware/rest"
+	"optisam-backend/common/optisam/token/apikey"
+	"optisam-backend/common/optisam/token/revocation"
 	v1 "optisam-backend/equipment-service/pkg/api/v1"
 	"os"
 	"os/signal"
@@ -23,7 +25,7 @@ import (
 
 // RunServer runs HTTP/REST gateway
 // nolint: funlen, gocyclo, gosec
-func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.PublicKey) error {
+func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.PublicKey, revoked revocation.List, keys apikey.Store) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -40,9 +42,7 @@ func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.Pu
 		Addr: ":" + httpPort,
 		// Handler: &ochttp.Handler{
 		Handler: &ochttp.Handler{Handler: rest_middleware.AddCORS([]string{"*"},
-			// rest_middleware.AddLogger(logger.Log,
-			muxHTTP),
-		// )},
+			rest_middleware.ValidateAuthWithAPIKeys(verifyKey, revoked, keys, muxHTTP)),
 		},
 	}
 
@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"math"
 	"net/url"
 	accv1 "optisam-backend/account-service/pkg/api/v1"
 	metv1 "optisam-backend/metric-service/pkg/api/v1"
 	prodv1 "optisam-backend/product-service/pkg/api/v1"
 
+	"optisam-backend/common/optisam/audit"
 	"optisam-backend/common/optisam/helper"
 	"optisam-backend/common/optisam/logger"
 	grpc_middleware "optisam-backend/common/optisam/middleware/grpc"
@@ -51,6 +53,8 @@ func (p *customTypeFloat) UnmarshalJSON(data []byte) error {
 	case string:
 		floatData, _ := strconv.ParseFloat(v, 64)
 		*p = customTypeFloat(floatData)
+	case float64:
+		*p = customTypeFloat(v)
 	default:
 	}
 	return nil
@@ -68,6 +72,32 @@ func (p *customTypeInt) UnmarshalJSON(data []byte) error {
 	case string:
 		intData, _ := strconv.ParseInt(v, 10, 64)
 		*p = customTypeInt(intData)
+	case float64:
+		if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+			return status.Errorf(codes.InvalidArgument, "%v is not an integer", v)
+		}
+		*p = customTypeInt(v)
+	default:
+	}
+	return nil
+}
+
+// customTypeString keeps numbers and booleans of typed files for string attributes
+type customTypeString string
+
+func (p *customTypeString) UnmarshalJSON(data []byte) error {
+	var tmp interface{}
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		logger.Log.Error("Failed to Unmarshal", zap.Error(err))
+		return err
+	}
+	switch v := tmp.(type) {
+	case string:
+		*p = customTypeString(v)
+	case float64:
+		*p = customTypeString(strconv.FormatFloat(v, 'f', -1, 64))
+	case bool:
+		*p = customTypeString(strconv.FormatBool(v))
 	default:
 	}
 	return nil
@@ -111,6 +141,24 @@ func (s *equipmentServiceServer) UpsertMetadata(ctx context.Context, req *v1.Ups
 	if !helper.Contains(userClaims.Socpes, req.Scope) {
 		return nil, status.Error(codes.InvalidArgument, "scope is not owned by user")
 	}
+	if audit.Recording(ctx) && req.GetMetadataType() == "equipment" {
+		metadatas, err := s.equipmentRepo.MetadataAllWithType(ctx, repo.MetadataTypeEquipment, []string{req.GetScope()})
+		if err != nil {
+			logger.Log.Error("Failed to get metadata from dgraph", zap.String("reason", err.Error()))
+			return nil, status.Error(codes.Internal, "cannot get metadata")
+		}
+		for _, m := range metadatas {
+			if m.Source == req.GetMetadataSource() {
+				audit.RecordPrevious(ctx, &v1.UpsertMetadataRequest{
+					MetadataType:       req.GetMetadataType(),
+					MetadataSource:     m.Source,
+					MetadataAttributes: m.Attributes,
+					Scope:              req.GetScope(),
+				})
+				break
+			}
+		}
+	}
 	if _, err := s.equipmentRepo.UpsertMetadata(ctx, &repo.Metadata{
 		MetadataType: req.GetMetadataType(),
 		Source:       req.GetMetadataSource(),
@@ -158,11 +206,11 @@ func (s *equipmentServiceServer) UpsertEquipment(ctx context.Context, req *v1.Up
 		case bool:
 			t = false
 		case string:
-			t = ""
+			t = customTypeString("")
 		case float64:
 			t = 0.0
 		default:
-			t = ""
+			t = customTypeString("")
 		}
 		var regTag string
 		var reqType reflect.Type
@@ -194,6 +242,9 @@ func (s *equipmentServiceServer) UpsertEquipment(ctx context.Context, req *v1.Up
 	err = json.Unmarshal(reqEqDataJSON.Bytes(), &instanceReq)
 	if err != nil {
 		logger.Log.Error("Equipment Data Unmarshal Error", zap.Error(err))
+		if status.Code(err) == codes.InvalidArgument {
+			return &v1.UpsertEquipmentResponse{Success: false}, err
+		}
 	}
 	err = validate.Struct(instanceReq)
 	if err != nil {
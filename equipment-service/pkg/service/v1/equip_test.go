@@ -14,6 +14,8 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func Test_DropMetadata(t *testing.T) {
@@ -2065,3 +2067,30 @@ func Test_equipmentServiceServer_DropEquipmentData(t *testing.T) {
 		})
 	}
 }
+
+func Test_customTypes(t *testing.T) {
+	var data struct {
+		Name    customTypeString `json:"name"`
+		Serial  customTypeString `json:"serial"`
+		Virtual customTypeString `json:"virtual"`
+		Cores   customTypeInt    `json:"cores"`
+		Sockets customTypeInt    `json:"sockets"`
+		Ratio   customTypeFloat  `json:"ratio"`
+		Weight  customTypeFloat  `json:"weight"`
+	}
+	err := json.Unmarshal([]byte(`{"name":"srv1","serial":12345,"virtual":true,"cores":8,"sockets":"4","ratio":0.5,"weight":"1.5"}`), &data)
+	assert.Nil(t, err)
+	assert.Equal(t, customTypeString("srv1"), data.Name)
+	assert.Equal(t, customTypeString("12345"), data.Serial)
+	assert.Equal(t, customTypeString("true"), data.Virtual)
+	assert.Equal(t, customTypeInt(8), data.Cores)
+	assert.Equal(t, customTypeInt(4), data.Sockets)
+	assert.Equal(t, customTypeFloat(0.5), data.Ratio)
+	assert.Equal(t, customTypeFloat(1.5), data.Weight)
+
+	for _, cores := range []string{"8.5", "9223372036854775808", "-1e19"} {
+		var c customTypeInt
+		err := json.Unmarshal([]byte(cores), &c)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), cores)
+	}
+}
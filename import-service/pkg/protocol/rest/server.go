@@ -46,11 +46,13 @@ func RunServer(ctx context.Context, config *config.Config, db *repo.ImportReposi
 	}
 	// TODO add a import handler here
 	router.POST("/api/v1/import/data", h.UploadDataHandler)
+	router.POST("/api/v1/import/data/preview", h.PreviewDataHandler)
 	router.POST("/api/v1/import/metadata", h.UploadMetaDataHandler)
 	router.POST("/api/v1/import/config", h.CreateConfigHandler)
 	router.PUT("/api/v1/import/config/:config_id", h.UpdateConfigHandler)
 	router.POST("/api/v1/import/globaldata", h.UploadGlobalDataHandler)
 	router.GET("/api/v1/import/download", h.DownloadFile)
+	router.POST("/api/v1/import/failed/retry", h.RetryFailedRecordsHandler)
 	router.POST("/api/v1/import/download/nominative", h.DownloadFileNominativeUser)
 	router.POST("/api/v1/import/upload", h.UploadFiles)
 	router.POST("/api/v1/import/nominative/user", h.ImportNominativeUser)
@@ -63,7 +65,7 @@ func RunServer(ctx context.Context, config *config.Config, db *repo.ImportReposi
 		Addr: ":" + config.HTTPPort,
 		Handler: rest_middleware.AddCORS([]string{"*"},
 			rest_middleware.AddLogger(logger.Log,
-				rest_middleware.ValidateAuth(verifyKey,
+				rest_middleware.ValidateAuthWithAPIKeys(verifyKey, iam.NewRevocationList(config.IAM), iam.NewAPIKeyStore(config.IAM),
 					rest_middleware.ValidateAuthZ(authZPolicies, &ochttp.Handler{Handler: router})),
 			)),
 	}
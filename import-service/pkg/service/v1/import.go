@@ -44,6 +44,8 @@ import (
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/import-service/pkg/repository/v1/postgres/db"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type ImportServiceServer struct {
@@ -68,10 +70,12 @@ const (
 	metadataload               uploadType   = "metadata"
 	dataload                   uploadType   = "data"
 	rawdataload                uploadType   = "globaldata"
+	previewload                uploadType   = "preview"
 	analysis                   uploadType   = "analysis"
 	source                     uploadType   = "source"
 	corefactor                 uploadType   = "corefactor"
 	errorFile                  downloadType = "error"
+	failedRecords              downloadType = "failed"
 	GENERIC                    string       = "GENERIC"
 	GEN                        string       = "GEN"
 	XLSX                       string       = ".xlsx"
@@ -329,6 +333,16 @@ func (i *ImportServiceServer) UploadDataHandler(res http.ResponseWriter, req *ht
 		http.Error(res, "cannot store files", http.StatusInternalServerError)
 		return
 	}
+	// the files are fully pushed unless only their changes or a snapshot of the inventory are requested
+	syncMode := v1.NotifyUploadRequest_FULL
+	if mode := req.FormValue("sync_mode"); mode != "" {
+		val, ok := v1.NotifyUploadRequestSyncModes_value[strings.ToUpper(mode)]
+		if !ok {
+			http.Error(res, "InvalidSyncMode", http.StatusBadRequest)
+			return
+		}
+		syncMode = v1.NotifyUploadRequestSyncModes(val)
+	}
 	err1 := os.MkdirAll(i.Config.Upload.UploadDir, os.ModePerm)
 	if err1 != nil {
 		logger.Log.Error("Cannot create Dir", zap.Error(err1))
@@ -391,10 +405,13 @@ func (i *ImportServiceServer) UploadDataHandler(res http.ResponseWriter, req *ht
 		// Notify call to DPS
 
 		_, err := i.dpsClient.NotifyUpload(req.Context(), &v1.NotifyUploadRequest{
-			Scope:      dataScope,
-			Type:       "data",
-			Files:      filenames,
-			UploadedBy: uploadedBy,
+			Scope:          dataScope,
+			Type:           "data",
+			Files:          filenames,
+			UploadedBy:     uploadedBy,
+			MappingProfile: req.FormValue("mapping_profile"),
+			Transactional:  req.FormValue("transactional") == "true",
+			SyncMode:       syncMode,
 		})
 		if err != nil {
 			logger.Log.Error("DPS call failed", zap.Error(err))
@@ -410,6 +427,111 @@ func (i *ImportServiceServer) UploadDataHandler(res http.ResponseWriter, req *ht
 	}
 }
 
+// PreviewDataHandler stores the data files under a preview name and returns what their upload would change.
+// The files are removed once dps has read them, nothing is injected.
+func (i *ImportServiceServer) PreviewDataHandler(res http.ResponseWriter, req *http.Request, param httprouter.Params) {
+	dataScope := req.FormValue("scope")
+	if dataScope == "" {
+		http.Error(res, "ScopeIsMissing", http.StatusBadRequest)
+		return
+	}
+	userClaims, ok := rest_middleware.RetrieveClaims(req.Context())
+	if !ok {
+		http.Error(res, "cannot preview files", http.StatusInternalServerError)
+		return
+	}
+	if userClaims.Role == claims.RoleUser {
+		http.Error(res, "RoleValidationFailed", http.StatusForbidden)
+		return
+	}
+	if !helper.Contains(userClaims.Socpes, dataScope) {
+		http.Error(res, "ScopeValidationFailed", http.StatusForbidden)
+		return
+	}
+	if parseerr := req.ParseMultipartForm(32 << 20); parseerr != nil {
+		logger.Log.Error("parse multi past form ", zap.Error(parseerr))
+		http.Error(res, "cannot preview files", http.StatusInternalServerError)
+		return
+	}
+	if err := os.MkdirAll(i.Config.Upload.UploadDir, os.ModePerm); err != nil {
+		logger.Log.Error("Cannot create Dir", zap.Error(err))
+		http.Error(res, "cannot preview files", http.StatusInternalServerError)
+		return
+	}
+	// the preview files are named as files transformed by nifi so that dps finds their scope and type after the prefix
+	prefix := fmt.Sprintf("%s#%s#", previewload, uuid.New().String())
+	var filenames []string
+	defer func() {
+		for _, f := range filenames {
+			if err := os.Remove(filepath.Join(i.Config.Upload.UploadDir, f)); err != nil {
+				logger.Log.Error("cannot remove preview file", zap.Error(err), zap.String("file", f))
+			}
+		}
+	}()
+	for _, fheaders := range req.MultipartForm.File {
+		for _, hdr := range fheaders {
+			if hdr.Size > i.Config.MaxFileSize*1024*1024 {
+				http.Error(res, "maximum file allowded is :"+strconv.FormatInt(i.Config.MaxFileSize, 10)+"Mbs", http.StatusBadRequest)
+				return
+			}
+			if !helper.RegexContains(i.Config.Upload.DataFileAllowedRegex, hdr.Filename) {
+				logger.Log.Error("Validation Error-File Not allowed", zap.String("File", hdr.Filename))
+				http.Error(res, "FileNotAllowed", http.StatusBadRequest)
+				return
+			}
+			fn := prefix + dataScope + "_" + hdr.Filename
+			if err := saveUploadedFile(hdr, filepath.Join(i.Config.Upload.UploadDir, fn)); err != nil {
+				logger.Log.Error("cannot store preview file", zap.Error(err), zap.String("File", hdr.Filename))
+				http.Error(res, "cannot preview files", http.StatusInternalServerError)
+				return
+			}
+			filenames = append(filenames, fn)
+		}
+	}
+	if len(filenames) == 0 {
+		http.Error(res, "NoFileToPreview", http.StatusBadRequest)
+		return
+	}
+	resp, err := i.dpsClient.PreviewUpload(req.Context(), &v1.PreviewUploadRequest{
+		Scope:          dataScope,
+		Files:          filenames,
+		MappingProfile: req.FormValue("mapping_profile"),
+	})
+	if err != nil {
+		logger.Log.Error("DPS call failed", zap.Error(err))
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			http.Error(res, st.Message(), http.StatusBadRequest)
+			return
+		}
+		http.Error(res, "InternalServerError", http.StatusInternalServerError)
+		return
+	}
+	out, err := json.Marshal(resp)
+	if err != nil {
+		logger.Log.Error("Failed to marshal the response", zap.Error(err))
+		http.Error(res, "ResponseParsingFailure", http.StatusInternalServerError)
+		return
+	}
+	res.Header().Set("Content-Type", "application/json")
+	res.Write(out) //nolint
+}
+
+// saveUploadedFile copies the uploaded file to fn
+func saveUploadedFile(hdr *multipart.FileHeader, fn string) error {
+	infile, err := hdr.Open()
+	if err != nil {
+		return err
+	}
+	defer infile.Close()
+	outfile, err := os.Create(fn)
+	if err != nil {
+		return err
+	}
+	defer outfile.Close()
+	_, err = io.Copy(outfile, infile)
+	return err
+}
+
 func (i *ImportServiceServer) UploadMetaDataHandler(res http.ResponseWriter, req *http.Request, param httprouter.Params) {
 	// origReq := req
 	metadataScope := req.FormValue("scope")
@@ -1052,7 +1174,7 @@ func (i *ImportServiceServer) DownloadFile(res http.ResponseWriter, req *http.Re
 		return
 	}
 	var isOlderGeneric bool
-	if downloadType == string(errorFile) || downloadType == string(source) {
+	if downloadType == string(errorFile) || downloadType == string(source) || downloadType == string(failedRecords) {
 		uploadID = req.FormValue("uploadId")
 		if uploadID == "" {
 			logger.Log.Error("UploadIdIsMissing")
@@ -1072,6 +1194,10 @@ func (i *ImportServiceServer) DownloadFile(res http.ResponseWriter, req *http.Re
 		})
 		if err != nil {
 			logger.Log.Error("Failed to get fileInfo", zap.Error(err), zap.String("uploadID", uploadID), zap.String("downloadType", downloadType))
+			if st, ok := status.FromError(err); ok && (st.Code() == codes.NotFound || st.Code() == codes.FailedPrecondition) {
+				http.Error(res, st.Message(), http.StatusNotFound)
+				return
+			}
 			http.Error(res, "Failed to get fileInfo", http.StatusInternalServerError)
 			return
 		}
@@ -1088,7 +1214,7 @@ func (i *ImportServiceServer) DownloadFile(res http.ResponseWriter, req *http.Re
 	}
 	fileLocation := ""
 	switch string(downloadType) { //nolint
-	case string(errorFile):
+	case string(errorFile), string(failedRecords):
 		fileLocation = path.Join(i.Config.Upload.RawDataUploadDir, scope, "errors", fileName)
 	case string(analysis):
 		fileLocation = path.Join(i.Config.Upload.RawDataUploadDir, scope, "analysis", fileName)
@@ -1128,6 +1254,106 @@ func (i *ImportServiceServer) DownloadFile(res http.ResponseWriter, req *http.Re
 	return
 }
 
+// RetryFailedRecordsHandler retries the failed records of a data upload with its corrected error workbook
+func (i *ImportServiceServer) RetryFailedRecordsHandler(res http.ResponseWriter, req *http.Request, param httprouter.Params) {
+	scope := req.FormValue("scope")
+	if scope == "" {
+		http.Error(res, "ScopeIsMissing", http.StatusBadRequest)
+		return
+	}
+	userClaims, ok := rest_middleware.RetrieveClaims(req.Context())
+	if !ok {
+		http.Error(res, "ClaimsNotFound", http.StatusInternalServerError)
+		return
+	}
+	if userClaims.Role == claims.RoleUser {
+		http.Error(res, "RoleValidationFailed", http.StatusForbidden)
+		return
+	}
+	if !helper.Contains(userClaims.Socpes, scope) {
+		http.Error(res, "ScopeValidationFailed", http.StatusForbidden)
+		return
+	}
+	uploadID, err := strconv.ParseInt(req.FormValue("uploadId"), 10, 32)
+	if err != nil || uploadID <= 0 {
+		http.Error(res, "BadUploadIdReceived", http.StatusBadRequest)
+		return
+	}
+	if parseerr := req.ParseMultipartForm(32 << 20); parseerr != nil {
+		logger.Log.Error("parse multi past form ", zap.Error(parseerr))
+		http.Error(res, "cannot retry failed records", http.StatusInternalServerError)
+		return
+	}
+	var hdr *multipart.FileHeader
+	for _, fheaders := range req.MultipartForm.File {
+		for _, h := range fheaders {
+			if hdr != nil {
+				http.Error(res, "OneFileExpected", http.StatusBadRequest)
+				return
+			}
+			hdr = h
+		}
+	}
+	if hdr == nil {
+		http.Error(res, "NoFileToRetry", http.StatusBadRequest)
+		return
+	}
+	if filepath.Ext(hdr.Filename) != XLSX {
+		http.Error(res, "FileNotAllowed", http.StatusBadRequest)
+		return
+	}
+	if hdr.Size > i.Config.MaxFileSize*1024*1024 {
+		http.Error(res, "maximum file allowded is :"+strconv.FormatInt(i.Config.MaxFileSize, 10)+"Mbs", http.StatusBadRequest)
+		return
+	}
+	dir := filepath.Join(i.Config.Upload.RawDataUploadDir, scope, "errors")
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		logger.Log.Error("Cannot create Dir", zap.Error(err))
+		http.Error(res, "cannot retry failed records", http.StatusInternalServerError)
+		return
+	}
+	// dps reads the corrected workbook from the errors directory of the scope
+	fn := fmt.Sprintf("retry_%d_%s%s", uploadID, uuid.New().String(), XLSX)
+	if err := saveUploadedFile(hdr, filepath.Join(dir, fn)); err != nil {
+		logger.Log.Error("cannot store corrected workbook", zap.Error(err), zap.String("File", hdr.Filename))
+		http.Error(res, "cannot retry failed records", http.StatusInternalServerError)
+		return
+	}
+	defer func() {
+		if err := os.Remove(filepath.Join(dir, fn)); err != nil {
+			logger.Log.Error("cannot remove corrected workbook", zap.Error(err), zap.String("file", fn))
+		}
+	}()
+	resp, err := i.dpsClient.RetryFailedRecords(req.Context(), &v1.RetryFailedRecordsRequest{
+		Scope:    scope,
+		UploadId: int32(uploadID),
+		File:     fn,
+	})
+	if err != nil {
+		logger.Log.Error("DPS call failed", zap.Error(err))
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				http.Error(res, st.Message(), http.StatusBadRequest)
+				return
+			case codes.NotFound:
+				http.Error(res, st.Message(), http.StatusNotFound)
+				return
+			}
+		}
+		http.Error(res, "InternalServerError", http.StatusInternalServerError)
+		return
+	}
+	out, err := json.Marshal(resp)
+	if err != nil {
+		logger.Log.Error("Failed to marshal the response", zap.Error(err))
+		http.Error(res, "ResponseParsingFailure", http.StatusInternalServerError)
+		return
+	}
+	res.Header().Set("Content-Type", "application/json")
+	res.Write(out) //nolint
+}
+
 func (i *ImportServiceServer) ImportNominativeUser(res http.ResponseWriter, req *http.Request, param httprouter.Params) {
 	userClaims, ok := rest_middleware.RetrieveClaims(req.Context())
 	if !ok {
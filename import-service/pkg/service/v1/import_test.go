@@ -42,6 +42,8 @@ import (
 	"github.com/julienschmidt/httprouter"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func Test_UploadFiles(t *testing.T) {
@@ -915,6 +917,285 @@ func Test_importServiceServer_UploadDataHandler(t *testing.T) {
 	}
 }
 
+func Test_ImportServiceServer_PreviewDataHandler(t *testing.T) {
+	var dpsClient v1.DpsServiceClient
+	var request *http.Request
+	var err error
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cfg := &config.Config{MaxFileSize: int64(100), Upload: config.UploadConfig{UploadDir: "data", DataFileAllowedRegex: []string{`^products\.csv$`}}}
+
+	tests := []struct {
+		name   string
+		config *config.Config
+		setup  func()
+		code   int
+		body   string
+	}{
+		{
+			name:   "SUCCESS - preview returned and files removed",
+			config: cfg,
+			setup: func() {
+				request, err = newfileUploadRequest("/api/v1/import/data/preview", "France", "files", []string{"testdata/products.csv"}, "", "")
+				if err != nil {
+					t.Fatal(err)
+				}
+				mockDPSClient := mock.NewMockDpsServiceClient(mockCtrl)
+				dpsClient = mockDPSClient
+				mockDPSClient.EXPECT().PreviewUpload(request.Context(), gomock.Any()).Times(1).DoAndReturn(
+					func(ctx context.Context, in *v1.PreviewUploadRequest, opts ...grpc.CallOption) (*v1.PreviewUploadResponse, error) {
+						assert.Equal(t, "France", in.Scope)
+						assert.Len(t, in.Files, 1)
+						assert.True(t, strings.HasPrefix(in.Files[0], "preview#"))
+						assert.True(t, strings.HasSuffix(in.Files[0], "#France_products.csv"))
+						_, err := os.Stat(filepath.Join("data", in.Files[0]))
+						assert.NoError(t, err)
+						return &v1.PreviewUploadResponse{Impacts: []*v1.EntityImpact{{Entity: "products", Inserts: 2}}}, nil
+					})
+			},
+			code: http.StatusOK,
+			body: `{"impacts":[{"entity":"products","inserts":2}]}`,
+		},
+		{
+			name:   "FAILURE - file not allowed",
+			config: &config.Config{MaxFileSize: int64(100), Upload: config.UploadConfig{UploadDir: "data", DataFileAllowedRegex: []string{`^applications\.csv$`}}},
+			setup: func() {
+				request, err = newfileUploadRequest("/api/v1/import/data/preview", "France", "files", []string{"testdata/products.csv"}, "", "")
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name:   "FAILURE - scope not owned",
+			config: cfg,
+			setup: func() {
+				request, err = newfileUploadRequest("/api/v1/import/data/preview", "Italy", "files", []string{"testdata/products.csv"}, "", "")
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			code: http.StatusForbidden,
+		},
+		{
+			name:   "FAILURE - mapping profile not found",
+			config: cfg,
+			setup: func() {
+				request, err = newfileUploadRequest("/api/v1/import/data/preview", "France", "files", []string{"testdata/products.csv"}, "", "")
+				if err != nil {
+					t.Fatal(err)
+				}
+				mockDPSClient := mock.NewMockDpsServiceClient(mockCtrl)
+				dpsClient = mockDPSClient
+				mockDPSClient.EXPECT().PreviewUpload(request.Context(), gomock.Any()).Times(1).Return(nil, status.Error(codes.InvalidArgument, "MappingProfileNotFound"))
+			},
+			code: http.StatusBadRequest,
+			body: "MappingProfileNotFound\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer os.RemoveAll("data")
+			i := &ImportServiceServer{
+				Config:    tt.config,
+				dpsClient: dpsClient,
+			}
+			rec := httptest.NewRecorder()
+			i.PreviewDataHandler(rec, request, httprouter.Params{})
+			assert.Equal(t, tt.code, rec.Code)
+			if tt.body != "" {
+				assert.Equal(t, tt.body, rec.Body.String())
+			}
+			files, _ := filepath.Glob(filepath.Join("data", "*"))
+			assert.Empty(t, files)
+		})
+	}
+}
+
+func newRetryRequest(scope, uploadID, role string, files []string) (*http.Request, error) {
+	payload := &bytes.Buffer{}
+	writer := multipart.NewWriter(payload)
+	_ = writer.WriteField("scope", scope)
+	_ = writer.WriteField("uploadId", uploadID)
+	for _, f := range files {
+		file, err := os.Open(f)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		part, err := writer.CreateFormFile("file", filepath.Base(f))
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.Copy(part, file); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", "/api/v1/import/data/retry", payload)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	ctx := rest_middleware.AddLogCtxKey(req.Context())
+	ctx = rest_middleware.AddClaims(ctx, &claims.Claims{UserID: "TestUser", Role: claims.Role(role), Socpes: []string{"Scope1", "France"}})
+	return req.WithContext(ctx), nil
+}
+
+func Test_ImportServiceServer_RetryFailedRecordsHandler(t *testing.T) {
+	var dpsClient v1.DpsServiceClient
+	var request *http.Request
+	var err error
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	cfg := &config.Config{MaxFileSize: int64(10), Upload: config.UploadConfig{RawDataUploadDir: "data"}}
+
+	tests := []struct {
+		name  string
+		setup func()
+		code  int
+		body  string
+	}{
+		{
+			name: "SUCCESS - failed records retried",
+			setup: func() {
+				request, err = newRetryRequest("France", "12", "Admin", []string{"testdata/temp2.xlsx"})
+				if err != nil {
+					t.Fatal(err)
+				}
+				mockDPSClient := mock.NewMockDpsServiceClient(mockCtrl)
+				dpsClient = mockDPSClient
+				mockDPSClient.EXPECT().RetryFailedRecords(request.Context(), gomock.Any()).Times(1).DoAndReturn(
+					func(ctx context.Context, in *v1.RetryFailedRecordsRequest, opts ...grpc.CallOption) (*v1.RetryFailedRecordsResponse, error) {
+						assert.Equal(t, "France", in.Scope)
+						assert.Equal(t, int32(12), in.UploadId)
+						assert.True(t, strings.HasPrefix(in.File, "retry_12_"))
+						assert.True(t, strings.HasSuffix(in.File, XLSX))
+						_, err := os.Stat(filepath.Join("data", "France", "errors", in.File))
+						assert.NoError(t, err)
+						return &v1.RetryFailedRecordsResponse{}, nil
+					})
+			},
+			code: http.StatusOK,
+			body: `{}`,
+		},
+		{
+			name: "FAILURE - scope is missing",
+			setup: func() {
+				request, err = newRetryRequest("", "12", "Admin", []string{"testdata/temp2.xlsx"})
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			code: http.StatusBadRequest,
+			body: "ScopeIsMissing\n",
+		},
+		{
+			name: "FAILURE - user role",
+			setup: func() {
+				request, err = newRetryRequest("France", "12", "User", []string{"testdata/temp2.xlsx"})
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			code: http.StatusForbidden,
+			body: "RoleValidationFailed\n",
+		},
+		{
+			name: "FAILURE - scope not owned",
+			setup: func() {
+				request, err = newRetryRequest("Italy", "12", "Admin", []string{"testdata/temp2.xlsx"})
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			code: http.StatusForbidden,
+			body: "ScopeValidationFailed\n",
+		},
+		{
+			name: "FAILURE - bad upload id",
+			setup: func() {
+				request, err = newRetryRequest("France", "abc", "Admin", []string{"testdata/temp2.xlsx"})
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			code: http.StatusBadRequest,
+			body: "BadUploadIdReceived\n",
+		},
+		{
+			name: "FAILURE - no file",
+			setup: func() {
+				request, err = newRetryRequest("France", "12", "Admin", nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			code: http.StatusBadRequest,
+			body: "NoFileToRetry\n",
+		},
+		{
+			name: "FAILURE - file is not a workbook",
+			setup: func() {
+				request, err = newRetryRequest("France", "12", "Admin", []string{"testdata/products.csv"})
+				if err != nil {
+					t.Fatal(err)
+				}
+			},
+			code: http.StatusBadRequest,
+			body: "FileNotAllowed\n",
+		},
+		{
+			name: "FAILURE - records cannot be retried",
+			setup: func() {
+				request, err = newRetryRequest("France", "12", "Admin", []string{"testdata/temp2.xlsx"})
+				if err != nil {
+					t.Fatal(err)
+				}
+				mockDPSClient := mock.NewMockDpsServiceClient(mockCtrl)
+				dpsClient = mockDPSClient
+				mockDPSClient.EXPECT().RetryFailedRecords(request.Context(), gomock.Any()).Times(1).Return(nil, status.Error(codes.InvalidArgument, "UploadIsNotFailed"))
+			},
+			code: http.StatusBadRequest,
+			body: "UploadIsNotFailed\n",
+		},
+		{
+			name: "FAILURE - upload not found",
+			setup: func() {
+				request, err = newRetryRequest("France", "12", "Admin", []string{"testdata/temp2.xlsx"})
+				if err != nil {
+					t.Fatal(err)
+				}
+				mockDPSClient := mock.NewMockDpsServiceClient(mockCtrl)
+				dpsClient = mockDPSClient
+				mockDPSClient.EXPECT().RetryFailedRecords(request.Context(), gomock.Any()).Times(1).Return(nil, status.Error(codes.NotFound, "UploadNotFound"))
+			},
+			code: http.StatusNotFound,
+			body: "UploadNotFound\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer os.RemoveAll("data")
+			i := &ImportServiceServer{
+				Config:    cfg,
+				dpsClient: dpsClient,
+			}
+			rec := httptest.NewRecorder()
+			i.RetryFailedRecordsHandler(rec, request, httprouter.Params{})
+			assert.Equal(t, tt.code, rec.Code)
+			assert.Equal(t, tt.body, rec.Body.String())
+			files, _ := filepath.Glob(filepath.Join("data", "France", "errors", "*"))
+			assert.Empty(t, files)
+		})
+	}
+}
+
 func Test_ImportServiceServer_UploadMetaDataHandler(t *testing.T) {
 
 	var dpsClient v1.DpsServiceClient
@@ -24,14 +24,34 @@ type DpsServiceClient interface {
 	ViewCoreFactorLogs(ctx context.Context, in *ViewCoreFactorLogsRequest, opts ...grpc.CallOption) (*ViewCoreFactorLogsResponse, error)
 	DataAnalysis(ctx context.Context, in *DataAnalysisRequest, opts ...grpc.CallOption) (*DataAnalysisResponse, error)
 	NotifyUpload(ctx context.Context, in *NotifyUploadRequest, opts ...grpc.CallOption) (*NotifyUploadResponse, error)
+	PreviewUpload(ctx context.Context, in *PreviewUploadRequest, opts ...grpc.CallOption) (*PreviewUploadResponse, error)
 	DashboardQualityOverview(ctx context.Context, in *DashboardQualityOverviewRequest, opts ...grpc.CallOption) (*DashboardQualityOverviewResponse, error)
 	ListUploadData(ctx context.Context, in *ListUploadRequest, opts ...grpc.CallOption) (*ListUploadResponse, error)
 	ListUploadMetaData(ctx context.Context, in *ListUploadRequest, opts ...grpc.CallOption) (*ListUploadResponse, error)
 	ListUploadGlobalData(ctx context.Context, in *ListUploadRequest, opts ...grpc.CallOption) (*ListUploadResponse, error)
 	ListFailedRecord(ctx context.Context, in *ListFailedRequest, opts ...grpc.CallOption) (*ListFailedResponse, error)
+	RetryFailedRecords(ctx context.Context, in *RetryFailedRecordsRequest, opts ...grpc.CallOption) (*RetryFailedRecordsResponse, error)
 	DeleteInventory(ctx context.Context, in *DeleteInventoryRequest, opts ...grpc.CallOption) (*DeleteInventoryResponse, error)
 	DropUploadedFileData(ctx context.Context, in *DropUploadedFileDataRequest, opts ...grpc.CallOption) (*DropUploadedFileDataResponse, error)
 	ListDeletionRecords(ctx context.Context, in *ListDeletionRequest, opts ...grpc.CallOption) (*ListDeletionResponse, error)
+	CancelUpload(ctx context.Context, in *CancelUploadRequest, opts ...grpc.CallOption) (*CancelUploadResponse, error)
+	CreateMappingProfile(ctx context.Context, in *MappingProfile, opts ...grpc.CallOption) (*MappingProfile, error)
+	UpdateMappingProfile(ctx context.Context, in *MappingProfile, opts ...grpc.CallOption) (*MappingProfile, error)
+	ListMappingProfiles(ctx context.Context, in *ListMappingProfilesRequest, opts ...grpc.CallOption) (*ListMappingProfilesResponse, error)
+	DeleteMappingProfile(ctx context.Context, in *DeleteMappingProfileRequest, opts ...grpc.CallOption) (*DeleteMappingProfileResponse, error)
+	ListInjections(ctx context.Context, in *ListInjectionsRequest, opts ...grpc.CallOption) (*ListInjectionsResponse, error)
+	// RollbackInjection compensates the latest committed injection of the scope with the previous state of its records,
+	// it is best-effort: the injection is COMPENSATED once all the compensating jobs are done, failed or not.
+	RollbackInjection(ctx context.Context, in *RollbackInjectionRequest, opts ...grpc.CallOption) (*RollbackInjectionResponse, error)
+	CreateConnector(ctx context.Context, in *Connector, opts ...grpc.CallOption) (*Connector, error)
+	UpdateConnector(ctx context.Context, in *Connector, opts ...grpc.CallOption) (*Connector, error)
+	ListConnectors(ctx context.Context, in *ListConnectorsRequest, opts ...grpc.CallOption) (*ListConnectorsResponse, error)
+	DeleteConnector(ctx context.Context, in *DeleteConnectorRequest, opts ...grpc.CallOption) (*DeleteConnectorResponse, error)
+	RunConnector(ctx context.Context, in *RunConnectorRequest, opts ...grpc.CallOption) (*RunConnectorResponse, error)
+	CreateQualityRule(ctx context.Context, in *QualityRule, opts ...grpc.CallOption) (*QualityRule, error)
+	UpdateQualityRule(ctx context.Context, in *QualityRule, opts ...grpc.CallOption) (*QualityRule, error)
+	ListQualityRules(ctx context.Context, in *ListQualityRulesRequest, opts ...grpc.CallOption) (*ListQualityRulesResponse, error)
+	DeleteQualityRule(ctx context.Context, in *DeleteQualityRuleRequest, opts ...grpc.CallOption) (*DeleteQualityRuleResponse, error)
 }
 
 type dpsServiceClient struct {
@@ -105,6 +125,15 @@ func (c *dpsServiceClient) NotifyUpload(ctx context.Context, in *NotifyUploadReq
 	return out, nil
 }
 
+func (c *dpsServiceClient) PreviewUpload(ctx context.Context, in *PreviewUploadRequest, opts ...grpc.CallOption) (*PreviewUploadResponse, error) {
+	out := new(PreviewUploadResponse)
+	err := c.cc.Invoke(ctx, "/optisam.dps.v1.DpsService/PreviewUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dpsServiceClient) DashboardQualityOverview(ctx context.Context, in *DashboardQualityOverviewRequest, opts ...grpc.CallOption) (*DashboardQualityOverviewResponse, error) {
 	out := new(DashboardQualityOverviewResponse)
 	err := c.cc.Invoke(ctx, "/optisam.dps.v1.DpsService/DashboardQualityOverview", in, out, opts...)
@@ -150,6 +179,15 @@ func (c *dpsServiceClient) ListFailedRecord(ctx context.Context, in *ListFailedR
 	return out, nil
 }
 
+func (c *dpsServiceClient) RetryFailedRecords(ctx context.Context, in *RetryFailedRecordsRequest, opts ...grpc.CallOption) (*RetryFailedRecordsResponse, error) {
+	out := new(RetryFailedRecordsResponse)
+	err := c.cc.Invoke(ctx, "/optisam.dps.v1.DpsService/RetryFailedRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dpsServiceClient) DeleteInventory(ctx context.Context, in *DeleteInventoryRequest, opts ...grpc.CallOption) (*DeleteInventoryResponse, error) {
 	out := new(DeleteInventoryResponse)
 	err := c.cc.Invoke(ctx, "/optisam.dps.v1.DpsService/DeleteInventory", in, out, opts...)
@@ -177,6 +215,150 @@ func (c *dpsServiceClient) ListDeletionRecords(ctx context.Context, in *ListDele
 	return out, nil
 }
 
+func (c *dpsServiceClient) CancelUpload(ctx context.Context, in *CancelUploadRequest, opts ...grpc.CallOption) (*CancelUploadResponse, error) {
+	out := new(CancelUploadResponse)
+	err := c.cc.Invoke(ctx, "/optisam.dps.v1.DpsService/CancelUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dpsServiceClient) CreateMappingProfile(ctx context.Context, in *MappingProfile, opts ...grpc.CallOption) (*MappingProfile, error) {
+	out := new(MappingProfile)
+	err := c.cc.Invoke(ctx, "/optisam.dps.v1.DpsService/CreateMappingProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dpsServiceClient) UpdateMappingProfile(ctx context.Context, in *MappingProfile, opts ...grpc.CallOption) (*MappingProfile, error) {
+	out := new(MappingProfile)
+	err := c.cc.Invoke(ctx, "/optisam.dps.v1.DpsService/UpdateMappingProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dpsServiceClient) ListMappingProfiles(ctx context.Context, in *ListMappingProfilesRequest, opts ...grpc.CallOption) (*ListMappingProfilesResponse, error) {
+	out := new(ListMappingProfilesResponse)
+	err := c.cc.Invoke(ctx, "/optisam.dps.v1.DpsService/ListMappingProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dpsServiceClient) DeleteMappingProfile(ctx context.Context, in *DeleteMappingProfileRequest, opts ...grpc.CallOption) (*DeleteMappingProfileResponse, error) {
+	out := new(DeleteMappingProfileResponse)
+	err := c.cc.Invoke(ctx, "/optisam.dps.v1.DpsService/DeleteMappingProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dpsServiceClient) ListInjections(ctx context.Context, in *ListInjectionsRequest, opts ...grpc.CallOption) (*ListInjectionsResponse, error) {
+	out := new(ListInjectionsResponse)
+	err := c.cc.Invoke(ctx, "/optisam.dps.v1.DpsService/ListInjections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dpsServiceClient) RollbackInjection(ctx context.Context, in *RollbackInjectionRequest, opts ...grpc.CallOption) (*RollbackInjectionResponse, error) {
+	out := new(RollbackInjectionResponse)
+	err := c.cc.Invoke(ctx, "/optisam.dps.v1.DpsService/RollbackInjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dpsServiceClient) CreateConnector(ctx context.Context, in *Connector, opts ...grpc.CallOption) (*Connector, error) {
+	out := new(Connector)
+	err := c.cc.Invoke(ctx, "/optisam.dps.v1.DpsService/CreateConnector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dpsServiceClient) UpdateConnector(ctx context.Context, in *Connector, opts ...grpc.CallOption) (*Connector, error) {
+	out := new(Connector)
+	err := c.cc.Invoke(ctx, "/optisam.dps.v1.DpsService/UpdateConnector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dpsServiceClient) ListConnectors(ctx context.Context, in *ListConnectorsRequest, opts ...grpc.CallOption) (*ListConnectorsResponse, error) {
+	out := new(ListConnectorsResponse)
+	err := c.cc.Invoke(ctx, "/optisam.dps.v1.DpsService/ListConnectors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dpsServiceClient) DeleteConnector(ctx context.Context, in *DeleteConnectorRequest, opts ...grpc.CallOption) (*DeleteConnectorResponse, error) {
+	out := new(DeleteConnectorResponse)
+	err := c.cc.Invoke(ctx, "/optisam.dps.v1.DpsService/DeleteConnector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dpsServiceClient) RunConnector(ctx context.Context, in *RunConnectorRequest, opts ...grpc.CallOption) (*RunConnectorResponse, error) {
+	out := new(RunConnectorResponse)
+	err := c.cc.Invoke(ctx, "/optisam.dps.v1.DpsService/RunConnector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dpsServiceClient) CreateQualityRule(ctx context.Context, in *QualityRule, opts ...grpc.CallOption) (*QualityRule, error) {
+	out := new(QualityRule)
+	err := c.cc.Invoke(ctx, "/optisam.dps.v1.DpsService/CreateQualityRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dpsServiceClient) UpdateQualityRule(ctx context.Context, in *QualityRule, opts ...grpc.CallOption) (*QualityRule, error) {
+	out := new(QualityRule)
+	err := c.cc.Invoke(ctx, "/optisam.dps.v1.DpsService/UpdateQualityRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dpsServiceClient) ListQualityRules(ctx context.Context, in *ListQualityRulesRequest, opts ...grpc.CallOption) (*ListQualityRulesResponse, error) {
+	out := new(ListQualityRulesResponse)
+	err := c.cc.Invoke(ctx, "/optisam.dps.v1.DpsService/ListQualityRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dpsServiceClient) DeleteQualityRule(ctx context.Context, in *DeleteQualityRuleRequest, opts ...grpc.CallOption) (*DeleteQualityRuleResponse, error) {
+	out := new(DeleteQualityRuleResponse)
+	err := c.cc.Invoke(ctx, "/optisam.dps.v1.DpsService/DeleteQualityRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DpsServiceServer is the server API for DpsService service.
 // All implementations should embed UnimplementedDpsServiceServer
 // for forward compatibility
@@ -188,14 +370,34 @@ type DpsServiceServer interface {
 	ViewCoreFactorLogs(context.Context, *ViewCoreFactorLogsRequest) (*ViewCoreFactorLogsResponse, error)
 	DataAnalysis(context.Context, *DataAnalysisRequest) (*DataAnalysisResponse, error)
 	NotifyUpload(context.Context, *NotifyUploadRequest) (*NotifyUploadResponse, error)
+	PreviewUpload(context.Context, *PreviewUploadRequest) (*PreviewUploadResponse, error)
 	DashboardQualityOverview(context.Context, *DashboardQualityOverviewRequest) (*DashboardQualityOverviewResponse, error)
 	ListUploadData(context.Context, *ListUploadRequest) (*ListUploadResponse, error)
 	ListUploadMetaData(context.Context, *ListUploadRequest) (*ListUploadResponse, error)
 	ListUploadGlobalData(context.Context, *ListUploadRequest) (*ListUploadResponse, error)
 	ListFailedRecord(context.Context, *ListFailedRequest) (*ListFailedResponse, error)
+	RetryFailedRecords(context.Context, *RetryFailedRecordsRequest) (*RetryFailedRecordsResponse, error)
 	DeleteInventory(context.Context, *DeleteInventoryRequest) (*DeleteInventoryResponse, error)
 	DropUploadedFileData(context.Context, *DropUploadedFileDataRequest) (*DropUploadedFileDataResponse, error)
 	ListDeletionRecords(context.Context, *ListDeletionRequest) (*ListDeletionResponse, error)
+	CancelUpload(context.Context, *CancelUploadRequest) (*CancelUploadResponse, error)
+	CreateMappingProfile(context.Context, *MappingProfile) (*MappingProfile, error)
+	UpdateMappingProfile(context.Context, *MappingProfile) (*MappingProfile, error)
+	ListMappingProfiles(context.Context, *ListMappingProfilesRequest) (*ListMappingProfilesResponse, error)
+	DeleteMappingProfile(context.Context, *DeleteMappingProfileRequest) (*DeleteMappingProfileResponse, error)
+	ListInjections(context.Context, *ListInjectionsRequest) (*ListInjectionsResponse, error)
+	// RollbackInjection compensates the latest committed injection of the scope with the previous state of its records,
+	// it is best-effort: the injection is COMPENSATED once all the compensating jobs are done, failed or not.
+	RollbackInjection(context.Context, *RollbackInjectionRequest) (*RollbackInjectionResponse, error)
+	CreateConnector(context.Context, *Connector) (*Connector, error)
+	UpdateConnector(context.Context, *Connector) (*Connector, error)
+	ListConnectors(context.Context, *ListConnectorsRequest) (*ListConnectorsResponse, error)
+	DeleteConnector(context.Context, *DeleteConnectorRequest) (*DeleteConnectorResponse, error)
+	RunConnector(context.Context, *RunConnectorRequest) (*RunConnectorResponse, error)
+	CreateQualityRule(context.Context, *QualityRule) (*QualityRule, error)
+	UpdateQualityRule(context.Context, *QualityRule) (*QualityRule, error)
+	ListQualityRules(context.Context, *ListQualityRulesRequest) (*ListQualityRulesResponse, error)
+	DeleteQualityRule(context.Context, *DeleteQualityRuleRequest) (*DeleteQualityRuleResponse, error)
 }
 
 // UnimplementedDpsServiceServer should be embedded to have forward compatible implementations.
@@ -223,6 +425,9 @@ func (UnimplementedDpsServiceServer) DataAnalysis(context.Context, *DataAnalysis
 func (UnimplementedDpsServiceServer) NotifyUpload(context.Context, *NotifyUploadRequest) (*NotifyUploadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NotifyUpload not implemented")
 }
+func (UnimplementedDpsServiceServer) PreviewUpload(context.Context, *PreviewUploadRequest) (*PreviewUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewUpload not implemented")
+}
 func (UnimplementedDpsServiceServer) DashboardQualityOverview(context.Context, *DashboardQualityOverviewRequest) (*DashboardQualityOverviewResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DashboardQualityOverview not implemented")
 }
@@ -238,6 +443,9 @@ func (UnimplementedDpsServiceServer) ListUploadGlobalData(context.Context, *List
 func (UnimplementedDpsServiceServer) ListFailedRecord(context.Context, *ListFailedRequest) (*ListFailedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFailedRecord not implemented")
 }
+func (UnimplementedDpsServiceServer) RetryFailedRecords(context.Context, *RetryFailedRecordsRequest) (*RetryFailedRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryFailedRecords not implemented")
+}
 func (UnimplementedDpsServiceServer) DeleteInventory(context.Context, *DeleteInventoryRequest) (*DeleteInventoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteInventory not implemented")
 }
@@ -247,6 +455,54 @@ func (UnimplementedDpsServiceServer) DropUploadedFileData(context.Context, *Drop
 func (UnimplementedDpsServiceServer) ListDeletionRecords(context.Context, *ListDeletionRequest) (*ListDeletionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDeletionRecords not implemented")
 }
+func (UnimplementedDpsServiceServer) CancelUpload(context.Context, *CancelUploadRequest) (*CancelUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUpload not implemented")
+}
+func (UnimplementedDpsServiceServer) CreateMappingProfile(context.Context, *MappingProfile) (*MappingProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMappingProfile not implemented")
+}
+func (UnimplementedDpsServiceServer) UpdateMappingProfile(context.Context, *MappingProfile) (*MappingProfile, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMappingProfile not implemented")
+}
+func (UnimplementedDpsServiceServer) ListMappingProfiles(context.Context, *ListMappingProfilesRequest) (*ListMappingProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMappingProfiles not implemented")
+}
+func (UnimplementedDpsServiceServer) DeleteMappingProfile(context.Context, *DeleteMappingProfileRequest) (*DeleteMappingProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMappingProfile not implemented")
+}
+func (UnimplementedDpsServiceServer) ListInjections(context.Context, *ListInjectionsRequest) (*ListInjectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInjections not implemented")
+}
+func (UnimplementedDpsServiceServer) RollbackInjection(context.Context, *RollbackInjectionRequest) (*RollbackInjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackInjection not implemented")
+}
+func (UnimplementedDpsServiceServer) CreateConnector(context.Context, *Connector) (*Connector, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConnector not implemented")
+}
+func (UnimplementedDpsServiceServer) UpdateConnector(context.Context, *Connector) (*Connector, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConnector not implemented")
+}
+func (UnimplementedDpsServiceServer) ListConnectors(context.Context, *ListConnectorsRequest) (*ListConnectorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnectors not implemented")
+}
+func (UnimplementedDpsServiceServer) DeleteConnector(context.Context, *DeleteConnectorRequest) (*DeleteConnectorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConnector not implemented")
+}
+func (UnimplementedDpsServiceServer) RunConnector(context.Context, *RunConnectorRequest) (*RunConnectorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunConnector not implemented")
+}
+func (UnimplementedDpsServiceServer) CreateQualityRule(context.Context, *QualityRule) (*QualityRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQualityRule not implemented")
+}
+func (UnimplementedDpsServiceServer) UpdateQualityRule(context.Context, *QualityRule) (*QualityRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQualityRule not implemented")
+}
+func (UnimplementedDpsServiceServer) ListQualityRules(context.Context, *ListQualityRulesRequest) (*ListQualityRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQualityRules not implemented")
+}
+func (UnimplementedDpsServiceServer) DeleteQualityRule(context.Context, *DeleteQualityRuleRequest) (*DeleteQualityRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQualityRule not implemented")
+}
 
 // UnsafeDpsServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to DpsServiceServer will
@@ -385,6 +641,24 @@ func _DpsService_NotifyUpload_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DpsService_PreviewUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DpsServiceServer).PreviewUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.dps.v1.DpsService/PreviewUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DpsServiceServer).PreviewUpload(ctx, req.(*PreviewUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DpsService_DashboardQualityOverview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DashboardQualityOverviewRequest)
 	if err := dec(in); err != nil {
@@ -475,6 +749,24 @@ func _DpsService_ListFailedRecord_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DpsService_RetryFailedRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryFailedRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DpsServiceServer).RetryFailedRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.dps.v1.DpsService/RetryFailedRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DpsServiceServer).RetryFailedRecords(ctx, req.(*RetryFailedRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DpsService_DeleteInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteInventoryRequest)
 	if err := dec(in); err != nil {
@@ -529,6 +821,294 @@ func _DpsService_ListDeletionRecords_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DpsService_CancelUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DpsServiceServer).CancelUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.dps.v1.DpsService/CancelUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DpsServiceServer).CancelUpload(ctx, req.(*CancelUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DpsService_CreateMappingProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MappingProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DpsServiceServer).CreateMappingProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.dps.v1.DpsService/CreateMappingProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DpsServiceServer).CreateMappingProfile(ctx, req.(*MappingProfile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DpsService_UpdateMappingProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MappingProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DpsServiceServer).UpdateMappingProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.dps.v1.DpsService/UpdateMappingProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DpsServiceServer).UpdateMappingProfile(ctx, req.(*MappingProfile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DpsService_ListMappingProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMappingProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DpsServiceServer).ListMappingProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.dps.v1.DpsService/ListMappingProfiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DpsServiceServer).ListMappingProfiles(ctx, req.(*ListMappingProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DpsService_DeleteMappingProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMappingProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DpsServiceServer).DeleteMappingProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.dps.v1.DpsService/DeleteMappingProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DpsServiceServer).DeleteMappingProfile(ctx, req.(*DeleteMappingProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DpsService_ListInjections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInjectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DpsServiceServer).ListInjections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.dps.v1.DpsService/ListInjections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DpsServiceServer).ListInjections(ctx, req.(*ListInjectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DpsService_RollbackInjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackInjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DpsServiceServer).RollbackInjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.dps.v1.DpsService/RollbackInjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DpsServiceServer).RollbackInjection(ctx, req.(*RollbackInjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DpsService_CreateConnector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Connector)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DpsServiceServer).CreateConnector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.dps.v1.DpsService/CreateConnector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DpsServiceServer).CreateConnector(ctx, req.(*Connector))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DpsService_UpdateConnector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Connector)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DpsServiceServer).UpdateConnector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.dps.v1.DpsService/UpdateConnector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DpsServiceServer).UpdateConnector(ctx, req.(*Connector))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DpsService_ListConnectors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConnectorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DpsServiceServer).ListConnectors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.dps.v1.DpsService/ListConnectors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DpsServiceServer).ListConnectors(ctx, req.(*ListConnectorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DpsService_DeleteConnector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConnectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DpsServiceServer).DeleteConnector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.dps.v1.DpsService/DeleteConnector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DpsServiceServer).DeleteConnector(ctx, req.(*DeleteConnectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DpsService_RunConnector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunConnectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DpsServiceServer).RunConnector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.dps.v1.DpsService/RunConnector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DpsServiceServer).RunConnector(ctx, req.(*RunConnectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DpsService_CreateQualityRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QualityRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DpsServiceServer).CreateQualityRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.dps.v1.DpsService/CreateQualityRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DpsServiceServer).CreateQualityRule(ctx, req.(*QualityRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DpsService_UpdateQualityRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QualityRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DpsServiceServer).UpdateQualityRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.dps.v1.DpsService/UpdateQualityRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DpsServiceServer).UpdateQualityRule(ctx, req.(*QualityRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DpsService_ListQualityRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQualityRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DpsServiceServer).ListQualityRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.dps.v1.DpsService/ListQualityRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DpsServiceServer).ListQualityRules(ctx, req.(*ListQualityRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DpsService_DeleteQualityRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQualityRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DpsServiceServer).DeleteQualityRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.dps.v1.DpsService/DeleteQualityRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DpsServiceServer).DeleteQualityRule(ctx, req.(*DeleteQualityRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DpsService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "optisam.dps.v1.DpsService",
 	HandlerType: (*DpsServiceServer)(nil),
@@ -561,6 +1141,10 @@ var _DpsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NotifyUpload",
 			Handler:    _DpsService_NotifyUpload_Handler,
 		},
+		{
+			MethodName: "PreviewUpload",
+			Handler:    _DpsService_PreviewUpload_Handler,
+		},
 		{
 			MethodName: "DashboardQualityOverview",
 			Handler:    _DpsService_DashboardQualityOverview_Handler,
@@ -581,6 +1165,10 @@ var _DpsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListFailedRecord",
 			Handler:    _DpsService_ListFailedRecord_Handler,
 		},
+		{
+			MethodName: "RetryFailedRecords",
+			Handler:    _DpsService_RetryFailedRecords_Handler,
+		},
 		{
 			MethodName: "DeleteInventory",
 			Handler:    _DpsService_DeleteInventory_Handler,
@@ -593,6 +1181,70 @@ var _DpsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListDeletionRecords",
 			Handler:    _DpsService_ListDeletionRecords_Handler,
 		},
+		{
+			MethodName: "CancelUpload",
+			Handler:    _DpsService_CancelUpload_Handler,
+		},
+		{
+			MethodName: "CreateMappingProfile",
+			Handler:    _DpsService_CreateMappingProfile_Handler,
+		},
+		{
+			MethodName: "UpdateMappingProfile",
+			Handler:    _DpsService_UpdateMappingProfile_Handler,
+		},
+		{
+			MethodName: "ListMappingProfiles",
+			Handler:    _DpsService_ListMappingProfiles_Handler,
+		},
+		{
+			MethodName: "DeleteMappingProfile",
+			Handler:    _DpsService_DeleteMappingProfile_Handler,
+		},
+		{
+			MethodName: "ListInjections",
+			Handler:    _DpsService_ListInjections_Handler,
+		},
+		{
+			MethodName: "RollbackInjection",
+			Handler:    _DpsService_RollbackInjection_Handler,
+		},
+		{
+			MethodName: "CreateConnector",
+			Handler:    _DpsService_CreateConnector_Handler,
+		},
+		{
+			MethodName: "UpdateConnector",
+			Handler:    _DpsService_UpdateConnector_Handler,
+		},
+		{
+			MethodName: "ListConnectors",
+			Handler:    _DpsService_ListConnectors_Handler,
+		},
+		{
+			MethodName: "DeleteConnector",
+			Handler:    _DpsService_DeleteConnector_Handler,
+		},
+		{
+			MethodName: "RunConnector",
+			Handler:    _DpsService_RunConnector_Handler,
+		},
+		{
+			MethodName: "CreateQualityRule",
+			Handler:    _DpsService_CreateQualityRule_Handler,
+		},
+		{
+			MethodName: "UpdateQualityRule",
+			Handler:    _DpsService_UpdateQualityRule_Handler,
+		},
+		{
+			MethodName: "ListQualityRules",
+			Handler:    _DpsService_ListQualityRules_Handler,
+		},
+		{
+			MethodName: "DeleteQualityRule",
+			Handler:    _DpsService_DeleteQualityRule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dps.proto",
@@ -2133,6 +2133,359 @@ func (*Attribute_FloatValOld) isAttribute_OldVal() {}
 
 func (*Attribute_StringValOld) isAttribute_OldVal() {}
 
+type OptimizeLicenseMixRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SwidTag         string `protobuf:"bytes,1,opt,name=swid_tag,json=swidTag,proto3" json:"swid_tag,omitempty"`
+	AggregationName string `protobuf:"bytes,2,opt,name=aggregation_name,json=aggregationName,proto3" json:"aggregation_name,omitempty"`
+	Scope           string `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+}
+
+func (x *OptimizeLicenseMixRequest) Reset() {
+	*x = OptimizeLicenseMixRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_license_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizeLicenseMixRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizeLicenseMixRequest) ProtoMessage() {}
+
+func (x *OptimizeLicenseMixRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_license_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizeLicenseMixRequest.ProtoReflect.Descriptor instead.
+func (*OptimizeLicenseMixRequest) Descriptor() ([]byte, []int) {
+	return file_license_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *OptimizeLicenseMixRequest) GetSwidTag() string {
+	if x != nil {
+		return x.SwidTag
+	}
+	return ""
+}
+
+func (x *OptimizeLicenseMixRequest) GetAggregationName() string {
+	if x != nil {
+		return x.AggregationName
+	}
+	return ""
+}
+
+func (x *OptimizeLicenseMixRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+type OptimizeLicenseMixResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SwidTag         string `protobuf:"bytes,1,opt,name=swid_tag,json=swidTag,proto3" json:"swid_tag,omitempty"`
+	AggregationName string `protobuf:"bytes,2,opt,name=aggregation_name,json=aggregationName,proto3" json:"aggregation_name,omitempty"`
+	// usage of the product under each of its metrics and the share of it covered by the licences of the metric
+	Metrics []*MetricCoverage `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	// licences and cost to buy on top of the available ones
+	LicencesToBuy int32   `protobuf:"varint,4,opt,name=licences_to_buy,json=licencesToBuy,proto3" json:"licences_to_buy,omitempty"`
+	PurchaseCost  float64 `protobuf:"fixed64,5,opt,name=purchase_cost,json=purchaseCost,proto3" json:"purchase_cost,omitempty"`
+	// licences of the product not used by the mix
+	ReassignableLicences int32            `protobuf:"varint,6,opt,name=reassignable_licences,json=reassignableLicences,proto3" json:"reassignable_licences,omitempty"`
+	ReassignableCost     float64          `protobuf:"fixed64,7,opt,name=reassignable_cost,json=reassignableCost,proto3" json:"reassignable_cost,omitempty"`
+	Skus                 []*SkuAllocation `protobuf:"bytes,8,rep,name=skus,proto3" json:"skus,omitempty"`
+}
+
+func (x *OptimizeLicenseMixResponse) Reset() {
+	*x = OptimizeLicenseMixResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_license_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizeLicenseMixResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizeLicenseMixResponse) ProtoMessage() {}
+
+func (x *OptimizeLicenseMixResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_license_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizeLicenseMixResponse.ProtoReflect.Descriptor instead.
+func (*OptimizeLicenseMixResponse) Descriptor() ([]byte, []int) {
+	return file_license_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *OptimizeLicenseMixResponse) GetSwidTag() string {
+	if x != nil {
+		return x.SwidTag
+	}
+	return ""
+}
+
+func (x *OptimizeLicenseMixResponse) GetAggregationName() string {
+	if x != nil {
+		return x.AggregationName
+	}
+	return ""
+}
+
+func (x *OptimizeLicenseMixResponse) GetMetrics() []*MetricCoverage {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *OptimizeLicenseMixResponse) GetLicencesToBuy() int32 {
+	if x != nil {
+		return x.LicencesToBuy
+	}
+	return 0
+}
+
+func (x *OptimizeLicenseMixResponse) GetPurchaseCost() float64 {
+	if x != nil {
+		return x.PurchaseCost
+	}
+	return 0
+}
+
+func (x *OptimizeLicenseMixResponse) GetReassignableLicences() int32 {
+	if x != nil {
+		return x.ReassignableLicences
+	}
+	return 0
+}
+
+func (x *OptimizeLicenseMixResponse) GetReassignableCost() float64 {
+	if x != nil {
+		return x.ReassignableCost
+	}
+	return 0
+}
+
+func (x *OptimizeLicenseMixResponse) GetSkus() []*SkuAllocation {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+type MetricCoverage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metric string `protobuf:"bytes,1,opt,name=metric,proto3" json:"metric,omitempty"`
+	// licences computed for the whole usage with this metric
+	ComputedLicences  int32 `protobuf:"varint,2,opt,name=computed_licences,json=computedLicences,proto3" json:"computed_licences,omitempty"`
+	AvailableLicences int32 `protobuf:"varint,3,opt,name=available_licences,json=availableLicences,proto3" json:"available_licences,omitempty"`
+	UsedLicences      int32 `protobuf:"varint,4,opt,name=used_licences,json=usedLicences,proto3" json:"used_licences,omitempty"`
+	LicencesToBuy     int32 `protobuf:"varint,5,opt,name=licences_to_buy,json=licencesToBuy,proto3" json:"licences_to_buy,omitempty"`
+	// percentage of the usage covered by the licences of this metric
+	CoveredPercentage float64 `protobuf:"fixed64,6,opt,name=covered_percentage,json=coveredPercentage,proto3" json:"covered_percentage,omitempty"`
+}
+
+func (x *MetricCoverage) Reset() {
+	*x = MetricCoverage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_license_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricCoverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricCoverage) ProtoMessage() {}
+
+func (x *MetricCoverage) ProtoReflect() protoreflect.Message {
+	mi := &file_license_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricCoverage.ProtoReflect.Descriptor instead.
+func (*MetricCoverage) Descriptor() ([]byte, []int) {
+	return file_license_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MetricCoverage) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *MetricCoverage) GetComputedLicences() int32 {
+	if x != nil {
+		return x.ComputedLicences
+	}
+	return 0
+}
+
+func (x *MetricCoverage) GetAvailableLicences() int32 {
+	if x != nil {
+		return x.AvailableLicences
+	}
+	return 0
+}
+
+func (x *MetricCoverage) GetUsedLicences() int32 {
+	if x != nil {
+		return x.UsedLicences
+	}
+	return 0
+}
+
+func (x *MetricCoverage) GetLicencesToBuy() int32 {
+	if x != nil {
+		return x.LicencesToBuy
+	}
+	return 0
+}
+
+func (x *MetricCoverage) GetCoveredPercentage() float64 {
+	if x != nil {
+		return x.CoveredPercentage
+	}
+	return 0
+}
+
+type SkuAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SKU                  string  `protobuf:"bytes,1,opt,name=SKU,proto3" json:"SKU,omitempty"`
+	Metric               string  `protobuf:"bytes,2,opt,name=metric,proto3" json:"metric,omitempty"`
+	AvgUnitPrice         float64 `protobuf:"fixed64,3,opt,name=avg_unit_price,json=avgUnitPrice,proto3" json:"avg_unit_price,omitempty"`
+	AvailableLicences    int32   `protobuf:"varint,4,opt,name=available_licences,json=availableLicences,proto3" json:"available_licences,omitempty"`
+	UsedLicences         int32   `protobuf:"varint,5,opt,name=used_licences,json=usedLicences,proto3" json:"used_licences,omitempty"`
+	LicencesToBuy        int32   `protobuf:"varint,6,opt,name=licences_to_buy,json=licencesToBuy,proto3" json:"licences_to_buy,omitempty"`
+	ReassignableLicences int32   `protobuf:"varint,7,opt,name=reassignable_licences,json=reassignableLicences,proto3" json:"reassignable_licences,omitempty"`
+}
+
+func (x *SkuAllocation) Reset() {
+	*x = SkuAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_license_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SkuAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuAllocation) ProtoMessage() {}
+
+func (x *SkuAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_license_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuAllocation.ProtoReflect.Descriptor instead.
+func (*SkuAllocation) Descriptor() ([]byte, []int) {
+	return file_license_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SkuAllocation) GetSKU() string {
+	if x != nil {
+		return x.SKU
+	}
+	return ""
+}
+
+func (x *SkuAllocation) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *SkuAllocation) GetAvgUnitPrice() float64 {
+	if x != nil {
+		return x.AvgUnitPrice
+	}
+	return 0
+}
+
+func (x *SkuAllocation) GetAvailableLicences() int32 {
+	if x != nil {
+		return x.AvailableLicences
+	}
+	return 0
+}
+
+func (x *SkuAllocation) GetUsedLicences() int32 {
+	if x != nil {
+		return x.UsedLicences
+	}
+	return 0
+}
+
+func (x *SkuAllocation) GetLicencesToBuy() int32 {
+	if x != nil {
+		return x.LicencesToBuy
+	}
+	return 0
+}
+
+func (x *SkuAllocation) GetReassignableLicences() int32 {
+	if x != nil {
+		return x.ReassignableLicences
+	}
+	return 0
+}
+
 var File_license_proto protoreflect.FileDescriptor
 
 var file_license_proto_rawDesc = []byte{
@@ -2495,106 +2848,183 @@ var file_license_proto_rawDesc = []byte{
 	0x4f, 0x6c, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61,
 	0x6c, 0x5f, 0x6f, 0x6c, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x73,
 	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x4f, 0x6c, 0x64, 0x42, 0x05, 0x0a, 0x03, 0x76,
-	0x61, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x2a, 0x38, 0x0a,
-	0x09, 0x44, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52, 0x49, 0x4e,
-	0x47, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05,
-	0x46, 0x4c, 0x4f, 0x41, 0x54, 0x10, 0x03, 0x32, 0x8f, 0x0b, 0x0a, 0x0e, 0x4c, 0x69, 0x63, 0x65,
-	0x6e, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xa5, 0x01, 0x0a, 0x14, 0x47,
-	0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x2f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69,
-	0x63, 0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72,
-	0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c,
-	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x76, 0x65,
-	0x72, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2f,
-	0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e,
-	0x63, 0x65, 0x12, 0xc7, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x71, 0x52, 0x69,
-	0x67, 0x68, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x37,
-	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
-	0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61,
-	0x6d, 0x2e, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x46,
-	0x6f, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x2f, 0x7b, 0x73, 0x77, 0x69, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x7d, 0x2f, 0x61, 0x63,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0xe8, 0x01, 0x0a,
-	0x23, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x71, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x46, 0x6f,
-	0x72, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x12, 0x3e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c,
-	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63,
-	0x71, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c,
-	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63,
-	0x71, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2f, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70,
-	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70,
-	0x72, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xab, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x12, 0x31, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69, 0x63,
-	0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70,
-	0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e,
-	0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
-	0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x24, 0x12, 0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x63, 0x65, 0x6e,
-	0x73, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x64, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0xc9, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63,
-	0x71, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e,
+	0x61, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x22, 0x8c, 0x01,
+	0x0a, 0x19, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73,
+	0x65, 0x4d, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73,
+	0x77, 0x69, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x77, 0x69, 0x64, 0x54, 0x61, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x13, 0xfa, 0x42, 0x10, 0x72, 0x0e, 0x32, 0x0c, 0x5c, 0x62, 0x5b, 0x41, 0x2d, 0x5a, 0x5d,
+	0x7b, 0x33, 0x7d, 0x5c, 0x62, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x86, 0x03, 0x0a,
+	0x1a, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
+	0x4d, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73,
+	0x77, 0x69, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x77, 0x69, 0x64, 0x54, 0x61, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x3c, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69, 0x63,
+	0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x62,
+	0x75, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x54, 0x6f, 0x42, 0x75, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c,
+	0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x15,
+	0x72, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x63,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x72, 0x65, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x72, 0x65,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x35,
+	0x0a, 0x04, 0x73, 0x6b, 0x75, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f,
+	0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x6b, 0x75, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x04, 0x73, 0x6b, 0x75, 0x73, 0x22, 0x80, 0x02, 0x0a, 0x0e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x63,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2d, 0x0a,
+	0x12, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x63, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x75, 0x73, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x5f, 0x74, 0x6f,
+	0x5f, 0x62, 0x75, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6c, 0x69, 0x63, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x54, 0x6f, 0x42, 0x75, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0x90, 0x02, 0x0a, 0x0d, 0x53, 0x6b, 0x75,
+	0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x53, 0x4b,
+	0x55, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x53, 0x4b, 0x55, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x76, 0x67, 0x5f, 0x75, 0x6e, 0x69, 0x74,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x76,
+	0x67, 0x55, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x73, 0x65,
+	0x64, 0x5f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x75, 0x73, 0x65, 0x64, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x75,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x54, 0x6f, 0x42, 0x75, 0x79, 0x12, 0x33, 0x0a, 0x15, 0x72, 0x65, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x72, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2a, 0x38, 0x0a, 0x09, 0x44,
+	0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x4c,
+	0x4f, 0x41, 0x54, 0x10, 0x03, 0x32, 0xaf, 0x0c, 0x0a, 0x0e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xa5, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x4f, 0x76, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x2f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69, 0x63, 0x65,
+	0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x41, 0x6c,
+	0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69, 0x63,
+	0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x41,
+	0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2f, 0x6f, 0x76,
+	0x65, 0x72, 0x61, 0x6c, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0xc7, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x71, 0x52, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x37, 0x2e, 0x6f,
+	0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e,
 	0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x63, 0x71, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x67, 0x67, 0x72, 0x65,
-	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e,
-	0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x71, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73,
-	0x46, 0x6f, 0x72, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2f,
-	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x2f, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x72, 0x69, 0x67, 0x68, 0x74,
-	0x73, 0x12, 0xc1, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x63,
-	0x65, 0x6e, 0x73, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x33,
+	0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x46, 0x6f, 0x72,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x2f, 0x7b, 0x73, 0x77, 0x69, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x7d, 0x2f, 0x61, 0x63, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0xe8, 0x01, 0x0a, 0x23, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x63, 0x71, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x12, 0x3e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69, 0x63,
+	0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x71, 0x52,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69, 0x63,
+	0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x71, 0x52,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2f, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f,
+	0x64, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xab, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x12, 0x31, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69, 0x63, 0x65, 0x6e,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69,
+	0x63, 0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12,
+	0x22, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
+	0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x12, 0xc9, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x71, 0x52,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x36, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69,
+	0x63, 0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x71,
+	0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6f, 0x70,
+	0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x71, 0x52, 0x69, 0x67, 0x68, 0x74, 0x73, 0x46, 0x6f,
+	0x72, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2f, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x72, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12,
+	0xc1, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x63, 0x65, 0x6e,
+	0x73, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x33, 0x2e, 0x6f,
+	0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
+	0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x34, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69, 0x63, 0x65,
+	0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69,
+	0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x22,
+	0x2f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
+	0x2f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x3a, 0x01, 0x2a, 0x12, 0xe1, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73,
+	0x46, 0x6f, 0x72, 0x45, 0x71, 0x75, 0x69, 0x70, 0x41, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x12, 0x34, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69, 0x63, 0x65,
+	0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x46,
+	0x6f, 0x72, 0x45, 0x71, 0x75, 0x69, 0x70, 0x41, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61,
+	0x6d, 0x2e, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x63,
+	0x65, 0x6e, 0x73, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x45, 0x71, 0x75, 0x69, 0x70, 0x41, 0x6e, 0x64,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x51, 0x22, 0x4c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x2f, 0x65, 0x71, 0x75, 0x69,
+	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x71,
+	0x75, 0x69, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x65, 0x71, 0x75, 0x69, 0x70,
+	0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x9d, 0x01, 0x0a, 0x12, 0x4f, 0x70, 0x74, 0x69,
+	0x6d, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4d, 0x69, 0x78, 0x12, 0x2d,
 	0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4c, 0x69, 0x63, 0x65, 0x6e,
-	0x73, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69,
-	0x63, 0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x34, 0x22, 0x2f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x63, 0x65, 0x6e,
-	0x73, 0x65, 0x2f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x2f, 0x7b, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0xe1, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73,
-	0x65, 0x73, 0x46, 0x6f, 0x72, 0x45, 0x71, 0x75, 0x69, 0x70, 0x41, 0x6e, 0x64, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x12, 0x34, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69,
-	0x63, 0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
-	0x73, 0x46, 0x6f, 0x72, 0x45, 0x71, 0x75, 0x69, 0x70, 0x41, 0x6e, 0x64, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6f, 0x70, 0x74, 0x69,
-	0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x45, 0x71, 0x75, 0x69, 0x70, 0x41,
-	0x6e, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x57, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x51, 0x22, 0x4c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x2f, 0x65, 0x71,
-	0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x7b,
-	0x65, 0x71, 0x75, 0x69, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x7d, 0x2f, 0x7b, 0x65, 0x71, 0x75,
-	0x69, 0x70, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x42, 0x53, 0x5a, 0x51, 0x67, 0x69, 0x74,
-	0x6c, 0x61, 0x62, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x6f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x2f,
-	0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2d,
-	0x69, 0x74, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x63, 0x65,
+	0x6e, 0x73, 0x65, 0x4d, 0x69, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2e, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x63, 0x65, 0x6e,
+	0x73, 0x65, 0x4d, 0x69, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c,
+	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x6d, 0x69, 0x78, 0x42, 0x53, 0x5a, 0x51, 0x67, 0x69, 0x74, 0x6c, 0x61,
+	0x62, 0x2e, 0x74, 0x65, 0x63, 0x68, 0x2e, 0x6f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x2f, 0x6f, 0x70,
+	0x74, 0x69, 0x73, 0x61, 0x6d, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2d, 0x69, 0x74,
+	0x2f, 0x6f, 0x70, 0x74, 0x69, 0x73, 0x61, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2610,7 +3040,7 @@ func file_license_proto_rawDescGZIP() []byte {
 }
 
 var file_license_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_license_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_license_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_license_proto_goTypes = []interface{}{
 	(DataTypes)(0),                                      // 0: optisam.license.v1.DataTypes
 	(*GetOverAllComplianceRequest)(nil),                 // 1: optisam.license.v1.GetOverAllComplianceRequest
@@ -2637,6 +3067,10 @@ var file_license_proto_goTypes = []interface{}{
 	(*ProductAcquiredRights)(nil),                       // 22: optisam.license.v1.ProductAcquiredRights
 	(*AggregationAcquiredRights)(nil),                   // 23: optisam.license.v1.AggregationAcquiredRights
 	(*Attribute)(nil),                                   // 24: optisam.license.v1.Attribute
+	(*OptimizeLicenseMixRequest)(nil),                   // 25: optisam.license.v1.OptimizeLicenseMixRequest
+	(*OptimizeLicenseMixResponse)(nil),                  // 26: optisam.license.v1.OptimizeLicenseMixResponse
+	(*MetricCoverage)(nil),                              // 27: optisam.license.v1.MetricCoverage
+	(*SkuAllocation)(nil),                               // 28: optisam.license.v1.SkuAllocation
 }
 var file_license_proto_depIdxs = []int32{
 	23, // 0: optisam.license.v1.GetOverAllComplianceResponse.acq_rights:type_name -> optisam.license.v1.AggregationAcquiredRights
@@ -2648,25 +3082,29 @@ var file_license_proto_depIdxs = []int32{
 	17, // 6: optisam.license.v1.ListMetricResponse.metrices:type_name -> optisam.license.v1.Metric
 	22, // 7: optisam.license.v1.ListAcquiredRightsForProductResponse.acq_rights:type_name -> optisam.license.v1.ProductAcquiredRights
 	0,  // 8: optisam.license.v1.Attribute.data_type:type_name -> optisam.license.v1.DataTypes
-	1,  // 9: optisam.license.v1.LicenseService.GetOverAllCompliance:input_type -> optisam.license.v1.GetOverAllComplianceRequest
-	18, // 10: optisam.license.v1.LicenseService.ListAcqRightsForProduct:input_type -> optisam.license.v1.ListAcquiredRightsForProductRequest
-	6,  // 11: optisam.license.v1.LicenseService.ListAcqRightsForApplicationsProduct:input_type -> optisam.license.v1.ListAcqRightsForApplicationsProductRequest
-	3,  // 12: optisam.license.v1.LicenseService.ListComputationDetails:input_type -> optisam.license.v1.ListComputationDetailsRequest
-	13, // 13: optisam.license.v1.LicenseService.ListAcqRightsForAggregation:input_type -> optisam.license.v1.ListAcqRightsForAggregationRequest
-	11, // 14: optisam.license.v1.LicenseService.ProductLicensesForMetric:input_type -> optisam.license.v1.ProductLicensesForMetricRequest
-	8,  // 15: optisam.license.v1.LicenseService.LicensesForEquipAndMetric:input_type -> optisam.license.v1.LicensesForEquipAndMetricRequest
-	2,  // 16: optisam.license.v1.LicenseService.GetOverAllCompliance:output_type -> optisam.license.v1.GetOverAllComplianceResponse
-	19, // 17: optisam.license.v1.LicenseService.ListAcqRightsForProduct:output_type -> optisam.license.v1.ListAcquiredRightsForProductResponse
-	7,  // 18: optisam.license.v1.LicenseService.ListAcqRightsForApplicationsProduct:output_type -> optisam.license.v1.ListAcqRightsForApplicationsProductResponse
-	4,  // 19: optisam.license.v1.LicenseService.ListComputationDetails:output_type -> optisam.license.v1.ListComputationDetailsResponse
-	14, // 20: optisam.license.v1.LicenseService.ListAcqRightsForAggregation:output_type -> optisam.license.v1.ListAcqRightsForAggregationResponse
-	12, // 21: optisam.license.v1.LicenseService.ProductLicensesForMetric:output_type -> optisam.license.v1.ProductLicensesForMetricResponse
-	9,  // 22: optisam.license.v1.LicenseService.LicensesForEquipAndMetric:output_type -> optisam.license.v1.LicensesForEquipAndMetricResponse
-	16, // [16:23] is the sub-list for method output_type
-	9,  // [9:16] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	27, // 9: optisam.license.v1.OptimizeLicenseMixResponse.metrics:type_name -> optisam.license.v1.MetricCoverage
+	28, // 10: optisam.license.v1.OptimizeLicenseMixResponse.skus:type_name -> optisam.license.v1.SkuAllocation
+	1,  // 11: optisam.license.v1.LicenseService.GetOverAllCompliance:input_type -> optisam.license.v1.GetOverAllComplianceRequest
+	18, // 12: optisam.license.v1.LicenseService.ListAcqRightsForProduct:input_type -> optisam.license.v1.ListAcquiredRightsForProductRequest
+	6,  // 13: optisam.license.v1.LicenseService.ListAcqRightsForApplicationsProduct:input_type -> optisam.license.v1.ListAcqRightsForApplicationsProductRequest
+	3,  // 14: optisam.license.v1.LicenseService.ListComputationDetails:input_type -> optisam.license.v1.ListComputationDetailsRequest
+	13, // 15: optisam.license.v1.LicenseService.ListAcqRightsForAggregation:input_type -> optisam.license.v1.ListAcqRightsForAggregationRequest
+	11, // 16: optisam.license.v1.LicenseService.ProductLicensesForMetric:input_type -> optisam.license.v1.ProductLicensesForMetricRequest
+	8,  // 17: optisam.license.v1.LicenseService.LicensesForEquipAndMetric:input_type -> optisam.license.v1.LicensesForEquipAndMetricRequest
+	25, // 18: optisam.license.v1.LicenseService.OptimizeLicenseMix:input_type -> optisam.license.v1.OptimizeLicenseMixRequest
+	2,  // 19: optisam.license.v1.LicenseService.GetOverAllCompliance:output_type -> optisam.license.v1.GetOverAllComplianceResponse
+	19, // 20: optisam.license.v1.LicenseService.ListAcqRightsForProduct:output_type -> optisam.license.v1.ListAcquiredRightsForProductResponse
+	7,  // 21: optisam.license.v1.LicenseService.ListAcqRightsForApplicationsProduct:output_type -> optisam.license.v1.ListAcqRightsForApplicationsProductResponse
+	4,  // 22: optisam.license.v1.LicenseService.ListComputationDetails:output_type -> optisam.license.v1.ListComputationDetailsResponse
+	14, // 23: optisam.license.v1.LicenseService.ListAcqRightsForAggregation:output_type -> optisam.license.v1.ListAcqRightsForAggregationResponse
+	12, // 24: optisam.license.v1.LicenseService.ProductLicensesForMetric:output_type -> optisam.license.v1.ProductLicensesForMetricResponse
+	9,  // 25: optisam.license.v1.LicenseService.LicensesForEquipAndMetric:output_type -> optisam.license.v1.LicensesForEquipAndMetricResponse
+	26, // 26: optisam.license.v1.LicenseService.OptimizeLicenseMix:output_type -> optisam.license.v1.OptimizeLicenseMixResponse
+	19, // [19:27] is the sub-list for method output_type
+	11, // [11:19] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_license_proto_init() }
@@ -2963,6 +3401,54 @@ func file_license_proto_init() {
 				return nil
 			}
 		}
+		file_license_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptimizeLicenseMixRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_license_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptimizeLicenseMixResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_license_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricCoverage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_license_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SkuAllocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_license_proto_msgTypes[23].OneofWrappers = []interface{}{
 		(*Attribute_IntVal)(nil),
@@ -2978,7 +3464,7 @@ func file_license_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_license_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   24,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
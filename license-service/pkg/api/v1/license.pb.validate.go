@@ -2147,3 +2147,352 @@ var _Attribute_DataType_InLookup = map[DataTypes]struct{}{
 	2: {},
 	3: {},
 }
+
+// Validate checks the field values on OptimizeLicenseMixRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *OptimizeLicenseMixRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for SwidTag
+
+	// no validation rules for AggregationName
+
+	if !_OptimizeLicenseMixRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return OptimizeLicenseMixRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	return nil
+}
+
+// OptimizeLicenseMixRequestValidationError is the validation error returned by
+// OptimizeLicenseMixRequest.Validate if the designated constraints aren't met.
+type OptimizeLicenseMixRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OptimizeLicenseMixRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OptimizeLicenseMixRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OptimizeLicenseMixRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OptimizeLicenseMixRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OptimizeLicenseMixRequestValidationError) ErrorName() string {
+	return "OptimizeLicenseMixRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OptimizeLicenseMixRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOptimizeLicenseMixRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OptimizeLicenseMixRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OptimizeLicenseMixRequestValidationError{}
+
+var _OptimizeLicenseMixRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on OptimizeLicenseMixResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *OptimizeLicenseMixResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for SwidTag
+
+	// no validation rules for AggregationName
+
+	for idx, item := range m.GetMetrics() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return OptimizeLicenseMixResponseValidationError{
+					field:  fmt.Sprintf("Metrics[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for LicencesToBuy
+
+	// no validation rules for PurchaseCost
+
+	// no validation rules for ReassignableLicences
+
+	// no validation rules for ReassignableCost
+
+	for idx, item := range m.GetSkus() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return OptimizeLicenseMixResponseValidationError{
+					field:  fmt.Sprintf("Skus[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// OptimizeLicenseMixResponseValidationError is the validation error returned
+// by OptimizeLicenseMixResponse.Validate if the designated constraints aren't met.
+type OptimizeLicenseMixResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OptimizeLicenseMixResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OptimizeLicenseMixResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OptimizeLicenseMixResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OptimizeLicenseMixResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OptimizeLicenseMixResponseValidationError) ErrorName() string {
+	return "OptimizeLicenseMixResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OptimizeLicenseMixResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOptimizeLicenseMixResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OptimizeLicenseMixResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OptimizeLicenseMixResponseValidationError{}
+
+// Validate checks the field values on MetricCoverage with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *MetricCoverage) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Metric
+
+	// no validation rules for ComputedLicences
+
+	// no validation rules for AvailableLicences
+
+	// no validation rules for UsedLicences
+
+	// no validation rules for LicencesToBuy
+
+	// no validation rules for CoveredPercentage
+
+	return nil
+}
+
+// MetricCoverageValidationError is the validation error returned by
+// MetricCoverage.Validate if the designated constraints aren't met.
+type MetricCoverageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricCoverageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricCoverageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricCoverageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricCoverageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricCoverageValidationError) ErrorName() string { return "MetricCoverageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetricCoverageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricCoverage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricCoverageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricCoverageValidationError{}
+
+// Validate checks the field values on SkuAllocation with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *SkuAllocation) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for SKU
+
+	// no validation rules for Metric
+
+	// no validation rules for AvgUnitPrice
+
+	// no validation rules for AvailableLicences
+
+	// no validation rules for UsedLicences
+
+	// no validation rules for LicencesToBuy
+
+	// no validation rules for ReassignableLicences
+
+	return nil
+}
+
+// SkuAllocationValidationError is the validation error returned by
+// SkuAllocation.Validate if the designated constraints aren't met.
+type SkuAllocationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SkuAllocationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SkuAllocationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SkuAllocationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SkuAllocationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SkuAllocationValidationError) ErrorName() string { return "SkuAllocationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SkuAllocationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSkuAllocation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SkuAllocationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SkuAllocationValidationError{}
@@ -25,6 +25,8 @@ type LicenseServiceClient interface {
 	ListAcqRightsForAggregation(ctx context.Context, in *ListAcqRightsForAggregationRequest, opts ...grpc.CallOption) (*ListAcqRightsForAggregationResponse, error)
 	ProductLicensesForMetric(ctx context.Context, in *ProductLicensesForMetricRequest, opts ...grpc.CallOption) (*ProductLicensesForMetricResponse, error)
 	LicensesForEquipAndMetric(ctx context.Context, in *LicensesForEquipAndMetricRequest, opts ...grpc.CallOption) (*LicensesForEquipAndMetricResponse, error)
+	// OptimizeLicenseMix finds the lowest cost mix of the acquired rights of all the metrics of a product or an aggregation covering its usage
+	OptimizeLicenseMix(ctx context.Context, in *OptimizeLicenseMixRequest, opts ...grpc.CallOption) (*OptimizeLicenseMixResponse, error)
 }
 
 type licenseServiceClient struct {
@@ -98,6 +100,15 @@ func (c *licenseServiceClient) LicensesForEquipAndMetric(ctx context.Context, in
 	return out, nil
 }
 
+func (c *licenseServiceClient) OptimizeLicenseMix(ctx context.Context, in *OptimizeLicenseMixRequest, opts ...grpc.CallOption) (*OptimizeLicenseMixResponse, error) {
+	out := new(OptimizeLicenseMixResponse)
+	err := c.cc.Invoke(ctx, "/optisam.license.v1.LicenseService/OptimizeLicenseMix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LicenseServiceServer is the server API for LicenseService service.
 // All implementations should embed UnimplementedLicenseServiceServer
 // for forward compatibility
@@ -110,6 +121,8 @@ type LicenseServiceServer interface {
 	ListAcqRightsForAggregation(context.Context, *ListAcqRightsForAggregationRequest) (*ListAcqRightsForAggregationResponse, error)
 	ProductLicensesForMetric(context.Context, *ProductLicensesForMetricRequest) (*ProductLicensesForMetricResponse, error)
 	LicensesForEquipAndMetric(context.Context, *LicensesForEquipAndMetricRequest) (*LicensesForEquipAndMetricResponse, error)
+	// OptimizeLicenseMix finds the lowest cost mix of the acquired rights of all the metrics of a product or an aggregation covering its usage
+	OptimizeLicenseMix(context.Context, *OptimizeLicenseMixRequest) (*OptimizeLicenseMixResponse, error)
 }
 
 // UnimplementedLicenseServiceServer should be embedded to have forward compatible implementations.
@@ -137,6 +150,9 @@ func (UnimplementedLicenseServiceServer) ProductLicensesForMetric(context.Contex
 func (UnimplementedLicenseServiceServer) LicensesForEquipAndMetric(context.Context, *LicensesForEquipAndMetricRequest) (*LicensesForEquipAndMetricResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LicensesForEquipAndMetric not implemented")
 }
+func (UnimplementedLicenseServiceServer) OptimizeLicenseMix(context.Context, *OptimizeLicenseMixRequest) (*OptimizeLicenseMixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptimizeLicenseMix not implemented")
+}
 
 // UnsafeLicenseServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to LicenseServiceServer will
@@ -275,6 +291,24 @@ func _LicenseService_LicensesForEquipAndMetric_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LicenseService_OptimizeLicenseMix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptimizeLicenseMixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LicenseServiceServer).OptimizeLicenseMix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.license.v1.LicenseService/OptimizeLicenseMix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LicenseServiceServer).OptimizeLicenseMix(ctx, req.(*OptimizeLicenseMixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _LicenseService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "optisam.license.v1.LicenseService",
 	HandlerType: (*LicenseServiceServer)(nil),
@@ -307,6 +341,10 @@ var _LicenseService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LicensesForEquipAndMetric",
 			Handler:    _LicenseService_LicensesForEquipAndMetric_Handler,
 		},
+		{
+			MethodName: "OptimizeLicenseMix",
+			Handler:    _LicenseService_OptimizeLicenseMix_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "license.proto",
@@ -156,6 +156,26 @@ func (mr *MockLicenseServiceClientMockRecorder) ListComputationDetails(ctx, in i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComputationDetails", reflect.TypeOf((*MockLicenseServiceClient)(nil).ListComputationDetails), varargs...)
 }
 
+// OptimizeLicenseMix mocks base method.
+func (m *MockLicenseServiceClient) OptimizeLicenseMix(ctx context.Context, in *v1.OptimizeLicenseMixRequest, opts ...grpc.CallOption) (*v1.OptimizeLicenseMixResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "OptimizeLicenseMix", varargs...)
+	ret0, _ := ret[0].(*v1.OptimizeLicenseMixResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OptimizeLicenseMix indicates an expected call of OptimizeLicenseMix.
+func (mr *MockLicenseServiceClientMockRecorder) OptimizeLicenseMix(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OptimizeLicenseMix", reflect.TypeOf((*MockLicenseServiceClient)(nil).OptimizeLicenseMix), varargs...)
+}
+
 // ProductLicensesForMetric mocks base method.
 func (m *MockLicenseServiceClient) ProductLicensesForMetric(ctx context.Context, in *v1.ProductLicensesForMetricRequest, opts ...grpc.CallOption) (*v1.ProductLicensesForMetricResponse, error) {
 	m.ctrl.T.Helper()
@@ -289,6 +309,21 @@ func (mr *MockLicenseServiceServerMockRecorder) ListComputationDetails(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComputationDetails", reflect.TypeOf((*MockLicenseServiceServer)(nil).ListComputationDetails), arg0, arg1)
 }
 
+// OptimizeLicenseMix mocks base method.
+func (m *MockLicenseServiceServer) OptimizeLicenseMix(arg0 context.Context, arg1 *v1.OptimizeLicenseMixRequest) (*v1.OptimizeLicenseMixResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OptimizeLicenseMix", arg0, arg1)
+	ret0, _ := ret[0].(*v1.OptimizeLicenseMixResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OptimizeLicenseMix indicates an expected call of OptimizeLicenseMix.
+func (mr *MockLicenseServiceServerMockRecorder) OptimizeLicenseMix(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OptimizeLicenseMix", reflect.TypeOf((*MockLicenseServiceServer)(nil).OptimizeLicenseMix), arg0, arg1)
+}
+
 // ProductLicensesForMetric mocks base method.
 func (m *MockLicenseServiceServer) ProductLicensesForMetric(arg0 context.Context, arg1 *v1.ProductLicensesForMetricRequest) (*v1.ProductLicensesForMetricResponse, error) {
 	m.ctrl.T.Helper()
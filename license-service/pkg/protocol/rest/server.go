@@ -13,6 +13,8 @@ import (
 
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
 	rest_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/rest"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/revocation"
 
 	"google.golang.org/protobuf/encoding/protojson"
 
@@ -25,7 +27,7 @@ import (
 
 // RunServer runs HTTP/REST gateway
 // nolint: funlen, gocyclo, gosec
-func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.PublicKey) error {
+func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.PublicKey, revoked revocation.List, keys apikey.Store) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -42,10 +44,7 @@ func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.Pu
 		Addr: ":" + httpPort,
 		// Handler: &ochttp.Handler{
 		Handler: &ochttp.Handler{Handler: rest_middleware.AddCORS([]string{"*"},
-			// rest_middleware.ValidateAuth(verifyKey,
-			// 	rest_middleware.AddLogger(logger.Log,
-			muxHTTP),
-		// ))},
+			rest_middleware.ValidateAuthWithAPIKeys(verifyKey, revoked, keys, muxHTTP)),
 		},
 	}
 
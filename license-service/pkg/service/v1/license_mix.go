@@ -0,0 +1,198 @@
+package v1
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"sort"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/license-service/pkg/api/v1"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/helper"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mixRight is an acquired right of the product or the aggregation with the licences computed for its metric
+type mixRight struct {
+	sku          string
+	metric       string
+	computed     int32
+	available    int32
+	avgUnitPrice float64
+}
+
+// OptimizeLicenseMix implements license service OptimizeLicenseMix function
+func (s *licenseServiceServer) OptimizeLicenseMix(ctx context.Context, req *v1.OptimizeLicenseMixRequest) (*v1.OptimizeLicenseMixResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "cannot find claims in context")
+	}
+	if !helper.Contains(userClaims.Socpes, req.GetScope()) {
+		logger.Log.Error("service/v1 - OptimizeLicenseMix", zap.String("reason", "ScopeError"))
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	if (req.SwidTag == "") == (req.AggregationName == "") {
+		return nil, status.Error(codes.InvalidArgument, "either swidtag or aggregation name is required")
+	}
+	resp := &v1.OptimizeLicenseMixResponse{
+		SwidTag:         req.SwidTag,
+		AggregationName: req.AggregationName,
+	}
+	var rights []mixRight
+	if req.SwidTag != "" {
+		prodResp, err := s.ListAcqRightsForProduct(ctx, &v1.ListAcquiredRightsForProductRequest{
+			SwidTag: req.SwidTag,
+			Scope:   req.Scope,
+		})
+		if err != nil {
+			logger.Log.Error("service/v1 - OptimizeLicenseMix - ListAcqRightsForProduct", zap.Error(err), zap.String("swidtag", req.SwidTag))
+			return nil, err
+		}
+		for _, r := range prodResp.AcqRights {
+			rights = append(rights, mixRight{
+				sku:          r.SKU,
+				metric:       r.Metric,
+				computed:     r.NumCptLicences,
+				available:    r.AvailableLicences,
+				avgUnitPrice: r.AvgUnitPrice,
+			})
+		}
+		// licences of a product bought in an aggregation are computed for the aggregation
+		resp.AggregationName = prodResp.AggregationName
+	}
+	if resp.AggregationName != "" {
+		aggResp, err := s.ListAcqRightsForAggregation(ctx, &v1.ListAcqRightsForAggregationRequest{
+			Name:  resp.AggregationName,
+			Scope: req.Scope,
+		})
+		if err != nil {
+			logger.Log.Error("service/v1 - OptimizeLicenseMix - ListAcqRightsForAggregation", zap.Error(err), zap.String("aggregation", resp.AggregationName))
+			return nil, err
+		}
+		for _, r := range aggResp.AcqRights {
+			rights = append(rights, mixRight{
+				sku:          r.SKU,
+				metric:       r.Metric,
+				computed:     r.NumCptLicences,
+				available:    r.AvailableLicences,
+				avgUnitPrice: r.AvgUnitPrice,
+			})
+		}
+	}
+	licenseMix(resp, rights)
+	return resp, nil
+}
+
+// licenseMix covers the usage of the product with the acquired rights of all its metrics at the lowest cost. A licence of a
+// metric covers the share of the usage given by one over the licences computed for the whole usage with this metric, so
+// every licence is compared on the price of covering the whole usage with its metric.
+//  1. The available licences are already paid so they are used first, the ones with the lowest price for the whole usage
+//     first to keep the most valuable ones free to be reassigned.
+//  2. The uncovered usage is bought from the acquired right with the lowest price for the whole usage.
+//
+// Metrics without computed licences cannot cover the usage, every licence not used by the mix can be reassigned.
+func licenseMix(resp *v1.OptimizeLicenseMixResponse, rights []mixRight) {
+	computed := map[string]int32{}
+	for _, r := range rights {
+		if _, ok := computed[r.metric]; !ok {
+			resp.Metrics = append(resp.Metrics, &v1.MetricCoverage{Metric: r.metric})
+		}
+		if r.computed >= computed[r.metric] {
+			computed[r.metric] = r.computed
+		}
+	}
+	var order []int
+	for i, r := range rights {
+		if computed[r.metric] > 0 {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return rights[order[i]].avgUnitPrice*float64(computed[rights[order[i]].metric]) <
+			rights[order[j]].avgUnitPrice*float64(computed[rights[order[j]].metric])
+	})
+	used := make([]int32, len(rights))
+	bought := make([]int32, len(rights))
+	covered := map[string]*big.Rat{}
+	// uncovered share of the usage, there is nothing to cover when no metric computes licences
+	uncovered := new(big.Rat)
+	if len(order) > 0 {
+		uncovered.SetInt64(1)
+	}
+	cover := func(i int, available int32) int32 {
+		c := computed[rights[i].metric]
+		units := ceilRat(new(big.Rat).Mul(uncovered, big.NewRat(int64(c), 1)))
+		if units > int64(available) {
+			units = int64(available)
+		}
+		share := big.NewRat(units, int64(c))
+		if share.Cmp(uncovered) > 0 {
+			share.Set(uncovered)
+		}
+		if covered[rights[i].metric] == nil {
+			covered[rights[i].metric] = new(big.Rat)
+		}
+		covered[rights[i].metric].Add(covered[rights[i].metric], share)
+		uncovered.Sub(uncovered, share)
+		return int32(units)
+	}
+	for _, i := range order {
+		if uncovered.Sign() <= 0 {
+			break
+		}
+		if rights[i].available > 0 {
+			used[i] = cover(i, rights[i].available)
+		}
+	}
+	if uncovered.Sign() > 0 {
+		i := order[0]
+		bought[i] = cover(i, math.MaxInt32)
+		used[i] += bought[i]
+	}
+	for i, r := range rights {
+		alloc := &v1.SkuAllocation{
+			SKU:               r.sku,
+			Metric:            r.metric,
+			AvgUnitPrice:      r.avgUnitPrice,
+			AvailableLicences: r.available,
+			UsedLicences:      used[i],
+			LicencesToBuy:     bought[i],
+		}
+		alloc.ReassignableLicences = alloc.AvailableLicences - alloc.UsedLicences + alloc.LicencesToBuy
+		resp.LicencesToBuy += alloc.LicencesToBuy
+		resp.PurchaseCost += float64(alloc.LicencesToBuy) * r.avgUnitPrice
+		resp.ReassignableLicences += alloc.ReassignableLicences
+		resp.ReassignableCost += float64(alloc.ReassignableLicences) * r.avgUnitPrice
+		resp.Skus = append(resp.Skus, alloc)
+		for _, m := range resp.Metrics {
+			if m.Metric == r.metric {
+				m.AvailableLicences += alloc.AvailableLicences
+				m.UsedLicences += alloc.UsedLicences
+				m.LicencesToBuy += alloc.LicencesToBuy
+			}
+		}
+	}
+	for _, m := range resp.Metrics {
+		m.ComputedLicences = computed[m.Metric]
+		if share, ok := covered[m.Metric]; ok {
+			pct, _ := new(big.Rat).Mul(share, big.NewRat(100, 1)).Float64()
+			m.CoveredPercentage = helper.ToFixed(pct, 2)
+		}
+	}
+	resp.PurchaseCost = helper.ToFixed(resp.PurchaseCost, 2)
+	resp.ReassignableCost = helper.ToFixed(resp.ReassignableCost, 2)
+}
+
+// ceilRat returns the smallest integer greater than or equal to the positive r
+func ceilRat(r *big.Rat) int64 {
+	q, m := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if m.Sign() > 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	return q.Int64()
+}
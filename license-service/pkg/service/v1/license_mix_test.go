@@ -0,0 +1,203 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/license-service/pkg/api/v1"
+	repo "gitlab.tech.orange/optisam/optisam-it/optisam-services/license-service/pkg/repository/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/license-service/pkg/repository/v1/mock"
+	prov1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/license-service/thirdparty/product-service/pkg/api/v1"
+	mockpro "gitlab.tech.orange/optisam/optisam-it/optisam-services/license-service/thirdparty/product-service/pkg/api/v1/mock"
+
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_licenseServiceServer_OptimizeLicenseMix(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"Scope1", "Scope2", "Scope3"},
+	})
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		req      *v1.OptimizeLicenseMixRequest
+		wantCode codes.Code
+	}{
+		{
+			name:     "FAILURE - cannot find claims in context",
+			ctx:      context.Background(),
+			req:      &v1.OptimizeLicenseMixRequest{SwidTag: "swidTag1", Scope: "Scope1"},
+			wantCode: codes.Internal,
+		},
+		{
+			name:     "FAILURE - ScopeValidationError",
+			ctx:      ctx,
+			req:      &v1.OptimizeLicenseMixRequest{SwidTag: "swidTag1", Scope: "Scope4"},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "FAILURE - neither swidtag nor aggregation",
+			ctx:      ctx,
+			req:      &v1.OptimizeLicenseMixRequest{Scope: "Scope1"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "FAILURE - both swidtag and aggregation",
+			ctx:      ctx,
+			req:      &v1.OptimizeLicenseMixRequest{SwidTag: "swidTag1", AggregationName: "agg1", Scope: "Scope1"},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &licenseServiceServer{}
+			_, err := s.OptimizeLicenseMix(tt.ctx, tt.req)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func Test_licenseServiceServer_OptimizeLicenseMix_aggregation(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"Scope1"},
+	})
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLicense := mock.NewMockLicense(mockCtrl)
+	mockProdClient := mockpro.NewMockProductServiceClient(mockCtrl)
+	metrics := []*repo.Metric{
+		{Name: "INS", Type: repo.MetricInstanceNumberStandard},
+		{Name: "SS", Type: repo.MetricStaticStandard},
+	}
+	mockLicense.EXPECT().ListMetrices(ctx, "Scope1").Return(metrics, nil)
+	mockLicense.EXPECT().AggregationDetails(ctx, "agg1", metrics, false, "Scope1").Return(&repo.AggregationInfo{
+		ID:              1,
+		Name:            "agg1",
+		ProductNames:    []string{"p1"},
+		Swidtags:        []string{"swid1"},
+		ProductIDs:      []string{"PR1"},
+		NumOfEquipments: 4,
+	}, []*repo.ProductAcquiredRight{
+		{SKU: "ins1", Metric: "INS", AcqLicenses: 6, AvgUnitPrice: 100},
+		{SKU: "ss1", Metric: "SS", AcqLicenses: 1, AvgUnitPrice: 300},
+	}, nil)
+	mockLicense.EXPECT().EquipmentTypes(ctx, "Scope1").Return([]*repo.EquipmentType{}, nil)
+	mockProdClient.EXPECT().GetMetric(ctx, &prov1.GetMetricRequest{Sku: "ins1", Scope: "Scope1"}).Return(&prov1.GetMetricResponse{Metric: "INS"}, nil)
+	mockProdClient.EXPECT().GetMetric(ctx, &prov1.GetMetricRequest{Sku: "ss1", Scope: "Scope1"}).Return(&prov1.GetMetricResponse{Metric: "SS"}, nil)
+	mockProdClient.EXPECT().GetAvailableLicenses(ctx, &prov1.GetAvailableLicensesRequest{Sku: "ins1", Scope: "Scope1"}).Return(&prov1.GetAvailableLicensesResponse{AvailableLicenses: 6}, nil)
+	mockProdClient.EXPECT().GetAvailableLicenses(ctx, &prov1.GetAvailableLicensesRequest{Sku: "ss1", Scope: "Scope1"}).Return(&prov1.GetAvailableLicensesResponse{AvailableLicenses: 1}, nil)
+	mockLicense.EXPECT().ListMetricINM(ctx, "Scope1").Return([]*repo.MetricINM{{Name: "INS", Coefficient: 1}}, nil)
+	mockLicense.EXPECT().MetricINMComputedLicensesAgg(ctx, "agg1", "INS", &repo.MetricINMComputed{Name: "INS", Coefficient: 1}, "Scope1").Return(uint64(10), uint64(10), nil)
+	mockLicense.EXPECT().ListMetricSS(ctx, "Scope1").Return([]*repo.MetricSS{{Name: "SS", ReferenceValue: 4}}, nil)
+	s := &licenseServiceServer{
+		licenseRepo:   mockLicense,
+		productClient: mockProdClient,
+	}
+
+	got, err := s.OptimizeLicenseMix(ctx, &v1.OptimizeLicenseMixRequest{AggregationName: "agg1", Scope: "Scope1"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	// the 4 missing instances are covered by the licence left on SS and 2 bought on INS,
+	// instead of 4 licences bought on INS or 3 on SS
+	assert.Equal(t, &v1.OptimizeLicenseMixResponse{
+		AggregationName: "agg1",
+		Metrics: []*v1.MetricCoverage{
+			{Metric: "INS", ComputedLicences: 10, AvailableLicences: 6, UsedLicences: 8, LicencesToBuy: 2, CoveredPercentage: 75},
+			{Metric: "SS", ComputedLicences: 4, AvailableLicences: 1, UsedLicences: 1, CoveredPercentage: 25},
+		},
+		LicencesToBuy: 2,
+		PurchaseCost:  200,
+		Skus: []*v1.SkuAllocation{
+			{SKU: "ins1", Metric: "INS", AvgUnitPrice: 100, AvailableLicences: 6, UsedLicences: 8, LicencesToBuy: 2},
+			{SKU: "ss1", Metric: "SS", AvgUnitPrice: 300, AvailableLicences: 1, UsedLicences: 1},
+		},
+	}, got)
+}
+
+func Test_licenseMix(t *testing.T) {
+	tests := []struct {
+		name   string
+		rights []mixRight
+		want   *v1.OptimizeLicenseMixResponse
+	}{
+		{
+			name: "available licences of both metrics cover the usage",
+			rights: []mixRight{
+				{sku: "sku1", metric: "ops", computed: 10, available: 6, avgUnitPrice: 100},
+				{sku: "sku2", metric: "ops", computed: 10, available: 2, avgUnitPrice: 80},
+				{sku: "sku3", metric: "nup", computed: 50, available: 60, avgUnitPrice: 20},
+			},
+			// covering everything with nup would keep only 960 of licences to reassign
+			want: &v1.OptimizeLicenseMixResponse{
+				Metrics: []*v1.MetricCoverage{
+					{Metric: "ops", ComputedLicences: 10, AvailableLicences: 8, UsedLicences: 8, CoveredPercentage: 80},
+					{Metric: "nup", ComputedLicences: 50, AvailableLicences: 60, UsedLicences: 10, CoveredPercentage: 20},
+				},
+				ReassignableLicences: 50,
+				ReassignableCost:     1000,
+				Skus: []*v1.SkuAllocation{
+					{SKU: "sku1", Metric: "ops", AvgUnitPrice: 100, AvailableLicences: 6, UsedLicences: 6},
+					{SKU: "sku2", Metric: "ops", AvgUnitPrice: 80, AvailableLicences: 2, UsedLicences: 2},
+					{SKU: "sku3", Metric: "nup", AvgUnitPrice: 20, AvailableLicences: 60, UsedLicences: 10, ReassignableLicences: 50},
+				},
+			},
+		},
+		{
+			name: "missing usage is bought on the cheapest metric",
+			rights: []mixRight{
+				{sku: "sku1", metric: "ops", computed: 10, available: 2, avgUnitPrice: 100},
+				{sku: "sku2", metric: "nup", computed: 50, available: 0, avgUnitPrice: 15},
+			},
+			want: &v1.OptimizeLicenseMixResponse{
+				Metrics: []*v1.MetricCoverage{
+					{Metric: "ops", ComputedLicences: 10, AvailableLicences: 2, UsedLicences: 2, CoveredPercentage: 20},
+					{Metric: "nup", ComputedLicences: 50, UsedLicences: 40, LicencesToBuy: 40, CoveredPercentage: 80},
+				},
+				LicencesToBuy: 40,
+				PurchaseCost:  600,
+				Skus: []*v1.SkuAllocation{
+					{SKU: "sku1", Metric: "ops", AvgUnitPrice: 100, AvailableLicences: 2, UsedLicences: 2},
+					{SKU: "sku2", Metric: "nup", AvgUnitPrice: 15, UsedLicences: 40, LicencesToBuy: 40},
+				},
+			},
+		},
+		{
+			name: "nothing to cover",
+			rights: []mixRight{
+				{sku: "sku1", metric: "ops", available: 3, avgUnitPrice: 100},
+			},
+			want: &v1.OptimizeLicenseMixResponse{
+				Metrics: []*v1.MetricCoverage{
+					{Metric: "ops", AvailableLicences: 3},
+				},
+				ReassignableLicences: 3,
+				ReassignableCost:     300,
+				Skus: []*v1.SkuAllocation{
+					{SKU: "sku1", Metric: "ops", AvgUnitPrice: 100, AvailableLicences: 3, ReassignableLicences: 3},
+				},
+			},
+		},
+		{
+			name: "no acquired rights",
+			want: &v1.OptimizeLicenseMixResponse{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &v1.OptimizeLicenseMixResponse{}
+			licenseMix(got, tt.rights)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 
@@ -45,6 +46,14 @@ func (n *NotificationServiceServer) SendMail(ctx context.Context, req *v1.SendMa
 	m.SetHeader("Subject", req.MailSubject)
 	// Set E-Mail body. You can set plain text or html with text/html
 	m.SetBody("text/html", req.GetMailMessage())
+	// Attach the files sent along, like generated reports
+	for _, a := range req.GetAttachments() {
+		if a.GetContentType() == "" {
+			m.AttachReader(a.GetName(), bytes.NewReader(a.GetContent()))
+			continue
+		}
+		m.AttachReader(a.GetName(), bytes.NewReader(a.GetContent()), gomail.SetHeader(map[string][]string{"Content-Type": {a.GetContentType()}}))
+	}
 
 	// Settings for SMTP server
 	d := gomail.NewDialer(n.Config.SMTP.Host, int(n.Config.SMTP.Port), n.Config.SMTP.From, n.Config.SMTP.Password)
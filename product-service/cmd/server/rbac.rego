@@ -2,9 +2,16 @@ package rbac
 
 default allow = false
 
-# Allow admins to do anything.
+# Allow admins to do anything but administering the worker queue.
 allow {
 	roles["Admin"][input.role]
+	not startswith(input.api, "/optisam.workerqueue.v1.WorkerQueueAdmin/")
+}
+
+# Only super admins administer the worker queue, its jobs belong to every scope.
+allow {
+	startswith(input.api, "/optisam.workerqueue.v1.WorkerQueueAdmin/")
+	input.role = "SuperAdmin"
 }
 
 # Normal Users
@@ -63,4 +70,6 @@ user_apis := {"/optisam.products.v1.ProductService/ListProducts",
 "/optisam.products.v1.ProductService/ProductsPercOpenClosedSource",
 "/optisam.products.v1.ProductService/GetWasteUpLicences",
 "/optisam.products.v1.ProductService/GetTrueUpLicences",
+"/optisam.products.v1.ProductService/ListComplianceSnapshots",
+"/optisam.products.v1.ProductService/DiffComplianceSnapshots",
 }
\ No newline at end of file
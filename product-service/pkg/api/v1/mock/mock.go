@@ -1595,6 +1595,66 @@ func (mr *MockProductServiceClientMockRecorder) GetProductInformationBySwidTag(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductInformationBySwidTag", reflect.TypeOf((*MockProductServiceClient)(nil).GetProductInformationBySwidTag), varargs...)
 }
 
+// CreateComplianceSnapshot mocks base method
+func (m *MockProductServiceClient) CreateComplianceSnapshot(ctx context.Context, in *v1.CreateComplianceSnapshotRequest, opts ...grpc.CallOption) (*v1.ComplianceSnapshot, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateComplianceSnapshot", varargs...)
+	ret0, _ := ret[0].(*v1.ComplianceSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateComplianceSnapshot indicates an expected call of CreateComplianceSnapshot
+func (mr *MockProductServiceClientMockRecorder) CreateComplianceSnapshot(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComplianceSnapshot", reflect.TypeOf((*MockProductServiceClient)(nil).CreateComplianceSnapshot), varargs...)
+}
+
+// ListComplianceSnapshots mocks base method
+func (m *MockProductServiceClient) ListComplianceSnapshots(ctx context.Context, in *v1.ListComplianceSnapshotsRequest, opts ...grpc.CallOption) (*v1.ListComplianceSnapshotsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListComplianceSnapshots", varargs...)
+	ret0, _ := ret[0].(*v1.ListComplianceSnapshotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComplianceSnapshots indicates an expected call of ListComplianceSnapshots
+func (mr *MockProductServiceClientMockRecorder) ListComplianceSnapshots(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComplianceSnapshots", reflect.TypeOf((*MockProductServiceClient)(nil).ListComplianceSnapshots), varargs...)
+}
+
+// DiffComplianceSnapshots mocks base method
+func (m *MockProductServiceClient) DiffComplianceSnapshots(ctx context.Context, in *v1.DiffComplianceSnapshotsRequest, opts ...grpc.CallOption) (*v1.DiffComplianceSnapshotsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DiffComplianceSnapshots", varargs...)
+	ret0, _ := ret[0].(*v1.DiffComplianceSnapshotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffComplianceSnapshots indicates an expected call of DiffComplianceSnapshots
+func (mr *MockProductServiceClientMockRecorder) DiffComplianceSnapshots(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffComplianceSnapshots", reflect.TypeOf((*MockProductServiceClient)(nil).DiffComplianceSnapshots), varargs...)
+}
+
 // MockProductServiceServer is a mock of ProductServiceServer interface
 type MockProductServiceServer struct {
 	ctrl     *gomock.Controller
@@ -2788,6 +2848,51 @@ func (mr *MockProductServiceServerMockRecorder) GetProductInformationBySwidTag(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductInformationBySwidTag", reflect.TypeOf((*MockProductServiceServer)(nil).GetProductInformationBySwidTag), arg0, arg1)
 }
 
+// CreateComplianceSnapshot mocks base method
+func (m *MockProductServiceServer) CreateComplianceSnapshot(arg0 context.Context, arg1 *v1.CreateComplianceSnapshotRequest) (*v1.ComplianceSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateComplianceSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ComplianceSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateComplianceSnapshot indicates an expected call of CreateComplianceSnapshot
+func (mr *MockProductServiceServerMockRecorder) CreateComplianceSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComplianceSnapshot", reflect.TypeOf((*MockProductServiceServer)(nil).CreateComplianceSnapshot), arg0, arg1)
+}
+
+// ListComplianceSnapshots mocks base method
+func (m *MockProductServiceServer) ListComplianceSnapshots(arg0 context.Context, arg1 *v1.ListComplianceSnapshotsRequest) (*v1.ListComplianceSnapshotsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComplianceSnapshots", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ListComplianceSnapshotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComplianceSnapshots indicates an expected call of ListComplianceSnapshots
+func (mr *MockProductServiceServerMockRecorder) ListComplianceSnapshots(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComplianceSnapshots", reflect.TypeOf((*MockProductServiceServer)(nil).ListComplianceSnapshots), arg0, arg1)
+}
+
+// DiffComplianceSnapshots mocks base method
+func (m *MockProductServiceServer) DiffComplianceSnapshots(arg0 context.Context, arg1 *v1.DiffComplianceSnapshotsRequest) (*v1.DiffComplianceSnapshotsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffComplianceSnapshots", arg0, arg1)
+	ret0, _ := ret[0].(*v1.DiffComplianceSnapshotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffComplianceSnapshots indicates an expected call of DiffComplianceSnapshots
+func (mr *MockProductServiceServerMockRecorder) DiffComplianceSnapshots(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffComplianceSnapshots", reflect.TypeOf((*MockProductServiceServer)(nil).DiffComplianceSnapshots), arg0, arg1)
+}
+
 // MockUnsafeProductServiceServer is a mock of UnsafeProductServiceServer interface
 type MockUnsafeProductServiceServer struct {
 	ctrl     *gomock.Controller
@@ -18031,6 +18031,761 @@ var _ interface {
 	ErrorName() string
 } = UpdateDgraphBatchSuccessCountValidationError{}
 
+// Validate checks the field values on CreateComplianceSnapshotRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateComplianceSnapshotRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_CreateComplianceSnapshotRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return CreateComplianceSnapshotRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetLabel()) > 200 {
+		return CreateComplianceSnapshotRequestValidationError{
+			field:  "Label",
+			reason: "value length must be at most 200 runes",
+		}
+	}
+
+	return nil
+}
+
+// CreateComplianceSnapshotRequestValidationError is the validation error
+// returned by CreateComplianceSnapshotRequest.Validate if the designated
+// constraints aren't met.
+type CreateComplianceSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateComplianceSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateComplianceSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateComplianceSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateComplianceSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateComplianceSnapshotRequestValidationError) ErrorName() string {
+	return "CreateComplianceSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateComplianceSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateComplianceSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateComplianceSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateComplianceSnapshotRequestValidationError{}
+
+var _CreateComplianceSnapshotRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on ComplianceSnapshot with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ComplianceSnapshot) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Scope
+
+	// no validation rules for Label
+
+	// no validation rules for SnapshotType
+
+	// no validation rules for Status
+
+	// no validation rules for CreatedBy
+
+	if v, ok := interface{}(m.GetCreatedOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ComplianceSnapshotValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetCompletedOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ComplianceSnapshotValidationError{
+				field:  "CompletedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ComplianceSnapshotValidationError is the validation error returned by
+// ComplianceSnapshot.Validate if the designated constraints aren't met.
+type ComplianceSnapshotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ComplianceSnapshotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ComplianceSnapshotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ComplianceSnapshotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ComplianceSnapshotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ComplianceSnapshotValidationError) ErrorName() string {
+	return "ComplianceSnapshotValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ComplianceSnapshotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComplianceSnapshot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ComplianceSnapshotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ComplianceSnapshotValidationError{}
+
+// Validate checks the field values on ListComplianceSnapshotsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListComplianceSnapshotsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_ListComplianceSnapshotsRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return ListComplianceSnapshotsRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	if val := m.GetPageNum(); val < 1 || val >= 1000 {
+		return ListComplianceSnapshotsRequestValidationError{
+			field:  "PageNum",
+			reason: "value must be inside range [1, 1000)",
+		}
+	}
+
+	if val := m.GetPageSize(); val < 10 || val > 200 {
+		return ListComplianceSnapshotsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [10, 200]",
+		}
+	}
+
+	return nil
+}
+
+// ListComplianceSnapshotsRequestValidationError is the validation error
+// returned by ListComplianceSnapshotsRequest.Validate if the designated
+// constraints aren't met.
+type ListComplianceSnapshotsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListComplianceSnapshotsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListComplianceSnapshotsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListComplianceSnapshotsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListComplianceSnapshotsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListComplianceSnapshotsRequestValidationError) ErrorName() string {
+	return "ListComplianceSnapshotsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListComplianceSnapshotsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListComplianceSnapshotsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListComplianceSnapshotsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListComplianceSnapshotsRequestValidationError{}
+
+var _ListComplianceSnapshotsRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on ListComplianceSnapshotsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListComplianceSnapshotsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for TotalRecords
+
+	for idx, item := range m.GetSnapshots() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListComplianceSnapshotsResponseValidationError{
+					field:  fmt.Sprintf("Snapshots[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListComplianceSnapshotsResponseValidationError is the validation error
+// returned by ListComplianceSnapshotsResponse.Validate if the designated
+// constraints aren't met.
+type ListComplianceSnapshotsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListComplianceSnapshotsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListComplianceSnapshotsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListComplianceSnapshotsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListComplianceSnapshotsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListComplianceSnapshotsResponseValidationError) ErrorName() string {
+	return "ListComplianceSnapshotsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListComplianceSnapshotsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListComplianceSnapshotsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListComplianceSnapshotsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListComplianceSnapshotsResponseValidationError{}
+
+// Validate checks the field values on DiffComplianceSnapshotsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DiffComplianceSnapshotsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_DiffComplianceSnapshotsRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return DiffComplianceSnapshotsRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	if m.GetFromSnapshotId() <= 0 {
+		return DiffComplianceSnapshotsRequestValidationError{
+			field:  "FromSnapshotId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	if m.GetToSnapshotId() <= 0 {
+		return DiffComplianceSnapshotsRequestValidationError{
+			field:  "ToSnapshotId",
+			reason: "value must be greater than 0",
+		}
+	}
+
+	// no validation rules for Editor
+
+	// no validation rules for IncludeUnchanged
+
+	return nil
+}
+
+// DiffComplianceSnapshotsRequestValidationError is the validation error
+// returned by DiffComplianceSnapshotsRequest.Validate if the designated
+// constraints aren't met.
+type DiffComplianceSnapshotsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffComplianceSnapshotsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffComplianceSnapshotsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffComplianceSnapshotsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffComplianceSnapshotsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffComplianceSnapshotsRequestValidationError) ErrorName() string {
+	return "DiffComplianceSnapshotsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffComplianceSnapshotsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffComplianceSnapshotsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffComplianceSnapshotsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffComplianceSnapshotsRequestValidationError{}
+
+var _DiffComplianceSnapshotsRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on DiffComplianceSnapshotsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DiffComplianceSnapshotsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetFrom()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return DiffComplianceSnapshotsResponseValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetTo()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return DiffComplianceSnapshotsResponseValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetDiffs() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return DiffComplianceSnapshotsResponseValidationError{
+					field:  fmt.Sprintf("Diffs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// DiffComplianceSnapshotsResponseValidationError is the validation error
+// returned by DiffComplianceSnapshotsResponse.Validate if the designated
+// constraints aren't met.
+type DiffComplianceSnapshotsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffComplianceSnapshotsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffComplianceSnapshotsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffComplianceSnapshotsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffComplianceSnapshotsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffComplianceSnapshotsResponseValidationError) ErrorName() string {
+	return "DiffComplianceSnapshotsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffComplianceSnapshotsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffComplianceSnapshotsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffComplianceSnapshotsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffComplianceSnapshotsResponseValidationError{}
+
+// Validate checks the field values on ComplianceDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ComplianceDiff) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for Editor
+
+	// no validation rules for Sku
+
+	// no validation rules for Swidtags
+
+	// no validation rules for ProductNames
+
+	// no validation rules for AggregationName
+
+	// no validation rules for Metrics
+
+	if v, ok := interface{}(m.GetFrom()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ComplianceDiffValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetTo()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ComplianceDiffValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DeltaNumberChange
+
+	// no validation rules for DeltaCostChange
+
+	return nil
+}
+
+// ComplianceDiffValidationError is the validation error returned by
+// ComplianceDiff.Validate if the designated constraints aren't met.
+type ComplianceDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ComplianceDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ComplianceDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ComplianceDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ComplianceDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ComplianceDiffValidationError) ErrorName() string { return "ComplianceDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ComplianceDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComplianceDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ComplianceDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ComplianceDiffValidationError{}
+
+// Validate checks the field values on ComplianceValues with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ComplianceValues) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for NumComputedLicences
+
+	// no validation rules for NumAcquiredLicences
+
+	// no validation rules for DeltaNumber
+
+	// no validation rules for DeltaCost
+
+	// no validation rules for TotalCost
+
+	// no validation rules for PurchaseCost
+
+	// no validation rules for ComputedCost
+
+	return nil
+}
+
+// ComplianceValuesValidationError is the validation error returned by
+// ComplianceValues.Validate if the designated constraints aren't met.
+type ComplianceValuesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ComplianceValuesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ComplianceValuesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ComplianceValuesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ComplianceValuesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ComplianceValuesValidationError) ErrorName() string { return "ComplianceValuesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ComplianceValuesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComplianceValues.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ComplianceValuesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ComplianceValuesValidationError{}
+
 // Validate checks the field values on
 // UpdateSharedLicensesRequest_SharedLicenses with the rules defined in the
 // proto definition for this message. If any rules are violated, an error is returned.
@@ -101,6 +101,12 @@ type ProductServiceClient interface {
 	GetTrueUpLicences(ctx context.Context, in *GetTrueUpLicencesRequest, opts ...grpc.CallOption) (*GetTrueUpLicencesResponse, error)
 	GetWasteUpLicences(ctx context.Context, in *GetWasteUpLicencesRequest, opts ...grpc.CallOption) (*GetWasteUpLicencesResponse, error)
 	GetProductInformationBySwidTag(ctx context.Context, in *GetProductInformationBySwidTagRequest, opts ...grpc.CallOption) (*GetProductInformationBySwidTagResponse, error)
+	// CreateComplianceSnapshot records the current compliance of the scope, the snapshot is
+	// completed once the licences of the scope are computed again
+	CreateComplianceSnapshot(ctx context.Context, in *CreateComplianceSnapshotRequest, opts ...grpc.CallOption) (*ComplianceSnapshot, error)
+	ListComplianceSnapshots(ctx context.Context, in *ListComplianceSnapshotsRequest, opts ...grpc.CallOption) (*ListComplianceSnapshotsResponse, error)
+	// DiffComplianceSnapshots compares the compliance of two snapshots of the same scope
+	DiffComplianceSnapshots(ctx context.Context, in *DiffComplianceSnapshotsRequest, opts ...grpc.CallOption) (*DiffComplianceSnapshotsResponse, error)
 }
 
 type productServiceClient struct {
@@ -813,6 +819,33 @@ func (c *productServiceClient) GetProductInformationBySwidTag(ctx context.Contex
 	return out, nil
 }
 
+func (c *productServiceClient) CreateComplianceSnapshot(ctx context.Context, in *CreateComplianceSnapshotRequest, opts ...grpc.CallOption) (*ComplianceSnapshot, error) {
+	out := new(ComplianceSnapshot)
+	err := c.cc.Invoke(ctx, "/optisam.products.v1.ProductService/CreateComplianceSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListComplianceSnapshots(ctx context.Context, in *ListComplianceSnapshotsRequest, opts ...grpc.CallOption) (*ListComplianceSnapshotsResponse, error) {
+	out := new(ListComplianceSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/optisam.products.v1.ProductService/ListComplianceSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DiffComplianceSnapshots(ctx context.Context, in *DiffComplianceSnapshotsRequest, opts ...grpc.CallOption) (*DiffComplianceSnapshotsResponse, error) {
+	out := new(DiffComplianceSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/optisam.products.v1.ProductService/DiffComplianceSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations should embed UnimplementedProductServiceServer
 // for forward compatibility
@@ -901,6 +934,12 @@ type ProductServiceServer interface {
 	GetTrueUpLicences(context.Context, *GetTrueUpLicencesRequest) (*GetTrueUpLicencesResponse, error)
 	GetWasteUpLicences(context.Context, *GetWasteUpLicencesRequest) (*GetWasteUpLicencesResponse, error)
 	GetProductInformationBySwidTag(context.Context, *GetProductInformationBySwidTagRequest) (*GetProductInformationBySwidTagResponse, error)
+	// CreateComplianceSnapshot records the current compliance of the scope, the snapshot is
+	// completed once the licences of the scope are computed again
+	CreateComplianceSnapshot(context.Context, *CreateComplianceSnapshotRequest) (*ComplianceSnapshot, error)
+	ListComplianceSnapshots(context.Context, *ListComplianceSnapshotsRequest) (*ListComplianceSnapshotsResponse, error)
+	// DiffComplianceSnapshots compares the compliance of two snapshots of the same scope
+	DiffComplianceSnapshots(context.Context, *DiffComplianceSnapshotsRequest) (*DiffComplianceSnapshotsResponse, error)
 }
 
 // UnimplementedProductServiceServer should be embedded to have forward compatible implementations.
@@ -1141,6 +1180,15 @@ func (UnimplementedProductServiceServer) GetWasteUpLicences(context.Context, *Ge
 func (UnimplementedProductServiceServer) GetProductInformationBySwidTag(context.Context, *GetProductInformationBySwidTagRequest) (*GetProductInformationBySwidTagResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProductInformationBySwidTag not implemented")
 }
+func (UnimplementedProductServiceServer) CreateComplianceSnapshot(context.Context, *CreateComplianceSnapshotRequest) (*ComplianceSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComplianceSnapshot not implemented")
+}
+func (UnimplementedProductServiceServer) ListComplianceSnapshots(context.Context, *ListComplianceSnapshotsRequest) (*ListComplianceSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComplianceSnapshots not implemented")
+}
+func (UnimplementedProductServiceServer) DiffComplianceSnapshots(context.Context, *DiffComplianceSnapshotsRequest) (*DiffComplianceSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffComplianceSnapshots not implemented")
+}
 
 // UnsafeProductServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ProductServiceServer will
@@ -2557,6 +2605,60 @@ func _ProductService_GetProductInformationBySwidTag_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_CreateComplianceSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateComplianceSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateComplianceSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.products.v1.ProductService/CreateComplianceSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateComplianceSnapshot(ctx, req.(*CreateComplianceSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListComplianceSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListComplianceSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListComplianceSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.products.v1.ProductService/ListComplianceSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListComplianceSnapshots(ctx, req.(*ListComplianceSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DiffComplianceSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffComplianceSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DiffComplianceSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.products.v1.ProductService/DiffComplianceSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DiffComplianceSnapshots(ctx, req.(*DiffComplianceSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ProductService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "optisam.products.v1.ProductService",
 	HandlerType: (*ProductServiceServer)(nil),
@@ -2873,6 +2975,18 @@ var _ProductService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetProductInformationBySwidTag",
 			Handler:    _ProductService_GetProductInformationBySwidTag_Handler,
 		},
+		{
+			MethodName: "CreateComplianceSnapshot",
+			Handler:    _ProductService_CreateComplianceSnapshot_Handler,
+		},
+		{
+			MethodName: "ListComplianceSnapshots",
+			Handler:    _ProductService_ListComplianceSnapshots_Handler,
+		},
+		{
+			MethodName: "DiffComplianceSnapshots",
+			Handler:    _ProductService_DiffComplianceSnapshots_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "product.proto",
@@ -21,6 +21,7 @@ import (
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/postgres"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/prometheus"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/admin"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/config"
 	cronJob "gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/cron"
 	v1kaf "gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/kafka/v1"
@@ -261,7 +262,7 @@ func RunServer() error {
 	}
 	defer q.Close(ctx)
 	for i := 0; i < cfg.MaxAPIWorker; i++ {
-		lWorker := dgworker.NewWorker("aw", dg, grpcClientMap)
+		lWorker := dgworker.NewWorker("aw", dg, grpcClientMap, q)
 		q.RegisterWorker(ctx, lWorker)
 	}
 
@@ -320,8 +321,14 @@ func RunServer() error {
 		logger.Log.Sugar().Debug("failed to open consumer: %v", err)
 		return fmt.Errorf("failed to open consumer: %v", err)
 	}
+	auditPublisher, err := iam.NewAuditPublisher(cfg.IAM)
+	if err != nil {
+		logger.Log.Fatal("Failed to create audit publisher", zap.Error(err))
+	}
+	revoked := iam.NewRevocationList(cfg.IAM)
+	apiKeys := iam.NewAPIKeyStore(cfg.IAM)
 	go func() {
-		_ = rest.RunServer(ctx, cfg.GRPCPort, cfg.HTTPPort, verifyKey)
+		_ = rest.RunServer(ctx, cfg.GRPCPort, cfg.HTTPPort, verifyKey, revoked, apiKeys)
 	}()
-	return grpc.RunServer(ctx, v1API, cfg.GRPCPort, verifyKey, authZPolicies, cfg.IAM.APIKey)
+	return grpc.RunServer(ctx, v1API, admin.NewServer(q), cfg.GRPCPort, verifyKey, authZPolicies, cfg.IAM.APIKey, revoked, apiKeys, auditPublisher)
 }
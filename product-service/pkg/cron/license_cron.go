@@ -10,6 +10,7 @@ import (
 	"log"
 	"net/http"
 	"net/url"
+	"time"
 
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/config"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
@@ -17,6 +18,8 @@ import (
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/job"
 
+	licenseworker "gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/worker/license_calculator"
+
 	"go.uber.org/zap"
 	"google.golang.org/grpc/metadata"
 )
@@ -60,10 +63,21 @@ func Job() {
 			logger.Log.Error("Cron AddClaims Failed", zap.Error(err))
 		}
 
+		// the jobs pushed by the replicas for this run take one periodic snapshot of the period
+		period := licenseworker.SnapshotPeriod(time.Now())
+		data, err := json.Marshal(licenseworker.DataUpdateWorker{
+			UpdatedBy: licenseworker.UpdatedByCron,
+			Period:    &period,
+		})
+		if err != nil {
+			logger.Log.Error("Cron Marshal Failed", zap.Error(err))
+			return
+		}
+
 		jobID, err := Queue.PushJob(cronAPIKeyCtx, job.Job{
 			Type:   sql.NullString{String: "lcalw"},
 			Status: job.JobStatusPENDING,
-			Data:   json.RawMessage(`{"updatedBy":"cron"}`),
+			Data:   data,
 		}, "lcalw")
 		if err != nil {
 			logger.Log.Info("Error from job", zap.Int32("jobId", jobID))
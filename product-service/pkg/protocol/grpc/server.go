@@ -11,17 +11,21 @@ import (
 	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/api/v1"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/errors"
 
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/audit"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
 	mw "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/opa"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/revocation"
+	wqv1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/api/v1"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
-	"github.com/open-policy-agent/opa/rego"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
 )
 
 // RunServer runs gRPC service to publish Auth service
-func RunServer(ctx context.Context, v1API v1.ProductServiceServer, port string, verifyKey *rsa.PublicKey, p *rego.PreparedEvalQuery, apiKey string) error {
+func RunServer(ctx context.Context, v1API v1.ProductServiceServer, queueAPI wqv1.WorkerQueueAdminServer, port string, verifyKey *rsa.PublicKey, p *opa.Policy, apiKey string, revoked revocation.List, keys apikey.Store, auditPublisher audit.Publisher) error {
 	runtime.HTTPError = errors.CustomHTTPError
 	listen, err := net.Listen("tcp", ":"+port)
 	if err != nil {
@@ -29,7 +33,7 @@ func RunServer(ctx context.Context, v1API v1.ProductServiceServer, port string,
 	}
 
 	// gRPC server statup options
-	opts := mw.Chained(logger.Log, verifyKey, p, apiKey)
+	opts := mw.Chained(logger.Log, verifyKey, p, apiKey, mw.WithRevocationList(revoked), mw.WithAPIKeyStore(keys), mw.WithAuditPublisher(auditPublisher))
 	opts = append(opts, grpc.StatsHandler(&ocgrpc.ServerHandler{}))
 	// add middleware
 	// opts = grpc_middleware.AddLogging(logger.Log, opts)
@@ -38,6 +42,7 @@ func RunServer(ctx context.Context, v1API v1.ProductServiceServer, port string,
 	opts = append(opts, grpc.MaxRecvMsgSize(8388608))
 	server := grpc.NewServer(opts...)
 	v1.RegisterProductServiceServer(server, v1API)
+	wqv1.RegisterWorkerQueueAdminServer(server, queueAPI)
 
 	// graceful shutdown
 	c := make(chan os.Signal, 1)
@@ -13,6 +13,9 @@ import (
 
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
 	rest_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/rest"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/apikey"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/revocation"
+	wqv1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/api/v1"
 
 	"google.golang.org/protobuf/encoding/protojson"
 
@@ -25,7 +28,7 @@ import (
 
 // RunServer runs HTTP/REST gateway
 // nolint: funlen, gocyclo, gosec
-func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.PublicKey) error {
+func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.PublicKey, revoked revocation.List, keys apikey.Store) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -41,9 +44,7 @@ func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.Pu
 		Addr: ":" + httpPort,
 		// Handler: &ochttp.Handler{
 		Handler: &ochttp.Handler{Handler: rest_middleware.AddCORS([]string{"*"},
-			// rest_middleware.AddLogger(logger.Log,
-			muxHTTP),
-		// ))},
+			rest_middleware.ValidateAuthWithAPIKeys(verifyKey, revoked, keys, muxHTTP)),
 		},
 	}
 
@@ -85,5 +86,8 @@ func newGateway(ctx context.Context, grpcPort string) (http.Handler, error) {
 	if err = v1.RegisterProductServiceHandler(ctx, muxGateway, conn); err != nil {
 		return nil, err
 	}
+	if err = wqv1.RegisterWorkerQueueAdminHandler(ctx, muxGateway, conn); err != nil {
+		return nil, err
+	}
 	return muxGateway, err
 }
@@ -94,6 +94,34 @@ func (mr *MockProductMockRecorder) AllNoMaintainenceProducts(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllNoMaintainenceProducts", reflect.TypeOf((*MockProduct)(nil).AllNoMaintainenceProducts), arg0, arg1)
 }
 
+// CompleteComplianceSnapshot mocks base method
+func (m *MockProduct) CompleteComplianceSnapshot(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteComplianceSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteComplianceSnapshot indicates an expected call of CompleteComplianceSnapshot
+func (mr *MockProductMockRecorder) CompleteComplianceSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteComplianceSnapshot", reflect.TypeOf((*MockProduct)(nil).CompleteComplianceSnapshot), arg0, arg1)
+}
+
+// CopyComplianceSnapshotEntries mocks base method
+func (m *MockProduct) CopyComplianceSnapshotEntries(arg0 context.Context, arg1 db.CopyComplianceSnapshotEntriesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyComplianceSnapshotEntries", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyComplianceSnapshotEntries indicates an expected call of CopyComplianceSnapshotEntries
+func (mr *MockProductMockRecorder) CopyComplianceSnapshotEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyComplianceSnapshotEntries", reflect.TypeOf((*MockProduct)(nil).CopyComplianceSnapshotEntries), arg0, arg1)
+}
+
 // CounterFeitedProductsCosts mocks base method
 func (m *MockProduct) CounterFeitedProductsCosts(arg0 context.Context, arg1 db.CounterFeitedProductsCostsParams) ([]db.CounterFeitedProductsCostsRow, error) {
 	m.ctrl.T.Helper()
@@ -237,6 +265,20 @@ func (mr *MockProductMockRecorder) DeleteAggregationByScope(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAggregationByScope", reflect.TypeOf((*MockProduct)(nil).DeleteAggregationByScope), arg0, arg1)
 }
 
+// DeleteComplianceSnapshotEntries mocks base method
+func (m *MockProduct) DeleteComplianceSnapshotEntries(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComplianceSnapshotEntries", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteComplianceSnapshotEntries indicates an expected call of DeleteComplianceSnapshotEntries
+func (mr *MockProductMockRecorder) DeleteComplianceSnapshotEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComplianceSnapshotEntries", reflect.TypeOf((*MockProduct)(nil).DeleteComplianceSnapshotEntries), arg0, arg1)
+}
+
 // DeleteNominativeUserByID mocks base method
 func (m *MockProduct) DeleteNominativeUserByID(arg0 context.Context, arg1 db.DeleteNominativeUserByIDParams) error {
 	m.ctrl.T.Helper()
@@ -467,6 +509,20 @@ func (mr *MockProductMockRecorder) ExportNominativeUsersProducts(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportNominativeUsersProducts", reflect.TypeOf((*MockProduct)(nil).ExportNominativeUsersProducts), arg0, arg1)
 }
 
+// FailComplianceSnapshot mocks base method
+func (m *MockProduct) FailComplianceSnapshot(arg0 context.Context, arg1 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailComplianceSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailComplianceSnapshot indicates an expected call of FailComplianceSnapshot
+func (mr *MockProductMockRecorder) FailComplianceSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailComplianceSnapshot", reflect.TypeOf((*MockProduct)(nil).FailComplianceSnapshot), arg0, arg1)
+}
+
 // GetAcqBySwidtag mocks base method
 func (m *MockProduct) GetAcqBySwidtag(arg0 context.Context, arg1 db.GetAcqBySwidtagParams) (db.Acqright, error) {
 	m.ctrl.T.Helper()
@@ -707,6 +763,21 @@ func (mr *MockProductMockRecorder) GetAvailableAggLicenses(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableAggLicenses", reflect.TypeOf((*MockProduct)(nil).GetAvailableAggLicenses), arg0, arg1)
 }
 
+// GetComplianceSnapshot mocks base method
+func (m *MockProduct) GetComplianceSnapshot(arg0 context.Context, arg1 db.GetComplianceSnapshotParams) (db.ComplianceSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetComplianceSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(db.ComplianceSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetComplianceSnapshot indicates an expected call of GetComplianceSnapshot
+func (mr *MockProductMockRecorder) GetComplianceSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetComplianceSnapshot", reflect.TypeOf((*MockProduct)(nil).GetComplianceSnapshot), arg0, arg1)
+}
+
 // GetComputedCost mocks base method
 func (m *MockProduct) GetComputedCost(arg0 context.Context, arg1 []string) (db.GetComputedCostRow, error) {
 	m.ctrl.T.Helper()
@@ -1367,6 +1438,21 @@ func (mr *MockProductMockRecorder) InsertAggregation(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAggregation", reflect.TypeOf((*MockProduct)(nil).InsertAggregation), arg0, arg1)
 }
 
+// InsertComplianceSnapshot mocks base method
+func (m *MockProduct) InsertComplianceSnapshot(arg0 context.Context, arg1 db.InsertComplianceSnapshotParams) (db.ComplianceSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertComplianceSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(db.ComplianceSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertComplianceSnapshot indicates an expected call of InsertComplianceSnapshot
+func (mr *MockProductMockRecorder) InsertComplianceSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertComplianceSnapshot", reflect.TypeOf((*MockProduct)(nil).InsertComplianceSnapshot), arg0, arg1)
+}
+
 // InsertNominativeUserFileUploadDetails mocks base method
 func (m *MockProduct) InsertNominativeUserFileUploadDetails(arg0 context.Context, arg1 db.InsertNominativeUserFileUploadDetailsParams) error {
 	m.ctrl.T.Helper()
@@ -1500,6 +1586,36 @@ func (mr *MockProductMockRecorder) ListAggregations(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAggregations", reflect.TypeOf((*MockProduct)(nil).ListAggregations), arg0, arg1)
 }
 
+// ListComplianceSnapshotEntries mocks base method
+func (m *MockProduct) ListComplianceSnapshotEntries(arg0 context.Context, arg1 db.ListComplianceSnapshotEntriesParams) ([]db.ComplianceSnapshotEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComplianceSnapshotEntries", arg0, arg1)
+	ret0, _ := ret[0].([]db.ComplianceSnapshotEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComplianceSnapshotEntries indicates an expected call of ListComplianceSnapshotEntries
+func (mr *MockProductMockRecorder) ListComplianceSnapshotEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComplianceSnapshotEntries", reflect.TypeOf((*MockProduct)(nil).ListComplianceSnapshotEntries), arg0, arg1)
+}
+
+// ListComplianceSnapshots mocks base method
+func (m *MockProduct) ListComplianceSnapshots(arg0 context.Context, arg1 db.ListComplianceSnapshotsParams) ([]db.ListComplianceSnapshotsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComplianceSnapshots", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListComplianceSnapshotsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComplianceSnapshots indicates an expected call of ListComplianceSnapshots
+func (mr *MockProductMockRecorder) ListComplianceSnapshots(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComplianceSnapshots", reflect.TypeOf((*MockProduct)(nil).ListComplianceSnapshots), arg0, arg1)
+}
+
 // ListConcurrentUsers mocks base method
 func (m *MockProduct) ListConcurrentUsers(arg0 context.Context, arg1 db.ListConcurrentUsersParams) ([]db.ListConcurrentUsersRow, error) {
 	m.ctrl.T.Helper()
@@ -2076,6 +2192,21 @@ func (mr *MockProductMockRecorder) UpsertNominativeUsersTx(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertNominativeUsersTx", reflect.TypeOf((*MockProduct)(nil).UpsertNominativeUsersTx), arg0, arg1)
 }
 
+// UpsertPeriodicComplianceSnapshot mocks base method
+func (m *MockProduct) UpsertPeriodicComplianceSnapshot(arg0 context.Context, arg1 db.UpsertPeriodicComplianceSnapshotParams) (db.ComplianceSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertPeriodicComplianceSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(db.ComplianceSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertPeriodicComplianceSnapshot indicates an expected call of UpsertPeriodicComplianceSnapshot
+func (mr *MockProductMockRecorder) UpsertPeriodicComplianceSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertPeriodicComplianceSnapshot", reflect.TypeOf((*MockProduct)(nil).UpsertPeriodicComplianceSnapshot), arg0, arg1)
+}
+
 // UpsertProduct mocks base method
 func (m *MockProduct) UpsertProduct(arg0 context.Context, arg1 db.UpsertProductParams) error {
 	m.ctrl.T.Helper()
@@ -66,6 +66,7 @@ const (
 	JobStatusFAILED    JobStatus = "FAILED"
 	JobStatusRETRY     JobStatus = "RETRY"
 	JobStatusRUNNING   JobStatus = "RUNNING"
+	JobStatusDEAD      JobStatus = "DEAD"
 )
 
 func (e *JobStatus) Scan(src interface{}) error {
@@ -402,6 +403,37 @@ type Aggregation struct {
 	UpdatedBy       sql.NullString `json:"updated_by"`
 }
 
+type ComplianceSnapshot struct {
+	ID           int32         `json:"id"`
+	Scope        string        `json:"scope"`
+	Label        string        `json:"label"`
+	SnapshotType string        `json:"snapshot_type"`
+	Status       string        `json:"status"`
+	CreatedBy    string        `json:"created_by"`
+	CreatedOn    time.Time     `json:"created_on"`
+	CompletedOn  sql.NullTime  `json:"completed_on"`
+	JobID        sql.NullInt32 `json:"job_id"`
+	Period       sql.NullTime  `json:"period"`
+}
+
+type ComplianceSnapshotEntry struct {
+	SnapshotID          int32           `json:"snapshot_id"`
+	Scope               string          `json:"scope"`
+	Editor              string          `json:"editor"`
+	Sku                 string          `json:"sku"`
+	Swidtags            string          `json:"swidtags"`
+	ProductNames        string          `json:"product_names"`
+	AggregationName     string          `json:"aggregation_name"`
+	Metrics             string          `json:"metrics"`
+	NumComputedLicences int32           `json:"num_computed_licences"`
+	NumAcquiredLicences int32           `json:"num_acquired_licences"`
+	DeltaNumber         int32           `json:"delta_number"`
+	DeltaCost           decimal.Decimal `json:"delta_cost"`
+	TotalCost           decimal.Decimal `json:"total_cost"`
+	PurchaseCost        decimal.Decimal `json:"purchase_cost"`
+	ComputedCost        decimal.Decimal `json:"computed_cost"`
+}
+
 type DashboardAudit struct {
 	ID           int32        `json:"id"`
 	UpdatedAt    time.Time    `json:"updated_at"`
@@ -428,17 +460,20 @@ type EditorCatalog struct {
 }
 
 type Job struct {
-	JobID      int32           `json:"job_id"`
-	Type       string          `json:"type"`
-	Status     JobStatus       `json:"status"`
-	Data       json.RawMessage `json:"data"`
-	Comments   sql.NullString  `json:"comments"`
-	StartTime  sql.NullTime    `json:"start_time"`
-	EndTime    sql.NullTime    `json:"end_time"`
-	CreatedAt  time.Time       `json:"created_at"`
-	RetryCount sql.NullInt32   `json:"retry_count"`
-	MetaData   json.RawMessage `json:"meta_data"`
-	Ppid       sql.NullString  `json:"ppid"`
+	JobID          int32           `json:"job_id"`
+	Type           string          `json:"type"`
+	Status         JobStatus       `json:"status"`
+	Data           json.RawMessage `json:"data"`
+	Comments       sql.NullString  `json:"comments"`
+	StartTime      sql.NullTime    `json:"start_time"`
+	EndTime        sql.NullTime    `json:"end_time"`
+	CreatedAt      time.Time       `json:"created_at"`
+	RetryCount     sql.NullInt32   `json:"retry_count"`
+	MetaData       json.RawMessage `json:"meta_data"`
+	Ppid           sql.NullString  `json:"ppid"`
+	Priority       int16           `json:"priority"`
+	LeaseOwner     sql.NullString  `json:"lease_owner"`
+	LeaseExpiresAt sql.NullTime    `json:"lease_expires_at"`
 }
 
 type NominativeUser struct {
@@ -14,6 +14,8 @@ type Querier interface {
 	AddComputedLicensesToAggregation(ctx context.Context, arg AddComputedLicensesToAggregationParams) error
 	AggregatedRightDetails(ctx context.Context, arg AggregatedRightDetailsParams) (AggregatedRightDetailsRow, error)
 	AllNoMaintainenceProducts(ctx context.Context, scope string) ([]string, error)
+	CompleteComplianceSnapshot(ctx context.Context, id int32) error
+	CopyComplianceSnapshotEntries(ctx context.Context, arg CopyComplianceSnapshotEntriesParams) error
 	CounterFeitedProductsCosts(ctx context.Context, arg CounterFeitedProductsCostsParams) ([]CounterFeitedProductsCostsRow, error)
 	CounterFeitedProductsLicences(ctx context.Context, arg CounterFeitedProductsLicencesParams) ([]CounterFeitedProductsLicencesRow, error)
 	CounterfeitPercent(ctx context.Context, scope string) (CounterfeitPercentRow, error)
@@ -24,6 +26,7 @@ type Querier interface {
 	DeleteAggregatedRightsByScope(ctx context.Context, scope string) error
 	DeleteAggregation(ctx context.Context, arg DeleteAggregationParams) error
 	DeleteAggregationByScope(ctx context.Context, scope string) error
+	DeleteComplianceSnapshotEntries(ctx context.Context, snapshotID int32) error
 	DeleteNominativeUserByID(ctx context.Context, arg DeleteNominativeUserByIDParams) error
 	DeleteOverallComputedLicensesByScope(ctx context.Context, scope string) error
 	DeleteProductApplications(ctx context.Context, arg DeleteProductApplicationsParams) error
@@ -39,6 +42,7 @@ type Querier interface {
 	ExportConcurrentUsers(ctx context.Context, arg ExportConcurrentUsersParams) ([]ExportConcurrentUsersRow, error)
 	ExportNominativeUsersAggregation(ctx context.Context, arg ExportNominativeUsersAggregationParams) ([]ExportNominativeUsersAggregationRow, error)
 	ExportNominativeUsersProducts(ctx context.Context, arg ExportNominativeUsersProductsParams) ([]ExportNominativeUsersProductsRow, error)
+	FailComplianceSnapshot(ctx context.Context, id int32) error
 	GetAcqBySwidtag(ctx context.Context, arg GetAcqBySwidtagParams) (Acqright, error)
 	GetAcqBySwidtags(ctx context.Context, arg GetAcqBySwidtagsParams) ([]GetAcqBySwidtagsRow, error)
 	GetAcqRightBySKU(ctx context.Context, arg GetAcqRightBySKUParams) (GetAcqRightBySKURow, error)
@@ -55,6 +59,7 @@ type Querier interface {
 	GetApplicationsByProductID(ctx context.Context, arg GetApplicationsByProductIDParams) ([]string, error)
 	GetAvailableAcqLicenses(ctx context.Context, arg GetAvailableAcqLicensesParams) (int32, error)
 	GetAvailableAggLicenses(ctx context.Context, arg GetAvailableAggLicensesParams) (int32, error)
+	GetComplianceSnapshot(ctx context.Context, arg GetComplianceSnapshotParams) (ComplianceSnapshot, error)
 	GetComputedCost(ctx context.Context, scope []string) (GetComputedCostRow, error)
 	GetComputedCostEditorProducts(ctx context.Context, arg GetComputedCostEditorProductsParams) ([]GetComputedCostEditorProductsRow, error)
 	GetComputedCostEditors(ctx context.Context, scope []string) ([]GetComputedCostEditorsRow, error)
@@ -99,6 +104,7 @@ type Querier interface {
 	GetTotalUnderusageAmount(ctx context.Context, scope string) (float64, error)
 	GetUnitPriceBySku(ctx context.Context, arg GetUnitPriceBySkuParams) (GetUnitPriceBySkuRow, error)
 	InsertAggregation(ctx context.Context, arg InsertAggregationParams) (int32, error)
+	InsertComplianceSnapshot(ctx context.Context, arg InsertComplianceSnapshotParams) (ComplianceSnapshot, error)
 	InsertNominativeUserFileUploadDetails(ctx context.Context, arg InsertNominativeUserFileUploadDetailsParams) error
 	InsertOverAllComputedLicences(ctx context.Context, arg InsertOverAllComputedLicencesParams) error
 	ListAcqRightsAggregation(ctx context.Context, arg ListAcqRightsAggregationParams) ([]ListAcqRightsAggregationRow, error)
@@ -108,6 +114,8 @@ type Querier interface {
 	ListAggregationNameByScope(ctx context.Context, scope string) ([]string, error)
 	ListAggregationNameWithScope(ctx context.Context) ([]ListAggregationNameWithScopeRow, error)
 	ListAggregations(ctx context.Context, arg ListAggregationsParams) ([]ListAggregationsRow, error)
+	ListComplianceSnapshotEntries(ctx context.Context, arg ListComplianceSnapshotEntriesParams) ([]ComplianceSnapshotEntry, error)
+	ListComplianceSnapshots(ctx context.Context, arg ListComplianceSnapshotsParams) ([]ListComplianceSnapshotsRow, error)
 	ListConcurrentUsers(ctx context.Context, arg ListConcurrentUsersParams) ([]ListConcurrentUsersRow, error)
 	ListDeployedAndAcquiredEditors(ctx context.Context, scope string) ([]string, error)
 	ListEditors(ctx context.Context, scope []string) ([]string, error)
@@ -145,6 +153,7 @@ type Querier interface {
 	UpsertAggrigationNominativeUser(ctx context.Context, arg UpsertAggrigationNominativeUserParams) error
 	UpsertConcurrentUser(ctx context.Context, arg UpsertConcurrentUserParams) error
 	UpsertDashboardUpdates(ctx context.Context, arg UpsertDashboardUpdatesParams) error
+	UpsertPeriodicComplianceSnapshot(ctx context.Context, arg UpsertPeriodicComplianceSnapshotParams) (ComplianceSnapshot, error)
 	// -- name: ProductAggregationChildOptions :many
 	// SELECT p.swidtag,p.product_name,p.product_edition,p.product_editor,p.product_version
 	// FROM products p
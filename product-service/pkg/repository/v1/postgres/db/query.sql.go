@@ -268,6 +268,39 @@ func (q *Queries) AllNoMaintainenceProducts(ctx context.Context, scope string) (
 	return items, nil
 }
 
+const completeComplianceSnapshot = `-- name: CompleteComplianceSnapshot :exec
+UPDATE compliance_snapshots SET status = 'COMPLETED', completed_on = NOW() WHERE id = $1
+`
+
+func (q *Queries) CompleteComplianceSnapshot(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, completeComplianceSnapshot, id)
+	return err
+}
+
+const copyComplianceSnapshotEntries = `-- name: CopyComplianceSnapshotEntries :exec
+INSERT INTO compliance_snapshot_entries (
+    snapshot_id, scope, editor, sku, swidtags, product_names, aggregation_name, metrics,
+    num_computed_licences, num_acquired_licences, delta_number, delta_cost,
+    total_cost, purchase_cost, computed_cost
+)
+SELECT
+    $1:: INTEGER, ocl.scope, ocl.editor, ocl.sku, ocl.swidtags, ocl.product_names, ocl.aggregation_name, ocl.metrics,
+    ocl.num_computed_licences, ocl.num_acquired_licences, ocl.delta_number, ocl.delta_cost,
+    ocl.total_cost, ocl.purchase_cost, ocl.computed_cost
+FROM overall_computed_licences ocl
+WHERE ocl.scope = $2
+`
+
+type CopyComplianceSnapshotEntriesParams struct {
+	SnapshotID int32  `json:"snapshot_id"`
+	Scope      string `json:"scope"`
+}
+
+func (q *Queries) CopyComplianceSnapshotEntries(ctx context.Context, arg CopyComplianceSnapshotEntriesParams) error {
+	_, err := q.db.ExecContext(ctx, copyComplianceSnapshotEntries, arg.SnapshotID, arg.Scope)
+	return err
+}
+
 const counterFeitedProductsCosts = `-- name: CounterFeitedProductsCosts :many
 
 SELECT
@@ -524,6 +557,15 @@ func (q *Queries) DeleteAggregationByScope(ctx context.Context, scope string) er
 	return err
 }
 
+const deleteComplianceSnapshotEntries = `-- name: DeleteComplianceSnapshotEntries :exec
+DELETE FROM compliance_snapshot_entries WHERE snapshot_id = $1
+`
+
+func (q *Queries) DeleteComplianceSnapshotEntries(ctx context.Context, snapshotID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteComplianceSnapshotEntries, snapshotID)
+	return err
+}
+
 const deleteNominativeUserByID = `-- name: DeleteNominativeUserByID :exec
 
 DELETE FROM nominative_user WHERE scope = $1 AND user_id = $2
@@ -1667,6 +1709,15 @@ func (q *Queries) ExportNominativeUsersProducts(ctx context.Context, arg ExportN
 	return items, nil
 }
 
+const failComplianceSnapshot = `-- name: FailComplianceSnapshot :exec
+UPDATE compliance_snapshots SET status = 'FAILED', completed_on = NOW() WHERE id = $1
+`
+
+func (q *Queries) FailComplianceSnapshot(ctx context.Context, id int32) error {
+	_, err := q.db.ExecContext(ctx, failComplianceSnapshot, id)
+	return err
+}
+
 const getAcqBySwidtag = `-- name: GetAcqBySwidtag :one
 
 Select sku, swidtag, product_name, product_editor, scope, metric, num_licenses_acquired, num_licences_computed, num_licences_maintainance, avg_unit_price, avg_maintenance_unit_price, total_purchase_cost, total_computed_cost, total_maintenance_cost, total_cost, created_on, created_by, updated_on, updated_by, start_of_maintenance, end_of_maintenance, last_purchased_order, support_number, maintenance_provider, version, comment, ordering_date, corporate_sourcing_contract, software_provider, file_name, file_data, repartition, support_numbers
@@ -2452,6 +2503,33 @@ func (q *Queries) GetAvailableAggLicenses(ctx context.Context, arg GetAvailableA
 	return acquired_licences, err
 }
 
+const getComplianceSnapshot = `-- name: GetComplianceSnapshot :one
+SELECT id, scope, label, snapshot_type, status, created_by, created_on, completed_on, job_id, period FROM compliance_snapshots WHERE id = $1 AND scope = $2
+`
+
+type GetComplianceSnapshotParams struct {
+	ID    int32  `json:"id"`
+	Scope string `json:"scope"`
+}
+
+func (q *Queries) GetComplianceSnapshot(ctx context.Context, arg GetComplianceSnapshotParams) (ComplianceSnapshot, error) {
+	row := q.db.QueryRowContext(ctx, getComplianceSnapshot, arg.ID, arg.Scope)
+	var i ComplianceSnapshot
+	err := row.Scan(
+		&i.ID,
+		&i.Scope,
+		&i.Label,
+		&i.SnapshotType,
+		&i.Status,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.CompletedOn,
+		&i.JobID,
+		&i.Period,
+	)
+	return i, err
+}
+
 const getComputedCost = `-- name: GetComputedCost :one
 SELECT
     SUM(computed_cost + (total_cost-purchase_cost)):: Numeric(15, 2) as total_cost,
@@ -3169,7 +3247,7 @@ func (q *Queries) GetIndividualProductForAggregationCount(ctx context.Context, a
 }
 
 const getJobsInExecution = `-- name: GetJobsInExecution :one
-SELECT count(*) FROM jobs WHERE status != 'FAILED' AND status != 'COMPLETED' and ppid = $1
+SELECT count(*) FROM jobs WHERE status != 'FAILED' AND status != 'COMPLETED' AND status != 'DEAD' and ppid = $1
 `
 
 func (q *Queries) GetJobsInExecution(ctx context.Context, ppid sql.NullString) (int64, error) {
@@ -4452,6 +4530,44 @@ func (q *Queries) InsertAggregation(ctx context.Context, arg InsertAggregationPa
 	return id, err
 }
 
+const insertComplianceSnapshot = `-- name: InsertComplianceSnapshot :one
+INSERT INTO compliance_snapshots (scope, label, snapshot_type, status, created_by)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, scope, label, snapshot_type, status, created_by, created_on, completed_on, job_id, period
+`
+
+type InsertComplianceSnapshotParams struct {
+	Scope        string `json:"scope"`
+	Label        string `json:"label"`
+	SnapshotType string `json:"snapshot_type"`
+	Status       string `json:"status"`
+	CreatedBy    string `json:"created_by"`
+}
+
+func (q *Queries) InsertComplianceSnapshot(ctx context.Context, arg InsertComplianceSnapshotParams) (ComplianceSnapshot, error) {
+	row := q.db.QueryRowContext(ctx, insertComplianceSnapshot,
+		arg.Scope,
+		arg.Label,
+		arg.SnapshotType,
+		arg.Status,
+		arg.CreatedBy,
+	)
+	var i ComplianceSnapshot
+	err := row.Scan(
+		&i.ID,
+		&i.Scope,
+		&i.Label,
+		&i.SnapshotType,
+		&i.Status,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.CompletedOn,
+		&i.JobID,
+		&i.Period,
+	)
+	return i, err
+}
+
 const insertNominativeUserFileUploadDetails = `-- name: InsertNominativeUserFileUploadDetails :exec
 
 INSERT INTO
@@ -5550,6 +5666,126 @@ func (q *Queries) ListAggregations(ctx context.Context, arg ListAggregationsPara
 	return items, nil
 }
 
+const listComplianceSnapshotEntries = `-- name: ListComplianceSnapshotEntries :many
+SELECT snapshot_id, scope, editor, sku, swidtags, product_names, aggregation_name, metrics, num_computed_licences, num_acquired_licences, delta_number, delta_cost, total_cost, purchase_cost, computed_cost FROM compliance_snapshot_entries
+WHERE
+    snapshot_id = $1
+    AND (
+        CASE
+            WHEN $2:: bool THEN editor = $3
+            ELSE TRUE
+        END
+    )
+ORDER BY editor, sku, swidtags
+`
+
+type ListComplianceSnapshotEntriesParams struct {
+	SnapshotID int32  `json:"snapshot_id"`
+	IsEditor   bool   `json:"is_editor"`
+	Editor     string `json:"editor"`
+}
+
+func (q *Queries) ListComplianceSnapshotEntries(ctx context.Context, arg ListComplianceSnapshotEntriesParams) ([]ComplianceSnapshotEntry, error) {
+	rows, err := q.db.QueryContext(ctx, listComplianceSnapshotEntries, arg.SnapshotID, arg.IsEditor, arg.Editor)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ComplianceSnapshotEntry
+	for rows.Next() {
+		var i ComplianceSnapshotEntry
+		if err := rows.Scan(
+			&i.SnapshotID,
+			&i.Scope,
+			&i.Editor,
+			&i.Sku,
+			&i.Swidtags,
+			&i.ProductNames,
+			&i.AggregationName,
+			&i.Metrics,
+			&i.NumComputedLicences,
+			&i.NumAcquiredLicences,
+			&i.DeltaNumber,
+			&i.DeltaCost,
+			&i.TotalCost,
+			&i.PurchaseCost,
+			&i.ComputedCost,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listComplianceSnapshots = `-- name: ListComplianceSnapshots :many
+SELECT
+    count(*) OVER() AS totalRecords,
+    id, scope, label, snapshot_type, status, created_by, created_on, completed_on
+FROM compliance_snapshots
+WHERE scope = $1
+ORDER BY created_on DESC, id DESC
+LIMIT $2
+OFFSET $3
+`
+
+type ListComplianceSnapshotsParams struct {
+	Scope    string `json:"scope"`
+	PageSize int32  `json:"page_size"`
+	PageNum  int32  `json:"page_num"`
+}
+
+type ListComplianceSnapshotsRow struct {
+	Totalrecords int64        `json:"totalrecords"`
+	ID           int32        `json:"id"`
+	Scope        string       `json:"scope"`
+	Label        string       `json:"label"`
+	SnapshotType string       `json:"snapshot_type"`
+	Status       string       `json:"status"`
+	CreatedBy    string       `json:"created_by"`
+	CreatedOn    time.Time    `json:"created_on"`
+	CompletedOn  sql.NullTime `json:"completed_on"`
+}
+
+func (q *Queries) ListComplianceSnapshots(ctx context.Context, arg ListComplianceSnapshotsParams) ([]ListComplianceSnapshotsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listComplianceSnapshots, arg.Scope, arg.PageSize, arg.PageNum)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListComplianceSnapshotsRow
+	for rows.Next() {
+		var i ListComplianceSnapshotsRow
+		if err := rows.Scan(
+			&i.Totalrecords,
+			&i.ID,
+			&i.Scope,
+			&i.Label,
+			&i.SnapshotType,
+			&i.Status,
+			&i.CreatedBy,
+			&i.CreatedOn,
+			&i.CompletedOn,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listConcurrentUsers = `-- name: ListConcurrentUsers :many
 
 SELECT
@@ -9844,6 +10080,45 @@ func (q *Queries) UpsertDashboardUpdates(ctx context.Context, arg UpsertDashboar
 	return err
 }
 
+const upsertPeriodicComplianceSnapshot = `-- name: UpsertPeriodicComplianceSnapshot :one
+INSERT INTO compliance_snapshots (scope, snapshot_type, status, created_by, job_id, period)
+VALUES ($1, 'PERIODIC', 'PENDING', $2, $3, $4)
+ON CONFLICT (scope, period)
+DO UPDATE SET status = 'PENDING', completed_on = NULL
+WHERE compliance_snapshots.job_id = EXCLUDED.job_id
+RETURNING id, scope, label, snapshot_type, status, created_by, created_on, completed_on, job_id, period
+`
+
+type UpsertPeriodicComplianceSnapshotParams struct {
+	Scope     string        `json:"scope"`
+	CreatedBy string        `json:"created_by"`
+	JobID     sql.NullInt32 `json:"job_id"`
+	Period    sql.NullTime  `json:"period"`
+}
+
+func (q *Queries) UpsertPeriodicComplianceSnapshot(ctx context.Context, arg UpsertPeriodicComplianceSnapshotParams) (ComplianceSnapshot, error) {
+	row := q.db.QueryRowContext(ctx, upsertPeriodicComplianceSnapshot,
+		arg.Scope,
+		arg.CreatedBy,
+		arg.JobID,
+		arg.Period,
+	)
+	var i ComplianceSnapshot
+	err := row.Scan(
+		&i.ID,
+		&i.Scope,
+		&i.Label,
+		&i.SnapshotType,
+		&i.Status,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.CompletedOn,
+		&i.JobID,
+		&i.Period,
+	)
+	return i, err
+}
+
 const upsertProduct = `-- name: UpsertProduct :exec
 
 
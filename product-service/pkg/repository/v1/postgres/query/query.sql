@@ -4743,7 +4743,7 @@ WHERE
             AND acq.scope = @scope ) AS final;
 
 -- name: GetJobsInExecution :one
-SELECT count(*) FROM jobs WHERE status != 'FAILED' AND status != 'COMPLETED' and ppid = @ppid;
+SELECT count(*) FROM jobs WHERE status != 'FAILED' AND status != 'COMPLETED' AND status != 'DEAD' and ppid = @ppid;
 
 
 
@@ -4767,3 +4767,63 @@ GROUP BY ac.swidtag,
          ac.version,
          p.swid_tag_product,
          v.swid_tag_version;
+
+-- name: InsertComplianceSnapshot :one
+INSERT INTO compliance_snapshots (scope, label, snapshot_type, status, created_by)
+VALUES (@scope, @label, @snapshot_type, @status, @created_by)
+RETURNING *;
+
+-- name: UpsertPeriodicComplianceSnapshot :one
+INSERT INTO compliance_snapshots (scope, snapshot_type, status, created_by, job_id, period)
+VALUES (@scope, 'PERIODIC', 'PENDING', @created_by, @job_id, @period)
+ON CONFLICT (scope, period)
+DO UPDATE SET status = 'PENDING', completed_on = NULL
+WHERE compliance_snapshots.job_id = EXCLUDED.job_id
+RETURNING *;
+
+-- name: CopyComplianceSnapshotEntries :exec
+INSERT INTO compliance_snapshot_entries (
+    snapshot_id, scope, editor, sku, swidtags, product_names, aggregation_name, metrics,
+    num_computed_licences, num_acquired_licences, delta_number, delta_cost,
+    total_cost, purchase_cost, computed_cost
+)
+SELECT
+    @snapshot_id:: INTEGER, ocl.scope, ocl.editor, ocl.sku, ocl.swidtags, ocl.product_names, ocl.aggregation_name, ocl.metrics,
+    ocl.num_computed_licences, ocl.num_acquired_licences, ocl.delta_number, ocl.delta_cost,
+    ocl.total_cost, ocl.purchase_cost, ocl.computed_cost
+FROM overall_computed_licences ocl
+WHERE ocl.scope = @scope;
+
+-- name: DeleteComplianceSnapshotEntries :exec
+DELETE FROM compliance_snapshot_entries WHERE snapshot_id = @snapshot_id;
+
+-- name: CompleteComplianceSnapshot :exec
+UPDATE compliance_snapshots SET status = 'COMPLETED', completed_on = NOW() WHERE id = @id;
+
+-- name: FailComplianceSnapshot :exec
+UPDATE compliance_snapshots SET status = 'FAILED', completed_on = NOW() WHERE id = @id;
+
+-- name: GetComplianceSnapshot :one
+SELECT * FROM compliance_snapshots WHERE id = @id AND scope = @scope;
+
+-- name: ListComplianceSnapshots :many
+SELECT
+    count(*) OVER() AS totalRecords,
+    id, scope, label, snapshot_type, status, created_by, created_on, completed_on
+FROM compliance_snapshots
+WHERE scope = @scope
+ORDER BY created_on DESC, id DESC
+LIMIT @page_size
+OFFSET @page_num;
+
+-- name: ListComplianceSnapshotEntries :many
+SELECT * FROM compliance_snapshot_entries
+WHERE
+    snapshot_id = @snapshot_id
+    AND (
+        CASE
+            WHEN @is_editor:: bool THEN editor = @editor
+            ELSE TRUE
+        END
+    )
+ORDER BY editor, sku, swidtags;
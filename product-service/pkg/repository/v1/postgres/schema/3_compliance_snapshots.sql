@@ -0,0 +1,46 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS compliance_snapshots (
+    id SERIAL PRIMARY KEY,
+    scope VARCHAR NOT NULL,
+    label VARCHAR NOT NULL DEFAULT '',
+    snapshot_type VARCHAR NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'PENDING',
+    created_by VARCHAR NOT NULL,
+    created_on TIMESTAMP with time zone NOT NULL DEFAULT NOW(),
+    completed_on TIMESTAMP with time zone,
+    job_id INTEGER
+);
+
+CREATE INDEX IF NOT EXISTS compliance_snapshots_scope_index ON compliance_snapshots (scope, created_on);
+
+-- a run of the licence calculation cron takes one periodic snapshot of the scope, whatever its retries
+CREATE UNIQUE INDEX IF NOT EXISTS compliance_snapshots_job_index ON compliance_snapshots (scope, job_id);
+
+CREATE TABLE IF NOT EXISTS compliance_snapshot_entries (
+    snapshot_id INTEGER NOT NULL REFERENCES compliance_snapshots (id) ON DELETE CASCADE,
+    scope VARCHAR NOT NULL,
+    editor VARCHAR NOT NULL,
+    sku VARCHAR NOT NULL,
+    swidtags VARCHAR NOT NULL,
+    product_names VARCHAR NOT NULL,
+    aggregation_name VARCHAR NOT NULL DEFAULT '',
+    metrics VARCHAR NOT NULL,
+    num_computed_licences INTEGER NOT NULL DEFAULT 0,
+    num_acquired_licences INTEGER NOT NULL DEFAULT 0,
+    delta_number INTEGER NOT NULL DEFAULT 0,
+    delta_cost NUMERIC(15,2) NOT NULL DEFAULT 0,
+    total_cost NUMERIC(15,2) NOT NULL DEFAULT 0,
+    purchase_cost NUMERIC(15,2) NOT NULL DEFAULT 0,
+    computed_cost NUMERIC(15,2) NOT NULL DEFAULT 0,
+    PRIMARY KEY (snapshot_id, sku, swidtags)
+);
+
+Grant ALL ON compliance_snapshots, compliance_snapshot_entries
+TO optisam_app_user;
+
+Grant ALL ON SEQUENCE compliance_snapshots_id_seq
+TO optisam_app_user;
+
+-- +migrate Down
+DROP TABLE IF EXISTS compliance_snapshot_entries;
+DROP TABLE IF EXISTS compliance_snapshots;
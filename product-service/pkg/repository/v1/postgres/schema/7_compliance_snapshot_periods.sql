@@ -0,0 +1,13 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE compliance_snapshots ADD COLUMN IF NOT EXISTS period TIMESTAMP with time zone;
+
+-- the replicas running the licence calculation cron take one periodic snapshot of the scope per run of the cron
+DROP INDEX IF EXISTS compliance_snapshots_job_index;
+CREATE UNIQUE INDEX IF NOT EXISTS compliance_snapshots_period_index ON compliance_snapshots (scope, period);
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP INDEX IF EXISTS compliance_snapshots_period_index;
+CREATE UNIQUE INDEX IF NOT EXISTS compliance_snapshots_job_index ON compliance_snapshots (scope, job_id);
+ALTER TABLE compliance_snapshots DROP COLUMN IF EXISTS period;
@@ -15,6 +15,7 @@ import (
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/repository/v1/postgres/db"
 	dgworker "gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/worker/dgraph"
 
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/audit"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/helper"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
 	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
@@ -176,7 +177,7 @@ func (s *ProductServiceServer) UpsertAcqRights(ctx context.Context, req *v1.Upse
 		LastPurchasedOrder:        req.LastPurchasedOrder,
 		SupportNumber:             req.SupportNumber,
 		Repartition:               req.Repartition,
-	}, req.Ppid)
+	}, req.Ppid, job.PriorityNormal)
 	return &v1.UpsertAcqRightsResponse{Success: true}, nil
 }
 
@@ -371,7 +372,7 @@ func (s *ProductServiceServer) CreateAcqRight(ctx context.Context, req *v1.AcqRi
 	}
 
 	// For Worker Queue
-	s.pushUpsertAcqrightsWorkerJob(ctx, *upsertAcqRight, "")
+	s.pushUpsertAcqrightsWorkerJob(ctx, *upsertAcqRight, "", job.PriorityHigh)
 	return &v1.AcqRightResponse{
 		Success: true,
 	}, nil
@@ -398,6 +399,7 @@ func (s *ProductServiceServer) UpdateAcqRight(ctx context.Context, req *v1.AcqRi
 		logger.Log.Error("service/v1 - CreateAcqRight - GetAcqRightBySKU", zap.String("reason", err.Error()))
 		return &v1.AcqRightResponse{}, status.Error(codes.Internal, "DBError")
 	}
+	audit.RecordPrevious(ctx, acqRightRequestFromRow(dbresp, req))
 	resp, err := s.GetAvailableLicenses(ctx, &v1.GetAvailableLicensesRequest{Sku: req.Sku, Scope: req.Scope})
 	if err != nil {
 		logger.Log.Error("service/v1 - UpdateAcqrightsSharedLicenses - GetAvailableLicenses", zap.String("reason", err.Error()))
@@ -423,12 +425,62 @@ func (s *ProductServiceServer) UpdateAcqRight(ctx context.Context, req *v1.AcqRi
 		upsertAcqRight.IsMetricModifed = true
 	}
 	// For Worker Queue
-	s.pushUpsertAcqrightsWorkerJob(ctx, *upsertAcqRight, "")
+	s.pushUpsertAcqrightsWorkerJob(ctx, *upsertAcqRight, "", job.PriorityHigh)
 	return &v1.AcqRightResponse{
 		Success: true,
 	}, nil
 }
 
+// acqRightRequestFromRow returns the request which would give the stored acquired right,
+// it is the previous state of acquired rights in the audit trail. Dates are formatted like
+// the dates of req so that unchanged dates give no change.
+func acqRightRequestFromRow(row db.GetAcqRightBySKURow, req *v1.AcqRightRequest) *v1.AcqRightRequest {
+	prev := &v1.AcqRightRequest{
+		Sku:                       row.Sku,
+		ProductName:               row.ProductName,
+		Version:                   row.Version,
+		ProductEditor:             row.ProductEditor,
+		MetricName:                row.Metric,
+		NumLicensesAcquired:       row.NumLicensesAcquired,
+		StartOfMaintenance:        auditDate(row.StartOfMaintenance, req.GetStartOfMaintenance()),
+		EndOfMaintenance:          auditDate(row.EndOfMaintenance, req.GetEndOfMaintenance()),
+		NumLicencesMaintainance:   row.NumLicencesMaintainance,
+		LastPurchasedOrder:        row.LastPurchasedOrder,
+		SupportNumber:             strings.Join(row.SupportNumbers, ","),
+		MaintenanceProvider:       row.MaintenanceProvider,
+		Scope:                     req.GetScope(),
+		Comment:                   row.Comment.String,
+		OrderingDate:              auditDate(row.OrderingDate, req.GetOrderingDate()),
+		CorporateSourcingContract: row.CorporateSourcingContract,
+		SoftwareProvider:          row.SoftwareProvider,
+		FileName:                  row.FileName,
+		FileData:                  row.FileData,
+		Repartition:               row.Repartition,
+	}
+	prev.AvgUnitPrice, _ = row.AvgUnitPrice.Float64()
+	prev.AvgMaintenanceUnitPrice, _ = row.AvgMaintenanceUnitPrice.Float64()
+	return prev
+}
+
+// auditDate formats the stored date in the layout of the request date, it returns the request
+// date itself when both are the same day and time.
+func auditDate(t sql.NullTime, reqDate string) string {
+	if !t.Valid {
+		return ""
+	}
+	for _, layout := range []string{"1/2/06", "02/01/2006", "02-01-2006", time.RFC3339Nano} {
+		d, err := time.Parse(layout, reqDate)
+		if err != nil {
+			continue
+		}
+		if d.Equal(t.Time) {
+			return reqDate
+		}
+		return t.Time.Format(layout)
+	}
+	return t.Time.Format(time.RFC3339Nano)
+}
+
 func (s *ProductServiceServer) DeleteAcqRight(ctx context.Context, req *v1.DeleteAcqRightRequest) (*v1.DeleteAcqRightResponse, error) {
 	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
 	if !ok {
@@ -437,6 +489,19 @@ func (s *ProductServiceServer) DeleteAcqRight(ctx context.Context, req *v1.Delet
 	if !helper.Contains(userClaims.Socpes, req.Scope) {
 		return &v1.DeleteAcqRightResponse{Success: false}, status.Error(codes.PermissionDenied, "ScopeValidationError")
 	}
+	if audit.Recording(ctx) {
+		dbresp, err := s.ProductRepo.GetAcqRightBySKU(ctx, db.GetAcqRightBySKUParams{
+			AcqrightSku: req.Sku,
+			Scope:       req.Scope,
+		})
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			logger.Log.Error("service/v1 - DeleteAcqRight - GetAcqRightBySKU", zap.String("reason", err.Error()))
+			return &v1.DeleteAcqRightResponse{Success: false}, status.Error(codes.Internal, "DBError")
+		}
+		if err == nil {
+			audit.RecordPrevious(ctx, acqRightRequestFromRow(dbresp, &v1.AcqRightRequest{Scope: req.Scope}))
+		}
+	}
 	if err := s.ProductRepo.DeleteSharedLicences(ctx, db.DeleteSharedLicencesParams{
 		Sku:   req.Sku,
 		Scope: req.Scope,
@@ -465,9 +530,10 @@ func (s *ProductServiceServer) DeleteAcqRight(ctx context.Context, req *v1.Delet
 	}
 
 	_, err = s.queue.PushJob(ctx, job.Job{
-		Type:   sql.NullString{String: "aw"},
-		Status: job.JobStatusPENDING,
-		Data:   envolveData,
+		Type:     sql.NullString{String: "aw"},
+		Status:   job.JobStatusPENDING,
+		Data:     envolveData,
+		Priority: job.PriorityHigh,
 	}, "aw")
 	if err != nil {
 		logger.Log.Error("Failed to push job to the queue", zap.Error(err))
@@ -914,57 +980,57 @@ func (s *ProductServiceServer) validateAcqRight(ctx context.Context, req *v1.Acq
 	}
 	totalMaintenanceCost = req.AvgMaintenanceUnitPrice * float64(req.NumLicencesMaintainance)
 	return db.UpsertAcqRightsParams{
-			Sku:                       req.Sku,
-			Swidtag:                   swidtag,
-			ProductName:               req.ProductName,
-			ProductEditor:             req.ProductEditor,
-			Scope:                     req.Scope,
-			Metric:                    req.MetricName,
-			NumLicensesAcquired:       req.NumLicensesAcquired,
-			AvgUnitPrice:              decimal.NewFromFloat(req.AvgUnitPrice),
-			AvgMaintenanceUnitPrice:   decimal.NewFromFloat(req.AvgMaintenanceUnitPrice),
-			TotalPurchaseCost:         decimal.NewFromFloat(totalPurchaseCost),
-			TotalMaintenanceCost:      decimal.NewFromFloat(totalMaintenanceCost),
-			TotalCost:                 decimal.NewFromFloat(totalPurchaseCost + totalMaintenanceCost),
-			StartOfMaintenance:        startOfMaintenance,
-			EndOfMaintenance:          endOfMaintenance,
-			NumLicencesMaintainance:   req.NumLicencesMaintainance,
-			Version:                   req.Version,
-			Comment:                   sql.NullString{String: req.Comment, Valid: true},
-			OrderingDate:              orderingDate,
-			CorporateSourcingContract: req.CorporateSourcingContract,
-			SoftwareProvider:          req.SoftwareProvider,
-			LastPurchasedOrder:        req.LastPurchasedOrder,
-			SupportNumbers:            strings.Split(req.SupportNumber, ","),
-			MaintenanceProvider:       req.MaintenanceProvider,
-			FileName:                  req.FileName,
-			FileData:                  req.FileData,
-			Repartition:               req.Repartition,
-		}, &dgworker.UpsertAcqRightsRequest{
-			Sku:                       req.Sku,
-			Swidtag:                   swidtag,
-			ProductName:               req.ProductName,
-			ProductEditor:             req.ProductEditor,
-			MetricType:                req.MetricName,
-			NumLicensesAcquired:       req.NumLicensesAcquired,
-			AvgUnitPrice:              req.AvgUnitPrice,
-			AvgMaintenanceUnitPrice:   req.AvgMaintenanceUnitPrice,
-			TotalPurchaseCost:         totalPurchaseCost,
-			TotalMaintenanceCost:      totalMaintenanceCost,
-			TotalCost:                 (totalPurchaseCost + totalMaintenanceCost),
-			Scope:                     req.Scope,
-			StartOfMaintenance:        req.StartOfMaintenance,
-			EndOfMaintenance:          req.EndOfMaintenance,
-			NumLicencesMaintenance:    req.NumLicencesMaintainance,
-			Version:                   req.Version,
-			OrderingDate:              req.OrderingDate,
-			CorporateSourcingContract: req.CorporateSourcingContract,
-			SoftwareProvider:          req.SoftwareProvider,
-			LastPurchasedOrder:        req.LastPurchasedOrder,
-			SupportNumber:             req.SupportNumber,
-			MaintenanceProvider:       req.MaintenanceProvider,
-			Repartition:               req.Repartition,
-		}, nil
+		Sku:                       req.Sku,
+		Swidtag:                   swidtag,
+		ProductName:               req.ProductName,
+		ProductEditor:             req.ProductEditor,
+		Scope:                     req.Scope,
+		Metric:                    req.MetricName,
+		NumLicensesAcquired:       req.NumLicensesAcquired,
+		AvgUnitPrice:              decimal.NewFromFloat(req.AvgUnitPrice),
+		AvgMaintenanceUnitPrice:   decimal.NewFromFloat(req.AvgMaintenanceUnitPrice),
+		TotalPurchaseCost:         decimal.NewFromFloat(totalPurchaseCost),
+		TotalMaintenanceCost:      decimal.NewFromFloat(totalMaintenanceCost),
+		TotalCost:                 decimal.NewFromFloat(totalPurchaseCost + totalMaintenanceCost),
+		StartOfMaintenance:        startOfMaintenance,
+		EndOfMaintenance:          endOfMaintenance,
+		NumLicencesMaintainance:   req.NumLicencesMaintainance,
+		Version:                   req.Version,
+		Comment:                   sql.NullString{String: req.Comment, Valid: true},
+		OrderingDate:              orderingDate,
+		CorporateSourcingContract: req.CorporateSourcingContract,
+		SoftwareProvider:          req.SoftwareProvider,
+		LastPurchasedOrder:        req.LastPurchasedOrder,
+		SupportNumbers:            strings.Split(req.SupportNumber, ","),
+		MaintenanceProvider:       req.MaintenanceProvider,
+		FileName:                  req.FileName,
+		FileData:                  req.FileData,
+		Repartition:               req.Repartition,
+	}, &dgworker.UpsertAcqRightsRequest{
+		Sku:                       req.Sku,
+		Swidtag:                   swidtag,
+		ProductName:               req.ProductName,
+		ProductEditor:             req.ProductEditor,
+		MetricType:                req.MetricName,
+		NumLicensesAcquired:       req.NumLicensesAcquired,
+		AvgUnitPrice:              req.AvgUnitPrice,
+		AvgMaintenanceUnitPrice:   req.AvgMaintenanceUnitPrice,
+		TotalPurchaseCost:         totalPurchaseCost,
+		TotalMaintenanceCost:      totalMaintenanceCost,
+		TotalCost:                 (totalPurchaseCost + totalMaintenanceCost),
+		Scope:                     req.Scope,
+		StartOfMaintenance:        req.StartOfMaintenance,
+		EndOfMaintenance:          req.EndOfMaintenance,
+		NumLicencesMaintenance:    req.NumLicencesMaintainance,
+		Version:                   req.Version,
+		OrderingDate:              req.OrderingDate,
+		CorporateSourcingContract: req.CorporateSourcingContract,
+		SoftwareProvider:          req.SoftwareProvider,
+		LastPurchasedOrder:        req.LastPurchasedOrder,
+		SupportNumber:             req.SupportNumber,
+		MaintenanceProvider:       req.MaintenanceProvider,
+		Repartition:               req.Repartition,
+	}, nil
 }
 
 func removeSpecialChars(str string) string {
@@ -981,7 +1047,9 @@ func metricExists(metrics []*metv1.Metric, name string) int {
 	return -1
 }
 
-func (s *ProductServiceServer) pushUpsertAcqrightsWorkerJob(ctx context.Context, req dgworker.UpsertAcqRightsRequest, ppid string) {
+// pushUpsertAcqrightsWorkerJob pushes the dgraph upsert of an acqright, single record changes are pushed with high priority
+// to be worked before the jobs of bulk uploads
+func (s *ProductServiceServer) pushUpsertAcqrightsWorkerJob(ctx context.Context, req dgworker.UpsertAcqRightsRequest, ppid string, priority job.Priority) {
 	jsonData, err := json.Marshal(req)
 	if err != nil {
 		logger.Log.Error("Failed to do json marshalling", zap.Error(err))
@@ -994,10 +1062,11 @@ func (s *ProductServiceServer) pushUpsertAcqrightsWorkerJob(ctx context.Context,
 	}
 	// log.Println(string(envolveData))
 	jobID, err := s.queue.PushJob(ctx, job.Job{
-		Type:   sql.NullString{String: "aw"},
-		Status: job.JobStatusPENDING,
-		Data:   envolveData,
-		PPID:   ppid,
+		Type:     sql.NullString{String: "aw"},
+		Status:   job.JobStatusPENDING,
+		Data:     envolveData,
+		PPID:     ppid,
+		Priority: priority,
 	}, "aw")
 	if err != nil {
 		logger.Log.Error("Failed to push job to the queue", zap.Error(err))
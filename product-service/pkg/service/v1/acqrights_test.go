@@ -21,6 +21,7 @@ import (
 	queuemock "gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/repository/v1/queuemock"
 	dgworker "gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/worker/dgraph"
 
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/audit"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
 	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
@@ -32,6 +33,7 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 )
 
 func getJob(input interface{}, jtype dgworker.MessageType) (json.RawMessage, error) {
@@ -2128,9 +2130,10 @@ func Test_ProductServiceServer_CreateAcqRight(t *testing.T) {
 					t.Errorf("Failed to do json marshalling in test  %v", err)
 				}
 				mockQueue.EXPECT().PushJob(ctx, job.Job{
-					Type:   sql.NullString{String: "aw"},
-					Status: job.JobStatusPENDING,
-					Data:   envelopeData,
+					Type:     sql.NullString{String: "aw"},
+					Status:   job.JobStatusPENDING,
+					Data:     envelopeData,
+					Priority: job.PriorityHigh,
 				}, "aw").Times(1).Return(int32(1000), nil)
 			},
 			want: &v1.AcqRightResponse{
@@ -2245,9 +2248,10 @@ func Test_ProductServiceServer_CreateAcqRight(t *testing.T) {
 					t.Errorf("Failed to do json marshalling in test  %v", err)
 				}
 				mockQueue.EXPECT().PushJob(ctx, job.Job{
-					Type:   sql.NullString{String: "aw"},
-					Status: job.JobStatusPENDING,
-					Data:   envelopeData,
+					Type:     sql.NullString{String: "aw"},
+					Status:   job.JobStatusPENDING,
+					Data:     envelopeData,
+					Priority: job.PriorityHigh,
 				}, "aw").Times(1).Return(int32(1000), nil)
 			},
 			want: &v1.AcqRightResponse{
@@ -3812,9 +3816,10 @@ func Test_ProductServiceServer_DeleteAcqRight(t *testing.T) {
 					t.Errorf("Failed to do json marshalling in test  %v", err)
 				}
 				mockQueue.EXPECT().PushJob(ctx, job.Job{
-					Type:   sql.NullString{String: "aw"},
-					Status: job.JobStatusPENDING,
-					Data:   envelopeData,
+					Type:     sql.NullString{String: "aw"},
+					Status:   job.JobStatusPENDING,
+					Data:     envelopeData,
+					Priority: job.PriorityHigh,
 				}, "aw").Times(1).Return(int32(1000), nil)
 			},
 			want: &v1.DeleteAcqRightResponse{
@@ -3923,6 +3928,65 @@ func Test_ProductServiceServer_DeleteAcqRight(t *testing.T) {
 	}
 }
 
+func Test_ProductServiceServer_DeleteAcqRight_audit(t *testing.T) {
+	ctx := audit.NewContext(grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"scope1"},
+	}))
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockRepo := dbmock.NewMockProduct(mockCtrl)
+	mockQueue := queuemock.NewMockWorkerqueue(mockCtrl)
+	mockRepo.EXPECT().GetAcqRightBySKU(ctx, db.GetAcqRightBySKUParams{AcqrightSku: "sku1", Scope: "scope1"}).Times(1).Return(db.GetAcqRightBySKURow{
+		Sku:                 "sku1",
+		Metric:              "ops",
+		NumLicensesAcquired: 10,
+		OrderingDate:        sql.NullTime{Time: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+	}, nil)
+	mockRepo.EXPECT().DeleteSharedLicences(ctx, db.DeleteSharedLicencesParams{Sku: "sku1", Scope: "scope1"}).Times(1).Return(nil)
+	mockRepo.EXPECT().DeleteAcqrightBySKU(ctx, db.DeleteAcqrightBySKUParams{Sku: "sku1", Scope: "scope1"}).Times(1).Return(nil)
+	mockQueue.EXPECT().PushJob(ctx, gomock.Any(), "aw").Times(1).Return(int32(1000), nil)
+	s := &ProductServiceServer{ProductRepo: mockRepo, queue: mockQueue}
+	if _, err := s.DeleteAcqRight(ctx, &v1.DeleteAcqRightRequest{Sku: "sku1", Scope: "scope1"}); err != nil {
+		t.Fatalf("ProductServiceServer.DeleteAcqRight() error = %v", err)
+	}
+	want := &v1.AcqRightRequest{
+		Sku:                 "sku1",
+		MetricName:          "ops",
+		NumLicensesAcquired: 10,
+		Scope:               "scope1",
+		OrderingDate:        "2022-03-01T00:00:00Z",
+	}
+	if got := audit.Previous(ctx); !proto.Equal(got.(*v1.AcqRightRequest), want) {
+		t.Errorf("ProductServiceServer.DeleteAcqRight() previous = %v, want %v", got, want)
+	}
+}
+
+func Test_auditDate(t *testing.T) {
+	stored := sql.NullTime{Time: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+	tests := []struct {
+		name    string
+		stored  sql.NullTime
+		reqDate string
+		want    string
+	}{
+		{name: "no stored date", reqDate: "01-03-2022", want: ""},
+		{name: "same date", stored: stored, reqDate: "01-03-2022", want: "01-03-2022"},
+		{name: "same date short layout", stored: stored, reqDate: "3/1/22", want: "3/1/22"},
+		{name: "other date in the layout of the request", stored: stored, reqDate: "02-03-2022", want: "01-03-2022"},
+		{name: "rfc3339 request", stored: stored, reqDate: "2022-03-01T00:00:00.000Z", want: "2022-03-01T00:00:00.000Z"},
+		{name: "no request date", stored: stored, want: "2022-03-01T00:00:00Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := auditDate(tt.stored, tt.reqDate); got != tt.want {
+				t.Errorf("auditDate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_ProductServiceServer_DownloadAcqRightFile(t *testing.T) {
 	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
 		UserID: "admin@superuser.com",
@@ -14,6 +14,7 @@ import (
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/repository/v1/postgres/db"
 	dgworker "gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/worker/dgraph"
 
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/audit"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/helper"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
 	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
@@ -241,6 +242,7 @@ func (s *ProductServiceServer) UpdateAggregatedRights(ctx context.Context, req *
 	if aggRight.Sku != req.Sku {
 		return &v1.AggregatedRightsResponse{}, status.Error(codes.InvalidArgument, "sku cannot be updated")
 	}
+	audit.RecordPrevious(ctx, aggregatedRightRequestFromRow(aggRight, req))
 	resp, err := s.GetAvailableLicenses(ctx, &v1.GetAvailableLicensesRequest{Sku: req.Sku, Scope: req.Scope})
 	if err != nil {
 		logger.Log.Error("service/v1 - UpdateAggregatedRights - GetAvailableLicenses", zap.String("reason", err.Error()))
@@ -265,6 +267,32 @@ func (s *ProductServiceServer) UpdateAggregatedRights(ctx context.Context, req *
 	return &v1.AggregatedRightsResponse{Success: true}, nil
 }
 
+// aggregatedRightRequestFromRow returns the request which would give the stored aggregated right,
+// it is the previous state of aggregated rights in the audit trail.
+func aggregatedRightRequestFromRow(row db.GetAggregatedRightBySKURow, req *v1.AggregatedRightsRequest) *v1.AggregatedRightsRequest {
+	prev := &v1.AggregatedRightsRequest{
+		Sku:                       row.Sku,
+		AggregationID:             row.AggregationID,
+		MetricName:                row.Metric,
+		NumLicensesAcquired:       row.NumLicensesAcquired,
+		StartOfMaintenance:        auditDate(row.StartOfMaintenance, req.GetStartOfMaintenance()),
+		EndOfMaintenance:          auditDate(row.EndOfMaintenance, req.GetEndOfMaintenance()),
+		LastPurchasedOrder:        row.LastPurchasedOrder,
+		SupportNumber:             strings.Join(row.SupportNumbers, ","),
+		MaintenanceProvider:       row.MaintenanceProvider,
+		NumLicencesMaintenance:    row.NumLicencesMaintenance,
+		Scope:                     req.GetScope(),
+		Comment:                   row.Comment.String,
+		OrderingDate:              auditDate(row.OrderingDate, req.GetOrderingDate()),
+		CorporateSourcingContract: row.CorporateSourcingContract,
+		SoftwareProvider:          row.SoftwareProvider,
+		FileName:                  row.FileName,
+	}
+	prev.AvgUnitPrice, _ = row.AvgUnitPrice.Float64()
+	prev.AvgMaintenanceUnitPrice, _ = row.AvgMaintenanceUnitPrice.Float64()
+	return prev
+}
+
 func (s *ProductServiceServer) UpdateAggrightsSharedLicenses(ctx context.Context, req *v1.UpdateAggrightsSharedLicensesRequest) (*v1.UpdateSharedLicensesResponse, error) {
 	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
 	if !ok {
@@ -547,6 +575,19 @@ func (s *ProductServiceServer) DeleteAggregatedRights(ctx context.Context, req *
 	if !helper.Contains(userClaims.Socpes, req.Scope) {
 		return &v1.DeleteAggregatedRightsResponse{Success: false}, status.Error(codes.PermissionDenied, "ScopeValidationError")
 	}
+	if audit.Recording(ctx) {
+		aggRight, err := s.ProductRepo.GetAggregatedRightBySKU(ctx, db.GetAggregatedRightBySKUParams{
+			Sku:   req.Sku,
+			Scope: req.Scope,
+		})
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			logger.Log.Error("service/v1 - DeleteAggregatedRights - GetAggregatedRightBySKU", zap.String("reason", err.Error()))
+			return &v1.DeleteAggregatedRightsResponse{Success: false}, status.Error(codes.Internal, "DBError")
+		}
+		if err == nil {
+			audit.RecordPrevious(ctx, aggregatedRightRequestFromRow(aggRight, &v1.AggregatedRightsRequest{Scope: req.Scope}))
+		}
+	}
 	if err := s.ProductRepo.DeleteAggregatedRightBySKU(ctx, db.DeleteAggregatedRightBySKUParams{
 		Sku:   req.Sku,
 		Scope: req.Scope,
@@ -0,0 +1,261 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/repository/v1/postgres/db"
+	licenseworker "gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/worker/license_calculator"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/helper"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/job"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// statuses of the licences in a diff of compliance snapshots
+const (
+	diffAdded     = "ADDED"
+	diffRemoved   = "REMOVED"
+	diffChanged   = "CHANGED"
+	diffUnchanged = "UNCHANGED"
+)
+
+// CreateComplianceSnapshot creates a pending snapshot and pushes the licence calculation job completing it
+func (s *ProductServiceServer) CreateComplianceSnapshot(ctx context.Context, req *v1.CreateComplianceSnapshotRequest) (*v1.ComplianceSnapshot, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFoundError")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		logger.Log.Error("Permission Error", zap.Any("Scopes", userClaims.Socpes), zap.String("Requested Scope", req.GetScope()))
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	snapshot, err := s.ProductRepo.InsertComplianceSnapshot(ctx, db.InsertComplianceSnapshotParams{
+		Scope:        req.Scope,
+		Label:        req.Label,
+		SnapshotType: licenseworker.SnapshotOnDemand,
+		Status:       licenseworker.SnapshotPending,
+		CreatedBy:    userClaims.UserID,
+	})
+	if err != nil {
+		logger.Log.Error("service/v1 - CreateComplianceSnapshot - InsertComplianceSnapshot", zap.Error(err))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	data, err := json.Marshal(licenseworker.DataUpdateWorker{
+		UpdatedBy:  userClaims.UserID,
+		Scope:      req.Scope,
+		SnapshotID: snapshot.ID,
+	})
+	if err != nil {
+		logger.Log.Error("service/v1 - CreateComplianceSnapshot - Marshal", zap.Error(err))
+		return nil, status.Error(codes.Internal, "MarshallingError")
+	}
+	if _, err := s.queue.PushJob(ctx, job.Job{
+		Type:   sql.NullString{String: "lcalw"},
+		Status: job.JobStatusPENDING,
+		Data:   data,
+	}, "lcalw"); err != nil {
+		logger.Log.Error("service/v1 - CreateComplianceSnapshot - PushJob", zap.Error(err), zap.Int32("snapshot", snapshot.ID))
+		return nil, status.Error(codes.Internal, "PushJobFailure")
+	}
+	return complianceSnapshot(snapshot), nil
+}
+
+// ListComplianceSnapshots lists the snapshots of the scope, latest first
+func (s *ProductServiceServer) ListComplianceSnapshots(ctx context.Context, req *v1.ListComplianceSnapshotsRequest) (*v1.ListComplianceSnapshotsResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFoundError")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		logger.Log.Error("Permission Error", zap.Any("Scopes", userClaims.Socpes), zap.String("Requested Scope", req.GetScope()))
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	dbresp, err := s.ProductRepo.ListComplianceSnapshots(ctx, db.ListComplianceSnapshotsParams{
+		Scope:    req.Scope,
+		PageSize: req.GetPageSize(),
+		PageNum:  req.GetPageSize() * (req.GetPageNum() - 1),
+	})
+	if err != nil {
+		logger.Log.Error("service/v1 - ListComplianceSnapshots - ListComplianceSnapshots", zap.Error(err))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	resp := &v1.ListComplianceSnapshotsResponse{
+		Snapshots: make([]*v1.ComplianceSnapshot, len(dbresp)),
+	}
+	if len(dbresp) > 0 {
+		resp.TotalRecords = int32(dbresp[0].Totalrecords)
+	}
+	for i := range dbresp {
+		resp.Snapshots[i] = complianceSnapshot(db.ComplianceSnapshot{
+			ID:           dbresp[i].ID,
+			Scope:        dbresp[i].Scope,
+			Label:        dbresp[i].Label,
+			SnapshotType: dbresp[i].SnapshotType,
+			Status:       dbresp[i].Status,
+			CreatedBy:    dbresp[i].CreatedBy,
+			CreatedOn:    dbresp[i].CreatedOn,
+			CompletedOn:  dbresp[i].CompletedOn,
+		})
+	}
+	return resp, nil
+}
+
+// DiffComplianceSnapshots compares the licences of two completed snapshots of the scope
+func (s *ProductServiceServer) DiffComplianceSnapshots(ctx context.Context, req *v1.DiffComplianceSnapshotsRequest) (*v1.DiffComplianceSnapshotsResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFoundError")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		logger.Log.Error("Permission Error", zap.Any("Scopes", userClaims.Socpes), zap.String("Requested Scope", req.GetScope()))
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	from, fromEntries, err := s.snapshotEntries(ctx, req.Scope, req.FromSnapshotId, req.Editor)
+	if err != nil {
+		return nil, err
+	}
+	to, toEntries, err := s.snapshotEntries(ctx, req.Scope, req.ToSnapshotId, req.Editor)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.DiffComplianceSnapshotsResponse{
+		From:  complianceSnapshot(from),
+		To:    complianceSnapshot(to),
+		Diffs: diffComplianceEntries(fromEntries, toEntries, req.IncludeUnchanged),
+	}, nil
+}
+
+func (s *ProductServiceServer) snapshotEntries(ctx context.Context, scope string, id int32, editor string) (db.ComplianceSnapshot, []db.ComplianceSnapshotEntry, error) {
+	snapshot, err := s.ProductRepo.GetComplianceSnapshot(ctx, db.GetComplianceSnapshotParams{
+		ID:    id,
+		Scope: scope,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return snapshot, nil, status.Error(codes.NotFound, "SnapshotNotFound")
+		}
+		logger.Log.Error("service/v1 - DiffComplianceSnapshots - GetComplianceSnapshot", zap.Error(err), zap.Int32("snapshot", id))
+		return snapshot, nil, status.Error(codes.Internal, "DBError")
+	}
+	if snapshot.Status != licenseworker.SnapshotCompleted {
+		return snapshot, nil, status.Error(codes.FailedPrecondition, "SnapshotNotCompleted")
+	}
+	entries, err := s.ProductRepo.ListComplianceSnapshotEntries(ctx, db.ListComplianceSnapshotEntriesParams{
+		SnapshotID: id,
+		IsEditor:   editor != "",
+		Editor:     editor,
+	})
+	if err != nil {
+		logger.Log.Error("service/v1 - DiffComplianceSnapshots - ListComplianceSnapshotEntries", zap.Error(err), zap.Int32("snapshot", id))
+		return snapshot, nil, status.Error(codes.Internal, "DBError")
+	}
+	return snapshot, entries, nil
+}
+
+// diffComplianceEntries matches the licences of both snapshots by sku and products,
+// the licences of the second snapshot come first followed by the removed ones.
+func diffComplianceEntries(from, to []db.ComplianceSnapshotEntry, includeUnchanged bool) []*v1.ComplianceDiff {
+	type key struct{ sku, swidtags string }
+	previous := make(map[key]db.ComplianceSnapshotEntry, len(from))
+	for _, e := range from {
+		previous[key{e.Sku, e.Swidtags}] = e
+	}
+	diffs := []*v1.ComplianceDiff{}
+	for _, e := range to {
+		k := key{e.Sku, e.Swidtags}
+		d := complianceDiff(e)
+		d.To = complianceValues(e)
+		d.DeltaNumberChange = d.To.DeltaNumber
+		d.DeltaCostChange = d.To.DeltaCost
+		d.Status = diffAdded
+		if old, ok := previous[k]; ok {
+			delete(previous, k)
+			d.From = complianceValues(old)
+			d.DeltaNumberChange -= d.From.DeltaNumber
+			d.DeltaCostChange = helper.ToFixed(d.DeltaCostChange-d.From.DeltaCost, 2)
+			d.Status = diffChanged
+			if sameCompliance(old, e) {
+				if !includeUnchanged {
+					continue
+				}
+				d.Status = diffUnchanged
+			}
+		}
+		diffs = append(diffs, d)
+	}
+	for _, e := range from {
+		if _, ok := previous[key{e.Sku, e.Swidtags}]; !ok {
+			continue
+		}
+		d := complianceDiff(e)
+		d.From = complianceValues(e)
+		d.DeltaNumberChange = -d.From.DeltaNumber
+		d.DeltaCostChange = -d.From.DeltaCost
+		d.Status = diffRemoved
+		diffs = append(diffs, d)
+	}
+	return diffs
+}
+
+func sameCompliance(a, b db.ComplianceSnapshotEntry) bool {
+	return a.NumComputedLicences == b.NumComputedLicences &&
+		a.NumAcquiredLicences == b.NumAcquiredLicences &&
+		a.DeltaNumber == b.DeltaNumber &&
+		a.DeltaCost.Equal(b.DeltaCost) &&
+		a.TotalCost.Equal(b.TotalCost) &&
+		a.PurchaseCost.Equal(b.PurchaseCost) &&
+		a.ComputedCost.Equal(b.ComputedCost)
+}
+
+func complianceDiff(e db.ComplianceSnapshotEntry) *v1.ComplianceDiff {
+	return &v1.ComplianceDiff{
+		Editor:          e.Editor,
+		Sku:             e.Sku,
+		Swidtags:        e.Swidtags,
+		ProductNames:    e.ProductNames,
+		AggregationName: e.AggregationName,
+		Metrics:         e.Metrics,
+	}
+}
+
+func complianceValues(e db.ComplianceSnapshotEntry) *v1.ComplianceValues {
+	deltaCost, _ := e.DeltaCost.Float64()
+	totalCost, _ := e.TotalCost.Float64()
+	purchaseCost, _ := e.PurchaseCost.Float64()
+	computedCost, _ := e.ComputedCost.Float64()
+	return &v1.ComplianceValues{
+		NumComputedLicences: e.NumComputedLicences,
+		NumAcquiredLicences: e.NumAcquiredLicences,
+		DeltaNumber:         e.DeltaNumber,
+		DeltaCost:           deltaCost,
+		TotalCost:           totalCost,
+		PurchaseCost:        purchaseCost,
+		ComputedCost:        computedCost,
+	}
+}
+
+func complianceSnapshot(snapshot db.ComplianceSnapshot) *v1.ComplianceSnapshot {
+	resp := &v1.ComplianceSnapshot{
+		Id:           snapshot.ID,
+		Scope:        snapshot.Scope,
+		Label:        snapshot.Label,
+		SnapshotType: snapshot.SnapshotType,
+		Status:       snapshot.Status,
+		CreatedBy:    snapshot.CreatedBy,
+		CreatedOn:    timestamppb.New(snapshot.CreatedOn),
+	}
+	if snapshot.CompletedOn.Valid {
+		resp.CompletedOn = timestamppb.New(snapshot.CompletedOn.Time)
+	}
+	return resp
+}
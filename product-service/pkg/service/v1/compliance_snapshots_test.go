@@ -0,0 +1,361 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/config"
+	repo "gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/repository/v1"
+	dbmock "gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/repository/v1/dbmock"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/repository/v1/postgres/db"
+	queuemock "gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/repository/v1/queuemock"
+
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/job"
+
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_CreateComplianceSnapshot(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"Scope1", "Scope2"},
+	})
+	createdOn := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	var mockCtrl *gomock.Controller
+	var rep repo.Product
+	var queue workerqueue.Workerqueue
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		req      *v1.CreateComplianceSnapshotRequest
+		setup    func()
+		want     *v1.ComplianceSnapshot
+		wantCode codes.Code
+	}{
+		{name: "SUCCESS",
+			ctx: ctx,
+			req: &v1.CreateComplianceSnapshotRequest{Scope: "Scope1", Label: "before audit"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockProduct(mockCtrl)
+				mockQueue := queuemock.NewMockWorkerqueue(mockCtrl)
+				rep = mockRepository
+				queue = mockQueue
+				mockRepository.EXPECT().InsertComplianceSnapshot(ctx, db.InsertComplianceSnapshotParams{
+					Scope:        "Scope1",
+					Label:        "before audit",
+					SnapshotType: "ON_DEMAND",
+					Status:       "PENDING",
+					CreatedBy:    "admin@superuser.com",
+				}).Times(1).Return(db.ComplianceSnapshot{
+					ID:           3,
+					Scope:        "Scope1",
+					Label:        "before audit",
+					SnapshotType: "ON_DEMAND",
+					Status:       "PENDING",
+					CreatedBy:    "admin@superuser.com",
+					CreatedOn:    createdOn,
+				}, nil)
+				mockQueue.EXPECT().PushJob(ctx, job.Job{
+					Type:   sql.NullString{String: "lcalw"},
+					Status: job.JobStatusPENDING,
+					Data:   []byte(`{"updatedBy":"admin@superuser.com","scope":"Scope1","snapshotId":3}`),
+				}, "lcalw").Times(1).Return(int32(1), nil)
+			},
+			want: &v1.ComplianceSnapshot{
+				Id:           3,
+				Scope:        "Scope1",
+				Label:        "before audit",
+				SnapshotType: "ON_DEMAND",
+				Status:       "PENDING",
+				CreatedBy:    "admin@superuser.com",
+				CreatedOn:    timestamppb.New(createdOn),
+			},
+		},
+		{name: "FAILURE - PushJob",
+			ctx: ctx,
+			req: &v1.CreateComplianceSnapshotRequest{Scope: "Scope1"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockProduct(mockCtrl)
+				mockQueue := queuemock.NewMockWorkerqueue(mockCtrl)
+				rep = mockRepository
+				queue = mockQueue
+				mockRepository.EXPECT().InsertComplianceSnapshot(ctx, gomock.Any()).Times(1).Return(db.ComplianceSnapshot{ID: 4}, nil)
+				mockQueue.EXPECT().PushJob(ctx, gomock.Any(), "lcalw").Times(1).Return(int32(0), errors.New("queue error"))
+			},
+			wantCode: codes.Internal,
+		},
+		{name: "FAILURE - scope not found",
+			ctx: ctx,
+			req: &v1.CreateComplianceSnapshotRequest{Scope: "Scope3"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = dbmock.NewMockProduct(mockCtrl)
+				queue = queuemock.NewMockWorkerqueue(mockCtrl)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{name: "FAILURE - claims not found",
+			ctx: context.Background(),
+			req: &v1.CreateComplianceSnapshotRequest{Scope: "Scope1"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = dbmock.NewMockProduct(mockCtrl)
+				queue = queuemock.NewMockWorkerqueue(mockCtrl)
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			s := NewProductServiceServer(rep, queue, nil, "", nil, nil, &config.Config{})
+			got, err := s.CreateComplianceSnapshot(tt.ctx, tt.req)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			if assert.Empty(t, err) {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_ListComplianceSnapshots(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "user@test.com",
+		Role:   "User",
+		Socpes: []string{"Scope1"},
+	})
+	createdOn := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	completedOn := createdOn.Add(time.Minute)
+	var mockCtrl *gomock.Controller
+	var rep repo.Product
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		req      *v1.ListComplianceSnapshotsRequest
+		setup    func()
+		want     *v1.ListComplianceSnapshotsResponse
+		wantCode codes.Code
+	}{
+		{name: "SUCCESS",
+			ctx: ctx,
+			req: &v1.ListComplianceSnapshotsRequest{Scope: "Scope1", PageNum: 2, PageSize: 10},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockProduct(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().ListComplianceSnapshots(ctx, db.ListComplianceSnapshotsParams{
+					Scope:    "Scope1",
+					PageSize: 10,
+					PageNum:  10,
+				}).Times(1).Return([]db.ListComplianceSnapshotsRow{
+					{
+						Totalrecords: 11,
+						ID:           1,
+						Scope:        "Scope1",
+						SnapshotType: "PERIODIC",
+						Status:       "COMPLETED",
+						CreatedBy:    "cron",
+						CreatedOn:    createdOn,
+						CompletedOn:  sql.NullTime{Time: completedOn, Valid: true},
+					},
+				}, nil)
+			},
+			want: &v1.ListComplianceSnapshotsResponse{
+				TotalRecords: 11,
+				Snapshots: []*v1.ComplianceSnapshot{
+					{
+						Id:           1,
+						Scope:        "Scope1",
+						SnapshotType: "PERIODIC",
+						Status:       "COMPLETED",
+						CreatedBy:    "cron",
+						CreatedOn:    timestamppb.New(createdOn),
+						CompletedOn:  timestamppb.New(completedOn),
+					},
+				},
+			},
+		},
+		{name: "FAILURE - DBError",
+			ctx: ctx,
+			req: &v1.ListComplianceSnapshotsRequest{Scope: "Scope1", PageNum: 1, PageSize: 10},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockProduct(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().ListComplianceSnapshots(ctx, gomock.Any()).Times(1).Return(nil, errors.New("DBError"))
+			},
+			wantCode: codes.Internal,
+		},
+		{name: "FAILURE - scope not found",
+			ctx: ctx,
+			req: &v1.ListComplianceSnapshotsRequest{Scope: "Scope2", PageNum: 1, PageSize: 10},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = dbmock.NewMockProduct(mockCtrl)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			s := NewProductServiceServer(rep, nil, nil, "", nil, nil, &config.Config{})
+			got, err := s.ListComplianceSnapshots(tt.ctx, tt.req)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			if assert.Empty(t, err) {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_DiffComplianceSnapshots(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "user@test.com",
+		Role:   "User",
+		Socpes: []string{"Scope1"},
+	})
+	createdOn := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	from := db.ComplianceSnapshot{ID: 1, Scope: "Scope1", SnapshotType: "PERIODIC", Status: "COMPLETED", CreatedBy: "cron", CreatedOn: createdOn}
+	to := db.ComplianceSnapshot{ID: 2, Scope: "Scope1", SnapshotType: "ON_DEMAND", Status: "COMPLETED", CreatedBy: "user@test.com", CreatedOn: createdOn.Add(24 * time.Hour)}
+	entry := func(sku string, computed, acquired int32, deltaCost float64) db.ComplianceSnapshotEntry {
+		return db.ComplianceSnapshotEntry{
+			Scope:               "Scope1",
+			Editor:              "oracle",
+			Sku:                 sku,
+			Swidtags:            "p_" + sku,
+			ProductNames:        "prod_" + sku,
+			Metrics:             "oracle.processor",
+			NumComputedLicences: computed,
+			NumAcquiredLicences: acquired,
+			DeltaNumber:         acquired - computed,
+			DeltaCost:           decimal.NewFromFloat(deltaCost),
+			TotalCost:           decimal.NewFromInt(100),
+			PurchaseCost:        decimal.NewFromInt(100),
+			ComputedCost:        decimal.NewFromInt(100),
+		}
+	}
+	values := func(computed, acquired int32, deltaCost float64) *v1.ComplianceValues {
+		return &v1.ComplianceValues{
+			NumComputedLicences: computed,
+			NumAcquiredLicences: acquired,
+			DeltaNumber:         acquired - computed,
+			DeltaCost:           deltaCost,
+			TotalCost:           100,
+			PurchaseCost:        100,
+			ComputedCost:        100,
+		}
+	}
+	var mockCtrl *gomock.Controller
+	var rep repo.Product
+	tests := []struct {
+		name     string
+		req      *v1.DiffComplianceSnapshotsRequest
+		setup    func()
+		want     *v1.DiffComplianceSnapshotsResponse
+		wantCode codes.Code
+	}{
+		{name: "SUCCESS",
+			req: &v1.DiffComplianceSnapshotsRequest{Scope: "Scope1", FromSnapshotId: 1, ToSnapshotId: 2, Editor: "oracle"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockProduct(mockCtrl)
+				rep = mockRepository
+				gomock.InOrder(
+					mockRepository.EXPECT().GetComplianceSnapshot(ctx, db.GetComplianceSnapshotParams{ID: 1, Scope: "Scope1"}).Times(1).Return(from, nil),
+					mockRepository.EXPECT().ListComplianceSnapshotEntries(ctx, db.ListComplianceSnapshotEntriesParams{SnapshotID: 1, IsEditor: true, Editor: "oracle"}).Times(1).Return([]db.ComplianceSnapshotEntry{
+						entry("s1", 10, 5, -50),
+						entry("s2", 5, 5, 0),
+						entry("s3", 1, 2, 10),
+					}, nil),
+					mockRepository.EXPECT().GetComplianceSnapshot(ctx, db.GetComplianceSnapshotParams{ID: 2, Scope: "Scope1"}).Times(1).Return(to, nil),
+					mockRepository.EXPECT().ListComplianceSnapshotEntries(ctx, db.ListComplianceSnapshotEntriesParams{SnapshotID: 2, IsEditor: true, Editor: "oracle"}).Times(1).Return([]db.ComplianceSnapshotEntry{
+						entry("s1", 12, 5, -70.5),
+						entry("s2", 5, 5, 0),
+						entry("s4", 3, 0, -30),
+					}, nil),
+				)
+			},
+			want: &v1.DiffComplianceSnapshotsResponse{
+				From: complianceSnapshot(from),
+				To:   complianceSnapshot(to),
+				Diffs: []*v1.ComplianceDiff{
+					{Status: "CHANGED", Editor: "oracle", Sku: "s1", Swidtags: "p_s1", ProductNames: "prod_s1", Metrics: "oracle.processor",
+						From: values(10, 5, -50), To: values(12, 5, -70.5), DeltaNumberChange: -2, DeltaCostChange: -20.5},
+					{Status: "ADDED", Editor: "oracle", Sku: "s4", Swidtags: "p_s4", ProductNames: "prod_s4", Metrics: "oracle.processor",
+						To: values(3, 0, -30), DeltaNumberChange: -3, DeltaCostChange: -30},
+					{Status: "REMOVED", Editor: "oracle", Sku: "s3", Swidtags: "p_s3", ProductNames: "prod_s3", Metrics: "oracle.processor",
+						From: values(1, 2, 10), DeltaNumberChange: -1, DeltaCostChange: -10},
+				},
+			},
+		},
+		{name: "FAILURE - snapshot not found",
+			req: &v1.DiffComplianceSnapshotsRequest{Scope: "Scope1", FromSnapshotId: 1, ToSnapshotId: 5},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockProduct(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetComplianceSnapshot(ctx, db.GetComplianceSnapshotParams{ID: 1, Scope: "Scope1"}).Times(1).Return(from, nil)
+				mockRepository.EXPECT().ListComplianceSnapshotEntries(ctx, gomock.Any()).Times(1).Return(nil, nil)
+				mockRepository.EXPECT().GetComplianceSnapshot(ctx, db.GetComplianceSnapshotParams{ID: 5, Scope: "Scope1"}).Times(1).Return(db.ComplianceSnapshot{}, sql.ErrNoRows)
+			},
+			wantCode: codes.NotFound,
+		},
+		{name: "FAILURE - snapshot pending",
+			req: &v1.DiffComplianceSnapshotsRequest{Scope: "Scope1", FromSnapshotId: 3, ToSnapshotId: 2},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := dbmock.NewMockProduct(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetComplianceSnapshot(ctx, db.GetComplianceSnapshotParams{ID: 3, Scope: "Scope1"}).Times(1).Return(db.ComplianceSnapshot{ID: 3, Status: "PENDING"}, nil)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{name: "FAILURE - scope not found",
+			req: &v1.DiffComplianceSnapshotsRequest{Scope: "Scope2", FromSnapshotId: 1, ToSnapshotId: 2},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				rep = dbmock.NewMockProduct(mockCtrl)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			s := NewProductServiceServer(rep, nil, nil, "", nil, nil, &config.Config{})
+			got, err := s.DiffComplianceSnapshots(ctx, tt.req)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			if assert.Empty(t, err) {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
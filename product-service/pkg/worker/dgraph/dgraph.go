@@ -26,11 +26,17 @@ import (
 var errRetry = errors.New("RETRY")
 var mu sync.Mutex
 
+// Throttler waits for the rate limit of the service called by a job
+type Throttler interface {
+	Throttle(ctx context.Context, target string) error
+}
+
 // Worker ...
 type Worker struct {
 	id              string
 	dg              *dgo.Dgraph
 	equipmentClient e_v1.EquipmentServiceClient
+	throttler       Throttler
 }
 
 // MessageType ...
@@ -75,8 +81,8 @@ type Envelope struct {
 }
 
 // NewWorker ...
-func NewWorker(id string, dg *dgo.Dgraph, grpcServers map[string]*grpc.ClientConn) *Worker {
-	return &Worker{id: id, dg: dg, equipmentClient: e_v1.NewEquipmentServiceClient(grpcServers["equipment"])}
+func NewWorker(id string, dg *dgo.Dgraph, grpcServers map[string]*grpc.ClientConn, throttler Throttler) *Worker {
+	return &Worker{id: id, dg: dg, equipmentClient: e_v1.NewEquipmentServiceClient(grpcServers["equipment"]), throttler: throttler}
 }
 
 // ID gives worker id
@@ -84,9 +90,21 @@ func (w *Worker) ID() string {
 	return w.id
 }
 
+// throttle waits for the rate limit of the target service, the worker is not throttled without throttler
+func (w *Worker) throttle(ctx context.Context, target string) error {
+	if w.throttler == nil {
+		return nil
+	}
+	return w.throttler.Throttle(ctx, target)
+}
+
 // DoWork will load products/linked applications,linked equipments data into Dgraph
 // nolint: funlen, gocyclo
 func (w *Worker) DoWork(ctx context.Context, j *job.Job) error {
+	// the job waits for dgraph before taking the lock so that the waiting jobs do not hold it
+	if err := w.throttle(ctx, "dgraph"); err != nil {
+		return err
+	}
 	mu.Lock()
 	defer mu.Unlock()
 	var e Envelope
@@ -278,6 +296,9 @@ func (w *Worker) DoWork(ctx context.Context, j *job.Job) error {
 			return errRetry
 		}
 		for _, v := range upr.GetEquipments().GetEquipmentusers() {
+			if err := w.throttle(ctx, "equipment"); err != nil {
+				return err
+			}
 			_, err := w.equipmentClient.UpsertAllocMetricByFile(ctx, &e_v1.UpsertAllocMetricByFileRequest{
 				Scope:            upr.GetScope(),
 				Swidtag:          upr.GetSwidTag(),
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"time"
 
 	l_v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/thirdparty/license-service/pkg/api/v1"
@@ -49,8 +50,37 @@ func (w *LicenseCalWorker) ID() string {
 type DataUpdateWorker struct {
 	UpdatedBy string `json:"updatedBy"`
 	Scope     string `json:"scope"`
+	// SnapshotID is the on demand compliance snapshot completed by the job
+	SnapshotID int32 `json:"snapshotId,omitempty"`
+	// Period is the run of the cron which pushed the job, see SnapshotPeriod
+	Period *time.Time `json:"period,omitempty"`
 }
 
+// SnapshotPeriod gives the period of the periodic snapshots taken by the jobs the cron pushes at t.
+// The cron runs on the minute, the jobs pushed by all the replicas at once share the period.
+func SnapshotPeriod(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Minute)
+}
+
+const (
+	// UpdatedByCron is the author of the jobs pushed by the licence calculation cron,
+	// a compliance snapshot of every scope is taken by these jobs
+	UpdatedByCron = "cron"
+
+	// SnapshotPeriodic is the type of the snapshots taken by the cron
+	SnapshotPeriodic = "PERIODIC"
+	// SnapshotOnDemand is the type of the snapshots requested by the users
+	SnapshotOnDemand = "ON_DEMAND"
+
+	// SnapshotPending is the status of a snapshot until the licences are computed
+	SnapshotPending = "PENDING"
+	// SnapshotCompleted is the status of a snapshot once its entries are recorded
+	SnapshotCompleted = "COMPLETED"
+	// SnapshotFailed is the status of a snapshot when the licences of some editors could not be computed,
+	// its entries are not recorded and it cannot be compared
+	SnapshotFailed = "FAILED"
+)
+
 // DoWork ...
 func (w *LicenseCalWorker) DoWork(ctx context.Context, j *job.Job) error {
 	defer func() {
@@ -91,6 +121,7 @@ func (w *LicenseCalWorker) DoWork(ctx context.Context, j *job.Job) error {
 			return status.Error(codes.Internal, "error fetching list of editor")
 		}
 		// fmt.Printf("editors list:%v for scope:%v\n", editors, scope)
+		var failedEditors []string
 		for _, editor := range editors {
 			// fmt.Printf("editor:%v, scope:%v\n", editor, scope)
 			resp, err := w.licenseClient.GetOverAllCompliance(ctx, &l_v1.GetOverAllComplianceRequest{
@@ -99,6 +130,7 @@ func (w *LicenseCalWorker) DoWork(ctx context.Context, j *job.Job) error {
 			})
 			if err != nil {
 				logger.Log.Error("worker - licenseCalculator - GetOverAllCompliance", zap.Error(err), zap.Any("scope", scope), zap.Any("editor", editor))
+				failedEditors = append(failedEditors, editor)
 				continue
 				// return status.Error(codes.Internal, "error fetching GetOverAllCompliance")
 			}
@@ -107,6 +139,9 @@ func (w *LicenseCalWorker) DoWork(ctx context.Context, j *job.Job) error {
 				return err
 			}
 		}
+		if err := w.takeSnapshot(ctx, j, data, scope, failedEditors); err != nil {
+			return err
+		}
 		if len(editors) > 0 {
 			curTime := time.Now().UTC()
 			parser := cron.NewParser(cron.Descriptor)
@@ -167,3 +202,60 @@ func (w *LicenseCalWorker) addComputedLicences(ctx context.Context, resp []*l_v1
 	}
 	return nil
 }
+
+// takeSnapshot records the computed licences of the scope in the on demand snapshot of the job
+// or, for the jobs of the cron, in the periodic snapshot of the period of the job. The first job of
+// the period takes the periodic snapshot, its retries take it again and the jobs of the other replicas skip it.
+// The snapshot fails when the licences of some editors could not be computed.
+func (w *LicenseCalWorker) takeSnapshot(ctx context.Context, j *job.Job, data DataUpdateWorker, scope string, failedEditors []string) error {
+	snapshotID := data.SnapshotID
+	if snapshotID == 0 {
+		if data.UpdatedBy != UpdatedByCron {
+			return nil
+		}
+		period := SnapshotPeriod(j.CreatedAt.Time)
+		if data.Period != nil {
+			period = SnapshotPeriod(*data.Period)
+		}
+		snapshot, err := w.productRepo.UpsertPeriodicComplianceSnapshot(ctx, db.UpsertPeriodicComplianceSnapshotParams{
+			Scope:     scope,
+			CreatedBy: data.UpdatedBy,
+			JobID:     sql.NullInt32{Int32: j.JobID, Valid: true},
+			Period:    sql.NullTime{Time: period, Valid: true},
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Log.Info("worker - licenseCalculator - periodic snapshot taken by another job", zap.Any("scope", scope), zap.Time("period", period))
+			return nil
+		}
+		if err != nil {
+			logger.Log.Error("worker - licenseCalculator - UpsertPeriodicComplianceSnapshot", zap.Error(err), zap.Any("scope", scope))
+			return status.Error(codes.Internal, "error inserting compliance snapshot")
+		}
+		snapshotID = snapshot.ID
+	}
+	if len(failedEditors) > 0 {
+		logger.Log.Error("worker - licenseCalculator - compliance snapshot failed", zap.Any("scope", scope), zap.Int32("snapshot", snapshotID), zap.Strings("editors", failedEditors))
+		if err := w.productRepo.FailComplianceSnapshot(ctx, snapshotID); err != nil {
+			logger.Log.Error("worker - licenseCalculator - FailComplianceSnapshot", zap.Error(err), zap.Int32("snapshot", snapshotID))
+			return status.Error(codes.Internal, "error failing compliance snapshot")
+		}
+		return nil
+	}
+	// entries copied by a previous try of the job are replaced
+	if err := w.productRepo.DeleteComplianceSnapshotEntries(ctx, snapshotID); err != nil {
+		logger.Log.Error("worker - licenseCalculator - DeleteComplianceSnapshotEntries", zap.Error(err), zap.Int32("snapshot", snapshotID))
+		return status.Error(codes.Internal, "error deleting compliance snapshot entries")
+	}
+	if err := w.productRepo.CopyComplianceSnapshotEntries(ctx, db.CopyComplianceSnapshotEntriesParams{
+		SnapshotID: snapshotID,
+		Scope:      scope,
+	}); err != nil {
+		logger.Log.Error("worker - licenseCalculator - CopyComplianceSnapshotEntries", zap.Error(err), zap.Any("scope", scope), zap.Int32("snapshot", snapshotID))
+		return status.Error(codes.Internal, "error copying compliance snapshot entries")
+	}
+	if err := w.productRepo.CompleteComplianceSnapshot(ctx, snapshotID); err != nil {
+		logger.Log.Error("worker - licenseCalculator - CompleteComplianceSnapshot", zap.Error(err), zap.Int32("snapshot", snapshotID))
+		return status.Error(codes.Internal, "error completing compliance snapshot")
+	}
+	return nil
+}
@@ -1,5 +1,119 @@
 package licensecalculator
 
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	dbmock "gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/repository/v1/dbmock"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/product-service/pkg/repository/v1/postgres/db"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/job"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestLicenseCalWorker_takeSnapshot(t *testing.T) {
+	ctx := context.Background()
+	period := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	pushedAt := period.Add(3 * time.Second)
+	cronJob := &job.Job{JobID: 7, CreatedAt: sql.NullTime{Time: period.Add(5 * time.Second)}}
+	tests := []struct {
+		name          string
+		data          DataUpdateWorker
+		failedEditors []string
+		setup         func(mockRepo *dbmock.MockProduct)
+		wantErr       bool
+	}{
+		{name: "no snapshot for dashboard updates",
+			data:  DataUpdateWorker{UpdatedBy: "admin@test.com", Scope: "s1"},
+			setup: func(mockRepo *dbmock.MockProduct) {},
+		},
+		{name: "periodic snapshot of the cron run",
+			data: DataUpdateWorker{UpdatedBy: UpdatedByCron, Period: &pushedAt},
+			setup: func(mockRepo *dbmock.MockProduct) {
+				gomock.InOrder(
+					mockRepo.EXPECT().UpsertPeriodicComplianceSnapshot(ctx, db.UpsertPeriodicComplianceSnapshotParams{
+						Scope:     "s1",
+						CreatedBy: UpdatedByCron,
+						JobID:     sql.NullInt32{Int32: 7, Valid: true},
+						Period:    sql.NullTime{Time: period, Valid: true},
+					}).Return(db.ComplianceSnapshot{ID: 3}, nil),
+					mockRepo.EXPECT().DeleteComplianceSnapshotEntries(ctx, int32(3)).Return(nil),
+					mockRepo.EXPECT().CopyComplianceSnapshotEntries(ctx, db.CopyComplianceSnapshotEntriesParams{SnapshotID: 3, Scope: "s1"}).Return(nil),
+					mockRepo.EXPECT().CompleteComplianceSnapshot(ctx, int32(3)).Return(nil),
+				)
+			},
+		},
+		{name: "periodic snapshot of a job pushed without period",
+			data: DataUpdateWorker{UpdatedBy: UpdatedByCron},
+			setup: func(mockRepo *dbmock.MockProduct) {
+				gomock.InOrder(
+					mockRepo.EXPECT().UpsertPeriodicComplianceSnapshot(ctx, db.UpsertPeriodicComplianceSnapshotParams{
+						Scope:     "s1",
+						CreatedBy: UpdatedByCron,
+						JobID:     sql.NullInt32{Int32: 7, Valid: true},
+						Period:    sql.NullTime{Time: period, Valid: true},
+					}).Return(db.ComplianceSnapshot{ID: 3}, nil),
+					mockRepo.EXPECT().DeleteComplianceSnapshotEntries(ctx, int32(3)).Return(nil),
+					mockRepo.EXPECT().CopyComplianceSnapshotEntries(ctx, db.CopyComplianceSnapshotEntriesParams{SnapshotID: 3, Scope: "s1"}).Return(nil),
+					mockRepo.EXPECT().CompleteComplianceSnapshot(ctx, int32(3)).Return(nil),
+				)
+			},
+		},
+		{name: "periodic snapshot taken by the job of another replica",
+			data: DataUpdateWorker{UpdatedBy: UpdatedByCron, Period: &pushedAt},
+			setup: func(mockRepo *dbmock.MockProduct) {
+				mockRepo.EXPECT().UpsertPeriodicComplianceSnapshot(ctx, db.UpsertPeriodicComplianceSnapshotParams{
+					Scope:     "s1",
+					CreatedBy: UpdatedByCron,
+					JobID:     sql.NullInt32{Int32: 7, Valid: true},
+					Period:    sql.NullTime{Time: period, Valid: true},
+				}).Return(db.ComplianceSnapshot{}, sql.ErrNoRows)
+			},
+		},
+		{name: "on demand snapshot",
+			data: DataUpdateWorker{UpdatedBy: "admin@test.com", Scope: "s1", SnapshotID: 5},
+			setup: func(mockRepo *dbmock.MockProduct) {
+				gomock.InOrder(
+					mockRepo.EXPECT().DeleteComplianceSnapshotEntries(ctx, int32(5)).Return(nil),
+					mockRepo.EXPECT().CopyComplianceSnapshotEntries(ctx, db.CopyComplianceSnapshotEntriesParams{SnapshotID: 5, Scope: "s1"}).Return(nil),
+					mockRepo.EXPECT().CompleteComplianceSnapshot(ctx, int32(5)).Return(nil),
+				)
+			},
+		},
+		{name: "snapshot fails when the licences of an editor are missing",
+			data:          DataUpdateWorker{UpdatedBy: "admin@test.com", Scope: "s1", SnapshotID: 5},
+			failedEditors: []string{"oracle"},
+			setup: func(mockRepo *dbmock.MockProduct) {
+				mockRepo.EXPECT().FailComplianceSnapshot(ctx, int32(5)).Return(nil)
+			},
+		},
+		{name: "copy error",
+			data: DataUpdateWorker{UpdatedBy: "admin@test.com", Scope: "s1", SnapshotID: 5},
+			setup: func(mockRepo *dbmock.MockProduct) {
+				mockRepo.EXPECT().DeleteComplianceSnapshotEntries(ctx, int32(5)).Return(nil)
+				mockRepo.EXPECT().CopyComplianceSnapshotEntries(ctx, db.CopyComplianceSnapshotEntriesParams{SnapshotID: 5, Scope: "s1"}).Return(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			mockRepo := dbmock.NewMockProduct(mockCtrl)
+			tt.setup(mockRepo)
+			w := &LicenseCalWorker{productRepo: mockRepo}
+			if err := w.takeSnapshot(ctx, cronJob, tt.data, "s1", tt.failedEditors); (err != nil) != tt.wantErr {
+				t.Errorf("LicenseCalWorker.takeSnapshot() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 /*
 func TestLicenseCalWorker_DoWork(t *testing.T) {
 	ctx := ctxmanage.AddClaims(context.Background(), &claims.Claims{
@@ -23,6 +23,21 @@ import (
 //go:generate protoc --proto_path=../../thirdparty/product-service/proto --proto_path=../../thirdparty/ --validate_out=lang=go,paths=source_relative:../../thirdparty/product-service/pkg/api/v1 product.proto
 //go:generate mockgen -destination=../../thirdparty/product-service/pkg/api/v1/mock/mock.go -source=../../thirdparty/product-service/pkg/api/v1/product_grpc.pb.go ProductServiceClient
 
+//go:generate protoc --proto_path=../../thirdparty/notification-service/proto --proto_path=../../thirdparty/ --go_out=paths=source_relative:../../thirdparty/notification-service/pkg/api/v1 --go-grpc_out=require_unimplemented_servers=false,paths=source_relative:../../thirdparty/notification-service/pkg/api/v1 notification.proto
+//go:generate protoc --proto_path=../../thirdparty/notification-service/proto  --proto_path=../../thirdparty/ --grpc-gateway_out=paths=source_relative:../../thirdparty/notification-service/pkg/api/v1 notification.proto
+//go:generate protoc --proto_path=../../thirdparty/notification-service/proto --proto_path=../../thirdparty/ --validate_out=lang=go,paths=source_relative:../../thirdparty/notification-service/pkg/api/v1 notification.proto
+//go:generate mockgen -destination=../../thirdparty/notification-service/pkg/api/v1/mock/mock.go -source=../../thirdparty/notification-service/pkg/api/v1/notification_grpc.pb.go NotificationServiceClient
+
+//go:generate protoc --proto_path=../../thirdparty/equipment-service/proto --proto_path=../../thirdparty/ --go_out=paths=source_relative:../../thirdparty/equipment-service/pkg/api/v1 --go-grpc_out=require_unimplemented_servers=false,paths=source_relative:../../thirdparty/equipment-service/pkg/api/v1 equipment.proto
+//go:generate protoc --proto_path=../../thirdparty/equipment-service/proto  --proto_path=../../thirdparty/ --grpc-gateway_out=paths=source_relative:../../thirdparty/equipment-service/pkg/api/v1 equipment.proto
+//go:generate protoc --proto_path=../../thirdparty/equipment-service/proto --proto_path=../../thirdparty/ --validate_out=lang=go,paths=source_relative:../../thirdparty/equipment-service/pkg/api/v1 equipment.proto
+//go:generate mockgen -destination=../../thirdparty/equipment-service/pkg/api/v1/mock/mock.go -source=../../thirdparty/equipment-service/pkg/api/v1/equipment_grpc.pb.go EquipmentServiceClient
+
+//go:generate protoc --proto_path=../../thirdparty/account-service/proto --proto_path=../../thirdparty/ --go_out=paths=source_relative:../../thirdparty/account-service/pkg/api/v1 --go-grpc_out=require_unimplemented_servers=false,paths=source_relative:../../thirdparty/account-service/pkg/api/v1 account.proto
+//go:generate protoc --proto_path=../../thirdparty/account-service/proto  --proto_path=../../thirdparty/ --grpc-gateway_out=paths=source_relative:../../thirdparty/account-service/pkg/api/v1 account.proto
+//go:generate protoc --proto_path=../../thirdparty/account-service/proto --proto_path=../../thirdparty/ --validate_out=lang=go,paths=source_relative:../../thirdparty/account-service/pkg/api/v1 account.proto
+//go:generate mockgen -destination=../../thirdparty/account-service/pkg/api/v1/mock/mock.go -source=../../thirdparty/account-service/pkg/api/v1/account_grpc.pb.go AccountServiceClient
+
 func main() {
 	if err := cmd.RunServer(); err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
@@ -2,14 +2,22 @@ package rbac
 
 default allow = false
 
-# Allow admins to do anything.
+# Allow admins to do anything but administering the worker queue.
 allow {
 	roles["Admin"][input.role]
+	not startswith(input.api, "/optisam.workerqueue.v1.WorkerQueueAdmin/")
 }
 
-# Allow users to do anything.
+# Allow users to do anything but administering the worker queue.
 allow {
 	roles["Normal"][input.role]
+	not startswith(input.api, "/optisam.workerqueue.v1.WorkerQueueAdmin/")
+}
+
+# Only super admins administer the worker queue, its jobs belong to every scope.
+allow {
+	startswith(input.api, "/optisam.workerqueue.v1.WorkerQueueAdmin/")
+	input.role = "SuperAdmin"
 }
 
 roles := {"Admin":{"SuperAdmin","Admin"},"Normal":{"User"}}
\ No newline at end of file
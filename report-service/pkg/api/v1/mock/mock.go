@@ -36,6 +36,86 @@ func (m *MockReportServiceClient) EXPECT() *MockReportServiceClientMockRecorder
 	return m.recorder
 }
 
+// CreateReportSchedule mocks base method.
+func (m *MockReportServiceClient) CreateReportSchedule(ctx context.Context, in *v1.CreateReportScheduleRequest, opts ...grpc.CallOption) (*v1.ReportSchedule, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateReportSchedule", varargs...)
+	ret0, _ := ret[0].(*v1.ReportSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReportSchedule indicates an expected call of CreateReportSchedule.
+func (mr *MockReportServiceClientMockRecorder) CreateReportSchedule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReportSchedule", reflect.TypeOf((*MockReportServiceClient)(nil).CreateReportSchedule), varargs...)
+}
+
+// CreateReportTemplate mocks base method.
+func (m *MockReportServiceClient) CreateReportTemplate(ctx context.Context, in *v1.CreateReportTemplateRequest, opts ...grpc.CallOption) (*v1.ReportTemplate, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateReportTemplate", varargs...)
+	ret0, _ := ret[0].(*v1.ReportTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReportTemplate indicates an expected call of CreateReportTemplate.
+func (mr *MockReportServiceClientMockRecorder) CreateReportTemplate(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReportTemplate", reflect.TypeOf((*MockReportServiceClient)(nil).CreateReportTemplate), varargs...)
+}
+
+// DeleteReportSchedule mocks base method.
+func (m *MockReportServiceClient) DeleteReportSchedule(ctx context.Context, in *v1.DeleteReportScheduleRequest, opts ...grpc.CallOption) (*v1.DeleteReportScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteReportSchedule", varargs...)
+	ret0, _ := ret[0].(*v1.DeleteReportScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReportSchedule indicates an expected call of DeleteReportSchedule.
+func (mr *MockReportServiceClientMockRecorder) DeleteReportSchedule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReportSchedule", reflect.TypeOf((*MockReportServiceClient)(nil).DeleteReportSchedule), varargs...)
+}
+
+// DeleteReportTemplate mocks base method.
+func (m *MockReportServiceClient) DeleteReportTemplate(ctx context.Context, in *v1.DeleteReportTemplateRequest, opts ...grpc.CallOption) (*v1.DeleteReportTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteReportTemplate", varargs...)
+	ret0, _ := ret[0].(*v1.DeleteReportTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReportTemplate indicates an expected call of DeleteReportTemplate.
+func (mr *MockReportServiceClientMockRecorder) DeleteReportTemplate(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReportTemplate", reflect.TypeOf((*MockReportServiceClient)(nil).DeleteReportTemplate), varargs...)
+}
+
 // DownloadReport mocks base method.
 func (m *MockReportServiceClient) DownloadReport(ctx context.Context, in *v1.DownloadReportRequest, opts ...grpc.CallOption) (*v1.DownloadReportResponse, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +176,86 @@ func (mr *MockReportServiceClientMockRecorder) ListReport(ctx, in interface{}, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReport", reflect.TypeOf((*MockReportServiceClient)(nil).ListReport), varargs...)
 }
 
+// ListReportScheduleRuns mocks base method.
+func (m *MockReportServiceClient) ListReportScheduleRuns(ctx context.Context, in *v1.ListReportScheduleRunsRequest, opts ...grpc.CallOption) (*v1.ListReportScheduleRunsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListReportScheduleRuns", varargs...)
+	ret0, _ := ret[0].(*v1.ListReportScheduleRunsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReportScheduleRuns indicates an expected call of ListReportScheduleRuns.
+func (mr *MockReportServiceClientMockRecorder) ListReportScheduleRuns(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReportScheduleRuns", reflect.TypeOf((*MockReportServiceClient)(nil).ListReportScheduleRuns), varargs...)
+}
+
+// ListReportSchedules mocks base method.
+func (m *MockReportServiceClient) ListReportSchedules(ctx context.Context, in *v1.ListReportSchedulesRequest, opts ...grpc.CallOption) (*v1.ListReportSchedulesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListReportSchedules", varargs...)
+	ret0, _ := ret[0].(*v1.ListReportSchedulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReportSchedules indicates an expected call of ListReportSchedules.
+func (mr *MockReportServiceClientMockRecorder) ListReportSchedules(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReportSchedules", reflect.TypeOf((*MockReportServiceClient)(nil).ListReportSchedules), varargs...)
+}
+
+// ListReportTemplateEntities mocks base method.
+func (m *MockReportServiceClient) ListReportTemplateEntities(ctx context.Context, in *v1.ListReportTemplateEntitiesRequest, opts ...grpc.CallOption) (*v1.ListReportTemplateEntitiesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListReportTemplateEntities", varargs...)
+	ret0, _ := ret[0].(*v1.ListReportTemplateEntitiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReportTemplateEntities indicates an expected call of ListReportTemplateEntities.
+func (mr *MockReportServiceClientMockRecorder) ListReportTemplateEntities(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReportTemplateEntities", reflect.TypeOf((*MockReportServiceClient)(nil).ListReportTemplateEntities), varargs...)
+}
+
+// ListReportTemplates mocks base method.
+func (m *MockReportServiceClient) ListReportTemplates(ctx context.Context, in *v1.ListReportTemplatesRequest, opts ...grpc.CallOption) (*v1.ListReportTemplatesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListReportTemplates", varargs...)
+	ret0, _ := ret[0].(*v1.ListReportTemplatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReportTemplates indicates an expected call of ListReportTemplates.
+func (mr *MockReportServiceClientMockRecorder) ListReportTemplates(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReportTemplates", reflect.TypeOf((*MockReportServiceClient)(nil).ListReportTemplates), varargs...)
+}
+
 // ListReportType mocks base method.
 func (m *MockReportServiceClient) ListReportType(ctx context.Context, in *v1.ListReportTypeRequest, opts ...grpc.CallOption) (*v1.ListReportTypeResponse, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +296,46 @@ func (mr *MockReportServiceClientMockRecorder) SubmitReport(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitReport", reflect.TypeOf((*MockReportServiceClient)(nil).SubmitReport), varargs...)
 }
 
+// UpdateReportSchedule mocks base method.
+func (m *MockReportServiceClient) UpdateReportSchedule(ctx context.Context, in *v1.UpdateReportScheduleRequest, opts ...grpc.CallOption) (*v1.ReportSchedule, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateReportSchedule", varargs...)
+	ret0, _ := ret[0].(*v1.ReportSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReportSchedule indicates an expected call of UpdateReportSchedule.
+func (mr *MockReportServiceClientMockRecorder) UpdateReportSchedule(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReportSchedule", reflect.TypeOf((*MockReportServiceClient)(nil).UpdateReportSchedule), varargs...)
+}
+
+// UpdateReportTemplate mocks base method.
+func (m *MockReportServiceClient) UpdateReportTemplate(ctx context.Context, in *v1.UpdateReportTemplateRequest, opts ...grpc.CallOption) (*v1.ReportTemplate, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateReportTemplate", varargs...)
+	ret0, _ := ret[0].(*v1.ReportTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReportTemplate indicates an expected call of UpdateReportTemplate.
+func (mr *MockReportServiceClientMockRecorder) UpdateReportTemplate(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReportTemplate", reflect.TypeOf((*MockReportServiceClient)(nil).UpdateReportTemplate), varargs...)
+}
+
 // MockReportServiceServer is a mock of ReportServiceServer interface.
 type MockReportServiceServer struct {
 	ctrl     *gomock.Controller
@@ -159,6 +359,66 @@ func (m *MockReportServiceServer) EXPECT() *MockReportServiceServerMockRecorder
 	return m.recorder
 }
 
+// CreateReportSchedule mocks base method.
+func (m *MockReportServiceServer) CreateReportSchedule(arg0 context.Context, arg1 *v1.CreateReportScheduleRequest) (*v1.ReportSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReportSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ReportSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReportSchedule indicates an expected call of CreateReportSchedule.
+func (mr *MockReportServiceServerMockRecorder) CreateReportSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReportSchedule", reflect.TypeOf((*MockReportServiceServer)(nil).CreateReportSchedule), arg0, arg1)
+}
+
+// CreateReportTemplate mocks base method.
+func (m *MockReportServiceServer) CreateReportTemplate(arg0 context.Context, arg1 *v1.CreateReportTemplateRequest) (*v1.ReportTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReportTemplate", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ReportTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReportTemplate indicates an expected call of CreateReportTemplate.
+func (mr *MockReportServiceServerMockRecorder) CreateReportTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReportTemplate", reflect.TypeOf((*MockReportServiceServer)(nil).CreateReportTemplate), arg0, arg1)
+}
+
+// DeleteReportSchedule mocks base method.
+func (m *MockReportServiceServer) DeleteReportSchedule(arg0 context.Context, arg1 *v1.DeleteReportScheduleRequest) (*v1.DeleteReportScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReportSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*v1.DeleteReportScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReportSchedule indicates an expected call of DeleteReportSchedule.
+func (mr *MockReportServiceServerMockRecorder) DeleteReportSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReportSchedule", reflect.TypeOf((*MockReportServiceServer)(nil).DeleteReportSchedule), arg0, arg1)
+}
+
+// DeleteReportTemplate mocks base method.
+func (m *MockReportServiceServer) DeleteReportTemplate(arg0 context.Context, arg1 *v1.DeleteReportTemplateRequest) (*v1.DeleteReportTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReportTemplate", arg0, arg1)
+	ret0, _ := ret[0].(*v1.DeleteReportTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReportTemplate indicates an expected call of DeleteReportTemplate.
+func (mr *MockReportServiceServerMockRecorder) DeleteReportTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReportTemplate", reflect.TypeOf((*MockReportServiceServer)(nil).DeleteReportTemplate), arg0, arg1)
+}
+
 // DownloadReport mocks base method.
 func (m *MockReportServiceServer) DownloadReport(arg0 context.Context, arg1 *v1.DownloadReportRequest) (*v1.DownloadReportResponse, error) {
 	m.ctrl.T.Helper()
@@ -204,6 +464,66 @@ func (mr *MockReportServiceServerMockRecorder) ListReport(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReport", reflect.TypeOf((*MockReportServiceServer)(nil).ListReport), arg0, arg1)
 }
 
+// ListReportScheduleRuns mocks base method.
+func (m *MockReportServiceServer) ListReportScheduleRuns(arg0 context.Context, arg1 *v1.ListReportScheduleRunsRequest) (*v1.ListReportScheduleRunsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReportScheduleRuns", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ListReportScheduleRunsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReportScheduleRuns indicates an expected call of ListReportScheduleRuns.
+func (mr *MockReportServiceServerMockRecorder) ListReportScheduleRuns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReportScheduleRuns", reflect.TypeOf((*MockReportServiceServer)(nil).ListReportScheduleRuns), arg0, arg1)
+}
+
+// ListReportSchedules mocks base method.
+func (m *MockReportServiceServer) ListReportSchedules(arg0 context.Context, arg1 *v1.ListReportSchedulesRequest) (*v1.ListReportSchedulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReportSchedules", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ListReportSchedulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReportSchedules indicates an expected call of ListReportSchedules.
+func (mr *MockReportServiceServerMockRecorder) ListReportSchedules(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReportSchedules", reflect.TypeOf((*MockReportServiceServer)(nil).ListReportSchedules), arg0, arg1)
+}
+
+// ListReportTemplateEntities mocks base method.
+func (m *MockReportServiceServer) ListReportTemplateEntities(arg0 context.Context, arg1 *v1.ListReportTemplateEntitiesRequest) (*v1.ListReportTemplateEntitiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReportTemplateEntities", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ListReportTemplateEntitiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReportTemplateEntities indicates an expected call of ListReportTemplateEntities.
+func (mr *MockReportServiceServerMockRecorder) ListReportTemplateEntities(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReportTemplateEntities", reflect.TypeOf((*MockReportServiceServer)(nil).ListReportTemplateEntities), arg0, arg1)
+}
+
+// ListReportTemplates mocks base method.
+func (m *MockReportServiceServer) ListReportTemplates(arg0 context.Context, arg1 *v1.ListReportTemplatesRequest) (*v1.ListReportTemplatesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReportTemplates", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ListReportTemplatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReportTemplates indicates an expected call of ListReportTemplates.
+func (mr *MockReportServiceServerMockRecorder) ListReportTemplates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReportTemplates", reflect.TypeOf((*MockReportServiceServer)(nil).ListReportTemplates), arg0, arg1)
+}
+
 // ListReportType mocks base method.
 func (m *MockReportServiceServer) ListReportType(arg0 context.Context, arg1 *v1.ListReportTypeRequest) (*v1.ListReportTypeResponse, error) {
 	m.ctrl.T.Helper()
@@ -234,6 +554,36 @@ func (mr *MockReportServiceServerMockRecorder) SubmitReport(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitReport", reflect.TypeOf((*MockReportServiceServer)(nil).SubmitReport), arg0, arg1)
 }
 
+// UpdateReportSchedule mocks base method.
+func (m *MockReportServiceServer) UpdateReportSchedule(arg0 context.Context, arg1 *v1.UpdateReportScheduleRequest) (*v1.ReportSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReportSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ReportSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReportSchedule indicates an expected call of UpdateReportSchedule.
+func (mr *MockReportServiceServerMockRecorder) UpdateReportSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReportSchedule", reflect.TypeOf((*MockReportServiceServer)(nil).UpdateReportSchedule), arg0, arg1)
+}
+
+// UpdateReportTemplate mocks base method.
+func (m *MockReportServiceServer) UpdateReportTemplate(arg0 context.Context, arg1 *v1.UpdateReportTemplateRequest) (*v1.ReportTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReportTemplate", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ReportTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReportTemplate indicates an expected call of UpdateReportTemplate.
+func (mr *MockReportServiceServerMockRecorder) UpdateReportTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReportTemplate", reflect.TypeOf((*MockReportServiceServer)(nil).UpdateReportTemplate), arg0, arg1)
+}
+
 // MockUnsafeReportServiceServer is a mock of UnsafeReportServiceServer interface.
 type MockUnsafeReportServiceServer struct {
 	ctrl     *gomock.Controller
@@ -343,6 +343,40 @@ func (m *ReportType) Validate() error {
 
 	// no validation rules for ReportTypeName
 
+	for idx, item := range m.GetParams() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ReportTypeValidationError{
+					field:  fmt.Sprintf("Params[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ReportTypeValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -400,6 +434,146 @@ var _ interface {
 	ErrorName() string
 } = ReportTypeValidationError{}
 
+// Validate checks the field values on ReportTypeParam with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ReportTypeParam) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Required
+
+	return nil
+}
+
+// ReportTypeParamValidationError is the validation error returned by
+// ReportTypeParam.Validate if the designated constraints aren't met.
+type ReportTypeParamValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportTypeParamValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportTypeParamValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportTypeParamValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportTypeParamValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportTypeParamValidationError) ErrorName() string { return "ReportTypeParamValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReportTypeParamValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportTypeParam.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportTypeParamValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportTypeParamValidationError{}
+
+// Validate checks the field values on ReportColumn with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ReportColumn) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Key
+
+	// no validation rules for Title
+
+	return nil
+}
+
+// ReportColumnValidationError is the validation error returned by
+// ReportColumn.Validate if the designated constraints aren't met.
+type ReportColumnValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportColumnValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportColumnValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportColumnValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportColumnValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportColumnValidationError) ErrorName() string { return "ReportColumnValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReportColumnValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportColumn.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportColumnValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportColumnValidationError{}
+
 // Validate checks the field values on SubmitReportRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -417,6 +591,8 @@ func (m *SubmitReportRequest) Validate() error {
 
 	// no validation rules for ReportTypeId
 
+	// no validation rules for Params
+
 	switch m.ReportMetadata.(type) {
 
 	case *SubmitReportRequest_AcqrightsReport:
@@ -1167,3 +1343,2091 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = DownloadReportResponseValidationError{}
+
+// Validate checks the field values on ReportSchedule with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ReportSchedule) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ScheduleId
+
+	// no validation rules for Scope
+
+	// no validation rules for ReportTypeId
+
+	// no validation rules for Params
+
+	// no validation rules for CronExpr
+
+	// no validation rules for DeliveryMode
+
+	// no validation rules for Enabled
+
+	// no validation rules for CreatedBy
+
+	if v, ok := interface{}(m.GetCreatedOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ReportScheduleValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdatedOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ReportScheduleValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetLastRunOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ReportScheduleValidationError{
+				field:  "LastRunOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch m.ReportMetadata.(type) {
+
+	case *ReportSchedule_AcqrightsReport:
+
+		if v, ok := interface{}(m.GetAcqrightsReport()).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ReportScheduleValidationError{
+					field:  "AcqrightsReport",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ReportSchedule_ProductEquipmentsReport:
+
+		if v, ok := interface{}(m.GetProductEquipmentsReport()).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ReportScheduleValidationError{
+					field:  "ProductEquipmentsReport",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ReportScheduleValidationError is the validation error returned by
+// ReportSchedule.Validate if the designated constraints aren't met.
+type ReportScheduleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportScheduleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportScheduleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportScheduleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportScheduleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportScheduleValidationError) ErrorName() string { return "ReportScheduleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReportScheduleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportSchedule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportScheduleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportScheduleValidationError{}
+
+// Validate checks the field values on CreateReportScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateReportScheduleRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_CreateReportScheduleRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return CreateReportScheduleRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	// no validation rules for ReportTypeId
+
+	if utf8.RuneCountInString(m.GetCronExpr()) < 1 {
+		return CreateReportScheduleRequestValidationError{
+			field:  "CronExpr",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if len(m.GetRecipients()) < 1 {
+		return CreateReportScheduleRequestValidationError{
+			field:  "Recipients",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetRecipients() {
+		_, _ = idx, item
+
+		if err := m._validateEmail(item); err != nil {
+			return CreateReportScheduleRequestValidationError{
+				field:  fmt.Sprintf("Recipients[%v]", idx),
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+		}
+
+	}
+
+	if _, ok := DeliveryMode_name[int32(m.GetDeliveryMode())]; !ok {
+		return CreateReportScheduleRequestValidationError{
+			field:  "DeliveryMode",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	// no validation rules for Params
+
+	switch m.ReportMetadata.(type) {
+
+	case *CreateReportScheduleRequest_AcqrightsReport:
+
+		if v, ok := interface{}(m.GetAcqrightsReport()).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return CreateReportScheduleRequestValidationError{
+					field:  "AcqrightsReport",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *CreateReportScheduleRequest_ProductEquipmentsReport:
+
+		if v, ok := interface{}(m.GetProductEquipmentsReport()).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return CreateReportScheduleRequestValidationError{
+					field:  "ProductEquipmentsReport",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *CreateReportScheduleRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *CreateReportScheduleRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// CreateReportScheduleRequestValidationError is the validation error returned
+// by CreateReportScheduleRequest.Validate if the designated constraints
+// aren't met.
+type CreateReportScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReportScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReportScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReportScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReportScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReportScheduleRequestValidationError) ErrorName() string {
+	return "CreateReportScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReportScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReportScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReportScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReportScheduleRequestValidationError{}
+
+var _CreateReportScheduleRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on UpdateReportScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateReportScheduleRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ScheduleId
+
+	if !_UpdateReportScheduleRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return UpdateReportScheduleRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetCronExpr()) < 1 {
+		return UpdateReportScheduleRequestValidationError{
+			field:  "CronExpr",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if len(m.GetRecipients()) < 1 {
+		return UpdateReportScheduleRequestValidationError{
+			field:  "Recipients",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetRecipients() {
+		_, _ = idx, item
+
+		if err := m._validateEmail(item); err != nil {
+			return UpdateReportScheduleRequestValidationError{
+				field:  fmt.Sprintf("Recipients[%v]", idx),
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+		}
+
+	}
+
+	if _, ok := DeliveryMode_name[int32(m.GetDeliveryMode())]; !ok {
+		return UpdateReportScheduleRequestValidationError{
+			field:  "DeliveryMode",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	// no validation rules for Enabled
+
+	// no validation rules for Params
+
+	switch m.ReportMetadata.(type) {
+
+	case *UpdateReportScheduleRequest_AcqrightsReport:
+
+		if v, ok := interface{}(m.GetAcqrightsReport()).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateReportScheduleRequestValidationError{
+					field:  "AcqrightsReport",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UpdateReportScheduleRequest_ProductEquipmentsReport:
+
+		if v, ok := interface{}(m.GetProductEquipmentsReport()).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateReportScheduleRequestValidationError{
+					field:  "ProductEquipmentsReport",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *UpdateReportScheduleRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *UpdateReportScheduleRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// UpdateReportScheduleRequestValidationError is the validation error returned
+// by UpdateReportScheduleRequest.Validate if the designated constraints
+// aren't met.
+type UpdateReportScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReportScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReportScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReportScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReportScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReportScheduleRequestValidationError) ErrorName() string {
+	return "UpdateReportScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReportScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReportScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReportScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReportScheduleRequestValidationError{}
+
+var _UpdateReportScheduleRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on ListReportSchedulesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListReportSchedulesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_ListReportSchedulesRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return ListReportSchedulesRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	return nil
+}
+
+// ListReportSchedulesRequestValidationError is the validation error returned
+// by ListReportSchedulesRequest.Validate if the designated constraints aren't met.
+type ListReportSchedulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportSchedulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportSchedulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportSchedulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportSchedulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportSchedulesRequestValidationError) ErrorName() string {
+	return "ListReportSchedulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportSchedulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportSchedulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportSchedulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportSchedulesRequestValidationError{}
+
+var _ListReportSchedulesRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on ListReportSchedulesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListReportSchedulesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetSchedules() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListReportSchedulesResponseValidationError{
+					field:  fmt.Sprintf("Schedules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListReportSchedulesResponseValidationError is the validation error returned
+// by ListReportSchedulesResponse.Validate if the designated constraints
+// aren't met.
+type ListReportSchedulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportSchedulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportSchedulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportSchedulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportSchedulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportSchedulesResponseValidationError) ErrorName() string {
+	return "ListReportSchedulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportSchedulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportSchedulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportSchedulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportSchedulesResponseValidationError{}
+
+// Validate checks the field values on DeleteReportScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteReportScheduleRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ScheduleId
+
+	if !_DeleteReportScheduleRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return DeleteReportScheduleRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	return nil
+}
+
+// DeleteReportScheduleRequestValidationError is the validation error returned
+// by DeleteReportScheduleRequest.Validate if the designated constraints
+// aren't met.
+type DeleteReportScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReportScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReportScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReportScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReportScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReportScheduleRequestValidationError) ErrorName() string {
+	return "DeleteReportScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReportScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReportScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReportScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReportScheduleRequestValidationError{}
+
+var _DeleteReportScheduleRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on DeleteReportScheduleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteReportScheduleResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Success
+
+	return nil
+}
+
+// DeleteReportScheduleResponseValidationError is the validation error returned
+// by DeleteReportScheduleResponse.Validate if the designated constraints
+// aren't met.
+type DeleteReportScheduleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReportScheduleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReportScheduleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReportScheduleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReportScheduleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReportScheduleResponseValidationError) ErrorName() string {
+	return "DeleteReportScheduleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReportScheduleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReportScheduleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReportScheduleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReportScheduleResponseValidationError{}
+
+// Validate checks the field values on ListReportScheduleRunsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListReportScheduleRunsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ScheduleId
+
+	if !_ListReportScheduleRunsRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return ListReportScheduleRunsRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	if val := m.GetPageNum(); val < 1 || val >= 1000 {
+		return ListReportScheduleRunsRequestValidationError{
+			field:  "PageNum",
+			reason: "value must be inside range [1, 1000)",
+		}
+	}
+
+	if val := m.GetPageSize(); val < 10 || val > 200 {
+		return ListReportScheduleRunsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [10, 200]",
+		}
+	}
+
+	return nil
+}
+
+// ListReportScheduleRunsRequestValidationError is the validation error
+// returned by ListReportScheduleRunsRequest.Validate if the designated
+// constraints aren't met.
+type ListReportScheduleRunsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportScheduleRunsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportScheduleRunsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportScheduleRunsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportScheduleRunsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportScheduleRunsRequestValidationError) ErrorName() string {
+	return "ListReportScheduleRunsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportScheduleRunsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportScheduleRunsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportScheduleRunsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportScheduleRunsRequestValidationError{}
+
+var _ListReportScheduleRunsRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on ListReportScheduleRunsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListReportScheduleRunsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for TotalRecords
+
+	for idx, item := range m.GetRuns() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListReportScheduleRunsResponseValidationError{
+					field:  fmt.Sprintf("Runs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListReportScheduleRunsResponseValidationError is the validation error
+// returned by ListReportScheduleRunsResponse.Validate if the designated
+// constraints aren't met.
+type ListReportScheduleRunsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportScheduleRunsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportScheduleRunsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportScheduleRunsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportScheduleRunsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportScheduleRunsResponseValidationError) ErrorName() string {
+	return "ListReportScheduleRunsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportScheduleRunsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportScheduleRunsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportScheduleRunsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportScheduleRunsResponseValidationError{}
+
+// Validate checks the field values on ReportScheduleRun with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ReportScheduleRun) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for RunId
+
+	// no validation rules for ReportId
+
+	// no validation rules for ReportStatus
+
+	if v, ok := interface{}(m.GetRunOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ReportScheduleRunValidationError{
+				field:  "RunOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DeliveryStatus
+
+	// no validation rules for DeliveryError
+
+	if v, ok := interface{}(m.GetDeliveredOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ReportScheduleRunValidationError{
+				field:  "DeliveredOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ReportScheduleRunValidationError is the validation error returned by
+// ReportScheduleRun.Validate if the designated constraints aren't met.
+type ReportScheduleRunValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportScheduleRunValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportScheduleRunValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportScheduleRunValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportScheduleRunValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportScheduleRunValidationError) ErrorName() string {
+	return "ReportScheduleRunValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportScheduleRunValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportScheduleRun.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportScheduleRunValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportScheduleRunValidationError{}
+
+// Validate checks the field values on ReportTemplateFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ReportTemplateFilter) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetColumn()) < 1 {
+		return ReportTemplateFilterValidationError{
+			field:  "Column",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if _, ok := ReportTemplateFilter_Operator_name[int32(m.GetOperator())]; !ok {
+		return ReportTemplateFilterValidationError{
+			field:  "Operator",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	// no validation rules for Value
+
+	return nil
+}
+
+// ReportTemplateFilterValidationError is the validation error returned by
+// ReportTemplateFilter.Validate if the designated constraints aren't met.
+type ReportTemplateFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportTemplateFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportTemplateFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportTemplateFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportTemplateFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportTemplateFilterValidationError) ErrorName() string {
+	return "ReportTemplateFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportTemplateFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportTemplateFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportTemplateFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportTemplateFilterValidationError{}
+
+// Validate checks the field values on ReportTemplate with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ReportTemplate) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for TemplateId
+
+	// no validation rules for ReportTypeId
+
+	// no validation rules for Name
+
+	// no validation rules for Entity
+
+	for idx, item := range m.GetFilters() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ReportTemplateValidationError{
+					field:  fmt.Sprintf("Filters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for GroupBy
+
+	// no validation rules for CreatedBy
+
+	if v, ok := interface{}(m.GetCreatedOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ReportTemplateValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdatedOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ReportTemplateValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ReportTemplateValidationError is the validation error returned by
+// ReportTemplate.Validate if the designated constraints aren't met.
+type ReportTemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportTemplateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportTemplateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportTemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportTemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportTemplateValidationError) ErrorName() string { return "ReportTemplateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReportTemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportTemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportTemplateValidationError{}
+
+// Validate checks the field values on CreateReportTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateReportTemplateRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		return CreateReportTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetEntity()) < 1 {
+		return CreateReportTemplateRequestValidationError{
+			field:  "Entity",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if len(m.GetColumns()) < 1 {
+		return CreateReportTemplateRequestValidationError{
+			field:  "Columns",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetFilters() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return CreateReportTemplateRequestValidationError{
+					field:  fmt.Sprintf("Filters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for GroupBy
+
+	return nil
+}
+
+// CreateReportTemplateRequestValidationError is the validation error returned
+// by CreateReportTemplateRequest.Validate if the designated constraints
+// aren't met.
+type CreateReportTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReportTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReportTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReportTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReportTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReportTemplateRequestValidationError) ErrorName() string {
+	return "CreateReportTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReportTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReportTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReportTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReportTemplateRequestValidationError{}
+
+// Validate checks the field values on UpdateReportTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateReportTemplateRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for TemplateId
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		return UpdateReportTemplateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetEntity()) < 1 {
+		return UpdateReportTemplateRequestValidationError{
+			field:  "Entity",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if len(m.GetColumns()) < 1 {
+		return UpdateReportTemplateRequestValidationError{
+			field:  "Columns",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetFilters() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateReportTemplateRequestValidationError{
+					field:  fmt.Sprintf("Filters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for GroupBy
+
+	return nil
+}
+
+// UpdateReportTemplateRequestValidationError is the validation error returned
+// by UpdateReportTemplateRequest.Validate if the designated constraints
+// aren't met.
+type UpdateReportTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReportTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReportTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReportTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReportTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReportTemplateRequestValidationError) ErrorName() string {
+	return "UpdateReportTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReportTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReportTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReportTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReportTemplateRequestValidationError{}
+
+// Validate checks the field values on ListReportTemplatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListReportTemplatesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// ListReportTemplatesRequestValidationError is the validation error returned
+// by ListReportTemplatesRequest.Validate if the designated constraints aren't met.
+type ListReportTemplatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportTemplatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportTemplatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportTemplatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportTemplatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportTemplatesRequestValidationError) ErrorName() string {
+	return "ListReportTemplatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportTemplatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportTemplatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportTemplatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportTemplatesRequestValidationError{}
+
+// Validate checks the field values on ListReportTemplatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListReportTemplatesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetTemplates() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListReportTemplatesResponseValidationError{
+					field:  fmt.Sprintf("Templates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListReportTemplatesResponseValidationError is the validation error returned
+// by ListReportTemplatesResponse.Validate if the designated constraints
+// aren't met.
+type ListReportTemplatesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportTemplatesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportTemplatesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportTemplatesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportTemplatesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportTemplatesResponseValidationError) ErrorName() string {
+	return "ListReportTemplatesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportTemplatesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportTemplatesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportTemplatesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportTemplatesResponseValidationError{}
+
+// Validate checks the field values on DeleteReportTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteReportTemplateRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for TemplateId
+
+	return nil
+}
+
+// DeleteReportTemplateRequestValidationError is the validation error returned
+// by DeleteReportTemplateRequest.Validate if the designated constraints
+// aren't met.
+type DeleteReportTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReportTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReportTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReportTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReportTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReportTemplateRequestValidationError) ErrorName() string {
+	return "DeleteReportTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReportTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReportTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReportTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReportTemplateRequestValidationError{}
+
+// Validate checks the field values on DeleteReportTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteReportTemplateResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Success
+
+	return nil
+}
+
+// DeleteReportTemplateResponseValidationError is the validation error returned
+// by DeleteReportTemplateResponse.Validate if the designated constraints
+// aren't met.
+type DeleteReportTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteReportTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteReportTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteReportTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteReportTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteReportTemplateResponseValidationError) ErrorName() string {
+	return "DeleteReportTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteReportTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteReportTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteReportTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteReportTemplateResponseValidationError{}
+
+// Validate checks the field values on ListReportTemplateEntitiesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ListReportTemplateEntitiesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// ListReportTemplateEntitiesRequestValidationError is the validation error
+// returned by ListReportTemplateEntitiesRequest.Validate if the designated
+// constraints aren't met.
+type ListReportTemplateEntitiesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportTemplateEntitiesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportTemplateEntitiesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportTemplateEntitiesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportTemplateEntitiesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportTemplateEntitiesRequestValidationError) ErrorName() string {
+	return "ListReportTemplateEntitiesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportTemplateEntitiesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportTemplateEntitiesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportTemplateEntitiesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportTemplateEntitiesRequestValidationError{}
+
+// Validate checks the field values on ListReportTemplateEntitiesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ListReportTemplateEntitiesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetEntities() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListReportTemplateEntitiesResponseValidationError{
+					field:  fmt.Sprintf("Entities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListReportTemplateEntitiesResponseValidationError is the validation error
+// returned by ListReportTemplateEntitiesResponse.Validate if the designated
+// constraints aren't met.
+type ListReportTemplateEntitiesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportTemplateEntitiesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportTemplateEntitiesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportTemplateEntitiesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportTemplateEntitiesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportTemplateEntitiesResponseValidationError) ErrorName() string {
+	return "ListReportTemplateEntitiesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportTemplateEntitiesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportTemplateEntitiesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportTemplateEntitiesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportTemplateEntitiesResponseValidationError{}
+
+// Validate checks the field values on ReportTemplateEntity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ReportTemplateEntity) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ReportTemplateEntityValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DynamicColumns
+
+	return nil
+}
+
+// ReportTemplateEntityValidationError is the validation error returned by
+// ReportTemplateEntity.Validate if the designated constraints aren't met.
+type ReportTemplateEntityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportTemplateEntityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportTemplateEntityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportTemplateEntityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportTemplateEntityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportTemplateEntityValidationError) ErrorName() string {
+	return "ReportTemplateEntityValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportTemplateEntityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportTemplateEntity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportTemplateEntityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportTemplateEntityValidationError{}
@@ -22,6 +22,23 @@ type ReportServiceClient interface {
 	DownloadReport(ctx context.Context, in *DownloadReportRequest, opts ...grpc.CallOption) (*DownloadReportResponse, error)
 	ListReportType(ctx context.Context, in *ListReportTypeRequest, opts ...grpc.CallOption) (*ListReportTypeResponse, error)
 	DropReportData(ctx context.Context, in *DropReportDataRequest, opts ...grpc.CallOption) (*DropReportDataResponse, error)
+	// CreateReportSchedule generates a report of the scope on a cron schedule and mails it to the recipients
+	CreateReportSchedule(ctx context.Context, in *CreateReportScheduleRequest, opts ...grpc.CallOption) (*ReportSchedule, error)
+	// UpdateReportSchedule replaces the parameters, the schedule and the recipients of a report schedule
+	UpdateReportSchedule(ctx context.Context, in *UpdateReportScheduleRequest, opts ...grpc.CallOption) (*ReportSchedule, error)
+	ListReportSchedules(ctx context.Context, in *ListReportSchedulesRequest, opts ...grpc.CallOption) (*ListReportSchedulesResponse, error)
+	DeleteReportSchedule(ctx context.Context, in *DeleteReportScheduleRequest, opts ...grpc.CallOption) (*DeleteReportScheduleResponse, error)
+	// ListReportScheduleRuns lists the past runs of a report schedule with their delivery, latest first
+	ListReportScheduleRuns(ctx context.Context, in *ListReportScheduleRunsRequest, opts ...grpc.CallOption) (*ListReportScheduleRunsResponse, error)
+	// ListReportTemplateEntities lists the entities the report templates are built on with their columns
+	ListReportTemplateEntities(ctx context.Context, in *ListReportTemplateEntitiesRequest, opts ...grpc.CallOption) (*ListReportTemplateEntitiesResponse, error)
+	// CreateReportTemplate adds a report type generating the table of the template, admins only
+	CreateReportTemplate(ctx context.Context, in *CreateReportTemplateRequest, opts ...grpc.CallOption) (*ReportTemplate, error)
+	// UpdateReportTemplate replaces the definition of a report template, the next reports use it
+	UpdateReportTemplate(ctx context.Context, in *UpdateReportTemplateRequest, opts ...grpc.CallOption) (*ReportTemplate, error)
+	ListReportTemplates(ctx context.Context, in *ListReportTemplatesRequest, opts ...grpc.CallOption) (*ListReportTemplatesResponse, error)
+	// DeleteReportTemplate removes a report template and its schedules, the reports already generated are kept
+	DeleteReportTemplate(ctx context.Context, in *DeleteReportTemplateRequest, opts ...grpc.CallOption) (*DeleteReportTemplateResponse, error)
 }
 
 type reportServiceClient struct {
@@ -77,6 +94,96 @@ func (c *reportServiceClient) DropReportData(ctx context.Context, in *DropReport
 	return out, nil
 }
 
+func (c *reportServiceClient) CreateReportSchedule(ctx context.Context, in *CreateReportScheduleRequest, opts ...grpc.CallOption) (*ReportSchedule, error) {
+	out := new(ReportSchedule)
+	err := c.cc.Invoke(ctx, "/optisam.reports.v1.ReportService/CreateReportSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) UpdateReportSchedule(ctx context.Context, in *UpdateReportScheduleRequest, opts ...grpc.CallOption) (*ReportSchedule, error) {
+	out := new(ReportSchedule)
+	err := c.cc.Invoke(ctx, "/optisam.reports.v1.ReportService/UpdateReportSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) ListReportSchedules(ctx context.Context, in *ListReportSchedulesRequest, opts ...grpc.CallOption) (*ListReportSchedulesResponse, error) {
+	out := new(ListReportSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/optisam.reports.v1.ReportService/ListReportSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) DeleteReportSchedule(ctx context.Context, in *DeleteReportScheduleRequest, opts ...grpc.CallOption) (*DeleteReportScheduleResponse, error) {
+	out := new(DeleteReportScheduleResponse)
+	err := c.cc.Invoke(ctx, "/optisam.reports.v1.ReportService/DeleteReportSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) ListReportScheduleRuns(ctx context.Context, in *ListReportScheduleRunsRequest, opts ...grpc.CallOption) (*ListReportScheduleRunsResponse, error) {
+	out := new(ListReportScheduleRunsResponse)
+	err := c.cc.Invoke(ctx, "/optisam.reports.v1.ReportService/ListReportScheduleRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) ListReportTemplateEntities(ctx context.Context, in *ListReportTemplateEntitiesRequest, opts ...grpc.CallOption) (*ListReportTemplateEntitiesResponse, error) {
+	out := new(ListReportTemplateEntitiesResponse)
+	err := c.cc.Invoke(ctx, "/optisam.reports.v1.ReportService/ListReportTemplateEntities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) CreateReportTemplate(ctx context.Context, in *CreateReportTemplateRequest, opts ...grpc.CallOption) (*ReportTemplate, error) {
+	out := new(ReportTemplate)
+	err := c.cc.Invoke(ctx, "/optisam.reports.v1.ReportService/CreateReportTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) UpdateReportTemplate(ctx context.Context, in *UpdateReportTemplateRequest, opts ...grpc.CallOption) (*ReportTemplate, error) {
+	out := new(ReportTemplate)
+	err := c.cc.Invoke(ctx, "/optisam.reports.v1.ReportService/UpdateReportTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) ListReportTemplates(ctx context.Context, in *ListReportTemplatesRequest, opts ...grpc.CallOption) (*ListReportTemplatesResponse, error) {
+	out := new(ListReportTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/optisam.reports.v1.ReportService/ListReportTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) DeleteReportTemplate(ctx context.Context, in *DeleteReportTemplateRequest, opts ...grpc.CallOption) (*DeleteReportTemplateResponse, error) {
+	out := new(DeleteReportTemplateResponse)
+	err := c.cc.Invoke(ctx, "/optisam.reports.v1.ReportService/DeleteReportTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReportServiceServer is the server API for ReportService service.
 // All implementations should embed UnimplementedReportServiceServer
 // for forward compatibility
@@ -86,6 +193,23 @@ type ReportServiceServer interface {
 	DownloadReport(context.Context, *DownloadReportRequest) (*DownloadReportResponse, error)
 	ListReportType(context.Context, *ListReportTypeRequest) (*ListReportTypeResponse, error)
 	DropReportData(context.Context, *DropReportDataRequest) (*DropReportDataResponse, error)
+	// CreateReportSchedule generates a report of the scope on a cron schedule and mails it to the recipients
+	CreateReportSchedule(context.Context, *CreateReportScheduleRequest) (*ReportSchedule, error)
+	// UpdateReportSchedule replaces the parameters, the schedule and the recipients of a report schedule
+	UpdateReportSchedule(context.Context, *UpdateReportScheduleRequest) (*ReportSchedule, error)
+	ListReportSchedules(context.Context, *ListReportSchedulesRequest) (*ListReportSchedulesResponse, error)
+	DeleteReportSchedule(context.Context, *DeleteReportScheduleRequest) (*DeleteReportScheduleResponse, error)
+	// ListReportScheduleRuns lists the past runs of a report schedule with their delivery, latest first
+	ListReportScheduleRuns(context.Context, *ListReportScheduleRunsRequest) (*ListReportScheduleRunsResponse, error)
+	// ListReportTemplateEntities lists the entities the report templates are built on with their columns
+	ListReportTemplateEntities(context.Context, *ListReportTemplateEntitiesRequest) (*ListReportTemplateEntitiesResponse, error)
+	// CreateReportTemplate adds a report type generating the table of the template, admins only
+	CreateReportTemplate(context.Context, *CreateReportTemplateRequest) (*ReportTemplate, error)
+	// UpdateReportTemplate replaces the definition of a report template, the next reports use it
+	UpdateReportTemplate(context.Context, *UpdateReportTemplateRequest) (*ReportTemplate, error)
+	ListReportTemplates(context.Context, *ListReportTemplatesRequest) (*ListReportTemplatesResponse, error)
+	// DeleteReportTemplate removes a report template and its schedules, the reports already generated are kept
+	DeleteReportTemplate(context.Context, *DeleteReportTemplateRequest) (*DeleteReportTemplateResponse, error)
 }
 
 // UnimplementedReportServiceServer should be embedded to have forward compatible implementations.
@@ -107,6 +231,36 @@ func (UnimplementedReportServiceServer) ListReportType(context.Context, *ListRep
 func (UnimplementedReportServiceServer) DropReportData(context.Context, *DropReportDataRequest) (*DropReportDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropReportData not implemented")
 }
+func (UnimplementedReportServiceServer) CreateReportSchedule(context.Context, *CreateReportScheduleRequest) (*ReportSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReportSchedule not implemented")
+}
+func (UnimplementedReportServiceServer) UpdateReportSchedule(context.Context, *UpdateReportScheduleRequest) (*ReportSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReportSchedule not implemented")
+}
+func (UnimplementedReportServiceServer) ListReportSchedules(context.Context, *ListReportSchedulesRequest) (*ListReportSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReportSchedules not implemented")
+}
+func (UnimplementedReportServiceServer) DeleteReportSchedule(context.Context, *DeleteReportScheduleRequest) (*DeleteReportScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReportSchedule not implemented")
+}
+func (UnimplementedReportServiceServer) ListReportScheduleRuns(context.Context, *ListReportScheduleRunsRequest) (*ListReportScheduleRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReportScheduleRuns not implemented")
+}
+func (UnimplementedReportServiceServer) ListReportTemplateEntities(context.Context, *ListReportTemplateEntitiesRequest) (*ListReportTemplateEntitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReportTemplateEntities not implemented")
+}
+func (UnimplementedReportServiceServer) CreateReportTemplate(context.Context, *CreateReportTemplateRequest) (*ReportTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReportTemplate not implemented")
+}
+func (UnimplementedReportServiceServer) UpdateReportTemplate(context.Context, *UpdateReportTemplateRequest) (*ReportTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReportTemplate not implemented")
+}
+func (UnimplementedReportServiceServer) ListReportTemplates(context.Context, *ListReportTemplatesRequest) (*ListReportTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReportTemplates not implemented")
+}
+func (UnimplementedReportServiceServer) DeleteReportTemplate(context.Context, *DeleteReportTemplateRequest) (*DeleteReportTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReportTemplate not implemented")
+}
 
 // UnsafeReportServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ReportServiceServer will
@@ -209,6 +363,186 @@ func _ReportService_DropReportData_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReportService_CreateReportSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReportScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).CreateReportSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.reports.v1.ReportService/CreateReportSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).CreateReportSchedule(ctx, req.(*CreateReportScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_UpdateReportSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReportScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).UpdateReportSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.reports.v1.ReportService/UpdateReportSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).UpdateReportSchedule(ctx, req.(*UpdateReportScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_ListReportSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).ListReportSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.reports.v1.ReportService/ListReportSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).ListReportSchedules(ctx, req.(*ListReportSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_DeleteReportSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReportScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).DeleteReportSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.reports.v1.ReportService/DeleteReportSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).DeleteReportSchedule(ctx, req.(*DeleteReportScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_ListReportScheduleRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportScheduleRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).ListReportScheduleRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.reports.v1.ReportService/ListReportScheduleRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).ListReportScheduleRuns(ctx, req.(*ListReportScheduleRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_ListReportTemplateEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportTemplateEntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).ListReportTemplateEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.reports.v1.ReportService/ListReportTemplateEntities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).ListReportTemplateEntities(ctx, req.(*ListReportTemplateEntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_CreateReportTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReportTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).CreateReportTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.reports.v1.ReportService/CreateReportTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).CreateReportTemplate(ctx, req.(*CreateReportTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_UpdateReportTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReportTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).UpdateReportTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.reports.v1.ReportService/UpdateReportTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).UpdateReportTemplate(ctx, req.(*UpdateReportTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_ListReportTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).ListReportTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.reports.v1.ReportService/ListReportTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).ListReportTemplates(ctx, req.(*ListReportTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_DeleteReportTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReportTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).DeleteReportTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.reports.v1.ReportService/DeleteReportTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).DeleteReportTemplate(ctx, req.(*DeleteReportTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ReportService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "optisam.reports.v1.ReportService",
 	HandlerType: (*ReportServiceServer)(nil),
@@ -233,6 +567,46 @@ var _ReportService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DropReportData",
 			Handler:    _ReportService_DropReportData_Handler,
 		},
+		{
+			MethodName: "CreateReportSchedule",
+			Handler:    _ReportService_CreateReportSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateReportSchedule",
+			Handler:    _ReportService_UpdateReportSchedule_Handler,
+		},
+		{
+			MethodName: "ListReportSchedules",
+			Handler:    _ReportService_ListReportSchedules_Handler,
+		},
+		{
+			MethodName: "DeleteReportSchedule",
+			Handler:    _ReportService_DeleteReportSchedule_Handler,
+		},
+		{
+			MethodName: "ListReportScheduleRuns",
+			Handler:    _ReportService_ListReportScheduleRuns_Handler,
+		},
+		{
+			MethodName: "ListReportTemplateEntities",
+			Handler:    _ReportService_ListReportTemplateEntities_Handler,
+		},
+		{
+			MethodName: "CreateReportTemplate",
+			Handler:    _ReportService_CreateReportTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateReportTemplate",
+			Handler:    _ReportService_UpdateReportTemplate_Handler,
+		},
+		{
+			MethodName: "ListReportTemplates",
+			Handler:    _ReportService_ListReportTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteReportTemplate",
+			Handler:    _ReportService_DeleteReportTemplate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "report.proto",
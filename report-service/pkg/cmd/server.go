@@ -19,6 +19,7 @@ import (
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/postgres"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/prometheus"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/admin"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/config"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/protocol/grpc"
 	"gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/protocol/rest"
@@ -222,14 +223,19 @@ func RunServer() error {
 	log.Printf(" config %+v  grpcConn %+v", cfg, grpcClientMap)
 
 	rep := repo.NewReportRepository(db)
-	v1API := v1.NewReportServiceServer(rep, q, grpcClientMap)
+	access := worker.NewAccess(grpcClientMap, cfg.HTTPServers.Address["auth"], cfg.Delivery.ClientID, cfg.Delivery.ClientSecret)
+	v1API := v1.NewReportServiceServer(rep, q, grpcClientMap, access)
 
 	for i := 0; i < cfg.MaxAPIWorker; i++ {
-		rWorker := worker.NewWorker("rw", rep, grpcClientMap, drep, cfg.WorkerQueue.Retries)
+		rWorker := worker.NewWorker("rw", rep, grpcClientMap, drep, cfg.WorkerQueue.Retries, cfg.Delivery.ReportLink, access)
 		q.RegisterWorker(ctx, rWorker)
 	}
 
 	q.IsWorkerRegCompleted = true
+	// report schedules submit their reports on their own schedules
+	if err := v1.StartReportSchedules(ctx, v1API); err != nil {
+		logger.Log.Error("Failed to schedule reports", zap.Error(err))
+	}
 	// get the verify key to validate jwt
 	verifyKey, err := iam.GetVerifyKey(cfg.IAM)
 	if err != nil {
@@ -249,8 +255,14 @@ func RunServer() error {
 			logger.Log.Sugar().Debug("Recovered in RunServer", r)
 		}
 	}()
+	auditPublisher, err := iam.NewAuditPublisher(cfg.IAM)
+	if err != nil {
+		logger.Log.Fatal("Failed to create audit publisher", zap.Error(err))
+	}
+	revoked := iam.NewRevocationList(cfg.IAM)
+	apiKeys := iam.NewAPIKeyStore(cfg.IAM)
 	go func() {
-		_ = rest.RunServer(ctx, cfg.GRPCPort, cfg.HTTPPort, verifyKey)
+		_ = rest.RunServer(ctx, cfg.GRPCPort, cfg.HTTPPort, verifyKey, revoked, apiKeys)
 	}()
-	return grpc.RunServer(ctx, v1API, cfg.GRPCPort, verifyKey, authZPolicies, cfg.IAM.APIKey)
+	return grpc.RunServer(ctx, v1API, admin.NewServer(q), cfg.GRPCPort, verifyKey, authZPolicies, cfg.IAM.APIKey, revoked, apiKeys, auditPublisher)
 }
@@ -35,6 +35,9 @@ type Config struct {
 
 	GrpcServers grpc.Config
 
+	// For interservice http calls(non grpc server)["ip:port"]
+	HTTPServers httpConfg
+
 	// HTTP/REST gateway start parameters section
 	// HTTPPort is TCP port to listen by HTTP/REST gateway
 	HTTPPort string
@@ -59,6 +62,9 @@ type Config struct {
 
 	AppParams AppParameters
 
+	// Delivery configures the mails of the scheduled reports
+	Delivery DeliveryConfig
+
 	// IAM Configuration
 	IAM iam.Config
 }
@@ -88,6 +94,21 @@ type AppParameters struct {
 	SortOrder string
 }
 
+// DeliveryConfig holds the delivery parameters of the scheduled reports
+type DeliveryConfig struct {
+	// ReportLink is the link mailed for reports delivered by link,
+	// {report_id} and {scope} are replaced by the ones of the report.
+	ReportLink string
+	// ClientID and ClientSecret are the credentials of the report service client registered in auth service,
+	// the mails are sent and the recipients checked with its tokens.
+	ClientID     string
+	ClientSecret string
+}
+
+type httpConfg struct {
+	Address map[string]string
+}
+
 // Validate validates the configuration.
 func (c Config) Validate() error {
 	if c.Environment == "" {
@@ -34,6 +34,95 @@ func (m *MockReport) EXPECT() *MockReportMockRecorder {
 	return m.recorder
 }
 
+// ClaimReportScheduleRun mocks base method
+func (m *MockReport) ClaimReportScheduleRun(arg0 context.Context, arg1 db.ClaimReportScheduleRunParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimReportScheduleRun", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimReportScheduleRun indicates an expected call of ClaimReportScheduleRun
+func (mr *MockReportMockRecorder) ClaimReportScheduleRun(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimReportScheduleRun", reflect.TypeOf((*MockReport)(nil).ClaimReportScheduleRun), arg0, arg1)
+}
+
+// CreateReportSchedule mocks base method
+func (m *MockReport) CreateReportSchedule(arg0 context.Context, arg1 db.CreateReportScheduleParams) (db.ReportSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReportSchedule", arg0, arg1)
+	ret0, _ := ret[0].(db.ReportSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReportSchedule indicates an expected call of CreateReportSchedule
+func (mr *MockReportMockRecorder) CreateReportSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReportSchedule", reflect.TypeOf((*MockReport)(nil).CreateReportSchedule), arg0, arg1)
+}
+
+// CreateReportTemplate mocks base method
+func (m *MockReport) CreateReportTemplate(arg0 context.Context, arg1 db.CreateReportTemplateParams) (db.ReportTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReportTemplate", arg0, arg1)
+	ret0, _ := ret[0].(db.ReportTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReportTemplate indicates an expected call of CreateReportTemplate
+func (mr *MockReportMockRecorder) CreateReportTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReportTemplate", reflect.TypeOf((*MockReport)(nil).CreateReportTemplate), arg0, arg1)
+}
+
+// DeleteReportSchedule mocks base method
+func (m *MockReport) DeleteReportSchedule(arg0 context.Context, arg1 db.DeleteReportScheduleParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReportSchedule", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReportSchedule indicates an expected call of DeleteReportSchedule
+func (mr *MockReportMockRecorder) DeleteReportSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReportSchedule", reflect.TypeOf((*MockReport)(nil).DeleteReportSchedule), arg0, arg1)
+}
+
+// DeleteReportSchedulesByScope mocks base method
+func (m *MockReport) DeleteReportSchedulesByScope(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReportSchedulesByScope", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReportSchedulesByScope indicates an expected call of DeleteReportSchedulesByScope
+func (mr *MockReportMockRecorder) DeleteReportSchedulesByScope(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReportSchedulesByScope", reflect.TypeOf((*MockReport)(nil).DeleteReportSchedulesByScope), arg0, arg1)
+}
+
+// DeleteReportTemplate mocks base method
+func (m *MockReport) DeleteReportTemplate(arg0 context.Context, arg1 int32) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReportTemplate", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReportTemplate indicates an expected call of DeleteReportTemplate
+func (mr *MockReportMockRecorder) DeleteReportTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReportTemplate", reflect.TypeOf((*MockReport)(nil).DeleteReportTemplate), arg0, arg1)
+}
+
 // DeleteReportsByScope mocks base method
 func (m *MockReport) DeleteReportsByScope(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -78,6 +167,51 @@ func (mr *MockReportMockRecorder) GetReport(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockReport)(nil).GetReport), arg0, arg1)
 }
 
+// GetReportSchedule mocks base method
+func (m *MockReport) GetReportSchedule(arg0 context.Context, arg1 int32) (db.ReportSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportSchedule", arg0, arg1)
+	ret0, _ := ret[0].(db.ReportSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReportSchedule indicates an expected call of GetReportSchedule
+func (mr *MockReportMockRecorder) GetReportSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportSchedule", reflect.TypeOf((*MockReport)(nil).GetReportSchedule), arg0, arg1)
+}
+
+// GetReportTemplate mocks base method
+func (m *MockReport) GetReportTemplate(arg0 context.Context, arg1 int32) (db.ReportTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportTemplate", arg0, arg1)
+	ret0, _ := ret[0].(db.ReportTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReportTemplate indicates an expected call of GetReportTemplate
+func (mr *MockReportMockRecorder) GetReportTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportTemplate", reflect.TypeOf((*MockReport)(nil).GetReportTemplate), arg0, arg1)
+}
+
+// GetReportTemplateByType mocks base method
+func (m *MockReport) GetReportTemplateByType(arg0 context.Context, arg1 int32) (db.ReportTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportTemplateByType", arg0, arg1)
+	ret0, _ := ret[0].(db.ReportTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReportTemplateByType indicates an expected call of GetReportTemplateByType
+func (mr *MockReportMockRecorder) GetReportTemplateByType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportTemplateByType", reflect.TypeOf((*MockReport)(nil).GetReportTemplateByType), arg0, arg1)
+}
+
 // GetReportType mocks base method
 func (m *MockReport) GetReportType(arg0 context.Context, arg1 int32) (db.ReportType, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +256,81 @@ func (mr *MockReportMockRecorder) InsertReportData(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertReportData", reflect.TypeOf((*MockReport)(nil).InsertReportData), arg0, arg1)
 }
 
+// InsertReportScheduleRun mocks base method
+func (m *MockReport) InsertReportScheduleRun(arg0 context.Context, arg1 db.InsertReportScheduleRunParams) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertReportScheduleRun", arg0, arg1)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertReportScheduleRun indicates an expected call of InsertReportScheduleRun
+func (mr *MockReportMockRecorder) InsertReportScheduleRun(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertReportScheduleRun", reflect.TypeOf((*MockReport)(nil).InsertReportScheduleRun), arg0, arg1)
+}
+
+// ListEnabledReportSchedules mocks base method
+func (m *MockReport) ListEnabledReportSchedules(arg0 context.Context) ([]db.ReportSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnabledReportSchedules", arg0)
+	ret0, _ := ret[0].([]db.ReportSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnabledReportSchedules indicates an expected call of ListEnabledReportSchedules
+func (mr *MockReportMockRecorder) ListEnabledReportSchedules(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnabledReportSchedules", reflect.TypeOf((*MockReport)(nil).ListEnabledReportSchedules), arg0)
+}
+
+// ListReportScheduleRuns mocks base method
+func (m *MockReport) ListReportScheduleRuns(arg0 context.Context, arg1 db.ListReportScheduleRunsParams) ([]db.ListReportScheduleRunsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReportScheduleRuns", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListReportScheduleRunsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReportScheduleRuns indicates an expected call of ListReportScheduleRuns
+func (mr *MockReportMockRecorder) ListReportScheduleRuns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReportScheduleRuns", reflect.TypeOf((*MockReport)(nil).ListReportScheduleRuns), arg0, arg1)
+}
+
+// ListReportSchedules mocks base method
+func (m *MockReport) ListReportSchedules(arg0 context.Context, arg1 []string) ([]db.ReportSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReportSchedules", arg0, arg1)
+	ret0, _ := ret[0].([]db.ReportSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReportSchedules indicates an expected call of ListReportSchedules
+func (mr *MockReportMockRecorder) ListReportSchedules(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReportSchedules", reflect.TypeOf((*MockReport)(nil).ListReportSchedules), arg0, arg1)
+}
+
+// ListReportTemplates mocks base method
+func (m *MockReport) ListReportTemplates(arg0 context.Context) ([]db.ReportTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReportTemplates", arg0)
+	ret0, _ := ret[0].([]db.ReportTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReportTemplates indicates an expected call of ListReportTemplates
+func (mr *MockReportMockRecorder) ListReportTemplates(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReportTemplates", reflect.TypeOf((*MockReport)(nil).ListReportTemplates), arg0)
+}
+
 // SubmitReport mocks base method
 func (m *MockReport) SubmitReport(arg0 context.Context, arg1 db.SubmitReportParams) (int32, error) {
 	m.ctrl.T.Helper()
@@ -137,6 +346,35 @@ func (mr *MockReportMockRecorder) SubmitReport(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitReport", reflect.TypeOf((*MockReport)(nil).SubmitReport), arg0, arg1)
 }
 
+// UpdateReportSchedule mocks base method
+func (m *MockReport) UpdateReportSchedule(arg0 context.Context, arg1 db.UpdateReportScheduleParams) (db.ReportSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReportSchedule", arg0, arg1)
+	ret0, _ := ret[0].(db.ReportSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReportSchedule indicates an expected call of UpdateReportSchedule
+func (mr *MockReportMockRecorder) UpdateReportSchedule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReportSchedule", reflect.TypeOf((*MockReport)(nil).UpdateReportSchedule), arg0, arg1)
+}
+
+// UpdateReportScheduleRunDelivery mocks base method
+func (m *MockReport) UpdateReportScheduleRunDelivery(arg0 context.Context, arg1 db.UpdateReportScheduleRunDeliveryParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReportScheduleRunDelivery", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReportScheduleRunDelivery indicates an expected call of UpdateReportScheduleRunDelivery
+func (mr *MockReportMockRecorder) UpdateReportScheduleRunDelivery(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReportScheduleRunDelivery", reflect.TypeOf((*MockReport)(nil).UpdateReportScheduleRunDelivery), arg0, arg1)
+}
+
 // UpdateReportStatus mocks base method
 func (m *MockReport) UpdateReportStatus(arg0 context.Context, arg1 db.UpdateReportStatusParams) error {
 	m.ctrl.T.Helper()
@@ -150,3 +388,18 @@ func (mr *MockReportMockRecorder) UpdateReportStatus(arg0, arg1 interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReportStatus", reflect.TypeOf((*MockReport)(nil).UpdateReportStatus), arg0, arg1)
 }
+
+// UpdateReportTemplate mocks base method
+func (m *MockReport) UpdateReportTemplate(arg0 context.Context, arg1 db.UpdateReportTemplateParams) (db.ReportTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReportTemplate", arg0, arg1)
+	ret0, _ := ret[0].(db.ReportTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReportTemplate indicates an expected call of UpdateReportTemplate
+func (mr *MockReportMockRecorder) UpdateReportTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReportTemplate", reflect.TypeOf((*MockReport)(nil).UpdateReportTemplate), arg0, arg1)
+}
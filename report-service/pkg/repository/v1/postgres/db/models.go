@@ -9,6 +9,45 @@ import (
 	"time"
 )
 
+type DeliveryMode string
+
+const (
+	DeliveryModeATTACHMENT DeliveryMode = "ATTACHMENT"
+	DeliveryModeLINK       DeliveryMode = "LINK"
+)
+
+func (e *DeliveryMode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DeliveryMode(s)
+	case string:
+		*e = DeliveryMode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DeliveryMode: %T", src)
+	}
+	return nil
+}
+
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPENDING DeliveryStatus = "PENDING"
+	DeliveryStatusSENT    DeliveryStatus = "SENT"
+	DeliveryStatusFAILED  DeliveryStatus = "FAILED"
+)
+
+func (e *DeliveryStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DeliveryStatus(s)
+	case string:
+		*e = DeliveryStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DeliveryStatus: %T", src)
+	}
+	return nil
+}
+
 type JobStatus string
 
 const (
@@ -17,6 +56,7 @@ const (
 	JobStatusFAILED    JobStatus = "FAILED"
 	JobStatusRETRY     JobStatus = "RETRY"
 	JobStatusRUNNING   JobStatus = "RUNNING"
+	JobStatusDEAD      JobStatus = "DEAD"
 )
 
 func (e *JobStatus) Scan(src interface{}) error {
@@ -76,7 +116,43 @@ type Report struct {
 	CreatedOn      time.Time       `json:"created_on"`
 }
 
+type ReportSchedule struct {
+	ScheduleID     int32           `json:"schedule_id"`
+	Scope          string          `json:"scope"`
+	ReportTypeID   int32           `json:"report_type_id"`
+	ReportMetadata json.RawMessage `json:"report_metadata"`
+	CronExpr       string          `json:"cron_expr"`
+	Recipients     []string        `json:"recipients"`
+	DeliveryMode   DeliveryMode    `json:"delivery_mode"`
+	Enabled        bool            `json:"enabled"`
+	LastRunOn      sql.NullTime    `json:"last_run_on"`
+	CreatedBy      string          `json:"created_by"`
+	CreatedOn      time.Time       `json:"created_on"`
+	UpdatedOn      time.Time       `json:"updated_on"`
+}
+
+type ReportScheduleRun struct {
+	RunID          int32          `json:"run_id"`
+	ScheduleID     int32          `json:"schedule_id"`
+	ReportID       int32          `json:"report_id"`
+	RunOn          time.Time      `json:"run_on"`
+	DeliveryStatus DeliveryStatus `json:"delivery_status"`
+	DeliveryError  sql.NullString `json:"delivery_error"`
+	DeliveredOn    sql.NullTime   `json:"delivered_on"`
+}
+
+type ReportTemplate struct {
+	TemplateID   int32           `json:"template_id"`
+	ReportTypeID int32           `json:"report_type_id"`
+	TemplateName string          `json:"template_name"`
+	Definition   json.RawMessage `json:"definition"`
+	CreatedBy    string          `json:"created_by"`
+	CreatedOn    time.Time       `json:"created_on"`
+	UpdatedOn    time.Time       `json:"updated_on"`
+}
+
 type ReportType struct {
 	ReportTypeID   int32  `json:"report_type_id"`
 	ReportTypeName string `json:"report_type_name"`
+	Archived       bool   `json:"archived"`
 }
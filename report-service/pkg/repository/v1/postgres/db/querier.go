@@ -7,14 +7,31 @@ import (
 )
 
 type Querier interface {
+	ClaimReportScheduleRun(ctx context.Context, arg ClaimReportScheduleRunParams) (int64, error)
+	CreateReportSchedule(ctx context.Context, arg CreateReportScheduleParams) (ReportSchedule, error)
+	CreateReportTemplate(ctx context.Context, arg CreateReportTemplateParams) (ReportTemplate, error)
+	DeleteReportSchedule(ctx context.Context, arg DeleteReportScheduleParams) (int64, error)
+	DeleteReportSchedulesByScope(ctx context.Context, scope string) error
+	DeleteReportTemplate(ctx context.Context, templateID int32) (int64, error)
 	DeleteReportsByScope(ctx context.Context, scope string) error
 	DownloadReport(ctx context.Context, arg DownloadReportParams) (DownloadReportRow, error)
 	GetReport(ctx context.Context, arg GetReportParams) ([]GetReportRow, error)
+	GetReportSchedule(ctx context.Context, scheduleID int32) (ReportSchedule, error)
+	GetReportTemplate(ctx context.Context, templateID int32) (ReportTemplate, error)
+	GetReportTemplateByType(ctx context.Context, reportTypeID int32) (ReportTemplate, error)
 	GetReportType(ctx context.Context, reportTypeID int32) (ReportType, error)
 	GetReportTypes(ctx context.Context) ([]ReportType, error)
 	InsertReportData(ctx context.Context, arg InsertReportDataParams) error
+	InsertReportScheduleRun(ctx context.Context, arg InsertReportScheduleRunParams) (int32, error)
+	ListEnabledReportSchedules(ctx context.Context) ([]ReportSchedule, error)
+	ListReportScheduleRuns(ctx context.Context, arg ListReportScheduleRunsParams) ([]ListReportScheduleRunsRow, error)
+	ListReportSchedules(ctx context.Context, scope []string) ([]ReportSchedule, error)
+	ListReportTemplates(ctx context.Context) ([]ReportTemplate, error)
 	SubmitReport(ctx context.Context, arg SubmitReportParams) (int32, error)
+	UpdateReportSchedule(ctx context.Context, arg UpdateReportScheduleParams) (ReportSchedule, error)
+	UpdateReportScheduleRunDelivery(ctx context.Context, arg UpdateReportScheduleRunDeliveryParams) error
 	UpdateReportStatus(ctx context.Context, arg UpdateReportStatusParams) error
+	UpdateReportTemplate(ctx context.Context, arg UpdateReportTemplateParams) (ReportTemplate, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -5,12 +5,157 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"time"
 
 	"github.com/lib/pq"
 )
 
+const claimReportScheduleRun = `-- name: ClaimReportScheduleRun :execrows
+UPDATE report_schedule
+SET last_run_on = $1
+WHERE schedule_id = $2
+AND enabled = TRUE
+AND (last_run_on IS NULL OR last_run_on < $1)
+`
+
+type ClaimReportScheduleRunParams struct {
+	RunOn      time.Time `json:"run_on"`
+	ScheduleID int32     `json:"schedule_id"`
+}
+
+func (q *Queries) ClaimReportScheduleRun(ctx context.Context, arg ClaimReportScheduleRunParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, claimReportScheduleRun, arg.RunOn, arg.ScheduleID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createReportSchedule = `-- name: CreateReportSchedule :one
+INSERT INTO
+report_schedule(scope,report_type_id,report_metadata,cron_expr,recipients,delivery_mode,enabled,created_by)
+VALUES($1,$2,$3,$4,$5,$6,$7,$8) RETURNING schedule_id, scope, report_type_id, report_metadata, cron_expr, recipients, delivery_mode, enabled, last_run_on, created_by, created_on, updated_on
+`
+
+type CreateReportScheduleParams struct {
+	Scope          string          `json:"scope"`
+	ReportTypeID   int32           `json:"report_type_id"`
+	ReportMetadata json.RawMessage `json:"report_metadata"`
+	CronExpr       string          `json:"cron_expr"`
+	Recipients     []string        `json:"recipients"`
+	DeliveryMode   DeliveryMode    `json:"delivery_mode"`
+	Enabled        bool            `json:"enabled"`
+	CreatedBy      string          `json:"created_by"`
+}
+
+func (q *Queries) CreateReportSchedule(ctx context.Context, arg CreateReportScheduleParams) (ReportSchedule, error) {
+	row := q.db.QueryRowContext(ctx, createReportSchedule,
+		arg.Scope,
+		arg.ReportTypeID,
+		arg.ReportMetadata,
+		arg.CronExpr,
+		pq.Array(arg.Recipients),
+		arg.DeliveryMode,
+		arg.Enabled,
+		arg.CreatedBy,
+	)
+	var i ReportSchedule
+	err := row.Scan(
+		&i.ScheduleID,
+		&i.Scope,
+		&i.ReportTypeID,
+		&i.ReportMetadata,
+		&i.CronExpr,
+		pq.Array(&i.Recipients),
+		&i.DeliveryMode,
+		&i.Enabled,
+		&i.LastRunOn,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.UpdatedOn,
+	)
+	return i, err
+}
+
+const createReportTemplate = `-- name: CreateReportTemplate :one
+WITH rt AS (
+  INSERT INTO report_type(report_type_name) VALUES($1) RETURNING report_type_id
+)
+INSERT INTO
+report_template(report_type_id,template_name,definition,created_by)
+SELECT rt.report_type_id,$1,$2,$3 FROM rt RETURNING template_id, report_type_id, template_name, definition, created_by, created_on, updated_on
+`
+
+type CreateReportTemplateParams struct {
+	TemplateName string          `json:"template_name"`
+	Definition   json.RawMessage `json:"definition"`
+	CreatedBy    string          `json:"created_by"`
+}
+
+func (q *Queries) CreateReportTemplate(ctx context.Context, arg CreateReportTemplateParams) (ReportTemplate, error) {
+	row := q.db.QueryRowContext(ctx, createReportTemplate, arg.TemplateName, arg.Definition, arg.CreatedBy)
+	var i ReportTemplate
+	err := row.Scan(
+		&i.TemplateID,
+		&i.ReportTypeID,
+		&i.TemplateName,
+		&i.Definition,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.UpdatedOn,
+	)
+	return i, err
+}
+
+const deleteReportSchedule = `-- name: DeleteReportSchedule :execrows
+DELETE FROM report_schedule
+WHERE schedule_id = $1
+AND scope = $2
+`
+
+type DeleteReportScheduleParams struct {
+	ScheduleID int32  `json:"schedule_id"`
+	Scope      string `json:"scope"`
+}
+
+func (q *Queries) DeleteReportSchedule(ctx context.Context, arg DeleteReportScheduleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteReportSchedule, arg.ScheduleID, arg.Scope)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteReportSchedulesByScope = `-- name: DeleteReportSchedulesByScope :exec
+DELETE FROM report_schedule WHERE scope = $1
+`
+
+func (q *Queries) DeleteReportSchedulesByScope(ctx context.Context, scope string) error {
+	_, err := q.db.ExecContext(ctx, deleteReportSchedulesByScope, scope)
+	return err
+}
+
+const deleteReportTemplate = `-- name: DeleteReportTemplate :execrows
+WITH t AS (
+  DELETE FROM report_template WHERE template_id = $1 RETURNING report_type_id
+), s AS (
+  DELETE FROM report_schedule WHERE report_type_id IN (SELECT report_type_id FROM t)
+)
+UPDATE report_type
+SET archived = TRUE
+WHERE report_type_id IN (SELECT report_type_id FROM t)
+`
+
+func (q *Queries) DeleteReportTemplate(ctx context.Context, templateID int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteReportTemplate, templateID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteReportsByScope = `-- name: DeleteReportsByScope :exec
 Delete from report where scope = $1
 `
@@ -150,20 +295,86 @@ func (q *Queries) GetReport(ctx context.Context, arg GetReportParams) ([]GetRepo
 	return items, nil
 }
 
+const getReportSchedule = `-- name: GetReportSchedule :one
+SELECT schedule_id, scope, report_type_id, report_metadata, cron_expr, recipients, delivery_mode, enabled, last_run_on, created_by, created_on, updated_on FROM report_schedule
+WHERE schedule_id = $1
+`
+
+func (q *Queries) GetReportSchedule(ctx context.Context, scheduleID int32) (ReportSchedule, error) {
+	row := q.db.QueryRowContext(ctx, getReportSchedule, scheduleID)
+	var i ReportSchedule
+	err := row.Scan(
+		&i.ScheduleID,
+		&i.Scope,
+		&i.ReportTypeID,
+		&i.ReportMetadata,
+		&i.CronExpr,
+		pq.Array(&i.Recipients),
+		&i.DeliveryMode,
+		&i.Enabled,
+		&i.LastRunOn,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.UpdatedOn,
+	)
+	return i, err
+}
+
+const getReportTemplate = `-- name: GetReportTemplate :one
+SELECT template_id, report_type_id, template_name, definition, created_by, created_on, updated_on FROM report_template
+WHERE template_id = $1
+`
+
+func (q *Queries) GetReportTemplate(ctx context.Context, templateID int32) (ReportTemplate, error) {
+	row := q.db.QueryRowContext(ctx, getReportTemplate, templateID)
+	var i ReportTemplate
+	err := row.Scan(
+		&i.TemplateID,
+		&i.ReportTypeID,
+		&i.TemplateName,
+		&i.Definition,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.UpdatedOn,
+	)
+	return i, err
+}
+
+const getReportTemplateByType = `-- name: GetReportTemplateByType :one
+SELECT template_id, report_type_id, template_name, definition, created_by, created_on, updated_on FROM report_template
+WHERE report_type_id = $1
+`
+
+func (q *Queries) GetReportTemplateByType(ctx context.Context, reportTypeID int32) (ReportTemplate, error) {
+	row := q.db.QueryRowContext(ctx, getReportTemplateByType, reportTypeID)
+	var i ReportTemplate
+	err := row.Scan(
+		&i.TemplateID,
+		&i.ReportTypeID,
+		&i.TemplateName,
+		&i.Definition,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.UpdatedOn,
+	)
+	return i, err
+}
+
 const getReportType = `-- name: GetReportType :one
-SELECT report_type_id, report_type_name FROM report_type
+SELECT report_type_id, report_type_name, archived FROM report_type
 WHERE report_type_id = $1
 `
 
 func (q *Queries) GetReportType(ctx context.Context, reportTypeID int32) (ReportType, error) {
 	row := q.db.QueryRowContext(ctx, getReportType, reportTypeID)
 	var i ReportType
-	err := row.Scan(&i.ReportTypeID, &i.ReportTypeName)
+	err := row.Scan(&i.ReportTypeID, &i.ReportTypeName, &i.Archived)
 	return i, err
 }
 
 const getReportTypes = `-- name: GetReportTypes :many
-SELECT report_type_id, report_type_name FROM report_type
+SELECT report_type_id, report_type_name, archived FROM report_type
+WHERE NOT archived
 `
 
 func (q *Queries) GetReportTypes(ctx context.Context) ([]ReportType, error) {
@@ -175,7 +386,7 @@ func (q *Queries) GetReportTypes(ctx context.Context) ([]ReportType, error) {
 	var items []ReportType
 	for rows.Next() {
 		var i ReportType
-		if err := rows.Scan(&i.ReportTypeID, &i.ReportTypeName); err != nil {
+		if err := rows.Scan(&i.ReportTypeID, &i.ReportTypeName, &i.Archived); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -205,6 +416,204 @@ func (q *Queries) InsertReportData(ctx context.Context, arg InsertReportDataPara
 	return err
 }
 
+const insertReportScheduleRun = `-- name: InsertReportScheduleRun :one
+INSERT INTO
+report_schedule_run(schedule_id,report_id,run_on)
+VALUES($1,$2,$3) RETURNING run_id
+`
+
+type InsertReportScheduleRunParams struct {
+	ScheduleID int32     `json:"schedule_id"`
+	ReportID   int32     `json:"report_id"`
+	RunOn      time.Time `json:"run_on"`
+}
+
+func (q *Queries) InsertReportScheduleRun(ctx context.Context, arg InsertReportScheduleRunParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, insertReportScheduleRun, arg.ScheduleID, arg.ReportID, arg.RunOn)
+	var run_id int32
+	err := row.Scan(&run_id)
+	return run_id, err
+}
+
+const listEnabledReportSchedules = `-- name: ListEnabledReportSchedules :many
+SELECT schedule_id, scope, report_type_id, report_metadata, cron_expr, recipients, delivery_mode, enabled, last_run_on, created_by, created_on, updated_on FROM report_schedule
+WHERE enabled = TRUE
+`
+
+func (q *Queries) ListEnabledReportSchedules(ctx context.Context) ([]ReportSchedule, error) {
+	rows, err := q.db.QueryContext(ctx, listEnabledReportSchedules)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReportSchedule
+	for rows.Next() {
+		var i ReportSchedule
+		if err := rows.Scan(
+			&i.ScheduleID,
+			&i.Scope,
+			&i.ReportTypeID,
+			&i.ReportMetadata,
+			&i.CronExpr,
+			pq.Array(&i.Recipients),
+			&i.DeliveryMode,
+			&i.Enabled,
+			&i.LastRunOn,
+			&i.CreatedBy,
+			&i.CreatedOn,
+			&i.UpdatedOn,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReportScheduleRuns = `-- name: ListReportScheduleRuns :many
+SELECT count(*) OVER() AS totalRecords,sr.run_id,sr.report_id,r.report_status,sr.run_on,sr.delivery_status,sr.delivery_error,sr.delivered_on FROM
+report_schedule_run sr
+JOIN
+report r
+ON sr.report_id = r.report_id
+WHERE sr.schedule_id = $1
+ORDER BY sr.run_id DESC
+LIMIT $3 OFFSET $2
+`
+
+type ListReportScheduleRunsParams struct {
+	ScheduleID int32 `json:"schedule_id"`
+	PageNum    int32 `json:"page_num"`
+	PageSize   int32 `json:"page_size"`
+}
+
+type ListReportScheduleRunsRow struct {
+	Totalrecords   int64          `json:"totalrecords"`
+	RunID          int32          `json:"run_id"`
+	ReportID       int32          `json:"report_id"`
+	ReportStatus   ReportStatus   `json:"report_status"`
+	RunOn          time.Time      `json:"run_on"`
+	DeliveryStatus DeliveryStatus `json:"delivery_status"`
+	DeliveryError  sql.NullString `json:"delivery_error"`
+	DeliveredOn    sql.NullTime   `json:"delivered_on"`
+}
+
+func (q *Queries) ListReportScheduleRuns(ctx context.Context, arg ListReportScheduleRunsParams) ([]ListReportScheduleRunsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listReportScheduleRuns, arg.ScheduleID, arg.PageNum, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReportScheduleRunsRow
+	for rows.Next() {
+		var i ListReportScheduleRunsRow
+		if err := rows.Scan(
+			&i.Totalrecords,
+			&i.RunID,
+			&i.ReportID,
+			&i.ReportStatus,
+			&i.RunOn,
+			&i.DeliveryStatus,
+			&i.DeliveryError,
+			&i.DeliveredOn,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReportSchedules = `-- name: ListReportSchedules :many
+SELECT schedule_id, scope, report_type_id, report_metadata, cron_expr, recipients, delivery_mode, enabled, last_run_on, created_by, created_on, updated_on FROM report_schedule
+WHERE scope = ANY($1::TEXT[])
+ORDER BY schedule_id
+`
+
+func (q *Queries) ListReportSchedules(ctx context.Context, scope []string) ([]ReportSchedule, error) {
+	rows, err := q.db.QueryContext(ctx, listReportSchedules, pq.Array(scope))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReportSchedule
+	for rows.Next() {
+		var i ReportSchedule
+		if err := rows.Scan(
+			&i.ScheduleID,
+			&i.Scope,
+			&i.ReportTypeID,
+			&i.ReportMetadata,
+			&i.CronExpr,
+			pq.Array(&i.Recipients),
+			&i.DeliveryMode,
+			&i.Enabled,
+			&i.LastRunOn,
+			&i.CreatedBy,
+			&i.CreatedOn,
+			&i.UpdatedOn,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReportTemplates = `-- name: ListReportTemplates :many
+SELECT template_id, report_type_id, template_name, definition, created_by, created_on, updated_on FROM report_template
+ORDER BY template_id
+`
+
+func (q *Queries) ListReportTemplates(ctx context.Context) ([]ReportTemplate, error) {
+	rows, err := q.db.QueryContext(ctx, listReportTemplates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReportTemplate
+	for rows.Next() {
+		var i ReportTemplate
+		if err := rows.Scan(
+			&i.TemplateID,
+			&i.ReportTypeID,
+			&i.TemplateName,
+			&i.Definition,
+			&i.CreatedBy,
+			&i.CreatedOn,
+			&i.UpdatedOn,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const submitReport = `-- name: SubmitReport :one
 INSERT INTO
 report(scope,report_type_id,report_status,report_metadata,created_by)
@@ -232,6 +641,76 @@ func (q *Queries) SubmitReport(ctx context.Context, arg SubmitReportParams) (int
 	return report_id, err
 }
 
+const updateReportSchedule = `-- name: UpdateReportSchedule :one
+UPDATE report_schedule
+SET report_metadata = $1,
+cron_expr = $2,
+recipients = $3,
+delivery_mode = $4,
+enabled = $5,
+updated_on = NOW()
+WHERE schedule_id = $6
+AND scope = $7
+RETURNING schedule_id, scope, report_type_id, report_metadata, cron_expr, recipients, delivery_mode, enabled, last_run_on, created_by, created_on, updated_on
+`
+
+type UpdateReportScheduleParams struct {
+	ReportMetadata json.RawMessage `json:"report_metadata"`
+	CronExpr       string          `json:"cron_expr"`
+	Recipients     []string        `json:"recipients"`
+	DeliveryMode   DeliveryMode    `json:"delivery_mode"`
+	Enabled        bool            `json:"enabled"`
+	ScheduleID     int32           `json:"schedule_id"`
+	Scope          string          `json:"scope"`
+}
+
+func (q *Queries) UpdateReportSchedule(ctx context.Context, arg UpdateReportScheduleParams) (ReportSchedule, error) {
+	row := q.db.QueryRowContext(ctx, updateReportSchedule,
+		arg.ReportMetadata,
+		arg.CronExpr,
+		pq.Array(arg.Recipients),
+		arg.DeliveryMode,
+		arg.Enabled,
+		arg.ScheduleID,
+		arg.Scope,
+	)
+	var i ReportSchedule
+	err := row.Scan(
+		&i.ScheduleID,
+		&i.Scope,
+		&i.ReportTypeID,
+		&i.ReportMetadata,
+		&i.CronExpr,
+		pq.Array(&i.Recipients),
+		&i.DeliveryMode,
+		&i.Enabled,
+		&i.LastRunOn,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.UpdatedOn,
+	)
+	return i, err
+}
+
+const updateReportScheduleRunDelivery = `-- name: UpdateReportScheduleRunDelivery :exec
+UPDATE report_schedule_run
+SET delivery_status = $1,
+delivery_error = $2,
+delivered_on = NOW()
+WHERE run_id = $3
+`
+
+type UpdateReportScheduleRunDeliveryParams struct {
+	DeliveryStatus DeliveryStatus `json:"delivery_status"`
+	DeliveryError  sql.NullString `json:"delivery_error"`
+	RunID          int32          `json:"run_id"`
+}
+
+func (q *Queries) UpdateReportScheduleRunDelivery(ctx context.Context, arg UpdateReportScheduleRunDeliveryParams) error {
+	_, err := q.db.ExecContext(ctx, updateReportScheduleRunDelivery, arg.DeliveryStatus, arg.DeliveryError, arg.RunID)
+	return err
+}
+
 const updateReportStatus = `-- name: UpdateReportStatus :exec
 UPDATE report
 SET report_status = $1
@@ -247,3 +726,41 @@ func (q *Queries) UpdateReportStatus(ctx context.Context, arg UpdateReportStatus
 	_, err := q.db.ExecContext(ctx, updateReportStatus, arg.ReportStatus, arg.ReportID)
 	return err
 }
+
+const updateReportTemplate = `-- name: UpdateReportTemplate :one
+WITH t AS (
+  UPDATE report_template
+  SET template_name = $1,
+  definition = $2,
+  updated_on = NOW()
+  WHERE template_id = $3
+  RETURNING template_id, report_type_id, template_name, definition, created_by, created_on, updated_on
+), rt AS (
+  UPDATE report_type
+  SET report_type_name = t.template_name
+  FROM t
+  WHERE report_type.report_type_id = t.report_type_id
+)
+SELECT template_id, report_type_id, template_name, definition, created_by, created_on, updated_on FROM t
+`
+
+type UpdateReportTemplateParams struct {
+	TemplateName string          `json:"template_name"`
+	Definition   json.RawMessage `json:"definition"`
+	TemplateID   int32           `json:"template_id"`
+}
+
+func (q *Queries) UpdateReportTemplate(ctx context.Context, arg UpdateReportTemplateParams) (ReportTemplate, error) {
+	row := q.db.QueryRowContext(ctx, updateReportTemplate, arg.TemplateName, arg.Definition, arg.TemplateID)
+	var i ReportTemplate
+	err := row.Scan(
+		&i.TemplateID,
+		&i.ReportTypeID,
+		&i.TemplateName,
+		&i.Definition,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.UpdatedOn,
+	)
+	return i, err
+}
@@ -29,7 +29,8 @@ WHERE r.report_id = @report_id
 AND r.scope = ANY(@scope::TEXT[]);
 
 -- name: GetReportTypes :many
-SELECT * FROM report_type;
+SELECT * FROM report_type
+WHERE NOT archived;
 
 -- name: GetReportType :one
 SELECT * FROM report_type
@@ -51,4 +52,117 @@ SET report_status = @report_status
 WHERE report_id = @report_id;
 
 -- name: DeleteReportsByScope :exec
-Delete from report where scope = $1;
\ No newline at end of file
+Delete from report where scope = $1;
+
+-- name: CreateReportSchedule :one
+INSERT INTO
+report_schedule(scope,report_type_id,report_metadata,cron_expr,recipients,delivery_mode,enabled,created_by)
+VALUES($1,$2,$3,$4,$5,$6,$7,$8) RETURNING *;
+
+-- name: UpdateReportSchedule :one
+UPDATE report_schedule
+SET report_metadata = @report_metadata,
+cron_expr = @cron_expr,
+recipients = @recipients,
+delivery_mode = @delivery_mode,
+enabled = @enabled,
+updated_on = NOW()
+WHERE schedule_id = @schedule_id
+AND scope = @scope
+RETURNING *;
+
+-- name: GetReportSchedule :one
+SELECT * FROM report_schedule
+WHERE schedule_id = @schedule_id;
+
+-- name: ListReportSchedules :many
+SELECT * FROM report_schedule
+WHERE scope = ANY(@scope::TEXT[])
+ORDER BY schedule_id;
+
+-- name: ListEnabledReportSchedules :many
+SELECT * FROM report_schedule
+WHERE enabled = TRUE;
+
+-- name: DeleteReportSchedule :execrows
+DELETE FROM report_schedule
+WHERE schedule_id = @schedule_id
+AND scope = @scope;
+
+-- name: DeleteReportSchedulesByScope :exec
+DELETE FROM report_schedule WHERE scope = $1;
+
+-- name: ClaimReportScheduleRun :execrows
+UPDATE report_schedule
+SET last_run_on = @run_on
+WHERE schedule_id = @schedule_id
+AND enabled = TRUE
+AND (last_run_on IS NULL OR last_run_on < @run_on);
+
+-- name: InsertReportScheduleRun :one
+INSERT INTO
+report_schedule_run(schedule_id,report_id,run_on)
+VALUES($1,$2,$3) RETURNING run_id;
+
+-- name: UpdateReportScheduleRunDelivery :exec
+UPDATE report_schedule_run
+SET delivery_status = @delivery_status,
+delivery_error = @delivery_error,
+delivered_on = NOW()
+WHERE run_id = @run_id;
+
+-- name: ListReportScheduleRuns :many
+SELECT count(*) OVER() AS totalRecords,sr.run_id,sr.report_id,r.report_status,sr.run_on,sr.delivery_status,sr.delivery_error,sr.delivered_on FROM
+report_schedule_run sr
+JOIN
+report r
+ON sr.report_id = r.report_id
+WHERE sr.schedule_id = @schedule_id
+ORDER BY sr.run_id DESC
+LIMIT @page_size OFFSET @page_num;
+
+-- name: CreateReportTemplate :one
+WITH rt AS (
+  INSERT INTO report_type(report_type_name) VALUES(@template_name) RETURNING report_type_id
+)
+INSERT INTO
+report_template(report_type_id,template_name,definition,created_by)
+SELECT rt.report_type_id,@template_name,@definition,@created_by FROM rt RETURNING *;
+
+-- name: UpdateReportTemplate :one
+WITH t AS (
+  UPDATE report_template
+  SET template_name = @template_name,
+  definition = @definition,
+  updated_on = NOW()
+  WHERE template_id = @template_id
+  RETURNING *
+), rt AS (
+  UPDATE report_type
+  SET report_type_name = t.template_name
+  FROM t
+  WHERE report_type.report_type_id = t.report_type_id
+)
+SELECT * FROM t;
+
+-- name: GetReportTemplate :one
+SELECT * FROM report_template
+WHERE template_id = @template_id;
+
+-- name: GetReportTemplateByType :one
+SELECT * FROM report_template
+WHERE report_type_id = @report_type_id;
+
+-- name: ListReportTemplates :many
+SELECT * FROM report_template
+ORDER BY template_id;
+
+-- name: DeleteReportTemplate :execrows
+WITH t AS (
+  DELETE FROM report_template WHERE template_id = @template_id RETURNING report_type_id
+), s AS (
+  DELETE FROM report_schedule WHERE report_type_id IN (SELECT report_type_id FROM t)
+)
+UPDATE report_type
+SET archived = TRUE
+WHERE report_type_id IN (SELECT report_type_id FROM t);
@@ -0,0 +1,39 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TYPE delivery_mode AS ENUM ('ATTACHMENT', 'LINK');
+
+CREATE TYPE delivery_status AS ENUM ('PENDING', 'SENT', 'FAILED');
+
+CREATE TABLE IF NOT EXISTS report_schedule (
+schedule_id SERIAL NOT NULL PRIMARY KEY,
+scope VARCHAR NOT NULL,
+report_type_id INTEGER NOT NULL REFERENCES report_type(report_type_id),
+report_metadata JSONB NOT NULL,
+cron_expr VARCHAR NOT NULL,
+recipients TEXT[] NOT NULL,
+delivery_mode delivery_mode NOT NULL DEFAULT 'ATTACHMENT',
+enabled BOOLEAN NOT NULL DEFAULT TRUE,
+last_run_on TIMESTAMP,
+created_by VARCHAR NOT NULL,
+created_on TIMESTAMP NOT NULL DEFAULT NOW(),
+updated_on TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS report_schedule_run (
+run_id SERIAL NOT NULL PRIMARY KEY,
+schedule_id INTEGER NOT NULL REFERENCES report_schedule(schedule_id) ON DELETE CASCADE,
+report_id INTEGER NOT NULL REFERENCES report(report_id) ON DELETE CASCADE,
+run_on TIMESTAMP NOT NULL DEFAULT NOW(),
+delivery_status delivery_status NOT NULL DEFAULT 'PENDING',
+delivery_error VARCHAR,
+delivered_on TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS report_schedule_run_index ON report_schedule_run (schedule_id);
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE IF EXISTS report_schedule_run;
+DROP TABLE IF EXISTS report_schedule;
+DROP TYPE IF EXISTS delivery_status;
+DROP TYPE IF EXISTS delivery_mode;
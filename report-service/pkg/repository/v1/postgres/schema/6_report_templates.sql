@@ -0,0 +1,21 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+ALTER TABLE report_type ADD COLUMN IF NOT EXISTS archived BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- the report types were inserted with their ids, the templates add theirs from the sequence
+SELECT setval('report_type_report_type_id_seq', (SELECT GREATEST(MAX(report_type_id), 3) FROM report_type));
+
+CREATE TABLE IF NOT EXISTS report_template (
+template_id SERIAL NOT NULL PRIMARY KEY,
+report_type_id INTEGER NOT NULL UNIQUE REFERENCES report_type(report_type_id),
+template_name VARCHAR NOT NULL UNIQUE,
+definition JSONB NOT NULL,
+created_by VARCHAR NOT NULL,
+created_on TIMESTAMP NOT NULL DEFAULT NOW(),
+updated_on TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE IF EXISTS report_template;
+ALTER TABLE report_type DROP COLUMN IF EXISTS archived;
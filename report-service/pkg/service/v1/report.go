@@ -24,6 +24,7 @@ import (
 	prodv1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/thirdparty/product-service/pkg/api/v1"
 
 	"github.com/golang/protobuf/jsonpb" // nolint: staticcheck
+	"github.com/golang/protobuf/proto"  // nolint: staticcheck
 	"github.com/golang/protobuf/ptypes"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -36,6 +37,8 @@ type ReportServiceServer struct {
 	reportRepo    repo.Report
 	productClient prodv1.ProductServiceClient
 	queue         workerqueue.Workerqueue
+	schedules     *reportScheduler
+	access        worker.Access
 }
 
 type ProductEquipmentType struct {
@@ -44,10 +47,11 @@ type ProductEquipmentType struct {
 }
 
 // NewReportServiceServer creates Auth service
-func NewReportServiceServer(reportRepo repo.Report, queue workerqueue.Workerqueue, grpcServers map[string]*grpc.ClientConn) v1.ReportServiceServer {
+func NewReportServiceServer(reportRepo repo.Report, queue workerqueue.Workerqueue, grpcServers map[string]*grpc.ClientConn, access worker.Access) v1.ReportServiceServer {
 	return &ReportServiceServer{reportRepo: reportRepo,
 		queue:         queue,
 		productClient: prodv1.NewProductServiceClient(grpcServers["product"]),
+		access:        access,
 	}
 }
 
@@ -64,6 +68,10 @@ func (r *ReportServiceServer) DropReportData(ctx context.Context, req *v1.DropRe
 		return &v1.DropReportDataResponse{Success: false}, status.Error(codes.PermissionDenied, "RoleValidationError")
 	}
 
+	if err := r.reportRepo.DeleteReportSchedulesByScope(ctx, req.Scope); err != nil {
+		logger.Log.Error("Failed to delete report schedules", zap.Any("scope", req.Scope), zap.Error(err))
+		return &v1.DropReportDataResponse{Success: false}, status.Error(codes.Internal, err.Error())
+	}
 	if err := r.reportRepo.DeleteReportsByScope(ctx, req.Scope); err != nil {
 		logger.Log.Error("Failed to delete reports", zap.Any("scope", req.Scope), zap.Error(err))
 		return &v1.DropReportDataResponse{Success: false}, status.Error(codes.Internal, err.Error())
@@ -79,8 +87,20 @@ func (r *ReportServiceServer) ListReportType(ctx context.Context, req *v1.ListRe
 	}
 	apiresp := &v1.ListReportTypeResponse{}
 	apiresp.ReportType = make([]*v1.ReportType, len(dbresp))
+	var templates map[int32][]worker.Column
 	for i := range dbresp {
 		apiresp.ReportType[i] = &v1.ReportType{ReportTypeId: dbresp[i].ReportTypeID, ReportTypeName: dbresp[i].ReportTypeName}
+		if p, ok := worker.Lookup(dbresp[i].ReportTypeName); ok {
+			apiresp.ReportType[i].Params = reportTypeParamsProto(p.Params())
+			apiresp.ReportType[i].Columns = reportColumnsProto(p.Columns())
+			continue
+		}
+		if templates == nil {
+			if templates, err = r.templateColumns(ctx); err != nil {
+				return nil, err
+			}
+		}
+		apiresp.ReportType[i].Columns = reportColumnsProto(templates[dbresp[i].ReportTypeID])
 	}
 	return apiresp, nil
 }
@@ -99,155 +119,114 @@ func (r *ReportServiceServer) SubmitReport(ctx context.Context, req *v1.SubmitRe
 		logger.Log.Error("Service/SubmitReport - Error in db/GetReportType", zap.Error(err))
 		return &v1.SubmitReportResponse{Success: false}, status.Error(codes.Internal, "Internal Server Error")
 	}
-	switch reportType.ReportTypeID {
-	case int32(1):
-		totalEditors, err := r.productClient.ListAggregationEditors(ctx, &prodv1.ListAggregationEditorsRequest{Scope: req.Scope})
-		edList := totalEditors.Editor
-		_, ok := req.ReportMetadata.(*v1.SubmitReportRequest_AcqrightsReport)
-		if !ok {
-			return &v1.SubmitReportResponse{Success: false}, status.Error(codes.InvalidArgument, "Bad Report Request")
-		}
-		ed := req.ReportMetadata.(*v1.SubmitReportRequest_AcqrightsReport).AcqrightsReport.Editor
-		counter := 0
-		for _, s := range edList {
-			if s == ed {
-				counter = 1
-				break
-			}
-		}
-		if counter == 0 {
-			return &v1.SubmitReportResponse{Success: false}, status.Error(codes.InvalidArgument, "Editor doesn't exist")
-		}
-		var j bytes.Buffer
-		marshaler := &jsonpb.Marshaler{}
-		err = marshaler.Marshal(&j, req.GetAcqrightsReport())
-		if err != nil {
-			return &v1.SubmitReportResponse{Success: false}, status.Error(codes.InvalidArgument, "Json Marshal Error")
-		}
-		reportID, err := r.reportRepo.SubmitReport(ctx, db.SubmitReportParams{
-			Scope:          req.GetScope(),
-			ReportStatus:   db.ReportStatusPENDING,
-			CreatedBy:      userClaims.UserID,
-			ReportTypeID:   req.GetReportTypeId(),
-			ReportMetadata: j.Bytes(),
-		})
-		if err != nil {
-			logger.Log.Error("Service/SubmitReport - Error in db/SubmitReport", zap.Error(err))
-			return &v1.SubmitReportResponse{Success: false}, status.Error(codes.Internal, "Internal Server Error")
-		}
-		e := worker.Envelope{Type: worker.AcqRightsReport, Scope: req.GetScope(), JSON: j.Bytes(), ReportID: reportID}
-		envolveData, err := json.Marshal(e)
-		if err != nil {
-			logger.Log.Error("Failed to do json marshalling of worker envelope", zap.Error(err))
-			return &v1.SubmitReportResponse{Success: false}, status.Error(codes.Internal, "Internal Server Error")
-		}
-		_, err = r.queue.PushJob(ctx, job.Job{
-			Type:   sql.NullString{String: "rw"},
-			Status: job.JobStatusPENDING,
-			Data:   envolveData,
-		}, "rw")
-		if err != nil {
-			logger.Log.Error("Failed to push job to the queue", zap.Error(err))
-			return &v1.SubmitReportResponse{Success: false}, status.Error(codes.Internal, "Internal Server Error")
-		}
-	case int32(2):
-		totalEditors, err := r.productClient.ListAggregationEditors(ctx, &prodv1.ListAggregationEditorsRequest{Scope: req.Scope})
-		edList := totalEditors.Editor
-		_, ok := req.ReportMetadata.(*v1.SubmitReportRequest_ProductEquipmentsReport)
-		if !ok {
-			return &v1.SubmitReportResponse{Success: false}, status.Error(codes.InvalidArgument, "Bad Report Request")
-		}
-		ed := req.ReportMetadata.(*v1.SubmitReportRequest_ProductEquipmentsReport).ProductEquipmentsReport.Editor
-		counter := 0
-		for _, s := range edList {
-			if s == ed {
-				counter = 1
-				break
-			}
-		}
-		if counter == 0 {
-			return &v1.SubmitReportResponse{Success: false}, status.Error(codes.InvalidArgument, "Editor doesn't exist")
-		}
-		var j bytes.Buffer
-		marshaler := &jsonpb.Marshaler{}
-		err = marshaler.Marshal(&j, req.GetProductEquipmentsReport())
-		if err != nil {
-			return &v1.SubmitReportResponse{Success: false}, status.Error(codes.InvalidArgument, "Json Marshal Error")
-		}
-		reportID, err := r.reportRepo.SubmitReport(ctx, db.SubmitReportParams{
-			Scope:          req.GetScope(),
-			ReportStatus:   db.ReportStatusPENDING,
-			CreatedBy:      userClaims.UserID,
-			ReportTypeID:   req.GetReportTypeId(),
-			ReportMetadata: j.Bytes(),
-		})
+	plugin, params, err := r.reportTypePlugin(ctx, reportType)
+	if err != nil {
+		return &v1.SubmitReportResponse{Success: false}, err
+	}
+	if params == nil {
+		params, err = reportParams(metadataMessage(req.GetAcqrightsReport(), req.GetProductEquipmentsReport()), req.GetParams())
 		if err != nil {
-			logger.Log.Error("Service/SubmitReport - Error in db/SubmitReport", zap.Error(err))
-			return &v1.SubmitReportResponse{Success: false}, status.Error(codes.Internal, "Internal Server Error")
+			return &v1.SubmitReportResponse{Success: false}, err
 		}
-		e := worker.Envelope{Type: worker.ProductEquipmentsReport, Scope: req.GetScope(), JSON: j.Bytes(), ReportID: reportID}
+	}
+	reportMetadata, err := plugin.Validate(ctx, r.sources(), req.GetScope(), params)
+	if err != nil {
+		return &v1.SubmitReportResponse{Success: false}, err
+	}
+	reportID, err := r.reportRepo.SubmitReport(ctx, db.SubmitReportParams{
+		Scope:          req.GetScope(),
+		ReportStatus:   db.ReportStatusPENDING,
+		CreatedBy:      userClaims.UserID,
+		ReportTypeID:   req.GetReportTypeId(),
+		ReportMetadata: reportMetadata,
+	})
+	if err != nil {
+		logger.Log.Error("Service/SubmitReport - Error in db/SubmitReport", zap.Error(err), zap.String("scope", req.GetScope()), zap.Int32("reportTypeID", req.GetReportTypeId()))
+		return &v1.SubmitReportResponse{Success: false}, status.Error(codes.Internal, "Internal Server Error")
+	}
+	e := worker.Envelope{Type: plugin.Type(), Scope: req.GetScope(), JSON: reportMetadata, ReportID: reportID}
+	envolveData, err := json.Marshal(e)
+	if err != nil {
+		logger.Log.Error("Failed to do json marshalling of worker envelope", zap.Error(err))
+		return &v1.SubmitReportResponse{Success: false}, status.Error(codes.Internal, "Internal Server Error")
+	}
+	_, err = r.queue.PushJob(ctx, job.Job{
+		Type:   sql.NullString{String: "rw"},
+		Status: job.JobStatusPENDING,
+		Data:   envolveData,
+	}, "rw")
+	if err != nil {
+		logger.Log.Error("Failed to push job to the queue", zap.Error(err))
+		return &v1.SubmitReportResponse{Success: false}, status.Error(codes.Internal, "Internal Server Error")
+	}
+	return &v1.SubmitReportResponse{Success: true}, nil
+}
 
-		envolveData, err := json.Marshal(e)
-		if err != nil {
-			logger.Log.Error("Failed to do json marshalling of worker envelope", zap.Error(err))
-			return &v1.SubmitReportResponse{Success: false}, status.Error(codes.Internal, "Internal Server Error")
-		}
-		_, err = r.queue.PushJob(ctx, job.Job{
-			Type:   sql.NullString{String: "rw"},
-			Status: job.JobStatusPENDING,
-			Data:   envolveData,
-		}, "rw")
-		if err != nil {
-			logger.Log.Error("Failed to push job to the queue", zap.Error(err))
-			return &v1.SubmitReportResponse{Success: false}, status.Error(codes.Internal, "Internal Server Error")
-		}
-	case int32(3):
-		rawJSON := json.RawMessage("[]")
-		reportID, err := r.reportRepo.SubmitReport(ctx, db.SubmitReportParams{
-			Scope:          req.GetScope(),
-			ReportStatus:   db.ReportStatusPENDING,
-			CreatedBy:      userClaims.UserID,
-			ReportTypeID:   req.GetReportTypeId(),
-			ReportMetadata: rawJSON,
-		})
-		if err != nil {
-			logger.Log.Sugar().Errorw("Service/SubmitReport - Error in db/SubmitReportor ",
-				"scope", req.Scope,
-				"ReportTypeID", req.ReportTypeId,
-				"error", err.Error(),
-			)
-			return &v1.SubmitReportResponse{Success: false}, status.Error(codes.Internal, "Internal Server Error")
-		}
-		e := worker.Envelope{Type: worker.ScopeExpensesByEditorReport, Scope: req.GetScope(), JSON: rawJSON, ReportID: reportID}
+// reportPlugin gives the plugin generating the reports of the report type,
+// with the parameters of the reports when they are set by the report type like the definition of a report template.
+func (r *ReportServiceServer) reportPlugin(ctx context.Context, reportTypeID int32) (worker.Plugin, json.RawMessage, error) {
+	reportType, err := r.reportRepo.GetReportType(ctx, reportTypeID)
+	if err == sql.ErrNoRows {
+		return nil, nil, status.Error(codes.InvalidArgument, "Wrong ReportID sent")
+	}
+	if err != nil {
+		logger.Log.Error("Failed to get report type", zap.Error(err), zap.Int32("reportTypeID", reportTypeID))
+		return nil, nil, status.Error(codes.Internal, "Internal Server Error")
+	}
+	return r.reportTypePlugin(ctx, reportType)
+}
 
-		envolveData, err := json.Marshal(e)
-		if err != nil {
-			logger.Log.Sugar().Errorw("Service/SubmitReport - Failed to do json marshalling of worker envelope ",
-				"scope", req.Scope,
-				"ReportTypeID", req.ReportTypeId,
-				"error", err.Error(),
-			)
-			return &v1.SubmitReportResponse{Success: false}, status.Error(codes.Internal, "Internal Server Error")
-		}
-		_, err = r.queue.PushJob(ctx, job.Job{
-			Type:   sql.NullString{String: "rw"},
-			Status: job.JobStatusPENDING,
-			Data:   envolveData,
-		}, "rw")
-		if err != nil {
-			logger.Log.Sugar().Errorw("Service/SubmitReport - Failed to push job to the queue ",
-				"scope", req.Scope,
-				"ReportTypeID", req.ReportTypeId,
-				"Data", envolveData,
-				"error", err.Error(),
-			)
-			return &v1.SubmitReportResponse{Success: false}, status.Error(codes.Internal, "Internal Server Error")
-		}
-	default:
-		return &v1.SubmitReportResponse{Success: false}, status.Error(codes.InvalidArgument, "Wrong ReportID sent")
+// reportTypePlugin gives the plugin of the report type looked up by its name, the report types
+// made for the report templates are generated by the template plugin.
+func (r *ReportServiceServer) reportTypePlugin(ctx context.Context, reportType db.ReportType) (worker.Plugin, json.RawMessage, error) {
+	if p, ok := worker.Lookup(reportType.ReportTypeName); ok {
+		return p, nil, nil
+	}
+	t, err := r.reportRepo.GetReportTemplateByType(ctx, reportType.ReportTypeID)
+	if err == sql.ErrNoRows {
+		return nil, nil, status.Error(codes.InvalidArgument, "Wrong ReportID sent")
+	}
+	if err != nil {
+		logger.Log.Error("Failed to get report template", zap.Error(err), zap.Int32("reportTypeID", reportType.ReportTypeID))
+		return nil, nil, status.Error(codes.Internal, "Internal Server Error")
+	}
+	p, _ := worker.LookupType(worker.TemplateReport)
+	return p, t.Definition, nil
+}
 
+// sources gives the plugins the clients of the service to validate the parameters of the reports
+func (r *ReportServiceServer) sources() *worker.Sources {
+	return &worker.Sources{Product: r.productClient}
+}
+
+// metadataMessage gives the report_metadata message set in a request, nil when there is none
+func metadataMessage(acqRights *v1.AcqRightsReport, productEquipments *v1.ProductEquipmentsReport) proto.Message {
+	if acqRights != nil {
+		return acqRights
 	}
-	return &v1.SubmitReportResponse{Success: true}, nil
+	if productEquipments != nil {
+		return productEquipments
+	}
+	return nil
+}
+
+// reportParams gives the json parameters of a report from its report_metadata message, or else its params
+func reportParams(metadata proto.Message, params map[string]string) (json.RawMessage, error) {
+	if metadata != nil {
+		var j bytes.Buffer
+		if err := (&jsonpb.Marshaler{}).Marshal(&j, metadata); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Json Marshal Error")
+		}
+		return j.Bytes(), nil
+	}
+	if len(params) == 0 {
+		return nil, nil
+	}
+	j, err := json.Marshal(params)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Json Marshal Error")
+	}
+	return j, nil
 }
 
 func (r *ReportServiceServer) ListReport(ctx context.Context, req *v1.ListReportRequest) (*v1.ListReportResponse, error) {
@@ -311,6 +290,9 @@ func extractValue(body string, key string) string {
 	keystr := "\"" + key + "\":[^,;\\]}]*"
 	r, _ := regexp.Compile(keystr)
 	match := r.FindString(body)
+	if match == "" {
+		return ""
+	}
 	keyValMatch := strings.Split(match, ":")
 	return strings.ReplaceAll(keyValMatch[1], "\"", "")
 }
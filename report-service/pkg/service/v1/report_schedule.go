@@ -0,0 +1,494 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/repository/v1/postgres/db"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/worker"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/cron"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/helper"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/job"
+
+	"github.com/golang/protobuf/jsonpb" // nolint: staticcheck
+	"github.com/golang/protobuf/proto"  // nolint: staticcheck
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// scheduleSyncInterval is how often a replica reloads the schedules changed through the other replicas
+const scheduleSyncInterval = time.Minute
+
+// reportScheduler runs the enabled report schedules, a schedule is rescheduled when its cron expression changes
+type reportScheduler struct {
+	cron  *cron.Scheduler
+	run   func(scheduleID int32)
+	mu    sync.Mutex
+	specs map[int32]string
+}
+
+func newReportScheduler(run func(scheduleID int32)) *reportScheduler {
+	return &reportScheduler{
+		cron:  cron.NewScheduler(),
+		run:   run,
+		specs: make(map[int32]string),
+	}
+}
+
+// schedule (re)schedules the report schedule, a disabled schedule is removed
+func (s *reportScheduler) schedule(rs db.ReportSchedule) error {
+	if !rs.Enabled {
+		s.remove(rs.ScheduleID)
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.specs[rs.ScheduleID] == rs.CronExpr {
+		return nil
+	}
+	id := rs.ScheduleID
+	spec, lastDay := monthEnd(rs.CronExpr)
+	if err := s.cron.Schedule(scheduleKey(id), spec, func() {
+		if lastDay && time.Now().AddDate(0, 0, 1).Day() != 1 {
+			return
+		}
+		s.run(id)
+	}); err != nil {
+		return err
+	}
+	s.specs[id] = rs.CronExpr
+	return nil
+}
+
+// remove stops running the report schedule
+func (s *reportScheduler) remove(scheduleID int32) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cron.Remove(scheduleKey(scheduleID))
+	delete(s.specs, scheduleID)
+}
+
+// load runs exactly the enabled report schedules
+func (s *reportScheduler) load(schedules []db.ReportSchedule) {
+	enabled := make(map[int32]bool, len(schedules))
+	for _, rs := range schedules {
+		enabled[rs.ScheduleID] = true
+		if err := s.schedule(rs); err != nil {
+			logger.Log.Error("Failed to schedule report", zap.Int32("scheduleID", rs.ScheduleID), zap.Error(err))
+		}
+	}
+	s.mu.Lock()
+	var removed []int32
+	for id := range s.specs {
+		if !enabled[id] {
+			removed = append(removed, id)
+		}
+	}
+	s.mu.Unlock()
+	for _, id := range removed {
+		s.remove(id)
+	}
+}
+
+func scheduleKey(scheduleID int32) string {
+	return "report-schedule/" + strconv.Itoa(int(scheduleID))
+}
+
+// monthEnd replaces the L day of month of a cron expression, which the cron parser does not know,
+// by the days a month can end on, it tells whether the runs are kept on the last day of the month only.
+func monthEnd(cronExpr string) (string, bool) {
+	fields := strings.Fields(cronExpr)
+	if len(fields) != 5 || fields[2] != "L" {
+		return cronExpr, false
+	}
+	fields[2] = "28-31"
+	return strings.Join(fields, " "), true
+}
+
+// StartReportSchedules runs the enabled report schedules until the context is done,
+// every replica runs the schedules but a run is claimed by a single one.
+func StartReportSchedules(ctx context.Context, srv v1.ReportServiceServer) error {
+	r, ok := srv.(*ReportServiceServer)
+	if !ok {
+		return errors.New("report schedules are run by the report server only")
+	}
+	r.schedules = newReportScheduler(func(scheduleID int32) {
+		if err := r.runReportSchedule(ctx, scheduleID, time.Now().Truncate(time.Minute)); err != nil {
+			logger.Log.Error("Scheduled report failed", zap.Int32("scheduleID", scheduleID), zap.Error(err))
+		}
+	})
+	if err := r.loadReportSchedules(ctx); err != nil {
+		return err
+	}
+	go func() {
+		ticker := time.NewTicker(scheduleSyncInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := r.loadReportSchedules(ctx); err != nil {
+					logger.Log.Error("Failed to reload report schedules", zap.Error(err))
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (r *ReportServiceServer) loadReportSchedules(ctx context.Context) error {
+	schedules, err := r.reportRepo.ListEnabledReportSchedules(ctx)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	r.schedules.load(schedules)
+	return nil
+}
+
+// runReportSchedule submits the report of the schedule due at runOn, nothing is submitted
+// when another replica already claimed the run.
+func (r *ReportServiceServer) runReportSchedule(ctx context.Context, scheduleID int32, runOn time.Time) error {
+	claimed, err := r.reportRepo.ClaimReportScheduleRun(ctx, db.ClaimReportScheduleRunParams{RunOn: runOn, ScheduleID: scheduleID})
+	if err != nil {
+		return err
+	}
+	if claimed == 0 {
+		return nil
+	}
+	s, err := r.reportRepo.GetReportSchedule(ctx, scheduleID)
+	if err != nil {
+		return err
+	}
+	// the schedule runs with the current role of its owner and only while the owner keeps the scope
+	users, err := r.access.ScopeUsers(ctx, s.Scope)
+	if err != nil {
+		return fmt.Errorf("cannot get users of scope %s: %v", s.Scope, err)
+	}
+	role, ok := users[strings.ToLower(s.CreatedBy)]
+	if !ok {
+		return fmt.Errorf("%s is no longer a user of scope %s", s.CreatedBy, s.Scope)
+	}
+	plugin, definition, err := r.reportPlugin(ctx, s.ReportTypeID)
+	if err != nil {
+		return err
+	}
+	// the reports of a template follow its latest definition
+	reportMetadata := s.ReportMetadata
+	if definition != nil {
+		reportMetadata = definition
+	}
+	reportID, err := r.reportRepo.SubmitReport(ctx, db.SubmitReportParams{
+		Scope:          s.Scope,
+		ReportStatus:   db.ReportStatusPENDING,
+		CreatedBy:      s.CreatedBy,
+		ReportTypeID:   s.ReportTypeID,
+		ReportMetadata: reportMetadata,
+	})
+	if err != nil {
+		return err
+	}
+	runID, err := r.reportRepo.InsertReportScheduleRun(ctx, db.InsertReportScheduleRunParams{ScheduleID: s.ScheduleID, ReportID: reportID, RunOn: runOn})
+	if err != nil {
+		return err
+	}
+	envelope, err := json.Marshal(worker.Envelope{Type: plugin.Type(), Scope: s.Scope, JSON: reportMetadata, ReportID: reportID, ScheduleID: s.ScheduleID, RunID: runID})
+	if err != nil {
+		return err
+	}
+	_, err = r.queue.PushJob(scheduleContext(ctx, s, role), job.Job{
+		Type:   sql.NullString{String: "rw"},
+		Status: job.JobStatusPENDING,
+		Data:   envelope,
+	}, "rw")
+	return err
+}
+
+// scheduleContext gives the context of the jobs of a schedule, the worker calls
+// the other services on behalf of the owner of the schedule with the role the owner has.
+func scheduleContext(ctx context.Context, s db.ReportSchedule, role claims.Role) context.Context {
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(
+		"user-id", s.CreatedBy,
+		"user-role", string(role),
+		"user-scopes", s.Scope,
+	))
+}
+
+// CreateReportSchedule creates a report schedule of the scope and runs it
+func (r *ReportServiceServer) CreateReportSchedule(ctx context.Context, req *v1.CreateReportScheduleRequest) (*v1.ReportSchedule, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !helper.Contains(userClaims.Socpes, req.GetScope()) {
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	plugin, reportMetadata, err := r.validateReportSchedule(ctx, req.GetScope(), req.GetReportTypeId(), req.GetCronExpr(), req.GetRecipients(), metadataMessage(req.GetAcqrightsReport(), req.GetProductEquipmentsReport()), req.GetParams())
+	if err != nil {
+		return nil, err
+	}
+	s, err := r.reportRepo.CreateReportSchedule(ctx, db.CreateReportScheduleParams{
+		Scope:          req.GetScope(),
+		ReportTypeID:   req.GetReportTypeId(),
+		ReportMetadata: reportMetadata,
+		CronExpr:       req.GetCronExpr(),
+		Recipients:     req.GetRecipients(),
+		DeliveryMode:   db.DeliveryMode(req.GetDeliveryMode().String()),
+		Enabled:        true,
+		CreatedBy:      userClaims.UserID,
+	})
+	if err != nil {
+		logger.Log.Error("Failed to create report schedule", zap.Error(err), zap.String("scope", req.GetScope()))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	return r.scheduleReport(s, plugin.Type())
+}
+
+// UpdateReportSchedule replaces the parameters, the schedule and the recipients of a report schedule
+func (r *ReportServiceServer) UpdateReportSchedule(ctx context.Context, req *v1.UpdateReportScheduleRequest) (*v1.ReportSchedule, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !helper.Contains(userClaims.Socpes, req.GetScope()) {
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	existing, err := r.getReportSchedule(ctx, req.GetScheduleId(), req.GetScope())
+	if err != nil {
+		return nil, err
+	}
+	plugin, reportMetadata, err := r.validateReportSchedule(ctx, req.GetScope(), existing.ReportTypeID, req.GetCronExpr(), req.GetRecipients(), metadataMessage(req.GetAcqrightsReport(), req.GetProductEquipmentsReport()), req.GetParams())
+	if err != nil {
+		return nil, err
+	}
+	s, err := r.reportRepo.UpdateReportSchedule(ctx, db.UpdateReportScheduleParams{
+		ReportMetadata: reportMetadata,
+		CronExpr:       req.GetCronExpr(),
+		Recipients:     req.GetRecipients(),
+		DeliveryMode:   db.DeliveryMode(req.GetDeliveryMode().String()),
+		Enabled:        req.GetEnabled(),
+		ScheduleID:     req.GetScheduleId(),
+		Scope:          req.GetScope(),
+	})
+	if err != nil {
+		logger.Log.Error("Failed to update report schedule", zap.Error(err), zap.Int32("scheduleID", req.GetScheduleId()))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	return r.scheduleReport(s, plugin.Type())
+}
+
+// ListReportSchedules lists the report schedules of the scope
+func (r *ReportServiceServer) ListReportSchedules(ctx context.Context, req *v1.ListReportSchedulesRequest) (*v1.ListReportSchedulesResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !helper.Contains(userClaims.Socpes, req.GetScope()) {
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	dbresp, err := r.reportRepo.ListReportSchedules(ctx, []string{req.GetScope()})
+	if err != nil && err != sql.ErrNoRows {
+		logger.Log.Error("Failed to list report schedules", zap.Error(err), zap.String("scope", req.GetScope()))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	reportTypes, err := r.pluginReportTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	apiresp := &v1.ListReportSchedulesResponse{Schedules: make([]*v1.ReportSchedule, len(dbresp))}
+	for i := range dbresp {
+		apiresp.Schedules[i] = reportScheduleProto(dbresp[i], reportTypes[dbresp[i].ReportTypeID])
+	}
+	return apiresp, nil
+}
+
+// DeleteReportSchedule deletes a report schedule with its runs, the generated reports are kept
+func (r *ReportServiceServer) DeleteReportSchedule(ctx context.Context, req *v1.DeleteReportScheduleRequest) (*v1.DeleteReportScheduleResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !helper.Contains(userClaims.Socpes, req.GetScope()) {
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	deleted, err := r.reportRepo.DeleteReportSchedule(ctx, db.DeleteReportScheduleParams{ScheduleID: req.GetScheduleId(), Scope: req.GetScope()})
+	if err != nil {
+		logger.Log.Error("Failed to delete report schedule", zap.Error(err), zap.Int32("scheduleID", req.GetScheduleId()))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	if deleted == 0 {
+		return nil, status.Error(codes.NotFound, "ReportScheduleNotFound")
+	}
+	if r.schedules != nil {
+		r.schedules.remove(req.GetScheduleId())
+	}
+	return &v1.DeleteReportScheduleResponse{Success: true}, nil
+}
+
+// ListReportScheduleRuns lists the past runs of a report schedule with their delivery, latest first
+func (r *ReportServiceServer) ListReportScheduleRuns(ctx context.Context, req *v1.ListReportScheduleRunsRequest) (*v1.ListReportScheduleRunsResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !helper.Contains(userClaims.Socpes, req.GetScope()) {
+		return nil, status.Error(codes.PermissionDenied, "ScopeValidationError")
+	}
+	if _, err := r.getReportSchedule(ctx, req.GetScheduleId(), req.GetScope()); err != nil {
+		return nil, err
+	}
+	dbresp, err := r.reportRepo.ListReportScheduleRuns(ctx, db.ListReportScheduleRunsParams{
+		ScheduleID: req.GetScheduleId(),
+		PageNum:    req.GetPageSize() * (req.GetPageNum() - 1),
+		PageSize:   req.GetPageSize(),
+	})
+	if err != nil && err != sql.ErrNoRows {
+		logger.Log.Error("Failed to list report schedule runs", zap.Error(err), zap.Int32("scheduleID", req.GetScheduleId()))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	apiresp := &v1.ListReportScheduleRunsResponse{Runs: make([]*v1.ReportScheduleRun, len(dbresp))}
+	if len(dbresp) > 0 {
+		apiresp.TotalRecords = int32(dbresp[0].Totalrecords)
+	}
+	for i, run := range dbresp {
+		runOn, _ := ptypes.TimestampProto(run.RunOn)
+		apiresp.Runs[i] = &v1.ReportScheduleRun{
+			RunId:          run.RunID,
+			ReportId:       run.ReportID,
+			ReportStatus:   string(run.ReportStatus),
+			RunOn:          runOn,
+			DeliveryStatus: string(run.DeliveryStatus),
+			DeliveryError:  run.DeliveryError.String,
+		}
+		if run.DeliveredOn.Valid {
+			apiresp.Runs[i].DeliveredOn, _ = ptypes.TimestampProto(run.DeliveredOn.Time)
+		}
+	}
+	return apiresp, nil
+}
+
+// getReportSchedule gives the report schedule of the scope
+func (r *ReportServiceServer) getReportSchedule(ctx context.Context, scheduleID int32, scope string) (db.ReportSchedule, error) {
+	s, err := r.reportRepo.GetReportSchedule(ctx, scheduleID)
+	if err == sql.ErrNoRows || (err == nil && s.Scope != scope) {
+		return db.ReportSchedule{}, status.Error(codes.NotFound, "ReportScheduleNotFound")
+	}
+	if err != nil {
+		logger.Log.Error("Failed to get report schedule", zap.Error(err), zap.Int32("scheduleID", scheduleID))
+		return db.ReportSchedule{}, status.Error(codes.Internal, "DBError")
+	}
+	return s, nil
+}
+
+// pluginReportTypes gives the report types generated by the plugins registered by name, by report type row
+func (r *ReportServiceServer) pluginReportTypes(ctx context.Context) (map[int32]worker.ReportType, error) {
+	dbresp, err := r.reportRepo.GetReportTypes(ctx)
+	if err != nil && err != sql.ErrNoRows {
+		logger.Log.Error("Failed to fetch report types", zap.Error(err))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	reportTypes := make(map[int32]worker.ReportType, len(dbresp))
+	for _, rt := range dbresp {
+		if p, ok := worker.Lookup(rt.ReportTypeName); ok {
+			reportTypes[rt.ReportTypeID] = p.Type()
+		}
+	}
+	return reportTypes, nil
+}
+
+// scheduleReport (re)schedules the runs of the report schedule on this replica
+func (r *ReportServiceServer) scheduleReport(s db.ReportSchedule, reportType worker.ReportType) (*v1.ReportSchedule, error) {
+	if r.schedules != nil {
+		if err := r.schedules.schedule(s); err != nil {
+			logger.Log.Error("Failed to schedule report", zap.Error(err), zap.Int32("scheduleID", s.ScheduleID))
+			return nil, status.Error(codes.Internal, "ScheduleError")
+		}
+	}
+	return reportScheduleProto(s, reportType), nil
+}
+
+// validateReportSchedule checks the cron expression, the recipients and the parameters of a scheduled report,
+// it gives the plugin of the report type with the parameters as stored with the reports.
+func (r *ReportServiceServer) validateReportSchedule(ctx context.Context, scope string, reportTypeID int32, cronExpr string, recipients []string, metadata proto.Message, params map[string]string) (worker.Plugin, json.RawMessage, error) {
+	if spec, _ := monthEnd(cronExpr); cron.ValidateSpec(spec) != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, "InvalidCronExpression")
+	}
+	users, err := r.access.ScopeUsers(ctx, scope)
+	if err != nil {
+		logger.Log.Error("Failed to get scope users", zap.Error(err), zap.String("scope", scope))
+		return nil, nil, status.Error(codes.Internal, "ScopeUsersError")
+	}
+	if _, others := worker.ScopeRecipients(recipients, users); len(others) > 0 {
+		return nil, nil, status.Error(codes.InvalidArgument, "RecipientNotInScope")
+	}
+	plugin, definition, err := r.reportPlugin(ctx, reportTypeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if definition == nil {
+		if definition, err = reportParams(metadata, params); err != nil {
+			return nil, nil, err
+		}
+	}
+	reportMetadata, err := plugin.Validate(ctx, r.sources(), scope, definition)
+	if err != nil {
+		return nil, nil, err
+	}
+	return plugin, reportMetadata, nil
+}
+
+// reportScheduleProto gives the report schedule, its parameters are decoded as the ones of the report type
+func reportScheduleProto(s db.ReportSchedule, reportType worker.ReportType) *v1.ReportSchedule {
+	createdOn, _ := ptypes.TimestampProto(s.CreatedOn)
+	updatedOn, _ := ptypes.TimestampProto(s.UpdatedOn)
+	apiresp := &v1.ReportSchedule{
+		ScheduleId:   s.ScheduleID,
+		Scope:        s.Scope,
+		ReportTypeId: s.ReportTypeID,
+		CronExpr:     s.CronExpr,
+		Recipients:   s.Recipients,
+		DeliveryMode: v1.DeliveryMode(v1.DeliveryMode_value[string(s.DeliveryMode)]),
+		Enabled:      s.Enabled,
+		CreatedBy:    s.CreatedBy,
+		CreatedOn:    createdOn,
+		UpdatedOn:    updatedOn,
+	}
+	if s.LastRunOn.Valid {
+		apiresp.LastRunOn, _ = ptypes.TimestampProto(s.LastRunOn.Time)
+	}
+	unmarshaler := &jsonpb.Unmarshaler{AllowUnknownFields: true}
+	switch reportType {
+	case worker.AcqRightsReport:
+		params := &v1.AcqRightsReport{}
+		if err := unmarshaler.Unmarshal(bytes.NewReader(s.ReportMetadata), params); err == nil {
+			apiresp.ReportMetadata = &v1.ReportSchedule_AcqrightsReport{AcqrightsReport: params}
+		}
+	case worker.ProductEquipmentsReport:
+		params := &v1.ProductEquipmentsReport{}
+		if err := unmarshaler.Unmarshal(bytes.NewReader(s.ReportMetadata), params); err == nil {
+			apiresp.ReportMetadata = &v1.ReportSchedule_ProductEquipmentsReport{ProductEquipmentsReport: params}
+		}
+	default:
+		var params map[string]string
+		if err := json.Unmarshal(s.ReportMetadata, &params); err == nil {
+			apiresp.Params = params
+		}
+	}
+	return apiresp
+}
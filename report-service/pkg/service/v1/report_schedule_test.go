@@ -0,0 +1,366 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/job"
+	queuemock "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/mock"
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/repository/v1/mock"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/repository/v1/postgres/db"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/worker"
+	prodv1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/thirdparty/product-service/pkg/api/v1"
+	pmock "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/thirdparty/product-service/pkg/api/v1/mock"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeAccess gives the users of every scope
+type fakeAccess struct {
+	users map[string]claims.Role
+	err   error
+}
+
+func (a fakeAccess) ServiceContext(ctx context.Context, scope string) (context.Context, error) {
+	return ctx, a.err
+}
+
+func (a fakeAccess) ScopeUsers(ctx context.Context, scope string) (map[string]claims.Role, error) {
+	return a.users, a.err
+}
+
+var scopeUsers = fakeAccess{users: map[string]claims.Role{"admin@test.com": claims.RoleAdmin, "manager@test.com": claims.RoleUser}}
+
+// expectReportTypes gives the report types of the tests, 5 is the report type of a report template
+func expectReportTypes(r *mock.MockReport) {
+	reportTypes := []db.ReportType{
+		{ReportTypeID: 1, ReportTypeName: "Compliance"},
+		{ReportTypeID: 2, ReportTypeName: "ProductEquipments"},
+		{ReportTypeID: 3, ReportTypeName: "Expenses by Editor"},
+		{ReportTypeID: 5, ReportTypeName: "Products by editor"},
+	}
+	r.EXPECT().GetReportType(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, reportTypeID int32) (db.ReportType, error) {
+		for _, rt := range reportTypes {
+			if rt.ReportTypeID == reportTypeID {
+				return rt, nil
+			}
+		}
+		return db.ReportType{}, sql.ErrNoRows
+	}).AnyTimes()
+	r.EXPECT().GetReportTypes(gomock.Any()).Return(reportTypes, nil).AnyTimes()
+}
+
+func TestReportServiceServer_CreateReportSchedule(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@test.com",
+		Role:   "Admin",
+		Socpes: []string{"Scope1"},
+	})
+	schedule := db.ReportSchedule{
+		ScheduleID:     1,
+		Scope:          "Scope1",
+		ReportTypeID:   1,
+		ReportMetadata: json.RawMessage(`{"editor":"e1"}`),
+		CronExpr:       "0 6 * * 1",
+		Recipients:     []string{"manager@test.com"},
+		DeliveryMode:   db.DeliveryModeATTACHMENT,
+		Enabled:        true,
+		CreatedBy:      "admin@test.com",
+	}
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		req      *v1.CreateReportScheduleRequest
+		access   worker.Access
+		setup    func(*mock.MockReport, *pmock.MockProductServiceClient)
+		wantCode codes.Code
+	}{
+		{
+			name:     "ClaimsNotFound",
+			ctx:      context.Background(),
+			req:      &v1.CreateReportScheduleRequest{Scope: "Scope1"},
+			setup:    func(*mock.MockReport, *pmock.MockProductServiceClient) {},
+			wantCode: codes.Internal,
+		},
+		{
+			name:     "ScopeNotFound",
+			ctx:      ctx,
+			req:      &v1.CreateReportScheduleRequest{Scope: "Scope2"},
+			setup:    func(*mock.MockReport, *pmock.MockProductServiceClient) {},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "InvalidCronExpression",
+			ctx:      ctx,
+			req:      &v1.CreateReportScheduleRequest{Scope: "Scope1", ReportTypeId: 3, CronExpr: "every monday"},
+			setup:    func(*mock.MockReport, *pmock.MockProductServiceClient) {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "MissingReportParameters",
+			ctx:      ctx,
+			req:      &v1.CreateReportScheduleRequest{Scope: "Scope1", ReportTypeId: 1, CronExpr: "0 6 * * 1"},
+			setup:    func(*mock.MockReport, *pmock.MockProductServiceClient) {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "EditorNotFound",
+			ctx:  ctx,
+			req: &v1.CreateReportScheduleRequest{Scope: "Scope1", ReportTypeId: 1, CronExpr: "0 6 * * 1",
+				ReportMetadata: &v1.CreateReportScheduleRequest_AcqrightsReport{AcqrightsReport: &v1.AcqRightsReport{Editor: "e2"}}},
+			setup: func(_ *mock.MockReport, p *pmock.MockProductServiceClient) {
+				p.EXPECT().ListAggregationEditors(ctx, &prodv1.ListAggregationEditorsRequest{Scope: "Scope1"}).Return(&prodv1.ListAggregationEditorsResponse{Editor: []string{"e1"}}, nil).Times(1)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "RecipientNotInScope",
+			ctx:      ctx,
+			req:      &v1.CreateReportScheduleRequest{Scope: "Scope1", ReportTypeId: 3, CronExpr: "0 6 * * 1", Recipients: []string{"manager@test.com", "someone@external.com"}},
+			setup:    func(*mock.MockReport, *pmock.MockProductServiceClient) {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "ScopeUsersError",
+			ctx:      ctx,
+			req:      &v1.CreateReportScheduleRequest{Scope: "Scope1", ReportTypeId: 3, CronExpr: "0 6 * * 1", Recipients: []string{"manager@test.com"}},
+			access:   fakeAccess{err: errors.New("account service unavailable")},
+			setup:    func(*mock.MockReport, *pmock.MockProductServiceClient) {},
+			wantCode: codes.Internal,
+		},
+		{
+			name:     "UnknownReportType",
+			ctx:      ctx,
+			req:      &v1.CreateReportScheduleRequest{Scope: "Scope1", ReportTypeId: 9, CronExpr: "0 6 * * 1", Recipients: []string{"manager@test.com"}},
+			setup:    func(*mock.MockReport, *pmock.MockProductServiceClient) {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "DBError",
+			ctx:  ctx,
+			req:  &v1.CreateReportScheduleRequest{Scope: "Scope1", ReportTypeId: 3, CronExpr: "0 0 L * *", Recipients: []string{"manager@test.com"}},
+			setup: func(r *mock.MockReport, _ *pmock.MockProductServiceClient) {
+				r.EXPECT().CreateReportSchedule(ctx, gomock.Any()).Return(db.ReportSchedule{}, errors.New("DBError")).Times(1)
+			},
+			wantCode: codes.Internal,
+		},
+		{
+			name: "SUCCESS",
+			ctx:  ctx,
+			req: &v1.CreateReportScheduleRequest{Scope: "Scope1", ReportTypeId: 1, CronExpr: "0 6 * * 1", Recipients: []string{"manager@test.com"},
+				ReportMetadata: &v1.CreateReportScheduleRequest_AcqrightsReport{AcqrightsReport: &v1.AcqRightsReport{Editor: "e1"}}},
+			setup: func(r *mock.MockReport, p *pmock.MockProductServiceClient) {
+				p.EXPECT().ListAggregationEditors(ctx, &prodv1.ListAggregationEditorsRequest{Scope: "Scope1"}).Return(&prodv1.ListAggregationEditorsResponse{Editor: []string{"e1"}}, nil).Times(1)
+				r.EXPECT().CreateReportSchedule(ctx, db.CreateReportScheduleParams{
+					Scope:          "Scope1",
+					ReportTypeID:   1,
+					ReportMetadata: json.RawMessage(`{"editor":"e1"}`),
+					CronExpr:       "0 6 * * 1",
+					Recipients:     []string{"manager@test.com"},
+					DeliveryMode:   db.DeliveryModeATTACHMENT,
+					Enabled:        true,
+					CreatedBy:      "admin@test.com",
+				}).Return(schedule, nil).Times(1)
+			},
+			wantCode: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			rep := mock.NewMockReport(mockCtrl)
+			pclient := pmock.NewMockProductServiceClient(mockCtrl)
+			tt.setup(rep, pclient)
+			expectReportTypes(rep)
+			access := tt.access
+			if access == nil {
+				access = scopeUsers
+			}
+			r := &ReportServiceServer{reportRepo: rep, productClient: pclient, access: access}
+			got, err := r.CreateReportSchedule(tt.ctx, tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("ReportServiceServer.CreateReportSchedule() error = %v, want code %v", err, tt.wantCode)
+				return
+			}
+			if err == nil && (got.GetScheduleId() != 1 || got.GetAcqrightsReport().GetEditor() != "e1") {
+				t.Errorf("ReportServiceServer.CreateReportSchedule() = %v", got)
+			}
+		})
+	}
+}
+
+func TestReportServiceServer_DeleteReportSchedule(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@test.com",
+		Role:   "Admin",
+		Socpes: []string{"Scope1"},
+	})
+	tests := []struct {
+		name     string
+		deleted  int64
+		err      error
+		wantCode codes.Code
+	}{
+		{name: "SUCCESS", deleted: 1, wantCode: codes.OK},
+		{name: "NotFound", deleted: 0, wantCode: codes.NotFound},
+		{name: "DBError", err: errors.New("DBError"), wantCode: codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			rep := mock.NewMockReport(mockCtrl)
+			rep.EXPECT().DeleteReportSchedule(ctx, db.DeleteReportScheduleParams{ScheduleID: 1, Scope: "Scope1"}).Return(tt.deleted, tt.err).Times(1)
+			r := &ReportServiceServer{reportRepo: rep}
+			_, err := r.DeleteReportSchedule(ctx, &v1.DeleteReportScheduleRequest{ScheduleId: 1, Scope: "Scope1"})
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("ReportServiceServer.DeleteReportSchedule() error = %v, want code %v", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestReportServiceServer_ListReportScheduleRuns(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@test.com",
+		Role:   "Admin",
+		Socpes: []string{"Scope1", "Scope2"},
+	})
+	runOn := time.Date(2026, 10, 12, 6, 0, 0, 0, time.UTC)
+	t.Run("ScheduleOfOtherScope", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		rep := mock.NewMockReport(mockCtrl)
+		rep.EXPECT().GetReportSchedule(ctx, int32(1)).Return(db.ReportSchedule{ScheduleID: 1, Scope: "Scope2"}, nil).Times(1)
+		r := &ReportServiceServer{reportRepo: rep}
+		_, err := r.ListReportScheduleRuns(ctx, &v1.ListReportScheduleRunsRequest{ScheduleId: 1, Scope: "Scope1", PageNum: 1, PageSize: 10})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("ReportServiceServer.ListReportScheduleRuns() error = %v, want NotFound", err)
+		}
+	})
+	t.Run("SUCCESS", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		rep := mock.NewMockReport(mockCtrl)
+		rep.EXPECT().GetReportSchedule(ctx, int32(1)).Return(db.ReportSchedule{ScheduleID: 1, Scope: "Scope1"}, nil).Times(1)
+		rep.EXPECT().ListReportScheduleRuns(ctx, db.ListReportScheduleRunsParams{ScheduleID: 1, PageNum: 10, PageSize: 10}).Return([]db.ListReportScheduleRunsRow{
+			{Totalrecords: 11, RunID: 2, ReportID: 7, ReportStatus: db.ReportStatusCOMPLETED, RunOn: runOn, DeliveryStatus: db.DeliveryStatusFAILED,
+				DeliveryError: sql.NullString{String: "cannot send mail", Valid: true}, DeliveredOn: sql.NullTime{Time: runOn, Valid: true}},
+		}, nil).Times(1)
+		r := &ReportServiceServer{reportRepo: rep}
+		got, err := r.ListReportScheduleRuns(ctx, &v1.ListReportScheduleRunsRequest{ScheduleId: 1, Scope: "Scope1", PageNum: 2, PageSize: 10})
+		if err != nil {
+			t.Fatalf("ReportServiceServer.ListReportScheduleRuns() error = %v", err)
+		}
+		if got.TotalRecords != 11 || len(got.Runs) != 1 || got.Runs[0].DeliveryStatus != "FAILED" || got.Runs[0].DeliveryError != "cannot send mail" || got.Runs[0].ReportStatus != "COMPLETED" {
+			t.Errorf("ReportServiceServer.ListReportScheduleRuns() = %v", got)
+		}
+	})
+}
+
+func TestReportServiceServer_runReportSchedule(t *testing.T) {
+	ctx := context.Background()
+	runOn := time.Date(2026, 10, 12, 6, 0, 0, 0, time.UTC)
+	t.Run("ClaimedByOtherReplica", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		rep := mock.NewMockReport(mockCtrl)
+		rep.EXPECT().ClaimReportScheduleRun(ctx, db.ClaimReportScheduleRunParams{RunOn: runOn, ScheduleID: 1}).Return(int64(0), nil).Times(1)
+		r := &ReportServiceServer{reportRepo: rep, access: scopeUsers}
+		if err := r.runReportSchedule(ctx, 1, runOn); err != nil {
+			t.Errorf("ReportServiceServer.runReportSchedule() error = %v", err)
+		}
+	})
+	t.Run("SUCCESS", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		rep := mock.NewMockReport(mockCtrl)
+		queue := queuemock.NewMockWorkerqueue(mockCtrl)
+		meta := json.RawMessage(`{"editor":"e1"}`)
+		rep.EXPECT().ClaimReportScheduleRun(ctx, db.ClaimReportScheduleRunParams{RunOn: runOn, ScheduleID: 1}).Return(int64(1), nil).Times(1)
+		rep.EXPECT().GetReportSchedule(ctx, int32(1)).Return(db.ReportSchedule{ScheduleID: 1, Scope: "Scope1", ReportTypeID: 1, ReportMetadata: meta, CreatedBy: "Manager@test.com"}, nil).Times(1)
+		expectReportTypes(rep)
+		rep.EXPECT().SubmitReport(ctx, db.SubmitReportParams{Scope: "Scope1", ReportStatus: db.ReportStatusPENDING, CreatedBy: "Manager@test.com", ReportTypeID: 1, ReportMetadata: meta}).Return(int32(7), nil).Times(1)
+		rep.EXPECT().InsertReportScheduleRun(ctx, db.InsertReportScheduleRunParams{ScheduleID: 1, ReportID: 7, RunOn: runOn}).Return(int32(3), nil).Times(1)
+		data, _ := json.Marshal(worker.Envelope{Type: worker.AcqRightsReport, Scope: "Scope1", JSON: meta, ReportID: 7, ScheduleID: 1, RunID: 3})
+		queue.EXPECT().PushJob(gomock.Any(), job.Job{Type: sql.NullString{String: "rw"}, Status: job.JobStatusPENDING, Data: data}, "rw").
+			DoAndReturn(func(jobCtx context.Context, _ job.Job, _ string) (int32, error) {
+				md, _ := metadata.FromIncomingContext(jobCtx)
+				if got := md.Get("user-id"); len(got) != 1 || got[0] != "Manager@test.com" {
+					t.Errorf("job user-id = %v", got)
+				}
+				if got := md.Get("user-role"); len(got) != 1 || got[0] != string(claims.RoleUser) {
+					t.Errorf("job user-role = %v", got)
+				}
+				if got := md.Get("user-scopes"); len(got) != 1 || got[0] != "Scope1" {
+					t.Errorf("job user-scopes = %v", got)
+				}
+				return 1, nil
+			}).Times(1)
+		r := &ReportServiceServer{reportRepo: rep, queue: queue, access: scopeUsers}
+		if err := r.runReportSchedule(ctx, 1, runOn); err != nil {
+			t.Errorf("ReportServiceServer.runReportSchedule() error = %v", err)
+		}
+	})
+	t.Run("OwnerLostScope", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		rep := mock.NewMockReport(mockCtrl)
+		rep.EXPECT().ClaimReportScheduleRun(ctx, db.ClaimReportScheduleRunParams{RunOn: runOn, ScheduleID: 1}).Return(int64(1), nil).Times(1)
+		rep.EXPECT().GetReportSchedule(ctx, int32(1)).Return(db.ReportSchedule{ScheduleID: 1, Scope: "Scope1", ReportTypeID: 1, CreatedBy: "former@test.com"}, nil).Times(1)
+		r := &ReportServiceServer{reportRepo: rep, access: scopeUsers}
+		if err := r.runReportSchedule(ctx, 1, runOn); err == nil {
+			t.Errorf("ReportServiceServer.runReportSchedule() of an owner out of the scope, want error")
+		}
+	})
+	t.Run("TemplateUpdated", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		rep := mock.NewMockReport(mockCtrl)
+		queue := queuemock.NewMockWorkerqueue(mockCtrl)
+		definition := json.RawMessage(`{"entity":"products","columns":["editor","totalCost"],"group_by":"editor"}`)
+		rep.EXPECT().ClaimReportScheduleRun(ctx, db.ClaimReportScheduleRunParams{RunOn: runOn, ScheduleID: 2}).Return(int64(1), nil).Times(1)
+		rep.EXPECT().GetReportSchedule(ctx, int32(2)).Return(db.ReportSchedule{ScheduleID: 2, Scope: "Scope1", ReportTypeID: 5, ReportMetadata: json.RawMessage(`{"entity":"products","columns":["editor"]}`), CreatedBy: "admin@test.com"}, nil).Times(1)
+		expectReportTypes(rep)
+		rep.EXPECT().GetReportTemplateByType(ctx, int32(5)).Return(db.ReportTemplate{TemplateID: 1, ReportTypeID: 5, Definition: definition}, nil).Times(1)
+		rep.EXPECT().SubmitReport(ctx, db.SubmitReportParams{Scope: "Scope1", ReportStatus: db.ReportStatusPENDING, CreatedBy: "admin@test.com", ReportTypeID: 5, ReportMetadata: definition}).Return(int32(8), nil).Times(1)
+		rep.EXPECT().InsertReportScheduleRun(ctx, db.InsertReportScheduleRunParams{ScheduleID: 2, ReportID: 8, RunOn: runOn}).Return(int32(4), nil).Times(1)
+		data, _ := json.Marshal(worker.Envelope{Type: worker.TemplateReport, Scope: "Scope1", JSON: definition, ReportID: 8, ScheduleID: 2, RunID: 4})
+		queue.EXPECT().PushJob(gomock.Any(), job.Job{Type: sql.NullString{String: "rw"}, Status: job.JobStatusPENDING, Data: data}, "rw").Return(int32(1), nil).Times(1)
+		r := &ReportServiceServer{reportRepo: rep, queue: queue, access: scopeUsers}
+		if err := r.runReportSchedule(ctx, 2, runOn); err != nil {
+			t.Errorf("ReportServiceServer.runReportSchedule() error = %v", err)
+		}
+	})
+}
+
+func Test_monthEnd(t *testing.T) {
+	tests := []struct {
+		cronExpr    string
+		wantSpec    string
+		wantLastDay bool
+	}{
+		{cronExpr: "0 22 L * *", wantSpec: "0 22 28-31 * *", wantLastDay: true},
+		{cronExpr: "0 6 * * 1", wantSpec: "0 6 * * 1", wantLastDay: false},
+		{cronExpr: "@monthly", wantSpec: "@monthly", wantLastDay: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cronExpr, func(t *testing.T) {
+			spec, lastDay := monthEnd(tt.cronExpr)
+			if spec != tt.wantSpec || lastDay != tt.wantLastDay {
+				t.Errorf("monthEnd() = %v, %v, want %v, %v", spec, lastDay, tt.wantSpec, tt.wantLastDay)
+			}
+		})
+	}
+}
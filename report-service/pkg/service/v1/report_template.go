@@ -0,0 +1,253 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/repository/v1/postgres/db"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/worker"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListReportTemplateEntities lists the entities the report templates are built on with their columns
+func (r *ReportServiceServer) ListReportTemplateEntities(ctx context.Context, req *v1.ListReportTemplateEntitiesRequest) (*v1.ListReportTemplateEntitiesResponse, error) {
+	if _, ok := grpc_middleware.RetrieveClaims(ctx); !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	entities := worker.TemplateEntities()
+	apiresp := &v1.ListReportTemplateEntitiesResponse{Entities: make([]*v1.ReportTemplateEntity, len(entities))}
+	for i, e := range entities {
+		apiresp.Entities[i] = &v1.ReportTemplateEntity{
+			Name:            e.Name,
+			Columns:         reportColumnsProto(e.Columns),
+			RequiredFilters: e.RequiredFilters,
+			DynamicColumns:  e.DynamicColumns,
+		}
+	}
+	return apiresp, nil
+}
+
+// CreateReportTemplate adds a report type generating the table of the template, admins only
+func (r *ReportServiceServer) CreateReportTemplate(ctx context.Context, req *v1.CreateReportTemplateRequest) (*v1.ReportTemplate, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !templateAdmin(userClaims.Role) {
+		return nil, status.Error(codes.PermissionDenied, "RoleValidationError")
+	}
+	definition, err := templateDefinition(req.GetEntity(), req.GetColumns(), req.GetFilters(), req.GetGroupBy())
+	if err != nil {
+		return nil, err
+	}
+	if err := r.checkReportTypeName(ctx, req.GetName(), 0); err != nil {
+		return nil, err
+	}
+	t, err := r.reportRepo.CreateReportTemplate(ctx, db.CreateReportTemplateParams{
+		TemplateName: req.GetName(),
+		Definition:   definition,
+		CreatedBy:    userClaims.UserID,
+	})
+	if err != nil {
+		logger.Log.Error("Failed to create report template", zap.Error(err), zap.String("name", req.GetName()))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	return reportTemplateProto(t), nil
+}
+
+// UpdateReportTemplate replaces the definition of a report template, the next reports use it
+func (r *ReportServiceServer) UpdateReportTemplate(ctx context.Context, req *v1.UpdateReportTemplateRequest) (*v1.ReportTemplate, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !templateAdmin(userClaims.Role) {
+		return nil, status.Error(codes.PermissionDenied, "RoleValidationError")
+	}
+	existing, err := r.reportRepo.GetReportTemplate(ctx, req.GetTemplateId())
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "ReportTemplateNotFound")
+	}
+	if err != nil {
+		logger.Log.Error("Failed to get report template", zap.Error(err), zap.Int32("templateID", req.GetTemplateId()))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	definition, err := templateDefinition(req.GetEntity(), req.GetColumns(), req.GetFilters(), req.GetGroupBy())
+	if err != nil {
+		return nil, err
+	}
+	if err := r.checkReportTypeName(ctx, req.GetName(), existing.ReportTypeID); err != nil {
+		return nil, err
+	}
+	t, err := r.reportRepo.UpdateReportTemplate(ctx, db.UpdateReportTemplateParams{
+		TemplateName: req.GetName(),
+		Definition:   definition,
+		TemplateID:   req.GetTemplateId(),
+	})
+	if err != nil {
+		logger.Log.Error("Failed to update report template", zap.Error(err), zap.Int32("templateID", req.GetTemplateId()))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	return reportTemplateProto(t), nil
+}
+
+// ListReportTemplates lists the report templates
+func (r *ReportServiceServer) ListReportTemplates(ctx context.Context, req *v1.ListReportTemplatesRequest) (*v1.ListReportTemplatesResponse, error) {
+	if _, ok := grpc_middleware.RetrieveClaims(ctx); !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	dbresp, err := r.reportRepo.ListReportTemplates(ctx)
+	if err != nil && err != sql.ErrNoRows {
+		logger.Log.Error("Failed to list report templates", zap.Error(err))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	apiresp := &v1.ListReportTemplatesResponse{Templates: make([]*v1.ReportTemplate, len(dbresp))}
+	for i := range dbresp {
+		apiresp.Templates[i] = reportTemplateProto(dbresp[i])
+	}
+	return apiresp, nil
+}
+
+// DeleteReportTemplate removes a report template and its schedules, the reports already generated are kept
+func (r *ReportServiceServer) DeleteReportTemplate(ctx context.Context, req *v1.DeleteReportTemplateRequest) (*v1.DeleteReportTemplateResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "ClaimsNotFound")
+	}
+	if !templateAdmin(userClaims.Role) {
+		return nil, status.Error(codes.PermissionDenied, "RoleValidationError")
+	}
+	deleted, err := r.reportRepo.DeleteReportTemplate(ctx, req.GetTemplateId())
+	if err != nil {
+		logger.Log.Error("Failed to delete report template", zap.Error(err), zap.Int32("templateID", req.GetTemplateId()))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	if deleted == 0 {
+		return nil, status.Error(codes.NotFound, "ReportTemplateNotFound")
+	}
+	return &v1.DeleteReportTemplateResponse{Success: true}, nil
+}
+
+// templateAdmin tells whether the role manages the report templates
+func templateAdmin(role claims.Role) bool {
+	return role == claims.RoleAdmin || role == claims.RoleSuperAdmin
+}
+
+// checkReportTypeName checks no other report type is named like the report template,
+// the names of the report types of the plugins are kept for them as plugins are looked up by name.
+func (r *ReportServiceServer) checkReportTypeName(ctx context.Context, name string, reportTypeID int32) error {
+	if _, ok := worker.Lookup(name); ok {
+		return status.Error(codes.AlreadyExists, "ReportTypeExists")
+	}
+	reportTypes, err := r.reportRepo.GetReportTypes(ctx)
+	if err != nil && err != sql.ErrNoRows {
+		logger.Log.Error("Failed to fetch report types", zap.Error(err))
+		return status.Error(codes.Internal, "DBError")
+	}
+	for _, rt := range reportTypes {
+		if rt.ReportTypeName == name && rt.ReportTypeID != reportTypeID {
+			return status.Error(codes.AlreadyExists, "ReportTypeExists")
+		}
+	}
+	return nil
+}
+
+// templateDefinition checks the report template and gives its definition as stored
+func templateDefinition(entity string, columns []string, filters []*v1.ReportTemplateFilter, groupBy string) (json.RawMessage, error) {
+	t := worker.Template{
+		Entity:  entity,
+		Columns: columns,
+		GroupBy: groupBy,
+	}
+	for _, f := range filters {
+		t.Filters = append(t.Filters, worker.Filter{Column: f.GetColumn(), Operator: f.GetOperator().String(), Value: f.GetValue()})
+	}
+	if err := t.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	definition, err := json.Marshal(t)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Json Marshal Error")
+	}
+	return definition, nil
+}
+
+// templateColumns gives the columns of the report templates by report type
+func (r *ReportServiceServer) templateColumns(ctx context.Context) (map[int32][]worker.Column, error) {
+	templates, err := r.reportRepo.ListReportTemplates(ctx)
+	if err != nil && err != sql.ErrNoRows {
+		logger.Log.Error("Failed to list report templates", zap.Error(err))
+		return nil, status.Error(codes.Internal, "DBError")
+	}
+	columns := make(map[int32][]worker.Column, len(templates))
+	for _, t := range templates {
+		var definition worker.Template
+		if err := json.Unmarshal(t.Definition, &definition); err != nil {
+			logger.Log.Error("Bad report template definition", zap.Error(err), zap.Int32("templateID", t.TemplateID))
+			continue
+		}
+		columns[t.ReportTypeID] = definition.ReportColumns()
+	}
+	return columns, nil
+}
+
+func reportTemplateProto(t db.ReportTemplate) *v1.ReportTemplate {
+	createdOn, _ := ptypes.TimestampProto(t.CreatedOn)
+	updatedOn, _ := ptypes.TimestampProto(t.UpdatedOn)
+	apiresp := &v1.ReportTemplate{
+		TemplateId:   t.TemplateID,
+		ReportTypeId: t.ReportTypeID,
+		Name:         t.TemplateName,
+		CreatedBy:    t.CreatedBy,
+		CreatedOn:    createdOn,
+		UpdatedOn:    updatedOn,
+	}
+	var definition worker.Template
+	if err := json.Unmarshal(t.Definition, &definition); err != nil {
+		logger.Log.Error("Bad report template definition", zap.Error(err), zap.Int32("templateID", t.TemplateID))
+		return apiresp
+	}
+	apiresp.Entity = definition.Entity
+	apiresp.Columns = definition.Columns
+	apiresp.GroupBy = definition.GroupBy
+	for _, f := range definition.Filters {
+		apiresp.Filters = append(apiresp.Filters, &v1.ReportTemplateFilter{
+			Column:   f.Column,
+			Operator: v1.ReportTemplateFilter_Operator(v1.ReportTemplateFilter_Operator_value[f.Operator]),
+			Value:    f.Value,
+		})
+	}
+	return apiresp
+}
+
+func reportTypeParamsProto(params []worker.Param) []*v1.ReportTypeParam {
+	if len(params) == 0 {
+		return nil
+	}
+	apiresp := make([]*v1.ReportTypeParam, len(params))
+	for i, p := range params {
+		apiresp[i] = &v1.ReportTypeParam{Name: p.Name, Description: p.Description, Required: p.Required}
+	}
+	return apiresp
+}
+
+func reportColumnsProto(columns []worker.Column) []*v1.ReportColumn {
+	if len(columns) == 0 {
+		return nil
+	}
+	apiresp := make([]*v1.ReportColumn, len(columns))
+	for i, c := range columns {
+		apiresp[i] = &v1.ReportColumn{Key: c.Key, Title: c.Title}
+	}
+	return apiresp
+}
@@ -0,0 +1,259 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	grpc_middleware "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/middleware/grpc"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/job"
+	queuemock "gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/workerqueue/mock"
+	v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/api/v1"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/repository/v1/mock"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/repository/v1/postgres/db"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/worker"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReportServiceServer_CreateReportTemplate(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@test.com",
+		Role:   "Admin",
+		Socpes: []string{"Scope1"},
+	})
+	userCtx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "user@test.com",
+		Role:   "User",
+		Socpes: []string{"Scope1"},
+	})
+	definition := json.RawMessage(`{"entity":"products","columns":["editor","totalCost"],"filters":[{"column":"category","operator":"NE","value":"os"}],"group_by":"editor"}`)
+	req := &v1.CreateReportTemplateRequest{
+		Name:    "Costs by editor",
+		Entity:  "products",
+		Columns: []string{"editor", "totalCost"},
+		Filters: []*v1.ReportTemplateFilter{{Column: "category", Operator: v1.ReportTemplateFilter_NE, Value: "os"}},
+		GroupBy: "editor",
+	}
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		req      *v1.CreateReportTemplateRequest
+		setup    func(*mock.MockReport)
+		wantCode codes.Code
+	}{
+		{
+			name:     "ClaimsNotFound",
+			ctx:      context.Background(),
+			req:      req,
+			setup:    func(*mock.MockReport) {},
+			wantCode: codes.Internal,
+		},
+		{
+			name:     "RoleValidationError",
+			ctx:      userCtx,
+			req:      req,
+			setup:    func(*mock.MockReport) {},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "UnknownColumn",
+			ctx:      ctx,
+			req:      &v1.CreateReportTemplateRequest{Name: "Costs", Entity: "products", Columns: []string{"cost"}},
+			setup:    func(*mock.MockReport) {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "MissingRequiredFilter",
+			ctx:      ctx,
+			req:      &v1.CreateReportTemplateRequest{Name: "Compliance", Entity: "compliance", Columns: []string{"sku"}},
+			setup:    func(*mock.MockReport) {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "ReportTypeExists",
+			ctx:  ctx,
+			req:  req,
+			setup: func(r *mock.MockReport) {
+				r.EXPECT().GetReportTypes(ctx).Return([]db.ReportType{{ReportTypeID: 1, ReportTypeName: "Compliance"}, {ReportTypeID: 4, ReportTypeName: "Costs by editor"}}, nil).Times(1)
+			},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:     "PluginReportType",
+			ctx:      ctx,
+			req:      &v1.CreateReportTemplateRequest{Name: "Expenses by Editor", Entity: req.Entity, Columns: req.Columns, Filters: req.Filters, GroupBy: req.GroupBy},
+			setup:    func(*mock.MockReport) {},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name: "DBError",
+			ctx:  ctx,
+			req:  req,
+			setup: func(r *mock.MockReport) {
+				r.EXPECT().GetReportTypes(ctx).Return([]db.ReportType{{ReportTypeID: 1, ReportTypeName: "Compliance"}}, nil).Times(1)
+				r.EXPECT().CreateReportTemplate(ctx, gomock.Any()).Return(db.ReportTemplate{}, errors.New("DBError")).Times(1)
+			},
+			wantCode: codes.Internal,
+		},
+		{
+			name: "SUCCESS",
+			ctx:  ctx,
+			req:  req,
+			setup: func(r *mock.MockReport) {
+				r.EXPECT().GetReportTypes(ctx).Return([]db.ReportType{{ReportTypeID: 1, ReportTypeName: "Compliance"}}, nil).Times(1)
+				r.EXPECT().CreateReportTemplate(ctx, db.CreateReportTemplateParams{
+					TemplateName: "Costs by editor",
+					Definition:   definition,
+					CreatedBy:    "admin@test.com",
+				}).Return(db.ReportTemplate{TemplateID: 1, ReportTypeID: 4, TemplateName: "Costs by editor", Definition: definition, CreatedBy: "admin@test.com"}, nil).Times(1)
+			},
+			wantCode: codes.OK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			rep := mock.NewMockReport(mockCtrl)
+			tt.setup(rep)
+			r := &ReportServiceServer{reportRepo: rep}
+			got, err := r.CreateReportTemplate(tt.ctx, tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("ReportServiceServer.CreateReportTemplate() error = %v, want code %v", err, tt.wantCode)
+				return
+			}
+			if err == nil && (got.GetReportTypeId() != 4 || got.GetGroupBy() != "editor" || len(got.GetFilters()) != 1 || got.GetFilters()[0].GetOperator() != v1.ReportTemplateFilter_NE) {
+				t.Errorf("ReportServiceServer.CreateReportTemplate() = %v", got)
+			}
+		})
+	}
+}
+
+func TestReportServiceServer_UpdateReportTemplate(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@test.com",
+		Role:   "SuperAdmin",
+		Socpes: []string{"Scope1"},
+	})
+	req := &v1.UpdateReportTemplateRequest{TemplateId: 1, Name: "Costs by editor", Entity: "products", Columns: []string{"editor", "totalCost"}}
+	t.Run("ReportTemplateNotFound", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		rep := mock.NewMockReport(mockCtrl)
+		rep.EXPECT().GetReportTemplate(ctx, int32(1)).Return(db.ReportTemplate{}, sql.ErrNoRows).Times(1)
+		r := &ReportServiceServer{reportRepo: rep}
+		if _, err := r.UpdateReportTemplate(ctx, req); status.Code(err) != codes.NotFound {
+			t.Errorf("ReportServiceServer.UpdateReportTemplate() error = %v, want code %v", err, codes.NotFound)
+		}
+	})
+	t.Run("SUCCESS", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		rep := mock.NewMockReport(mockCtrl)
+		definition := json.RawMessage(`{"entity":"products","columns":["editor","totalCost"]}`)
+		rep.EXPECT().GetReportTemplate(ctx, int32(1)).Return(db.ReportTemplate{TemplateID: 1, ReportTypeID: 4, TemplateName: "Costs"}, nil).Times(1)
+		// the template keeps the name of its own report type
+		rep.EXPECT().GetReportTypes(ctx).Return([]db.ReportType{{ReportTypeID: 4, ReportTypeName: "Costs by editor"}}, nil).Times(1)
+		rep.EXPECT().UpdateReportTemplate(ctx, db.UpdateReportTemplateParams{TemplateName: "Costs by editor", Definition: definition, TemplateID: 1}).
+			Return(db.ReportTemplate{TemplateID: 1, ReportTypeID: 4, TemplateName: "Costs by editor", Definition: definition}, nil).Times(1)
+		r := &ReportServiceServer{reportRepo: rep}
+		got, err := r.UpdateReportTemplate(ctx, req)
+		if err != nil || got.GetName() != "Costs by editor" || len(got.GetColumns()) != 2 {
+			t.Errorf("ReportServiceServer.UpdateReportTemplate() = %v, error = %v", got, err)
+		}
+	})
+}
+
+func TestReportServiceServer_DeleteReportTemplate(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@test.com",
+		Role:   "Admin",
+		Socpes: []string{"Scope1"},
+	})
+	tests := []struct {
+		name     string
+		deleted  int64
+		err      error
+		wantCode codes.Code
+	}{
+		{name: "DBError", err: errors.New("DBError"), wantCode: codes.Internal},
+		{name: "ReportTemplateNotFound", wantCode: codes.NotFound},
+		{name: "SUCCESS", deleted: 1, wantCode: codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			rep := mock.NewMockReport(mockCtrl)
+			rep.EXPECT().DeleteReportTemplate(ctx, int32(1)).Return(tt.deleted, tt.err).Times(1)
+			r := &ReportServiceServer{reportRepo: rep}
+			if _, err := r.DeleteReportTemplate(ctx, &v1.DeleteReportTemplateRequest{TemplateId: 1}); status.Code(err) != tt.wantCode {
+				t.Errorf("ReportServiceServer.DeleteReportTemplate() error = %v, want code %v", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestReportServiceServer_SubmitReport_Template(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "user@test.com",
+		Role:   "User",
+		Socpes: []string{"Scope1"},
+	})
+	definition := json.RawMessage(`{"entity":"equipments","columns":["type","name"],"filters":[{"column":"type","operator":"EQ","value":"server"}]}`)
+	t.Run("WrongReportID", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		rep := mock.NewMockReport(mockCtrl)
+		rep.EXPECT().GetReportType(ctx, int32(9)).Return(db.ReportType{ReportTypeID: 9, ReportTypeName: "Unknown"}, nil).Times(1)
+		rep.EXPECT().GetReportTemplateByType(ctx, int32(9)).Return(db.ReportTemplate{}, sql.ErrNoRows).Times(1)
+		r := &ReportServiceServer{reportRepo: rep}
+		if _, err := r.SubmitReport(ctx, &v1.SubmitReportRequest{Scope: "Scope1", ReportTypeId: 9}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("ReportServiceServer.SubmitReport() error = %v, want code %v", err, codes.InvalidArgument)
+		}
+	})
+	t.Run("SUCCESS", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		rep := mock.NewMockReport(mockCtrl)
+		queue := queuemock.NewMockWorkerqueue(mockCtrl)
+		rep.EXPECT().GetReportType(ctx, int32(4)).Return(db.ReportType{ReportTypeID: 4, ReportTypeName: "Servers"}, nil).Times(1)
+		rep.EXPECT().GetReportTemplateByType(ctx, int32(4)).Return(db.ReportTemplate{TemplateID: 1, ReportTypeID: 4, Definition: definition}, nil).Times(1)
+		rep.EXPECT().SubmitReport(ctx, db.SubmitReportParams{Scope: "Scope1", ReportStatus: db.ReportStatusPENDING, CreatedBy: "user@test.com", ReportTypeID: 4, ReportMetadata: definition}).Return(int32(7), nil).Times(1)
+		data, _ := json.Marshal(worker.Envelope{Type: worker.TemplateReport, Scope: "Scope1", JSON: definition, ReportID: 7})
+		queue.EXPECT().PushJob(ctx, job.Job{Type: sql.NullString{String: "rw"}, Status: job.JobStatusPENDING, Data: data}, "rw").Return(int32(1), nil).Times(1)
+		r := &ReportServiceServer{reportRepo: rep, queue: queue}
+		if got, err := r.SubmitReport(ctx, &v1.SubmitReportRequest{Scope: "Scope1", ReportTypeId: 4}); err != nil || !got.GetSuccess() {
+			t.Errorf("ReportServiceServer.SubmitReport() = %v, error = %v", got, err)
+		}
+	})
+}
+
+func TestReportServiceServer_ListReportType_Template(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	rep := mock.NewMockReport(mockCtrl)
+	ctx := context.Background()
+	rep.EXPECT().GetReportTypes(ctx).Return([]db.ReportType{{ReportTypeID: 1, ReportTypeName: "Compliance"}, {ReportTypeID: 4, ReportTypeName: "Costs by editor"}}, nil).Times(1)
+	rep.EXPECT().ListReportTemplates(ctx).Return([]db.ReportTemplate{
+		{TemplateID: 1, ReportTypeID: 4, Definition: json.RawMessage(`{"entity":"products","columns":["editor","totalCost"],"group_by":"editor"}`)},
+	}, nil).Times(1)
+	r := &ReportServiceServer{reportRepo: rep}
+	got, err := r.ListReportType(ctx, &v1.ListReportTypeRequest{})
+	if err != nil {
+		t.Fatalf("ReportServiceServer.ListReportType() error = %v", err)
+	}
+	if params := got.ReportType[0].GetParams(); len(params) != 1 || params[0].GetName() != "editor" || !params[0].GetRequired() {
+		t.Errorf("ReportServiceServer.ListReportType() params = %v", params)
+	}
+	columns := got.ReportType[1].GetColumns()
+	if len(columns) != 3 || columns[1].GetTitle() != "Total cost" || columns[2].GetKey() != worker.CountColumn {
+		t.Errorf("ReportServiceServer.ListReportType() columns = %v", columns)
+	}
+}
@@ -79,6 +79,7 @@ func Test_DropReportData(t *testing.T) {
 				mockworkerqueue := queuemock.NewMockWorkerqueue(mockCtrl)
 				rep = mockRepo
 				queue = mockworkerqueue
+				mockRepo.EXPECT().DeleteReportSchedulesByScope(ctx, "Scope1").Return(nil).Times(1)
 				mockRepo.EXPECT().DeleteReportsByScope(ctx, "Scope1").Return(errors.New("DBError")).Times(1)
 			},
 			input:   &v1.DropReportDataRequest{Scope: "Scope1"},
@@ -93,6 +94,7 @@ func Test_DropReportData(t *testing.T) {
 				mockworkerqueue := queuemock.NewMockWorkerqueue(mockCtrl)
 				rep = mockRepo
 				queue = mockworkerqueue
+				mockRepo.EXPECT().DeleteReportSchedulesByScope(ctx, "Scope1").Return(nil).Times(1)
 				mockRepo.EXPECT().DeleteReportsByScope(ctx, "Scope1").Return(nil).Times(1)
 			},
 			input:   &v1.DropReportDataRequest{Scope: "Scope1"},
@@ -157,7 +159,7 @@ func TestReportServiceServer_SubmitReport(t *testing.T) {
 
 				mockRepo.EXPECT().GetReportType(ctx, int32(3)).Return(db.ReportType{
 					ReportTypeID:   3,
-					ReportTypeName: "EditorExpenses",
+					ReportTypeName: "Expenses by Editor",
 				}, nil)
 				rawJSON := json.RawMessage("[]")
 				fcall :=
@@ -201,7 +203,7 @@ func TestReportServiceServer_SubmitReport(t *testing.T) {
 
 				mockRepo.EXPECT().GetReportType(ctx, int32(3)).Return(db.ReportType{
 					ReportTypeID:   3,
-					ReportTypeName: "EditorExpenses",
+					ReportTypeName: "Expenses by Editor",
 				}, nil)
 				rawJSON := json.RawMessage("[]")
 				fcall :=
@@ -245,7 +247,7 @@ func TestReportServiceServer_SubmitReport(t *testing.T) {
 
 				mockRepo.EXPECT().GetReportType(ctx, int32(3)).Return(db.ReportType{
 					ReportTypeID:   3,
-					ReportTypeName: "EditorExpenses",
+					ReportTypeName: "Expenses by Editor",
 				}, nil)
 				rawJSON := json.RawMessage("[]")
 				mockRepo.EXPECT().SubmitReport(ctx, db.SubmitReportParams{
@@ -383,7 +385,7 @@ func TestReportServiceServer_SubmitReport(t *testing.T) {
 				pclient = mockProductClient
 				mockRepo.EXPECT().GetReportType(ctx, int32(1)).Times(1).Return(db.ReportType{
 					ReportTypeID:   1,
-					ReportTypeName: "ProductEquipments",
+					ReportTypeName: "Compliance",
 				}, nil)
 				mockProductClient.EXPECT().ListAggregationEditors(ctx, &prodv1.ListAggregationEditorsRequest{Scope: "Scope1"}).Return(&prodv1.ListAggregationEditorsResponse{Editor: []string{"editor", "e1"}}, nil).AnyTimes()
 
@@ -431,7 +433,7 @@ func TestReportServiceServer_SubmitReport(t *testing.T) {
 				pclient = mockProductClient
 				mockRepo.EXPECT().GetReportType(ctx, int32(1)).Times(1).Return(db.ReportType{
 					ReportTypeID:   1,
-					ReportTypeName: "ProductEquipments",
+					ReportTypeName: "Compliance",
 				}, nil)
 				mockProductClient.EXPECT().ListAggregationEditors(ctx, &prodv1.ListAggregationEditorsRequest{Scope: "Scope1"}).Return(&prodv1.ListAggregationEditorsResponse{Editor: []string{"editor", "e1"}}, nil).AnyTimes()
 				var j bytes.Buffer
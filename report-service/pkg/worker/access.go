@@ -0,0 +1,85 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	a_v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/thirdparty/account-service/pkg/api/v1"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/ctxmanage"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+
+	"google.golang.org/grpc"
+)
+
+// Access tells who can get the scheduled reports of a scope, the reports are run for
+// and mailed to the users of the scope only with the roles they have at the time.
+type Access interface {
+	// ServiceContext gives the context calling the other services as the report service client for the scope
+	ServiceContext(ctx context.Context, scope string) (context.Context, error)
+	// ScopeUsers gives the roles of the users of the scope by their lowercased user id
+	ScopeUsers(ctx context.Context, scope string) (map[string]claims.Role, error)
+}
+
+type clientAccess struct {
+	accountClient a_v1.AccountServiceClient
+	authAddress   string
+	clientID      string
+	clientSecret  string
+}
+
+// NewAccess gives the access of the report service client registered in auth service with clientID,
+// the users of the scopes are listed by account service.
+func NewAccess(grpcServers map[string]*grpc.ClientConn, authAddress, clientID, clientSecret string) Access {
+	a := &clientAccess{authAddress: authAddress, clientID: clientID, clientSecret: clientSecret}
+	if conn, ok := grpcServers["account"]; ok {
+		a.accountClient = a_v1.NewAccountServiceClient(conn)
+	}
+	return a
+}
+
+func (a *clientAccess) ServiceContext(ctx context.Context, scope string) (context.Context, error) {
+	if a.clientID == "" || a.authAddress == "" {
+		return nil, errors.New("report service client is not configured")
+	}
+	return ctxmanage.CreateServiceContext(ctx, a.clientID, a.clientSecret, scope, a.authAddress)
+}
+
+func (a *clientAccess) ScopeUsers(ctx context.Context, scope string) (map[string]claims.Role, error) {
+	if a.accountClient == nil {
+		return nil, errors.New("account service is not configured")
+	}
+	serviceCtx, err := a.ServiceContext(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := a.accountClient.ListScopeUsers(serviceCtx, &a_v1.ListScopeUsersRequest{Scope: scope})
+	if err != nil {
+		return nil, err
+	}
+	users := make(map[string]claims.Role, len(resp.GetUsers()))
+	for _, u := range resp.GetUsers() {
+		switch u.GetRole() {
+		case a_v1.ROLE_SUPER_ADMIN:
+			users[strings.ToLower(u.GetUserId())] = claims.RoleSuperAdmin
+		case a_v1.ROLE_ADMIN:
+			users[strings.ToLower(u.GetUserId())] = claims.RoleAdmin
+		case a_v1.ROLE_USER:
+			users[strings.ToLower(u.GetUserId())] = claims.RoleUser
+		}
+	}
+	return users, nil
+}
+
+// ScopeRecipients splits the recipients of the reports between the users of the scope and the others
+func ScopeRecipients(recipients []string, users map[string]claims.Role) (scopeUsers, others []string) {
+	for _, r := range recipients {
+		if _, ok := users[strings.ToLower(r)]; ok {
+			scopeUsers = append(scopeUsers, r)
+			continue
+		}
+		others = append(others, r)
+	}
+	return scopeUsers, others
+}
@@ -0,0 +1,184 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/repository/v1/postgres/db"
+	n_v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/thirdparty/notification-service/pkg/api/v1"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"go.uber.org/zap"
+)
+
+// deliverReport mails the generated report of a schedule run to the recipients of the schedule,
+// the outcome is kept on the run and a failed mail does not fail the report.
+func (w *Worker) deliverReport(ctx context.Context, e Envelope) {
+	if e.RunID == 0 {
+		return
+	}
+	w.recordDelivery(ctx, e.RunID, w.mailReport(ctx, e))
+}
+
+func (w *Worker) mailReport(ctx context.Context, e Envelope) error {
+	if w.notificationClient == nil {
+		return errors.New("notification service is not configured")
+	}
+	if w.access == nil {
+		return errors.New("scope access is not configured")
+	}
+	s, err := w.reportRepo.GetReportSchedule(ctx, e.ScheduleID)
+	if err != nil {
+		return fmt.Errorf("cannot get report schedule: %v", err)
+	}
+	// the reports are mailed while their owner keeps the scope and to the users of the scope only
+	users, err := w.access.ScopeUsers(ctx, s.Scope)
+	if err != nil {
+		return fmt.Errorf("cannot get users of scope: %v", err)
+	}
+	if _, ok := users[strings.ToLower(s.CreatedBy)]; !ok {
+		return fmt.Errorf("%s is no longer a user of scope %s", s.CreatedBy, s.Scope)
+	}
+	recipients, others := ScopeRecipients(s.Recipients, users)
+	if len(others) > 0 {
+		logger.Log.Warn("worker - recipients are not users of the scope", zap.Int32("scheduleID", s.ScheduleID), zap.Strings("recipients", others))
+	}
+	if len(recipients) == 0 {
+		return fmt.Errorf("no recipient is a user of scope %s", s.Scope)
+	}
+	req := &n_v1.SendMailRequest{
+		MailSubject: fmt.Sprintf("OPTISAM - %s report of scope %s", e.Type, e.Scope),
+		MailTo:      recipients,
+	}
+	switch s.DeliveryMode {
+	case db.DeliveryModeLINK:
+		if w.reportLink == "" {
+			return errors.New("report link is not configured")
+		}
+		link := html.EscapeString(strings.NewReplacer("{report_id}", strconv.Itoa(int(e.ReportID)), "{scope}", e.Scope).Replace(w.reportLink))
+		req.MailMessage = fmt.Sprintf(`<p>The %s report of scope %s is available at <a href="%s">%s</a>.</p>`, e.Type, e.Scope, link, link)
+	default:
+		report, err := w.reportRepo.DownloadReport(ctx, db.DownloadReportParams{ReportID: e.ReportID, Scope: []string{e.Scope}})
+		if err != nil {
+			return fmt.Errorf("cannot get report data: %v", err)
+		}
+		content, err := reportCSV(report.ReportData)
+		if err != nil {
+			return fmt.Errorf("cannot convert report data: %v", err)
+		}
+		req.MailMessage = fmt.Sprintf("<p>Please find attached the %s report of scope %s.</p>", e.Type, e.Scope)
+		req.Attachments = []*n_v1.Attachment{
+			{
+				Name:        fmt.Sprintf("%s_%s_%d.csv", e.Type, e.Scope, e.ReportID),
+				ContentType: "text/csv",
+				Content:     content,
+			},
+		}
+	}
+	// the mail is sent as the report service client, not on behalf of the owner of the schedule
+	mailCtx, err := w.access.ServiceContext(ctx, s.Scope)
+	if err != nil {
+		return fmt.Errorf("cannot get report service token: %v", err)
+	}
+	if _, err := w.notificationClient.SendMail(mailCtx, req); err != nil {
+		return fmt.Errorf("cannot send mail: %v", err)
+	}
+	return nil
+}
+
+// recordDelivery keeps the outcome of the delivery of a schedule run
+func (w *Worker) recordDelivery(ctx context.Context, runID int32, err error) {
+	params := db.UpdateReportScheduleRunDeliveryParams{DeliveryStatus: db.DeliveryStatusSENT, RunID: runID}
+	if err != nil {
+		logger.Log.Error("worker - report delivery failed", zap.Int32("runID", runID), zap.Error(err))
+		params.DeliveryStatus = db.DeliveryStatusFAILED
+		params.DeliveryError = sql.NullString{String: err.Error(), Valid: true}
+	}
+	if err := w.reportRepo.UpdateReportScheduleRunDelivery(ctx, params); err != nil {
+		logger.Log.Error("worker - recordDelivery - UpdateReportScheduleRunDelivery", zap.Int32("runID", runID), zap.Error(err))
+	}
+}
+
+// reportCSV converts the rows of a report into csv, the columns follow the order of their first appearance
+func reportCSV(data json.RawMessage) ([]byte, error) {
+	var rows []json.RawMessage
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &rows); err != nil {
+			return nil, err
+		}
+	}
+	var columns []string
+	index := make(map[string]int)
+	values := make([]map[string]string, len(rows))
+	for i, row := range rows {
+		fields, keys, err := decodeRow(row)
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range keys {
+			if _, ok := index[k]; !ok {
+				index[k] = len(columns)
+				columns = append(columns, k)
+			}
+		}
+		values[i] = fields
+	}
+	var buf bytes.Buffer
+	cw := csv.NewWriter(&buf)
+	if err := cw.Write(columns); err != nil {
+		return nil, err
+	}
+	for _, fields := range values {
+		record := make([]string, len(columns))
+		for k, v := range fields {
+			record[index[k]] = v
+		}
+		if err := cw.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	cw.Flush()
+	return buf.Bytes(), cw.Error()
+}
+
+// decodeRow gives the values of a report row with its keys in order
+func decodeRow(row json.RawMessage) (map[string]string, []string, error) {
+	fields, err := objectFields(row)
+	if err != nil {
+		return nil, nil, err
+	}
+	values := make(map[string]string, len(fields))
+	keys := make([]string, 0, len(fields))
+	for _, f := range fields {
+		raw, _ := f.Value.(json.RawMessage)
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.UseNumber()
+		var v interface{}
+		if err := dec.Decode(&v); err != nil {
+			return nil, nil, err
+		}
+		switch val := v.(type) {
+		case nil:
+			values[f.Key] = ""
+		case string:
+			values[f.Key] = val
+		case json.Number:
+			values[f.Key] = val.String()
+		case bool:
+			values[f.Key] = strconv.FormatBool(val)
+		default:
+			values[f.Key] = string(raw)
+		}
+		keys = append(keys, f.Key)
+	}
+	return values, keys, nil
+}
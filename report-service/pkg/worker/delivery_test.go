@@ -0,0 +1,207 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/repository/v1/mock"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/repository/v1/postgres/db"
+	nv1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/thirdparty/notification-service/pkg/api/v1"
+	mocknv1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/thirdparty/notification-service/pkg/api/v1/mock"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/token/claims"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// fakeAccess gives the users of every scope and a service context carrying a client token
+type fakeAccess struct {
+	users map[string]claims.Role
+	err   error
+}
+
+func (a fakeAccess) ServiceContext(ctx context.Context, scope string) (context.Context, error) {
+	if a.err != nil {
+		return nil, a.err
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer report-service"), nil
+}
+
+func (a fakeAccess) ScopeUsers(ctx context.Context, scope string) (map[string]claims.Role, error) {
+	return a.users, a.err
+}
+
+// serviceCall checks the mails are sent as the report service client
+func serviceCall(ctx context.Context, t *testing.T) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if got := md.Get("authorization"); len(got) != 1 || got[0] != "Bearer report-service" {
+		t.Errorf("SendMail() authorization = %v", got)
+	}
+	if got := md.Get("user-role"); len(got) != 0 {
+		t.Errorf("SendMail() user-role = %v, want none", got)
+	}
+}
+
+func TestWorker_deliverReport(t *testing.T) {
+	ctx := context.Background()
+	e := Envelope{Type: ScopeExpensesByEditorReport, Scope: "OFR", ReportID: 7, ScheduleID: 1, RunID: 3}
+	users := map[string]claims.Role{"admin@test.com": claims.RoleAdmin, "manager@test.com": claims.RoleUser}
+	tests := []struct {
+		name       string
+		mode       db.DeliveryMode
+		reportLink string
+		recipients []string
+		access     Access
+		setup      func(*mock.MockReport, *mocknv1.MockNotificationServiceClient)
+		wantStatus db.DeliveryStatus
+	}{
+		{
+			name: "ATTACHMENT",
+			mode: db.DeliveryModeATTACHMENT,
+			setup: func(r *mock.MockReport, n *mocknv1.MockNotificationServiceClient) {
+				r.EXPECT().DownloadReport(ctx, db.DownloadReportParams{ReportID: 7, Scope: []string{"OFR"}}).Return(db.DownloadReportRow{
+					ReportData: json.RawMessage(`[{"editor":"e1","totalCost":"10.00"},{"editor":"e2","totalCost":"2.50"}]`),
+				}, nil).Times(1)
+				n.EXPECT().SendMail(gomock.Any(), gomock.Any()).DoAndReturn(func(mailCtx context.Context, req *nv1.SendMailRequest, _ ...grpc.CallOption) (*nv1.SendMailResponse, error) {
+					serviceCall(mailCtx, t)
+					if len(req.Attachments) != 1 || req.Attachments[0].Name != "ScopeExpensesByEditorReport_OFR_7.csv" ||
+						string(req.Attachments[0].Content) != "editor,totalCost\ne1,10.00\ne2,2.50\n" {
+						t.Errorf("SendMail() attachments = %v", req.Attachments)
+					}
+					if len(req.MailTo) != 1 || req.MailTo[0] != "manager@test.com" {
+						t.Errorf("SendMail() mail to = %v", req.MailTo)
+					}
+					return &nv1.SendMailResponse{Success: "true"}, nil
+				}).Times(1)
+			},
+			wantStatus: db.DeliveryStatusSENT,
+		},
+		{
+			name:       "LINK",
+			mode:       db.DeliveryModeLINK,
+			reportLink: "https://optisam/reports?scope={scope}&report={report_id}",
+			setup: func(_ *mock.MockReport, n *mocknv1.MockNotificationServiceClient) {
+				n.EXPECT().SendMail(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *nv1.SendMailRequest, _ ...grpc.CallOption) (*nv1.SendMailResponse, error) {
+					if len(req.Attachments) != 0 || !strings.Contains(req.MailMessage, "https://optisam/reports?scope=OFR&amp;report=7") {
+						t.Errorf("SendMail() message = %v", req.MailMessage)
+					}
+					return &nv1.SendMailResponse{Success: "true"}, nil
+				}).Times(1)
+			},
+			wantStatus: db.DeliveryStatusSENT,
+		},
+		{
+			name:       "recipients out of the scope",
+			mode:       db.DeliveryModeLINK,
+			reportLink: "https://optisam/reports?scope={scope}&report={report_id}",
+			recipients: []string{"Manager@test.com", "someone@external.com"},
+			setup: func(_ *mock.MockReport, n *mocknv1.MockNotificationServiceClient) {
+				n.EXPECT().SendMail(gomock.Any(), gomock.Any()).DoAndReturn(func(mailCtx context.Context, req *nv1.SendMailRequest, _ ...grpc.CallOption) (*nv1.SendMailResponse, error) {
+					serviceCall(mailCtx, t)
+					if len(req.MailTo) != 1 || req.MailTo[0] != "Manager@test.com" {
+						t.Errorf("SendMail() mail to = %v", req.MailTo)
+					}
+					return &nv1.SendMailResponse{Success: "true"}, nil
+				}).Times(1)
+			},
+			wantStatus: db.DeliveryStatusSENT,
+		},
+		{
+			name:       "no recipient in the scope",
+			mode:       db.DeliveryModeLINK,
+			reportLink: "https://optisam/reports?scope={scope}&report={report_id}",
+			recipients: []string{"someone@external.com"},
+			setup:      func(*mock.MockReport, *mocknv1.MockNotificationServiceClient) {},
+			wantStatus: db.DeliveryStatusFAILED,
+		},
+		{
+			name:       "owner lost the scope",
+			mode:       db.DeliveryModeLINK,
+			reportLink: "https://optisam/reports?scope={scope}&report={report_id}",
+			access:     fakeAccess{users: map[string]claims.Role{"manager@test.com": claims.RoleUser}},
+			setup:      func(*mock.MockReport, *mocknv1.MockNotificationServiceClient) {},
+			wantStatus: db.DeliveryStatusFAILED,
+		},
+		{
+			name:       "scope users not available",
+			mode:       db.DeliveryModeLINK,
+			reportLink: "https://optisam/reports?scope={scope}&report={report_id}",
+			access:     fakeAccess{err: errors.New("no token")},
+			setup:      func(*mock.MockReport, *mocknv1.MockNotificationServiceClient) {},
+			wantStatus: db.DeliveryStatusFAILED,
+		},
+		{
+			name:       "LINK not configured",
+			mode:       db.DeliveryModeLINK,
+			setup:      func(*mock.MockReport, *mocknv1.MockNotificationServiceClient) {},
+			wantStatus: db.DeliveryStatusFAILED,
+		},
+		{
+			name: "SendMail failed",
+			mode: db.DeliveryModeATTACHMENT,
+			setup: func(r *mock.MockReport, n *mocknv1.MockNotificationServiceClient) {
+				r.EXPECT().DownloadReport(ctx, gomock.Any()).Return(db.DownloadReportRow{ReportData: json.RawMessage(`[]`)}, nil).Times(1)
+				n.EXPECT().SendMail(gomock.Any(), gomock.Any()).Return(nil, errors.New("smtp error")).Times(1)
+			},
+			wantStatus: db.DeliveryStatusFAILED,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			rep := mock.NewMockReport(mockCtrl)
+			nclient := mocknv1.NewMockNotificationServiceClient(mockCtrl)
+			recipients := tt.recipients
+			if recipients == nil {
+				recipients = []string{"manager@test.com"}
+			}
+			access := tt.access
+			if access == nil {
+				access = fakeAccess{users: users}
+			}
+			rep.EXPECT().GetReportSchedule(ctx, int32(1)).Return(db.ReportSchedule{ScheduleID: 1, Scope: "OFR", Recipients: recipients, DeliveryMode: tt.mode, CreatedBy: "Admin@test.com"}, nil).Times(1)
+			tt.setup(rep, nclient)
+			rep.EXPECT().UpdateReportScheduleRunDelivery(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, arg db.UpdateReportScheduleRunDeliveryParams) error {
+				if arg.RunID != 3 || arg.DeliveryStatus != tt.wantStatus {
+					t.Errorf("UpdateReportScheduleRunDelivery() = %v, want status %v", arg, tt.wantStatus)
+				}
+				if arg.DeliveryError.Valid != (tt.wantStatus == db.DeliveryStatusFAILED) {
+					t.Errorf("UpdateReportScheduleRunDelivery() error = %v", arg.DeliveryError)
+				}
+				return nil
+			}).Times(1)
+			w := &Worker{reportRepo: rep, notificationClient: nclient, reportLink: tt.reportLink, access: access}
+			w.deliverReport(ctx, e)
+		})
+	}
+}
+
+func TestWorker_deliverReport_NotScheduled(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	w := &Worker{reportRepo: mock.NewMockReport(mockCtrl)}
+	w.deliverReport(context.Background(), Envelope{Type: AcqRightsReport, Scope: "OFR", ReportID: 7})
+}
+
+func Test_reportCSV(t *testing.T) {
+	got, err := reportCSV(json.RawMessage(`[{"swidtag":"p1","server":"s1","cost":12.5,"deployed":true},{"swidtag":"p2, beta","cluster":"c1","cost":null}]`))
+	if err != nil {
+		t.Fatalf("reportCSV() error = %v", err)
+	}
+	want := "swidtag,server,cost,deployed,cluster\np1,s1,12.5,true,\n\"p2, beta\",,,,c1\n"
+	if string(got) != want {
+		t.Errorf("reportCSV() = %q, want %q", got, want)
+	}
+	if _, err := reportCSV(json.RawMessage(`["p1"]`)); err == nil {
+		t.Errorf("reportCSV() of rows which are not objects, want error")
+	}
+	if _, err := reportCSV(json.RawMessage(`[]`)); err != nil {
+		t.Errorf("reportCSV() of no rows error = %v", err)
+	}
+}
@@ -0,0 +1,208 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	repo "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/repository/v1"
+	e_v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/thirdparty/equipment-service/pkg/api/v1"
+	l_v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/thirdparty/license-service/pkg/api/v1"
+	p_v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/thirdparty/product-service/pkg/api/v1"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/helper"
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Plugin generates the reports of a report type
+type Plugin interface {
+	// Type is the report type set in the envelopes of the reports
+	Type() ReportType
+	// Params is the schema of the parameters of the reports
+	Params() []Param
+	// Validate checks the parameters of a report before it is submitted,
+	// it gives them as they are kept with the report.
+	Validate(ctx context.Context, src *Sources, scope string, params json.RawMessage) (json.RawMessage, error)
+	// Columns are the columns of the reports, the ones depending on the data are not listed
+	Columns() []Column
+	// Fetch gathers the rows of a report
+	Fetch(ctx context.Context, src *Sources, e Envelope) ([]Row, error)
+	// Format gives the data kept for a report, nil when there is nothing to keep
+	Format(rows []Row) (json.RawMessage, error)
+}
+
+// Sources are the services and stores the plugins fetch the data of the reports from
+type Sources struct {
+	License   l_v1.LicenseServiceClient
+	Product   p_v1.ProductServiceClient
+	Equipment e_v1.EquipmentServiceClient
+	Dgraph    repo.DgraphReport
+}
+
+// Param describes a parameter of a report
+type Param struct {
+	Name        string
+	Description string
+	Required    bool
+}
+
+// Column describes a column of a report
+type Column struct {
+	Key   string
+	Title string
+}
+
+// Field is a value of a report row
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// Row is a record of a report, its fields keep the order of the columns
+type Row []Field
+
+var plugins = struct {
+	byName map[string]Plugin
+	byType map[ReportType]Plugin
+}{
+	byName: make(map[string]Plugin),
+	byType: make(map[ReportType]Plugin),
+}
+
+func init() {
+	Register("Compliance", acqRightsPlugin{})
+	Register("ProductEquipments", productEquipmentsPlugin{})
+	Register("Expenses by Editor", scopeExpensesPlugin{})
+	Register("", templatePlugin{})
+}
+
+// Register adds the plugin of a report type, reportTypeName is the name of the report type in report_type,
+// it is empty when the report types are made at runtime like the ones of the report templates.
+// Register is meant to be called from init functions.
+func Register(reportTypeName string, p Plugin) {
+	if reportTypeName != "" {
+		plugins.byName[reportTypeName] = p
+	}
+	plugins.byType[p.Type()] = p
+}
+
+// Lookup gives the plugin registered for the report type named reportTypeName
+func Lookup(reportTypeName string) (Plugin, bool) {
+	p, ok := plugins.byName[reportTypeName]
+	return p, ok
+}
+
+// LookupType gives the plugin generating the reports of the report type
+func LookupType(t ReportType) (Plugin, bool) {
+	p, ok := plugins.byType[t]
+	return p, ok
+}
+
+// checkParams checks the parameters of a report against the schema and decodes them into v
+func checkParams(schema []Param, params json.RawMessage, v interface{}) error {
+	fields := make(map[string]json.RawMessage)
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, &fields); err != nil {
+			return status.Error(codes.InvalidArgument, "Bad Report Request")
+		}
+	}
+	known := make(map[string]bool, len(schema))
+	for _, p := range schema {
+		known[p.Name] = true
+		if value, ok := fields[p.Name]; p.Required && (!ok || string(value) == `""`) {
+			return status.Error(codes.InvalidArgument, "Bad Report Request")
+		}
+	}
+	for name := range fields {
+		if !known[name] {
+			return status.Error(codes.InvalidArgument, "Bad Report Request")
+		}
+	}
+	if len(params) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(params, v); err != nil {
+		return status.Error(codes.InvalidArgument, "Bad Report Request")
+	}
+	return nil
+}
+
+// checkEditor checks the editor has products in the scope
+func checkEditor(ctx context.Context, src *Sources, scope, editor string) error {
+	editors, err := src.Product.ListAggregationEditors(ctx, &p_v1.ListAggregationEditorsRequest{Scope: scope})
+	if err != nil {
+		logger.Log.Error("worker - checkEditor - ProductService - ListAggregationEditors", zap.String("scope", scope), zap.Error(err))
+		return status.Error(codes.Internal, "Internal Server Error")
+	}
+	if !helper.Contains(editors.GetEditor(), editor) {
+		return status.Error(codes.InvalidArgument, "Editor doesn't exist")
+	}
+	return nil
+}
+
+// jsonFormat keeps the rows of a report as a json array of objects
+type jsonFormat struct{}
+
+func (jsonFormat) Format(rows []Row) (json.RawMessage, error) {
+	return formatRows(rows)
+}
+
+// formatRows gives the json array of the rows, the fields of an object keep the order of the row
+func formatRows(rows []Row) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, row := range rows {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('{')
+		for j, f := range row {
+			if j > 0 {
+				buf.WriteByte(',')
+			}
+			key, err := json.Marshal(f.Key)
+			if err != nil {
+				return nil, err
+			}
+			value, err := json.Marshal(f.Value)
+			if err != nil {
+				return nil, fmt.Errorf("cannot marshal %s: %v", f.Key, err)
+			}
+			buf.Write(key)
+			buf.WriteByte(':')
+			buf.Write(value)
+		}
+		buf.WriteByte('}')
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// objectFields gives the fields of a json object in order, their values are kept as json
+func objectFields(data []byte) ([]Field, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if t, err := dec.Token(); err != nil {
+		return nil, err
+	} else if t != json.Delim('{') {
+		return nil, fmt.Errorf("not a json object: %s", data)
+	}
+	var fields []Field
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, _ := t.(string)
+		var v json.RawMessage
+		if err := dec.Decode(&v); err != nil {
+			return nil, err
+		}
+		fields = append(fields, Field{Key: key, Value: v})
+	}
+	return fields, nil
+}
@@ -0,0 +1,266 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	repo "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/pkg/repository/v1"
+	l_v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/thirdparty/license-service/pkg/api/v1"
+	p_v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/thirdparty/product-service/pkg/api/v1"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"go.uber.org/zap"
+)
+
+// acqRightsPlugin generates the compliance of the acquired rights of an editor
+type acqRightsPlugin struct {
+	jsonFormat
+}
+
+func (acqRightsPlugin) Type() ReportType {
+	return AcqRightsReport
+}
+
+func (acqRightsPlugin) Params() []Param {
+	return []Param{
+		{Name: "editor", Description: "Editor of the acquired rights", Required: true},
+	}
+}
+
+func (p acqRightsPlugin) Validate(ctx context.Context, src *Sources, scope string, params json.RawMessage) (json.RawMessage, error) {
+	var r AcqRightsReportStruct
+	if err := checkParams(p.Params(), params, &r); err != nil {
+		return nil, err
+	}
+	if err := checkEditor(ctx, src, scope, r.Editor); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+func (acqRightsPlugin) Columns() []Column {
+	return []Column{
+		{Key: "sku", Title: "SKU"},
+		{Key: "aggregationName", Title: "Aggregation"},
+		{Key: "swidtags", Title: "Swidtags"},
+		{Key: "editor", Title: "Editor"},
+		{Key: "product", Title: "Product"},
+		{Key: "metric", Title: "Metric"},
+		{Key: "computedLicenses", Title: "Computed licenses"},
+		{Key: "computationDetails", Title: "Computation details"},
+		{Key: "acquiredLicenses", Title: "Acquired licenses"},
+		{Key: "delta(licenses)", Title: "Delta (licenses)"},
+		{Key: "delta(cost)", Title: "Delta (cost)"},
+		{Key: "totalcost", Title: "Total cost"},
+		{Key: "avgunitprice", Title: "Average unit price"},
+	}
+}
+
+func (acqRightsPlugin) Fetch(ctx context.Context, src *Sources, e Envelope) ([]Row, error) {
+	var r AcqRightsReportStruct
+	if err := json.Unmarshal(e.JSON, &r); err != nil {
+		logger.Log.Error("worker - AcqRightsReport - Unmarshall Error", zap.Error(err))
+		return nil, fmt.Errorf("worker - AcqRightsReport - Json Marshalling failed")
+	}
+	resp, err := src.License.GetOverAllCompliance(ctx, &l_v1.GetOverAllComplianceRequest{
+		Scope:  e.Scope,
+		Editor: r.Editor,
+	})
+	if err != nil {
+		logger.Log.Error("worker - acqrights report - LicenseService - GetOverAllCompliance", zap.Error(err))
+		return nil, fmt.Errorf("worker - acqrights report - LicenseService - GetOverAllCompliance failed")
+	}
+	rows := make([]Row, 0, len(resp.AcqRights))
+	for _, a := range resp.AcqRights {
+		computationDetails := a.ComputedDetails
+		if a.MetricNotDefined {
+			computationDetails = "Metric Not Defined"
+		}
+		if a.NotDeployed {
+			computationDetails = "Product Not Deployed"
+		}
+		rows = append(rows, Row{
+			{Key: "sku", Value: a.SKU},
+			{Key: "aggregationName", Value: a.AggregationName},
+			{Key: "swidtags", Value: a.SwidTags},
+			{Key: "editor", Value: r.Editor},
+			{Key: "product", Value: a.ProductNames},
+			{Key: "metric", Value: a.Metric},
+			{Key: "computedLicenses", Value: a.NumCptLicences},
+			{Key: "computationDetails", Value: computationDetails},
+			{Key: "acquiredLicenses", Value: a.NumAcqLicences},
+			{Key: "delta(licenses)", Value: a.DeltaNumber},
+			{Key: "delta(cost)", Value: a.DeltaCost},
+			{Key: "totalcost", Value: a.TotalCost},
+			{Key: "avgunitprice", Value: a.AvgUnitPrice},
+		})
+	}
+	return rows, nil
+}
+
+// productEquipmentsPlugin generates the equipments of a type the products of an editor are installed on,
+// with the attributes of the equipments and their parents.
+type productEquipmentsPlugin struct {
+	jsonFormat
+}
+
+func (productEquipmentsPlugin) Type() ReportType {
+	return ProductEquipmentsReport
+}
+
+func (productEquipmentsPlugin) Params() []Param {
+	return []Param{
+		{Name: "editor", Description: "Editor of the products", Required: true},
+		{Name: "equipType", Description: "Type of the equipments", Required: true},
+	}
+}
+
+func (p productEquipmentsPlugin) Validate(ctx context.Context, src *Sources, scope string, params json.RawMessage) (json.RawMessage, error) {
+	var r productEquipmentsReportStruct
+	if err := checkParams(p.Params(), params, &r); err != nil {
+		return nil, err
+	}
+	if err := checkEditor(ctx, src, scope, r.Editor); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+// Columns are followed by the equipment, its attributes and its parents
+func (productEquipmentsPlugin) Columns() []Column {
+	return []Column{
+		{Key: "swidtag", Title: "Swidtag"},
+		{Key: "editor", Title: "Editor"},
+		{Key: "product", Title: "Product"},
+	}
+}
+
+func (productEquipmentsPlugin) Fetch(ctx context.Context, src *Sources, e Envelope) ([]Row, error) {
+	var r productEquipmentsReportStruct
+	if err := json.Unmarshal(e.JSON, &r); err != nil {
+		logger.Log.Error("worker - ProductEquipmentsReport - Unmarshall Error", zap.Error(err))
+		return nil, fmt.Errorf("worker - ProductEquipmentsReport - Json Marshalling failed")
+	}
+
+	// Find equipment type parents to make columns
+	parents, err := src.Dgraph.EquipmentTypeParents(ctx, r.EquipType, e.Scope)
+	if err != nil && err != repo.ErrNoData {
+		logger.Log.Error("worker - ProductEquipmentsReport -  EquipmentTypeParents", zap.Error(err))
+		return nil, fmt.Errorf("worker - ProductEquipmentsReport - EquipmentTypeParents failed")
+	}
+
+	// Find equipmenttype attributes to make columns
+	attrs, err := src.Dgraph.EquipmentTypeAttrs(ctx, r.EquipType, e.Scope)
+	if err != nil && err != repo.ErrNoData {
+		logger.Log.Error("worker - ProductEquipmentsReport -  EquipmentTypeAttrs", zap.Error(err))
+		return nil, fmt.Errorf("worker - ProductEquipmentsReport - EquipmentTypeAttrs failed")
+	}
+
+	// Find Equipments on which the product is installed
+	productEquipments, err := src.Dgraph.ProductEquipments(ctx, r.Editor, e.Scope, r.EquipType)
+	if err == repo.ErrNoData {
+		return nil, nil
+	}
+	if err != nil {
+		logger.Log.Error("worker - ProductEquipmentsReport -  ProductEquipments", zap.Error(err))
+		return nil, fmt.Errorf("worker - ProductEquipmentsReport - ProductEquipments failed")
+	}
+	var rows []Row
+	for _, pro := range productEquipments {
+		for _, equipment := range pro.Equipments {
+			row := Row{
+				{Key: "swidtag", Value: pro.Swidtag},
+				{Key: "editor", Value: r.Editor},
+				{Key: "product", Value: pro.ProductName},
+				{Key: equipment.EquipmentType, Value: equipment.EquipmentID},
+			}
+			// Find all attributes value if the attribute are available
+			if attrs != nil {
+				attributeJSON, err := src.Dgraph.EquipmentAttributes(ctx, equipment.EquipmentID, equipment.EquipmentType, attrs, e.Scope)
+				if err != nil {
+					logger.Log.Error("worker - ProductEquipmentsReport -  EquipmentAttributes", zap.Error(err))
+					return nil, fmt.Errorf("worker - ProductEquipmentsReport - EquipmentAttributes failed")
+				}
+				attributes, err := objectFields(attributeJSON)
+				if err != nil {
+					logger.Log.Error("worker - ProductEquipmentsReport -  EquipmentAttributes", zap.Error(err))
+					return nil, fmt.Errorf("worker - ProductEquipmentsReport - EquipmentAttributes failed")
+				}
+				row = append(row, attributes...)
+			}
+			// Find parentsIDs if there are parents exists
+			if parents != nil {
+				equipmentParents, err := src.Dgraph.EquipmentParents(ctx, equipment.EquipmentID, equipment.EquipmentType, e.Scope)
+				if err != nil && err != repo.ErrNoData {
+					logger.Log.Error("worker - ProductEquipmentsReport -  EquipmentParents", zap.Error(err))
+					return nil, fmt.Errorf("worker - ProductEquipmentsReport - EquipmentParents failed")
+				}
+				for _, parent := range parents {
+					row = append(row, Field{Key: parent, Value: findEquipmentID(parent, equipmentParents)})
+				}
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+// scopeExpensesPlugin generates the expenses of the scope by editor
+type scopeExpensesPlugin struct{}
+
+func (scopeExpensesPlugin) Type() ReportType {
+	return ScopeExpensesByEditorReport
+}
+
+func (scopeExpensesPlugin) Params() []Param {
+	return nil
+}
+
+// Validate ignores the parameters, the report of the whole scope is kept with empty ones
+func (scopeExpensesPlugin) Validate(ctx context.Context, src *Sources, scope string, params json.RawMessage) (json.RawMessage, error) {
+	return json.RawMessage("[]"), nil
+}
+
+func (scopeExpensesPlugin) Columns() []Column {
+	return []Column{
+		{Key: "editor", Title: "Editor"},
+		{Key: "purchaseCost", Title: "Purchase cost"},
+		{Key: "maintenanceCost", Title: "Maintenance cost"},
+		{Key: "totalCost", Title: "Total cost"},
+	}
+}
+
+func (scopeExpensesPlugin) Fetch(ctx context.Context, src *Sources, e Envelope) ([]Row, error) {
+	logger.Log.Sugar().Infow("ScopeExpensesByEditorReport Report genration started ", "scope", e.Scope)
+	editorResponse, err := src.Product.GetEditorExpensesByScope(ctx, &p_v1.EditorExpensesByScopeRequest{
+		Scope: e.Scope,
+	})
+	if err != nil {
+		logger.Log.Sugar().Errorw("Report Worker - ScopeExpensesByEditorReport - ProductService - GetEditorExpensesByScope",
+			"scope", e.Scope,
+			"error", err.Error(),
+		)
+		return nil, fmt.Errorf("Report Worker - ScopeExpensesByEditorReport - ProductService - GetEditorExpensesByScope failed")
+	}
+	rows := make([]Row, 0, len(editorResponse.EditorExpensesByScope))
+	for _, editorData := range editorResponse.EditorExpensesByScope {
+		rows = append(rows, Row{
+			{Key: "editor", Value: editorData.EditorName},
+			{Key: "purchaseCost", Value: fmt.Sprintf("%.2f", editorData.TotalPurchaseCost)},
+			{Key: "maintenanceCost", Value: fmt.Sprintf("%.2f", editorData.TotalMaintenanceCost)},
+			{Key: "totalCost", Value: fmt.Sprintf("%.2f", editorData.TotalCost)},
+		})
+	}
+	return rows, nil
+}
+
+// Format keeps no data when the scope has no expenses
+func (scopeExpensesPlugin) Format(rows []Row) (json.RawMessage, error) {
+	if len(rows) == 0 {
+		logger.Log.Sugar().Debugw("Report Worker - ScopeExpensesByEditorReport - no data found")
+		return nil, nil
+	}
+	return formatRows(rows)
+}
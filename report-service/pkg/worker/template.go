@@ -0,0 +1,598 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	e_v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/thirdparty/equipment-service/pkg/api/v1"
+	l_v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/thirdparty/license-service/pkg/api/v1"
+	p_v1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/thirdparty/product-service/pkg/api/v1"
+
+	"gitlab.tech.orange/optisam/optisam-it/optisam-services/common/optisam/logger"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Template is a tabular report defined by an admin, the records of the entity are filtered,
+// grouped by a column if any, and the columns are kept in order.
+type Template struct {
+	Entity  string   `json:"entity"`
+	Columns []string `json:"columns"`
+	Filters []Filter `json:"filters,omitempty"`
+	GroupBy string   `json:"group_by,omitempty"`
+}
+
+// Filter keeps the records whose column compares to the value
+type Filter struct {
+	Column   string `json:"column"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+// Operators of the filters, GT and LT compare numbers
+const (
+	FilterEQ       = "EQ"
+	FilterNE       = "NE"
+	FilterContains = "CONTAINS"
+	FilterGT       = "GT"
+	FilterLT       = "LT"
+)
+
+// CountColumn is added to the rows of a grouped template with the number of records of the group
+const CountColumn = "count"
+
+// Entity is what the report templates are built on
+type Entity struct {
+	Name    string
+	Columns []Column
+	// RequiredFilters are the columns a template must filter with EQ, their values are passed to the service
+	RequiredFilters []string
+	// DynamicColumns is set when the columns depend on the data of the scope, any column is accepted
+	DynamicColumns bool
+	fetch          func(ctx context.Context, src *Sources, scope string, eq map[string]string) ([]record, error)
+}
+
+// record is an entity fetched from a service, the numbers are float64
+type record map[string]interface{}
+
+// templatePageSize is the largest page the listing apis of the services accept
+const templatePageSize = 200
+
+// templateMaxPages is the number of pages the product service lets list
+const templateMaxPages = 999
+
+// errTooManyRecords is returned rather than a report missing the records past the pages the services let list
+var errTooManyRecords = fmt.Errorf("more than %d records to report", templatePageSize*templateMaxPages)
+
+var templateEntities = []Entity{
+	{
+		Name: "acqrights",
+		Columns: []Column{
+			{Key: "sku", Title: "SKU"},
+			{Key: "swidtag", Title: "Swidtag"},
+			{Key: "product", Title: "Product"},
+			{Key: "version", Title: "Version"},
+			{Key: "editor", Title: "Editor"},
+			{Key: "metric", Title: "Metric"},
+			{Key: "acquiredLicenses", Title: "Acquired licenses"},
+			{Key: "avgLicenseUnitPrice", Title: "Average license unit price"},
+			{Key: "avgMaintenanceUnitPrice", Title: "Average maintenance unit price"},
+			{Key: "totalPurchaseCost", Title: "Total purchase cost"},
+			{Key: "totalMaintenanceCost", Title: "Total maintenance cost"},
+			{Key: "totalCost", Title: "Total cost"},
+			{Key: "licensesUnderMaintenance", Title: "Licenses under maintenance"},
+			{Key: "startOfMaintenance", Title: "Start of maintenance"},
+			{Key: "endOfMaintenance", Title: "End of maintenance"},
+			{Key: "softwareProvider", Title: "Software provider"},
+			{Key: "maintenanceProvider", Title: "Maintenance provider"},
+		},
+		fetch: fetchAcqRights,
+	},
+	{
+		Name: "compliance",
+		Columns: []Column{
+			{Key: "sku", Title: "SKU"},
+			{Key: "aggregation", Title: "Aggregation"},
+			{Key: "swidtags", Title: "Swidtags"},
+			{Key: "product", Title: "Product"},
+			{Key: "editor", Title: "Editor"},
+			{Key: "metric", Title: "Metric"},
+			{Key: "computedLicenses", Title: "Computed licenses"},
+			{Key: "acquiredLicenses", Title: "Acquired licenses"},
+			{Key: "deltaLicenses", Title: "Delta (licenses)"},
+			{Key: "deltaCost", Title: "Delta (cost)"},
+			{Key: "totalCost", Title: "Total cost"},
+			{Key: "avgUnitPrice", Title: "Average unit price"},
+			{Key: "computationDetails", Title: "Computation details"},
+		},
+		RequiredFilters: []string{"editor"},
+		fetch:           fetchCompliance,
+	},
+	{
+		Name: "equipments",
+		Columns: []Column{
+			{Key: "type", Title: "Equipment type"},
+		},
+		RequiredFilters: []string{"type"},
+		DynamicColumns:  true,
+		fetch:           fetchEquipments,
+	},
+	{
+		Name: "products",
+		Columns: []Column{
+			{Key: "swidtag", Title: "Swidtag"},
+			{Key: "name", Title: "Name"},
+			{Key: "version", Title: "Version"},
+			{Key: "category", Title: "Category"},
+			{Key: "edition", Title: "Edition"},
+			{Key: "editor", Title: "Editor"},
+			{Key: "location", Title: "Location"},
+			{Key: "allocatedMetric", Title: "Allocated metric"},
+			{Key: "totalCost", Title: "Total cost"},
+			{Key: "numOfApplications", Title: "Applications"},
+			{Key: "numOfEquipments", Title: "Equipments"},
+			{Key: "numOfUsers", Title: "Users"},
+		},
+		fetch: fetchProducts,
+	},
+}
+
+// TemplateEntities gives the entities the report templates can be built on
+func TemplateEntities() []Entity {
+	return append([]Entity(nil), templateEntities...)
+}
+
+func templateEntity(name string) (Entity, bool) {
+	for _, e := range templateEntities {
+		if e.Name == name {
+			return e, true
+		}
+	}
+	return Entity{}, false
+}
+
+func (e Entity) hasColumn(key string) bool {
+	if e.DynamicColumns {
+		return key != ""
+	}
+	for _, c := range e.Columns {
+		if c.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the template can be generated
+func (t Template) Validate() error {
+	entity, ok := templateEntity(t.Entity)
+	if !ok {
+		return fmt.Errorf("unknown entity %q", t.Entity)
+	}
+	if len(t.Columns) == 0 {
+		return errors.New("a template has at least one column")
+	}
+	seen := make(map[string]bool, len(t.Columns))
+	for _, c := range t.Columns {
+		if !entity.hasColumn(c) {
+			return fmt.Errorf("unknown column %q of entity %s", c, t.Entity)
+		}
+		if seen[c] {
+			return fmt.Errorf("column %q is repeated", c)
+		}
+		seen[c] = true
+	}
+	if t.GroupBy != "" {
+		if !seen[t.GroupBy] {
+			return fmt.Errorf("group by column %q is not a column of the template", t.GroupBy)
+		}
+		if seen[CountColumn] {
+			return fmt.Errorf("column %q is added to the groups", CountColumn)
+		}
+	}
+	for _, f := range t.Filters {
+		if !entity.hasColumn(f.Column) {
+			return fmt.Errorf("unknown filter column %q of entity %s", f.Column, t.Entity)
+		}
+		switch f.Operator {
+		case FilterEQ, FilterNE, FilterContains:
+		case FilterGT, FilterLT:
+			if _, err := strconv.ParseFloat(f.Value, 64); err != nil {
+				return fmt.Errorf("filter on %q compares to a number", f.Column)
+			}
+		default:
+			return fmt.Errorf("unknown filter operator %q", f.Operator)
+		}
+	}
+	eq := t.equalFilters()
+	for _, c := range entity.RequiredFilters {
+		if eq[c] == "" {
+			return fmt.Errorf("entity %s is filtered on %q with EQ", t.Entity, c)
+		}
+	}
+	return nil
+}
+
+// ReportColumns are the columns of the reports of the template with their titles
+func (t Template) ReportColumns() []Column {
+	entity, _ := templateEntity(t.Entity)
+	columns := make([]Column, 0, len(t.Columns)+1)
+	for _, key := range t.Columns {
+		c := Column{Key: key, Title: key}
+		for _, ec := range entity.Columns {
+			if ec.Key == key {
+				c.Title = ec.Title
+				break
+			}
+		}
+		columns = append(columns, c)
+	}
+	if t.GroupBy != "" {
+		columns = append(columns, Column{Key: CountColumn, Title: "Count"})
+	}
+	return columns
+}
+
+// equalFilters gives the values of the EQ filters by column
+func (t Template) equalFilters() map[string]string {
+	eq := make(map[string]string)
+	for _, f := range t.Filters {
+		if f.Operator == FilterEQ {
+			eq[f.Column] = f.Value
+		}
+	}
+	return eq
+}
+
+// rows filters, groups and projects the records of the entity
+func (t Template) rows(records []record) []Row {
+	var kept []record
+	for _, r := range records {
+		if t.keep(r) {
+			kept = append(kept, r)
+		}
+	}
+	if t.GroupBy == "" {
+		rows := make([]Row, len(kept))
+		for i, r := range kept {
+			row := make(Row, len(t.Columns))
+			for j, c := range t.Columns {
+				row[j] = Field{Key: c, Value: r[c]}
+			}
+			rows[i] = row
+		}
+		return rows
+	}
+	var keys []string
+	groups := make(map[string][]record)
+	for _, r := range kept {
+		k := text(r[t.GroupBy])
+		if _, ok := groups[k]; !ok {
+			keys = append(keys, k)
+		}
+		groups[k] = append(groups[k], r)
+	}
+	rows := make([]Row, len(keys))
+	for i, k := range keys {
+		group := groups[k]
+		row := make(Row, 0, len(t.Columns)+1)
+		for _, c := range t.Columns {
+			if c == t.GroupBy {
+				row = append(row, Field{Key: c, Value: group[0][c]})
+				continue
+			}
+			row = append(row, Field{Key: c, Value: aggregate(group, c)})
+		}
+		rows[i] = append(row, Field{Key: CountColumn, Value: len(group)})
+	}
+	return rows
+}
+
+func (t Template) keep(r record) bool {
+	for _, f := range t.Filters {
+		v := r[f.Column]
+		switch f.Operator {
+		case FilterEQ:
+			if text(v) != f.Value {
+				return false
+			}
+		case FilterNE:
+			if text(v) == f.Value {
+				return false
+			}
+		case FilterContains:
+			if !strings.Contains(strings.ToLower(text(v)), strings.ToLower(f.Value)) {
+				return false
+			}
+		case FilterGT, FilterLT:
+			n, ok := v.(float64)
+			limit, _ := strconv.ParseFloat(f.Value, 64)
+			if !ok || (f.Operator == FilterGT && n <= limit) || (f.Operator == FilterLT && n >= limit) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// aggregate sums the numbers of the column in the group, the other values are listed once
+func aggregate(group []record, column string) interface{} {
+	var sum float64
+	numbers := true
+	var values []string
+	seen := make(map[string]bool)
+	for _, r := range group {
+		v := r[column]
+		if n, ok := v.(float64); ok {
+			sum += n
+		} else if v != nil {
+			numbers = false
+		}
+		if s := text(v); s != "" && !seen[s] {
+			seen[s] = true
+			values = append(values, s)
+		}
+	}
+	if numbers {
+		return sum
+	}
+	sort.Strings(values)
+	return strings.Join(values, ", ")
+}
+
+func text(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// templatePlugin generates the reports of the report templates, the envelope carries the template
+type templatePlugin struct {
+	jsonFormat
+}
+
+func (templatePlugin) Type() ReportType {
+	return TemplateReport
+}
+
+// Params are the ones of the template, a report of a template has none
+func (templatePlugin) Params() []Param {
+	return nil
+}
+
+// Validate checks the template given as parameters
+func (templatePlugin) Validate(ctx context.Context, src *Sources, scope string, params json.RawMessage) (json.RawMessage, error) {
+	var t Template
+	if err := json.Unmarshal(params, &t); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Bad Report Request")
+	}
+	if err := t.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return params, nil
+}
+
+// Columns are the ones of the template
+func (templatePlugin) Columns() []Column {
+	return nil
+}
+
+func (templatePlugin) Fetch(ctx context.Context, src *Sources, e Envelope) ([]Row, error) {
+	var t Template
+	if err := json.Unmarshal(e.JSON, &t); err != nil {
+		logger.Log.Error("worker - TemplateReport - Unmarshall Error", zap.Error(err))
+		return nil, fmt.Errorf("worker - TemplateReport - Json Marshalling failed")
+	}
+	if err := t.Validate(); err != nil {
+		return nil, fmt.Errorf("worker - TemplateReport - %v", err)
+	}
+	entity, _ := templateEntity(t.Entity)
+	records, err := entity.fetch(ctx, src, e.Scope, t.equalFilters())
+	if err != nil {
+		logger.Log.Error("worker - TemplateReport - fetch", zap.String("entity", t.Entity), zap.String("scope", e.Scope), zap.Error(err))
+		if errors.Is(err, errTooManyRecords) {
+			return nil, fmt.Errorf("worker - TemplateReport - %s - %v", t.Entity, err)
+		}
+		return nil, fmt.Errorf("worker - TemplateReport - %s - fetch failed", t.Entity)
+	}
+	return t.rows(records), nil
+}
+
+func fetchProducts(ctx context.Context, src *Sources, scope string, eq map[string]string) ([]record, error) {
+	var records []record
+	for page := int32(1); ; page++ {
+		resp, err := src.Product.ListProducts(ctx, &p_v1.ListProductsRequest{
+			PageNum:   page,
+			PageSize:  templatePageSize,
+			SortBy:    "name",
+			SortOrder: p_v1.SortOrder_asc,
+			Scopes:    []string{scope},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range resp.Products {
+			records = append(records, record{
+				"swidtag":           p.SwidTag,
+				"name":              p.Name,
+				"version":           p.Version,
+				"category":          p.Category,
+				"edition":           p.Edition,
+				"editor":            p.Editor,
+				"location":          p.Location,
+				"allocatedMetric":   p.AllocatedMetric,
+				"totalCost":         p.TotalCost,
+				"numOfApplications": float64(p.NumOfApplications),
+				"numOfEquipments":   float64(p.NumofEquipments),
+				"numOfUsers":        float64(p.NumofUsers),
+			})
+		}
+		if len(resp.Products) < templatePageSize || len(records) >= int(resp.TotalRecords) {
+			return records, nil
+		}
+		if page == templateMaxPages {
+			return nil, errTooManyRecords
+		}
+	}
+}
+
+func fetchAcqRights(ctx context.Context, src *Sources, scope string, eq map[string]string) ([]record, error) {
+	var records []record
+	for page := int32(1); ; page++ {
+		resp, err := src.Product.ListAcqRights(ctx, &p_v1.ListAcqRightsRequest{
+			PageNum:   page,
+			PageSize:  templatePageSize,
+			SortBy:    p_v1.ListAcqRightsRequest_SKU,
+			SortOrder: p_v1.SortOrder_asc,
+			Scopes:    []string{scope},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range resp.AcquiredRights {
+			records = append(records, record{
+				"sku":                      a.SKU,
+				"swidtag":                  a.SwidTag,
+				"product":                  a.ProductName,
+				"version":                  a.Version,
+				"editor":                   a.Editor,
+				"metric":                   a.Metric,
+				"acquiredLicenses":         float64(a.AcquiredLicensesNumber),
+				"avgLicenseUnitPrice":      a.AvgLicenesUnitPrice,
+				"avgMaintenanceUnitPrice":  a.AvgMaintenanceUnitPrice,
+				"totalPurchaseCost":        a.TotalPurchaseCost,
+				"totalMaintenanceCost":     a.TotalMaintenanceCost,
+				"totalCost":                a.TotalCost,
+				"licensesUnderMaintenance": float64(a.LicensesUnderMaintenanceNumber),
+				"startOfMaintenance":       date(a.StartOfMaintenance),
+				"endOfMaintenance":         date(a.EndOfMaintenance),
+				"softwareProvider":         a.SoftwareProvider,
+				"maintenanceProvider":      a.MaintenanceProvider,
+			})
+		}
+		if len(resp.AcquiredRights) < templatePageSize || len(records) >= int(resp.TotalRecords) {
+			return records, nil
+		}
+		if page == templateMaxPages {
+			return nil, errTooManyRecords
+		}
+	}
+}
+
+func fetchCompliance(ctx context.Context, src *Sources, scope string, eq map[string]string) ([]record, error) {
+	resp, err := src.License.GetOverAllCompliance(ctx, &l_v1.GetOverAllComplianceRequest{
+		Scope:  scope,
+		Editor: eq["editor"],
+	})
+	if err != nil {
+		return nil, err
+	}
+	records := make([]record, len(resp.AcqRights))
+	for i, a := range resp.AcqRights {
+		computationDetails := a.ComputedDetails
+		if a.MetricNotDefined {
+			computationDetails = "Metric Not Defined"
+		}
+		if a.NotDeployed {
+			computationDetails = "Product Not Deployed"
+		}
+		records[i] = record{
+			"sku":                a.SKU,
+			"aggregation":        a.AggregationName,
+			"swidtags":           a.SwidTags,
+			"product":            a.ProductNames,
+			"editor":             eq["editor"],
+			"metric":             a.Metric,
+			"computedLicenses":   float64(a.NumCptLicences),
+			"acquiredLicenses":   float64(a.NumAcqLicences),
+			"deltaLicenses":      float64(a.DeltaNumber),
+			"deltaCost":          a.DeltaCost,
+			"totalCost":          a.TotalCost,
+			"avgUnitPrice":       a.AvgUnitPrice,
+			"computationDetails": computationDetails,
+		}
+	}
+	return records, nil
+}
+
+func fetchEquipments(ctx context.Context, src *Sources, scope string, eq map[string]string) ([]record, error) {
+	if src.Equipment == nil {
+		return nil, errors.New("equipment service is not configured")
+	}
+	types, err := src.Equipment.EquipmentsTypes(ctx, &e_v1.EquipmentTypesRequest{Scopes: []string{scope}})
+	if err != nil {
+		return nil, err
+	}
+	var equipType *e_v1.EquipmentType
+	for _, t := range types.EquipmentTypes {
+		if t.Type == eq["type"] {
+			equipType = t
+			break
+		}
+	}
+	if equipType == nil {
+		return nil, fmt.Errorf("equipment type %s does not exist", eq["type"])
+	}
+	// the equipments are listed sorted by a displayed attribute, the identifier when it is
+	sortBy := ""
+	for _, a := range equipType.Attributes {
+		if a.Displayed && (sortBy == "" || a.PrimaryKey) {
+			sortBy = a.Name
+		}
+	}
+	var records []record
+	for page := int32(1); ; page++ {
+		resp, err := src.Equipment.ListEquipments(ctx, &e_v1.ListEquipmentsRequest{
+			TypeId:    equipType.ID,
+			PageNum:   page,
+			PageSize:  templatePageSize,
+			SortBy:    sortBy,
+			SortOrder: e_v1.SortOrder_ASC,
+			Scopes:    []string{scope},
+		})
+		if err != nil {
+			return nil, err
+		}
+		var equipments []record
+		if len(resp.Equipments) > 0 {
+			if err := json.Unmarshal(resp.Equipments, &equipments); err != nil {
+				return nil, err
+			}
+		}
+		for _, r := range equipments {
+			r["type"] = equipType.Type
+		}
+		records = append(records, equipments...)
+		if len(equipments) < templatePageSize || len(records) >= int(resp.TotalRecords) {
+			return records, nil
+		}
+	}
+}
+
+// date gives the day of the timestamp, empty when it is not set
+func date(ts *timestamp.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
@@ -0,0 +1,165 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	ev1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/thirdparty/equipment-service/pkg/api/v1"
+	mockev1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/thirdparty/equipment-service/pkg/api/v1/mock"
+	pv1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/thirdparty/product-service/pkg/api/v1"
+	mockpv1 "gitlab.tech.orange/optisam/optisam-it/optisam-services/report-service/thirdparty/product-service/pkg/api/v1/mock"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestTemplate_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		t       Template
+		wantErr bool
+	}{
+		{name: "UnknownEntity", t: Template{Entity: "licenses", Columns: []string{"sku"}}, wantErr: true},
+		{name: "NoColumn", t: Template{Entity: "products"}, wantErr: true},
+		{name: "UnknownColumn", t: Template{Entity: "products", Columns: []string{"sku"}}, wantErr: true},
+		{name: "RepeatedColumn", t: Template{Entity: "products", Columns: []string{"name", "name"}}, wantErr: true},
+		{name: "GroupByNotAColumn", t: Template{Entity: "products", Columns: []string{"name"}, GroupBy: "editor"}, wantErr: true},
+		{name: "UnknownOperator", t: Template{Entity: "products", Columns: []string{"name"}, Filters: []Filter{{Column: "editor", Operator: "LIKE", Value: "e1"}}}, wantErr: true},
+		{name: "NotANumber", t: Template{Entity: "products", Columns: []string{"name"}, Filters: []Filter{{Column: "totalCost", Operator: FilterGT, Value: "ten"}}}, wantErr: true},
+		{name: "MissingRequiredFilter", t: Template{Entity: "compliance", Columns: []string{"sku"}, Filters: []Filter{{Column: "editor", Operator: FilterNE, Value: "e1"}}}, wantErr: true},
+		{name: "DynamicColumns", t: Template{Entity: "equipments", Columns: []string{"type", "cpu"}, Filters: []Filter{{Column: "type", Operator: FilterEQ, Value: "server"}}}},
+		{name: "SUCCESS", t: Template{Entity: "products", Columns: []string{"editor", "totalCost"}, Filters: []Filter{{Column: "totalCost", Operator: FilterGT, Value: "10.5"}}, GroupBy: "editor"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.t.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Template.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTemplate_rows(t *testing.T) {
+	records := []record{
+		{"name": "p1", "editor": "e1", "category": "db", "totalCost": 10.0},
+		{"name": "p2", "editor": "e2", "category": "os", "totalCost": 5.0},
+		{"name": "p3", "editor": "e1", "category": "dev", "totalCost": 2.5},
+		{"name": "p4", "editor": "e1", "category": "db", "totalCost": 1.0},
+	}
+	tests := []struct {
+		name string
+		t    Template
+		want string
+	}{
+		{
+			name: "Filters",
+			t: Template{Entity: "products", Columns: []string{"name", "totalCost"}, Filters: []Filter{
+				{Column: "editor", Operator: FilterEQ, Value: "e1"},
+				{Column: "totalCost", Operator: FilterGT, Value: "1"},
+			}},
+			want: `[{"name":"p1","totalCost":10},{"name":"p3","totalCost":2.5}]`,
+		},
+		{
+			name: "Contains",
+			t:    Template{Entity: "products", Columns: []string{"name"}, Filters: []Filter{{Column: "category", Operator: FilterContains, Value: "D"}}},
+			want: `[{"name":"p1"},{"name":"p3"},{"name":"p4"}]`,
+		},
+		{
+			name: "GroupBy",
+			t:    Template{Entity: "products", Columns: []string{"editor", "category", "totalCost"}, Filters: []Filter{{Column: "category", Operator: FilterNE, Value: "dev"}}, GroupBy: "editor"},
+			want: `[{"editor":"e1","category":"db","totalCost":11,"count":2},{"editor":"e2","category":"os","totalCost":5,"count":1}]`,
+		},
+		{
+			name: "NoRecord",
+			t:    Template{Entity: "products", Columns: []string{"name"}, Filters: []Filter{{Column: "totalCost", Operator: FilterLT, Value: "0"}}},
+			want: `[]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := formatRows(tt.t.rows(records))
+			if err != nil {
+				t.Fatalf("formatRows() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Template.rows() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemplatePlugin_Fetch(t *testing.T) {
+	ctx := context.Background()
+	t.Run("Products", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		product := mockpv1.NewMockProductServiceClient(mockCtrl)
+		product.EXPECT().ListProducts(ctx, &pv1.ListProductsRequest{PageNum: 1, PageSize: templatePageSize, SortBy: "name", SortOrder: pv1.SortOrder_asc, Scopes: []string{"OFR"}}).
+			Return(&pv1.ListProductsResponse{TotalRecords: 3, Products: []*pv1.Product{
+				{Name: "p1", Editor: "e1", TotalCost: 10, NumofEquipments: 2},
+				{Name: "p2", Editor: "e2", TotalCost: 5, NumofEquipments: 1},
+				{Name: "p3", Editor: "e1", TotalCost: 2.5, NumofEquipments: 4},
+			}}, nil).Times(1)
+		definition, _ := json.Marshal(Template{Entity: "products", Columns: []string{"editor", "totalCost", "numOfEquipments"}, GroupBy: "editor"})
+		rows, err := templatePlugin{}.Fetch(ctx, &Sources{Product: product}, Envelope{Type: TemplateReport, Scope: "OFR", JSON: definition, ReportID: 1})
+		if err != nil {
+			t.Fatalf("templatePlugin.Fetch() error = %v", err)
+		}
+		got, _ := templatePlugin{}.Format(rows)
+		if want := `[{"editor":"e1","totalCost":12.5,"numOfEquipments":6,"count":2},{"editor":"e2","totalCost":5,"numOfEquipments":1,"count":1}]`; string(got) != want {
+			t.Errorf("templatePlugin.Fetch() = %s, want %s", got, want)
+		}
+	})
+	t.Run("TooManyProducts", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		product := mockpv1.NewMockProductServiceClient(mockCtrl)
+		page := make([]*pv1.Product, templatePageSize)
+		for i := range page {
+			page[i] = &pv1.Product{Name: "p", Editor: "e1"}
+		}
+		product.EXPECT().ListProducts(ctx, gomock.Any()).Return(&pv1.ListProductsResponse{TotalRecords: templatePageSize*templateMaxPages + 1, Products: page}, nil).Times(templateMaxPages)
+		definition, _ := json.Marshal(Template{Entity: "products", Columns: []string{"editor"}})
+		_, err := templatePlugin{}.Fetch(ctx, &Sources{Product: product}, Envelope{Type: TemplateReport, Scope: "OFR", JSON: definition, ReportID: 1})
+		if err == nil || !strings.Contains(err.Error(), "more than") {
+			t.Errorf("templatePlugin.Fetch() error = %v, want the records past the last page reported", err)
+		}
+	})
+	t.Run("Equipments", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		equipment := mockev1.NewMockEquipmentServiceClient(mockCtrl)
+		equipment.EXPECT().EquipmentsTypes(ctx, &ev1.EquipmentTypesRequest{Scopes: []string{"OFR"}}).Return(&ev1.EquipmentTypesResponse{EquipmentTypes: []*ev1.EquipmentType{
+			{ID: "0x1", Type: "cluster"},
+			{ID: "0x2", Type: "server", Attributes: []*ev1.Attribute{
+				{Name: "cpu", Displayed: true},
+				{Name: "server_code", Displayed: true, PrimaryKey: true},
+			}},
+		}}, nil).Times(1)
+		equipment.EXPECT().ListEquipments(ctx, &ev1.ListEquipmentsRequest{TypeId: "0x2", PageNum: 1, PageSize: templatePageSize, SortBy: "server_code", SortOrder: ev1.SortOrder_ASC, Scopes: []string{"OFR"}}).
+			Return(&ev1.ListEquipmentsResponse{TotalRecords: 2, Equipments: []byte(`[{"server_code":"s1","cpu":4},{"server_code":"s2","cpu":8}]`)}, nil).Times(1)
+		definition, _ := json.Marshal(Template{Entity: "equipments", Columns: []string{"type", "server_code", "cpu"}, Filters: []Filter{
+			{Column: "type", Operator: FilterEQ, Value: "server"},
+			{Column: "cpu", Operator: FilterGT, Value: "4"},
+		}})
+		rows, err := templatePlugin{}.Fetch(ctx, &Sources{Equipment: equipment}, Envelope{Type: TemplateReport, Scope: "OFR", JSON: definition, ReportID: 1})
+		if err != nil {
+			t.Fatalf("templatePlugin.Fetch() error = %v", err)
+		}
+		got, _ := templatePlugin{}.Format(rows)
+		if want := `[{"type":"server","server_code":"s2","cpu":8}]`; string(got) != want {
+			t.Errorf("templatePlugin.Fetch() = %s, want %s", got, want)
+		}
+	})
+	t.Run("UnknownEquipmentType", func(t *testing.T) {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		equipment := mockev1.NewMockEquipmentServiceClient(mockCtrl)
+		equipment.EXPECT().EquipmentsTypes(ctx, gomock.Any()).Return(&ev1.EquipmentTypesResponse{}, nil).Times(1)
+		definition, _ := json.Marshal(Template{Entity: "equipments", Columns: []string{"type"}, Filters: []Filter{{Column: "type", Operator: FilterEQ, Value: "server"}}})
+		if _, err := (templatePlugin{}).Fetch(ctx, &Sources{Equipment: equipment}, Envelope{Type: TemplateReport, Scope: "OFR", JSON: definition}); err == nil {
+			t.Errorf("templatePlugin.Fetch() error = nil, want an error")
+		}
+	})
+}
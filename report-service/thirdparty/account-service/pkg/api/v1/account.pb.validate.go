@@ -0,0 +1,265 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: account.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/ptypes"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = ptypes.DynamicAny{}
+)
+
+// define the regex for a UUID once up-front
+var _account_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on ListScopeUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListScopeUsersRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_ListScopeUsersRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return ListScopeUsersRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	return nil
+}
+
+// ListScopeUsersRequestValidationError is the validation error returned by
+// ListScopeUsersRequest.Validate if the designated constraints aren't met.
+type ListScopeUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScopeUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScopeUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScopeUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScopeUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScopeUsersRequestValidationError) ErrorName() string {
+	return "ListScopeUsersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScopeUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScopeUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScopeUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScopeUsersRequestValidationError{}
+
+var _ListScopeUsersRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on ListScopeUsersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListScopeUsersResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListScopeUsersResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListScopeUsersResponseValidationError is the validation error returned by
+// ListScopeUsersResponse.Validate if the designated constraints aren't met.
+type ListScopeUsersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScopeUsersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScopeUsersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScopeUsersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScopeUsersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScopeUsersResponseValidationError) ErrorName() string {
+	return "ListScopeUsersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScopeUsersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScopeUsersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScopeUsersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScopeUsersResponseValidationError{}
+
+// Validate checks the field values on ScopeUser with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *ScopeUser) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	return nil
+}
+
+// ScopeUserValidationError is the validation error returned by
+// ScopeUser.Validate if the designated constraints aren't met.
+type ScopeUserValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScopeUserValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScopeUserValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScopeUserValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScopeUserValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScopeUserValidationError) ErrorName() string { return "ScopeUserValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScopeUserValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScopeUser.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScopeUserValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScopeUserValidationError{}
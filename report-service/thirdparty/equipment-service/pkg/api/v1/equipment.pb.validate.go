@@ -0,0 +1,821 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: equipment.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/ptypes"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = ptypes.DynamicAny{}
+)
+
+// define the regex for a UUID once up-front
+var _equipment_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on ListEquipmentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListEquipmentsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for TypeId
+
+	if m.GetPageNum() < 1 {
+		return ListEquipmentsRequestValidationError{
+			field:  "PageNum",
+			reason: "value must be greater than or equal to 1",
+		}
+	}
+
+	if val := m.GetPageSize(); val < 10 || val > 200 {
+		return ListEquipmentsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [10, 200]",
+		}
+	}
+
+	// no validation rules for SortBy
+
+	// no validation rules for SortOrder
+
+	// no validation rules for SearchParams
+
+	if v, ok := interface{}(m.GetFilter()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ListEquipmentsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetScopes()) != 1 {
+		return ListEquipmentsRequestValidationError{
+			field:  "Scopes",
+			reason: "value must contain exactly 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetScopes() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) != 3 {
+			return ListEquipmentsRequestValidationError{
+				field:  fmt.Sprintf("Scopes[%v]", idx),
+				reason: "value length must be 3 runes",
+			}
+
+		}
+
+	}
+
+	return nil
+}
+
+// ListEquipmentsRequestValidationError is the validation error returned by
+// ListEquipmentsRequest.Validate if the designated constraints aren't met.
+type ListEquipmentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEquipmentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEquipmentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEquipmentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEquipmentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEquipmentsRequestValidationError) ErrorName() string {
+	return "ListEquipmentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEquipmentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEquipmentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEquipmentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEquipmentsRequestValidationError{}
+
+// Validate checks the field values on EquipFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *EquipFilter) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetApplicationId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return EquipFilterValidationError{
+				field:  "ApplicationId",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetProductId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return EquipFilterValidationError{
+				field:  "ProductId",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetInstanceId()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return EquipFilterValidationError{
+				field:  "InstanceId",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// EquipFilterValidationError is the validation error returned by
+// EquipFilter.Validate if the designated constraints aren't met.
+type EquipFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EquipFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EquipFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EquipFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EquipFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EquipFilterValidationError) ErrorName() string { return "EquipFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EquipFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEquipFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EquipFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EquipFilterValidationError{}
+
+// Validate checks the field values on ListEquipmentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListEquipmentsResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for TotalRecords
+
+	// no validation rules for Equipments
+
+	return nil
+}
+
+// ListEquipmentsResponseValidationError is the validation error returned by
+// ListEquipmentsResponse.Validate if the designated constraints aren't met.
+type ListEquipmentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEquipmentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEquipmentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEquipmentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEquipmentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEquipmentsResponseValidationError) ErrorName() string {
+	return "ListEquipmentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEquipmentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEquipmentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEquipmentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEquipmentsResponseValidationError{}
+
+// Validate checks the field values on EquipmentTypesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EquipmentTypesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetScopes()) != 1 {
+		return EquipmentTypesRequestValidationError{
+			field:  "Scopes",
+			reason: "value must contain exactly 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetScopes() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) != 3 {
+			return EquipmentTypesRequestValidationError{
+				field:  fmt.Sprintf("Scopes[%v]", idx),
+				reason: "value length must be 3 runes",
+			}
+
+		}
+
+	}
+
+	return nil
+}
+
+// EquipmentTypesRequestValidationError is the validation error returned by
+// EquipmentTypesRequest.Validate if the designated constraints aren't met.
+type EquipmentTypesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EquipmentTypesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EquipmentTypesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EquipmentTypesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EquipmentTypesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EquipmentTypesRequestValidationError) ErrorName() string {
+	return "EquipmentTypesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EquipmentTypesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEquipmentTypesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EquipmentTypesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EquipmentTypesRequestValidationError{}
+
+// Validate checks the field values on EquipmentTypesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EquipmentTypesResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetEquipmentTypes() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return EquipmentTypesResponseValidationError{
+					field:  fmt.Sprintf("EquipmentTypes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// EquipmentTypesResponseValidationError is the validation error returned by
+// EquipmentTypesResponse.Validate if the designated constraints aren't met.
+type EquipmentTypesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EquipmentTypesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EquipmentTypesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EquipmentTypesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EquipmentTypesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EquipmentTypesResponseValidationError) ErrorName() string {
+	return "EquipmentTypesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EquipmentTypesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEquipmentTypesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EquipmentTypesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EquipmentTypesResponseValidationError{}
+
+// Validate checks the field values on EquipmentType with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *EquipmentType) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ID
+
+	if !_EquipmentType_Type_Pattern.MatchString(m.GetType()) {
+		return EquipmentTypeValidationError{
+			field:  "Type",
+			reason: "value does not match regex pattern \"^[-_A-Za-z0-9]+$\"",
+		}
+	}
+
+	// no validation rules for ParentId
+
+	// no validation rules for ParentType
+
+	// no validation rules for MetadataId
+
+	// no validation rules for MetadataSource
+
+	for idx, item := range m.GetAttributes() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return EquipmentTypeValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetScopes()) != 1 {
+		return EquipmentTypeValidationError{
+			field:  "Scopes",
+			reason: "value must contain exactly 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetScopes() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) != 3 {
+			return EquipmentTypeValidationError{
+				field:  fmt.Sprintf("Scopes[%v]", idx),
+				reason: "value length must be 3 runes",
+			}
+
+		}
+
+	}
+
+	return nil
+}
+
+// EquipmentTypeValidationError is the validation error returned by
+// EquipmentType.Validate if the designated constraints aren't met.
+type EquipmentTypeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EquipmentTypeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EquipmentTypeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EquipmentTypeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EquipmentTypeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EquipmentTypeValidationError) ErrorName() string { return "EquipmentTypeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EquipmentTypeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEquipmentType.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EquipmentTypeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EquipmentTypeValidationError{}
+
+var _EquipmentType_Type_Pattern = regexp.MustCompile("^[-_A-Za-z0-9]+$")
+
+// Validate checks the field values on Attribute with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Attribute) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ID
+
+	if !_Attribute_Name_Pattern.MatchString(m.GetName()) {
+		return AttributeValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[-_A-Za-z0-9]+$\"",
+		}
+	}
+
+	if _, ok := _Attribute_DataType_InLookup[m.GetDataType()]; !ok {
+		return AttributeValidationError{
+			field:  "DataType",
+			reason: "value must be in list [1 2 3]",
+		}
+	}
+
+	// no validation rules for PrimaryKey
+
+	// no validation rules for Displayed
+
+	// no validation rules for Searchable
+
+	// no validation rules for ParentIdentifier
+
+	// no validation rules for MappedTo
+
+	// no validation rules for Simulated
+
+	// no validation rules for SchemaName
+
+	switch m.Val.(type) {
+
+	case *Attribute_IntVal:
+		// no validation rules for IntVal
+
+	case *Attribute_FloatVal:
+		// no validation rules for FloatVal
+
+	case *Attribute_StringVal:
+		// no validation rules for StringVal
+
+	}
+
+	switch m.OldVal.(type) {
+
+	case *Attribute_IntValOld:
+		// no validation rules for IntValOld
+
+	case *Attribute_FloatValOld:
+		// no validation rules for FloatValOld
+
+	case *Attribute_StringValOld:
+		// no validation rules for StringValOld
+
+	}
+
+	return nil
+}
+
+// AttributeValidationError is the validation error returned by
+// Attribute.Validate if the designated constraints aren't met.
+type AttributeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttributeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeValidationError) ErrorName() string { return "AttributeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttributeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttribute.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValidationError{}
+
+var _Attribute_Name_Pattern = regexp.MustCompile("^[-_A-Za-z0-9]+$")
+
+var _Attribute_DataType_InLookup = map[DataTypes]struct{}{
+	1: {},
+	2: {},
+	3: {},
+}
+
+// Validate checks the field values on StringFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *StringFilter) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for FilteringOrder
+
+	// no validation rules for Filteringkey
+
+	// no validation rules for FilterType
+
+	return nil
+}
+
+// StringFilterValidationError is the validation error returned by
+// StringFilter.Validate if the designated constraints aren't met.
+type StringFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StringFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StringFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StringFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StringFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StringFilterValidationError) ErrorName() string { return "StringFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StringFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStringFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StringFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StringFilterValidationError{}
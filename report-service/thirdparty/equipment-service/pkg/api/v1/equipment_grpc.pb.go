@@ -0,0 +1,131 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// EquipmentServiceClient is the client API for EquipmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EquipmentServiceClient interface {
+	EquipmentsTypes(ctx context.Context, in *EquipmentTypesRequest, opts ...grpc.CallOption) (*EquipmentTypesResponse, error)
+	ListEquipments(ctx context.Context, in *ListEquipmentsRequest, opts ...grpc.CallOption) (*ListEquipmentsResponse, error)
+}
+
+type equipmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEquipmentServiceClient(cc grpc.ClientConnInterface) EquipmentServiceClient {
+	return &equipmentServiceClient{cc}
+}
+
+func (c *equipmentServiceClient) EquipmentsTypes(ctx context.Context, in *EquipmentTypesRequest, opts ...grpc.CallOption) (*EquipmentTypesResponse, error) {
+	out := new(EquipmentTypesResponse)
+	err := c.cc.Invoke(ctx, "/optisam.equipment.v1.EquipmentService/EquipmentsTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *equipmentServiceClient) ListEquipments(ctx context.Context, in *ListEquipmentsRequest, opts ...grpc.CallOption) (*ListEquipmentsResponse, error) {
+	out := new(ListEquipmentsResponse)
+	err := c.cc.Invoke(ctx, "/optisam.equipment.v1.EquipmentService/ListEquipments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EquipmentServiceServer is the server API for EquipmentService service.
+// All implementations should embed UnimplementedEquipmentServiceServer
+// for forward compatibility
+type EquipmentServiceServer interface {
+	EquipmentsTypes(context.Context, *EquipmentTypesRequest) (*EquipmentTypesResponse, error)
+	ListEquipments(context.Context, *ListEquipmentsRequest) (*ListEquipmentsResponse, error)
+}
+
+// UnimplementedEquipmentServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedEquipmentServiceServer struct {
+}
+
+func (UnimplementedEquipmentServiceServer) EquipmentsTypes(context.Context, *EquipmentTypesRequest) (*EquipmentTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EquipmentsTypes not implemented")
+}
+func (UnimplementedEquipmentServiceServer) ListEquipments(context.Context, *ListEquipmentsRequest) (*ListEquipmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEquipments not implemented")
+}
+
+// UnsafeEquipmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EquipmentServiceServer will
+// result in compilation errors.
+type UnsafeEquipmentServiceServer interface {
+	mustEmbedUnimplementedEquipmentServiceServer()
+}
+
+func RegisterEquipmentServiceServer(s grpc.ServiceRegistrar, srv EquipmentServiceServer) {
+	s.RegisterService(&_EquipmentService_serviceDesc, srv)
+}
+
+func _EquipmentService_EquipmentsTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EquipmentTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EquipmentServiceServer).EquipmentsTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.equipment.v1.EquipmentService/EquipmentsTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EquipmentServiceServer).EquipmentsTypes(ctx, req.(*EquipmentTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EquipmentService_ListEquipments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEquipmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EquipmentServiceServer).ListEquipments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.equipment.v1.EquipmentService/ListEquipments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EquipmentServiceServer).ListEquipments(ctx, req.(*ListEquipmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _EquipmentService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "optisam.equipment.v1.EquipmentService",
+	HandlerType: (*EquipmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EquipmentsTypes",
+			Handler:    _EquipmentService_EquipmentsTypes_Handler,
+		},
+		{
+			MethodName: "ListEquipments",
+			Handler:    _EquipmentService_ListEquipments_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "equipment.proto",
+}
@@ -0,0 +1,333 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: notification.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/ptypes"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = ptypes.DynamicAny{}
+)
+
+// define the regex for a UUID once up-front
+var _notification_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on SendMailRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *SendMailRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for MailSubject
+
+	// no validation rules for MailMessage
+
+	// no validation rules for NoOfRetiries
+
+	for idx, item := range m.GetAttachments() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return SendMailRequestValidationError{
+					field:  fmt.Sprintf("Attachments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// SendMailRequestValidationError is the validation error returned by
+// SendMailRequest.Validate if the designated constraints aren't met.
+type SendMailRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendMailRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendMailRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendMailRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendMailRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendMailRequestValidationError) ErrorName() string { return "SendMailRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SendMailRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendMailRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendMailRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendMailRequestValidationError{}
+
+// Validate checks the field values on Attachment with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Attachment) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for ContentType
+
+	// no validation rules for Content
+
+	return nil
+}
+
+// AttachmentValidationError is the validation error returned by
+// Attachment.Validate if the designated constraints aren't met.
+type AttachmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttachmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttachmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttachmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttachmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttachmentValidationError) ErrorName() string { return "AttachmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttachmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttachment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttachmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttachmentValidationError{}
+
+// Validate checks the field values on SendMailResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *SendMailResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Success
+
+	return nil
+}
+
+// SendMailResponseValidationError is the validation error returned by
+// SendMailResponse.Validate if the designated constraints aren't met.
+type SendMailResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendMailResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendMailResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendMailResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendMailResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendMailResponseValidationError) ErrorName() string { return "SendMailResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SendMailResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendMailResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendMailResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendMailResponseValidationError{}
+
+// Validate checks the field values on DeadLetterQueue with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *DeadLetterQueue) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for TopicName
+
+	// no validation rules for Message
+
+	// no validation rules for Error
+
+	return nil
+}
+
+// DeadLetterQueueValidationError is the validation error returned by
+// DeadLetterQueue.Validate if the designated constraints aren't met.
+type DeadLetterQueueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeadLetterQueueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeadLetterQueueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeadLetterQueueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeadLetterQueueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeadLetterQueueValidationError) ErrorName() string { return "DeadLetterQueueValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeadLetterQueueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeadLetterQueue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeadLetterQueueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeadLetterQueueValidationError{}
@@ -16,4 +16,6 @@ allow {
 roles := {"Admin":{"SuperAdmin","Admin"},"Normal":{"User"}}
 
 user_apis := {"/optisam.simulation.v1.SimulationService/GetConfigData","/optisam.simulation.v1.SimulationService/SimulationByMetric",
-"/optisam.simulation.v1.SimulationService/SimulationByHardware","/optisam.simulation.v1.SimulationService/ListConfig"}
\ No newline at end of file
+"/optisam.simulation.v1.SimulationService/SimulationByHardware","/optisam.simulation.v1.SimulationService/ListConfig",
+"/optisam.simulation.v1.SimulationService/CreateScenario","/optisam.simulation.v1.SimulationService/UpdateScenario","/optisam.simulation.v1.SimulationService/ListScenarios",
+"/optisam.simulation.v1.SimulationService/DeleteScenario","/optisam.simulation.v1.SimulationService/EvaluateScenario"}
\ No newline at end of file
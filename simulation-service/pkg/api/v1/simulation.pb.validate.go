@@ -2439,3 +2439,1312 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = UpdateConfigResponseValidationError{}
+
+// Validate checks the field values on Scenario with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Scenario) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Scope
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ScenarioValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for CreatedBy
+
+	if v, ok := interface{}(m.GetCreatedOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ScenarioValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UpdatedBy
+
+	if v, ok := interface{}(m.GetUpdatedOn()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ScenarioValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ScenarioValidationError is the validation error returned by
+// Scenario.Validate if the designated constraints aren't met.
+type ScenarioValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScenarioValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScenarioValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScenarioValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScenarioValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScenarioValidationError) ErrorName() string { return "ScenarioValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScenarioValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScenario.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScenarioValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScenarioValidationError{}
+
+// Validate checks the field values on ScenarioChange with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ScenarioChange) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if _, ok := _ScenarioChange_Type_InLookup[m.GetType()]; !ok {
+		return ScenarioChangeValidationError{
+			field:  "Type",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+	}
+
+	// no validation rules for EquipType
+
+	// no validation rules for EquipId
+
+	for idx, item := range m.GetAttributes() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ScenarioChangeValidationError{
+					field:  fmt.Sprintf("Attributes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for SwidTag
+
+	// no validation rules for AggregationName
+
+	// no validation rules for Editor
+
+	// no validation rules for TargetEquipId
+
+	// no validation rules for MetricName
+
+	// no validation rules for UnitCost
+
+	return nil
+}
+
+// ScenarioChangeValidationError is the validation error returned by
+// ScenarioChange.Validate if the designated constraints aren't met.
+type ScenarioChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScenarioChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScenarioChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScenarioChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScenarioChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScenarioChangeValidationError) ErrorName() string { return "ScenarioChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScenarioChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScenarioChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScenarioChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScenarioChangeValidationError{}
+
+var _ScenarioChange_Type_InLookup = map[ScenarioChange_Type]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+// Validate checks the field values on CreateScenarioRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CreateScenarioRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		return CreateScenarioRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Description
+
+	if len(m.GetChanges()) < 1 {
+		return CreateScenarioRequestValidationError{
+			field:  "Changes",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return CreateScenarioRequestValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if !_CreateScenarioRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return CreateScenarioRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	return nil
+}
+
+// CreateScenarioRequestValidationError is the validation error returned by
+// CreateScenarioRequest.Validate if the designated constraints aren't met.
+type CreateScenarioRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateScenarioRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateScenarioRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateScenarioRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateScenarioRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateScenarioRequestValidationError) ErrorName() string {
+	return "CreateScenarioRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateScenarioRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateScenarioRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateScenarioRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateScenarioRequestValidationError{}
+
+var _CreateScenarioRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on UpdateScenarioRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateScenarioRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		return UpdateScenarioRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Description
+
+	if len(m.GetChanges()) < 1 {
+		return UpdateScenarioRequestValidationError{
+			field:  "Changes",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateScenarioRequestValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if !_UpdateScenarioRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return UpdateScenarioRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	return nil
+}
+
+// UpdateScenarioRequestValidationError is the validation error returned by
+// UpdateScenarioRequest.Validate if the designated constraints aren't met.
+type UpdateScenarioRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateScenarioRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateScenarioRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateScenarioRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateScenarioRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateScenarioRequestValidationError) ErrorName() string {
+	return "UpdateScenarioRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateScenarioRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateScenarioRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateScenarioRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateScenarioRequestValidationError{}
+
+var _UpdateScenarioRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on ListScenariosRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListScenariosRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if !_ListScenariosRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return ListScenariosRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	return nil
+}
+
+// ListScenariosRequestValidationError is the validation error returned by
+// ListScenariosRequest.Validate if the designated constraints aren't met.
+type ListScenariosRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScenariosRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScenariosRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScenariosRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScenariosRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScenariosRequestValidationError) ErrorName() string {
+	return "ListScenariosRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScenariosRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScenariosRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScenariosRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScenariosRequestValidationError{}
+
+var _ListScenariosRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on ListScenariosResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListScenariosResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetScenarios() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return ListScenariosResponseValidationError{
+					field:  fmt.Sprintf("Scenarios[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ListScenariosResponseValidationError is the validation error returned by
+// ListScenariosResponse.Validate if the designated constraints aren't met.
+type ListScenariosResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScenariosResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScenariosResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScenariosResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScenariosResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScenariosResponseValidationError) ErrorName() string {
+	return "ListScenariosResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScenariosResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScenariosResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScenariosResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScenariosResponseValidationError{}
+
+// Validate checks the field values on DeleteScenarioRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteScenarioRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	if !_DeleteScenarioRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return DeleteScenarioRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	return nil
+}
+
+// DeleteScenarioRequestValidationError is the validation error returned by
+// DeleteScenarioRequest.Validate if the designated constraints aren't met.
+type DeleteScenarioRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteScenarioRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteScenarioRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteScenarioRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteScenarioRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteScenarioRequestValidationError) ErrorName() string {
+	return "DeleteScenarioRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteScenarioRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteScenarioRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteScenarioRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteScenarioRequestValidationError{}
+
+var _DeleteScenarioRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on DeleteScenarioResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteScenarioResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Success
+
+	return nil
+}
+
+// DeleteScenarioResponseValidationError is the validation error returned by
+// DeleteScenarioResponse.Validate if the designated constraints aren't met.
+type DeleteScenarioResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteScenarioResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteScenarioResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteScenarioResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteScenarioResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteScenarioResponseValidationError) ErrorName() string {
+	return "DeleteScenarioResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteScenarioResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteScenarioResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteScenarioResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteScenarioResponseValidationError{}
+
+// Validate checks the field values on EvaluateScenarioRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EvaluateScenarioRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	if !_EvaluateScenarioRequest_Scope_Pattern.MatchString(m.GetScope()) {
+		return EvaluateScenarioRequestValidationError{
+			field:  "Scope",
+			reason: "value does not match regex pattern \"\\\\b[A-Z]{3}\\\\b\"",
+		}
+	}
+
+	return nil
+}
+
+// EvaluateScenarioRequestValidationError is the validation error returned by
+// EvaluateScenarioRequest.Validate if the designated constraints aren't met.
+type EvaluateScenarioRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EvaluateScenarioRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EvaluateScenarioRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EvaluateScenarioRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EvaluateScenarioRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EvaluateScenarioRequestValidationError) ErrorName() string {
+	return "EvaluateScenarioRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EvaluateScenarioRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvaluateScenarioRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EvaluateScenarioRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EvaluateScenarioRequestValidationError{}
+
+var _EvaluateScenarioRequest_Scope_Pattern = regexp.MustCompile("\\b[A-Z]{3}\\b")
+
+// Validate checks the field values on EvaluateScenarioResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EvaluateScenarioResponse) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetScenario()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return EvaluateScenarioResponseValidationError{
+				field:  "Scenario",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetEditors() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return EvaluateScenarioResponseValidationError{
+					field:  fmt.Sprintf("Editors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetProducts() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return EvaluateScenarioResponseValidationError{
+					field:  fmt.Sprintf("Products[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetFailures() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface {
+			Validate() error
+		}); ok {
+			if err := v.Validate(); err != nil {
+				return EvaluateScenarioResponseValidationError{
+					field:  fmt.Sprintf("Failures[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// EvaluateScenarioResponseValidationError is the validation error returned by
+// EvaluateScenarioResponse.Validate if the designated constraints aren't met.
+type EvaluateScenarioResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EvaluateScenarioResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EvaluateScenarioResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EvaluateScenarioResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EvaluateScenarioResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EvaluateScenarioResponseValidationError) ErrorName() string {
+	return "EvaluateScenarioResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EvaluateScenarioResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvaluateScenarioResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EvaluateScenarioResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EvaluateScenarioResponseValidationError{}
+
+// Validate checks the field values on EditorProjection with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *EditorProjection) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Editor
+
+	if v, ok := interface{}(m.GetBaseline()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return EditorProjectionValidationError{
+				field:  "Baseline",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetProjected()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return EditorProjectionValidationError{
+				field:  "Projected",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// EditorProjectionValidationError is the validation error returned by
+// EditorProjection.Validate if the designated constraints aren't met.
+type EditorProjectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EditorProjectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EditorProjectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EditorProjectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EditorProjectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EditorProjectionValidationError) ErrorName() string { return "EditorProjectionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EditorProjectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEditorProjection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EditorProjectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EditorProjectionValidationError{}
+
+// Validate checks the field values on ProductProjection with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ProductProjection) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Editor
+
+	// no validation rules for Sku
+
+	// no validation rules for SwidTags
+
+	// no validation rules for AggregationName
+
+	// no validation rules for ProductNames
+
+	// no validation rules for Metrics
+
+	if v, ok := interface{}(m.GetBaseline()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ProductProjectionValidationError{
+				field:  "Baseline",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetProjected()).(interface {
+		Validate() error
+	}); ok {
+		if err := v.Validate(); err != nil {
+			return ProductProjectionValidationError{
+				field:  "Projected",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ProductProjectionValidationError is the validation error returned by
+// ProductProjection.Validate if the designated constraints aren't met.
+type ProductProjectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProductProjectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProductProjectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProductProjectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProductProjectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProductProjectionValidationError) ErrorName() string {
+	return "ProductProjectionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProductProjectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProductProjection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProductProjectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProductProjectionValidationError{}
+
+// Validate checks the field values on CompliancePosition with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CompliancePosition) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for NumComputedLicences
+
+	// no validation rules for NumAcquiredLicences
+
+	// no validation rules for DeltaNumber
+
+	// no validation rules for PurchaseCost
+
+	// no validation rules for ComputedCost
+
+	// no validation rules for DeltaCost
+
+	return nil
+}
+
+// CompliancePositionValidationError is the validation error returned by
+// CompliancePosition.Validate if the designated constraints aren't met.
+type CompliancePositionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompliancePositionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompliancePositionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompliancePositionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompliancePositionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompliancePositionValidationError) ErrorName() string {
+	return "CompliancePositionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompliancePositionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompliancePosition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompliancePositionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompliancePositionValidationError{}
+
+// Validate checks the field values on ScenarioChangeFailure with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ScenarioChangeFailure) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ChangeIndex
+
+	// no validation rules for Reason
+
+	return nil
+}
+
+// ScenarioChangeFailureValidationError is the validation error returned by
+// ScenarioChangeFailure.Validate if the designated constraints aren't met.
+type ScenarioChangeFailureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScenarioChangeFailureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScenarioChangeFailureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScenarioChangeFailureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScenarioChangeFailureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScenarioChangeFailureValidationError) ErrorName() string {
+	return "ScenarioChangeFailureValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScenarioChangeFailureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScenarioChangeFailure.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScenarioChangeFailureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScenarioChangeFailureValidationError{}
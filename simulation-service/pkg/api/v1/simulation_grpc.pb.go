@@ -25,6 +25,12 @@ type SimulationServiceClient interface {
 	SimulationByCost(ctx context.Context, in *SimulationByCostRequest, opts ...grpc.CallOption) (*SimulationByCostResponse, error)
 	SimulationByMetric(ctx context.Context, in *SimulationByMetricRequest, opts ...grpc.CallOption) (*SimulationByMetricResponse, error)
 	SimulationByHardware(ctx context.Context, in *SimulationByHardwareRequest, opts ...grpc.CallOption) (*SimulationByHardwareResponse, error)
+	CreateScenario(ctx context.Context, in *CreateScenarioRequest, opts ...grpc.CallOption) (*Scenario, error)
+	UpdateScenario(ctx context.Context, in *UpdateScenarioRequest, opts ...grpc.CallOption) (*Scenario, error)
+	ListScenarios(ctx context.Context, in *ListScenariosRequest, opts ...grpc.CallOption) (*ListScenariosResponse, error)
+	DeleteScenario(ctx context.Context, in *DeleteScenarioRequest, opts ...grpc.CallOption) (*DeleteScenarioResponse, error)
+	// EvaluateScenario projects the compliance and the cost per editor once all the changes of the scenario are applied
+	EvaluateScenario(ctx context.Context, in *EvaluateScenarioRequest, opts ...grpc.CallOption) (*EvaluateScenarioResponse, error)
 }
 
 type simulationServiceClient struct {
@@ -107,6 +113,51 @@ func (c *simulationServiceClient) SimulationByHardware(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *simulationServiceClient) CreateScenario(ctx context.Context, in *CreateScenarioRequest, opts ...grpc.CallOption) (*Scenario, error) {
+	out := new(Scenario)
+	err := c.cc.Invoke(ctx, "/optisam.simulation.v1.SimulationService/CreateScenario", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simulationServiceClient) UpdateScenario(ctx context.Context, in *UpdateScenarioRequest, opts ...grpc.CallOption) (*Scenario, error) {
+	out := new(Scenario)
+	err := c.cc.Invoke(ctx, "/optisam.simulation.v1.SimulationService/UpdateScenario", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simulationServiceClient) ListScenarios(ctx context.Context, in *ListScenariosRequest, opts ...grpc.CallOption) (*ListScenariosResponse, error) {
+	out := new(ListScenariosResponse)
+	err := c.cc.Invoke(ctx, "/optisam.simulation.v1.SimulationService/ListScenarios", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simulationServiceClient) DeleteScenario(ctx context.Context, in *DeleteScenarioRequest, opts ...grpc.CallOption) (*DeleteScenarioResponse, error) {
+	out := new(DeleteScenarioResponse)
+	err := c.cc.Invoke(ctx, "/optisam.simulation.v1.SimulationService/DeleteScenario", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simulationServiceClient) EvaluateScenario(ctx context.Context, in *EvaluateScenarioRequest, opts ...grpc.CallOption) (*EvaluateScenarioResponse, error) {
+	out := new(EvaluateScenarioResponse)
+	err := c.cc.Invoke(ctx, "/optisam.simulation.v1.SimulationService/EvaluateScenario", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SimulationServiceServer is the server API for SimulationService service.
 // All implementations should embed UnimplementedSimulationServiceServer
 // for forward compatibility
@@ -119,6 +170,12 @@ type SimulationServiceServer interface {
 	SimulationByCost(context.Context, *SimulationByCostRequest) (*SimulationByCostResponse, error)
 	SimulationByMetric(context.Context, *SimulationByMetricRequest) (*SimulationByMetricResponse, error)
 	SimulationByHardware(context.Context, *SimulationByHardwareRequest) (*SimulationByHardwareResponse, error)
+	CreateScenario(context.Context, *CreateScenarioRequest) (*Scenario, error)
+	UpdateScenario(context.Context, *UpdateScenarioRequest) (*Scenario, error)
+	ListScenarios(context.Context, *ListScenariosRequest) (*ListScenariosResponse, error)
+	DeleteScenario(context.Context, *DeleteScenarioRequest) (*DeleteScenarioResponse, error)
+	// EvaluateScenario projects the compliance and the cost per editor once all the changes of the scenario are applied
+	EvaluateScenario(context.Context, *EvaluateScenarioRequest) (*EvaluateScenarioResponse, error)
 }
 
 // UnimplementedSimulationServiceServer should be embedded to have forward compatible implementations.
@@ -149,6 +206,21 @@ func (UnimplementedSimulationServiceServer) SimulationByMetric(context.Context,
 func (UnimplementedSimulationServiceServer) SimulationByHardware(context.Context, *SimulationByHardwareRequest) (*SimulationByHardwareResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SimulationByHardware not implemented")
 }
+func (UnimplementedSimulationServiceServer) CreateScenario(context.Context, *CreateScenarioRequest) (*Scenario, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScenario not implemented")
+}
+func (UnimplementedSimulationServiceServer) UpdateScenario(context.Context, *UpdateScenarioRequest) (*Scenario, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScenario not implemented")
+}
+func (UnimplementedSimulationServiceServer) ListScenarios(context.Context, *ListScenariosRequest) (*ListScenariosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScenarios not implemented")
+}
+func (UnimplementedSimulationServiceServer) DeleteScenario(context.Context, *DeleteScenarioRequest) (*DeleteScenarioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScenario not implemented")
+}
+func (UnimplementedSimulationServiceServer) EvaluateScenario(context.Context, *EvaluateScenarioRequest) (*EvaluateScenarioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateScenario not implemented")
+}
 
 // UnsafeSimulationServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to SimulationServiceServer will
@@ -305,6 +377,96 @@ func _SimulationService_SimulationByHardware_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SimulationService_CreateScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScenarioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulationServiceServer).CreateScenario(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.simulation.v1.SimulationService/CreateScenario",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulationServiceServer).CreateScenario(ctx, req.(*CreateScenarioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimulationService_UpdateScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScenarioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulationServiceServer).UpdateScenario(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.simulation.v1.SimulationService/UpdateScenario",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulationServiceServer).UpdateScenario(ctx, req.(*UpdateScenarioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimulationService_ListScenarios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScenariosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulationServiceServer).ListScenarios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.simulation.v1.SimulationService/ListScenarios",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulationServiceServer).ListScenarios(ctx, req.(*ListScenariosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimulationService_DeleteScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScenarioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulationServiceServer).DeleteScenario(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.simulation.v1.SimulationService/DeleteScenario",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulationServiceServer).DeleteScenario(ctx, req.(*DeleteScenarioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimulationService_EvaluateScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateScenarioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimulationServiceServer).EvaluateScenario(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/optisam.simulation.v1.SimulationService/EvaluateScenario",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimulationServiceServer).EvaluateScenario(ctx, req.(*EvaluateScenarioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SimulationService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "optisam.simulation.v1.SimulationService",
 	HandlerType: (*SimulationServiceServer)(nil),
@@ -341,6 +503,26 @@ var _SimulationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SimulationByHardware",
 			Handler:    _SimulationService_SimulationByHardware_Handler,
 		},
+		{
+			MethodName: "CreateScenario",
+			Handler:    _SimulationService_CreateScenario_Handler,
+		},
+		{
+			MethodName: "UpdateScenario",
+			Handler:    _SimulationService_UpdateScenario_Handler,
+		},
+		{
+			MethodName: "ListScenarios",
+			Handler:    _SimulationService_ListScenarios_Handler,
+		},
+		{
+			MethodName: "DeleteScenario",
+			Handler:    _SimulationService_DeleteScenario_Handler,
+		},
+		{
+			MethodName: "EvaluateScenario",
+			Handler:    _SimulationService_EvaluateScenario_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "simulation.proto",
@@ -7,6 +7,8 @@ import (
 	"net/http/pprof"
 	"optisam-backend/common/optisam/logger"
 	rest_middleware "optisam-backend/common/optisam/middleware/rest"
+	"optisam-backend/common/optisam/token/apikey"
+	"optisam-backend/common/optisam/token/revocation"
 	v1 "optisam-backend/simulation-service/pkg/api/v1"
 	"os"
 	"os/signal"
@@ -22,7 +24,7 @@ import (
 )
 
 // RunServer runs HTTP/REST gateway
-func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.PublicKey) error {
+func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.PublicKey, revoked revocation.List, keys apikey.Store) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -39,16 +41,8 @@ func RunServer(ctx context.Context, grpcPort, httpPort string, verifyKey *rsa.Pu
 		Addr: ":" + httpPort,
 		// Handler: &ochttp.Handler{
 		Handler: &ochttp.Handler{Handler: rest_middleware.AddCORS([]string{"*"},
-			// rest_middleware.AddLogger(logger.Log,
-			// 	rest_middleware.ValidateAuth(verifyKey,
-			// 		rest_middleware.ValidateAuthZ(p, mux_http),
-			// rest_middleware.ValidateAuth(verifyKey,
-			// 	rest_middleware.AddLogger(logger.Log, mux_http),
-			muxHTTP),
-		// )))
-
+			rest_middleware.ValidateAuthWithAPIKeys(verifyKey, revoked, keys, muxHTTP)),
 		},
-		// },
 	}
 
 	// graceful shutdown
@@ -77,6 +77,20 @@ func (mr *MockRepositoryMockRecorder) DeleteConfigData(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfigData", reflect.TypeOf((*MockRepository)(nil).DeleteConfigData), arg0, arg1)
 }
 
+// DeleteScenario mocks base method
+func (m *MockRepository) DeleteScenario(arg0 context.Context, arg1 db.DeleteScenarioParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScenario", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteScenario indicates an expected call of DeleteScenario
+func (mr *MockRepositoryMockRecorder) DeleteScenario(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScenario", reflect.TypeOf((*MockRepository)(nil).DeleteScenario), arg0, arg1)
+}
+
 // GetConfig mocks base method
 func (m *MockRepository) GetConfig(arg0 context.Context, arg1 db.GetConfigParams) (db.ConfigMaster, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +136,36 @@ func (mr *MockRepositoryMockRecorder) GetMetadatabyConfigID(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadatabyConfigID", reflect.TypeOf((*MockRepository)(nil).GetMetadatabyConfigID), arg0, arg1)
 }
 
+// GetScenario mocks base method
+func (m *MockRepository) GetScenario(arg0 context.Context, arg1 db.GetScenarioParams) (db.Scenario, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScenario", arg0, arg1)
+	ret0, _ := ret[0].(db.Scenario)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScenario indicates an expected call of GetScenario
+func (mr *MockRepositoryMockRecorder) GetScenario(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScenario", reflect.TypeOf((*MockRepository)(nil).GetScenario), arg0, arg1)
+}
+
+// InsertScenario mocks base method
+func (m *MockRepository) InsertScenario(arg0 context.Context, arg1 db.InsertScenarioParams) (db.Scenario, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertScenario", arg0, arg1)
+	ret0, _ := ret[0].(db.Scenario)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertScenario indicates an expected call of InsertScenario
+func (mr *MockRepositoryMockRecorder) InsertScenario(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertScenario", reflect.TypeOf((*MockRepository)(nil).InsertScenario), arg0, arg1)
+}
+
 // ListConfig mocks base method
 func (m *MockRepository) ListConfig(arg0 context.Context, arg1 db.ListConfigParams) ([]db.ListConfigRow, error) {
 	m.ctrl.T.Helper()
@@ -137,6 +181,21 @@ func (mr *MockRepositoryMockRecorder) ListConfig(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConfig", reflect.TypeOf((*MockRepository)(nil).ListConfig), arg0, arg1)
 }
 
+// ListScenarios mocks base method
+func (m *MockRepository) ListScenarios(arg0 context.Context, arg1 string) ([]db.Scenario, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScenarios", arg0, arg1)
+	ret0, _ := ret[0].([]db.Scenario)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScenarios indicates an expected call of ListScenarios
+func (mr *MockRepositoryMockRecorder) ListScenarios(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScenarios", reflect.TypeOf((*MockRepository)(nil).ListScenarios), arg0, arg1)
+}
+
 // UpdateConfig mocks base method
 func (m *MockRepository) UpdateConfig(arg0 context.Context, arg1 int32, arg2, arg3 string, arg4 []int32, arg5 []*v1.ConfigData, arg6 string) error {
 	m.ctrl.T.Helper()
@@ -150,3 +209,18 @@ func (mr *MockRepositoryMockRecorder) UpdateConfig(arg0, arg1, arg2, arg3, arg4,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConfig", reflect.TypeOf((*MockRepository)(nil).UpdateConfig), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 }
+
+// UpdateScenario mocks base method
+func (m *MockRepository) UpdateScenario(arg0 context.Context, arg1 db.UpdateScenarioParams) (db.Scenario, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScenario", arg0, arg1)
+	ret0, _ := ret[0].(db.Scenario)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScenario indicates an expected call of UpdateScenario
+func (mr *MockRepositoryMockRecorder) UpdateScenario(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScenario", reflect.TypeOf((*MockRepository)(nil).UpdateScenario), arg0, arg1)
+}
@@ -34,6 +34,18 @@ type ConfigMetadatum struct {
 	ConfigFilename string `json:"config_filename"`
 }
 
+type Scenario struct {
+	ID          int32           `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Scope       string          `json:"scope"`
+	Changes     json.RawMessage `json:"changes"`
+	CreatedBy   string          `json:"created_by"`
+	CreatedOn   time.Time       `json:"created_on"`
+	UpdatedBy   string          `json:"updated_by"`
+	UpdatedOn   time.Time       `json:"updated_on"`
+}
+
 type Status struct {
 	ID   int32          `json:"id"`
 	Text sql.NullString `json:"text"`
@@ -9,10 +9,15 @@ import (
 type Querier interface {
 	DeleteConfig(ctx context.Context, arg DeleteConfigParams) error
 	DeleteConfigData(ctx context.Context, configID int32) error
+	DeleteScenario(ctx context.Context, arg DeleteScenarioParams) error
 	GetConfig(ctx context.Context, arg GetConfigParams) (ConfigMaster, error)
 	GetDataByMetadataID(ctx context.Context, metadataID int32) ([]GetDataByMetadataIDRow, error)
 	GetMetadatabyConfigID(ctx context.Context, configID int32) ([]GetMetadatabyConfigIDRow, error)
+	GetScenario(ctx context.Context, arg GetScenarioParams) (Scenario, error)
+	InsertScenario(ctx context.Context, arg InsertScenarioParams) (Scenario, error)
 	ListConfig(ctx context.Context, arg ListConfigParams) ([]ListConfigRow, error)
+	ListScenarios(ctx context.Context, scope string) ([]Scenario, error)
+	UpdateScenario(ctx context.Context, arg UpdateScenarioParams) (Scenario, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -33,6 +33,20 @@ func (q *Queries) DeleteConfigData(ctx context.Context, configID int32) error {
 	return err
 }
 
+const deleteScenario = `-- name: DeleteScenario :exec
+DELETE FROM scenarios WHERE id = $1 AND scope = $2
+`
+
+type DeleteScenarioParams struct {
+	ID    int32  `json:"id"`
+	Scope string `json:"scope"`
+}
+
+func (q *Queries) DeleteScenario(ctx context.Context, arg DeleteScenarioParams) error {
+	_, err := q.db.ExecContext(ctx, deleteScenario, arg.ID, arg.Scope)
+	return err
+}
+
 const getConfig = `-- name: GetConfig :one
 SELECT id,name,equipment_type,status,created_by,created_on,updated_by,updated_on,scope from config_master where id = $1 AND status = $2 AND scope = $3
 `
@@ -131,6 +145,69 @@ func (q *Queries) GetMetadatabyConfigID(ctx context.Context, configID int32) ([]
 	return items, nil
 }
 
+const getScenario = `-- name: GetScenario :one
+SELECT id, name, description, scope, changes, created_by, created_on, updated_by, updated_on FROM scenarios WHERE id = $1 AND scope = $2
+`
+
+type GetScenarioParams struct {
+	ID    int32  `json:"id"`
+	Scope string `json:"scope"`
+}
+
+func (q *Queries) GetScenario(ctx context.Context, arg GetScenarioParams) (Scenario, error) {
+	row := q.db.QueryRowContext(ctx, getScenario, arg.ID, arg.Scope)
+	var i Scenario
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Scope,
+		&i.Changes,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.UpdatedBy,
+		&i.UpdatedOn,
+	)
+	return i, err
+}
+
+const insertScenario = `-- name: InsertScenario :one
+INSERT INTO scenarios (name, description, scope, changes, created_by, updated_by)
+VALUES ($1, $2, $3, $4, $5, $5)
+RETURNING id, name, description, scope, changes, created_by, created_on, updated_by, updated_on
+`
+
+type InsertScenarioParams struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Scope       string          `json:"scope"`
+	Changes     json.RawMessage `json:"changes"`
+	CreatedBy   string          `json:"created_by"`
+}
+
+func (q *Queries) InsertScenario(ctx context.Context, arg InsertScenarioParams) (Scenario, error) {
+	row := q.db.QueryRowContext(ctx, insertScenario,
+		arg.Name,
+		arg.Description,
+		arg.Scope,
+		arg.Changes,
+		arg.CreatedBy,
+	)
+	var i Scenario
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Scope,
+		&i.Changes,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.UpdatedBy,
+		&i.UpdatedOn,
+	)
+	return i, err
+}
+
 const listConfig = `-- name: ListConfig :many
 SELECT id,name,equipment_type,status,created_by,created_on,updated_by,updated_on from config_master 
 WHERE (CASE WHEN $1::bool THEN equipment_type = $2 ELSE TRUE END) AND
@@ -191,3 +268,79 @@ func (q *Queries) ListConfig(ctx context.Context, arg ListConfigParams) ([]ListC
 	}
 	return items, nil
 }
+
+const listScenarios = `-- name: ListScenarios :many
+SELECT id, name, description, scope, changes, created_by, created_on, updated_by, updated_on FROM scenarios WHERE scope = $1 ORDER BY updated_on DESC
+`
+
+func (q *Queries) ListScenarios(ctx context.Context, scope string) ([]Scenario, error) {
+	rows, err := q.db.QueryContext(ctx, listScenarios, scope)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Scenario
+	for rows.Next() {
+		var i Scenario
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Scope,
+			&i.Changes,
+			&i.CreatedBy,
+			&i.CreatedOn,
+			&i.UpdatedBy,
+			&i.UpdatedOn,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateScenario = `-- name: UpdateScenario :one
+UPDATE scenarios SET name = $1, description = $2, changes = $3, updated_by = $4, updated_on = now()
+WHERE id = $5 AND scope = $6
+RETURNING id, name, description, scope, changes, created_by, created_on, updated_by, updated_on
+`
+
+type UpdateScenarioParams struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Changes     json.RawMessage `json:"changes"`
+	UpdatedBy   string          `json:"updated_by"`
+	ID          int32           `json:"id"`
+	Scope       string          `json:"scope"`
+}
+
+func (q *Queries) UpdateScenario(ctx context.Context, arg UpdateScenarioParams) (Scenario, error) {
+	row := q.db.QueryRowContext(ctx, updateScenario,
+		arg.Name,
+		arg.Description,
+		arg.Changes,
+		arg.UpdatedBy,
+		arg.ID,
+		arg.Scope,
+	)
+	var i Scenario
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Scope,
+		&i.Changes,
+		&i.CreatedBy,
+		&i.CreatedOn,
+		&i.UpdatedBy,
+		&i.UpdatedOn,
+	)
+	return i, err
+}
@@ -16,4 +16,23 @@ SELECT attribute_value, json_data from config_data where metadata_id=$1;
 UPDATE config_master SET status = @status where id = @id AND scope = @scope;
 
 -- name: DeleteConfigData :exec
-DELETE FROM config_metadata WHERE config_id=$1; 
\ No newline at end of file
+DELETE FROM config_metadata WHERE config_id=$1; 
+
+-- name: InsertScenario :one
+INSERT INTO scenarios (name, description, scope, changes, created_by, updated_by)
+VALUES (@name, @description, @scope, @changes, @created_by, @created_by)
+RETURNING *;
+
+-- name: UpdateScenario :one
+UPDATE scenarios SET name = @name, description = @description, changes = @changes, updated_by = @updated_by, updated_on = now()
+WHERE id = @id AND scope = @scope
+RETURNING *;
+
+-- name: GetScenario :one
+SELECT * FROM scenarios WHERE id = @id AND scope = @scope;
+
+-- name: ListScenarios :many
+SELECT * FROM scenarios WHERE scope = @scope ORDER BY updated_on DESC;
+
+-- name: DeleteScenario :exec
+DELETE FROM scenarios WHERE id = @id AND scope = @scope;
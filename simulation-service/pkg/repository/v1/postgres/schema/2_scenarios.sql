@@ -0,0 +1,19 @@
+-- +migrate Up
+-- SQL in section 'Up' is executed when this migration is applied
+
+CREATE TABLE IF NOT EXISTS scenarios (
+  id SERIAL PRIMARY KEY NOT NULL,
+  name VARCHAR NOT NULL,
+  description VARCHAR NOT NULL DEFAULT '',
+  scope VARCHAR NOT NULL,
+  changes jsonb NOT NULL,
+  created_by VARCHAR NOT NULL,
+  created_on TIMESTAMP NOT NULL DEFAULT now(),
+  updated_by VARCHAR NOT NULL,
+  updated_on TIMESTAMP NOT NULL DEFAULT now(),
+  UNIQUE (name, scope)
+);
+
+-- +migrate Down
+-- SQL section 'Down' is executed when this migration is rolled back
+DROP TABLE scenarios;
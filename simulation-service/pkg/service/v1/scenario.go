@@ -0,0 +1,668 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"optisam-backend/common/optisam/helper"
+	"optisam-backend/common/optisam/logger"
+	grpc_middleware "optisam-backend/common/optisam/middleware/grpc"
+	l_v1 "optisam-backend/license-service/pkg/api/v1"
+	metv1 "optisam-backend/metric-service/pkg/api/v1"
+	v1 "optisam-backend/simulation-service/pkg/api/v1"
+	"optisam-backend/simulation-service/pkg/repository/v1/postgres/db"
+	"sort"
+	"strings"
+
+	pTypes "github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// CreateScenario saves a named set of changes to evaluate later
+func (hcs *SimulationService) CreateScenario(ctx context.Context, req *v1.CreateScenarioRequest) (*v1.Scenario, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "cannot find claims in context")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		return nil, status.Error(codes.PermissionDenied, "Do not have access to the scope")
+	}
+	if err := validateScenarioChanges(req.Changes); err != nil {
+		return nil, err
+	}
+	scenarios, err := hcs.repo.ListScenarios(ctx, req.Scope)
+	if err != nil {
+		logger.Log.Error("service/v1 - Scenario - CreateScenario - Repo - ListScenarios", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Internal Error")
+	}
+	if scenarioByName(scenarios, req.Name) != -1 {
+		return nil, status.Error(codes.AlreadyExists, "Scenario with same name already exists")
+	}
+	changes, err := marshalScenarioChanges(req.Changes)
+	if err != nil {
+		logger.Log.Error("service/v1 - Scenario - CreateScenario - marshalScenarioChanges", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Internal Error")
+	}
+	scenario, err := hcs.repo.InsertScenario(ctx, db.InsertScenarioParams{
+		Name:        req.Name,
+		Description: req.Description,
+		Scope:       req.Scope,
+		Changes:     changes,
+		CreatedBy:   userClaims.UserID,
+	})
+	if err != nil {
+		logger.Log.Error("service/v1 - Scenario - CreateScenario - Repo - InsertScenario", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Internal Error")
+	}
+	return repoToServScenario(scenario)
+}
+
+// UpdateScenario replaces the name, the description and the changes of a scenario
+func (hcs *SimulationService) UpdateScenario(ctx context.Context, req *v1.UpdateScenarioRequest) (*v1.Scenario, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "cannot find claims in context")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		return nil, status.Error(codes.PermissionDenied, "Do not have access to the scope")
+	}
+	if err := validateScenarioChanges(req.Changes); err != nil {
+		return nil, err
+	}
+	scenarios, err := hcs.repo.ListScenarios(ctx, req.Scope)
+	if err != nil {
+		logger.Log.Error("service/v1 - Scenario - UpdateScenario - Repo - ListScenarios", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Internal Error")
+	}
+	if index := scenarioByName(scenarios, req.Name); index != -1 && scenarios[index].ID != req.Id {
+		return nil, status.Error(codes.AlreadyExists, "Scenario with same name already exists")
+	}
+	changes, err := marshalScenarioChanges(req.Changes)
+	if err != nil {
+		logger.Log.Error("service/v1 - Scenario - UpdateScenario - marshalScenarioChanges", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Internal Error")
+	}
+	scenario, err := hcs.repo.UpdateScenario(ctx, db.UpdateScenarioParams{
+		Name:        req.Name,
+		Description: req.Description,
+		Changes:     changes,
+		UpdatedBy:   userClaims.UserID,
+		ID:          req.Id,
+		Scope:       req.Scope,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "scenario does not exist")
+		}
+		logger.Log.Error("service/v1 - Scenario - UpdateScenario - Repo - UpdateScenario", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Internal Error")
+	}
+	return repoToServScenario(scenario)
+}
+
+// ListScenarios lists the scenarios of the scope, last updated first
+func (hcs *SimulationService) ListScenarios(ctx context.Context, req *v1.ListScenariosRequest) (*v1.ListScenariosResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "cannot find claims in context")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		return nil, status.Error(codes.PermissionDenied, "Do not have access to the scope")
+	}
+	scenarios, err := hcs.repo.ListScenarios(ctx, req.Scope)
+	if err != nil {
+		logger.Log.Error("service/v1 - Scenario - ListScenarios - Repo - ListScenarios", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Internal Error")
+	}
+	resp := &v1.ListScenariosResponse{
+		Scenarios: make([]*v1.Scenario, 0, len(scenarios)),
+	}
+	for _, scenario := range scenarios {
+		servScenario, err := repoToServScenario(scenario)
+		if err != nil {
+			return nil, err
+		}
+		resp.Scenarios = append(resp.Scenarios, servScenario)
+	}
+	return resp, nil
+}
+
+// DeleteScenario deletes a scenario of the scope
+func (hcs *SimulationService) DeleteScenario(ctx context.Context, req *v1.DeleteScenarioRequest) (*v1.DeleteScenarioResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "cannot find claims in context")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		return nil, status.Error(codes.PermissionDenied, "Do not have access to the scope")
+	}
+	if err := hcs.repo.DeleteScenario(ctx, db.DeleteScenarioParams{
+		ID:    req.Id,
+		Scope: req.Scope,
+	}); err != nil {
+		logger.Log.Error("service/v1 - Scenario - DeleteScenario - Repo - DeleteScenario", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Internal Error")
+	}
+	return &v1.DeleteScenarioResponse{Success: true}, nil
+}
+
+// EvaluateScenario evaluates every change of the scenario against the current state with the
+// computations of the license service, sums their effects on the licences of the products and
+// projects the resulting compliance and cost per editor next to the current ones.
+func (hcs *SimulationService) EvaluateScenario(ctx context.Context, req *v1.EvaluateScenarioRequest) (*v1.EvaluateScenarioResponse, error) {
+	userClaims, ok := grpc_middleware.RetrieveClaims(ctx)
+	if !ok {
+		return nil, status.Error(codes.Internal, "cannot find claims in context")
+	}
+	if !helper.Contains(userClaims.Socpes, req.Scope) {
+		return nil, status.Error(codes.PermissionDenied, "Do not have access to the scope")
+	}
+	dbScenario, err := hcs.repo.GetScenario(ctx, db.GetScenarioParams{
+		ID:    req.Id,
+		Scope: req.Scope,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "scenario does not exist")
+		}
+		logger.Log.Error("service/v1 - Scenario - EvaluateScenario - Repo - GetScenario", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Internal Error")
+	}
+	scenario, err := repoToServScenario(dbScenario)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.EvaluateScenarioResponse{
+		Scenario: scenario,
+	}
+	var switches []*metricSwitch
+	for i, change := range scenario.Changes {
+		if change.Type != v1.ScenarioChange_SWITCH_METRIC {
+			continue
+		}
+		sw, err := hcs.switchMetric(ctx, change, req.Scope)
+		if err != nil {
+			resp.Failures = append(resp.Failures, &v1.ScenarioChangeFailure{ChangeIndex: int32(i), Reason: err.Error()})
+			continue
+		}
+		switches = append(switches, sw)
+	}
+	var deltas []*licenceDelta
+	if states := equipmentStates(scenario.Changes); len(states) > 0 {
+		metResp, err := hcs.metricClient.ListMetrices(ctx, &metv1.ListMetricRequest{
+			Scopes: []string{req.Scope},
+		})
+		if err != nil {
+			logger.Log.Error("service/v1 - Scenario - EvaluateScenario - MetricService - ListMetrices", zap.Error(err))
+			return nil, status.Error(codes.Internal, "unable to fetch metrics")
+		}
+		var failures []*v1.ScenarioChangeFailure
+		deltas, failures = hcs.equipmentDeltas(ctx, states, metResp.Metrices, req.Scope)
+		resp.Failures = append(resp.Failures, failures...)
+		sort.SliceStable(resp.Failures, func(i, j int) bool {
+			return resp.Failures[i].ChangeIndex < resp.Failures[j].ChangeIndex
+		})
+	}
+	for _, editor := range scenarioEditors(deltas, switches) {
+		compResp, err := hcs.licenseClient.GetOverAllCompliance(ctx, &l_v1.GetOverAllComplianceRequest{
+			Editor: editor,
+			Scope:  req.Scope,
+		})
+		if err != nil {
+			logger.Log.Error("service/v1 - Scenario - EvaluateScenario - l_v1 - GetOverAllCompliance", zap.Error(err), zap.String("editor", editor))
+			return nil, status.Error(codes.Internal, "unable to fetch compliance")
+		}
+		editorProj, productProjs := projectEditorCompliance(editor, compResp.AcqRights, deltas, switches)
+		resp.Editors = append(resp.Editors, editorProj)
+		resp.Products = append(resp.Products, productProjs...)
+	}
+	return resp, nil
+}
+
+// licenceDelta is the change of the computed licences of a product for a metric
+type licenceDelta struct {
+	editor          string
+	swidTag         string
+	aggregationName string
+	metric          string
+	delta           int64
+}
+
+// metricSwitch is the computed licences of a product licensed with another metric
+type metricSwitch struct {
+	editor          string
+	swidTag         string
+	aggregationName string
+	metric          string
+	unitCost        float64
+	numCptLicences  int32
+}
+
+// equipmentState is an equipment with all the changes of the scenario applied, the changes of
+// an equipment are evaluated together so that their effects are not counted twice.
+type equipmentState struct {
+	equipType string
+	equipID   string
+	// added is true for the equipments added by the scenario
+	added bool
+	// attributes are the attributes changed by the scenario, the last change of an attribute wins
+	attributes []*v1.EquipAttribute
+	retired    bool
+	// movedOut are the products moved from the equipment to other equipments
+	movedOut []string
+	// movedIn are the products moved to the equipment from other equipments
+	movedIn []*movedProduct
+	// changes are the indexes of the changes of the equipment in the scenario
+	changes []int
+}
+
+// movedProduct is a product moved from an equipment
+type movedProduct struct {
+	swidTag string
+	from    *equipmentState
+}
+
+func (e *equipmentState) setAttributes(attrs []*v1.EquipAttribute) {
+	for _, attr := range attrs {
+		replaced := false
+		for i, cur := range e.attributes {
+			if cur.Name != attr.Name {
+				continue
+			}
+			// the old value stays the one of the current state
+			merged := proto.Clone(attr).(*v1.EquipAttribute)
+			merged.OldVal = cur.OldVal
+			e.attributes[i] = merged
+			replaced = true
+			break
+		}
+		if !replaced {
+			e.attributes = append(e.attributes, attr)
+		}
+	}
+}
+
+// equipmentStates applies the equipment changes of the scenario, in order, to the equipments they change.
+func equipmentStates(changes []*v1.ScenarioChange) []*equipmentState {
+	var states []*equipmentState
+	byID := make(map[string]*equipmentState)
+	state := func(equipType, equipID string, i int) *equipmentState {
+		e, ok := byID[equipID]
+		if !ok {
+			e = &equipmentState{equipType: equipType, equipID: equipID}
+			byID[equipID] = e
+			states = append(states, e)
+		}
+		if len(e.changes) == 0 || e.changes[len(e.changes)-1] != i {
+			e.changes = append(e.changes, i)
+		}
+		return e
+	}
+	for i, change := range changes {
+		switch change.Type {
+		case v1.ScenarioChange_ADD_EQUIPMENT:
+			// every added equipment is a new equipment like equip_id
+			states = append(states, &equipmentState{
+				equipType:  change.EquipType,
+				equipID:    change.EquipId,
+				added:      true,
+				attributes: change.Attributes,
+				changes:    []int{i},
+			})
+		case v1.ScenarioChange_RETIRE_EQUIPMENT:
+			state(change.EquipType, change.EquipId, i).retired = true
+		case v1.ScenarioChange_CHANGE_EQUIPMENT:
+			state(change.EquipType, change.EquipId, i).setAttributes(change.Attributes)
+		case v1.ScenarioChange_MOVE_PRODUCT:
+			from := state(change.EquipType, change.EquipId, i)
+			from.movedOut = append(from.movedOut, change.SwidTag)
+			to := state(change.EquipType, change.TargetEquipId, i)
+			to.setAttributes(change.Attributes)
+			to.movedIn = append(to.movedIn, &movedProduct{swidTag: change.SwidTag, from: from})
+		}
+	}
+	return states
+}
+
+// equipmentDeltas simulates every equipment state for every metric of the scope, the metrics for which
+// the simulation fails are reported as failures of the changes of the equipment.
+func (hcs *SimulationService) equipmentDeltas(ctx context.Context, states []*equipmentState, metrics []*metv1.Metric, scope string) ([]*licenceDelta, []*v1.ScenarioChangeFailure) {
+	var failures []*v1.ScenarioChangeFailure
+	fail := func(e *equipmentState, reason string) {
+		for _, i := range e.changes {
+			failures = append(failures, &v1.ScenarioChangeFailure{ChangeIndex: int32(i), Reason: reason})
+		}
+	}
+	// licences of the equipments in their new state, by metric
+	licences := make(map[*equipmentState]map[string][]*l_v1.ProductLicenseForEquipAndMetric, len(states))
+	for _, e := range states {
+		licences[e] = make(map[string][]*l_v1.ProductLicenseForEquipAndMetric, len(metrics))
+		for _, met := range metrics {
+			resp, err := hcs.licenseClient.LicensesForEquipAndMetric(ctx, &l_v1.LicensesForEquipAndMetricRequest{
+				EquipType:  e.equipType,
+				EquipId:    e.equipID,
+				MetricType: met.Type,
+				MetricName: met.Name,
+				Attributes: simulationToLicenseAttributesAll(e.attributes),
+				Scope:      scope,
+			})
+			if err != nil {
+				logger.Log.Error("service/v1 - Scenario - EvaluateScenario - l_v1 - LicensesForEquipAndMetric", zap.Error(err), zap.String("metric", met.Name), zap.String("equipment", e.equipID))
+				fail(e, met.Name+": "+status.Convert(err).Message())
+				continue
+			}
+			licences[e][met.Name] = resp.Licenses
+		}
+	}
+	var deltas []*licenceDelta
+	add := func(editor, swidTag, aggregationName, metric string, delta int64) {
+		if delta != 0 {
+			deltas = append(deltas, &licenceDelta{
+				editor:          editor,
+				swidTag:         swidTag,
+				aggregationName: aggregationName,
+				metric:          metric,
+				delta:           delta,
+			})
+		}
+	}
+	for _, e := range states {
+		for _, met := range metrics {
+			lics, ok := licences[e][met.Name]
+			if !ok {
+				continue
+			}
+			for _, lic := range lics {
+				switch {
+				case e.added:
+					add(lic.Editor, lic.SwidTag, lic.AggregationName, met.Name, lic.NewLicenses)
+				case e.retired || helper.Contains(e.movedOut, lic.SwidTag):
+					add(lic.Editor, lic.SwidTag, lic.AggregationName, met.Name, -lic.OldLicences)
+				default:
+					add(lic.Editor, lic.SwidTag, lic.AggregationName, met.Name, lic.NewLicenses-lic.OldLicences)
+				}
+			}
+			if e.retired {
+				continue
+			}
+			for _, mp := range e.movedIn {
+				// only the metrics licensing the product on its equipment license it on the target
+				moved := productLicense(licences[mp.from][met.Name], mp.swidTag)
+				if moved == nil || productLicense(lics, mp.swidTag) != nil {
+					continue
+				}
+				if len(lics) == 0 {
+					fail(e, met.Name+": cannot compute the licences of "+e.equipID+" as it hosts no product")
+					continue
+				}
+				// the licences of the target are the same for all its products
+				add(moved.Editor, moved.SwidTag, moved.AggregationName, met.Name, lics[0].NewLicenses)
+			}
+		}
+	}
+	return deltas, failures
+}
+
+func productLicense(lics []*l_v1.ProductLicenseForEquipAndMetric, swidTag string) *l_v1.ProductLicenseForEquipAndMetric {
+	for _, lic := range lics {
+		if lic.SwidTag == swidTag {
+			return lic
+		}
+	}
+	return nil
+}
+
+func (hcs *SimulationService) switchMetric(ctx context.Context, change *v1.ScenarioChange, scope string) (*metricSwitch, error) {
+	resp, err := hcs.licenseClient.ProductLicensesForMetric(ctx, &l_v1.ProductLicensesForMetricRequest{
+		SwidTag:         change.SwidTag,
+		AggregationName: change.AggregationName,
+		MetricName:      change.MetricName,
+		UnitCost:        change.UnitCost,
+		Scope:           scope,
+	})
+	if err != nil {
+		logger.Log.Error("service/v1 - Scenario - EvaluateScenario - l_v1 - ProductLicensesForMetric", zap.Error(err), zap.String("metric", change.MetricName))
+		return nil, errors.New(change.MetricName + ": " + status.Convert(err).Message())
+	}
+	return &metricSwitch{
+		editor:          change.Editor,
+		swidTag:         change.SwidTag,
+		aggregationName: change.AggregationName,
+		metric:          change.MetricName,
+		unitCost:        change.UnitCost,
+		numCptLicences:  int32(resp.NumCptLicences),
+	}, nil
+}
+
+// productProjection is a licence of the compliance of an editor with the changes applied
+type productProjection struct {
+	right        *l_v1.AggregationAcquiredRights
+	metrics      string
+	computed     int32
+	computedCost float64
+	touched      bool
+}
+
+func (p *productProjection) matches(swidTag, aggregationName string) bool {
+	if aggregationName != "" {
+		return p.right.AggregationName == aggregationName
+	}
+	return p.right.AggregationName == "" && helper.Contains(strings.Split(p.right.SwidTags, ","), swidTag)
+}
+
+// projectEditorCompliance applies the metric switches and then the licence deltas of the editor
+// to its compliance, a delta is applied to the first licence of the product for the metric.
+func projectEditorCompliance(editor string, rights []*l_v1.AggregationAcquiredRights, deltas []*licenceDelta, switches []*metricSwitch) (*v1.EditorProjection, []*v1.ProductProjection) {
+	projections := make([]*productProjection, 0, len(rights))
+	for _, right := range rights {
+		projections = append(projections, &productProjection{
+			right:        right,
+			metrics:      right.Metric,
+			computed:     right.NumCptLicences,
+			computedCost: right.ComputedCost,
+		})
+	}
+	for _, sw := range switches {
+		if sw.editor != editor {
+			continue
+		}
+		switched := false
+		for _, p := range projections {
+			if !p.matches(sw.swidTag, sw.aggregationName) {
+				continue
+			}
+			p.touched = true
+			p.computed, p.computedCost = 0, 0
+			if !switched {
+				switched = true
+				p.metrics = sw.metric
+				p.computed = sw.numCptLicences
+				p.computedCost = helper.ToFixed(float64(sw.numCptLicences)*sw.unitCost, 2)
+			}
+		}
+	}
+	for _, d := range deltas {
+		if d.editor != editor {
+			continue
+		}
+		var proj *productProjection
+		for _, p := range projections {
+			if p.matches(d.swidTag, d.aggregationName) && helper.Contains(strings.Split(p.metrics, ","), d.metric) {
+				proj = p
+				break
+			}
+		}
+		if proj == nil {
+			if d.delta < 0 {
+				continue
+			}
+			// licences of a product without acquired rights for the metric
+			proj = &productProjection{
+				right: &l_v1.AggregationAcquiredRights{
+					SwidTags:        d.swidTag,
+					AggregationName: d.aggregationName,
+					Metric:          d.metric,
+				},
+				metrics: d.metric,
+			}
+			projections = append(projections, proj)
+		}
+		computed := int64(proj.computed) + d.delta
+		if computed < 0 {
+			computed = 0
+		}
+		proj.computedCost = helper.ToFixed(proj.computedCost+float64(computed-int64(proj.computed))*proj.right.AvgUnitPrice, 2)
+		proj.computed = int32(computed)
+		proj.touched = true
+	}
+	editorProj := &v1.EditorProjection{
+		Editor:    editor,
+		Baseline:  &v1.CompliancePosition{},
+		Projected: &v1.CompliancePosition{},
+	}
+	var productProjs []*v1.ProductProjection
+	for _, p := range projections {
+		baseline := &v1.CompliancePosition{
+			NumComputedLicences: p.right.NumCptLicences,
+			NumAcquiredLicences: p.right.NumAcqLicences,
+			DeltaNumber:         p.right.DeltaNumber,
+			PurchaseCost:        p.right.PurchaseCost,
+			ComputedCost:        p.right.ComputedCost,
+			DeltaCost:           p.right.DeltaCost,
+		}
+		projected := baseline
+		if p.touched {
+			projected = &v1.CompliancePosition{
+				NumComputedLicences: p.computed,
+				NumAcquiredLicences: p.right.NumAcqLicences,
+				DeltaNumber:         p.right.NumAcqLicences - p.computed,
+				PurchaseCost:        p.right.PurchaseCost,
+				ComputedCost:        p.computedCost,
+				DeltaCost:           helper.ToFixed(p.right.PurchaseCost-p.computedCost, 2),
+			}
+			productProjs = append(productProjs, &v1.ProductProjection{
+				Editor:          editor,
+				Sku:             p.right.SKU,
+				SwidTags:        p.right.SwidTags,
+				AggregationName: p.right.AggregationName,
+				ProductNames:    p.right.ProductNames,
+				Metrics:         p.metrics,
+				Baseline:        baseline,
+				Projected:       projected,
+			})
+		}
+		addCompliancePosition(editorProj.Baseline, baseline)
+		addCompliancePosition(editorProj.Projected, projected)
+	}
+	return editorProj, productProjs
+}
+
+func addCompliancePosition(total, pos *v1.CompliancePosition) {
+	total.NumComputedLicences += pos.NumComputedLicences
+	total.NumAcquiredLicences += pos.NumAcquiredLicences
+	total.DeltaNumber += pos.DeltaNumber
+	total.PurchaseCost = helper.ToFixed(total.PurchaseCost+pos.PurchaseCost, 2)
+	total.ComputedCost = helper.ToFixed(total.ComputedCost+pos.ComputedCost, 2)
+	total.DeltaCost = helper.ToFixed(total.DeltaCost+pos.DeltaCost, 2)
+}
+
+func scenarioEditors(deltas []*licenceDelta, switches []*metricSwitch) []string {
+	var editors []string
+	for _, d := range deltas {
+		if !helper.Contains(editors, d.editor) {
+			editors = append(editors, d.editor)
+		}
+	}
+	for _, sw := range switches {
+		if !helper.Contains(editors, sw.editor) {
+			editors = append(editors, sw.editor)
+		}
+	}
+	sort.Strings(editors)
+	return editors
+}
+
+func validateScenarioChanges(changes []*v1.ScenarioChange) error {
+	for i, change := range changes {
+		switch change.Type {
+		case v1.ScenarioChange_ADD_EQUIPMENT, v1.ScenarioChange_RETIRE_EQUIPMENT, v1.ScenarioChange_CHANGE_EQUIPMENT:
+			if change.EquipType == "" || change.EquipId == "" {
+				return status.Errorf(codes.InvalidArgument, "change %d: equipment type and id are required", i)
+			}
+		case v1.ScenarioChange_MOVE_PRODUCT:
+			if change.EquipType == "" || change.EquipId == "" || change.TargetEquipId == "" || change.SwidTag == "" {
+				return status.Errorf(codes.InvalidArgument, "change %d: equipment type, source and target equipments and swidtag are required", i)
+			}
+		case v1.ScenarioChange_SWITCH_METRIC:
+			if change.Editor == "" || change.MetricName == "" || (change.SwidTag == "") == (change.AggregationName == "") {
+				return status.Errorf(codes.InvalidArgument, "change %d: editor, metric and either swidtag or aggregation are required", i)
+			}
+		default:
+			return status.Errorf(codes.InvalidArgument, "change %d: unknown type", i)
+		}
+	}
+	return nil
+}
+
+func scenarioByName(scenarios []db.Scenario, name string) int {
+	for i := range scenarios {
+		if scenarios[i].Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func marshalScenarioChanges(changes []*v1.ScenarioChange) (json.RawMessage, error) {
+	raw := make([]json.RawMessage, 0, len(changes))
+	for _, change := range changes {
+		data, err := protojson.Marshal(change)
+		if err != nil {
+			return nil, err
+		}
+		raw = append(raw, data)
+	}
+	return json.Marshal(raw)
+}
+
+func unmarshalScenarioChanges(data json.RawMessage) ([]*v1.ScenarioChange, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	changes := make([]*v1.ScenarioChange, 0, len(raw))
+	for _, r := range raw {
+		change := &v1.ScenarioChange{}
+		if err := protojson.Unmarshal(r, change); err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+func repoToServScenario(scenario db.Scenario) (*v1.Scenario, error) {
+	changes, err := unmarshalScenarioChanges(scenario.Changes)
+	if err != nil {
+		logger.Log.Error("service/v1 - Scenario - unmarshalScenarioChanges", zap.Error(err), zap.Int32("scenario", scenario.ID))
+		return nil, status.Error(codes.Internal, "Internal Error")
+	}
+	createdOn, _ := pTypes.TimestampProto(scenario.CreatedOn)
+	updatedOn, _ := pTypes.TimestampProto(scenario.UpdatedOn)
+	return &v1.Scenario{
+		Id:          scenario.ID,
+		Name:        scenario.Name,
+		Description: scenario.Description,
+		Scope:       scenario.Scope,
+		Changes:     changes,
+		CreatedBy:   scenario.CreatedBy,
+		CreatedOn:   createdOn,
+		UpdatedBy:   scenario.UpdatedBy,
+		UpdatedOn:   updatedOn,
+	}, nil
+}
@@ -0,0 +1,492 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	grpc_middleware "optisam-backend/common/optisam/middleware/grpc"
+	"optisam-backend/common/optisam/token/claims"
+	ls "optisam-backend/license-service/pkg/api/v1"
+	mockls "optisam-backend/license-service/pkg/api/v1/mock"
+	metv1 "optisam-backend/metric-service/pkg/api/v1"
+	mockMet "optisam-backend/metric-service/pkg/api/v1/mock"
+	v1 "optisam-backend/simulation-service/pkg/api/v1"
+	repo "optisam-backend/simulation-service/pkg/repository/v1"
+	"optisam-backend/simulation-service/pkg/repository/v1/mock"
+	"optisam-backend/simulation-service/pkg/repository/v1/postgres/db"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSimulationService_CreateScenario(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"Scope1", "Scope2", "Scope3"},
+	})
+	changes := []*v1.ScenarioChange{
+		{
+			Type:      v1.ScenarioChange_RETIRE_EQUIPMENT,
+			EquipType: "server",
+			EquipId:   "server1",
+		},
+	}
+	var mockCtrl *gomock.Controller
+	var rep repo.Repository
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		req      *v1.CreateScenarioRequest
+		setup    func()
+		wantCode codes.Code
+	}{
+		{name: "SUCCESS",
+			ctx: ctx,
+			req: &v1.CreateScenarioRequest{
+				Name:    "retire server1",
+				Changes: changes,
+				Scope:   "Scope1",
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := mock.NewMockRepository(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().ListScenarios(ctx, "Scope1").Return([]db.Scenario{{ID: 1, Name: "other"}}, nil).Times(1)
+				mockRepository.EXPECT().InsertScenario(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, arg db.InsertScenarioParams) (db.Scenario, error) {
+					assert.Equal(t, "retire server1", arg.Name)
+					assert.Equal(t, "Scope1", arg.Scope)
+					assert.Equal(t, "admin@superuser.com", arg.CreatedBy)
+					return db.Scenario{
+						ID:        2,
+						Name:      arg.Name,
+						Scope:     arg.Scope,
+						Changes:   arg.Changes,
+						CreatedBy: arg.CreatedBy,
+						UpdatedBy: arg.CreatedBy,
+					}, nil
+				}).Times(1)
+			},
+		},
+		{name: "FAILURE - CreateScenario - cannot find claims in context",
+			ctx:      context.Background(),
+			req:      &v1.CreateScenarioRequest{Name: "s", Changes: changes, Scope: "Scope1"},
+			setup:    func() {},
+			wantCode: codes.Internal,
+		},
+		{name: "FAILURE - CreateScenario - do not have access to the scope",
+			ctx:      ctx,
+			req:      &v1.CreateScenarioRequest{Name: "s", Changes: changes, Scope: "Scope4"},
+			setup:    func() {},
+			wantCode: codes.PermissionDenied,
+		},
+		{name: "FAILURE - CreateScenario - switch metric without editor",
+			ctx: ctx,
+			req: &v1.CreateScenarioRequest{
+				Name: "s",
+				Changes: []*v1.ScenarioChange{
+					{
+						Type:       v1.ScenarioChange_SWITCH_METRIC,
+						SwidTag:    "p1",
+						MetricName: "nup",
+					},
+				},
+				Scope: "Scope1",
+			},
+			setup:    func() {},
+			wantCode: codes.InvalidArgument,
+		},
+		{name: "FAILURE - CreateScenario - scenario with same name already exists",
+			ctx: ctx,
+			req: &v1.CreateScenarioRequest{
+				Name:    "retire server1",
+				Changes: changes,
+				Scope:   "Scope1",
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := mock.NewMockRepository(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().ListScenarios(ctx, "Scope1").Return([]db.Scenario{{ID: 1, Name: "retire server1"}}, nil).Times(1)
+			},
+			wantCode: codes.AlreadyExists,
+		},
+		{name: "FAILURE - CreateScenario - DBError",
+			ctx: ctx,
+			req: &v1.CreateScenarioRequest{
+				Name:    "retire server1",
+				Changes: changes,
+				Scope:   "Scope1",
+			},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := mock.NewMockRepository(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().ListScenarios(ctx, "Scope1").Return(nil, errors.New("internal")).Times(1)
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rep = nil
+			tt.setup()
+			hcs := NewSimulationServiceForTest(rep, nil)
+			got, err := hcs.CreateScenario(tt.ctx, tt.req)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, int32(2), got.Id)
+			assert.Equal(t, tt.req.Name, got.Name)
+			if assert.Len(t, got.Changes, 1) {
+				assert.Equal(t, v1.ScenarioChange_RETIRE_EQUIPMENT, got.Changes[0].Type)
+				assert.Equal(t, "server1", got.Changes[0].EquipId)
+			}
+		})
+	}
+}
+
+func TestSimulationService_EvaluateScenario(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"Scope1", "Scope2", "Scope3"},
+	})
+	attributes := []*v1.EquipAttribute{
+		{
+			ID:        "0x5092e",
+			Name:      "server_corenumber",
+			DataType:  v1.DataTypes_INT,
+			MappedTo:  "server_coresNumber",
+			Val:       &v1.EquipAttribute_IntVal{IntVal: 24},
+			OldVal:    &v1.EquipAttribute_IntValOld{IntValOld: 16},
+			Simulated: true,
+		},
+	}
+	changes, err := marshalScenarioChanges([]*v1.ScenarioChange{
+		{
+			Type:       v1.ScenarioChange_CHANGE_EQUIPMENT,
+			EquipType:  "server",
+			EquipId:    "server1",
+			Attributes: attributes,
+		},
+		{
+			Type:       v1.ScenarioChange_SWITCH_METRIC,
+			Editor:     "Oracle",
+			SwidTag:    "p2",
+			MetricName: "nup",
+			UnitCost:   100,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	scenario := db.Scenario{
+		ID:        1,
+		Name:      "upgrade server1",
+		Scope:     "Scope1",
+		Changes:   changes,
+		CreatedBy: "admin@superuser.com",
+		CreatedOn: time.Now(),
+		UpdatedBy: "admin@superuser.com",
+		UpdatedOn: time.Now(),
+	}
+	var mockCtrl *gomock.Controller
+	var rep repo.Repository
+	var licenseClient ls.LicenseServiceClient
+	var metricClient metv1.MetricServiceClient
+	tests := []struct {
+		name     string
+		req      *v1.EvaluateScenarioRequest
+		setup    func()
+		want     *v1.EvaluateScenarioResponse
+		wantCode codes.Code
+	}{
+		{name: "SUCCESS",
+			req: &v1.EvaluateScenarioRequest{Id: 1, Scope: "Scope1"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := mock.NewMockRepository(mockCtrl)
+				mockLicense := mockls.NewMockLicenseServiceClient(mockCtrl)
+				mockMetric := mockMet.NewMockMetricServiceClient(mockCtrl)
+				rep = mockRepository
+				licenseClient = mockLicense
+				metricClient = mockMetric
+				mockRepository.EXPECT().GetScenario(ctx, db.GetScenarioParams{ID: 1, Scope: "Scope1"}).Return(scenario, nil).Times(1)
+				mockMetric.EXPECT().ListMetrices(ctx, &metv1.ListMetricRequest{
+					Scopes: []string{"Scope1"},
+				}).Return(&metv1.ListMetricResponse{
+					Metrices: []*metv1.Metric{
+						{
+							Type: "oracle.processor.standard",
+							Name: "ops",
+						},
+						{
+							Type: "oracle.nup.standard",
+							Name: "nup",
+						},
+					},
+				}, nil).Times(1)
+				mockLicense.EXPECT().LicensesForEquipAndMetric(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, req *ls.LicensesForEquipAndMetricRequest, _ ...interface{}) (*ls.LicensesForEquipAndMetricResponse, error) {
+					if req.MetricName == "nup" {
+						return nil, status.Error(codes.NotFound, "metric does not exist")
+					}
+					assert.Equal(t, "server1", req.EquipId)
+					assert.Len(t, req.Attributes, 1)
+					return &ls.LicensesForEquipAndMetricResponse{
+						Licenses: []*ls.ProductLicenseForEquipAndMetric{
+							{
+								SwidTag:     "p1",
+								Editor:      "Oracle",
+								MetricName:  "ops",
+								OldLicences: 8,
+								NewLicenses: 12,
+								Delta:       4,
+							},
+						},
+					}, nil
+				}).Times(2)
+				mockLicense.EXPECT().ProductLicensesForMetric(ctx, &ls.ProductLicensesForMetricRequest{
+					SwidTag:    "p2",
+					MetricName: "nup",
+					UnitCost:   100,
+					Scope:      "Scope1",
+				}).Return(&ls.ProductLicensesForMetricResponse{
+					NumCptLicences: 5,
+					TotalCost:      500,
+				}, nil).Times(1)
+				mockLicense.EXPECT().GetOverAllCompliance(ctx, &ls.GetOverAllComplianceRequest{
+					Editor: "Oracle",
+					Scope:  "Scope1",
+				}).Return(&ls.GetOverAllComplianceResponse{
+					AcqRights: []*ls.AggregationAcquiredRights{
+						{
+							SKU:            "sku1",
+							SwidTags:       "p1",
+							Metric:         "ops",
+							NumCptLicences: 10,
+							NumAcqLicences: 12,
+							DeltaNumber:    2,
+							AvgUnitPrice:   100,
+							PurchaseCost:   1200,
+							ComputedCost:   1000,
+							DeltaCost:      200,
+						},
+						{
+							SKU:            "sku2",
+							SwidTags:       "p2",
+							Metric:         "ops",
+							NumCptLicences: 8,
+							NumAcqLicences: 4,
+							DeltaNumber:    -4,
+							AvgUnitPrice:   50,
+							PurchaseCost:   200,
+							ComputedCost:   400,
+							DeltaCost:      -200,
+						},
+						{
+							SKU:            "sku3",
+							SwidTags:       "p3",
+							Metric:         "ops",
+							NumCptLicences: 1,
+							NumAcqLicences: 1,
+							AvgUnitPrice:   10,
+							PurchaseCost:   10,
+							ComputedCost:   10,
+						},
+					},
+				}, nil).Times(1)
+			},
+			want: &v1.EvaluateScenarioResponse{
+				Editors: []*v1.EditorProjection{
+					{
+						Editor: "Oracle",
+						Baseline: &v1.CompliancePosition{
+							NumComputedLicences: 19,
+							NumAcquiredLicences: 17,
+							DeltaNumber:         -2,
+							PurchaseCost:        1410,
+							ComputedCost:        1410,
+							DeltaCost:           0,
+						},
+						Projected: &v1.CompliancePosition{
+							NumComputedLicences: 20,
+							NumAcquiredLicences: 17,
+							DeltaNumber:         -3,
+							PurchaseCost:        1410,
+							ComputedCost:        1910,
+							DeltaCost:           -500,
+						},
+					},
+				},
+				Products: []*v1.ProductProjection{
+					{
+						Editor:   "Oracle",
+						Sku:      "sku1",
+						SwidTags: "p1",
+						Metrics:  "ops",
+						Baseline: &v1.CompliancePosition{
+							NumComputedLicences: 10,
+							NumAcquiredLicences: 12,
+							DeltaNumber:         2,
+							PurchaseCost:        1200,
+							ComputedCost:        1000,
+							DeltaCost:           200,
+						},
+						Projected: &v1.CompliancePosition{
+							NumComputedLicences: 14,
+							NumAcquiredLicences: 12,
+							DeltaNumber:         -2,
+							PurchaseCost:        1200,
+							ComputedCost:        1400,
+							DeltaCost:           -200,
+						},
+					},
+					{
+						Editor:   "Oracle",
+						Sku:      "sku2",
+						SwidTags: "p2",
+						Metrics:  "nup",
+						Baseline: &v1.CompliancePosition{
+							NumComputedLicences: 8,
+							NumAcquiredLicences: 4,
+							DeltaNumber:         -4,
+							PurchaseCost:        200,
+							ComputedCost:        400,
+							DeltaCost:           -200,
+						},
+						Projected: &v1.CompliancePosition{
+							NumComputedLicences: 5,
+							NumAcquiredLicences: 4,
+							DeltaNumber:         -1,
+							PurchaseCost:        200,
+							ComputedCost:        500,
+							DeltaCost:           -300,
+						},
+					},
+				},
+				Failures: []*v1.ScenarioChangeFailure{
+					{
+						ChangeIndex: 0,
+						Reason:      "nup: metric does not exist",
+					},
+				},
+			},
+		},
+		{name: "FAILURE - EvaluateScenario - scenario does not exist",
+			req: &v1.EvaluateScenarioRequest{Id: 2, Scope: "Scope1"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := mock.NewMockRepository(mockCtrl)
+				rep = mockRepository
+				mockRepository.EXPECT().GetScenario(ctx, db.GetScenarioParams{ID: 2, Scope: "Scope1"}).Return(db.Scenario{}, sql.ErrNoRows).Times(1)
+			},
+			wantCode: codes.NotFound,
+		},
+		{name: "FAILURE - EvaluateScenario - unable to fetch metrics",
+			req: &v1.EvaluateScenarioRequest{Id: 1, Scope: "Scope1"},
+			setup: func() {
+				mockCtrl = gomock.NewController(t)
+				mockRepository := mock.NewMockRepository(mockCtrl)
+				mockMetric := mockMet.NewMockMetricServiceClient(mockCtrl)
+				rep = mockRepository
+				metricClient = mockMetric
+				mockRepository.EXPECT().GetScenario(ctx, db.GetScenarioParams{ID: 1, Scope: "Scope1"}).Return(scenario, nil).Times(1)
+				mockMetric.EXPECT().ListMetrices(ctx, gomock.Any()).Return(nil, errors.New("internal")).Times(1)
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			defer mockCtrl.Finish()
+			hcs := &SimulationService{
+				repo:          rep,
+				licenseClient: licenseClient,
+				metricClient:  metricClient,
+			}
+			got, err := hcs.EvaluateScenario(ctx, tt.req)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, scenario.Name, got.Scenario.Name)
+			assert.Len(t, got.Scenario.Changes, 2)
+			assert.Equal(t, tt.want.Editors, got.Editors)
+			assert.Equal(t, tt.want.Products, got.Products)
+			assert.Equal(t, tt.want.Failures, got.Failures)
+		})
+	}
+}
+
+func TestSimulationService_equipmentDeltas(t *testing.T) {
+	ctx := grpc_middleware.AddClaims(context.Background(), &claims.Claims{
+		UserID: "admin@superuser.com",
+		Role:   "Admin",
+		Socpes: []string{"Scope1"},
+	})
+	cores := func(val, old int32) []*v1.EquipAttribute {
+		return []*v1.EquipAttribute{
+			{
+				Name:      "server_corenumber",
+				DataType:  v1.DataTypes_INT,
+				MappedTo:  "server_coresNumber",
+				Val:       &v1.EquipAttribute_IntVal{IntVal: val},
+				OldVal:    &v1.EquipAttribute_IntValOld{IntValOld: old},
+				Simulated: true,
+			},
+		}
+	}
+	changes := []*v1.ScenarioChange{
+		{Type: v1.ScenarioChange_CHANGE_EQUIPMENT, EquipType: "server", EquipId: "server1", Attributes: cores(24, 16)},
+		{Type: v1.ScenarioChange_CHANGE_EQUIPMENT, EquipType: "server", EquipId: "server1", Attributes: cores(32, 24)},
+		{Type: v1.ScenarioChange_MOVE_PRODUCT, EquipType: "server", EquipId: "server1", SwidTag: "p2", TargetEquipId: "server2", Attributes: cores(8, 8)},
+	}
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mockLicense := mockls.NewMockLicenseServiceClient(mockCtrl)
+	mockLicense.EXPECT().LicensesForEquipAndMetric(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, req *ls.LicensesForEquipAndMetricRequest, _ ...interface{}) (*ls.LicensesForEquipAndMetricResponse, error) {
+		switch req.EquipId {
+		case "server1":
+			// both changes of server1 are applied to the same state
+			if assert.Len(t, req.Attributes, 1) {
+				assert.Equal(t, int32(32), req.Attributes[0].GetIntVal())
+				assert.Equal(t, int32(16), req.Attributes[0].GetIntValOld())
+			}
+			return &ls.LicensesForEquipAndMetricResponse{
+				Licenses: []*ls.ProductLicenseForEquipAndMetric{
+					{SwidTag: "p1", Editor: "Oracle", OldLicences: 8, NewLicenses: 16},
+					{SwidTag: "p2", Editor: "Oracle", OldLicences: 8, NewLicenses: 16},
+				},
+			}, nil
+		case "server2":
+			return &ls.LicensesForEquipAndMetricResponse{
+				Licenses: []*ls.ProductLicenseForEquipAndMetric{
+					{SwidTag: "p3", Editor: "Oracle", OldLicences: 4, NewLicenses: 4},
+				},
+			}, nil
+		}
+		return nil, status.Error(codes.NotFound, "equipment does not exist")
+	}).Times(2)
+	hcs := &SimulationService{licenseClient: mockLicense}
+	deltas, failures := hcs.equipmentDeltas(ctx, equipmentStates(changes), []*metv1.Metric{{Type: "oracle.processor.standard", Name: "ops"}}, "Scope1")
+	assert.Empty(t, failures)
+	assert.Equal(t, []*licenceDelta{
+		{editor: "Oracle", swidTag: "p1", metric: "ops", delta: 8},
+		{editor: "Oracle", swidTag: "p2", metric: "ops", delta: -8},
+		// p2 is licensed on server2 like the products it already hosts
+		{editor: "Oracle", swidTag: "p2", metric: "ops", delta: 4},
+	}, deltas)
+}